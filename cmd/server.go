@@ -3,17 +3,72 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"time"
+
+	"cinema-booking/pkg/utils"
 
 	"github.com/go-chi/chi/v5"
+	"golang.org/x/crypto/acme/autocert"
+	"google.golang.org/grpc"
 )
 
-// APIServerWithPort starts server
-func APIServer(route *chi.Mux, port string) {
-	addr := fmt.Sprintf(":%s", port)
-	fmt.Printf("Server running on http://localhost%s\n", addr)
+// APIServer starts HTTP server; HTTPS (dengan HTTP/2) kalau cert file atau autocert di-set di config
+func APIServer(route *chi.Mux, config utils.AppConfig) {
+	addr := fmt.Sprintf(":%s", config.Port)
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           route,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	var err error
+	switch {
+	case len(config.TLSAutocertDomains) > 0:
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(config.TLSAutocertDomains...),
+			Cache:      autocert.DirCache(config.TLSAutocertCacheDir),
+			Email:      config.TLSAutocertEmail,
+		}
+		server.TLSConfig = manager.TLSConfig()
+
+		// HTTP-01 challenge Let's Encrypt harus lewat port 80; request lain di-redirect ke HTTPS
+		go func() {
+			if err := http.ListenAndServe(":80", manager.HTTPHandler(nil)); err != nil {
+				log.Fatal("ACME challenge server error:", err)
+			}
+		}()
+
+		fmt.Printf("Server running on https://%s%s (autocert)\n", config.TLSAutocertDomains[0], addr)
+		err = server.ListenAndServeTLS("", "")
+
+	case config.TLSCertFile != "":
+		fmt.Printf("Server running on https://localhost%s\n", addr)
+		err = server.ListenAndServeTLS(config.TLSCertFile, config.TLSKeyFile)
+
+	default:
+		fmt.Printf("Server running on http://localhost%s\n", addr)
+		err = server.ListenAndServe()
+	}
 
-	if err := http.ListenAndServe(addr, route); err != nil {
+	if err != nil {
 		log.Fatal("Server error:", err)
 	}
 }
+
+// GRPCServer starts gRPC listener untuk internal services
+func GRPCServer(server *grpc.Server, port string) {
+	addr := fmt.Sprintf(":%s", port)
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatal("gRPC listen error:", err)
+	}
+
+	fmt.Printf("gRPC server running on %s\n", addr)
+	if err := server.Serve(lis); err != nil {
+		log.Fatal("gRPC server error:", err)
+	}
+}
@@ -0,0 +1,52 @@
+package adaptor
+
+import (
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+type AuditHandler struct {
+	service usecase.AuditService
+	log     *zap.Logger
+}
+
+func NewAuditHandler(service usecase.AuditService, log *zap.Logger) *AuditHandler {
+	return &AuditHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "audit")),
+	}
+}
+
+// GetAuditLogs handles GET /api/admin/audit-logs?actor_id=&target_id=&action=&page=&per_page=
+func (h *AuditHandler) GetAuditLogs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 20),
+	}
+	filter := &request.AuditLogFilter{
+		ActorID:  query.Get("actor_id"),
+		TargetID: query.Get("target_id"),
+		Action:   query.Get("action"),
+	}
+
+	entries, err := h.service.GetAuditLogs(r.Context(), req, filter)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			h.log.Warn("Get audit logs validation failed", zap.Error(err))
+			utils.ResponseValidationError(w, r, err)
+			return
+		}
+		h.log.Error("Failed to get audit logs", zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", entries.Data, entries.Pagination)
+}
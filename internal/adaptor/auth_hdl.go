@@ -9,6 +9,7 @@ import (
 	"cinema-booking/internal/usecase"
 	"cinema-booking/pkg/utils"
 
+	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 )
 
@@ -34,16 +35,10 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	// Call service
 	response, err := h.service.Register(r.Context(), &req)
 	if err != nil {
-		h.handleServiceError(w, err, "register")
+		h.handleServiceError(w, r, err, "register")
 		return
 	}
 
@@ -59,15 +54,9 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	response, err := h.service.Login(r.Context(), &req)
 	if err != nil {
-		h.handleServiceError(w, err, "login")
+		h.handleServiceError(w, r, err, "login")
 		return
 	}
 
@@ -93,7 +82,7 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	token := parts[1]
 
 	if err := h.service.Logout(r.Context(), token); err != nil {
-		h.handleServiceError(w, err, "logout")
+		h.handleServiceError(w, r, err, "logout")
 		return
 	}
 
@@ -111,12 +100,12 @@ func (h *AuthHandler) SendOTP(w http.ResponseWriter, r *http.Request) {
 
 	// Validate request
 	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
+		utils.ResponseValidationError(w, r, validationErrors)
 		return
 	}
 
 	if err := h.service.SendOTP(r.Context(), req.Email, req.Type); err != nil {
-		h.handleServiceError(w, err, "send OTP")
+		h.handleServiceError(w, r, err, "send OTP")
 		return
 	}
 
@@ -132,22 +121,56 @@ func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
+	if err := h.service.VerifyEmail(r.Context(), &req); err != nil {
+		h.handleServiceError(w, r, err, "verify email")
 		return
 	}
 
-	if err := h.service.VerifyEmail(r.Context(), &req); err != nil {
-		h.handleServiceError(w, err, "verify email")
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// Impersonate handles POST /api/admin/users/{id}/impersonate (platform admin) {reason}
+func (h *AuthHandler) Impersonate(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
 		return
 	}
 
-	utils.ResponseSuccess(w, "success", nil)
+	var req request.ImpersonateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+	req.IPAddress = r.RemoteAddr
+
+	session, err := h.service.Impersonate(r.Context(), adminID.String(), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "impersonate user")
+		return
+	}
+
+	utils.ResponseCreated(w, "Impersonation session started", session)
+}
+
+// StopImpersonation handles POST /api/impersonation/stop, dipanggil dengan token impersonation itu sendiri
+func (h *AuthHandler) StopImpersonation(w http.ResponseWriter, r *http.Request) {
+	token, ok := utils.GetTokenFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	if err := h.service.StopImpersonation(r.Context(), token, r.RemoteAddr); err != nil {
+		h.handleServiceError(w, r, err, "stop impersonation")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Impersonation session stopped", nil)
 }
 
 // handleServiceError categorizes service errors and returns appropriate HTTP responses
-func (h *AuthHandler) handleServiceError(w http.ResponseWriter, err error, operation string) {
+func (h *AuthHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
 	errMsg := err.Error()
 
 	// Check error message patterns to determine error type
@@ -174,12 +197,16 @@ func (h *AuthHandler) handleServiceError(w http.ResponseWriter, err error, opera
 
 	case strings.Contains(errMsg, "validation failed"):
 		h.log.Warn(operation+" validation failed", zap.Error(err))
-		utils.ResponseBadRequest(w, errMsg, err)
+		utils.ResponseValidationError(w, r, err)
 
 	case strings.Contains(errMsg, "invalid or expired"):
 		h.log.Warn(operation+" failed - invalid OTP", zap.Error(err))
 		utils.ResponseBadRequest(w, errMsg, err)
 
+	case strings.Contains(errMsg, "cannot"), strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation, zap.Error(err))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
 	default:
 		h.log.Error("Failed to "+operation, zap.Error(err), zap.String("operation", operation))
 		utils.ResponseInternalError(w, "Internal server error")
@@ -0,0 +1,154 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type BannerHandler struct {
+	service usecase.BannerService
+	log     *zap.Logger
+}
+
+func NewBannerHandler(service usecase.BannerService, log *zap.Logger) *BannerHandler {
+	return &BannerHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "banner")),
+	}
+}
+
+// GetLiveBanners handles GET /api/banners?city= (public, hanya banner yang sedang tampil)
+func (h *BannerHandler) GetLiveBanners(w http.ResponseWriter, r *http.Request) {
+	banners, err := h.service.GetLiveBanners(r.Context(), r.URL.Query().Get("city"))
+	if err != nil {
+		h.handleServiceError(w, r, err, "get live banners")
+		return
+	}
+
+	// Tanpa ETag: banner bisa mulai/berakhir tanpa ada row yang berubah, jadi cukup cache pendek
+	w.Header().Set("Cache-Control", utils.CacheControlPublic(bannerListMaxAge))
+	utils.ResponseSuccess(w, "success", banners)
+}
+
+// GetBanners handles GET /api/admin/banners (termasuk terjadwal, nonaktif dan yang sudah lewat)
+func (h *BannerHandler) GetBanners(w http.ResponseWriter, r *http.Request) {
+	banners, err := h.service.GetBanners(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get banners")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", banners)
+}
+
+// GetBannerByID handles GET /api/admin/banners/{id}
+func (h *BannerHandler) GetBannerByID(w http.ResponseWriter, r *http.Request) {
+	bannerID := chi.URLParam(r, "id")
+	if bannerID == "" {
+		utils.ResponseBadRequest(w, "Banner ID is required", nil)
+		return
+	}
+
+	banner, err := h.service.GetBannerByID(r.Context(), bannerID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get banner")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", banner)
+}
+
+// CreateBanner handles POST /api/admin/banners
+func (h *BannerHandler) CreateBanner(w http.ResponseWriter, r *http.Request) {
+	var req request.BannerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	banner, err := h.service.CreateBanner(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "create banner")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", banner)
+}
+
+// UpdateBanner handles PUT /api/admin/banners/{id}, body lengkap seperti create
+func (h *BannerHandler) UpdateBanner(w http.ResponseWriter, r *http.Request) {
+	bannerID := chi.URLParam(r, "id")
+	if bannerID == "" {
+		utils.ResponseBadRequest(w, "Banner ID is required", nil)
+		return
+	}
+
+	var req request.BannerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	banner, err := h.service.UpdateBanner(r.Context(), bannerID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update banner")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", banner)
+}
+
+// DeleteBanner handles DELETE /api/admin/banners/{id}
+func (h *BannerHandler) DeleteBanner(w http.ResponseWriter, r *http.Request) {
+	bannerID := chi.URLParam(r, "id")
+	if bannerID == "" {
+		utils.ResponseBadRequest(w, "Banner ID is required", nil)
+		return
+	}
+
+	if err := h.service.DeleteBanner(r.Context(), bannerID); err != nil {
+		h.handleServiceError(w, r, err, "delete banner")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// handleServiceError handles errors untuk banner operations
+func (h *BannerHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -2,17 +2,27 @@ package adaptor
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"cinema-booking/internal/dto/request"
 	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/i18n"
 	"cinema-booking/pkg/utils"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 )
 
+// errCodeSalesClosed dikirim di "errors.code" supaya client bisa membedakan penjualan tutup dari error booking lain
+const errCodeSalesClosed = "SALES_CLOSED"
+
+// errCodeSeatAlreadyBooked kursi sudah dipegang booking lain, baik ketahuan di service maupun di unique index
+const errCodeSeatAlreadyBooked = "SEAT_ALREADY_BOOKED"
+
 type BookingHandler struct {
 	service usecase.BookingService
 	log     *zap.Logger
@@ -40,15 +50,9 @@ func (h *BookingHandler) CreateBooking(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	booking, err := h.service.CreateBooking(r.Context(), userID.String(), &req)
 	if err != nil {
-		h.handleServiceError(w, err, "create booking")
+		h.handleServiceError(w, r, err, "create booking")
 		return
 	}
 
@@ -73,14 +77,75 @@ func (h *BookingHandler) GetUserBookings(w http.ResponseWriter, r *http.Request)
 	query := r.URL.Query()
 	req.Page = utils.ParseInt(query.Get("page"), 1)
 	req.PerPage = utils.ParseInt(query.Get("per_page"), 10)
+	req.Cursor = request.CursorFromQuery(query)
+
+	// Optional filters: status, when=upcoming|past, start_date/end_date (show date)
+	filter := &request.BookingHistoryFilter{
+		Status:    query.Get("status"),
+		When:      query.Get("when"),
+		StartDate: query.Get("start_date"),
+		EndDate:   query.Get("end_date"),
+	}
+
+	bookings, err := h.service.GetUserBookings(r.Context(), userID.String(), req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get user bookings")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", bookings.Data, bookings.Pagination)
+}
 
-	bookings, err := h.service.GetUserBookings(r.Context(), userID.String(), req)
+// GetUserBookingByOrderID handles GET /api/user/bookings/by-order/{orderID} (protected, owner only)
+func (h *BookingHandler) GetUserBookingByOrderID(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	orderID := chi.URLParam(r, "orderID")
+	if orderID == "" {
+		utils.ResponseBadRequest(w, "Order ID is required", nil)
+		return
+	}
+
+	booking, err := h.service.GetUserBookingByOrderID(r.Context(), userID.String(), orderID)
 	if err != nil {
-		h.handleServiceError(w, err, "get user bookings")
+		h.handleServiceError(w, r, err, "get user booking by order ID")
 		return
 	}
 
-	utils.ResponseSuccess(w, "success", bookings)
+	utils.ResponseSuccess(w, "success", booking)
+}
+
+// GetBookingReceipt handles GET /api/user/bookings/{id}/receipt (protected, owner only)
+func (h *BookingHandler) GetBookingReceipt(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	bookingID := chi.URLParam(r, "id")
+	if bookingID == "" {
+		utils.ResponseBadRequest(w, "Booking ID is required", nil)
+		return
+	}
+
+	content, filename, err := h.service.GetBookingReceipt(r.Context(), userID.String(), bookingID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get booking receipt")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(content); err != nil {
+		h.log.Warn("Failed to write receipt", zap.Error(err))
+	}
 }
 
 // ProcessPayment handles POST /api/pay (protected)
@@ -98,15 +163,9 @@ func (h *BookingHandler) ProcessPayment(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	payment, err := h.service.ProcessPayment(r.Context(), userID.String(), &req)
 	if err != nil {
-		h.handleServiceError(w, err, "process payment")
+		h.handleServiceError(w, r, err, "process payment")
 		return
 	}
 
@@ -117,15 +176,75 @@ func (h *BookingHandler) ProcessPayment(w http.ResponseWriter, r *http.Request)
 func (h *BookingHandler) GetPaymentMethods(w http.ResponseWriter, r *http.Request) {
 	paymentMethods, err := h.service.GetPaymentMethods(r.Context())
 	if err != nil {
-		h.handleServiceError(w, err, "get payment methods")
+		h.handleServiceError(w, r, err, "get payment methods")
 		return
 	}
 
 	utils.ResponseSuccess(w, "success", paymentMethods)
 }
 
+// GetPaymentStatus handles GET /api/payments/{id}/status (protected, owner only)
+func (h *BookingHandler) GetPaymentStatus(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	paymentID := chi.URLParam(r, "id")
+	if paymentID == "" {
+		utils.ResponseBadRequest(w, "Payment ID is required", nil)
+		return
+	}
+
+	status, err := h.service.GetPaymentStatus(r.Context(), userID.String(), paymentID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get payment status")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", status)
+}
+
+// PaymentWebhook handles POST /api/payments/webhook (signature diverifikasi middleware)
+func (h *BookingHandler) PaymentWebhook(w http.ResponseWriter, r *http.Request) {
+	var req request.PaymentWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	status, err := h.service.HandlePaymentWebhook(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "handle payment webhook")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", status)
+}
+
 // ==================== ADMIN METHODS ====================
 
+// GetAllBookings handles GET /api/admin/bookings (admin only)
+func (h *BookingHandler) GetAllBookings(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 10),
+		Cursor:  request.CursorFromQuery(query),
+	}
+
+	filter := &request.AdminBookingFilter{Flag: query.Get("flag")}
+
+	bookings, err := h.service.GetAllBookings(r.Context(), req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get all bookings")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", bookings.Data, bookings.Pagination)
+}
+
 // GetBookingByID handles GET /api/admin/bookings/{id} (admin only)
 func (h *BookingHandler) GetBookingByID(w http.ResponseWriter, r *http.Request) {
 	bookingID := chi.URLParam(r, "id")
@@ -136,7 +255,24 @@ func (h *BookingHandler) GetBookingByID(w http.ResponseWriter, r *http.Request)
 
 	booking, err := h.service.GetBookingByID(r.Context(), bookingID)
 	if err != nil {
-		h.handleServiceError(w, err, "get booking by ID")
+		h.handleServiceError(w, r, err, "get booking by ID")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", booking)
+}
+
+// GetBookingByOrderID handles GET /api/admin/bookings/by-order/{orderID} (admin only)
+func (h *BookingHandler) GetBookingByOrderID(w http.ResponseWriter, r *http.Request) {
+	orderID := chi.URLParam(r, "orderID")
+	if orderID == "" {
+		utils.ResponseBadRequest(w, "Order ID is required", nil)
+		return
+	}
+
+	booking, err := h.service.GetBookingByOrderID(r.Context(), orderID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get booking by order ID")
 		return
 	}
 
@@ -152,53 +288,178 @@ func (h *BookingHandler) CancelBooking(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.CancelBooking(r.Context(), bookingID); err != nil {
-		h.handleServiceError(w, err, "cancel booking")
+		h.handleServiceError(w, r, err, "cancel booking")
 		return
 	}
 
 	utils.ResponseSuccess(w, "success", nil)
 }
 
+// CreateGroupBooking handles POST /api/admin/bookings/group (admin only)
+func (h *BookingHandler) CreateGroupBooking(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.GroupBookingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	booking, err := h.service.CreateGroupBooking(r.Context(), adminID.String(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "create group booking")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", booking)
+}
+
+// AddBookingNote handles POST /api/admin/bookings/{id}/notes (admin only)
+func (h *BookingHandler) AddBookingNote(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.CreateBookingNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	note, err := h.service.AddBookingNote(r.Context(), adminID.String(), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "add booking note")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", note)
+}
+
+// GetBookingNotes handles GET /api/staff/bookings/{id}/notes (staff / admin)
+func (h *BookingHandler) GetBookingNotes(w http.ResponseWriter, r *http.Request) {
+	notes, err := h.service.GetBookingNotes(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.handleServiceError(w, r, err, "get booking notes")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", notes)
+}
+
+// UpdateBookingFlags handles PUT /api/admin/bookings/{id}/flags (admin only)
+func (h *BookingHandler) UpdateBookingFlags(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.UpdateBookingFlagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	flags, err := h.service.UpdateBookingFlags(r.Context(), adminID.String(), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update booking flags")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", map[string]any{"flags": flags})
+}
+
+// TransitionBooking handles POST /api/admin/bookings/{id}/status (admin only)
+func (h *BookingHandler) TransitionBooking(w http.ResponseWriter, r *http.Request) {
+	var req request.TransitionBookingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	booking, err := h.service.TransitionBooking(r.Context(), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "transition booking")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", booking)
+}
+
 // handleServiceError handles errors untuk booking operations
-func (h *BookingHandler) handleServiceError(w http.ResponseWriter, err error, operation string) {
+func (h *BookingHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
 	errMsg := err.Error()
+	// errMsg (English) untuk pencocokan, msg untuk response dalam bahasa request
+	msg := i18n.Message(r.Context(), err)
+
+	// Seat rule violations carry the rule code so clients can show a specific message
+	var seatErr *usecase.SeatSelectionError
+	if errors.As(err, &seatErr) {
+		h.log.Warn(operation+" failed - seat rule violated",
+			zap.Error(err),
+			zap.String("rule", string(seatErr.Rule)))
+		utils.ResponseBadRequest(w, msg, map[string]string{"rule": string(seatErr.Rule)})
+		return
+	}
+
+	// Booking/payment diubah request lain di tengah jalan (mis. admin cancel saat user bayar)
+	var conflictErr *usecase.ConflictError
+	if errors.As(err, &conflictErr) {
+		h.log.Warn(operation+" failed - concurrent update",
+			zap.Error(err),
+			zap.String("entity", conflictErr.Entity))
+		utils.ResponseConflict(w, i18n.T(i18n.FromContext(r.Context()), "booking.concurrent_update"))
+		return
+	}
+
+	if errors.Is(err, usecase.ErrSalesClosed) {
+		h.log.Warn(operation+" failed - sales closed", zap.Error(err))
+		utils.ResponseBadRequest(w, msg, map[string]string{"code": errCodeSalesClosed})
+		return
+	}
 
 	switch {
 	case strings.Contains(errMsg, "not found"):
 		h.log.Warn(operation+" failed - not found",
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseNotFound(w, errMsg)
+		utils.ResponseNotFound(w, msg)
 
 	case strings.Contains(errMsg, "validation failed"):
 		h.log.Warn(operation+" validation failed",
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseBadRequest(w, errMsg, nil)
+		utils.ResponseValidationError(w, r, err)
 
 	case strings.Contains(errMsg, "invalid"):
 		h.log.Warn("Invalid input for "+operation,
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseBadRequest(w, errMsg, nil)
+		utils.ResponseBadRequest(w, msg, nil)
 
 	case strings.Contains(errMsg, "already booked"):
 		h.log.Warn(operation+" failed - seat already booked",
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseBadRequest(w, errMsg, nil)
+		utils.ResponseBadRequest(w, msg, map[string]string{"code": errCodeSeatAlreadyBooked})
 
 	case strings.Contains(errMsg, "unauthorized"):
 		h.log.Warn(operation+" failed - unauthorized",
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseUnauthorized(w, errMsg)
+		utils.ResponseUnauthorized(w, msg)
 
 	case strings.Contains(errMsg, "cannot"):
 		h.log.Warn(operation+" failed - invalid state",
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseBadRequest(w, errMsg, nil)
+		utils.ResponseBadRequest(w, msg, nil)
 
 	default:
 		h.log.Error("Failed to "+operation,
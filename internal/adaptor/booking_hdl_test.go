@@ -0,0 +1,193 @@
+package adaptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/internal/usecase/mockusecase"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestBookingHandler_CreateBooking(t *testing.T) {
+	userID := uuid.New()
+	req := request.CreateBookingRequest{
+		ScheduleID:      uuid.NewString(),
+		SeatIDs:         []string{uuid.NewString()},
+		PaymentMethodID: uuid.NewString(),
+	}
+
+	tests := []struct {
+		name       string
+		authed     bool
+		body       string
+		setup      func(svc *mockusecase.MockBookingService)
+		wantStatus int
+		wantErrors map[string]string
+	}{
+		{
+			name:       "unauthenticated",
+			body:       "{}",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "malformed body",
+			authed:     true,
+			body:       "{",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "created",
+			authed: true,
+			setup: func(svc *mockusecase.MockBookingService) {
+				svc.EXPECT().
+					CreateBooking(gomock.Any(), userID.String(), &req).
+					Return(&response.BookingResponse{ID: uuid.NewString(), OrderID: "BOOK-20261016-ABCDEFGHJK"}, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "seat already booked",
+			authed: true,
+			setup: func(svc *mockusecase.MockBookingService) {
+				svc.EXPECT().CreateBooking(gomock.Any(), userID.String(), &req).
+					Return(nil, errors.New("seat A1 is already booked"))
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErrors: map[string]string{"code": errCodeSeatAlreadyBooked},
+		},
+		{
+			name:   "sales closed",
+			authed: true,
+			setup: func(svc *mockusecase.MockBookingService) {
+				svc.EXPECT().CreateBooking(gomock.Any(), userID.String(), &req).
+					Return(nil, fmt.Errorf("create booking: %w", usecase.ErrSalesClosed))
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErrors: map[string]string{"code": errCodeSalesClosed},
+		},
+		{
+			name:   "concurrent update",
+			authed: true,
+			setup: func(svc *mockusecase.MockBookingService) {
+				svc.EXPECT().CreateBooking(gomock.Any(), userID.String(), &req).
+					Return(nil, &usecase.ConflictError{Entity: "booking", ID: uuid.New()})
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:   "unexpected error",
+			authed: true,
+			setup: func(svc *mockusecase.MockBookingService) {
+				svc.EXPECT().CreateBooking(gomock.Any(), userID.String(), &req).
+					Return(nil, errors.New("connection reset"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			svc := mockusecase.NewMockBookingService(ctrl)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			handler := NewBookingHandler(svc, zap.NewNop())
+
+			body := jsonBody(t, req)
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			ctx := context.Background()
+			if tc.authed {
+				ctx = asUser(userID)
+			}
+
+			rec := serve(t, ctx, http.MethodPost, "/api/booking", "/api/booking", body, handler.CreateBooking)
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+
+			resp := decodeResponse(t, rec)
+			if resp.Status != (tc.wantStatus < http.StatusBadRequest) {
+				t.Fatalf("response status = %v for HTTP %d", resp.Status, rec.Code)
+			}
+			for key, want := range tc.wantErrors {
+				errs, _ := resp.Errors.(map[string]any)
+				if got := errs[key]; got != want {
+					t.Fatalf("errors.%s = %v, want %q", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBookingHandler_GetBookingByID(t *testing.T) {
+	bookingID := uuid.NewString()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "found", wantStatus: http.StatusOK},
+		{name: "not found", err: fmt.Errorf("booking %s not found", bookingID), wantStatus: http.StatusNotFound},
+		{name: "invalid id", err: errors.New("invalid booking ID"), wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			svc := mockusecase.NewMockBookingService(ctrl)
+
+			var detail *response.BookingDetailResponse
+			if tc.err == nil {
+				detail = &response.BookingDetailResponse{}
+			}
+			svc.EXPECT().GetBookingByID(gomock.Any(), bookingID).Return(detail, tc.err)
+
+			handler := NewBookingHandler(svc, zap.NewNop())
+			rec := serve(t, context.Background(), http.MethodGet, "/api/admin/bookings/{id}", "/api/admin/bookings/"+bookingID, nil, handler.GetBookingByID)
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestBookingHandler_CancelBooking(t *testing.T) {
+	bookingID := uuid.NewString()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "cancelled", wantStatus: http.StatusOK},
+		{name: "invalid state", err: errors.New("cannot cancel booking with status expired"), wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			svc := mockusecase.NewMockBookingService(ctrl)
+			svc.EXPECT().CancelBooking(gomock.Any(), bookingID).Return(tc.err)
+
+			handler := NewBookingHandler(svc, zap.NewNop())
+			rec := serve(t, context.Background(), http.MethodPut, "/api/admin/bookings/{id}/cancel", "/api/admin/bookings/"+bookingID+"/cancel", nil, handler.CancelBooking)
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
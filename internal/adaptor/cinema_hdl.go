@@ -3,9 +3,11 @@ package adaptor
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
 	"cinema-booking/internal/usecase"
 	"cinema-booking/pkg/utils"
 
@@ -27,47 +29,77 @@ func NewCinemaHandler(service usecase.CinemaService, log *zap.Logger) *CinemaHan
 
 // GetCinemas handles GET /api/cinemas (public)
 func (h *CinemaHandler) GetCinemas(w http.ResponseWriter, r *http.Request) {
-	// Parse query parameters
-	req := &request.PaginatedRequest{
-		Page:    1,
-		PerPage: 10,
-	}
+	h.listCinemas(w, r, false, true)
+}
 
-	query := r.URL.Query()
-	req.Page = utils.ParseInt(query.Get("page"), 1)
-	req.PerPage = utils.ParseInt(query.Get("per_page"), 10)
+// GetCinemaByID handles GET /api/cinemas/{id} (public)
+// ?date=YYYY-MM-DD atau ?date=today menambahkan showtimes hari itu per movie
+func (h *CinemaHandler) GetCinemaByID(w http.ResponseWriter, r *http.Request) {
+	cinemaID := chi.URLParam(r, "id")
+	if cinemaID == "" {
+		utils.ResponseBadRequest(w, "Cinema ID is required", nil)
+		return
+	}
 
-	// Filter by city (optional)
-	var cityFilter *string
-	if city := query.Get("city"); city != "" {
-		cityFilter = &city
+	cinema, err := h.service.GetCinemaByID(r.Context(), cinemaID, r.URL.Query().Get("date"))
+	if err != nil {
+		h.handleServiceError(w, r, err, "get cinema by ID")
+		return
 	}
 
-	// Call service
-	cinemas, err := h.service.GetCinemas(r.Context(), req, cityFilter)
+	utils.ResponseSuccess(w, "success", cinema)
+}
+
+// GetCities handles GET /api/cities (public)
+func (h *CinemaHandler) GetCities(w http.ResponseWriter, r *http.Request) {
+	cities, err := h.service.GetCities(r.Context())
 	if err != nil {
-		h.handleServiceError(w, err, "get cinemas")
+		h.handleServiceError(w, r, err, "get cities")
 		return
 	}
 
-	utils.ResponseSuccess(w, "success", cinemas)
+	utils.ResponseSuccess(w, "success", cities)
 }
 
-// GetCinemaByID handles GET /api/cinemas/{id} (public)
-func (h *CinemaHandler) GetCinemaByID(w http.ResponseWriter, r *http.Request) {
-	cinemaID := chi.URLParam(r, "id")
-	if cinemaID == "" {
-		utils.ResponseBadRequest(w, "Cinema ID is required", nil)
+// GetNearbyCinemas handles GET /api/cinemas/nearby?lat=&lng=&radius_km= (public)
+func (h *CinemaHandler) GetNearbyCinemas(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	lat, err := strconv.ParseFloat(query.Get("lat"), 64)
+	if err != nil {
+		utils.ResponseBadRequest(w, "Valid lat query parameter is required", nil)
 		return
 	}
 
-	cinema, err := h.service.GetCinemaByID(r.Context(), cinemaID)
+	lng, err := strconv.ParseFloat(query.Get("lng"), 64)
 	if err != nil {
-		h.handleServiceError(w, err, "get cinema by ID")
+		utils.ResponseBadRequest(w, "Valid lng query parameter is required", nil)
 		return
 	}
 
-	utils.ResponseSuccess(w, "success", cinema)
+	// Default radius 10 km
+	radiusKm := 10.0
+	if radius := query.Get("radius_km"); radius != "" {
+		radiusKm, err = strconv.ParseFloat(radius, 64)
+		if err != nil {
+			utils.ResponseBadRequest(w, "Invalid radius_km query parameter", nil)
+			return
+		}
+	}
+
+	req := &request.NearbyCinemasRequest{
+		Latitude:  lat,
+		Longitude: lng,
+		RadiusKm:  radiusKm,
+	}
+
+	cinemas, err := h.service.GetNearbyCinemas(r.Context(), req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get nearby cinemas")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", cinemas)
 }
 
 // GetSeatAvailability handles GET /api/cinemas/{id}/seats (public)
@@ -91,7 +123,7 @@ func (h *CinemaHandler) GetSeatAvailability(w http.ResponseWriter, r *http.Reque
 	// Call service
 	seatAvailability, err := h.service.GetSeatAvailability(r.Context(), cinemaID, date, time)
 	if err != nil {
-		h.handleServiceError(w, err, "get seat availability")
+		h.handleServiceError(w, r, err, "get seat availability")
 		return
 	}
 
@@ -107,15 +139,9 @@ func (h *CinemaHandler) CreateCinema(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	cinema, err := h.service.CreateCinema(r.Context(), &req)
 	if err != nil {
-		h.handleServiceError(w, err, "create cinema")
+		h.handleServiceError(w, r, err, "create cinema")
 		return
 	}
 
@@ -136,15 +162,9 @@ func (h *CinemaHandler) UpdateCinema(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	cinema, err := h.service.UpdateCinema(r.Context(), cinemaID, &req)
 	if err != nil {
-		h.handleServiceError(w, err, "update cinema")
+		h.handleServiceError(w, r, err, "update cinema")
 		return
 	}
 
@@ -160,15 +180,154 @@ func (h *CinemaHandler) DeleteCinema(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteCinema(r.Context(), cinemaID); err != nil {
-		h.handleServiceError(w, err, "delete cinema")
+		h.handleServiceError(w, r, err, "delete cinema")
 		return
 	}
 
 	utils.ResponseSuccess(w, "success", nil)
 }
 
+// GetCinemasAdmin handles GET /api/admin/cinemas (admin only, supports include_deleted)
+func (h *CinemaHandler) GetCinemasAdmin(w http.ResponseWriter, r *http.Request) {
+	h.listCinemas(w, r, utils.ParseBool(r.URL.Query().Get("include_deleted"), false), false)
+}
+
+// RestoreCinema handles POST /api/admin/cinemas/{id}/restore
+func (h *CinemaHandler) RestoreCinema(w http.ResponseWriter, r *http.Request) {
+	cinemaID := chi.URLParam(r, "id")
+	if cinemaID == "" {
+		utils.ResponseBadRequest(w, "Cinema ID is required", nil)
+		return
+	}
+
+	if err := h.service.RestoreCinema(r.Context(), cinemaID); err != nil {
+		h.handleServiceError(w, r, err, "restore cinema")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Cinema restored successfully", nil)
+}
+
+// CloseCinema handles PUT /api/admin/cinemas/{id}/close
+func (h *CinemaHandler) CloseCinema(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.CloseCinemaRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	result, err := h.service.CloseCinema(r.Context(), adminID.String(), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "close cinema")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Cinema closed successfully", result)
+}
+
+// CheckCapacity handles GET /api/admin/halls/capacity-check, pengecekan yang sama dengan job malam
+func (h *CinemaHandler) CheckCapacity(w http.ResponseWriter, r *http.Request) {
+	result, err := h.service.CheckCapacity(r.Context())
+	if err != nil {
+		h.log.Error("Failed to check hall capacity", zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", result)
+}
+
+// BlockHallSeats handles POST /api/admin/halls/{id}/seats/block (admin only)
+func (h *CinemaHandler) BlockHallSeats(w http.ResponseWriter, r *http.Request) {
+	h.setHallSeats(w, r, true)
+}
+
+// UnblockHallSeats handles POST /api/admin/halls/{id}/seats/unblock (admin only)
+func (h *CinemaHandler) UnblockHallSeats(w http.ResponseWriter, r *http.Request) {
+	h.setHallSeats(w, r, false)
+}
+
+func (h *CinemaHandler) setHallSeats(w http.ResponseWriter, r *http.Request, block bool) {
+	hallID := chi.URLParam(r, "id")
+	if hallID == "" {
+		utils.ResponseBadRequest(w, "Hall ID is required", nil)
+		return
+	}
+
+	var req request.SeatIDsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	var (
+		seats []response.SeatResponse
+		err   error
+	)
+	if block {
+		seats, err = h.service.BlockHallSeats(r.Context(), hallID, &req)
+	} else {
+		seats, err = h.service.UnblockHallSeats(r.Context(), hallID, &req)
+	}
+	if err != nil {
+		h.handleServiceError(w, r, err, "update hall seats")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", seats)
+}
+
+// listCinemas shared by public and admin list endpoints; hanya public yang memakai ETag
+func (h *CinemaHandler) listCinemas(w http.ResponseWriter, r *http.Request, includeDeleted, public bool) {
+	// Parse query parameters
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:           utils.ParseInt(query.Get("page"), 1),
+		PerPage:        utils.ParseInt(query.Get("per_page"), 10),
+		IncludeDeleted: includeDeleted,
+	}
+
+	fields, err := response.ParseFields[response.CinemaResponse](query.Get("fields"))
+	if err != nil {
+		utils.ResponseBadRequest(w, err.Error(), nil)
+		return
+	}
+
+	// Filter by city dan facilities (optional, comma separated)
+	filter := &request.CinemaListFilter{}
+	if city := query.Get("city"); city != "" {
+		filter.City = &city
+	}
+	if facilities := query.Get("facilities"); facilities != "" {
+		for _, facility := range strings.Split(facilities, ",") {
+			if facility = strings.TrimSpace(strings.ToLower(facility)); facility != "" {
+				filter.Facilities = append(filter.Facilities, facility)
+			}
+		}
+	}
+
+	// Call service
+	cinemas, err := h.service.GetCinemas(r.Context(), req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get cinemas")
+		return
+	}
+
+	if public {
+		responsePublicListing(w, r, cinemas, fields, cinemaListMaxAge)
+		return
+	}
+	utils.ResponsePaginated(w, "success", response.Project(cinemas.Data, fields), cinemas.Pagination)
+}
+
 // handleServiceError handles errors untuk cinema operations
-func (h *CinemaHandler) handleServiceError(w http.ResponseWriter, err error, operation string) {
+func (h *CinemaHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
 	errMsg := err.Error()
 
 	switch {
@@ -182,7 +341,7 @@ func (h *CinemaHandler) handleServiceError(w http.ResponseWriter, err error, ope
 		h.log.Warn(operation+" validation failed",
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseBadRequest(w, errMsg, nil)
+		utils.ResponseValidationError(w, r, err)
 
 	case strings.Contains(errMsg, "invalid"):
 		h.log.Warn("Invalid input for "+operation,
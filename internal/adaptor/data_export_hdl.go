@@ -0,0 +1,117 @@
+package adaptor
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type DataExportHandler struct {
+	service usecase.DataExportService
+	log     *zap.Logger
+}
+
+func NewDataExportHandler(service usecase.DataExportService, log *zap.Logger) *DataExportHandler {
+	return &DataExportHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "data_export")),
+	}
+}
+
+// RequestExport handles GET /api/users/me/export?format= (protected).
+// 202 selama export masih antri / diproses, 200 dengan download_url setelah selesai.
+func (h *DataExportHandler) RequestExport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	req := &request.DataExportRequest{Format: strings.ToLower(r.URL.Query().Get("format"))}
+
+	export, err := h.service.RequestExport(r.Context(), userID.String(), req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "request data export")
+		return
+	}
+
+	if export.DownloadURL == nil {
+		utils.ResponseJSON(w, http.StatusAccepted, true, "Export is being prepared", export, nil)
+		return
+	}
+	utils.ResponseSuccess(w, "success", export)
+}
+
+// Download handles GET /api/exports/{id}/download?expires=&signature= (public, signed link)
+func (h *DataExportHandler) Download(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	file, err := h.service.OpenDownload(r.Context(), chi.URLParam(r, "id"), query.Get("expires"), query.Get("signature"))
+	if err != nil {
+		h.handleServiceError(w, r, err, "download data export")
+		return
+	}
+	defer file.Content.Close()
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.Filename))
+	w.Header().Set("Cache-Control", "private, no-store")
+	http.ServeContent(w, r, file.Filename, file.ModTime, file.Content)
+}
+
+// GetDataExports handles GET /api/admin/data-exports?user_id=&status=&page=&per_page=
+func (h *DataExportHandler) GetDataExports(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 20),
+	}
+	filter := &request.DataExportFilter{
+		UserID: query.Get("user_id"),
+		Status: query.Get("status"),
+	}
+
+	exports, err := h.service.GetDataExports(r.Context(), req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get data exports")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", exports.Data, exports.Pagination)
+}
+
+// handleServiceError handles errors untuk data export operations
+func (h *DataExportHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found", zap.Error(err))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed", zap.Error(err))
+		utils.ResponseValidationError(w, r, err)
+
+	// Sebelum "invalid": signature link yang salah berbunyi "forbidden: invalid download signature"
+	case strings.Contains(errMsg, "forbidden"):
+		h.log.Warn(operation+" failed - forbidden", zap.Error(err))
+		utils.ResponseForbidden(w, errMsg)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation, zap.Error(err))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
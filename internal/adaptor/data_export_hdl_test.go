@@ -0,0 +1,45 @@
+package adaptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"cinema-booking/internal/usecase/mockusecase"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestDataExportHandler_Download(t *testing.T) {
+	exportID := uuid.NewString()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "tampered signature", err: errors.New("forbidden: invalid download signature"), wantStatus: http.StatusForbidden},
+		{name: "expired link", err: errors.New("forbidden: download link has expired"), wantStatus: http.StatusForbidden},
+		{name: "malformed expiry", err: errors.New("invalid download link: expires must be a unix timestamp"), wantStatus: http.StatusBadRequest},
+		{name: "not found", err: fmt.Errorf("data export %s not found", exportID), wantStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			svc := mockusecase.NewMockDataExportService(ctrl)
+			svc.EXPECT().OpenDownload(gomock.Any(), exportID, "1760000000", "abc").Return(nil, tc.err)
+
+			handler := NewDataExportHandler(svc, zap.NewNop())
+			target := "/api/exports/" + exportID + "/download?expires=1760000000&signature=abc"
+			rec := serve(t, context.Background(), http.MethodGet, "/api/exports/{id}/download", target, nil, handler.Download)
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
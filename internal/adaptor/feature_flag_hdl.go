@@ -0,0 +1,100 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type FeatureFlagHandler struct {
+	service usecase.FeatureFlagService
+	log     *zap.Logger
+}
+
+func NewFeatureFlagHandler(service usecase.FeatureFlagService, log *zap.Logger) *FeatureFlagHandler {
+	return &FeatureFlagHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "feature_flag")),
+	}
+}
+
+// ListFlags handles GET /api/admin/feature-flags
+func (h *FeatureFlagHandler) ListFlags(w http.ResponseWriter, r *http.Request) {
+	flags, err := h.service.ListFlags(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "list feature flags")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", flags)
+}
+
+// UpdateFlag handles PUT /api/admin/feature-flags/{key}
+// Body: {"enabled": true, "rollout_percent": 10, "environments": ["staging"]}
+func (h *FeatureFlagHandler) UpdateFlag(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.UpdateFeatureFlagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	flag, err := h.service.UpdateFlag(r.Context(), adminID.String(), chi.URLParam(r, "key"), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update feature flag")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Feature flag updated successfully", flag)
+}
+
+// DeleteFlag handles DELETE /api/admin/feature-flags/{key}, flag kembali ke default di kode
+func (h *FeatureFlagHandler) DeleteFlag(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteFlag(r.Context(), adminID.String(), chi.URLParam(r, "key")); err != nil {
+		h.handleServiceError(w, r, err, "delete feature flag")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Feature flag reset to default", nil)
+}
+
+// handleServiceError handles errors untuk feature flag operations
+func (h *FeatureFlagHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found", zap.Error(err))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed", zap.Error(err))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation, zap.Error(err))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation, zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -0,0 +1,71 @@
+package adaptor
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/feed"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// feedMaxAgeSeconds cache browser/CDN; crawler tidak perlu melihat perubahan movie dalam hitungan detik
+const feedMaxAgeSeconds = 300
+
+type FeedHandler struct {
+	service usecase.FeedService
+	log     *zap.Logger
+}
+
+func NewFeedHandler(service usecase.FeedService, log *zap.Logger) *FeedHandler {
+	return &FeedHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "feed")),
+	}
+}
+
+// GetSitemap handles GET /sitemap.xml
+func (h *FeedHandler) GetSitemap(w http.ResponseWriter, r *http.Request) {
+	body, err := h.service.Sitemap(r.Context())
+	if err != nil {
+		h.log.Error("Failed to get sitemap", zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+		return
+	}
+
+	h.write(w, feed.SitemapContentType, body)
+}
+
+// GetMoviesJSON handles GET /api/feeds/movies.json
+func (h *FeedHandler) GetMoviesJSON(w http.ResponseWriter, r *http.Request) {
+	h.getMovieFeed(w, r, feed.FormatJSON)
+}
+
+// GetMoviesRSS handles GET /api/feeds/movies.rss
+func (h *FeedHandler) GetMoviesRSS(w http.ResponseWriter, r *http.Request) {
+	h.getMovieFeed(w, r, feed.FormatRSS)
+}
+
+func (h *FeedHandler) getMovieFeed(w http.ResponseWriter, r *http.Request, format feed.Format) {
+	body, err := h.service.MovieFeed(r.Context(), format)
+	if err != nil {
+		h.log.Error("Failed to get movie feed", zap.Error(err), zap.String("format", string(format)))
+		utils.ResponseInternalError(w, "Internal server error")
+		return
+	}
+
+	h.write(w, format.ContentType(), body)
+}
+
+func (h *FeedHandler) write(w http.ResponseWriter, contentType string, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", feedMaxAgeSeconds))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		h.log.Warn("Failed to write feed", zap.Error(err))
+	}
+}
@@ -0,0 +1,164 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type GiftCardHandler struct {
+	service usecase.GiftCardService
+	log     *zap.Logger
+}
+
+func NewGiftCardHandler(service usecase.GiftCardService, log *zap.Logger) *GiftCardHandler {
+	return &GiftCardHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "gift_card")),
+	}
+}
+
+// GetGiftBalance handles GET /api/user/giftcards (protected)
+func (h *GiftCardHandler) GetGiftBalance(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	balance, err := h.service.GetGiftBalance(r.Context(), userID.String())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get gift balance")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", balance)
+}
+
+// RedeemGiftCard handles POST /api/user/giftcards/redeem (protected)
+func (h *GiftCardHandler) RedeemGiftCard(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.RedeemGiftCardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	balance, err := h.service.RedeemGiftCard(r.Context(), userID.String(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "redeem gift card")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Gift card redeemed", balance)
+}
+
+// GetGiftCards handles GET /api/admin/giftcards?page=&per_page=
+func (h *GiftCardHandler) GetGiftCards(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 20),
+	}
+
+	cards, err := h.service.GetGiftCards(r.Context(), req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get gift cards")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", cards.Data, cards.Pagination)
+}
+
+// GetGiftCardByID handles GET /api/admin/giftcards/{id}
+func (h *GiftCardHandler) GetGiftCardByID(w http.ResponseWriter, r *http.Request) {
+	giftCardID := chi.URLParam(r, "id")
+	if giftCardID == "" {
+		utils.ResponseBadRequest(w, "Gift card ID is required", nil)
+		return
+	}
+
+	card, err := h.service.GetGiftCardByID(r.Context(), giftCardID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get gift card")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", card)
+}
+
+// IssueGiftCards handles POST /api/admin/giftcards; response berisi kode lengkap, hanya sekali ini dibagikan
+func (h *GiftCardHandler) IssueGiftCards(w http.ResponseWriter, r *http.Request) {
+	var req request.IssueGiftCardsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	cards, err := h.service.IssueGiftCards(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "issue gift cards")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", cards)
+}
+
+// DeactivateGiftCard handles DELETE /api/admin/giftcards/{id}
+func (h *GiftCardHandler) DeactivateGiftCard(w http.ResponseWriter, r *http.Request) {
+	giftCardID := chi.URLParam(r, "id")
+	if giftCardID == "" {
+		utils.ResponseBadRequest(w, "Gift card ID is required", nil)
+		return
+	}
+
+	if err := h.service.DeactivateGiftCard(r.Context(), giftCardID); err != nil {
+		h.handleServiceError(w, r, err, "deactivate gift card")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Gift card deactivated", nil)
+}
+
+// handleServiceError handles errors untuk gift card operations
+func (h *GiftCardHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"), strings.Contains(errMsg, "cannot"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
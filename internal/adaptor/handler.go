@@ -1,7 +1,12 @@
 package adaptor
 
 import (
+	"net/http"
+	"time"
+
+	"cinema-booking/internal/dto/response"
 	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
 
 	"go.uber.org/zap"
 )
@@ -13,6 +18,29 @@ type Handler struct {
 	Cinema  *CinemaHandler
 	Booking *BookingHandler
 	Review  *ReviewHandler
+
+	Notification *NotificationHandler
+	Report       *ReportHandler
+	Waitlist     *WaitlistHandler
+	Watchlist    *WatchlistHandler
+	Schedule     *ScheduleHandler
+	Home         *HomeHandler
+
+	PaymentMethod  *PaymentMethodHandler
+	PricePromotion *PricePromotionHandler
+	Organization   *OrganizationHandler
+	Webhook        *WebhookHandler
+	Feed           *FeedHandler
+	Banner         *BannerHandler
+	GiftCard       *GiftCardHandler
+	DataExport     *DataExportHandler
+	Audit          *AuditHandler
+	Ledger         *LedgerHandler
+	Settings       *SettingsHandler
+	FeatureFlag    *FeatureFlagHandler
+	Job            *JobHandler
+	LogLevel       *LogLevelHandler
+	Health         *HealthHandler
 }
 
 func NewHandler(service *usecase.Service, log *zap.Logger) *Handler {
@@ -23,5 +51,49 @@ func NewHandler(service *usecase.Service, log *zap.Logger) *Handler {
 		Cinema:  NewCinemaHandler(service.Cinema, log),
 		Booking: NewBookingHandler(service.Booking, log),
 		Review:  NewReviewHandler(service.Review, log),
+
+		Notification: NewNotificationHandler(service.Notification, log),
+		Report:       NewReportHandler(service.Report, log),
+		Waitlist:     NewWaitlistHandler(service.Waitlist, log),
+		Watchlist:    NewWatchlistHandler(service.Watchlist, log),
+		Schedule:     NewScheduleHandler(service.Schedule, log),
+		Home:         NewHomeHandler(service.Home, log),
+
+		PaymentMethod:  NewPaymentMethodHandler(service.PaymentMethod, log),
+		PricePromotion: NewPricePromotionHandler(service.PricePromotion, log),
+		Organization:   NewOrganizationHandler(service.Organization, log),
+		Webhook:        NewWebhookHandler(service.Webhook, log),
+		Feed:           NewFeedHandler(service.Feed, log),
+		Banner:         NewBannerHandler(service.Banner, log),
+		GiftCard:       NewGiftCardHandler(service.GiftCard, log),
+		DataExport:     NewDataExportHandler(service.DataExport, log),
+		Audit:          NewAuditHandler(service.Audit, log),
+		Ledger:         NewLedgerHandler(service.Ledger, log),
+		Settings:       NewSettingsHandler(service.Settings, log),
+		FeatureFlag:    NewFeatureFlagHandler(service.FeatureFlag, log),
+		Job:            NewJobHandler(service.Job, log),
+		LogLevel:       NewLogLevelHandler(utils.LogLevel(), log),
+		Health:         NewHealthHandler(service.Health, log),
 	}
 }
+
+// Umur cache listing publik; schedule paling pendek karena jadwal yang sudah mulai hilang dari listing
+const (
+	movieListMaxAge    = time.Minute
+	trendingMaxAge     = 5 * time.Minute
+	cinemaListMaxAge   = 5 * time.Minute
+	scheduleListMaxAge = 30 * time.Second
+	bannerListMaxAge   = time.Minute
+)
+
+// responsePublicListing writes listing publik dengan weak ETag dari LastModified dan pagination,
+// atau 304 tanpa body kalau If-None-Match client masih cocok. fields hasil ?fields= (boleh nil).
+func responsePublicListing[T any](w http.ResponseWriter, r *http.Request, list *response.PaginatedResponse[T], fields response.Fields, maxAge time.Duration) {
+	p := list.Pagination
+	etag := utils.WeakETag(list.LastModified, p.CurrentPage, p.Limit, p.TotalRecords, len(list.Data))
+	if utils.CheckNotModified(w, r, etag, utils.CacheControlPublic(maxAge)) {
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", response.Project(list.Data, fields), list.Pagination)
+}
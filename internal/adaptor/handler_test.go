@@ -0,0 +1,55 @@
+package adaptor
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// serve menjalankan request lewat router chi supaya URL param terisi seperti di server asli
+func serve(t *testing.T, ctx context.Context, method, pattern, target string, body io.Reader, handler http.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+
+	router := chi.NewRouter()
+	router.MethodFunc(method, pattern, handler)
+
+	req := httptest.NewRequest(method, target, body).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// asUser context request yang sudah lewat middleware auth
+func asUser(userID uuid.UUID) context.Context {
+	return context.WithValue(context.Background(), utils.UserIDKey, userID.String())
+}
+
+func jsonBody(t *testing.T, v any) io.Reader {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal request body: %v", err)
+	}
+	return strings.NewReader(string(b))
+}
+
+func decodeResponse(t *testing.T, rec *httptest.ResponseRecorder) utils.Response {
+	t.Helper()
+
+	var resp utils.Response
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode response body: %v", err)
+	}
+	return resp
+}
@@ -0,0 +1,111 @@
+package adaptor
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+type HealthHandler struct {
+	service usecase.HealthService
+	log     *zap.Logger
+}
+
+func NewHealthHandler(service usecase.HealthService, log *zap.Logger) *HealthHandler {
+	return &HealthHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "health")),
+	}
+}
+
+// Health handles GET /health (liveness): proses hidup, tanpa cek dependency
+func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("OK"))
+}
+
+// Readiness handles GET /readyz: 503 kalau primary database tidak bisa di-ping.
+// Pool yang saturated hanya dilaporkan sebagai "degraded".
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	result, ready := h.service.Readiness(r.Context())
+	if !ready {
+		utils.ResponseJSON(w, http.StatusServiceUnavailable, false, "not ready", result, nil)
+		return
+	}
+
+	utils.ResponseSuccess(w, result.Status, result)
+}
+
+// Metrics handles GET /metrics dalam Prometheus text format
+func (h *HealthHandler) Metrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	stats := h.service.PoolStats()
+	metric := func(name, kind, help string, value func(i int) float64) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+		for i, s := range stats {
+			fmt.Fprintf(w, "%s{pool=%q} %g\n", name, s.Pool, value(i))
+		}
+	}
+
+	metric("cinema_db_pool_healthy", "gauge", "Whether the pool is in use (replica is 0 while reads fall back to primary).",
+		func(i int) float64 { return boolMetric(stats[i].Healthy) })
+	metric("cinema_db_pool_max_conns", "gauge", "Maximum size of the pool.",
+		func(i int) float64 { return float64(stats[i].MaxConns) })
+	metric("cinema_db_pool_total_conns", "gauge", "Connections currently open, acquired or idle.",
+		func(i int) float64 { return float64(stats[i].TotalConns) })
+	metric("cinema_db_pool_acquired_conns", "gauge", "Connections currently acquired by queries.",
+		func(i int) float64 { return float64(stats[i].AcquiredConns) })
+	metric("cinema_db_pool_idle_conns", "gauge", "Connections currently idle.",
+		func(i int) float64 { return float64(stats[i].IdleConns) })
+	metric("cinema_db_pool_saturation_ratio", "gauge", "Acquired connections divided by max connections.",
+		func(i int) float64 { return stats[i].Saturation() })
+	metric("cinema_db_pool_acquires_total", "counter", "Successful connection acquires.",
+		func(i int) float64 { return float64(stats[i].AcquireCount) })
+	metric("cinema_db_pool_empty_acquires_total", "counter", "Acquires that had to wait because the pool was empty.",
+		func(i int) float64 { return float64(stats[i].EmptyAcquireCount) })
+	metric("cinema_db_pool_canceled_acquires_total", "counter", "Acquires canceled by context before a connection was available.",
+		func(i int) float64 { return float64(stats[i].CanceledAcquireCount) })
+	metric("cinema_db_pool_acquire_duration_seconds_total", "counter", "Total time spent acquiring connections.",
+		func(i int) float64 { return stats[i].AcquireDuration.Seconds() })
+	metric("cinema_db_pool_empty_acquire_wait_seconds_total", "counter", "Total time spent waiting for a connection while the pool was empty.",
+		func(i int) float64 { return stats[i].EmptyAcquireWaitTime.Seconds() })
+
+	cleanup := h.service.CleanupStats()
+	cleanupMetric := func(name, kind, help string, value func(i int) float64) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+		for i, s := range cleanup {
+			fmt.Fprintf(w, "%s{task=%q} %g\n", name, s.Task, value(i))
+		}
+	}
+
+	cleanupMetric("cinema_cleanup_runs_total", "counter", "Scheduled cleanup runs.",
+		func(i int) float64 { return float64(cleanup[i].Runs) })
+	cleanupMetric("cinema_cleanup_failures_total", "counter", "Scheduled cleanup runs that returned an error.",
+		func(i int) float64 { return float64(cleanup[i].Failures) })
+	cleanupMetric("cinema_cleanup_rows_purged_total", "counter", "Rows deleted by scheduled cleanup.",
+		func(i int) float64 { return float64(cleanup[i].RowsPurged) })
+	cleanupMetric("cinema_cleanup_last_duration_seconds", "gauge", "Duration of the last cleanup run.",
+		func(i int) float64 { return cleanup[i].LastDuration.Seconds() })
+	cleanupMetric("cinema_cleanup_last_success_timestamp_seconds", "gauge", "Unix time of the last successful cleanup run, 0 if none yet.",
+		func(i int) float64 { return unixMetric(cleanup[i].LastSuccessAt) })
+}
+
+func unixMetric(t time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+	return float64(t.Unix())
+}
+
+func boolMetric(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,34 @@
+package adaptor
+
+import (
+	"net/http"
+
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+type HomeHandler struct {
+	service usecase.HomeService
+	log     *zap.Logger
+}
+
+func NewHomeHandler(service usecase.HomeService, log *zap.Logger) *HomeHandler {
+	return &HomeHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "home")),
+	}
+}
+
+// GetHome handles GET /api/home (public, token opsional untuk upcoming booking)
+func (h *HomeHandler) GetHome(w http.ResponseWriter, r *http.Request) {
+	home, err := h.service.GetHome(r.Context(), viewerID(r))
+	if err != nil {
+		h.log.Error("Failed to get home", zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", home)
+}
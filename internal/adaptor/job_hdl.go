@@ -0,0 +1,112 @@
+package adaptor
+
+import (
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type JobHandler struct {
+	service usecase.JobService
+	log     *zap.Logger
+}
+
+func NewJobHandler(service usecase.JobService, log *zap.Logger) *JobHandler {
+	return &JobHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "job")),
+	}
+}
+
+// GetJobs handles GET /api/admin/jobs?type=&status=&page=&per_page=
+func (h *JobHandler) GetJobs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 20),
+	}
+	filter := &request.JobFilter{
+		Type:   query.Get("type"),
+		Status: query.Get("status"),
+	}
+
+	jobs, err := h.service.GetJobs(r.Context(), req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get jobs")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", jobs.Data, jobs.Pagination)
+}
+
+// GetJobStats handles GET /api/admin/jobs/stats
+func (h *JobHandler) GetJobStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.service.GetJobStats(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get job stats")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", stats)
+}
+
+// GetJob handles GET /api/admin/jobs/{id}
+func (h *JobHandler) GetJob(w http.ResponseWriter, r *http.Request) {
+	job, err := h.service.GetJob(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.handleServiceError(w, r, err, "get job")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", job)
+}
+
+// RetryJob handles POST /api/admin/jobs/{id}/retry, hanya untuk job di dead letter
+func (h *JobHandler) RetryJob(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	job, err := h.service.RetryJob(r.Context(), adminID.String(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.handleServiceError(w, r, err, "retry job")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Job requeued successfully", job)
+}
+
+// handleServiceError handles errors untuk job operations
+func (h *JobHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found", zap.Error(err))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed", zap.Error(err))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation, zap.Error(err))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	case strings.Contains(errMsg, "cannot"):
+		h.log.Warn(operation+" rejected", zap.Error(err))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation, zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -0,0 +1,46 @@
+package adaptor
+
+import (
+	"net/http"
+
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+type LedgerHandler struct {
+	service usecase.LedgerService
+	log     *zap.Logger
+}
+
+func NewLedgerHandler(service usecase.LedgerService, log *zap.Logger) *LedgerHandler {
+	return &LedgerHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "ledger")),
+	}
+}
+
+// GetBalances handles GET /api/admin/ledger/balances
+func (h *LedgerHandler) GetBalances(w http.ResponseWriter, r *http.Request) {
+	balances, err := h.service.GetBalances(r.Context())
+	if err != nil {
+		h.log.Error("Failed to get ledger balances", zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", balances)
+}
+
+// CheckInvariants handles GET /api/admin/ledger/check, pengecekan yang sama dengan job malam
+func (h *LedgerHandler) CheckInvariants(w http.ResponseWriter, r *http.Request) {
+	result, err := h.service.CheckInvariants(r.Context())
+	if err != nil {
+		h.log.Error("Failed to check ledger invariants", zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", result)
+}
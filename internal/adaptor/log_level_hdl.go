@@ -0,0 +1,64 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// LogLevelHandler tidak punya service: level disimpan di zap.AtomicLevel milik logger proses ini
+type LogLevelHandler struct {
+	level zap.AtomicLevel
+	log   *zap.Logger
+}
+
+func NewLogLevelHandler(level zap.AtomicLevel, log *zap.Logger) *LogLevelHandler {
+	return &LogLevelHandler{
+		level: level,
+		log:   log.With(zap.String("handler", "log_level")),
+	}
+}
+
+// GetLogLevel handles GET /api/admin/loglevel (admin only)
+func (h *LogLevelHandler) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+	utils.ResponseSuccess(w, "success", response.LogLevelResponse{Level: h.level.Level().String()})
+}
+
+// UpdateLogLevel handles PUT /api/admin/loglevel (admin only). Hanya berlaku untuk instance
+// yang menerima request; kalau jalan beberapa replica, panggil tiap instance.
+func (h *LogLevelHandler) UpdateLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req request.LogLevelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+	if errs := utils.ValidateStruct(&req); len(errs) > 0 {
+		utils.ResponseValidationError(w, r, errs)
+		return
+	}
+
+	level, err := zapcore.ParseLevel(req.Level)
+	if err != nil {
+		utils.ResponseBadRequest(w, "Invalid log level", nil)
+		return
+	}
+
+	previous := h.level.Level()
+	h.level.SetLevel(level)
+
+	// Warn supaya perubahan tetap tercatat walaupun level baru lebih tinggi dari info
+	userID, _ := utils.GetUserIDFromContext(r.Context())
+	h.log.Warn("Log level changed",
+		zap.String("from", previous.String()),
+		zap.String("to", level.String()),
+		zap.String("admin_id", userID.String()),
+	)
+
+	utils.ResponseSuccess(w, "Log level updated", response.LogLevelResponse{Level: level.String()})
+}
@@ -2,17 +2,25 @@ package adaptor
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
 	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/export"
 	"cinema-booking/pkg/utils"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 )
 
+// maxMovieImportUpload batas ukuran CSV katalog yang di-upload
+const maxMovieImportUpload = 5 << 20
+
 type MovieHandler struct {
 	service usecase.MovieService
 	log     *zap.Logger
@@ -26,56 +34,81 @@ func NewMovieHandler(service usecase.MovieService, log *zap.Logger) *MovieHandle
 }
 
 // GetMovies handles GET /api/movies (sesuai requirement)
+// ?sort=release_date|rating|title|popularity&order=asc|desc, popularity = tiket terjual
 func (h *MovieHandler) GetMovies(w http.ResponseWriter, r *http.Request) {
-	// Parse query parameters
-	req := &request.PaginatedRequest{
-		Page:    1,
-		PerPage: 10,
+	h.listMovies(w, r, false, true)
+}
+
+// GetMoviesAdmin handles GET /api/admin/movies (admin only, supports include_deleted)
+func (h *MovieHandler) GetMoviesAdmin(w http.ResponseWriter, r *http.Request) {
+	h.listMovies(w, r, utils.ParseBool(r.URL.Query().Get("include_deleted"), false), false)
+}
+
+// GetMovieByID handles GET /api/movies/{id} (optional)
+// ?include=schedules menambahkan showtimes per cinema, bisa difilter dengan ?date=YYYY-MM-DD&city=
+func (h *MovieHandler) GetMovieByID(w http.ResponseWriter, r *http.Request) {
+	movieID := chi.URLParam(r, "id")
+	if movieID == "" {
+		utils.ResponseBadRequest(w, "Movie ID is required", nil)
+		return
 	}
 
 	query := r.URL.Query()
-	req.Page = utils.ParseInt(query.Get("page"), 1)
-	req.PerPage = utils.ParseInt(query.Get("per_page"), 10)
-
-	// Parse optional filter parameter
-	var releaseStatus *string
-	if status := query.Get("release_status"); status != "" {
-		// Map "now" to "now_playing" for compatibility
-		if status == "now_playing" || status == "coming_soon" || status == "now" {
-			if status == "now" {
-				status = "now_playing"
+	opts := &request.MovieDetailRequest{Date: query.Get("date")}
+	if include := query.Get("include"); include != "" {
+		for _, part := range strings.Split(include, ",") {
+			if part = strings.TrimSpace(strings.ToLower(part)); part != "" {
+				opts.Include = append(opts.Include, part)
 			}
-			releaseStatus = &status
-		} else {
-			h.log.Warn("Invalid release_status filter", zap.String("status", status))
 		}
 	}
+	if city := query.Get("city"); city != "" {
+		opts.City = &city
+	}
 
-	// Call service
-	movies, err := h.service.GetMovies(r.Context(), req, releaseStatus)
+	movie, err := h.service.GetMovieByID(r.Context(), movieID, viewerID(r), opts)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get movie by ID")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", movie)
+}
+
+// GetTrendingMovies handles GET /api/movies/trending?limit=10 (public)
+// Movie dengan tiket terjual terbanyak 7 hari terakhir, untuk carousel "popular now"
+func (h *MovieHandler) GetTrendingMovies(w http.ResponseWriter, r *http.Request) {
+	limit := utils.ParseInt(r.URL.Query().Get("limit"), 10)
+	if limit < 1 || limit > 20 {
+		utils.ResponseBadRequest(w, "limit must be between 1 and 20", nil)
+		return
+	}
+
+	movies, err := h.service.GetTrendingMovies(r.Context(), limit)
 	if err != nil {
-		h.handleServiceError(w, err, "get movies")
+		h.handleServiceError(w, r, err, "get trending movies")
 		return
 	}
 
+	w.Header().Set("Cache-Control", utils.CacheControlPublic(trendingMaxAge))
 	utils.ResponseSuccess(w, "success", movies)
 }
 
-// GetMovieByID handles GET /api/movies/{id} (optional)
-func (h *MovieHandler) GetMovieByID(w http.ResponseWriter, r *http.Request) {
+// GetMovieSchedules handles GET /api/movies/{id}/schedules (public)
+func (h *MovieHandler) GetMovieSchedules(w http.ResponseWriter, r *http.Request) {
 	movieID := chi.URLParam(r, "id")
 	if movieID == "" {
 		utils.ResponseBadRequest(w, "Movie ID is required", nil)
 		return
 	}
 
-	movie, err := h.service.GetMovieByID(r.Context(), movieID)
+	schedules, err := h.service.GetMovieSchedules(r.Context(), movieID)
 	if err != nil {
-		h.handleServiceError(w, err, "get movie by ID")
+		h.handleServiceError(w, r, err, "get movie schedules")
 		return
 	}
 
-	utils.ResponseSuccess(w, "success", movie)
+	utils.ResponseSuccess(w, "success", schedules)
 }
 
 // CreateMovie handles POST /api/admin/movies (admin only - optional)
@@ -87,15 +120,9 @@ func (h *MovieHandler) CreateMovie(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	movie, err := h.service.CreateMovie(r.Context(), &req)
 	if err != nil {
-		h.handleServiceError(w, err, "create movie")
+		h.handleServiceError(w, r, err, "create movie")
 		return
 	}
 
@@ -116,19 +143,53 @@ func (h *MovieHandler) UpdateMovie(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// ✅ FIX: Tambah validation untuk update (optional fields)
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
+	movie, err := h.service.UpdateMovie(r.Context(), movieID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update movie")
 		return
 	}
 
-	movie, err := h.service.UpdateMovie(r.Context(), movieID, &req)
+	utils.ResponseSuccess(w, "Movie updated successfully", movie)
+}
+
+// SetReleaseStatus handles PUT /api/admin/movies/{id}/release-status (admin override)
+func (h *MovieHandler) SetReleaseStatus(w http.ResponseWriter, r *http.Request) {
+	movieID := chi.URLParam(r, "id")
+	if movieID == "" {
+		utils.ResponseBadRequest(w, "Movie ID is required", nil)
+		return
+	}
+
+	var req request.MovieReleaseStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	movie, err := h.service.SetReleaseStatus(r.Context(), movieID, &req)
 	if err != nil {
-		h.handleServiceError(w, err, "update movie")
+		h.handleServiceError(w, r, err, "set release status")
 		return
 	}
 
-	utils.ResponseSuccess(w, "Movie updated successfully", movie)
+	utils.ResponseSuccess(w, "Release status updated successfully", movie)
+}
+
+// MergeGenre handles POST /api/admin/genres/{id}/merge-into/{targetID} (admin only)
+func (h *MovieHandler) MergeGenre(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	result, err := h.service.MergeGenre(r.Context(), adminID.String(), chi.URLParam(r, "id"), chi.URLParam(r, "targetID"))
+	if err != nil {
+		h.handleServiceError(w, r, err, "merge genre")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Genre merged successfully", result)
 }
 
 // DeleteMovie handles DELETE /api/admin/movies/{id} (admin only - optional)
@@ -140,15 +201,147 @@ func (h *MovieHandler) DeleteMovie(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteMovie(r.Context(), movieID); err != nil {
-		h.handleServiceError(w, err, "delete movie")
+		h.handleServiceError(w, r, err, "delete movie")
 		return
 	}
 
 	utils.ResponseSuccess(w, "Movie deleted successfully", nil)
 }
 
+// RestoreMovie handles POST /api/admin/movies/{id}/restore (admin only)
+func (h *MovieHandler) RestoreMovie(w http.ResponseWriter, r *http.Request) {
+	movieID := chi.URLParam(r, "id")
+	if movieID == "" {
+		utils.ResponseBadRequest(w, "Movie ID is required", nil)
+		return
+	}
+
+	if err := h.service.RestoreMovie(r.Context(), movieID); err != nil {
+		h.handleServiceError(w, r, err, "restore movie")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Movie restored successfully", nil)
+}
+
+// ImportMovies handles POST /api/admin/movies/import (admin only, multipart)
+// dry_run=true hanya memvalidasi; response berisi hasil per baris
+func (h *MovieHandler) ImportMovies(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxMovieImportUpload)
+	if err := r.ParseMultipartForm(maxMovieImportUpload); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			utils.ResponseRequestTooLarge(w, tooLarge.Limit)
+			return
+		}
+		utils.ResponseBadRequest(w, "Invalid multipart form", nil)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		utils.ResponseBadRequest(w, "Movie CSV file is required (form field: file)", nil)
+		return
+	}
+	defer file.Close()
+
+	dryRun := utils.ParseBool(r.FormValue("dry_run"), false)
+	result, err := h.service.ImportMovies(r.Context(), file, dryRun)
+	if err != nil {
+		h.handleServiceError(w, r, err, "import movies")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", result)
+}
+
+// ExportMovies handles GET /api/admin/movies/export (admin only)
+func (h *MovieHandler) ExportMovies(w http.ResponseWriter, r *http.Request) {
+	format, err := export.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		utils.ResponseBadRequest(w, err.Error(), nil)
+		return
+	}
+
+	filename := fmt.Sprintf("movies-%s", time.Now().Format("2006-01-02"))
+	out := newExportResponseWriter(w, format, filename)
+	if err := h.service.ExportMovies(r.Context(), format, out); err != nil {
+		if out.started {
+			// Response sudah terkirim sebagian, tidak bisa diganti JSON error
+			h.log.Error("Export aborted mid-stream",
+				zap.Error(err),
+				zap.String("operation", "export movies"))
+			return
+		}
+		h.handleServiceError(w, r, err, "export movies")
+	}
+}
+
+// listMovies shared by public and admin list endpoints
+// public listing mendukung ETag/304; admin listing selalu dikirim penuh
+func (h *MovieHandler) listMovies(w http.ResponseWriter, r *http.Request, includeDeleted, public bool) {
+	// Parse query parameters
+	req := &request.PaginatedRequest{
+		Page:           1,
+		PerPage:        10,
+		IncludeDeleted: includeDeleted,
+	}
+
+	query := r.URL.Query()
+	req.Page = utils.ParseInt(query.Get("page"), 1)
+	req.PerPage = utils.ParseInt(query.Get("per_page"), 10)
+
+	// ?fields=id,title,poster_url untuk client yang hanya butuh sebagian field
+	fields, err := response.ParseFields[response.MovieResponse](query.Get("fields"))
+	if err != nil {
+		utils.ResponseBadRequest(w, err.Error(), nil)
+		return
+	}
+
+	// Parse optional filter parameter
+	var releaseStatus *string
+	if status := query.Get("release_status"); status != "" {
+		// Map "now" to "now_playing" for compatibility
+		if status == "now_playing" || status == "coming_soon" || status == "ended" || status == "now" {
+			if status == "now" {
+				status = "now_playing"
+			}
+			releaseStatus = &status
+		} else {
+			h.log.Warn("Invalid release_status filter", zap.String("status", status))
+		}
+	}
+
+	filter := &request.MovieListFilter{
+		Sort:  strings.ToLower(query.Get("sort")),
+		Order: strings.ToLower(query.Get("order")),
+	}
+
+	// Call service
+	viewer := viewerID(r)
+	movies, err := h.service.GetMovies(r.Context(), req, releaseStatus, filter, viewer)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get movies")
+		return
+	}
+
+	if public {
+		w.Header().Add("Vary", "Authorization")
+
+		// in_watchlist per user dan tidak ikut updated_at movie, jadi response login tidak di-cache
+		if viewer == "" {
+			responsePublicListing(w, r, movies, fields, movieListMaxAge)
+			return
+		}
+		w.Header().Set("Cache-Control", "private, no-cache")
+	}
+
+	utils.ResponsePaginated(w, "success", response.Project(movies.Data, fields), movies.Pagination)
+}
+
 // handleServiceError handles errors untuk movie operations
-func (h *MovieHandler) handleServiceError(w http.ResponseWriter, err error, operation string) {
+func (h *MovieHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
 	errMsg := err.Error()
 
 	switch {
@@ -162,7 +355,7 @@ func (h *MovieHandler) handleServiceError(w http.ResponseWriter, err error, oper
 		h.log.Warn(operation+" validation failed",
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseBadRequest(w, errMsg, nil)
+		utils.ResponseValidationError(w, r, err)
 
 	case strings.Contains(errMsg, "invalid"):
 		h.log.Warn("Invalid input for "+operation,
@@ -183,3 +376,12 @@ func (h *MovieHandler) handleServiceError(w http.ResponseWriter, err error, oper
 		utils.ResponseInternalError(w, "Internal server error")
 	}
 }
+
+// viewerID returns user ID dari OptionalAuth, kosong untuk anonymous
+func viewerID(r *http.Request) string {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		return ""
+	}
+	return userID.String()
+}
@@ -0,0 +1,77 @@
+package adaptor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/internal/usecase/mockusecase"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestMovieHandler_GetMovieByID(t *testing.T) {
+	movieID := uuid.NewString()
+	viewer := uuid.New()
+	city := "Bandung"
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		query      string
+		wantViewer string
+		wantOpts   *request.MovieDetailRequest
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "anonymous without options",
+			ctx:        context.Background(),
+			wantOpts:   &request.MovieDetailRequest{},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "include list is trimmed and lowercased",
+			ctx:        asUser(viewer),
+			query:      "?include=Schedules,%20reviews,,&date=2026-10-16&city=Bandung",
+			wantViewer: viewer.String(),
+			wantOpts: &request.MovieDetailRequest{
+				Include: []string{"schedules", "reviews"},
+				Date:    "2026-10-16",
+				City:    &city,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "not found",
+			ctx:        context.Background(),
+			wantOpts:   &request.MovieDetailRequest{},
+			err:        fmt.Errorf("movie %s not found", movieID),
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			svc := mockusecase.NewMockMovieService(ctrl)
+
+			var movie *response.MovieDetailResponse
+			if tc.err == nil {
+				movie = &response.MovieDetailResponse{}
+			}
+			svc.EXPECT().GetMovieByID(gomock.Any(), movieID, tc.wantViewer, tc.wantOpts).Return(movie, tc.err)
+
+			handler := NewMovieHandler(svc, zap.NewNop())
+			rec := serve(t, tc.ctx, http.MethodGet, "/api/movies/{id}", "/api/movies/"+movieID+tc.query, nil, handler.GetMovieByID)
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type NotificationHandler struct {
+	service usecase.NotificationService
+	log     *zap.Logger
+}
+
+func NewNotificationHandler(service usecase.NotificationService, log *zap.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "notification")),
+	}
+}
+
+// GetSettings handles GET /api/users/notification-settings (protected)
+func (h *NotificationHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	settings, err := h.service.GetSettings(r.Context(), userID.String())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get notification settings")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", settings)
+}
+
+// UpdateSettings handles PUT /api/users/notification-settings (protected)
+func (h *NotificationHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.UpdateNotificationSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(r.Context(), userID.String(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update notification settings")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", settings)
+}
+
+// RegisterDevice handles POST /api/users/devices (protected)
+func (h *NotificationHandler) RegisterDevice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.RegisterDeviceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	device, err := h.service.RegisterDevice(r.Context(), userID.String(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "register device")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", device)
+}
+
+// UnregisterDevice handles DELETE /api/users/devices/{token} (protected)
+func (h *NotificationHandler) UnregisterDevice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	token := chi.URLParam(r, "token")
+	if token == "" {
+		utils.ResponseBadRequest(w, "Device token is required", nil)
+		return
+	}
+
+	if err := h.service.UnregisterDevice(r.Context(), userID.String(), token); err != nil {
+		h.handleServiceError(w, r, err, "unregister device")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// handleServiceError handles errors untuk notification operations
+func (h *NotificationHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -0,0 +1,226 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type OrganizationHandler struct {
+	service usecase.OrganizationService
+	log     *zap.Logger
+}
+
+func NewOrganizationHandler(service usecase.OrganizationService, log *zap.Logger) *OrganizationHandler {
+	return &OrganizationHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "organization")),
+	}
+}
+
+// GetOrganizations handles GET /api/admin/organizations
+func (h *OrganizationHandler) GetOrganizations(w http.ResponseWriter, r *http.Request) {
+	organizations, err := h.service.GetOrganizations(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get organizations")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", organizations)
+}
+
+// GetOrganizationByID handles GET /api/admin/organizations/{id}
+func (h *OrganizationHandler) GetOrganizationByID(w http.ResponseWriter, r *http.Request) {
+	organizationID := chi.URLParam(r, "id")
+	if organizationID == "" {
+		utils.ResponseBadRequest(w, "Organization ID is required", nil)
+		return
+	}
+
+	organization, err := h.service.GetOrganizationByID(r.Context(), organizationID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get organization")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", organization)
+}
+
+// CreateOrganization handles POST /api/admin/organizations
+func (h *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	var req request.OrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	organization, err := h.service.CreateOrganization(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "create organization")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", organization)
+}
+
+// UpdateOrganization handles PUT /api/admin/organizations/{id}
+func (h *OrganizationHandler) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+	organizationID := chi.URLParam(r, "id")
+	if organizationID == "" {
+		utils.ResponseBadRequest(w, "Organization ID is required", nil)
+		return
+	}
+
+	var req request.OrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	organization, err := h.service.UpdateOrganization(r.Context(), organizationID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update organization")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", organization)
+}
+
+// DeleteOrganization handles DELETE /api/admin/organizations/{id}
+func (h *OrganizationHandler) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+	organizationID := chi.URLParam(r, "id")
+	if organizationID == "" {
+		utils.ResponseBadRequest(w, "Organization ID is required", nil)
+		return
+	}
+
+	if err := h.service.DeleteOrganization(r.Context(), organizationID); err != nil {
+		h.handleServiceError(w, r, err, "delete organization")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// AssignCinemas handles PUT /api/admin/organizations/{id}/cinemas
+func (h *OrganizationHandler) AssignCinemas(w http.ResponseWriter, r *http.Request) {
+	organizationID := chi.URLParam(r, "id")
+	if organizationID == "" {
+		utils.ResponseBadRequest(w, "Organization ID is required", nil)
+		return
+	}
+
+	var req request.OrganizationCinemasRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	organization, err := h.service.AssignCinemas(r.Context(), organizationID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "assign organization cinemas")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", organization)
+}
+
+// ReleaseCinema handles DELETE /api/admin/organizations/{id}/cinemas/{cinemaID}
+func (h *OrganizationHandler) ReleaseCinema(w http.ResponseWriter, r *http.Request) {
+	organizationID := chi.URLParam(r, "id")
+	cinemaID := chi.URLParam(r, "cinemaID")
+	if organizationID == "" || cinemaID == "" {
+		utils.ResponseBadRequest(w, "Organization ID and cinema ID are required", nil)
+		return
+	}
+
+	if err := h.service.ReleaseCinema(r.Context(), organizationID, cinemaID); err != nil {
+		h.handleServiceError(w, r, err, "release organization cinema")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// AddAdmin handles POST /api/admin/organizations/{id}/admins
+func (h *OrganizationHandler) AddAdmin(w http.ResponseWriter, r *http.Request) {
+	organizationID := chi.URLParam(r, "id")
+	if organizationID == "" {
+		utils.ResponseBadRequest(w, "Organization ID is required", nil)
+		return
+	}
+
+	var req request.OrganizationAdminRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	if err := h.service.AddAdmin(r.Context(), organizationID, &req); err != nil {
+		h.handleServiceError(w, r, err, "add organization admin")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// RemoveAdmin handles DELETE /api/admin/organizations/{id}/admins/{userID}
+func (h *OrganizationHandler) RemoveAdmin(w http.ResponseWriter, r *http.Request) {
+	organizationID := chi.URLParam(r, "id")
+	userID := chi.URLParam(r, "userID")
+	if organizationID == "" || userID == "" {
+		utils.ResponseBadRequest(w, "Organization ID and user ID are required", nil)
+		return
+	}
+
+	if err := h.service.RemoveAdmin(r.Context(), organizationID, userID); err != nil {
+		h.handleServiceError(w, r, err, "remove organization admin")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// handleServiceError handles errors untuk organization operations
+func (h *OrganizationHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	case strings.Contains(errMsg, "already exists"), strings.Contains(errMsg, "cannot"):
+		h.log.Warn(operation+" failed - conflict",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
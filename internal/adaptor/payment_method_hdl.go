@@ -0,0 +1,147 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type PaymentMethodHandler struct {
+	service usecase.PaymentMethodService
+	log     *zap.Logger
+}
+
+func NewPaymentMethodHandler(service usecase.PaymentMethodService, log *zap.Logger) *PaymentMethodHandler {
+	return &PaymentMethodHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "payment_method")),
+	}
+}
+
+// GetPaymentMethods handles GET /api/admin/payment-methods (termasuk yang nonaktif)
+func (h *PaymentMethodHandler) GetPaymentMethods(w http.ResponseWriter, r *http.Request) {
+	paymentMethods, err := h.service.GetPaymentMethods(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get payment methods")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", paymentMethods)
+}
+
+// GetPaymentMethodByID handles GET /api/admin/payment-methods/{id}
+func (h *PaymentMethodHandler) GetPaymentMethodByID(w http.ResponseWriter, r *http.Request) {
+	paymentMethodID := chi.URLParam(r, "id")
+	if paymentMethodID == "" {
+		utils.ResponseBadRequest(w, "Payment method ID is required", nil)
+		return
+	}
+
+	paymentMethod, err := h.service.GetPaymentMethodByID(r.Context(), paymentMethodID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get payment method")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", paymentMethod)
+}
+
+// CreatePaymentMethod handles POST /api/admin/payment-methods
+func (h *PaymentMethodHandler) CreatePaymentMethod(w http.ResponseWriter, r *http.Request) {
+	var req request.PaymentMethodRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	paymentMethod, err := h.service.CreatePaymentMethod(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "create payment method")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", paymentMethod)
+}
+
+// UpdatePaymentMethod handles PUT /api/admin/payment-methods/{id}
+func (h *PaymentMethodHandler) UpdatePaymentMethod(w http.ResponseWriter, r *http.Request) {
+	paymentMethodID := chi.URLParam(r, "id")
+	if paymentMethodID == "" {
+		utils.ResponseBadRequest(w, "Payment method ID is required", nil)
+		return
+	}
+
+	var req request.PaymentMethodUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	paymentMethod, err := h.service.UpdatePaymentMethod(r.Context(), paymentMethodID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update payment method")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", paymentMethod)
+}
+
+// DeletePaymentMethod handles DELETE /api/admin/payment-methods/{id}
+func (h *PaymentMethodHandler) DeletePaymentMethod(w http.ResponseWriter, r *http.Request) {
+	paymentMethodID := chi.URLParam(r, "id")
+	if paymentMethodID == "" {
+		utils.ResponseBadRequest(w, "Payment method ID is required", nil)
+		return
+	}
+
+	if err := h.service.DeletePaymentMethod(r.Context(), paymentMethodID); err != nil {
+		h.handleServiceError(w, r, err, "delete payment method")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// handleServiceError handles errors untuk payment method operations
+func (h *PaymentMethodHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	case strings.Contains(errMsg, "already exists"):
+		h.log.Warn(operation+" failed - already exists",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -0,0 +1,141 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type PricePromotionHandler struct {
+	service usecase.PricePromotionService
+	log     *zap.Logger
+}
+
+func NewPricePromotionHandler(service usecase.PricePromotionService, log *zap.Logger) *PricePromotionHandler {
+	return &PricePromotionHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "price_promotion")),
+	}
+}
+
+// GetPromotions handles GET /api/admin/promotions (termasuk yang nonaktif)
+func (h *PricePromotionHandler) GetPromotions(w http.ResponseWriter, r *http.Request) {
+	promotions, err := h.service.GetPromotions(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get price promotions")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", promotions)
+}
+
+// GetPromotionByID handles GET /api/admin/promotions/{id}
+func (h *PricePromotionHandler) GetPromotionByID(w http.ResponseWriter, r *http.Request) {
+	promotionID := chi.URLParam(r, "id")
+	if promotionID == "" {
+		utils.ResponseBadRequest(w, "Promotion ID is required", nil)
+		return
+	}
+
+	promotion, err := h.service.GetPromotionByID(r.Context(), promotionID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get price promotion")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", promotion)
+}
+
+// CreatePromotion handles POST /api/admin/promotions
+func (h *PricePromotionHandler) CreatePromotion(w http.ResponseWriter, r *http.Request) {
+	var req request.PricePromotionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	promotion, err := h.service.CreatePromotion(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "create price promotion")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", promotion)
+}
+
+// UpdatePromotion handles PUT /api/admin/promotions/{id}, body lengkap seperti create
+func (h *PricePromotionHandler) UpdatePromotion(w http.ResponseWriter, r *http.Request) {
+	promotionID := chi.URLParam(r, "id")
+	if promotionID == "" {
+		utils.ResponseBadRequest(w, "Promotion ID is required", nil)
+		return
+	}
+
+	var req request.PricePromotionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	promotion, err := h.service.UpdatePromotion(r.Context(), promotionID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update price promotion")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", promotion)
+}
+
+// DeletePromotion handles DELETE /api/admin/promotions/{id}
+func (h *PricePromotionHandler) DeletePromotion(w http.ResponseWriter, r *http.Request) {
+	promotionID := chi.URLParam(r, "id")
+	if promotionID == "" {
+		utils.ResponseBadRequest(w, "Promotion ID is required", nil)
+		return
+	}
+
+	if err := h.service.DeletePromotion(r.Context(), promotionID); err != nil {
+		h.handleServiceError(w, r, err, "delete price promotion")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// handleServiceError handles errors untuk price promotion operations
+func (h *PricePromotionHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
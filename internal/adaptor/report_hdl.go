@@ -0,0 +1,278 @@
+package adaptor
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/export"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// maxSettlementUpload batas ukuran settlement file yang di-upload
+const maxSettlementUpload = 10 << 20
+
+type ReportHandler struct {
+	service usecase.ReportService
+	log     *zap.Logger
+}
+
+func NewReportHandler(service usecase.ReportService, log *zap.Logger) *ReportHandler {
+	return &ReportHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "report")),
+	}
+}
+
+// GetSalesReport handles GET /api/admin/reports/sales (admin only)
+func (h *ReportHandler) GetSalesReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := request.SalesReportRequest{
+		StartDate: query.Get("start_date"),
+		EndDate:   query.Get("end_date"),
+		GroupBy:   query.Get("group_by"),
+	}
+	if req.GroupBy == "" {
+		req.GroupBy = "day"
+	}
+
+	report, err := h.service.GetSalesReport(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get sales report")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", report)
+}
+
+// GetTodaySummary handles GET /api/admin/reports/summary (admin only)
+func (h *ReportHandler) GetTodaySummary(w http.ResponseWriter, r *http.Request) {
+	summary, err := h.service.GetTodaySummary(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get sales summary")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", summary)
+}
+
+// GetOccupancyReport handles GET /api/admin/reports/occupancy (admin only)
+func (h *ReportHandler) GetOccupancyReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := request.OccupancyReportRequest{
+		CinemaID: query.Get("cinema_id"),
+		Date:     query.Get("date"),
+	}
+	if req.Date == "" {
+		req.Date = time.Now().Format("2006-01-02")
+	}
+
+	report, err := h.service.GetOccupancyReport(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get occupancy report")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", report)
+}
+
+// GetFunnelReport handles GET /api/admin/reports/funnel?from=&to= (admin only); default 30 hari terakhir
+func (h *ReportHandler) GetFunnelReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := request.FunnelReportRequest{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+	}
+	if req.To == "" {
+		req.To = time.Now().Format("2006-01-02")
+	}
+	if req.From == "" {
+		if to, err := time.Parse("2006-01-02", req.To); err == nil {
+			req.From = to.AddDate(0, 0, -29).Format("2006-01-02")
+		}
+	}
+
+	report, err := h.service.GetFunnelReport(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get funnel report")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", report)
+}
+
+// ExportSalesReport handles GET /api/admin/reports/sales/export (admin only)
+func (h *ReportHandler) ExportSalesReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format, err := export.ParseFormat(query.Get("format"))
+	if err != nil {
+		utils.ResponseBadRequest(w, err.Error(), nil)
+		return
+	}
+
+	req := request.SalesReportRequest{
+		StartDate: query.Get("start_date"),
+		EndDate:   query.Get("end_date"),
+		GroupBy:   query.Get("group_by"),
+	}
+	if req.GroupBy == "" {
+		req.GroupBy = "day"
+	}
+
+	filename := fmt.Sprintf("sales-%s-%s_%s", req.GroupBy, req.StartDate, req.EndDate)
+	out := newExportResponseWriter(w, format, filename)
+	if err := h.service.ExportSalesReport(r.Context(), &req, format, out); err != nil {
+		h.handleExportError(w, r, out, err, "export sales report")
+	}
+}
+
+// ExportBookings handles GET /api/admin/reports/bookings/export (admin only)
+func (h *ReportHandler) ExportBookings(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format, err := export.ParseFormat(query.Get("format"))
+	if err != nil {
+		utils.ResponseBadRequest(w, err.Error(), nil)
+		return
+	}
+
+	req := request.ExportBookingsRequest{
+		StartDate: query.Get("start_date"),
+		EndDate:   query.Get("end_date"),
+		Status:    query.Get("status"),
+	}
+
+	filename := fmt.Sprintf("bookings-%s_%s", req.StartDate, req.EndDate)
+	out := newExportResponseWriter(w, format, filename)
+	if err := h.service.ExportBookings(r.Context(), &req, format, out); err != nil {
+		h.handleExportError(w, r, out, err, "export bookings")
+	}
+}
+
+// GetPaymentReport handles GET /api/admin/reports/payments (admin only)
+func (h *ReportHandler) GetPaymentReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := request.PaymentReportRequest{
+		StartDate:       query.Get("start_date"),
+		EndDate:         query.Get("end_date"),
+		Status:          query.Get("status"),
+		PaymentMethodID: query.Get("payment_method_id"),
+		Page:            utils.ParseInt(query.Get("page"), 1),
+		PerPage:         utils.ParseInt(query.Get("per_page"), 20),
+	}
+
+	report, err := h.service.GetPaymentReport(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get payment report")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", report)
+}
+
+// ReconcilePayments handles POST /api/admin/reports/payments/reconcile (admin only, multipart)
+func (h *ReportHandler) ReconcilePayments(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxSettlementUpload)
+	if err := r.ParseMultipartForm(maxSettlementUpload); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			utils.ResponseRequestTooLarge(w, tooLarge.Limit)
+			return
+		}
+		utils.ResponseBadRequest(w, "Invalid multipart form", nil)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		utils.ResponseBadRequest(w, "Settlement file is required (form field: file)", nil)
+		return
+	}
+	defer file.Close()
+
+	req := request.ReconcilePaymentsRequest{
+		StartDate:       r.FormValue("start_date"),
+		EndDate:         r.FormValue("end_date"),
+		PaymentMethodID: r.FormValue("payment_method_id"),
+	}
+
+	result, err := h.service.ReconcilePayments(r.Context(), &req, file)
+	if err != nil {
+		h.handleServiceError(w, r, err, "reconcile payments")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", result)
+}
+
+// handleExportError sends a JSON error if streaming belum mulai, otherwise the response is already partial so only log
+func (h *ReportHandler) handleExportError(w http.ResponseWriter, r *http.Request, out *exportResponseWriter, err error, operation string) {
+	if !out.started {
+		h.handleServiceError(w, r, err, operation)
+		return
+	}
+
+	h.log.Error("Export aborted mid-stream",
+		zap.Error(err),
+		zap.String("operation", operation))
+}
+
+// exportResponseWriter sets download headers on the first write, so errors before any output can still be JSON
+type exportResponseWriter struct {
+	w        http.ResponseWriter
+	format   export.Format
+	filename string
+	started  bool
+}
+
+func newExportResponseWriter(w http.ResponseWriter, format export.Format, filename string) *exportResponseWriter {
+	return &exportResponseWriter{w: w, format: format, filename: filename}
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		e.w.Header().Set("Content-Type", e.format.ContentType())
+		e.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, e.format.Filename(e.filename)))
+		e.w.Header().Set("Cache-Control", "no-store")
+		e.w.WriteHeader(http.StatusOK)
+	}
+	return e.w.Write(p)
+}
+
+// handleServiceError handles errors untuk report operations
+func (h *ReportHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -2,11 +2,13 @@ package adaptor
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
 	"cinema-booking/internal/dto/request"
 	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/i18n"
 	"cinema-booking/pkg/utils"
 
 	"github.com/go-chi/chi/v5"
@@ -40,22 +42,16 @@ func (h *ReviewHandler) CreateReview(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	review, err := h.service.CreateReview(r.Context(), userID.String(), &req)
 	if err != nil {
-		h.handleServiceError(w, err, "create review")
+		h.handleServiceError(w, r, err, "create review")
 		return
 	}
 
 	utils.ResponseCreated(w, "success", review)
 }
 
-// GetMovieReviews handles GET /api/movies/{id}/reviews (public)
+// GetMovieReviews handles GET /api/movies/{id}/reviews?sort=newest|highest_rating (public)
 func (h *ReviewHandler) GetMovieReviews(w http.ResponseWriter, r *http.Request) {
 	movieID := chi.URLParam(r, "id")
 	if movieID == "" {
@@ -72,17 +68,18 @@ func (h *ReviewHandler) GetMovieReviews(w http.ResponseWriter, r *http.Request)
 	query := r.URL.Query()
 	req.Page = utils.ParseInt(query.Get("page"), 1)
 	req.PerPage = utils.ParseInt(query.Get("per_page"), 10)
+	filter := &request.ReviewListFilter{Sort: query.Get("sort")}
 
-	reviews, err := h.service.GetMovieReviews(r.Context(), movieID, req)
+	reviews, err := h.service.GetMovieReviews(r.Context(), movieID, req, filter)
 	if err != nil {
-		h.handleServiceError(w, err, "get movie reviews")
+		h.handleServiceError(w, r, err, "get movie reviews")
 		return
 	}
 
-	utils.ResponseSuccess(w, "success", reviews)
+	utils.ResponsePaginated(w, "success", reviews.Data, reviews.Pagination)
 }
 
-// GetUserReviews handles GET /api/user/reviews (protected)
+// GetUserReviews handles GET /api/user/reviews?sort=newest|highest_rating (protected)
 func (h *ReviewHandler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context
 	userID, ok := utils.GetUserIDFromContext(r.Context())
@@ -100,14 +97,15 @@ func (h *ReviewHandler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 	req.Page = utils.ParseInt(query.Get("page"), 1)
 	req.PerPage = utils.ParseInt(query.Get("per_page"), 10)
+	filter := &request.ReviewListFilter{Sort: query.Get("sort")}
 
-	reviews, err := h.service.GetUserReviews(r.Context(), userID.String(), req)
+	reviews, err := h.service.GetUserReviews(r.Context(), userID.String(), req, filter)
 	if err != nil {
-		h.handleServiceError(w, err, "get user reviews")
+		h.handleServiceError(w, r, err, "get user reviews")
 		return
 	}
 
-	utils.ResponseSuccess(w, "success", reviews)
+	utils.ResponsePaginated(w, "success", reviews.Data, reviews.Pagination)
 }
 
 // UpdateReview handles PUT /api/reviews/{id} (protected)
@@ -131,15 +129,9 @@ func (h *ReviewHandler) UpdateReview(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate request
-	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
-		utils.ResponseBadRequest(w, "Validation failed", validationErrors)
-		return
-	}
-
 	review, err := h.service.UpdateReview(r.Context(), reviewID, userID.String(), &req)
 	if err != nil {
-		h.handleServiceError(w, err, "update review")
+		h.handleServiceError(w, r, err, "update review")
 		return
 	}
 
@@ -162,7 +154,7 @@ func (h *ReviewHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteReview(r.Context(), reviewID, userID.String()); err != nil {
-		h.handleServiceError(w, err, "delete review")
+		h.handleServiceError(w, r, err, "delete review")
 		return
 	}
 
@@ -179,17 +171,196 @@ func (h *ReviewHandler) GetMovieReviewStats(w http.ResponseWriter, r *http.Reque
 
 	stats, err := h.service.GetMovieReviewStats(r.Context(), movieID)
 	if err != nil {
-		h.handleServiceError(w, err, "get movie review stats")
+		h.handleServiceError(w, r, err, "get movie review stats")
 		return
 	}
 
 	utils.ResponseSuccess(w, "success", stats)
 }
 
+// RestoreReview handles POST /api/admin/reviews/{id}/restore (admin only)
+func (h *ReviewHandler) RestoreReview(w http.ResponseWriter, r *http.Request) {
+	reviewID := chi.URLParam(r, "id")
+	if reviewID == "" {
+		utils.ResponseBadRequest(w, "Review ID is required", nil)
+		return
+	}
+
+	if err := h.service.RestoreReview(r.Context(), reviewID); err != nil {
+		h.handleServiceError(w, r, err, "restore review")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Review restored successfully", nil)
+}
+
+// GetReviewRevisions handles GET /api/admin/reviews/{id}/revisions
+func (h *ReviewHandler) GetReviewRevisions(w http.ResponseWriter, r *http.Request) {
+	reviewID := chi.URLParam(r, "id")
+	if reviewID == "" {
+		utils.ResponseBadRequest(w, "Review ID is required", nil)
+		return
+	}
+
+	revisions, err := h.service.GetReviewRevisions(r.Context(), reviewID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get review revisions")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", revisions)
+}
+
+// ReplyToReview handles POST /api/reviews/{id}/reply (staff/admin)
+func (h *ReviewHandler) ReplyToReview(w http.ResponseWriter, r *http.Request) {
+	h.saveReply(w, r, true)
+}
+
+// UpdateReply handles PUT /api/reviews/{id}/reply (staff/admin)
+func (h *ReviewHandler) UpdateReply(w http.ResponseWriter, r *http.Request) {
+	h.saveReply(w, r, false)
+}
+
+func (h *ReviewHandler) saveReply(w http.ResponseWriter, r *http.Request, create bool) {
+	staffID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	reviewID := chi.URLParam(r, "id")
+	if reviewID == "" {
+		utils.ResponseBadRequest(w, "Review ID is required", nil)
+		return
+	}
+
+	var req request.ReviewReplyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	if create {
+		review, err := h.service.ReplyToReview(r.Context(), reviewID, staffID.String(), &req)
+		if err != nil {
+			h.handleServiceError(w, r, err, "reply to review")
+			return
+		}
+		utils.ResponseCreated(w, "Reply posted", review)
+		return
+	}
+
+	review, err := h.service.UpdateReply(r.Context(), reviewID, staffID.String(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update review reply")
+		return
+	}
+	utils.ResponseSuccess(w, "Reply updated", review)
+}
+
+// DeleteReply handles DELETE /api/reviews/{id}/reply (staff/admin)
+func (h *ReviewHandler) DeleteReply(w http.ResponseWriter, r *http.Request) {
+	reviewID := chi.URLParam(r, "id")
+	if reviewID == "" {
+		utils.ResponseBadRequest(w, "Review ID is required", nil)
+		return
+	}
+
+	if err := h.service.DeleteReply(r.Context(), reviewID); err != nil {
+		h.handleServiceError(w, r, err, "delete review reply")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Reply deleted", nil)
+}
+
+// ReportReview handles POST /api/reviews/{id}/report (protected)
+func (h *ReviewHandler) ReportReview(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	reviewID := chi.URLParam(r, "id")
+	if reviewID == "" {
+		utils.ResponseBadRequest(w, "Review ID is required", nil)
+		return
+	}
+
+	var req request.ReportReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	if err := h.service.ReportReview(r.Context(), reviewID, userID.String(), &req); err != nil {
+		h.handleServiceError(w, r, err, "report review")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Review reported", nil)
+}
+
+// GetReportedReviews handles GET /api/admin/reviews/reported (admin only)
+func (h *ReviewHandler) GetReportedReviews(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 10),
+	}
+
+	reviews, err := h.service.GetReportedReviews(r.Context(), req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get reported reviews")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", reviews.Data, reviews.Pagination)
+}
+
+// ModerateReview handles POST /api/admin/reviews/{id}/moderate (admin only)
+func (h *ReviewHandler) ModerateReview(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	reviewID := chi.URLParam(r, "id")
+	if reviewID == "" {
+		utils.ResponseBadRequest(w, "Review ID is required", nil)
+		return
+	}
+
+	var req request.ModerateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	if err := h.service.ModerateReview(r.Context(), reviewID, adminID.String(), &req); err != nil {
+		h.handleServiceError(w, r, err, "moderate review")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Review moderated", nil)
+}
+
 // handleServiceError handles errors untuk review operations
-func (h *ReviewHandler) handleServiceError(w http.ResponseWriter, err error, operation string) {
+func (h *ReviewHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
 	errMsg := err.Error()
 
+	// Komentar yang ditolak content filter membawa jenis pelanggarannya supaya client bisa menandai field
+	var contentErr *usecase.ReviewContentError
+	if errors.As(err, &contentErr) {
+		h.log.Warn(operation+" failed - comment rejected",
+			zap.String("violation", string(contentErr.Violation)),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, i18n.Message(r.Context(), err), map[string]string{"violation": string(contentErr.Violation)})
+		return
+	}
+
 	switch {
 	case strings.Contains(errMsg, "not found"):
 		h.log.Warn(operation+" failed - not found",
@@ -201,7 +372,7 @@ func (h *ReviewHandler) handleServiceError(w http.ResponseWriter, err error, ope
 		h.log.Warn(operation+" validation failed",
 			zap.Error(err),
 			zap.String("operation", operation))
-		utils.ResponseBadRequest(w, errMsg, nil)
+		utils.ResponseValidationError(w, r, err)
 
 	case strings.Contains(errMsg, "invalid"):
 		h.log.Warn("Invalid input for "+operation,
@@ -215,6 +386,25 @@ func (h *ReviewHandler) handleServiceError(w http.ResponseWriter, err error, ope
 			zap.String("operation", operation))
 		utils.ResponseBadRequest(w, errMsg, nil)
 
+	case strings.Contains(errMsg, "already reported"),
+		strings.Contains(errMsg, "cannot report your own review"):
+		h.log.Warn(operation+" failed - report rejected",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	case strings.Contains(errMsg, "can only be edited within"):
+		h.log.Warn(operation+" failed - edit window closed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseForbidden(w, i18n.Message(r.Context(), err))
+
+	case strings.Contains(errMsg, "already has a reply"):
+		h.log.Warn(operation+" failed - already replied",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
 	case strings.Contains(errMsg, "unauthorized"):
 		h.log.Warn(operation+" failed - unauthorized",
 			zap.Error(err),
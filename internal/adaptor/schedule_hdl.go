@@ -0,0 +1,296 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type ScheduleHandler struct {
+	service usecase.ScheduleService
+	log     *zap.Logger
+}
+
+func NewScheduleHandler(service usecase.ScheduleService, log *zap.Logger) *ScheduleHandler {
+	return &ScheduleHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "schedule")),
+	}
+}
+
+// GetSchedules handles GET /api/schedules?movie_id=&cinema_id=&date=&format= (public)
+func (h *ScheduleHandler) GetSchedules(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 10),
+	}
+
+	filter := &request.ScheduleListFilter{
+		MovieID:  query.Get("movie_id"),
+		CinemaID: query.Get("cinema_id"),
+		Date:     query.Get("date"),
+		Format:   strings.ToUpper(query.Get("format")),
+	}
+
+	schedules, err := h.service.GetSchedules(r.Context(), req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get schedules")
+		return
+	}
+
+	responsePublicListing(w, r, schedules, nil, scheduleListMaxAge)
+}
+
+// GetAdminSchedules handles GET /api/admin/schedules?status=&movie_id=&cinema_id=&date=&format= (admin only)
+func (h *ScheduleHandler) GetAdminSchedules(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 10),
+	}
+
+	filter := &request.AdminScheduleListFilter{
+		ScheduleListFilter: request.ScheduleListFilter{
+			MovieID:  query.Get("movie_id"),
+			CinemaID: query.Get("cinema_id"),
+			Date:     query.Get("date"),
+			Format:   strings.ToUpper(query.Get("format")),
+		},
+		Status: strings.ToLower(query.Get("status")),
+	}
+
+	schedules, err := h.service.GetAdminSchedules(r.Context(), req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get admin schedules")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", schedules.Data, schedules.Pagination)
+}
+
+// CreateSchedule handles POST /api/admin/schedules (admin only, selalu draft)
+func (h *ScheduleHandler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	var req request.ScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	schedule, err := h.service.CreateSchedule(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "create schedule")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", schedule)
+}
+
+// UpdateSchedule handles PUT /api/admin/schedules/{id} (admin only, draft saja)
+func (h *ScheduleHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID := chi.URLParam(r, "id")
+	if scheduleID == "" {
+		utils.ResponseBadRequest(w, "Schedule ID is required", nil)
+		return
+	}
+
+	var req request.ScheduleUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	schedule, err := h.service.UpdateSchedule(r.Context(), scheduleID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update schedule")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", schedule)
+}
+
+// SetPriceOverride handles PUT /api/admin/schedules/{id}/price-override (admin only, draft maupun published)
+func (h *ScheduleHandler) SetPriceOverride(w http.ResponseWriter, r *http.Request) {
+	scheduleID := chi.URLParam(r, "id")
+	if scheduleID == "" {
+		utils.ResponseBadRequest(w, "Schedule ID is required", nil)
+		return
+	}
+
+	var req request.SchedulePriceOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	schedule, err := h.service.SetPriceOverride(r.Context(), scheduleID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "set schedule price override")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", schedule)
+}
+
+// DeleteSchedule handles DELETE /api/admin/schedules/{id} (admin only, draft saja)
+func (h *ScheduleHandler) DeleteSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID := chi.URLParam(r, "id")
+	if scheduleID == "" {
+		utils.ResponseBadRequest(w, "Schedule ID is required", nil)
+		return
+	}
+
+	if err := h.service.DeleteSchedule(r.Context(), scheduleID); err != nil {
+		h.handleServiceError(w, r, err, "delete schedule")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// PublishSchedules handles POST /api/admin/schedules/publish (admin only)
+func (h *ScheduleHandler) PublishSchedules(w http.ResponseWriter, r *http.Request) {
+	var req request.PublishSchedulesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	result, err := h.service.PublishSchedules(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "publish schedules")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", result)
+}
+
+// CloneWeek handles POST /api/admin/schedules/clone-week (admin only)
+func (h *ScheduleHandler) CloneWeek(w http.ResponseWriter, r *http.Request) {
+	var req request.CloneScheduleWeekRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	result, err := h.service.CloneWeek(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "clone schedule week")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", result)
+}
+
+// GetSeatBlocks handles GET /api/admin/schedules/{id}/seats/blocked (admin only)
+func (h *ScheduleHandler) GetSeatBlocks(w http.ResponseWriter, r *http.Request) {
+	scheduleID := chi.URLParam(r, "id")
+	if scheduleID == "" {
+		utils.ResponseBadRequest(w, "Schedule ID is required", nil)
+		return
+	}
+
+	blocks, err := h.service.GetSeatBlocks(r.Context(), scheduleID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get seat blocks")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", blocks)
+}
+
+// BlockSeats handles POST /api/admin/schedules/{id}/seats/block (admin only)
+func (h *ScheduleHandler) BlockSeats(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	scheduleID := chi.URLParam(r, "id")
+	if scheduleID == "" {
+		utils.ResponseBadRequest(w, "Schedule ID is required", nil)
+		return
+	}
+
+	var req request.SeatBlockRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	blocks, err := h.service.BlockSeats(r.Context(), adminID.String(), scheduleID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "block seats")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", blocks)
+}
+
+// UnblockSeats handles POST /api/admin/schedules/{id}/seats/unblock (admin only)
+func (h *ScheduleHandler) UnblockSeats(w http.ResponseWriter, r *http.Request) {
+	scheduleID := chi.URLParam(r, "id")
+	if scheduleID == "" {
+		utils.ResponseBadRequest(w, "Schedule ID is required", nil)
+		return
+	}
+
+	var req request.SeatIDsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	blocks, err := h.service.UnblockSeats(r.Context(), scheduleID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "unblock seats")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", blocks)
+}
+
+// handleServiceError handles errors untuk schedule operations
+func (h *ScheduleHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	case strings.Contains(errMsg, "cannot"):
+		h.log.Warn(operation+" failed - invalid state",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -0,0 +1,71 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+type SettingsHandler struct {
+	service usecase.SettingsService
+	log     *zap.Logger
+}
+
+func NewSettingsHandler(service usecase.SettingsService, log *zap.Logger) *SettingsHandler {
+	return &SettingsHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "settings")),
+	}
+}
+
+// GetSettings handles GET /api/admin/settings
+func (h *SettingsHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	settings, err := h.service.GetSettings(r.Context())
+	if err != nil {
+		h.log.Error("Failed to get settings", zap.Error(err))
+		utils.ResponseInternalError(w, "Internal server error")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", settings)
+}
+
+// UpdateSettings handles PUT /api/admin/settings, body {"settings": {"max_seats_per_booking": 8}}
+func (h *SettingsHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.UpdateSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(r.Context(), adminID.String(), &req)
+	if err != nil {
+		errMsg := err.Error()
+		switch {
+		case strings.Contains(errMsg, "validation failed"):
+			h.log.Warn("Update settings validation failed", zap.Error(err))
+			utils.ResponseValidationError(w, r, err)
+		case strings.Contains(errMsg, "invalid"):
+			h.log.Warn("Invalid input for update settings", zap.Error(err))
+			utils.ResponseBadRequest(w, errMsg, nil)
+		default:
+			h.log.Error("Failed to update settings", zap.Error(err))
+			utils.ResponseInternalError(w, "Internal server error")
+		}
+		return
+	}
+
+	utils.ResponseSuccess(w, "Settings updated successfully", settings)
+}
@@ -1,6 +1,7 @@
 package adaptor
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 
@@ -35,14 +36,102 @@ func (h *UserHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 
 	profile, err := h.service.GetProfile(r.Context(), userID.String())
 	if err != nil {
-		h.handleServiceError(w, err, "get profile")
+		h.handleServiceError(w, r, err, "get profile")
 		return
 	}
 
 	utils.ResponseSuccess(w, "success", profile)
 }
 
-// GetAllUsers handles GET /api/admin/users (admin only)
+// UpdateLanguage handles PUT /api/user/profile/language
+func (h *UserHandler) UpdateLanguage(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.UpdateLanguageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	profile, err := h.service.UpdateLanguage(r.Context(), userID.String(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update language")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Language updated successfully", profile)
+}
+
+// UpdateDateOfBirth handles PUT /api/user/profile/date-of-birth
+func (h *UserHandler) UpdateDateOfBirth(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	var req request.UpdateDateOfBirthRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	profile, err := h.service.UpdateDateOfBirth(r.Context(), userID.String(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update date of birth")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Date of birth updated successfully", profile)
+}
+
+// GetActivity handles GET /api/users/activity?type=&page=&per_page=
+func (h *UserHandler) GetActivity(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	h.respondActivity(w, r, userID.String())
+}
+
+// GetUserActivity handles GET /api/admin/users/{id}/activity (admin only), untuk investigasi support
+func (h *UserHandler) GetUserActivity(w http.ResponseWriter, r *http.Request) {
+	userID := chi.URLParam(r, "id")
+	if userID == "" {
+		utils.ResponseBadRequest(w, "User ID is required", nil)
+		return
+	}
+
+	h.respondActivity(w, r, userID)
+}
+
+func (h *UserHandler) respondActivity(w http.ResponseWriter, r *http.Request, userID string) {
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 20),
+	}
+	if req.PerPage > 100 {
+		req.PerPage = 100
+	}
+	filter := &request.ActivityFilter{Type: query.Get("type")}
+
+	activity, err := h.service.GetActivity(r.Context(), userID, req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get user activity")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", activity.Data, activity.Pagination)
+}
+
+// GetAllUsers handles GET /api/admin/users (admin only), dengan search untuk support
 func (h *UserHandler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	req := &request.PaginatedRequest{
 		Page:    1,
@@ -53,19 +142,49 @@ func (h *UserHandler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 	req.Page = utils.ParseInt(query.Get("page"), 1)
 	req.PerPage = utils.ParseInt(query.Get("per_page"), 10)
+	req.IncludeDeleted = utils.ParseBool(query.Get("include_deleted"), false)
+	req.Cursor = request.CursorFromQuery(query)
 
 	// Validate per_page max
 	if req.PerPage > 100 {
 		req.PerPage = 100
 	}
 
-	users, err := h.service.GetAllUsers(r.Context(), req)
+	filter := &request.UserSearchFilter{
+		Email:       query.Get("email"),
+		Username:    query.Get("username"),
+		Phone:       query.Get("phone"),
+		Role:        query.Get("role"),
+		Verified:    query.Get("verified"),
+		Active:      query.Get("active"),
+		CreatedFrom: query.Get("created_from"),
+		CreatedTo:   query.Get("created_to"),
+	}
+
+	users, err := h.service.GetAllUsers(r.Context(), req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get all users")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", users.Data, users.Pagination)
+}
+
+// GetUser handles GET /api/admin/users/{id} (admin only): profil plus statistik booking
+func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	userID := chi.URLParam(r, "id")
+	if userID == "" {
+		utils.ResponseBadRequest(w, "User ID is required", nil)
+		return
+	}
+
+	user, err := h.service.GetUserDetail(r.Context(), userID)
 	if err != nil {
-		h.handleServiceError(w, err, "get all users")
+		h.handleServiceError(w, r, err, "get user detail")
 		return
 	}
 
-	utils.ResponseSuccess(w, "success", users)
+	utils.ResponseSuccess(w, "success", user)
 }
 
 // DeleteUser handles DELETE /api/admin/users/{id} (admin only)
@@ -77,15 +196,31 @@ func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteUser(r.Context(), userID); err != nil {
-		h.handleServiceError(w, err, "delete user")
+		h.handleServiceError(w, r, err, "delete user")
 		return
 	}
 
 	utils.ResponseSuccess(w, "success", nil)
 }
 
+// RestoreUser handles POST /api/admin/users/{id}/restore (admin only)
+func (h *UserHandler) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	userID := chi.URLParam(r, "id")
+	if userID == "" {
+		utils.ResponseBadRequest(w, "User ID is required", nil)
+		return
+	}
+
+	if err := h.service.RestoreUser(r.Context(), userID); err != nil {
+		h.handleServiceError(w, r, err, "restore user")
+		return
+	}
+
+	utils.ResponseSuccess(w, "User restored successfully", nil)
+}
+
 // handleServiceError handles errors for user operations
-func (h *UserHandler) handleServiceError(w http.ResponseWriter, err error, operation string) {
+func (h *UserHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
 	errMsg := err.Error()
 
 	switch {
@@ -95,7 +230,7 @@ func (h *UserHandler) handleServiceError(w http.ResponseWriter, err error, opera
 
 	case strings.Contains(errMsg, "validation failed"):
 		h.log.Warn(operation+" validation failed", zap.Error(err))
-		utils.ResponseBadRequest(w, errMsg, err)
+		utils.ResponseValidationError(w, r, err)
 
 	case strings.Contains(errMsg, "invalid"):
 		h.log.Warn("Invalid input for "+operation, zap.Error(err))
@@ -0,0 +1,142 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type WaitlistHandler struct {
+	service usecase.WaitlistService
+	log     *zap.Logger
+}
+
+func NewWaitlistHandler(service usecase.WaitlistService, log *zap.Logger) *WaitlistHandler {
+	return &WaitlistHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "waitlist")),
+	}
+}
+
+// JoinWaitlist handles POST /api/schedules/{id}/waitlist (protected)
+func (h *WaitlistHandler) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	scheduleID := chi.URLParam(r, "id")
+	if scheduleID == "" {
+		utils.ResponseBadRequest(w, "Schedule ID is required", nil)
+		return
+	}
+
+	// Body opsional, default 1 seat
+	var req request.JoinWaitlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	entry, err := h.service.JoinWaitlist(r.Context(), userID.String(), scheduleID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "join waitlist")
+		return
+	}
+
+	utils.ResponseCreated(w, "Joined waitlist", entry)
+}
+
+// LeaveWaitlist handles DELETE /api/schedules/{id}/waitlist (protected)
+func (h *WaitlistHandler) LeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	scheduleID := chi.URLParam(r, "id")
+	if scheduleID == "" {
+		utils.ResponseBadRequest(w, "Schedule ID is required", nil)
+		return
+	}
+
+	if err := h.service.LeaveWaitlist(r.Context(), userID.String(), scheduleID); err != nil {
+		h.handleServiceError(w, r, err, "leave waitlist")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Left waitlist", nil)
+}
+
+// GetUserWaitlist handles GET /api/user/waitlist (protected)
+func (h *WaitlistHandler) GetUserWaitlist(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	entries, err := h.service.GetUserWaitlist(r.Context(), userID.String())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get user waitlist")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", entries)
+}
+
+// handleServiceError handles errors untuk waitlist operations
+func (h *WaitlistHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	if errors.Is(err, usecase.ErrSalesClosed) {
+		h.log.Warn(operation+" rejected - sales closed", zap.Error(err))
+		utils.ResponseBadRequest(w, i18n.Message(r.Context(), err), map[string]string{"code": errCodeSalesClosed})
+		return
+	}
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	case strings.Contains(errMsg, "already exists"),
+		strings.Contains(errMsg, "cannot"):
+		h.log.Warn(operation+" rejected",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -0,0 +1,110 @@
+package adaptor
+
+import (
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type WatchlistHandler struct {
+	service usecase.WatchlistService
+	log     *zap.Logger
+}
+
+func NewWatchlistHandler(service usecase.WatchlistService, log *zap.Logger) *WatchlistHandler {
+	return &WatchlistHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "watchlist")),
+	}
+}
+
+// AddToWatchlist handles POST /api/user/watchlist/{movieID} (protected)
+func (h *WatchlistHandler) AddToWatchlist(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	movieID := chi.URLParam(r, "movieID")
+	if movieID == "" {
+		utils.ResponseBadRequest(w, "Movie ID is required", nil)
+		return
+	}
+
+	if err := h.service.AddToWatchlist(r.Context(), userID.String(), movieID); err != nil {
+		h.handleServiceError(w, r, err, "add to watchlist")
+		return
+	}
+
+	utils.ResponseCreated(w, "Movie added to watchlist", nil)
+}
+
+// RemoveFromWatchlist handles DELETE /api/user/watchlist/{movieID} (protected)
+func (h *WatchlistHandler) RemoveFromWatchlist(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	movieID := chi.URLParam(r, "movieID")
+	if movieID == "" {
+		utils.ResponseBadRequest(w, "Movie ID is required", nil)
+		return
+	}
+
+	if err := h.service.RemoveFromWatchlist(r.Context(), userID.String(), movieID); err != nil {
+		h.handleServiceError(w, r, err, "remove from watchlist")
+		return
+	}
+
+	utils.ResponseSuccess(w, "Movie removed from watchlist", nil)
+}
+
+// GetUserWatchlist handles GET /api/user/watchlist (protected)
+func (h *WatchlistHandler) GetUserWatchlist(w http.ResponseWriter, r *http.Request) {
+	userID, ok := utils.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.ResponseUnauthorized(w, "Authentication required")
+		return
+	}
+
+	movies, err := h.service.GetUserWatchlist(r.Context(), userID.String())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get user watchlist")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", movies)
+}
+
+// handleServiceError handles errors untuk watchlist operations
+func (h *WatchlistHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -0,0 +1,184 @@
+package adaptor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/usecase"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type WebhookHandler struct {
+	service usecase.WebhookService
+	log     *zap.Logger
+}
+
+func NewWebhookHandler(service usecase.WebhookService, log *zap.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		service: service,
+		log:     log.With(zap.String("handler", "webhook")),
+	}
+}
+
+// GetSubscriptions handles GET /api/admin/webhooks
+func (h *WebhookHandler) GetSubscriptions(w http.ResponseWriter, r *http.Request) {
+	subscriptions, err := h.service.GetSubscriptions(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "get webhook subscriptions")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", subscriptions)
+}
+
+// GetSubscriptionByID handles GET /api/admin/webhooks/{id}
+func (h *WebhookHandler) GetSubscriptionByID(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := chi.URLParam(r, "id")
+	if subscriptionID == "" {
+		utils.ResponseBadRequest(w, "Webhook subscription ID is required", nil)
+		return
+	}
+
+	subscription, err := h.service.GetSubscriptionByID(r.Context(), subscriptionID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get webhook subscription")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", subscription)
+}
+
+// CreateSubscription handles POST /api/admin/webhooks
+func (h *WebhookHandler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var req request.WebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	subscription, err := h.service.CreateSubscription(r.Context(), &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "create webhook subscription")
+		return
+	}
+
+	utils.ResponseCreated(w, "success", subscription)
+}
+
+// UpdateSubscription handles PUT /api/admin/webhooks/{id}
+func (h *WebhookHandler) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := chi.URLParam(r, "id")
+	if subscriptionID == "" {
+		utils.ResponseBadRequest(w, "Webhook subscription ID is required", nil)
+		return
+	}
+
+	var req request.WebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ResponseBadRequest(w, "Invalid request body", nil)
+		return
+	}
+
+	subscription, err := h.service.UpdateSubscription(r.Context(), subscriptionID, &req)
+	if err != nil {
+		h.handleServiceError(w, r, err, "update webhook subscription")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", subscription)
+}
+
+// DeleteSubscription handles DELETE /api/admin/webhooks/{id}
+func (h *WebhookHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := chi.URLParam(r, "id")
+	if subscriptionID == "" {
+		utils.ResponseBadRequest(w, "Webhook subscription ID is required", nil)
+		return
+	}
+
+	if err := h.service.DeleteSubscription(r.Context(), subscriptionID); err != nil {
+		h.handleServiceError(w, r, err, "delete webhook subscription")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", nil)
+}
+
+// RotateSecret handles POST /api/admin/webhooks/{id}/rotate-secret
+func (h *WebhookHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := chi.URLParam(r, "id")
+	if subscriptionID == "" {
+		utils.ResponseBadRequest(w, "Webhook subscription ID is required", nil)
+		return
+	}
+
+	subscription, err := h.service.RotateSecret(r.Context(), subscriptionID)
+	if err != nil {
+		h.handleServiceError(w, r, err, "rotate webhook secret")
+		return
+	}
+
+	utils.ResponseSuccess(w, "success", subscription)
+}
+
+// GetDeliveries handles GET /api/admin/webhooks/{id}/deliveries
+func (h *WebhookHandler) GetDeliveries(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := chi.URLParam(r, "id")
+	if subscriptionID == "" {
+		utils.ResponseBadRequest(w, "Webhook subscription ID is required", nil)
+		return
+	}
+
+	query := r.URL.Query()
+	req := &request.PaginatedRequest{
+		Page:    utils.ParseInt(query.Get("page"), 1),
+		PerPage: utils.ParseInt(query.Get("per_page"), 10),
+	}
+	filter := &request.WebhookDeliveryFilter{
+		Status: query.Get("status"),
+	}
+
+	deliveries, err := h.service.GetDeliveries(r.Context(), subscriptionID, req, filter)
+	if err != nil {
+		h.handleServiceError(w, r, err, "get webhook deliveries")
+		return
+	}
+
+	utils.ResponsePaginated(w, "success", deliveries.Data, deliveries.Pagination)
+}
+
+// handleServiceError handles errors untuk webhook operations
+func (h *WebhookHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "not found"):
+		h.log.Warn(operation+" failed - not found",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseNotFound(w, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"):
+		h.log.Warn(operation+" validation failed",
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseValidationError(w, r, err)
+
+	case strings.Contains(errMsg, "invalid"):
+		h.log.Warn("Invalid input for "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseBadRequest(w, errMsg, nil)
+
+	default:
+		h.log.Error("Failed to "+operation,
+			zap.Error(err),
+			zap.String("operation", operation))
+		utils.ResponseInternalError(w, "Internal server error")
+	}
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ActivityType string
+
+const (
+	ActivityTypeBooking ActivityType = "booking"
+	ActivityTypePayment ActivityType = "payment"
+	ActivityTypeReview  ActivityType = "review"
+	ActivityTypeLogin   ActivityType = "login"
+)
+
+// UserActivity satu baris timeline akun, digabung dari bookings, payments, reviews dan sessions.
+// Field yang tidak relevan untuk Type dibiarkan nil (mis. Rating hanya untuk review).
+type UserActivity struct {
+	Type        ActivityType `db:"type"`
+	ReferenceID uuid.UUID    `db:"reference_id"`
+	OrderID     *string      `db:"order_id"`
+	MovieTitle  *string      `db:"movie_title"`
+	Status      *string      `db:"status"`
+	Amount      *int64       `db:"amount"` // minor unit Currency
+	Currency    *string      `db:"currency"`
+	Rating      *int         `db:"rating"`
+	IPAddress   *string      `db:"ip_address"`
+	UserAgent   *string      `db:"user_agent"`
+	OccurredAt  time.Time    `db:"occurred_at"`
+}
@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AppSetting override satu setting aplikasi; Value disimpan sebagai teks dan di-parse sesuai tipenya
+type AppSetting struct {
+	Key       string     `db:"key"`
+	Value     string     `db:"value"`
+	UpdatedBy *uuid.UUID `db:"updated_by"`
+	UpdatedAt time.Time  `db:"updated_at"`
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// Action audit log, format <objek>.<aksi>
+const (
+	AuditActionImpersonationStart = "impersonation.start"
+	AuditActionImpersonationStop  = "impersonation.stop"
+	AuditActionBookingFlags       = "booking.flags_update"
+	AuditActionGenreMerge         = "genre.merge"
+	AuditActionCinemaClose        = "cinema.close"
+	AuditActionSettingsUpdate     = "settings.update"
+	AuditActionFeatureFlagUpdate  = "feature_flag.update"
+	AuditActionFeatureFlagDelete  = "feature_flag.delete"
+	AuditActionJobRetry           = "job.retry"
+)
+
+// Jenis target audit log
+const (
+	AuditTargetUser    = "user"
+	AuditTargetBooking = "booking"
+	AuditTargetGenre   = "genre"
+	AuditTargetCinema  = "cinema"
+	// Settings target tanpa ID; key yang diubah ada di metadata
+	AuditTargetSettings = "settings"
+	// Feature flag target tanpa ID; key flag ada di metadata
+	AuditTargetFeatureFlag = "feature_flag"
+	AuditTargetJob         = "job"
+)
+
+// AuditLog catatan aksi sensitif admin; Metadata JSON bebas per action (mis. alasan impersonation)
+type AuditLog struct {
+	BaseSimple
+	ActorID    uuid.UUID       `db:"actor_id"`
+	Action     string          `db:"action"`
+	TargetType string          `db:"target_type"`
+	TargetID   *uuid.UUID      `db:"target_id"`
+	Metadata   json.RawMessage `db:"metadata"`
+	IPAddress  *string         `db:"ip_address"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// Banner konten home screen app yang dijadwalkan: tampil selama [StartsAt, EndsAt).
+// EndsAt nil berarti tampil terus sampai dinonaktifkan.
+type Banner struct {
+	Base
+	Title    string  `db:"title"`
+	ImageURL string  `db:"image_url"`
+	LinkURL  *string `db:"link_url"` // URL web atau deep link app
+	// City nil berarti tampil di semua kota
+	City      *string    `db:"city"`
+	StartsAt  time.Time  `db:"starts_at"`
+	EndsAt    *time.Time `db:"ends_at"`
+	SortOrder int        `db:"sort_order"` // kecil tampil duluan
+	IsActive  bool       `db:"is_active"`
+}
+
+// IsLive reports whether banner tampil pada waktu now untuk city (kosong = tanpa filter kota)
+func (b *Banner) IsLive(now time.Time, city string) bool {
+	if !b.IsActive || now.Before(b.StartsAt) {
+		return false
+	}
+	if b.EndsAt != nil && !now.Before(*b.EndsAt) {
+		return false
+	}
+	return b.City == nil || city == "" || strings.EqualFold(*b.City, city)
+}
@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"slices"
+
 	"github.com/google/uuid"
 )
 
@@ -11,14 +13,59 @@ const (
 	BookingStatusConfirmed BookingStatus = "confirmed"
 	BookingStatusCancelled BookingStatus = "cancelled"
 	BookingStatusExpired   BookingStatus = "expired"
+	BookingStatusCheckedIn BookingStatus = "checked_in"
+	BookingStatusRefunded  BookingStatus = "refunded"
 )
 
+// bookingTransitions perpindahan status yang sah; status yang tidak punya entry sudah final
+var bookingTransitions = map[BookingStatus][]BookingStatus{
+	BookingStatusPending:   {BookingStatusConfirmed, BookingStatusCancelled, BookingStatusExpired},
+	BookingStatusConfirmed: {BookingStatusCheckedIn, BookingStatusCancelled, BookingStatusRefunded},
+	// Booking dibayar yang dibatalkan baru berstatus refunded setelah dananya benar-benar dikembalikan
+	BookingStatusCancelled: {BookingStatusRefunded},
+}
+
+// CanTransitionTo reports whether booking boleh pindah dari s ke next
+func (s BookingStatus) CanTransitionTo(next BookingStatus) bool {
+	return slices.Contains(bookingTransitions[s], next)
+}
+
+// HoldsSeats reports whether booking berstatus s masih menempati kursinya di jadwal
+func (s BookingStatus) HoldsSeats() bool {
+	return s == BookingStatusPending || s == BookingStatusConfirmed || s == BookingStatusCheckedIn
+}
+
 type Booking struct {
 	Base
 	OrderID    string        `db:"order_id"`
 	UserID     uuid.UUID     `db:"user_id"`
 	ScheduleID uuid.UUID     `db:"schedule_id"`
 	TotalSeats int           `db:"total_seats"`
-	TotalPrice float64       `db:"total_price"`
+	TotalPrice int64         `db:"total_price"`
 	Status     BookingStatus `db:"status"`
+
+	// Rincian TotalPrice: BasePrice - DiscountAmount + FeeAmount + TaxAmount.
+	// Semua nominal dalam minor unit Currency.
+	BasePrice      int64  `db:"base_price"`
+	FeeAmount      int64  `db:"fee_amount"`
+	TaxAmount      int64  `db:"tax_amount"`
+	DiscountAmount int64  `db:"discount_amount"`
+	Currency       string `db:"currency"`
+
+	// Version naik di setiap update; Update/UpdateStatus menolak kalau version yang dibaca sudah basi
+	Version int `db:"version"`
+
+	// CustomerTier tier user saat booking dibuat; fee dan promo waktu bayar memakai tier ini, bukan tier terbaru
+	CustomerTier UserTier `db:"customer_tier"`
+}
+
+// BookingStatusChange satu baris riwayat status booking. ActorID nil untuk perubahan dari
+// webhook gateway atau background job.
+type BookingStatusChange struct {
+	BaseSimple
+	BookingID  uuid.UUID     `db:"booking_id"`
+	FromStatus BookingStatus `db:"from_status"`
+	ToStatus   BookingStatus `db:"to_status"`
+	ActorID    *uuid.UUID    `db:"actor_id"`
+	Reason     *string       `db:"reason"`
 }
@@ -0,0 +1,19 @@
+package entity
+
+import "github.com/google/uuid"
+
+// BookingNote catatan internal support, hanya terlihat oleh staff dan admin
+type BookingNote struct {
+	BaseSimple
+	BookingID uuid.UUID `db:"booking_id"`
+	AuthorID  uuid.UUID `db:"author_id"`
+	Body      string    `db:"body"`
+}
+
+type BookingFlag string
+
+const (
+	BookingFlagDisputed   BookingFlag = "disputed"
+	BookingFlagChargeback BookingFlag = "chargeback"
+	BookingFlagVIP        BookingFlag = "vip"
+)
@@ -6,4 +6,10 @@ type BookingSeat struct {
 	BaseSimple
 	BookingID uuid.UUID `db:"booking_id"`
 	SeatID    uuid.UUID `db:"seat_id"`
+	// ScheduleID salinan bookings.schedule_id, dipakai unique index kursi aktif per jadwal
+	ScheduleID uuid.UUID `db:"schedule_id"`
+
+	// TicketCode hanya diisi untuk group booking; IsBlocked kursi yang ditutup dari penjualan tanpa ticket
+	TicketCode *string `db:"ticket_code"`
+	IsBlocked  bool    `db:"is_blocked"`
 }
@@ -1,8 +1,98 @@
 package entity
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CinemaFacility string
+
+const (
+	CinemaFacilityIMAX         CinemaFacility = "imax"
+	CinemaFacilityDolby        CinemaFacility = "dolby"
+	CinemaFacilityParking      CinemaFacility = "parking"
+	CinemaFacilityFoodBeverage CinemaFacility = "food_beverage"
+)
+
+type CinemaClosureType string
+
+const (
+	CinemaClosureTemporary CinemaClosureType = "temporary"
+	CinemaClosurePermanent CinemaClosureType = "permanent"
+)
+
+// DayHours jam buka satu hari, format HH:MM (close boleh lewat tengah malam)
+type DayHours struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// OpeningHours keyed by lowercase weekday (monday..sunday); hari yang tidak ada berarti tutup
+type OpeningHours map[string]DayHours
+
 type Cinema struct {
 	Base
-	Name     string `db:"name"`
-	Location string `db:"location"`
-	City     string `db:"city"`
+	Name         string           `db:"name"`
+	Location     string           `db:"location"`
+	City         string           `db:"city"`
+	Latitude     *float64         `db:"latitude"`
+	Longitude    *float64         `db:"longitude"`
+	Facilities   []CinemaFacility `db:"facilities"`
+	OpeningHours OpeningHours     `db:"opening_hours"`
+	// TaxRate fraction (0.1 = 10%); nil berarti pakai default config
+	TaxRate *float64 `db:"tax_rate"`
+	// Timezone nama zona IANA (mis. "Asia/Makassar") untuk jam tayang di cinema ini
+	Timezone string `db:"timezone"`
+	// OrganizationID chain pemilik cinema; nil berarti dikelola platform
+	OrganizationID *uuid.UUID `db:"organization_id"`
+
+	// Penutupan mulai ClosedFrom; ClosedUntil (eksklusif) hanya untuk temporary. Semua nil = buka.
+	ClosureType   *CinemaClosureType `db:"closure_type"`
+	ClosedFrom    *time.Time         `db:"closed_from"`
+	ClosedUntil   *time.Time         `db:"closed_until"`
+	ClosureReason *string            `db:"closure_reason"`
+}
+
+// DefaultCinemaTimezone dipakai kalau admin tidak mengisi timezone
+const DefaultCinemaTimezone = "Asia/Jakarta"
+
+// TimeLocation returns the cinema time zone; nama yang tidak dikenal jatuh ke default
+func (c *Cinema) TimeLocation() *time.Location {
+	name := c.Timezone
+	if name == "" {
+		name = DefaultCinemaTimezone
+	}
+	if loc, err := time.LoadLocation(name); err == nil {
+		return loc
+	}
+	loc, err := time.LoadLocation(DefaultCinemaTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// IsClosedOn reports whether the cinema tutup pada tanggal lokal date (jam diabaikan)
+func (c *Cinema) IsClosedOn(date time.Time) bool {
+	if c.ClosedFrom == nil {
+		return false
+	}
+	day := date.Format(time.DateOnly)
+	if day < c.ClosedFrom.Format(time.DateOnly) {
+		return false
+	}
+	return c.ClosedUntil == nil || day < c.ClosedUntil.Format(time.DateOnly)
+}
+
+// CityCount is one distinct city with jumlah cinema aktif di dalamnya
+type CityCount struct {
+	City        string `db:"city"`
+	CinemaCount int64  `db:"cinema_count"`
+}
+
+// NearbyCinema is a cinema with its distance from the requested point
+type NearbyCinema struct {
+	Cinema
+	DistanceKm float64 `db:"distance_km"`
 }
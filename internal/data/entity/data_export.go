@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataExportStatus string
+
+const (
+	DataExportStatusPending    DataExportStatus = "pending"
+	DataExportStatusProcessing DataExportStatus = "processing"
+	DataExportStatusCompleted  DataExportStatus = "completed"
+	DataExportStatusFailed     DataExportStatus = "failed"
+	DataExportStatusExpired    DataExportStatus = "expired" // file sudah dihapus setelah expires_at
+)
+
+type DataExportFormat string
+
+const (
+	DataExportFormatJSON DataExportFormat = "json"
+	DataExportFormatZIP  DataExportFormat = "zip"
+)
+
+// DataExport permintaan export data pribadi; dikerjakan worker, file bisa diunduh lewat signed URL
+type DataExport struct {
+	BaseNoDelete
+	UserID       uuid.UUID        `db:"user_id"`
+	Format       DataExportFormat `db:"format"`
+	Status       DataExportStatus `db:"status"`
+	FilePath     *string          `db:"file_path"`
+	SizeBytes    *int64           `db:"size_bytes"`
+	Error        *string          `db:"error"`
+	CompletedAt  *time.Time       `db:"completed_at"`
+	ExpiresAt    *time.Time       `db:"expires_at"`
+	DownloadedAt *time.Time       `db:"downloaded_at"`
+}
+
+// Downloadable reports whether file export masih tersedia untuk diunduh
+func (e *DataExport) Downloadable(now time.Time) bool {
+	return e.Status == DataExportStatusCompleted && e.FilePath != nil && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
+
+// InProgress reports whether export masih antri atau sedang dikerjakan worker
+func (e *DataExport) InProgress() bool {
+	return e.Status == DataExportStatusPending || e.Status == DataExportStatusProcessing
+}
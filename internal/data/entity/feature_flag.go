@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeatureFlag status satu fitur yang di-rollout bertahap. Environments kosong berarti semua
+// environment; RolloutPercent membatasi fitur ke sebagian user berdasarkan hash user ID.
+type FeatureFlag struct {
+	Key            string     `db:"key"`
+	Description    string     `db:"description"`
+	Enabled        bool       `db:"enabled"`
+	RolloutPercent int        `db:"rollout_percent"`
+	Environments   []string   `db:"environments"`
+	UpdatedBy      *uuid.UUID `db:"updated_by"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// ActiveIn reports whether flag menyala di environment ini, sebelum rollout percent diperhitungkan
+func (f *FeatureFlag) ActiveIn(environment string) bool {
+	if !f.Enabled {
+		return false
+	}
+	return len(f.Environments) == 0 || slices.Contains(f.Environments, environment)
+}
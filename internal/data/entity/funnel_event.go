@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FunnelStep tahapan checkout yang diukur untuk laporan conversion funnel, urut dari atas
+type FunnelStep string
+
+const (
+	FunnelStepSeatView FunnelStep = "seat_view" // seat map schedule dibuka
+	FunnelStepHold     FunnelStep = "hold"      // booking pending dibuat, kursi ditahan sampai batas bayar
+	FunnelStepBooking  FunnelStep = "booking"   // user submit pembayaran untuk booking
+	FunnelStepPayment  FunnelStep = "payment"   // pembayaran selesai, booking confirmed
+)
+
+// FunnelSteps urutan tahapan funnel
+var FunnelSteps = []FunnelStep{FunnelStepSeatView, FunnelStepHold, FunnelStepBooking, FunnelStepPayment}
+
+type FunnelEvent struct {
+	ID         uuid.UUID  `db:"id"`
+	Step       FunnelStep `db:"step"`
+	ScheduleID uuid.UUID  `db:"schedule_id"`
+	UserID     *uuid.UUID `db:"user_id"`
+	BookingID  *uuid.UUID `db:"booking_id"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GiftCard saldo tersimpan yang diterbitkan admin. Sebelum di-redeem UserID nil dan kode
+// bisa diklaim siapa saja; setelahnya saldo hanya dipakai untuk booking pemilik akun.
+type GiftCard struct {
+	BaseNoDelete
+	Code          string     `db:"code"` // uppercase tanpa tanda hubung
+	InitialAmount int64      `db:"initial_amount"`
+	Balance       int64      `db:"balance"` // minor unit Currency
+	Currency      string     `db:"currency"`
+	UserID        *uuid.UUID `db:"user_id"`
+	RedeemedAt    *time.Time `db:"redeemed_at"`
+	ExpiresAt     *time.Time `db:"expires_at"`
+	IsActive      bool       `db:"is_active"`
+}
+
+// Expired reports whether kartu sudah lewat masa berlaku pada now
+func (g *GiftCard) Expired(now time.Time) bool {
+	return g.ExpiresAt != nil && !now.Before(*g.ExpiresAt)
+}
+
+// GiftCardTransaction satu baris ledger saldo. Amount negatif = dipakai bayar,
+// positif = dikembalikan karena payment-nya gagal atau expired.
+type GiftCardTransaction struct {
+	BaseSimple
+	GiftCardID uuid.UUID  `db:"gift_card_id"`
+	PaymentID  *uuid.UUID `db:"payment_id"`
+	Amount     int64      `db:"amount"`
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupBooking is the event metadata of a block booking dibuat admin; kursi dan harga ada di Booking
+type GroupBooking struct {
+	BookingID      uuid.UUID `db:"booking_id"`
+	GroupName      string    `db:"group_name"`
+	Notes          *string   `db:"notes"`
+	CreatedBy      uuid.UUID `db:"created_by"`
+	BlockRemaining bool      `db:"block_remaining"`
+	CreatedAt      time.Time `db:"created_at"`
+}
@@ -2,9 +2,19 @@ package entity
 
 import "github.com/google/uuid"
 
+type HallType string
+
+const (
+	HallType2D   HallType = "2D"
+	HallType3D   HallType = "3D"
+	HallTypeIMAX HallType = "IMAX"
+	HallType4DX  HallType = "4DX"
+)
+
 type Hall struct {
 	Base
 	CinemaID   uuid.UUID `db:"cinema_id"`
 	HallNumber int       `db:"hall_number"`
 	TotalSeats int       `db:"total_seats"`
+	HallType   HallType  `db:"hall_type"`
 }
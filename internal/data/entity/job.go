@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusDead      JobStatus = "dead" // gagal max_attempts kali, menunggu retry admin
+)
+
+// Job satu pekerjaan async di antrian persistent. Payload hanya berisi ID (booking, user, export);
+// handler membaca ulang datanya supaya job yang di-retry belakangan tidak memakai data basi.
+type Job struct {
+	BaseNoDelete
+	Type        string          `db:"type"`
+	Payload     json.RawMessage `db:"payload"`
+	Status      JobStatus       `db:"status"`
+	Attempts    int             `db:"attempts"`
+	MaxAttempts int             `db:"max_attempts"`
+	RunAt       time.Time       `db:"run_at"`
+	LockedUntil *time.Time      `db:"locked_until"`
+	LastError   *string         `db:"last_error"`
+	CompletedAt *time.Time      `db:"completed_at"`
+}
+
+// LastAttempt reports whether percobaan yang sedang jalan adalah yang terakhir sebelum dead letter
+func (j *Job) LastAttempt() bool {
+	return j.Attempts >= j.MaxAttempts
+}
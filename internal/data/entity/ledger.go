@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LedgerAccount akun buku besar. Aset (cash) dan kontra-pendapatan (discounts) bersaldo debit,
+// kewajiban dan pendapatan bersaldo credit.
+type LedgerAccount string
+
+const (
+	LedgerAccountCash              LedgerAccount = "cash"                // uang masuk lewat payment method atau penjualan gift card
+	LedgerAccountGiftCardLiability LedgerAccount = "gift_card_liability" // saldo gift card yang belum dipakai
+	LedgerAccountPaymentClearing   LedgerAccount = "payment_clearing"    // saldo gift yang ditahan payment pending
+	LedgerAccountTicketRevenue     LedgerAccount = "ticket_revenue"
+	LedgerAccountFeeRevenue        LedgerAccount = "fee_revenue"
+	LedgerAccountTaxPayable        LedgerAccount = "tax_payable"
+	LedgerAccountDiscounts         LedgerAccount = "discounts"
+	LedgerAccountRefundsPayable    LedgerAccount = "refunds_payable" // refund yang masih harus dibayar ke customer
+)
+
+// LedgerSalesAccounts dijumlah (credit - debit) untuk angka penjualan kotor di report, sama dengan total_price booking
+var LedgerSalesAccounts = []LedgerAccount{
+	LedgerAccountTicketRevenue,
+	LedgerAccountFeeRevenue,
+	LedgerAccountTaxPayable,
+	LedgerAccountDiscounts,
+}
+
+// LedgerKind jenis kejadian yang diposting; bersama ReferenceID unik per transaksi
+type LedgerKind string
+
+const (
+	LedgerKindGiftCardIssue      LedgerKind = "gift_card_issue"      // reference: gift card
+	LedgerKindPaymentGiftHold    LedgerKind = "payment_gift_hold"    // reference: payment
+	LedgerKindPaymentGiftRelease LedgerKind = "payment_gift_release" // reference: payment
+	LedgerKindPaymentCompleted   LedgerKind = "payment_completed"    // reference: payment
+	LedgerKindBookingRefund      LedgerKind = "booking_refund"       // reference: booking
+)
+
+// LedgerTransaction satu kejadian keuangan dengan entry-entry-nya
+type LedgerTransaction struct {
+	BaseSimple
+	Kind        LedgerKind `db:"kind"`
+	ReferenceID uuid.UUID  `db:"reference_id"`
+	BookingID   *uuid.UUID `db:"booking_id"`
+	Currency    string     `db:"currency"`
+	Description *string    `db:"description"`
+	OccurredAt  time.Time  `db:"occurred_at"`
+
+	Entries []LedgerEntry
+}
+
+// LedgerEntry satu sisi posting; tepat satu dari Debit / Credit yang lebih dari nol
+type LedgerEntry struct {
+	ID            uuid.UUID     `db:"id"`
+	TransactionID uuid.UUID     `db:"transaction_id"`
+	Account       LedgerAccount `db:"account"`
+	Debit         int64         `db:"debit"`
+	Credit        int64         `db:"credit"`
+}
+
+// Debit adds entry debit; nominal nol dilewati supaya komponen kosong (mis. fee 0) tidak jadi baris
+func (t *LedgerTransaction) Debit(account LedgerAccount, amount int64) *LedgerTransaction {
+	if amount != 0 {
+		t.Entries = append(t.Entries, LedgerEntry{ID: uuid.New(), TransactionID: t.ID, Account: account, Debit: amount})
+	}
+	return t
+}
+
+// Credit adds entry credit, pasangan Debit
+func (t *LedgerTransaction) Credit(account LedgerAccount, amount int64) *LedgerTransaction {
+	if amount != 0 {
+		t.Entries = append(t.Entries, LedgerEntry{ID: uuid.New(), TransactionID: t.ID, Account: account, Credit: amount})
+	}
+	return t
+}
+
+// Balanced reports whether transaksi boleh diposting: minimal dua entry, tidak ada nominal negatif,
+// dan total debit sama dengan total credit
+func (t *LedgerTransaction) Balanced() bool {
+	if len(t.Entries) < 2 {
+		return false
+	}
+
+	var debit, credit int64
+	for _, entry := range t.Entries {
+		if entry.Debit < 0 || entry.Credit < 0 || (entry.Debit > 0) == (entry.Credit > 0) {
+			return false
+		}
+		debit += entry.Debit
+		credit += entry.Credit
+	}
+	return debit == credit
+}
+
+// LedgerAccountBalance total debit dan credit satu akun per currency
+type LedgerAccountBalance struct {
+	Currency string        `db:"currency"`
+	Account  LedgerAccount `db:"account"`
+	Debit    int64         `db:"debit"`
+	Credit   int64         `db:"credit"`
+}
+
+// LedgerImbalance transaksi yang total debit dan credit-nya tidak sama
+type LedgerImbalance struct {
+	TransactionID uuid.UUID  `db:"transaction_id"`
+	Kind          LedgerKind `db:"kind"`
+	ReferenceID   uuid.UUID  `db:"reference_id"`
+	Currency      string     `db:"currency"`
+	Debit         int64      `db:"debit"`
+	Credit        int64      `db:"credit"`
+}
+
+// LedgerMismatch selisih antara saldo menurut tabel operasional (gift card, payment) dan menurut ledger.
+// ReferenceID terisi untuk selisih per payment, nil untuk selisih saldo akun per currency.
+type LedgerMismatch struct {
+	ReferenceID *uuid.UUID `db:"reference_id"`
+	Currency    string     `db:"currency"`
+	Expected    int64      `db:"expected"`
+	Posted      int64      `db:"posted"`
+}
@@ -9,8 +9,33 @@ type ReleaseStatus string
 const (
 	ReleaseStatusNowPlaying ReleaseStatus = "now_playing"
 	ReleaseStatusComingSoon ReleaseStatus = "coming_soon"
+	ReleaseStatusEnded      ReleaseStatus = "ended" // tidak ada jadwal lagi, diarsip otomatis
 )
 
+// AgeRating klasifikasi usia film mengikuti LSF
+type AgeRating string
+
+const (
+	AgeRatingSU    AgeRating = "SU" // semua umur
+	AgeRating13    AgeRating = "13+"
+	AgeRating17    AgeRating = "17+"
+	AgeRatingAdult AgeRating = "R" // dewasa, 21 tahun ke atas
+)
+
+// MinimumAge returns umur minimal penonton untuk rating ini; rating tidak dikenal dianggap SU
+func (a AgeRating) MinimumAge() int {
+	switch a {
+	case AgeRating13:
+		return 13
+	case AgeRating17:
+		return 17
+	case AgeRatingAdult:
+		return 21
+	default:
+		return 0
+	}
+}
+
 type Movie struct {
 	Base
 	Title             string        `db:"title"`
@@ -20,4 +45,5 @@ type Movie struct {
 	ReleaseDate       time.Time     `db:"release_date"`
 	DurationInMinutes int           `db:"duration_in_minutes"`
 	ReleaseStatus     ReleaseStatus `db:"release_status"`
+	AgeRating         AgeRating     `db:"age_rating"`
 }
@@ -0,0 +1,53 @@
+package entity
+
+import "github.com/google/uuid"
+
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail NotificationChannel = "email"
+	NotificationChannelPush  NotificationChannel = "push"
+	NotificationChannelSMS   NotificationChannel = "sms"
+)
+
+type NotificationCategory string
+
+const (
+	NotificationCategoryBookingConfirmation NotificationCategory = "booking_confirmation"
+	NotificationCategoryReminder            NotificationCategory = "reminder"
+	NotificationCategoryPromotion           NotificationCategory = "promotion"
+)
+
+type UserNotificationSetting struct {
+	BaseNoDelete
+	UserID              uuid.UUID           `db:"user_id"`
+	Channel             NotificationChannel `db:"channel"`
+	BookingConfirmation bool                `db:"booking_confirmation"`
+	Reminders           bool                `db:"reminders"`
+	Promotions          bool                `db:"promotions"`
+}
+
+// DefaultNotificationSetting returns the setting used when user belum pernah menyimpan preferensi
+func DefaultNotificationSetting(userID uuid.UUID, channel NotificationChannel) *UserNotificationSetting {
+	return &UserNotificationSetting{
+		UserID:              userID,
+		Channel:             channel,
+		BookingConfirmation: true,
+		Reminders:           true,
+		Promotions:          false,
+	}
+}
+
+// Allows checks whether the given category is enabled for this channel
+func (s *UserNotificationSetting) Allows(category NotificationCategory) bool {
+	switch category {
+	case NotificationCategoryBookingConfirmation:
+		return s.BookingConfirmation
+	case NotificationCategoryReminder:
+		return s.Reminders
+	case NotificationCategoryPromotion:
+		return s.Promotions
+	default:
+		return false
+	}
+}
@@ -0,0 +1,12 @@
+package entity
+
+// Organization is a cinema chain. Cinema dan admin bisa terikat ke satu organization;
+// tanpa organization berarti dikelola platform.
+type Organization struct {
+	Base
+	Name string `db:"name"`
+	Slug string `db:"slug"`
+
+	// OrderPrefix awalan order ID booking di cinema chain ini; nil = prefix default dari config
+	OrderPrefix *string `db:"order_prefix"`
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxEvent struct {
+	BaseSimple
+	AggregateType string     `db:"aggregate_type"`
+	AggregateID   uuid.UUID  `db:"aggregate_id"`
+	EventType     string     `db:"event_type"`
+	Payload       []byte     `db:"payload"`
+	Attempts      int        `db:"attempts"`
+	LastError     *string    `db:"last_error"`
+	PublishedAt   *time.Time `db:"published_at"`
+}
@@ -1,22 +1,76 @@
 package entity
 
 import (
+	"slices"
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type PaymentStatus string
 
 const (
-	PaymentStatusPending   PaymentStatus = "pending"
-	PaymentStatusCompleted PaymentStatus = "completed"
-	PaymentStatusFailed    PaymentStatus = "failed"
+	PaymentStatusPending           PaymentStatus = "pending"
+	PaymentStatusProcessing        PaymentStatus = "processing"
+	PaymentStatusCompleted         PaymentStatus = "completed"
+	PaymentStatusFailed            PaymentStatus = "failed"
+	PaymentStatusExpired           PaymentStatus = "expired"
+	PaymentStatusRefunded          PaymentStatus = "refunded"
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
 )
 
+// paymentTransitions perpindahan status payment yang sah. Pending boleh langsung selesai karena
+// method instan dan sebagian callback gateway tidak pernah melewati processing.
+var paymentTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusPending:           {PaymentStatusProcessing, PaymentStatusCompleted, PaymentStatusFailed, PaymentStatusExpired},
+	PaymentStatusProcessing:        {PaymentStatusCompleted, PaymentStatusFailed, PaymentStatusExpired},
+	PaymentStatusCompleted:         {PaymentStatusRefunded, PaymentStatusPartiallyRefunded},
+	PaymentStatusPartiallyRefunded: {PaymentStatusRefunded},
+}
+
+// CanTransitionTo reports whether payment boleh pindah dari s ke next
+func (s PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
+	return slices.Contains(paymentTransitions[s], next)
+}
+
+// Open reports whether payment masih menunggu hasil dari customer atau gateway
+func (s PaymentStatus) Open() bool {
+	return s == PaymentStatusPending || s == PaymentStatusProcessing
+}
+
+// Settled reports whether dana payment pernah diterima, termasuk yang kemudian di-refund
+func (s PaymentStatus) Settled() bool {
+	return s == PaymentStatusCompleted || s == PaymentStatusRefunded || s == PaymentStatusPartiallyRefunded
+}
+
 type Payment struct {
 	Base
 	BookingID       uuid.UUID     `db:"booking_id"`
 	PaymentMethodID uuid.UUID     `db:"payment_method_id"`
-	Amount          float64       `db:"amount"`
+	Amount          int64         `db:"amount"` // minor unit Currency
+	Currency        string        `db:"currency"`
 	Status          PaymentStatus `db:"status"`
 	TransactionID   *string       `db:"transaction_id"`
+
+	// PaymentCode nomor VA atau payload QRIS untuk method async, nil untuk method instan
+	PaymentCode *string    `db:"payment_code"`
+	ExpiresAt   *time.Time `db:"expires_at"`
+	PaidAt      *time.Time `db:"paid_at"`
+
+	// GiftCardAmount bagian total booking yang dipotong dari saldo gift card.
+	// Amount hanya porsi yang ditagih ke payment method, jadi total = Amount + GiftCardAmount.
+	GiftCardAmount int64 `db:"gift_card_amount"`
+
+	// Version untuk optimistic locking, lihat Booking.Version
+	Version int `db:"version"`
+}
+
+// PaymentStatusChange satu baris riwayat status payment, pasangan BookingStatusChange
+type PaymentStatusChange struct {
+	BaseSimple
+	PaymentID  uuid.UUID     `db:"payment_id"`
+	FromStatus PaymentStatus `db:"from_status"`
+	ToStatus   PaymentStatus `db:"to_status"`
+	ActorID    *uuid.UUID    `db:"actor_id"`
+	Reason     *string       `db:"reason"`
 }
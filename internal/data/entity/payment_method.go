@@ -1,7 +1,31 @@
 package entity
 
+type PaymentMethodType string
+
+const (
+	PaymentMethodTypeEWallet        PaymentMethodType = "e_wallet"
+	PaymentMethodTypeVirtualAccount PaymentMethodType = "virtual_account"
+	PaymentMethodTypeCard           PaymentMethodType = "card"
+	PaymentMethodTypeQRIS           PaymentMethodType = "qris"
+	PaymentMethodTypeOther          PaymentMethodType = "other"
+)
+
 type PaymentMethod struct {
 	Base
-	Name     string `db:"name"`
-	IsActive bool   `db:"is_active"`
+	Name         string            `db:"name"`
+	Type         PaymentMethodType `db:"payment_type"`
+	IsActive     bool              `db:"is_active"`
+	LogoURL      *string           `db:"logo_url"`
+	Instructions *string           `db:"instructions"`
+
+	// ConvenienceFee per kursi dalam minor unit currency booking; nil berarti pakai default config
+	ConvenienceFee *int64 `db:"convenience_fee"`
+	// FeePercent fraction dari harga tiket (0.02 = 2%), ditambahkan di atas ConvenienceFee
+	FeePercent *float64 `db:"fee_percent"`
+}
+
+// IsAsync true untuk method yang dibayar di luar app (transfer VA / scan QRIS);
+// payment-nya pending sampai gateway mengirim webhook
+func (pm *PaymentMethod) IsAsync() bool {
+	return pm.Type == PaymentMethodTypeVirtualAccount || pm.Type == PaymentMethodTypeQRIS
 }
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PricePromotion diskon untuk show yang mulai di jendela jam tertentu, mis. weekday pagi.
+// Jam dibandingkan dengan ShowTime schedule (jam dinding lokal cinema).
+type PricePromotion struct {
+	Base
+	Name string `db:"name"`
+	// DiscountPercent fraction dari harga kursi (0.25 = 25%)
+	DiscountPercent float64 `db:"discount_percent"`
+	// Weekdays 0 = Minggu sampai 6 = Sabtu; kosong berarti setiap hari
+	Weekdays   []int16    `db:"weekdays"`
+	StartTime  time.Time  `db:"start_time"`
+	EndTime    time.Time  `db:"end_time"`
+	ValidFrom  *time.Time `db:"valid_from"`
+	ValidUntil *time.Time `db:"valid_until"`
+	// CinemaID nil berarti berlaku di semua cinema
+	CinemaID *uuid.UUID `db:"cinema_id"`
+	// Tiers membatasi promo ke customer tier tertentu; kosong berarti untuk semua customer
+	Tiers    []UserTier `db:"tiers"`
+	IsActive bool       `db:"is_active"`
+}
+
+// Matches reports whether promo berlaku untuk show di showDate/showTime pada cinemaID bagi customer
+// dengan tier; tier kosong (mis. listing publik tanpa login) hanya cocok dengan promo tanpa batasan tier
+func (p *PricePromotion) Matches(showDate, showTime time.Time, cinemaID uuid.UUID, tier UserTier) bool {
+	if !p.IsActive {
+		return false
+	}
+	if p.CinemaID != nil && *p.CinemaID != cinemaID {
+		return false
+	}
+	if len(p.Tiers) > 0 && !slices.Contains(p.Tiers, tier) {
+		return false
+	}
+
+	day := showDate.Format("2006-01-02")
+	if p.ValidFrom != nil && day < p.ValidFrom.Format("2006-01-02") {
+		return false
+	}
+	if p.ValidUntil != nil && day > p.ValidUntil.Format("2006-01-02") {
+		return false
+	}
+
+	if len(p.Weekdays) > 0 && !slices.Contains(p.Weekdays, int16(showDate.Weekday())) {
+		return false
+	}
+
+	// Jendela [start, end): show jam 12:00 tidak ikut promo 09:00-12:00
+	clock := showTime.Format("15:04")
+	return clock >= p.StartTime.Format("15:04") && clock < p.EndTime.Format("15:04")
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SalesReportRow is one aggregated bucket (day, cinema, or movie) of a sales report
+type SalesReportRow struct {
+	GroupKey    string `db:"group_key"`
+	Label       string `db:"label"`
+	Revenue     int64  `db:"revenue"` // minor unit default currency
+	TicketsSold int64  `db:"tickets_sold"`
+	Bookings    int64  `db:"bookings"`
+	Capacity    int64  `db:"capacity"`
+}
+
+// SalesSummary holds key figures for a single day
+type SalesSummary struct {
+	Date            time.Time `db:"date"`
+	Revenue         int64     `db:"revenue"`
+	BookingsCreated int64     `db:"bookings_created"`
+	TicketsSold     int64     `db:"tickets_sold"`
+	PendingBookings int64     `db:"pending_bookings"`
+	ShowsToday      int64     `db:"shows_today"`
+	SeatsSoldToday  int64     `db:"seats_sold_today"`
+	CapacityToday   int64     `db:"capacity_today"`
+}
+
+// ScheduleOccupancyRow is seat usage of one schedule against its hall capacity
+type ScheduleOccupancyRow struct {
+	ScheduleID   uuid.UUID `db:"schedule_id"`
+	MovieID      uuid.UUID `db:"movie_id"`
+	MovieTitle   string    `db:"movie_title"`
+	HallID       uuid.UUID `db:"hall_id"`
+	HallNumber   int       `db:"hall_number"`
+	ShowDate     time.Time `db:"show_date"`
+	ShowTime     time.Time `db:"show_time"`
+	Capacity     int       `db:"capacity"`
+	SoldSeats    int       `db:"sold_seats"`
+	PendingSeats int       `db:"pending_seats"`
+}
+
+// BookingExportRow is a flattened booking with its customer and show details for admin exports
+type BookingExportRow struct {
+	ID          uuid.UUID     `db:"id"`
+	OrderID     string        `db:"order_id"`
+	Username    string        `db:"username"`
+	Email       string        `db:"email"`
+	MovieTitle  string        `db:"movie_title"`
+	CinemaName  string        `db:"cinema_name"`
+	HallNumber  int           `db:"hall_number"`
+	ShowDate    time.Time     `db:"show_date"`
+	ShowTime    time.Time     `db:"show_time"`
+	SeatNumbers string        `db:"seat_numbers"`
+	TotalSeats  int           `db:"total_seats"`
+	TotalPrice  int64         `db:"total_price"`
+	Currency    string        `db:"currency"`
+	Status      BookingStatus `db:"status"`
+	CreatedAt   time.Time     `db:"created_at"`
+}
+
+// PaymentReportRow is a payment joined with its booking and method for reconciliation reports
+type PaymentReportRow struct {
+	ID                uuid.UUID         `db:"id"`
+	BookingID         uuid.UUID         `db:"booking_id"`
+	OrderID           string            `db:"order_id"`
+	PaymentMethodID   uuid.UUID         `db:"payment_method_id"`
+	PaymentMethodName string            `db:"payment_method_name"`
+	PaymentMethodType PaymentMethodType `db:"payment_type"`
+	Amount            int64             `db:"amount"`
+	Currency          string            `db:"currency"`
+	Status            PaymentStatus     `db:"status"`
+	TransactionID     *string           `db:"transaction_id"`
+	CreatedAt         time.Time         `db:"created_at"`
+	PaidAt            *time.Time        `db:"paid_at"`
+}
+
+// PaymentTotalRow is the count and amount of payments for one (status, method) pair
+type PaymentTotalRow struct {
+	Status            PaymentStatus `db:"status"`
+	PaymentMethodID   uuid.UUID     `db:"payment_method_id"`
+	PaymentMethodName string        `db:"payment_method_name"`
+	Payments          int64         `db:"payments"`
+	Amount            int64         `db:"amount"`
+}
+
+// FunnelStepCount jumlah unik yang mencapai satu tahapan funnel untuk satu movie.
+// Tahapan booking dihitung per booking, seat_view per user+schedule (anonim per event).
+type FunnelStepCount struct {
+	MovieID    uuid.UUID  `db:"movie_id"`
+	MovieTitle string     `db:"movie_title"`
+	Step       FunnelStep `db:"step"`
+	Count      int64      `db:"count"`
+}
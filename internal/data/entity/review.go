@@ -1,13 +1,36 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type Review struct {
 	BaseSimple
-	UserID  uuid.UUID `db:"user_id"`
-	MovieID uuid.UUID `db:"movie_id"`
-	Rating  int       `db:"rating"` // 1-5
-	Comment *string   `db:"comment"`
+	UserID    uuid.UUID  `db:"user_id"`
+	MovieID   uuid.UUID  `db:"movie_id"`
+	Rating    int        `db:"rating"` // 1-5
+	Comment   *string    `db:"comment"`
+	DeletedAt *time.Time `db:"deleted_at"`
+
+	// ReportCount report yang belum ditinjau admin; HiddenAt di-set saat melewati threshold
+	ReportCount int        `db:"report_count"`
+	HiddenAt    *time.Time `db:"hidden_at"`
+
+	// EditedAt waktu edit terakhir oleh pemilik; isi sebelumnya disimpan sebagai ReviewRevision
+	EditedAt *time.Time `db:"edited_at"`
+}
+
+// ReviewRevision isi review sebelum satu kali edit, append-only
+type ReviewRevision struct {
+	BaseSimple
+	ReviewID uuid.UUID `db:"review_id"`
+	Rating   int       `db:"rating"`
+	Comment  *string   `db:"comment"`
+}
+
+// IsHidden reports whether review disembunyikan dari publik menunggu moderasi
+func (r *Review) IsHidden() bool {
+	return r.HiddenAt != nil
 }
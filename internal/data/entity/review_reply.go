@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReviewReply is the official cinema response ke satu review
+type ReviewReply struct {
+	BaseSimple
+	ReviewID  uuid.UUID `db:"review_id"`
+	AuthorID  uuid.UUID `db:"author_id"`
+	Message   string    `db:"message"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
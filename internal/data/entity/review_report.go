@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewReportReason string
+
+const (
+	ReviewReportSpam       ReviewReportReason = "spam"
+	ReviewReportOffensive  ReviewReportReason = "offensive"
+	ReviewReportSpoiler    ReviewReportReason = "spoiler"
+	ReviewReportHarassment ReviewReportReason = "harassment"
+	ReviewReportOffTopic   ReviewReportReason = "off_topic"
+	ReviewReportOther      ReviewReportReason = "other"
+)
+
+// ReviewReport is one user's flag terhadap review; satu user hanya bisa report review yang sama sekali
+type ReviewReport struct {
+	BaseSimple
+	ReviewID   uuid.UUID          `db:"review_id"`
+	ReporterID uuid.UUID          `db:"reporter_id"`
+	Reason     ReviewReportReason `db:"reason"`
+	Details    *string            `db:"details"`
+	ResolvedAt *time.Time         `db:"resolved_at"`
+}
@@ -6,11 +6,45 @@ import (
 	"github.com/google/uuid"
 )
 
+type ScheduleStatus string
+
+const (
+	ScheduleStatusDraft     ScheduleStatus = "draft"
+	ScheduleStatusPublished ScheduleStatus = "published"
+	// Cancelled dibatalkan karena cinema tutup; tidak bisa di-publish ulang
+	ScheduleStatusCancelled ScheduleStatus = "cancelled"
+)
+
 type Schedule struct {
 	Base
 	MovieID  uuid.UUID `db:"movie_id"`
 	HallID   uuid.UUID `db:"hall_id"`
 	ShowDate time.Time `db:"show_date"`
 	ShowTime time.Time `db:"show_time"`
-	Price    float64   `db:"price"`
+	// StartsAt waktu mulai dalam UTC; ShowDate/ShowTime adalah jam dinding di zona waktu cinema
+	StartsAt time.Time `db:"starts_at"`
+	Price    int64     `db:"price"` // minor unit Currency
+	Currency string    `db:"currency"`
+	// PriceOverride menggantikan harga setelah multiplier hall untuk schedule ini; nil = pakai pricing rules
+	PriceOverride *int64 `db:"price_override"`
+
+	// Draft hanya terlihat admin; endpoint publik dan booking hanya menerima published
+	Status      ScheduleStatus `db:"status"`
+	PublishedAt *time.Time     `db:"published_at"`
+
+	// Capacity salinan halls.total_seats; BookedSeats dijaga di transaction yang sama dengan
+	// booking_seats, termasuk kursi blocked group booking
+	Capacity    int `db:"capacity"`
+	BookedSeats int `db:"booked_seats"`
+}
+
+// SeatsLeft kursi yang belum dipegang booking. Kursi yang diblokir admin atau di-hold waitlist
+// tidak ikut dikurangi, jadi angka ini batas atas untuk listing, bukan pengganti seat map.
+func (s *Schedule) SeatsLeft() int {
+	return max(s.Capacity-s.BookedSeats, 0)
+}
+
+// IsPublished reports whether the schedule boleh dilihat dan dibooking user
+func (s *Schedule) IsPublished() bool {
+	return s.Status == ScheduleStatusPublished
 }
@@ -10,3 +10,23 @@ type Seat struct {
 	SeatColumn  int       `db:"seat_column"` // 1, 2, 3, etc.
 	IsAvailable bool      `db:"is_available"`
 }
+
+// SeatBlockReason why admin memblokir kursi untuk satu schedule
+type SeatBlockReason string
+
+const (
+	SeatBlockReasonMaintenance SeatBlockReason = "maintenance"
+	SeatBlockReasonDistancing  SeatBlockReason = "distancing"
+	SeatBlockReasonOther       SeatBlockReason = "other"
+)
+
+// SeatBlock marks a seat unavailable untuk satu schedule saja.
+// Kursi yang rusak permanen cukup di-set IsAvailable=false di level hall.
+type SeatBlock struct {
+	BaseSimple
+	ScheduleID uuid.UUID       `db:"schedule_id"`
+	SeatID     uuid.UUID       `db:"seat_id"`
+	Reason     SeatBlockReason `db:"reason"`
+	Note       *string         `db:"note"`
+	BlockedBy  *uuid.UUID      `db:"blocked_by"`
+}
@@ -14,4 +14,12 @@ type Session struct {
 	IPAddress *string    `db:"ip_address"`
 	ExpiresAt time.Time  `db:"expires_at"`
 	RevokedAt *time.Time `db:"revoked_at"`
+
+	// ImpersonatorID admin yang membuat session ini untuk bertindak sebagai UserID; nil untuk login biasa
+	ImpersonatorID *uuid.UUID `db:"impersonator_id"`
+}
+
+// IsImpersonation reports whether session dibuat admin lewat impersonation
+func (s *Session) IsImpersonation() bool {
+	return s.ImpersonatorID != nil
 }
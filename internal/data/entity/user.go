@@ -1,10 +1,27 @@
 package entity
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type UserRole string
 
 const (
 	RoleCustomer UserRole = "customer"
 	RoleAdmin    UserRole = "admin"
+	RoleStaff    UserRole = "staff" // akun cinema, mis. untuk membalas review
+)
+
+// UserTier level loyalty customer dari total belanja; dihitung ulang berkala, bukan diisi manual
+type UserTier string
+
+const (
+	TierStandard UserTier = "standard"
+	TierSilver   UserTier = "silver"
+	TierGold     UserTier = "gold"
+	TierPlatinum UserTier = "platinum"
 )
 
 type User struct {
@@ -16,4 +33,35 @@ type User struct {
 	Role          UserRole `db:"role"`
 	EmailVerified bool     `db:"email_verified"`
 	IsActive      bool     `db:"is_active"`
+	Language      string   `db:"language"` // bahasa notifikasi, "en" / "id"
+	Tier          UserTier `db:"tier"`
+
+	// DateOfBirth dipakai untuk cek klasifikasi usia film; nil kalau user belum mengisi
+	DateOfBirth *time.Time `db:"date_of_birth"`
+
+	// OrganizationID membatasi admin ke cinema milik chain-nya; nil untuk admin platform
+	OrganizationID *uuid.UUID `db:"organization_id"`
+}
+
+// IsPlatformAdmin reports whether user admin tanpa organization, boleh mengelola semua data
+func (u *User) IsPlatformAdmin() bool {
+	return u.Role == RoleAdmin && u.OrganizationID == nil
+}
+
+// IsStaff reports whether user boleh bertindak atas nama cinema; admin termasuk
+func (u *User) IsStaff() bool {
+	return u.Role == RoleStaff || u.Role == RoleAdmin
+}
+
+// AgeOn returns umur user (tahun penuh) pada tanggal at; ok false kalau tanggal lahir belum diisi
+func (u *User) AgeOn(at time.Time) (age int, ok bool) {
+	if u.DateOfBirth == nil {
+		return 0, false
+	}
+	dob := *u.DateOfBirth
+	age = at.Year() - dob.Year()
+	if at.Month() < dob.Month() || (at.Month() == dob.Month() && at.Day() < dob.Day()) {
+		age--
+	}
+	return age, true
 }
@@ -0,0 +1,18 @@
+package entity
+
+import "github.com/google/uuid"
+
+type DevicePlatform string
+
+const (
+	DevicePlatformAndroid DevicePlatform = "android"
+	DevicePlatformIOS     DevicePlatform = "ios"
+	DevicePlatformWeb     DevicePlatform = "web"
+)
+
+type UserDevice struct {
+	BaseNoDelete
+	UserID   uuid.UUID      `db:"user_id"`
+	Token    string         `db:"token"`
+	Platform DevicePlatform `db:"platform"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+)
+
+// UserBookingStats agregat booking satu user untuk halaman detail admin
+type UserBookingStats struct {
+	TotalBookings int64      `db:"total_bookings"`
+	Cancellations int64      `db:"cancellations"` // cancelled + refunded
+	LastBookingAt *time.Time `db:"last_booking_at"`
+}
+
+// UserSpend total belanja user dalam satu currency, minor unit. Booking bisa beda currency
+// per jadwal, jadi tidak dijumlahkan lintas currency.
+type UserSpend struct {
+	Currency string `db:"currency"`
+	Amount   int64  `db:"amount"`
+}
+
+// UserFavoriteGenre genre yang paling sering ditonton user beserta jumlah booking-nya
+type UserFavoriteGenre struct {
+	GenreID  string `db:"genre_id"`
+	Name     string `db:"name"`
+	Bookings int64  `db:"bookings"`
+}
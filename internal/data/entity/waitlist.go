@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting   WaitlistStatus = "waiting"
+	WaitlistStatusOffered   WaitlistStatus = "offered"
+	WaitlistStatusFulfilled WaitlistStatus = "fulfilled"
+	WaitlistStatusExpired   WaitlistStatus = "expired"
+	WaitlistStatusCancelled WaitlistStatus = "cancelled"
+)
+
+type WaitlistEntry struct {
+	BaseNoDelete
+	ScheduleID     uuid.UUID      `db:"schedule_id"`
+	UserID         uuid.UUID      `db:"user_id"`
+	SeatsRequested int            `db:"seats_requested"`
+	Status         WaitlistStatus `db:"status"`
+	OfferedAt      *time.Time     `db:"offered_at"`
+	HoldExpiresAt  *time.Time     `db:"hold_expires_at"`
+}
+
+// SeatHold reserves a freed seat for a waitlisted user sampai ExpiresAt
+type SeatHold struct {
+	BaseSimple
+	ScheduleID      uuid.UUID `db:"schedule_id"`
+	SeatID          uuid.UUID `db:"seat_id"`
+	UserID          uuid.UUID `db:"user_id"`
+	WaitlistEntryID uuid.UUID `db:"waitlist_entry_id"`
+	ExpiresAt       time.Time `db:"expires_at"`
+}
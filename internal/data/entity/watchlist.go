@@ -0,0 +1,9 @@
+package entity
+
+import "github.com/google/uuid"
+
+type WatchlistItem struct {
+	BaseSimple
+	UserID  uuid.UUID `db:"user_id"`
+	MovieID uuid.UUID `db:"movie_id"`
+}
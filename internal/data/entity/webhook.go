@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed" // attempts habis atau subscription sudah nonaktif
+)
+
+// WebhookSubscription is a partner endpoint untuk satu atau beberapa event type
+type WebhookSubscription struct {
+	Base
+	Name       string   `db:"name"`
+	URL        string   `db:"url"`
+	Secret     string   `db:"secret"`
+	EventTypes []string `db:"event_types"`
+	IsActive   bool     `db:"is_active"`
+}
+
+// WebhookDelivery is one event untuk satu subscription; Payload berisi events.Envelope apa adanya
+type WebhookDelivery struct {
+	BaseSimple
+	SubscriptionID uuid.UUID             `db:"subscription_id"`
+	EventID        uuid.UUID             `db:"event_id"`
+	EventType      string                `db:"event_type"`
+	Payload        []byte                `db:"payload"`
+	Status         WebhookDeliveryStatus `db:"status"`
+	Attempts       int                   `db:"attempts"`
+	NextAttemptAt  time.Time             `db:"next_attempt_at"`
+	LastAttemptAt  *time.Time            `db:"last_attempt_at"`
+	LastStatusCode *int                  `db:"last_status_code"`
+	LastError      *string               `db:"last_error"`
+	DeliveredAt    *time.Time            `db:"delivered_at"`
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ActivityRepository membaca timeline user langsung dari tabel sumber, tidak ada tabel activity terpisah
+type ActivityRepository interface {
+	// FindByUser urut terbaru dulu; activityType nil = semua jenis
+	FindByUser(ctx context.Context, userID uuid.UUID, activityType *entity.ActivityType, limit, offset int) ([]*entity.UserActivity, error)
+	CountByUser(ctx context.Context, userID uuid.UUID, activityType *entity.ActivityType) (int64, error)
+}
+
+type activityRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewActivityRepository(db database.PgxIface, log *zap.Logger) ActivityRepository {
+	return &activityRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "activity")),
+	}
+}
+
+// activityTimeline menggabungkan semua sumber untuk user $1, difilter jenis $2 (NULL = semua).
+// Payment memakai paid_at kalau ada, amount termasuk porsi gift card supaya sama dengan total booking.
+const activityTimeline = `
+	WITH timeline AS (
+		SELECT 'booking' AS type, b.id AS reference_id, b.order_id, m.title AS movie_title,
+		       b.status::text AS status, b.total_price AS amount, b.currency::text AS currency,
+		       NULL::int AS rating, NULL::text AS ip_address, NULL::text AS user_agent,
+		       b.created_at AS occurred_at
+		FROM bookings b
+		INNER JOIN schedules s ON s.id = b.schedule_id
+		LEFT JOIN movies m ON m.id = s.movie_id
+		WHERE b.user_id = $1 AND b.deleted_at IS NULL
+
+		UNION ALL
+
+		SELECT 'payment', p.id, b.order_id, m.title,
+		       p.status::text, p.amount + p.gift_card_amount, p.currency::text,
+		       NULL, NULL, NULL,
+		       COALESCE(p.paid_at, p.created_at)
+		FROM payments p
+		INNER JOIN bookings b ON b.id = p.booking_id
+		INNER JOIN schedules s ON s.id = b.schedule_id
+		LEFT JOIN movies m ON m.id = s.movie_id
+		WHERE b.user_id = $1
+
+		UNION ALL
+
+		SELECT 'review', r.id, NULL, m.title,
+		       NULL, NULL, NULL,
+		       r.rating, NULL, NULL,
+		       r.created_at
+		FROM reviews r
+		LEFT JOIN movies m ON m.id = r.movie_id
+		WHERE r.user_id = $1 AND r.deleted_at IS NULL
+
+		UNION ALL
+
+		-- Session impersonation admin tetap tampil supaya user bisa melihatnya, ditandai lewat status
+		SELECT 'login', se.id, NULL, NULL,
+		       CASE WHEN se.impersonator_id IS NOT NULL THEN 'impersonated' END, NULL, NULL,
+		       NULL, se.ip_address, se.user_agent,
+		       se.created_at
+		FROM sessions se
+		WHERE se.user_id = $1
+	)
+	SELECT %s FROM timeline WHERE ($2::text IS NULL OR type = $2::text)
+`
+
+func (r *activityRepository) FindByUser(ctx context.Context, userID uuid.UUID, activityType *entity.ActivityType, limit, offset int) ([]*entity.UserActivity, error) {
+	query := fmt.Sprintf(activityTimeline, `type, reference_id, order_id, movie_title, status, amount, currency,
+		rating, ip_address, user_agent, occurred_at`) + `
+		ORDER BY occurred_at DESC, reference_id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, userID, activityTypeArg(activityType), limit, offset)
+	if err != nil {
+		r.log.Error("Failed to find user activity",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find activity for user %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	var activities []*entity.UserActivity
+	for rows.Next() {
+		var activity entity.UserActivity
+		err := rows.Scan(
+			&activity.Type,
+			&activity.ReferenceID,
+			&activity.OrderID,
+			&activity.MovieTitle,
+			&activity.Status,
+			&activity.Amount,
+			&activity.Currency,
+			&activity.Rating,
+			&activity.IPAddress,
+			&activity.UserAgent,
+			&activity.OccurredAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan activity row", zap.Error(err))
+			return nil, fmt.Errorf("scan activity row: %w", err)
+		}
+		activities = append(activities, &activity)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return activities, nil
+}
+
+func (r *activityRepository) CountByUser(ctx context.Context, userID uuid.UUID, activityType *entity.ActivityType) (int64, error) {
+	query := fmt.Sprintf(activityTimeline, `COUNT(*)`)
+
+	var total int64
+	if err := r.db.Reader().QueryRow(ctx, query, userID, activityTypeArg(activityType)).Scan(&total); err != nil {
+		r.log.Error("Failed to count user activity",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return 0, fmt.Errorf("count activity for user %s: %w", userID.String(), err)
+	}
+
+	return total, nil
+}
+
+func activityTypeArg(activityType *entity.ActivityType) *string {
+	if activityType == nil {
+		return nil
+	}
+	value := string(*activityType)
+	return &value
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type AuditRepository interface {
+	Create(ctx context.Context, entry *entity.AuditLog) error
+	FindAll(ctx context.Context, filter AuditFilter, limit, offset int) ([]*entity.AuditLog, error)
+	CountAll(ctx context.Context, filter AuditFilter) (int64, error)
+}
+
+// AuditFilter untuk daftar audit log admin; nil field = tidak difilter
+type AuditFilter struct {
+	ActorID  *uuid.UUID
+	TargetID *uuid.UUID
+	Action   *string
+}
+
+func (f AuditFilter) args() []any {
+	return []any{f.ActorID, f.TargetID, f.Action}
+}
+
+const auditFilterSQL = `($1::uuid IS NULL OR actor_id = $1::uuid)
+		  AND ($2::uuid IS NULL OR target_id = $2::uuid)
+		  AND ($3::text IS NULL OR action = $3::text)`
+
+const auditLogColumns = `id, actor_id, action, target_type, target_id, metadata, ip_address, created_at`
+
+type auditRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewAuditRepository(db database.PgxIface, log *zap.Logger) AuditRepository {
+	return &auditRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "audit")),
+	}
+}
+
+func (r *auditRepository) Create(ctx context.Context, entry *entity.AuditLog) error {
+	query := `
+		INSERT INTO audit_logs (id, actor_id, action, target_type, target_id, metadata, ip_address, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	metadata := entry.Metadata
+	if len(metadata) == 0 {
+		metadata = []byte("{}")
+	}
+
+	_, err := r.db.Exec(ctx, query,
+		entry.ID,
+		entry.ActorID,
+		entry.Action,
+		entry.TargetType,
+		entry.TargetID,
+		metadata,
+		entry.IPAddress,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to create audit log",
+			zap.Error(err),
+			zap.String("action", entry.Action),
+			zap.String("actor_id", entry.ActorID.String()),
+		)
+		return fmt.Errorf("create audit log %s: %w", entry.Action, err)
+	}
+
+	return nil
+}
+
+func (r *auditRepository) FindAll(ctx context.Context, filter AuditFilter, limit, offset int) ([]*entity.AuditLog, error) {
+	query := `
+		SELECT ` + auditLogColumns + `
+		FROM audit_logs
+		WHERE ` + auditFilterSQL + `
+		ORDER BY created_at DESC, id
+		LIMIT $4 OFFSET $5
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, append(filter.args(), limit, offset)...)
+	if err != nil {
+		r.log.Error("Failed to find audit logs", zap.Error(err))
+		return nil, fmt.Errorf("find audit logs: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAuditLogs(rows)
+}
+
+func (r *auditRepository) CountAll(ctx context.Context, filter AuditFilter) (int64, error) {
+	query := `SELECT COUNT(*) FROM audit_logs WHERE ` + auditFilterSQL
+
+	var total int64
+	if err := r.db.Reader().QueryRow(ctx, query, filter.args()...).Scan(&total); err != nil {
+		r.log.Error("Failed to count audit logs", zap.Error(err))
+		return 0, fmt.Errorf("count audit logs: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *auditRepository) scanAuditLogs(rows pgx.Rows) ([]*entity.AuditLog, error) {
+	entries := []*entity.AuditLog{}
+	for rows.Next() {
+		var entry entity.AuditLog
+		err := rows.Scan(
+			&entry.ID,
+			&entry.ActorID,
+			&entry.Action,
+			&entry.TargetType,
+			&entry.TargetID,
+			&entry.Metadata,
+			&entry.IPAddress,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan audit log row", zap.Error(err))
+			return nil, fmt.Errorf("scan audit log row: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate audit log rows: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type BannerRepository interface {
+	Create(ctx context.Context, banner *entity.Banner) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Banner, error)
+	// FindAll includes banner terjadwal, nonaktif dan yang sudah lewat, untuk admin
+	FindAll(ctx context.Context) ([]*entity.Banner, error)
+	// FindLive returns banner yang tampil pada now. City kosong berarti semua banner live;
+	// kalau diisi, hanya banner untuk kota itu plus banner tanpa kota.
+	FindLive(ctx context.Context, now time.Time, city string) ([]*entity.Banner, error)
+	Update(ctx context.Context, banner *entity.Banner) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+const bannerColumns = `id, title, image_url, link_url, city, starts_at, ends_at, sort_order, is_active,
+		created_at, updated_at`
+
+type bannerRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewBannerRepository(db database.PgxIface, log *zap.Logger) BannerRepository {
+	return &bannerRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "banner")),
+	}
+}
+
+func (r *bannerRepository) Create(ctx context.Context, banner *entity.Banner) error {
+	query := `
+		INSERT INTO banners (id, title, image_url, link_url, city, starts_at, ends_at, sort_order, is_active,
+		                     created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		banner.ID,
+		banner.Title,
+		banner.ImageURL,
+		banner.LinkURL,
+		banner.City,
+		banner.StartsAt,
+		banner.EndsAt,
+		banner.SortOrder,
+		banner.IsActive,
+		banner.CreatedAt,
+		banner.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to create banner",
+			zap.Error(err),
+			zap.String("title", banner.Title),
+		)
+		return fmt.Errorf("create banner %s: %w", banner.Title, err)
+	}
+
+	return nil
+}
+
+func (r *bannerRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Banner, error) {
+	query := `
+		SELECT ` + bannerColumns + `
+		FROM banners
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	banner, err := scanBanner(r.db.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find banner by ID",
+			zap.Error(err),
+			zap.String("banner_id", id.String()),
+		)
+		return nil, fmt.Errorf("find banner by ID %s: %w", id.String(), err)
+	}
+
+	return banner, nil
+}
+
+func (r *bannerRepository) FindAll(ctx context.Context) ([]*entity.Banner, error) {
+	query := `
+		SELECT ` + bannerColumns + `
+		FROM banners
+		WHERE deleted_at IS NULL
+		ORDER BY starts_at DESC, sort_order, id
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find banners", zap.Error(err))
+		return nil, fmt.Errorf("find banners: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanBanners(rows)
+}
+
+func (r *bannerRepository) FindLive(ctx context.Context, now time.Time, city string) ([]*entity.Banner, error) {
+	query := `
+		SELECT ` + bannerColumns + `
+		FROM banners
+		WHERE is_active = TRUE AND deleted_at IS NULL
+		  AND starts_at <= $1 AND (ends_at IS NULL OR ends_at > $1)
+		  AND ($2 = '' OR city IS NULL OR LOWER(city) = LOWER($2))
+		ORDER BY sort_order, starts_at DESC, id
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, now, city)
+	if err != nil {
+		r.log.Error("Failed to find live banners",
+			zap.Error(err),
+			zap.String("city", city),
+		)
+		return nil, fmt.Errorf("find live banners: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanBanners(rows)
+}
+
+func (r *bannerRepository) Update(ctx context.Context, banner *entity.Banner) error {
+	query := `
+		UPDATE banners
+		SET title = $2, image_url = $3, link_url = $4, city = $5, starts_at = $6, ends_at = $7,
+		    sort_order = $8, is_active = $9, updated_at = $10
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		banner.ID,
+		banner.Title,
+		banner.ImageURL,
+		banner.LinkURL,
+		banner.City,
+		banner.StartsAt,
+		banner.EndsAt,
+		banner.SortOrder,
+		banner.IsActive,
+		banner.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to update banner",
+			zap.Error(err),
+			zap.String("banner_id", banner.ID.String()),
+		)
+		return fmt.Errorf("update banner %s: %w", banner.ID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("banner %s not found", banner.ID.String())
+	}
+
+	return nil
+}
+
+func (r *bannerRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE banners SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to delete banner",
+			zap.Error(err),
+			zap.String("banner_id", id.String()),
+		)
+		return fmt.Errorf("delete banner %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("banner %s not found", id.String())
+	}
+
+	r.log.Info("Banner deleted", zap.String("banner_id", id.String()))
+	return nil
+}
+
+func scanBanner(row pgx.Row) (*entity.Banner, error) {
+	var banner entity.Banner
+	err := row.Scan(
+		&banner.ID,
+		&banner.Title,
+		&banner.ImageURL,
+		&banner.LinkURL,
+		&banner.City,
+		&banner.StartsAt,
+		&banner.EndsAt,
+		&banner.SortOrder,
+		&banner.IsActive,
+		&banner.CreatedAt,
+		&banner.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &banner, nil
+}
+
+func (r *bannerRepository) scanBanners(rows pgx.Rows) ([]*entity.Banner, error) {
+	banners := []*entity.Banner{}
+	for rows.Next() {
+		banner, err := scanBanner(rows)
+		if err != nil {
+			r.log.Error("Failed to scan banner row", zap.Error(err))
+			return nil, fmt.Errorf("scan banner row: %w", err)
+		}
+		banners = append(banners, banner)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate banner rows: %w", err)
+	}
+
+	return banners, nil
+}
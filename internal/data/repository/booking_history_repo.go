@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BookingHistoryRepository riwayat perpindahan status booking, append-only
+type BookingHistoryRepository interface {
+	Create(ctx context.Context, change *entity.BookingStatusChange) error
+	// FindByBookingID returns riwayat status booking, terlama dulu
+	FindByBookingID(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingStatusChange, error)
+}
+
+type bookingHistoryRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewBookingHistoryRepository(db database.PgxIface, log *zap.Logger) BookingHistoryRepository {
+	return &bookingHistoryRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "booking_history")),
+	}
+}
+
+func (r *bookingHistoryRepository) Create(ctx context.Context, change *entity.BookingStatusChange) error {
+	query := `
+		INSERT INTO booking_status_history (id, booking_id, from_status, to_status, actor_id, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		change.ID,
+		change.BookingID,
+		change.FromStatus,
+		change.ToStatus,
+		change.ActorID,
+		change.Reason,
+		change.CreatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to record booking status change",
+			zap.Error(err),
+			zap.String("booking_id", change.BookingID.String()),
+			zap.String("to_status", string(change.ToStatus)),
+		)
+		return fmt.Errorf("record status change for booking %s: %w", change.BookingID.String(), err)
+	}
+
+	return nil
+}
+
+func (r *bookingHistoryRepository) FindByBookingID(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingStatusChange, error) {
+	query := `
+		SELECT id, booking_id, from_status, to_status, actor_id, reason, created_at
+		FROM booking_status_history
+		WHERE booking_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, bookingID)
+	if err != nil {
+		r.log.Error("Failed to find booking status history",
+			zap.Error(err),
+			zap.String("booking_id", bookingID.String()),
+		)
+		return nil, fmt.Errorf("find status history for booking %s: %w", bookingID.String(), err)
+	}
+	defer rows.Close()
+
+	history := []*entity.BookingStatusChange{}
+	for rows.Next() {
+		var change entity.BookingStatusChange
+		err := rows.Scan(
+			&change.ID,
+			&change.BookingID,
+			&change.FromStatus,
+			&change.ToStatus,
+			&change.ActorID,
+			&change.Reason,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan booking status history row", zap.Error(err))
+			return nil, fmt.Errorf("scan booking status history row: %w", err)
+		}
+		history = append(history, &change)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate booking status history rows: %w", err)
+	}
+
+	return history, nil
+}
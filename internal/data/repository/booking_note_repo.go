@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BookingNoteRepository catatan internal dan flag support per booking
+type BookingNoteRepository interface {
+	CreateNote(ctx context.Context, note *entity.BookingNote) error
+	// FindNotes returns catatan booking, terlama dulu seperti thread
+	FindNotes(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingNote, error)
+
+	// ReplaceFlags sets flag booking persis menjadi flags; flag yang sudah ada tetap menyimpan created_by lamanya.
+	// Dua statement, panggil di dalam WithTx.
+	ReplaceFlags(ctx context.Context, bookingID uuid.UUID, flags []entity.BookingFlag, createdBy uuid.UUID) error
+	// FindFlags returns flag per booking untuk satu halaman list; booking tanpa flag tidak ada di map
+	FindFlags(ctx context.Context, bookingIDs []uuid.UUID) (map[uuid.UUID][]entity.BookingFlag, error)
+}
+
+type bookingNoteRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewBookingNoteRepository(db database.PgxIface, log *zap.Logger) BookingNoteRepository {
+	return &bookingNoteRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "booking_note")),
+	}
+}
+
+func (r *bookingNoteRepository) CreateNote(ctx context.Context, note *entity.BookingNote) error {
+	query := `
+		INSERT INTO booking_notes (id, booking_id, author_id, body, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.Exec(ctx, query, note.ID, note.BookingID, note.AuthorID, note.Body, note.CreatedAt)
+	if err != nil {
+		r.log.Error("Failed to create booking note",
+			zap.Error(err),
+			zap.String("booking_id", note.BookingID.String()),
+		)
+		return fmt.Errorf("create note for booking %s: %w", note.BookingID.String(), err)
+	}
+
+	return nil
+}
+
+func (r *bookingNoteRepository) FindNotes(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingNote, error) {
+	query := `
+		SELECT id, booking_id, author_id, body, created_at
+		FROM booking_notes
+		WHERE booking_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, bookingID)
+	if err != nil {
+		r.log.Error("Failed to find booking notes",
+			zap.Error(err),
+			zap.String("booking_id", bookingID.String()),
+		)
+		return nil, fmt.Errorf("find notes for booking %s: %w", bookingID.String(), err)
+	}
+	defer rows.Close()
+
+	notes := []*entity.BookingNote{}
+	for rows.Next() {
+		var note entity.BookingNote
+		if err := rows.Scan(&note.ID, &note.BookingID, &note.AuthorID, &note.Body, &note.CreatedAt); err != nil {
+			r.log.Error("Failed to scan booking note row", zap.Error(err))
+			return nil, fmt.Errorf("scan booking note row: %w", err)
+		}
+		notes = append(notes, &note)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate booking note rows: %w", err)
+	}
+
+	return notes, nil
+}
+
+func (r *bookingNoteRepository) ReplaceFlags(ctx context.Context, bookingID uuid.UUID, flags []entity.BookingFlag, createdBy uuid.UUID) error {
+	values := make([]string, len(flags))
+	for i, flag := range flags {
+		values[i] = string(flag)
+	}
+
+	_, err := r.db.Exec(ctx, `DELETE FROM booking_flags WHERE booking_id = $1 AND flag <> ALL($2::text[])`, bookingID, values)
+	if err != nil {
+		r.log.Error("Failed to remove booking flags",
+			zap.Error(err),
+			zap.String("booking_id", bookingID.String()),
+		)
+		return fmt.Errorf("remove flags for booking %s: %w", bookingID.String(), err)
+	}
+
+	query := `
+		INSERT INTO booking_flags (booking_id, flag, created_by, created_at)
+		SELECT $1, flag, $3, NOW() FROM unnest($2::text[]) AS flag
+		ON CONFLICT (booking_id, flag) DO NOTHING
+	`
+
+	if _, err := r.db.Exec(ctx, query, bookingID, values, createdBy); err != nil {
+		r.log.Error("Failed to add booking flags",
+			zap.Error(err),
+			zap.String("booking_id", bookingID.String()),
+		)
+		return fmt.Errorf("add flags for booking %s: %w", bookingID.String(), err)
+	}
+
+	return nil
+}
+
+func (r *bookingNoteRepository) FindFlags(ctx context.Context, bookingIDs []uuid.UUID) (map[uuid.UUID][]entity.BookingFlag, error) {
+	flags := make(map[uuid.UUID][]entity.BookingFlag)
+	if len(bookingIDs) == 0 {
+		return flags, nil
+	}
+
+	query := `
+		SELECT booking_id, flag
+		FROM booking_flags
+		WHERE booking_id = ANY($1)
+		ORDER BY booking_id, flag
+	`
+
+	rows, err := r.db.Query(ctx, query, bookingIDs)
+	if err != nil {
+		r.log.Error("Failed to find booking flags", zap.Error(err), zap.Int("bookings", len(bookingIDs)))
+		return nil, fmt.Errorf("find booking flags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			bookingID uuid.UUID
+			flag      entity.BookingFlag
+		)
+		if err := rows.Scan(&bookingID, &flag); err != nil {
+			r.log.Error("Failed to scan booking flag row", zap.Error(err))
+			return nil, fmt.Errorf("scan booking flag row: %w", err)
+		}
+		flags[bookingID] = append(flags[bookingID], flag)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate booking flag rows: %w", err)
+	}
+
+	return flags, nil
+}
@@ -3,9 +3,11 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/pkg/database"
+	"cinema-booking/pkg/utils"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,16 +17,71 @@ import (
 type BookingRepository interface {
 	Create(ctx context.Context, booking *entity.Booking) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Booking, error)
+	// FindByIDForUpdate row-locks the booking, hanya berguna di dalam WithTx
+	FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.Booking, error)
 	FindByOrderID(ctx context.Context, orderID string) (*entity.Booking, error)
-	FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.Booking, error)
-	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID, filter BookingFilter, limit, offset int) ([]*entity.Booking, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID, filter BookingFilter) (int64, error)
+	FindByUserIDAfter(ctx context.Context, userID uuid.UUID, filter BookingFilter, cursor *Cursor, limit int) ([]*entity.Booking, error)
+	FindNextUpcomingByUserID(ctx context.Context, userID uuid.UUID) (*entity.Booking, error)
+	// FindAll, FindAllAfter dan CountAll untuk list admin
+	FindAll(ctx context.Context, filter AdminBookingFilter, limit, offset int) ([]*entity.Booking, error)
+	FindAllAfter(ctx context.Context, filter AdminBookingFilter, cursor *Cursor, limit int) ([]*entity.Booking, error)
+	CountAll(ctx context.Context, filter AdminBookingFilter) (int64, error)
 	Update(ctx context.Context, booking *entity.Booking) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) error
 
 	// Business queries
 	FindByScheduleID(ctx context.Context, scheduleID uuid.UUID) ([]*entity.Booking, error)
 	FindConfirmedByScheduleID(ctx context.Context, scheduleID uuid.UUID) ([]*entity.Booking, error)
-	UpdateStatus(ctx context.Context, bookingID uuid.UUID, status entity.BookingStatus) error
+	// UpdateStatus hanya jalan kalau booking.Version masih cocok; booking.Status di memory tidak disentuh.
+	// Dipanggil lewat transitionBooking di usecase supaya transisinya divalidasi dan tercatat.
+	UpdateStatus(ctx context.Context, booking *entity.Booking, status entity.BookingStatus) error
+	// UpdateCharges menyimpan fee, pajak dan total yang dihitung ulang saat bayar, dengan cek version yang sama
+	UpdateCharges(ctx context.Context, booking *entity.Booking) error
+
+	// Show reminders
+	FindPendingReminders(ctx context.Context, from, to time.Time) ([]*entity.Booking, error)
+	MarkReminderSent(ctx context.Context, bookingID uuid.UUID) error
+}
+
+// AdminBookingFilter untuk list booking admin; OrganizationID nil = semua cinema, Flag nil = semua booking
+type AdminBookingFilter struct {
+	OrganizationID *uuid.UUID
+	Flag           *entity.BookingFlag
+}
+
+// adminBookingFilterSQL expects organization dan flag args di $argN dan $argN+1
+func adminBookingFilterSQL(argN int) string {
+	return organizationScheduleSQL("bookings.schedule_id", argN) + fmt.Sprintf(`
+		  AND ($%[1]d::text IS NULL OR EXISTS (
+			SELECT 1 FROM booking_flags bf WHERE bf.booking_id = bookings.id AND bf.flag = $%[1]d::text))`, argN+1)
+}
+
+// BookingFilter narrows booking history; nil field = tidak difilter
+type BookingFilter struct {
+	Status       *entity.BookingStatus
+	Upcoming     *bool // true = show belum mulai, false = show sudah lewat
+	ShowDateFrom *time.Time
+	ShowDateTo   *time.Time
+}
+
+// bookingFilterSQL expects schedules joined as s and filter args at $2..$5
+const bookingFilterSQL = `
+		  AND ($2::text IS NULL OR b.status = $2::text)
+		  AND ($3::boolean IS NULL OR (s.starts_at >= NOW()) = $3::boolean)
+		  AND ($4::date IS NULL OR s.show_date >= $4::date)
+		  AND ($5::date IS NULL OR s.show_date <= $5::date)`
+
+// args returns the filter values in bookingFilterSQL order
+func (f BookingFilter) args() []any {
+	var status *string
+	if f.Status != nil {
+		value := string(*f.Status)
+		status = &value
+	}
+	return []any{status, f.Upcoming, f.ShowDateFrom, f.ShowDateTo}
 }
 
 type bookingRepository struct {
@@ -39,42 +96,84 @@ func NewBookingRepository(db database.PgxIface, log *zap.Logger) BookingReposito
 	}
 }
 
+// maxOrderIDAttempts batas acak ulang order ID yang bentrok; dengan 50 bit acak per hari
+// bentrok kedua kali berturut-turut praktis tidak mungkin, jadi habisnya percobaan berarti ada bug
+const maxOrderIDAttempts = 5
+
+// Create inserts booking. Kalau order ID sudah dipakai, bagian acaknya diganti lalu dicoba lagi;
+// booking.OrderID berisi nomor yang akhirnya tersimpan. ON CONFLICT dipakai (bukan menangkap
+// unique violation) supaya transaction pemanggil tidak ikut batal.
 func (r *bookingRepository) Create(ctx context.Context, booking *entity.Booking) error {
 	query := `
-		INSERT INTO bookings (id, order_id, user_id, schedule_id, total_seats, total_price, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO bookings (id, order_id, user_id, schedule_id, total_seats, total_price, status,
+		                      base_price, fee_amount, tax_amount, discount_amount, currency, created_at, updated_at, version,
+		                      customer_tier)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		ON CONFLICT (order_id) DO NOTHING
 	`
 
-	_, err := r.db.Exec(ctx, query,
-		booking.ID,
-		booking.OrderID,
-		booking.UserID,
-		booking.ScheduleID,
-		booking.TotalSeats,
-		booking.TotalPrice,
-		booking.Status,
-		booking.CreatedAt,
-		booking.UpdatedAt,
-	)
+	booking.Version = 1
+	for attempt := 1; ; attempt++ {
+		result, err := r.db.Exec(ctx, query,
+			booking.ID,
+			booking.OrderID,
+			booking.UserID,
+			booking.ScheduleID,
+			booking.TotalSeats,
+			booking.TotalPrice,
+			booking.Status,
+			booking.BasePrice,
+			booking.FeeAmount,
+			booking.TaxAmount,
+			booking.DiscountAmount,
+			booking.Currency,
+			booking.CreatedAt,
+			booking.UpdatedAt,
+			booking.Version,
+			booking.CustomerTier,
+		)
 
-	if err != nil {
-		r.log.Error("Failed to create booking",
-			zap.Error(err),
+		if err != nil {
+			r.log.Error("Failed to create booking",
+				zap.Error(err),
+				zap.String("order_id", booking.OrderID),
+				zap.String("user_id", booking.UserID.String()),
+			)
+			return fmt.Errorf("create booking %s: %w", booking.OrderID, err)
+		}
+		if result.RowsAffected() == 1 {
+			return nil
+		}
+
+		r.log.Warn("Order ID collision, regenerating",
 			zap.String("order_id", booking.OrderID),
-			zap.String("user_id", booking.UserID.String()),
+			zap.Int("attempt", attempt),
 		)
-		return fmt.Errorf("create booking %s: %w", booking.OrderID, err)
+		if attempt == maxOrderIDAttempts {
+			return fmt.Errorf("create booking: order ID still taken after %d attempts", attempt)
+		}
+		booking.OrderID = utils.RerollOrderID(booking.OrderID)
 	}
-
-	return nil
 }
 
 func (r *bookingRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Booking, error) {
+	return r.findByID(ctx, id, false)
+}
+
+func (r *bookingRepository) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.Booking, error) {
+	return r.findByID(ctx, id, true)
+}
+
+func (r *bookingRepository) findByID(ctx context.Context, id uuid.UUID, forUpdate bool) (*entity.Booking, error) {
 	query := `
-		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status, created_at, updated_at
+		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status,
+		       base_price, fee_amount, tax_amount, discount_amount, currency, created_at, updated_at, version, customer_tier
 		FROM bookings
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
 
 	var booking entity.Booking
 	err := r.db.QueryRow(ctx, query, id).Scan(
@@ -85,8 +184,15 @@ func (r *bookingRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity
 		&booking.TotalSeats,
 		&booking.TotalPrice,
 		&booking.Status,
+		&booking.BasePrice,
+		&booking.FeeAmount,
+		&booking.TaxAmount,
+		&booking.DiscountAmount,
+		&booking.Currency,
 		&booking.CreatedAt,
 		&booking.UpdatedAt,
+		&booking.Version,
+		&booking.CustomerTier,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -105,9 +211,10 @@ func (r *bookingRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity
 
 func (r *bookingRepository) FindByOrderID(ctx context.Context, orderID string) (*entity.Booking, error) {
 	query := `
-		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status, created_at, updated_at
+		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status,
+		       base_price, fee_amount, tax_amount, discount_amount, currency, created_at, updated_at, version, customer_tier
 		FROM bookings
-		WHERE order_id = $1
+		WHERE order_id = $1 AND deleted_at IS NULL
 	`
 
 	var booking entity.Booking
@@ -119,8 +226,15 @@ func (r *bookingRepository) FindByOrderID(ctx context.Context, orderID string) (
 		&booking.TotalSeats,
 		&booking.TotalPrice,
 		&booking.Status,
+		&booking.BasePrice,
+		&booking.FeeAmount,
+		&booking.TaxAmount,
+		&booking.DiscountAmount,
+		&booking.Currency,
 		&booking.CreatedAt,
 		&booking.UpdatedAt,
+		&booking.Version,
+		&booking.CustomerTier,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -137,16 +251,19 @@ func (r *bookingRepository) FindByOrderID(ctx context.Context, orderID string) (
 	return &booking, nil
 }
 
-func (r *bookingRepository) FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.Booking, error) {
+func (r *bookingRepository) FindByUserID(ctx context.Context, userID uuid.UUID, filter BookingFilter, limit, offset int) ([]*entity.Booking, error) {
 	query := `
-		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status, created_at, updated_at
-		FROM bookings
-		WHERE user_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
+		SELECT b.id, b.order_id, b.user_id, b.schedule_id, b.total_seats, b.total_price, b.status,
+		       b.base_price, b.fee_amount, b.tax_amount, b.discount_amount, b.currency, b.created_at, b.updated_at, b.version, b.customer_tier
+		FROM bookings b
+		INNER JOIN schedules s ON s.id = b.schedule_id
+		WHERE b.user_id = $1 AND b.deleted_at IS NULL` + bookingFilterSQL + `
+		ORDER BY b.created_at DESC, b.id DESC
+		LIMIT $6 OFFSET $7
 	`
 
-	rows, err := r.db.Query(ctx, query, userID, limit, offset)
+	args := append([]any{userID}, filter.args()...)
+	rows, err := r.db.Query(ctx, query, append(args, limit, offset)...)
 	if err != nil {
 		r.log.Error("Failed to find bookings by user ID",
 			zap.Error(err),
@@ -158,35 +275,18 @@ func (r *bookingRepository) FindByUserID(ctx context.Context, userID uuid.UUID,
 	}
 	defer rows.Close()
 
-	var bookings []*entity.Booking
-	for rows.Next() {
-		var booking entity.Booking
-		err := rows.Scan(
-			&booking.ID,
-			&booking.OrderID,
-			&booking.UserID,
-			&booking.ScheduleID,
-			&booking.TotalSeats,
-			&booking.TotalPrice,
-			&booking.Status,
-			&booking.CreatedAt,
-			&booking.UpdatedAt,
-		)
-		if err != nil {
-			r.log.Error("Failed to scan booking row", zap.Error(err))
-			return nil, fmt.Errorf("scan booking row: %w", err)
-		}
-		bookings = append(bookings, &booking)
-	}
-
-	return bookings, nil
+	return r.scanBookings(rows)
 }
 
-func (r *bookingRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
-	query := `SELECT COUNT(*) FROM bookings WHERE user_id = $1`
+func (r *bookingRepository) CountByUserID(ctx context.Context, userID uuid.UUID, filter BookingFilter) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM bookings b
+		INNER JOIN schedules s ON s.id = b.schedule_id
+		WHERE b.user_id = $1 AND b.deleted_at IS NULL` + bookingFilterSQL
 
 	var count int64
-	err := r.db.QueryRow(ctx, query, userID).Scan(&count)
+	err := r.db.QueryRow(ctx, query, append([]any{userID}, filter.args()...)...).Scan(&count)
 	if err != nil {
 		r.log.Error("Failed to count bookings by user ID",
 			zap.Error(err),
@@ -198,12 +298,156 @@ func (r *bookingRepository) CountByUserID(ctx context.Context, userID uuid.UUID)
 	return count, nil
 }
 
+// FindByUserIDAfter is the keyset variant of FindByUserID, stable under concurrent inserts
+func (r *bookingRepository) FindByUserIDAfter(ctx context.Context, userID uuid.UUID, filter BookingFilter, cursor *Cursor, limit int) ([]*entity.Booking, error) {
+	query := `
+		SELECT b.id, b.order_id, b.user_id, b.schedule_id, b.total_seats, b.total_price, b.status,
+		       b.base_price, b.fee_amount, b.tax_amount, b.discount_amount, b.currency, b.created_at, b.updated_at, b.version, b.customer_tier
+		FROM bookings b
+		INNER JOIN schedules s ON s.id = b.schedule_id
+		WHERE b.user_id = $1 AND b.deleted_at IS NULL` + bookingFilterSQL + `
+		  AND ($6::timestamp IS NULL OR (b.created_at, b.id) < ($6::timestamp, $7::uuid))
+		ORDER BY b.created_at DESC, b.id DESC
+		LIMIT $8
+	`
+
+	createdAt, id := cursorArgs(cursor)
+	args := append([]any{userID}, filter.args()...)
+	rows, err := r.db.Query(ctx, query, append(args, createdAt, id, limit)...)
+	if err != nil {
+		r.log.Error("Failed to find bookings by user ID after cursor",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+			zap.Int("limit", limit),
+		)
+		return nil, fmt.Errorf("find bookings by user ID %s after cursor: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanBookings(rows)
+}
+
+// FindNextUpcomingByUserID returns booking aktif (pending/confirmed) dengan show terdekat
+func (r *bookingRepository) FindNextUpcomingByUserID(ctx context.Context, userID uuid.UUID) (*entity.Booking, error) {
+	query := `
+		SELECT b.id, b.order_id, b.user_id, b.schedule_id, b.total_seats, b.total_price, b.status,
+		       b.base_price, b.fee_amount, b.tax_amount, b.discount_amount, b.currency, b.created_at, b.updated_at, b.version, b.customer_tier
+		FROM bookings b
+		JOIN schedules s ON s.id = b.schedule_id
+		WHERE b.user_id = $1 AND b.deleted_at IS NULL
+		  AND b.status IN ('pending', 'confirmed', 'checked_in')
+		  AND s.starts_at >= NOW()
+		ORDER BY s.starts_at
+		LIMIT 1
+	`
+
+	var booking entity.Booking
+	err := r.db.QueryRow(ctx, query, userID).Scan(
+		&booking.ID,
+		&booking.OrderID,
+		&booking.UserID,
+		&booking.ScheduleID,
+		&booking.TotalSeats,
+		&booking.TotalPrice,
+		&booking.Status,
+		&booking.BasePrice,
+		&booking.FeeAmount,
+		&booking.TaxAmount,
+		&booking.DiscountAmount,
+		&booking.Currency,
+		&booking.CreatedAt,
+		&booking.UpdatedAt,
+		&booking.Version,
+		&booking.CustomerTier,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find next upcoming booking",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find next upcoming booking for user %s: %w", userID.String(), err)
+	}
+
+	return &booking, nil
+}
+
+func (r *bookingRepository) FindAll(ctx context.Context, filter AdminBookingFilter, limit, offset int) ([]*entity.Booking, error) {
+	query := `
+		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status,
+		       base_price, fee_amount, tax_amount, discount_amount, currency, created_at, updated_at, version, customer_tier
+		FROM bookings
+		WHERE deleted_at IS NULL AND ` + adminBookingFilterSQL(3) + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.Query(ctx, query, limit, offset, filter.OrganizationID, filter.Flag)
+	if err != nil {
+		r.log.Error("Failed to find all bookings",
+			zap.Error(err),
+			zap.Int("limit", limit),
+			zap.Int("offset", offset),
+		)
+		return nil, fmt.Errorf("find all bookings limit %d offset %d: %w", limit, offset, err)
+	}
+	defer rows.Close()
+
+	return r.scanBookings(rows)
+}
+
+func (r *bookingRepository) FindAllAfter(ctx context.Context, filter AdminBookingFilter, cursor *Cursor, limit int) ([]*entity.Booking, error) {
+	query := `
+		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status,
+		       base_price, fee_amount, tax_amount, discount_amount, currency, created_at, updated_at, version, customer_tier
+		FROM bookings
+		WHERE deleted_at IS NULL
+		  AND ($1::timestamp IS NULL OR (created_at, id) < ($1::timestamp, $2::uuid))
+		  AND ` + adminBookingFilterSQL(4) + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3
+	`
+
+	createdAt, id := cursorArgs(cursor)
+	rows, err := r.db.Query(ctx, query, createdAt, id, limit, filter.OrganizationID, filter.Flag)
+	if err != nil {
+		r.log.Error("Failed to find all bookings after cursor",
+			zap.Error(err),
+			zap.Int("limit", limit),
+		)
+		return nil, fmt.Errorf("find all bookings after cursor: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanBookings(rows)
+}
+
+func (r *bookingRepository) CountAll(ctx context.Context, filter AdminBookingFilter) (int64, error) {
+	query := `SELECT COUNT(*) FROM bookings WHERE deleted_at IS NULL AND ` + adminBookingFilterSQL(1)
+
+	var count int64
+	if err := r.db.QueryRow(ctx, query, filter.OrganizationID, filter.Flag).Scan(&count); err != nil {
+		r.log.Error("Failed to count all bookings", zap.Error(err))
+		return 0, fmt.Errorf("count all bookings: %w", err)
+	}
+
+	return count, nil
+}
+
+// Update writes the booking kalau version-nya masih sama dengan yang dibaca; kalau tidak,
+// *ConflictError dikembalikan. Sukses menaikkan booking.Version. Status tidak ikut ditulis,
+// perubahan status hanya lewat UpdateStatus.
 func (r *bookingRepository) Update(ctx context.Context, booking *entity.Booking) error {
 	query := `
 		UPDATE bookings
 		SET order_id = $2, user_id = $3, schedule_id = $4, total_seats = $5, 
-		    total_price = $6, status = $7, updated_at = $8
-		WHERE id = $1
+		    total_price = $6, base_price = $7, fee_amount = $8,
+		    tax_amount = $9, discount_amount = $10, currency = $11, updated_at = $12,
+		    version = version + 1
+		WHERE id = $1 AND version = $13 AND deleted_at IS NULL
 	`
 
 	result, err := r.db.Exec(ctx, query,
@@ -213,8 +457,13 @@ func (r *bookingRepository) Update(ctx context.Context, booking *entity.Booking)
 		booking.ScheduleID,
 		booking.TotalSeats,
 		booking.TotalPrice,
-		booking.Status,
+		booking.BasePrice,
+		booking.FeeAmount,
+		booking.TaxAmount,
+		booking.DiscountAmount,
+		booking.Currency,
 		booking.UpdatedAt,
+		booking.Version,
 	)
 
 	if err != nil {
@@ -226,16 +475,32 @@ func (r *bookingRepository) Update(ctx context.Context, booking *entity.Booking)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("booking %s not found", booking.ID.String())
+		return versionMiss(ctx, r.db, "bookings", "booking", booking.ID)
 	}
 
+	booking.Version++
 	return nil
 }
 
+// Delete soft deletes booking sekaligus melepas kursinya (dan counter schedule) dalam satu statement
 func (r *bookingRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM bookings WHERE id = $1`
+	query := `
+		WITH deleted AS (
+			UPDATE bookings SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
+			RETURNING id
+		), released AS (
+			UPDATE booking_seats SET released_at = NOW()
+			WHERE booking_id IN (SELECT id FROM deleted) AND released_at IS NULL
+			RETURNING schedule_id
+		), counted AS (
+			UPDATE schedules SET booked_seats = booked_seats - (SELECT COUNT(*) FROM released)
+			WHERE id IN (SELECT schedule_id FROM released)
+		)
+		SELECT COUNT(*) FROM deleted
+	`
 
-	result, err := r.db.Exec(ctx, query, id)
+	var affected int
+	err := r.db.QueryRow(ctx, query, id).Scan(&affected)
 	if err != nil {
 		r.log.Error("Failed to delete booking",
 			zap.Error(err),
@@ -244,19 +509,62 @@ func (r *bookingRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("delete booking %s: %w", id.String(), err)
 	}
 
-	if result.RowsAffected() == 0 {
+	if affected == 0 {
 		return fmt.Errorf("booking %s not found", id.String())
 	}
 
-	r.log.Info("Booking deleted", zap.String("booking_id", id.String()))
+	r.log.Info("Booking soft deleted", zap.String("booking_id", id.String()))
+	return nil
+}
+
+// Restore mengembalikan booking yang di-soft delete. Kursinya ikut diklaim ulang kalau statusnya
+// masih memegang kursi; gagal kalau kursi itu sudah terjual ke booking lain selama terhapus.
+func (r *bookingRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `
+		WITH restored AS (
+			UPDATE bookings SET deleted_at = NULL, updated_at = NOW(), version = version + 1
+			WHERE id = $1 AND deleted_at IS NOT NULL
+			RETURNING id, status
+		), reclaimed AS (
+			UPDATE booking_seats bs SET released_at = NULL
+			FROM restored
+			WHERE bs.booking_id = restored.id
+			  AND restored.status::text IN ('pending', 'confirmed', 'checked_in')
+			RETURNING bs.schedule_id
+		), counted AS (
+			UPDATE schedules SET booked_seats = booked_seats + (SELECT COUNT(*) FROM reclaimed)
+			WHERE id IN (SELECT schedule_id FROM reclaimed)
+		)
+		SELECT COUNT(*) FROM restored
+	`
+
+	var affected int
+	err := r.db.QueryRow(ctx, query, id).Scan(&affected)
+	if isUniqueViolation(err, bookingSeatActiveIndex) {
+		return fmt.Errorf("cannot restore booking %s: its seats are already booked by another booking", id.String())
+	}
+	if err != nil {
+		r.log.Error("Failed to restore booking",
+			zap.Error(err),
+			zap.String("booking_id", id.String()),
+		)
+		return fmt.Errorf("restore booking %s: %w", id.String(), err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("booking %s not found or not deleted", id.String())
+	}
+
+	r.log.Info("Booking restored", zap.String("booking_id", id.String()))
 	return nil
 }
 
 func (r *bookingRepository) FindByScheduleID(ctx context.Context, scheduleID uuid.UUID) ([]*entity.Booking, error) {
 	query := `
-		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status, created_at, updated_at
+		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status,
+		       base_price, fee_amount, tax_amount, discount_amount, currency, created_at, updated_at, version, customer_tier
 		FROM bookings
-		WHERE schedule_id = $1
+		WHERE schedule_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at
 	`
 
@@ -281,8 +589,15 @@ func (r *bookingRepository) FindByScheduleID(ctx context.Context, scheduleID uui
 			&booking.TotalSeats,
 			&booking.TotalPrice,
 			&booking.Status,
+			&booking.BasePrice,
+			&booking.FeeAmount,
+			&booking.TaxAmount,
+			&booking.DiscountAmount,
+			&booking.Currency,
 			&booking.CreatedAt,
 			&booking.UpdatedAt,
+			&booking.Version,
+			&booking.CustomerTier,
 		)
 		if err != nil {
 			r.log.Error("Failed to scan booking row", zap.Error(err))
@@ -296,9 +611,10 @@ func (r *bookingRepository) FindByScheduleID(ctx context.Context, scheduleID uui
 
 func (r *bookingRepository) FindConfirmedByScheduleID(ctx context.Context, scheduleID uuid.UUID) ([]*entity.Booking, error) {
 	query := `
-		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status, created_at, updated_at
+		SELECT id, order_id, user_id, schedule_id, total_seats, total_price, status,
+		       base_price, fee_amount, tax_amount, discount_amount, currency, created_at, updated_at, version, customer_tier
 		FROM bookings
-		WHERE schedule_id = $1 AND status = 'confirmed'
+		WHERE schedule_id = $1 AND status IN ('confirmed', 'checked_in') AND deleted_at IS NULL
 	`
 
 	rows, err := r.db.Query(ctx, query, scheduleID)
@@ -322,8 +638,15 @@ func (r *bookingRepository) FindConfirmedByScheduleID(ctx context.Context, sched
 			&booking.TotalSeats,
 			&booking.TotalPrice,
 			&booking.Status,
+			&booking.BasePrice,
+			&booking.FeeAmount,
+			&booking.TaxAmount,
+			&booking.DiscountAmount,
+			&booking.Currency,
 			&booking.CreatedAt,
 			&booking.UpdatedAt,
+			&booking.Version,
+			&booking.CustomerTier,
 		)
 		if err != nil {
 			r.log.Error("Failed to scan booking row", zap.Error(err))
@@ -335,22 +658,172 @@ func (r *bookingRepository) FindConfirmedByScheduleID(ctx context.Context, sched
 	return bookings, nil
 }
 
-func (r *bookingRepository) UpdateStatus(ctx context.Context, bookingID uuid.UUID, status entity.BookingStatus) error {
-	query := `UPDATE bookings SET status = $2, updated_at = NOW() WHERE id = $1`
+// UpdateStatus is the status-only variant of Update, dengan compare-and-swap yang sama
+func (r *bookingRepository) UpdateStatus(ctx context.Context, booking *entity.Booking, status entity.BookingStatus) error {
+	query := `
+		UPDATE bookings
+		SET status = $2, updated_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+	`
 
-	result, err := r.db.Exec(ctx, query, bookingID, status)
+	result, err := r.db.Exec(ctx, query, booking.ID, status, booking.Version)
 	if err != nil {
 		r.log.Error("Failed to update booking status",
 			zap.Error(err),
-			zap.String("booking_id", bookingID.String()),
+			zap.String("booking_id", booking.ID.String()),
 			zap.String("status", string(status)),
 		)
-		return fmt.Errorf("update booking %s status to %s: %w", bookingID.String(), string(status), err)
+		return fmt.Errorf("update booking %s status to %s: %w", booking.ID.String(), string(status), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return versionMiss(ctx, r.db, "bookings", "booking", booking.ID)
+	}
+
+	booking.Version++
+	return nil
+}
+
+func (r *bookingRepository) UpdateCharges(ctx context.Context, booking *entity.Booking) error {
+	query := `
+		UPDATE bookings
+		SET fee_amount = $2, tax_amount = $3, total_price = $4, updated_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $5 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		booking.ID,
+		booking.FeeAmount,
+		booking.TaxAmount,
+		booking.TotalPrice,
+		booking.Version,
+	)
+	if err != nil {
+		r.log.Error("Failed to update booking charges",
+			zap.Error(err),
+			zap.String("booking_id", booking.ID.String()),
+		)
+		return fmt.Errorf("update booking %s charges: %w", booking.ID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return versionMiss(ctx, r.db, "bookings", "booking", booking.ID)
+	}
+
+	booking.Version++
+	return nil
+}
+
+// FindPendingReminders returns confirmed bookings whose show starts between from and to and belum dikirimi reminder
+func (r *bookingRepository) FindPendingReminders(ctx context.Context, from, to time.Time) ([]*entity.Booking, error) {
+	query := `
+		SELECT b.id, b.order_id, b.user_id, b.schedule_id, b.total_seats, b.total_price, b.status,
+		       b.base_price, b.fee_amount, b.tax_amount, b.discount_amount, b.currency, b.created_at, b.updated_at, b.version, b.customer_tier
+		FROM bookings b
+		INNER JOIN schedules s ON b.schedule_id = s.id
+		WHERE b.status = 'confirmed'
+		  AND b.deleted_at IS NULL
+		  AND s.deleted_at IS NULL
+		  AND b.reminder_sent_at IS NULL
+		  AND s.starts_at BETWEEN $1 AND $2
+		ORDER BY s.starts_at
+	`
+
+	rows, err := r.db.Query(ctx, query, from, to)
+	if err != nil {
+		r.log.Error("Failed to find bookings pending reminder",
+			zap.Error(err),
+			zap.Time("from", from),
+			zap.Time("to", to),
+		)
+		return nil, fmt.Errorf("find bookings pending reminder: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []*entity.Booking
+	for rows.Next() {
+		var booking entity.Booking
+		err := rows.Scan(
+			&booking.ID,
+			&booking.OrderID,
+			&booking.UserID,
+			&booking.ScheduleID,
+			&booking.TotalSeats,
+			&booking.TotalPrice,
+			&booking.Status,
+			&booking.BasePrice,
+			&booking.FeeAmount,
+			&booking.TaxAmount,
+			&booking.DiscountAmount,
+			&booking.Currency,
+			&booking.CreatedAt,
+			&booking.UpdatedAt,
+			&booking.Version,
+			&booking.CustomerTier,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan booking row", zap.Error(err))
+			return nil, fmt.Errorf("scan booking row: %w", err)
+		}
+		bookings = append(bookings, &booking)
+	}
+
+	return bookings, nil
+}
+
+func (r *bookingRepository) MarkReminderSent(ctx context.Context, bookingID uuid.UUID) error {
+	query := `UPDATE bookings SET reminder_sent_at = NOW() WHERE id = $1 AND reminder_sent_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, bookingID)
+	if err != nil {
+		r.log.Error("Failed to mark reminder sent",
+			zap.Error(err),
+			zap.String("booking_id", bookingID.String()),
+		)
+		return fmt.Errorf("mark reminder sent for booking %s: %w", bookingID.String(), err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("booking %s not found", bookingID.String())
+		return fmt.Errorf("booking %s not found or reminder already sent", bookingID.String())
 	}
 
 	return nil
 }
+
+// scanBookings reads booking rows selected with the standard column list
+func (r *bookingRepository) scanBookings(rows pgx.Rows) ([]*entity.Booking, error) {
+	var bookings []*entity.Booking
+	for rows.Next() {
+		var booking entity.Booking
+		err := rows.Scan(
+			&booking.ID,
+			&booking.OrderID,
+			&booking.UserID,
+			&booking.ScheduleID,
+			&booking.TotalSeats,
+			&booking.TotalPrice,
+			&booking.Status,
+			&booking.BasePrice,
+			&booking.FeeAmount,
+			&booking.TaxAmount,
+			&booking.DiscountAmount,
+			&booking.Currency,
+			&booking.CreatedAt,
+			&booking.UpdatedAt,
+			&booking.Version,
+			&booking.CustomerTier,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan booking row", zap.Error(err))
+			return nil, fmt.Errorf("scan booking row: %w", err)
+		}
+		bookings = append(bookings, &booking)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate booking rows: %w", err)
+	}
+
+	return bookings, nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+)
+
+// benchSink mencegah compiler membuang hasil yang diukur
+var benchSink any
+
+func BenchmarkBookingFilter_Args(b *testing.B) {
+	status := entity.BookingStatusConfirmed
+	upcoming := true
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	for _, tc := range []struct {
+		name   string
+		filter BookingFilter
+	}{
+		{name: "empty", filter: BookingFilter{}},
+		{name: "all fields", filter: BookingFilter{Status: &status, Upcoming: &upcoming, ShowDateFrom: &from, ShowDateTo: &to}},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				benchSink = tc.filter.args()
+			}
+		})
+	}
+}
+
+// adminBookingFilterSQL dibangun ulang di setiap FindAll / FindAllAfter / CountAll
+func BenchmarkAdminBookingFilterSQL(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		benchSink = adminBookingFilterSQL(3)
+	}
+}
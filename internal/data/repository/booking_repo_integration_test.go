@@ -0,0 +1,102 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+)
+
+func TestBookingRepository_CreateAndFind(t *testing.T) {
+	ctx := context.Background()
+	show := newShowFixture(t, 2)
+	user := newTestUser(t)
+
+	booking := newPendingBooking(user.ID, show.Schedule, 2)
+	if err := testRepo.Booking.Create(ctx, booking); err != nil {
+		t.Fatalf("create booking: %v", err)
+	}
+
+	got, err := testRepo.Booking.FindByOrderID(ctx, booking.OrderID)
+	if err != nil {
+		t.Fatalf("find by order id: %v", err)
+	}
+	if got == nil || got.ID != booking.ID {
+		t.Fatalf("find by order id = %+v, want booking %s", got, booking.ID)
+	}
+	if got.TotalPrice != booking.TotalPrice || got.Currency != booking.Currency || got.Version != 1 {
+		t.Fatalf("stored booking = %+v, want total %d %s version 1", got, booking.TotalPrice, booking.Currency)
+	}
+
+	missing, err := testRepo.Booking.FindByOrderID(ctx, "IT-00000000-NOPE")
+	if err != nil || missing != nil {
+		t.Fatalf("find unknown order id = %+v, %v; want nil, nil", missing, err)
+	}
+}
+
+func TestBookingRepository_VersionConflict(t *testing.T) {
+	ctx := context.Background()
+	show := newShowFixture(t, 1)
+	user := newTestUser(t)
+
+	booking := newPendingBooking(user.ID, show.Schedule, 1)
+	if err := testRepo.Booking.Create(ctx, booking); err != nil {
+		t.Fatalf("create booking: %v", err)
+	}
+
+	stale, err := testRepo.Booking.FindByID(ctx, booking.ID)
+	if err != nil {
+		t.Fatalf("find booking: %v", err)
+	}
+
+	if err := testRepo.Booking.UpdateStatus(ctx, booking, entity.BookingStatusConfirmed); err != nil {
+		t.Fatalf("update status: %v", err)
+	}
+	if booking.Version != 2 {
+		t.Fatalf("version after update = %d, want 2", booking.Version)
+	}
+
+	var conflict *repository.ConflictError
+	err = testRepo.Booking.UpdateStatus(ctx, stale, entity.BookingStatusCancelled)
+	if !errors.As(err, &conflict) {
+		t.Fatalf("update with stale version = %v, want ConflictError", err)
+	}
+	err = testRepo.Booking.UpdateCharges(ctx, stale)
+	if !errors.As(err, &conflict) {
+		t.Fatalf("update charges with stale version = %v, want ConflictError", err)
+	}
+}
+
+func TestBookingRepository_UpdateCharges(t *testing.T) {
+	ctx := context.Background()
+	show := newShowFixture(t, 1)
+	user := newTestUser(t)
+
+	booking := newPendingBooking(user.ID, show.Schedule, 1)
+	if err := testRepo.Booking.Create(ctx, booking); err != nil {
+		t.Fatalf("create booking: %v", err)
+	}
+
+	booking.FeeAmount = 250000
+	booking.TaxAmount = 525000
+	booking.TotalPrice = booking.BasePrice + booking.FeeAmount + booking.TaxAmount
+	if err := testRepo.Booking.UpdateCharges(ctx, booking); err != nil {
+		t.Fatalf("update charges: %v", err)
+	}
+
+	got, err := testRepo.Booking.FindByID(ctx, booking.ID)
+	if err != nil {
+		t.Fatalf("find booking: %v", err)
+	}
+	if got.FeeAmount != booking.FeeAmount || got.TaxAmount != booking.TaxAmount || got.TotalPrice != booking.TotalPrice {
+		t.Fatalf("stored charges = fee %d tax %d total %d, want fee %d tax %d total %d",
+			got.FeeAmount, got.TaxAmount, got.TotalPrice, booking.FeeAmount, booking.TaxAmount, booking.TotalPrice)
+	}
+	if got.Version != booking.Version {
+		t.Fatalf("stored version = %d, want %d", got.Version, booking.Version)
+	}
+}
@@ -11,17 +11,35 @@ import (
 	"go.uber.org/zap"
 )
 
+// bookingSeatActiveIndex partial unique index (schedule_id, seat_id) untuk kursi yang belum dilepas
+const bookingSeatActiveIndex = "uq_booking_seats_schedule_seat_active"
+
+// SeatAlreadyBookedError is returned by Create kalau kursi sudah dipegang booking aktif lain
+// di jadwal yang sama. Ini jaring terakhir di database, jadi tetap berlaku saat dua request
+// lolos pengecekan di service secara bersamaan.
+type SeatAlreadyBookedError struct {
+	SeatID uuid.UUID
+}
+
+func (e *SeatAlreadyBookedError) Error() string {
+	return fmt.Sprintf("seat %s is already booked", e.SeatID)
+}
+
 type BookingSeatRepository interface {
 	Create(ctx context.Context, bookingSeat *entity.BookingSeat) error
 	FindByBookingID(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingSeat, error)
 	FindBySeatID(ctx context.Context, seatID uuid.UUID) ([]*entity.BookingSeat, error)
 	DeleteByBookingID(ctx context.Context, bookingID uuid.UUID) error
+	// ReleaseByBookingID melepas kursi booking dari unique index supaya bisa dijual lagi,
+	// returns jumlah kursi yang baru dilepas
+	ReleaseByBookingID(ctx context.Context, bookingID uuid.UUID) (int, error)
 
 	// Batch operations
 	CreateBatch(ctx context.Context, bookingSeats []*entity.BookingSeat) error
 
 	// Business queries
 	FindBookedSeatsBySchedule(ctx context.Context, scheduleID uuid.UUID) ([]uuid.UUID, error)
+	FindSeatNumbersByBookingID(ctx context.Context, bookingID uuid.UUID) ([]string, error)
 }
 
 type bookingSeatRepository struct {
@@ -38,17 +56,28 @@ func NewBookingSeatRepository(db database.PgxIface, log *zap.Logger) BookingSeat
 
 func (r *bookingSeatRepository) Create(ctx context.Context, bookingSeat *entity.BookingSeat) error {
 	query := `
-		INSERT INTO booking_seats (id, booking_id, seat_id, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO booking_seats (id, booking_id, seat_id, schedule_id, created_at, ticket_code, is_blocked)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
 	_, err := r.db.Exec(ctx, query,
 		bookingSeat.ID,
 		bookingSeat.BookingID,
 		bookingSeat.SeatID,
+		bookingSeat.ScheduleID,
 		bookingSeat.CreatedAt,
+		bookingSeat.TicketCode,
+		bookingSeat.IsBlocked,
 	)
 
+	if isUniqueViolation(err, bookingSeatActiveIndex) {
+		r.log.Warn("Seat already held by another active booking",
+			zap.String("booking_id", bookingSeat.BookingID.String()),
+			zap.String("schedule_id", bookingSeat.ScheduleID.String()),
+			zap.String("seat_id", bookingSeat.SeatID.String()),
+		)
+		return &SeatAlreadyBookedError{SeatID: bookingSeat.SeatID}
+	}
 	if err != nil {
 		r.log.Error("Failed to create booking seat",
 			zap.Error(err),
@@ -79,7 +108,7 @@ func (r *bookingSeatRepository) CreateBatch(ctx context.Context, bookingSeats []
 
 func (r *bookingSeatRepository) FindByBookingID(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingSeat, error) {
 	query := `
-		SELECT id, booking_id, seat_id, created_at
+		SELECT id, booking_id, seat_id, schedule_id, created_at, ticket_code, is_blocked
 		FROM booking_seats
 		WHERE booking_id = $1
 		ORDER BY created_at
@@ -102,7 +131,10 @@ func (r *bookingSeatRepository) FindByBookingID(ctx context.Context, bookingID u
 			&bs.ID,
 			&bs.BookingID,
 			&bs.SeatID,
+			&bs.ScheduleID,
 			&bs.CreatedAt,
+			&bs.TicketCode,
+			&bs.IsBlocked,
 		)
 		if err != nil {
 			r.log.Error("Failed to scan booking seat row", zap.Error(err))
@@ -116,7 +148,7 @@ func (r *bookingSeatRepository) FindByBookingID(ctx context.Context, bookingID u
 
 func (r *bookingSeatRepository) FindBySeatID(ctx context.Context, seatID uuid.UUID) ([]*entity.BookingSeat, error) {
 	query := `
-		SELECT id, booking_id, seat_id, created_at
+		SELECT id, booking_id, seat_id, schedule_id, created_at, ticket_code, is_blocked
 		FROM booking_seats
 		WHERE seat_id = $1
 	`
@@ -138,7 +170,10 @@ func (r *bookingSeatRepository) FindBySeatID(ctx context.Context, seatID uuid.UU
 			&bs.ID,
 			&bs.BookingID,
 			&bs.SeatID,
+			&bs.ScheduleID,
 			&bs.CreatedAt,
+			&bs.TicketCode,
+			&bs.IsBlocked,
 		)
 		if err != nil {
 			r.log.Error("Failed to scan booking seat row", zap.Error(err))
@@ -165,12 +200,27 @@ func (r *bookingSeatRepository) DeleteByBookingID(ctx context.Context, bookingID
 	return nil
 }
 
+func (r *bookingSeatRepository) ReleaseByBookingID(ctx context.Context, bookingID uuid.UUID) (int, error) {
+	query := `UPDATE booking_seats SET released_at = NOW() WHERE booking_id = $1 AND released_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, bookingID)
+	if err != nil {
+		r.log.Error("Failed to release booking seats",
+			zap.Error(err),
+			zap.String("booking_id", bookingID.String()),
+		)
+		return 0, fmt.Errorf("release booking seats for booking %s: %w", bookingID.String(), err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
 func (r *bookingSeatRepository) FindBookedSeatsBySchedule(ctx context.Context, scheduleID uuid.UUID) ([]uuid.UUID, error) {
 	query := `
 		SELECT DISTINCT bs.seat_id
 		FROM booking_seats bs
 		INNER JOIN bookings b ON bs.booking_id = b.id
-		WHERE b.schedule_id = $1 AND b.status IN ('confirmed', 'pending')
+		WHERE b.schedule_id = $1 AND b.status IN ('confirmed', 'checked_in', 'pending') AND b.deleted_at IS NULL
 	`
 
 	rows, err := r.db.Query(ctx, query, scheduleID)
@@ -196,3 +246,37 @@ func (r *bookingSeatRepository) FindBookedSeatsBySchedule(ctx context.Context, s
 
 	return seatIDs, nil
 }
+
+// FindSeatNumbersByBookingID mengambil nomor kursi satu booking dalam satu query (join ke seats).
+// Kursi blocked group booking tidak termasuk karena bukan ticket.
+func (r *bookingSeatRepository) FindSeatNumbersByBookingID(ctx context.Context, bookingID uuid.UUID) ([]string, error) {
+	query := `
+		SELECT s.seat_number
+		FROM booking_seats bs
+		INNER JOIN seats s ON bs.seat_id = s.id
+		WHERE bs.booking_id = $1 AND NOT bs.is_blocked
+		ORDER BY bs.created_at, s.seat_number
+	`
+
+	rows, err := r.db.Query(ctx, query, bookingID)
+	if err != nil {
+		r.log.Error("Failed to find seat numbers by booking ID",
+			zap.Error(err),
+			zap.String("booking_id", bookingID.String()),
+		)
+		return nil, fmt.Errorf("find seat numbers by booking ID %s: %w", bookingID.String(), err)
+	}
+	defer rows.Close()
+
+	seatNumbers := []string{}
+	for rows.Next() {
+		var seatNumber string
+		if err := rows.Scan(&seatNumber); err != nil {
+			r.log.Error("Failed to scan seat number row", zap.Error(err))
+			return nil, fmt.Errorf("scan seat number row: %w", err)
+		}
+		seatNumbers = append(seatNumbers, seatNumber)
+	}
+
+	return seatNumbers, nil
+}
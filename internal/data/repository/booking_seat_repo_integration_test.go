@@ -0,0 +1,150 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+
+	"github.com/google/uuid"
+)
+
+// bookSeats mengikuti urutan CreateBooking: booking, counter schedule, lalu booking_seats dalam satu transaction
+func bookSeats(ctx context.Context, userID uuid.UUID, schedule *entity.Schedule, seats []*entity.Seat, lockSchedule bool) error {
+	return testRepo.WithTx(ctx, func(tx *repository.Repository) error {
+		if lockSchedule {
+			if err := tx.Schedule.LockByID(ctx, schedule.ID); err != nil {
+				return err
+			}
+		}
+
+		booking := newPendingBooking(userID, schedule, len(seats))
+		if err := tx.Booking.Create(ctx, booking); err != nil {
+			return err
+		}
+		if err := tx.Schedule.AdjustBookedSeats(ctx, schedule.ID, len(seats)); err != nil {
+			return err
+		}
+
+		bookingSeats := make([]*entity.BookingSeat, 0, len(seats))
+		for _, seat := range seats {
+			bookingSeats = append(bookingSeats, &entity.BookingSeat{
+				BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: time.Now().UTC()},
+				BookingID:  booking.ID,
+				SeatID:     seat.ID,
+				ScheduleID: schedule.ID,
+			})
+		}
+		return tx.BookingSeat.CreateBatch(ctx, bookingSeats)
+	})
+}
+
+func TestBookingSeatRepository_ConcurrentDoubleBooking(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		lockSchedule bool
+	}{
+		// Tanpa lock, unique index kursi aktif adalah satu-satunya penjaga
+		{name: "unique index only", lockSchedule: false},
+		{name: "with schedule lock", lockSchedule: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			show := newShowFixture(t, 4)
+			seat := show.Seats[0]
+
+			const attempts = 8
+			users := make([]*entity.User, attempts)
+			for i := range users {
+				users[i] = newTestUser(t)
+			}
+
+			var (
+				wg    sync.WaitGroup
+				start = make(chan struct{})
+				errs  = make([]error, attempts)
+			)
+			for i := 0; i < attempts; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					<-start
+					errs[i] = bookSeats(ctx, users[i].ID, show.Schedule, []*entity.Seat{seat}, tc.lockSchedule)
+				}(i)
+			}
+			close(start)
+			wg.Wait()
+
+			succeeded := 0
+			for i, err := range errs {
+				var seatErr *repository.SeatAlreadyBookedError
+				switch {
+				case err == nil:
+					succeeded++
+				case errors.As(err, &seatErr):
+					if seatErr.SeatID != seat.ID {
+						t.Errorf("attempt %d: conflict on seat %s, want %s", i, seatErr.SeatID, seat.ID)
+					}
+				default:
+					t.Errorf("attempt %d: unexpected error: %v", i, err)
+				}
+			}
+			if succeeded != 1 {
+				t.Fatalf("got %d successful bookings for the same seat, want 1", succeeded)
+			}
+
+			booked, err := testRepo.BookingSeat.FindBookedSeatsBySchedule(ctx, show.Schedule.ID)
+			if err != nil {
+				t.Fatalf("find booked seats: %v", err)
+			}
+			if len(booked) != 1 || booked[0] != seat.ID {
+				t.Fatalf("booked seats = %v, want [%s]", booked, seat.ID)
+			}
+
+			// Transaction yang gagal di-rollback, jadi counter hanya naik sekali
+			schedule, err := testRepo.Schedule.FindByID(ctx, show.Schedule.ID)
+			if err != nil {
+				t.Fatalf("find schedule: %v", err)
+			}
+			if schedule.BookedSeats != 1 {
+				t.Fatalf("booked_seats = %d, want 1", schedule.BookedSeats)
+			}
+		})
+	}
+}
+
+func TestBookingSeatRepository_ReleasedSeatCanBeRebooked(t *testing.T) {
+	ctx := context.Background()
+	show := newShowFixture(t, 2)
+	seat := show.Seats[0]
+	first := newTestUser(t)
+
+	if err := bookSeats(ctx, first.ID, show.Schedule, []*entity.Seat{seat}, true); err != nil {
+		t.Fatalf("first booking: %v", err)
+	}
+
+	bookings, err := testRepo.Booking.FindByScheduleID(ctx, show.Schedule.ID)
+	if err != nil || len(bookings) != 1 {
+		t.Fatalf("find bookings: %v (got %d)", err, len(bookings))
+	}
+	released, err := testRepo.BookingSeat.ReleaseByBookingID(ctx, bookings[0].ID)
+	if err != nil {
+		t.Fatalf("release seats: %v", err)
+	}
+	if released != 1 {
+		t.Fatalf("released %d seats, want 1", released)
+	}
+	if err := testRepo.Schedule.AdjustBookedSeats(ctx, show.Schedule.ID, -released); err != nil {
+		t.Fatalf("adjust booked seats: %v", err)
+	}
+
+	if err := bookSeats(ctx, newTestUser(t).ID, show.Schedule, []*entity.Seat{seat}, true); err != nil {
+		t.Fatalf("rebooking released seat: %v", err)
+	}
+}
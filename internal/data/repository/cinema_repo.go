@@ -16,10 +16,25 @@ import (
 type CinemaRepository interface {
 	Create(ctx context.Context, cinema *entity.Cinema) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Cinema, error)
-	FindAll(ctx context.Context, limit, offset int, cityFilter *string) ([]*entity.Cinema, error)
-	CountAll(ctx context.Context, cityFilter *string) (int64, error)
+	FindAll(ctx context.Context, limit, offset int, filter CinemaFilter, includeDeleted bool) ([]*entity.Cinema, error)
+	CountAll(ctx context.Context, filter CinemaFilter, includeDeleted bool) (int64, error)
 	Update(ctx context.Context, cinema *entity.Cinema) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) error
+	// SetClosure menyimpan closure_type, closed_from, closed_until dan closure_reason cinema
+	SetClosure(ctx context.Context, cinema *entity.Cinema) error
+
+	// Discovery
+	FindCities(ctx context.Context) ([]*entity.CityCount, error)
+	FindNearby(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*entity.NearbyCinema, error)
+}
+
+// CinemaFilter narrows cinema listings; Facilities harus dimiliki semua (AND)
+type CinemaFilter struct {
+	City       *string
+	Facilities []entity.CinemaFacility
+	// OrganizationID dipakai listing admin chain
+	OrganizationID *uuid.UUID
 }
 
 type cinemaRepository struct {
@@ -36,8 +51,9 @@ func NewCinemaRepository(db database.PgxIface, log *zap.Logger) CinemaRepository
 
 func (r *cinemaRepository) Create(ctx context.Context, cinema *entity.Cinema) error {
 	query := `
-		INSERT INTO cinemas (id, name, location, city, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO cinemas (id, name, location, city, latitude, longitude, facilities, opening_hours, tax_rate, timezone,
+		                     organization_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, '[]'::jsonb), $8, $9, $10, $11, $12, $13)
 	`
 
 	_, err := r.db.Exec(ctx, query,
@@ -45,6 +61,13 @@ func (r *cinemaRepository) Create(ctx context.Context, cinema *entity.Cinema) er
 		cinema.Name,
 		cinema.Location,
 		cinema.City,
+		cinema.Latitude,
+		cinema.Longitude,
+		cinema.Facilities,
+		cinema.OpeningHours,
+		cinema.TaxRate,
+		cinema.Timezone,
+		cinema.OrganizationID,
 		cinema.CreatedAt,
 		cinema.UpdatedAt,
 	)
@@ -63,7 +86,8 @@ func (r *cinemaRepository) Create(ctx context.Context, cinema *entity.Cinema) er
 
 func (r *cinemaRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Cinema, error) {
 	query := `
-		SELECT id, name, location, city, created_at, updated_at, deleted_at
+		SELECT id, name, location, city, latitude, longitude, facilities, opening_hours, tax_rate, timezone, organization_id,
+		       closure_type, closed_from, closed_until, closure_reason, created_at, updated_at, deleted_at
 		FROM cinemas
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -74,6 +98,17 @@ func (r *cinemaRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.
 		&cinema.Name,
 		&cinema.Location,
 		&cinema.City,
+		&cinema.Latitude,
+		&cinema.Longitude,
+		&cinema.Facilities,
+		&cinema.OpeningHours,
+		&cinema.TaxRate,
+		&cinema.Timezone,
+		&cinema.OrganizationID,
+		&cinema.ClosureType,
+		&cinema.ClosedFrom,
+		&cinema.ClosedUntil,
+		&cinema.ClosureReason,
 		&cinema.CreatedAt,
 		&cinema.UpdatedAt,
 		&cinema.DeletedAt,
@@ -93,35 +128,35 @@ func (r *cinemaRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.
 	return &cinema, nil
 }
 
-func (r *cinemaRepository) FindAll(ctx context.Context, limit, offset int, cityFilter *string) ([]*entity.Cinema, error) {
+func (r *cinemaRepository) FindAll(ctx context.Context, limit, offset int, filter CinemaFilter, includeDeleted bool) ([]*entity.Cinema, error) {
 	// Build query dengan optional filter
 	var queryBuilder strings.Builder
 	queryBuilder.WriteString(`
-		SELECT id, name, location, city, created_at, updated_at
+		SELECT id, name, location, city, latitude, longitude, facilities, opening_hours, tax_rate, timezone, organization_id,
+		       closure_type, closed_from, closed_until, closure_reason, created_at, updated_at, deleted_at
 		FROM cinemas
-		WHERE deleted_at IS NULL
+		WHERE 1 = 1
 	`)
 
-	args := []interface{}{}
-	argCount := 1
-
-	if cityFilter != nil && *cityFilter != "" {
-		queryBuilder.WriteString(fmt.Sprintf(" AND city ILIKE $%d", argCount))
-		args = append(args, "%"+*cityFilter+"%")
-		argCount++
+	if !includeDeleted {
+		queryBuilder.WriteString(" AND deleted_at IS NULL")
 	}
 
+	where, args := filter.sql(1)
+	queryBuilder.WriteString(where)
+	argCount := len(args) + 1
+
 	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY city, name LIMIT $%d OFFSET $%d", argCount, argCount+1))
 	args = append(args, limit, offset)
 
 	// Execute query
-	rows, err := r.db.Query(ctx, queryBuilder.String(), args...)
+	rows, err := r.db.Reader().Query(ctx, queryBuilder.String(), args...)
 	if err != nil {
 		r.log.Error("Failed to find all cinemas",
 			zap.Error(err),
 			zap.Int("limit", limit),
 			zap.Int("offset", offset),
-			zap.Stringp("city_filter", cityFilter),
+			zap.Stringp("city_filter", filter.City),
 		)
 		return nil, fmt.Errorf("find all cinemas limit %d offset %d: %w", limit, offset, err)
 	}
@@ -135,8 +170,20 @@ func (r *cinemaRepository) FindAll(ctx context.Context, limit, offset int, cityF
 			&cinema.Name,
 			&cinema.Location,
 			&cinema.City,
+			&cinema.Latitude,
+			&cinema.Longitude,
+			&cinema.Facilities,
+			&cinema.OpeningHours,
+			&cinema.TaxRate,
+			&cinema.Timezone,
+			&cinema.OrganizationID,
+			&cinema.ClosureType,
+			&cinema.ClosedFrom,
+			&cinema.ClosedUntil,
+			&cinema.ClosureReason,
 			&cinema.CreatedAt,
 			&cinema.UpdatedAt,
+			&cinema.DeletedAt,
 		)
 		if err != nil {
 			r.log.Error("Failed to scan cinema row", zap.Error(err))
@@ -153,22 +200,23 @@ func (r *cinemaRepository) FindAll(ctx context.Context, limit, offset int, cityF
 	return cinemas, nil
 }
 
-func (r *cinemaRepository) CountAll(ctx context.Context, cityFilter *string) (int64, error) {
+func (r *cinemaRepository) CountAll(ctx context.Context, filter CinemaFilter, includeDeleted bool) (int64, error) {
 	// Build count query
-	query := `SELECT COUNT(*) FROM cinemas WHERE deleted_at IS NULL`
-	args := []interface{}{}
+	query := `SELECT COUNT(*) FROM cinemas WHERE 1 = 1`
 
-	if cityFilter != nil && *cityFilter != "" {
-		query += " AND city ILIKE $1"
-		args = append(args, "%"+*cityFilter+"%")
+	if !includeDeleted {
+		query += " AND deleted_at IS NULL"
 	}
 
+	where, args := filter.sql(1)
+	query += where
+
 	var total int64
-	err := r.db.QueryRow(ctx, query, args...).Scan(&total)
+	err := r.db.Reader().QueryRow(ctx, query, args...).Scan(&total)
 	if err != nil {
 		r.log.Error("Failed to count cinemas",
 			zap.Error(err),
-			zap.Stringp("city_filter", cityFilter),
+			zap.Stringp("city_filter", filter.City),
 		)
 		return 0, fmt.Errorf("count all cinemas: %w", err)
 	}
@@ -176,10 +224,34 @@ func (r *cinemaRepository) CountAll(ctx context.Context, cityFilter *string) (in
 	return total, nil
 }
 
+// sql builds the filter conditions, placeholder dimulai dari $argStart
+func (f CinemaFilter) sql(argStart int) (string, []interface{}) {
+	var where strings.Builder
+	args := []interface{}{}
+
+	if f.City != nil && *f.City != "" {
+		where.WriteString(fmt.Sprintf(" AND city ILIKE $%d", argStart+len(args)))
+		args = append(args, "%"+*f.City+"%")
+	}
+
+	if len(f.Facilities) > 0 {
+		where.WriteString(fmt.Sprintf(" AND facilities @> $%d::jsonb", argStart+len(args)))
+		args = append(args, f.Facilities)
+	}
+
+	if f.OrganizationID != nil {
+		where.WriteString(fmt.Sprintf(" AND organization_id = $%d", argStart+len(args)))
+		args = append(args, *f.OrganizationID)
+	}
+
+	return where.String(), args
+}
+
 func (r *cinemaRepository) Update(ctx context.Context, cinema *entity.Cinema) error {
 	query := `
 		UPDATE cinemas
-		SET name = $2, location = $3, city = $4, updated_at = $5
+		SET name = $2, location = $3, city = $4, latitude = $5, longitude = $6,
+		    facilities = COALESCE($7, '[]'::jsonb), opening_hours = $8, tax_rate = $9, timezone = $10, updated_at = $11
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
@@ -188,6 +260,12 @@ func (r *cinemaRepository) Update(ctx context.Context, cinema *entity.Cinema) er
 		cinema.Name,
 		cinema.Location,
 		cinema.City,
+		cinema.Latitude,
+		cinema.Longitude,
+		cinema.Facilities,
+		cinema.OpeningHours,
+		cinema.TaxRate,
+		cinema.Timezone,
 		cinema.UpdatedAt,
 	)
 
@@ -225,3 +303,163 @@ func (r *cinemaRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.log.Info("Cinema deleted", zap.String("cinema_id", id.String()))
 	return nil
 }
+
+func (r *cinemaRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE cinemas SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to restore cinema",
+			zap.Error(err),
+			zap.String("cinema_id", id.String()),
+		)
+		return fmt.Errorf("restore cinema %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("cinema %s not found or not deleted", id.String())
+	}
+
+	r.log.Info("Cinema restored", zap.String("cinema_id", id.String()))
+	return nil
+}
+
+func (r *cinemaRepository) SetClosure(ctx context.Context, cinema *entity.Cinema) error {
+	query := `
+		UPDATE cinemas
+		SET closure_type = $2, closed_from = $3, closed_until = $4, closure_reason = $5, updated_at = $6
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		cinema.ID,
+		cinema.ClosureType,
+		cinema.ClosedFrom,
+		cinema.ClosedUntil,
+		cinema.ClosureReason,
+		cinema.UpdatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to set cinema closure",
+			zap.Error(err),
+			zap.String("cinema_id", cinema.ID.String()),
+		)
+		return fmt.Errorf("set closure for cinema %s: %w", cinema.ID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("cinema %s not found or already deleted", cinema.ID.String())
+	}
+
+	return nil
+}
+
+// FindCities returns distinct cities dari cinema aktif beserta jumlah cinema
+func (r *cinemaRepository) FindCities(ctx context.Context) ([]*entity.CityCount, error) {
+	query := `
+		SELECT city, COUNT(*) AS cinema_count
+		FROM cinemas
+		WHERE deleted_at IS NULL
+		GROUP BY city
+		ORDER BY city
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find cities", zap.Error(err))
+		return nil, fmt.Errorf("find cities: %w", err)
+	}
+	defer rows.Close()
+
+	var cities []*entity.CityCount
+	for rows.Next() {
+		var city entity.CityCount
+		if err := rows.Scan(&city.City, &city.CinemaCount); err != nil {
+			r.log.Error("Failed to scan city row", zap.Error(err))
+			return nil, fmt.Errorf("scan city row: %w", err)
+		}
+		cities = append(cities, &city)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate city rows: %w", err)
+	}
+
+	return cities, nil
+}
+
+// FindNearby returns cinemas within radiusKm ordered by haversine distance.
+// Bounding box di WHERE supaya index koordinat bisa dipakai sebelum hitung jarak.
+func (r *cinemaRepository) FindNearby(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*entity.NearbyCinema, error) {
+	query := `
+		SELECT id, name, location, city, latitude, longitude, facilities, opening_hours, tax_rate, timezone, organization_id,
+		       closure_type, closed_from, closed_until, closure_reason, created_at, updated_at, deleted_at, distance_km
+		FROM (
+			SELECT c.*,
+			       6371 * 2 * ASIN(SQRT(
+			           POWER(SIN(RADIANS(c.latitude - $1) / 2), 2) +
+			           COS(RADIANS($1)) * COS(RADIANS(c.latitude)) *
+			           POWER(SIN(RADIANS(c.longitude - $2) / 2), 2)
+			       )) AS distance_km
+			FROM cinemas c
+			WHERE c.deleted_at IS NULL
+			  AND c.latitude BETWEEN $1 - ($3 / 111.0) AND $1 + ($3 / 111.0)
+			  AND c.latitude IS NOT NULL
+			  AND c.longitude IS NOT NULL
+		) nearby
+		WHERE distance_km <= $3
+		ORDER BY distance_km, name
+		LIMIT $4
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, lat, lng, radiusKm, limit)
+	if err != nil {
+		r.log.Error("Failed to find nearby cinemas",
+			zap.Error(err),
+			zap.Float64("lat", lat),
+			zap.Float64("lng", lng),
+			zap.Float64("radius_km", radiusKm),
+		)
+		return nil, fmt.Errorf("find nearby cinemas: %w", err)
+	}
+	defer rows.Close()
+
+	var cinemas []*entity.NearbyCinema
+	for rows.Next() {
+		var cinema entity.NearbyCinema
+		err := rows.Scan(
+			&cinema.ID,
+			&cinema.Name,
+			&cinema.Location,
+			&cinema.City,
+			&cinema.Latitude,
+			&cinema.Longitude,
+			&cinema.Facilities,
+			&cinema.OpeningHours,
+			&cinema.TaxRate,
+			&cinema.Timezone,
+			&cinema.OrganizationID,
+			&cinema.ClosureType,
+			&cinema.ClosedFrom,
+			&cinema.ClosedUntil,
+			&cinema.ClosureReason,
+			&cinema.CreatedAt,
+			&cinema.UpdatedAt,
+			&cinema.DeletedAt,
+			&cinema.DistanceKm,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan nearby cinema row", zap.Error(err))
+			return nil, fmt.Errorf("scan nearby cinema row: %w", err)
+		}
+		cinemas = append(cinemas, &cinema)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate nearby cinema rows: %w", err)
+	}
+
+	return cinemas, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// pgUniqueViolation SQLSTATE unique_violation
+const pgUniqueViolation = "23505"
+
+// ConflictError is returned by compare-and-swap updates kalau version row sudah berubah sejak dibaca.
+// Pemanggil sebaiknya membaca ulang row lalu memutuskan lagi, bukan mengulang update yang sama.
+type ConflictError struct {
+	Entity string
+	ID     uuid.UUID
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s %s was modified concurrently", e.Entity, e.ID)
+}
+
+// versionMiss menjelaskan update versioned yang tidak mengenai row: row masih ada berarti
+// version-nya sudah maju (conflict), selain itu row memang tidak ada / sudah dihapus
+func versionMiss(ctx context.Context, db database.PgxIface, table, entityName string, id uuid.UUID) error {
+	query := `SELECT EXISTS (SELECT 1 FROM ` + table + ` WHERE id = $1 AND deleted_at IS NULL)`
+
+	var exists bool
+	if err := db.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return fmt.Errorf("check %s %s after version miss: %w", entityName, id.String(), err)
+	}
+	if exists {
+		return &ConflictError{Entity: entityName, ID: id}
+	}
+	return fmt.Errorf("%s %s not found", entityName, id.String())
+}
+
+// isUniqueViolation reports whether err berasal dari unique index / constraint bernama constraint
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor is a keyset position for lists ordered by (created_at DESC, id DESC); nil means first page
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// cursorArgs unpacks a cursor into nullable query args
+func cursorArgs(cursor *Cursor) (*time.Time, *uuid.UUID) {
+	if cursor == nil {
+		return nil, nil
+	}
+	return &cursor.CreatedAt, &cursor.ID
+}
@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type DataExportRepository interface {
+	Create(ctx context.Context, export *entity.DataExport) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.DataExport, error)
+	// FindLatestByUser returns permintaan terbaru user, nil kalau belum pernah
+	FindLatestByUser(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error)
+	// FindExpired returns export completed yang expires_at-nya sudah lewat, file-nya perlu dihapus
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]*entity.DataExport, error)
+	Update(ctx context.Context, export *entity.DataExport) error
+	FindAll(ctx context.Context, filter DataExportFilter, limit, offset int) ([]*entity.DataExport, error)
+	CountAll(ctx context.Context, filter DataExportFilter) (int64, error)
+}
+
+// DataExportFilter untuk log admin; nil field = tidak difilter
+type DataExportFilter struct {
+	UserID *uuid.UUID
+	Status *entity.DataExportStatus
+}
+
+// args returns $1..$2 for the admin list WHERE clause
+func (f DataExportFilter) args() []any {
+	var status *string
+	if f.Status != nil {
+		value := string(*f.Status)
+		status = &value
+	}
+	return []any{f.UserID, status}
+}
+
+const dataExportFilterSQL = `($1::uuid IS NULL OR user_id = $1::uuid) AND ($2::text IS NULL OR status = $2::text)`
+
+const dataExportColumns = `id, user_id, format, status, file_path, size_bytes, error,
+		completed_at, expires_at, downloaded_at, created_at, updated_at`
+
+type dataExportRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewDataExportRepository(db database.PgxIface, log *zap.Logger) DataExportRepository {
+	return &dataExportRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "data_export")),
+	}
+}
+
+func (r *dataExportRepository) Create(ctx context.Context, export *entity.DataExport) error {
+	query := `
+		INSERT INTO data_exports (id, user_id, format, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		export.ID,
+		export.UserID,
+		export.Format,
+		export.Status,
+		export.CreatedAt,
+		export.UpdatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to create data export",
+			zap.Error(err),
+			zap.String("user_id", export.UserID.String()),
+		)
+		return fmt.Errorf("create data export: %w", err)
+	}
+
+	return nil
+}
+
+func (r *dataExportRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.DataExport, error) {
+	query := `SELECT ` + dataExportColumns + ` FROM data_exports WHERE id = $1`
+
+	export, err := scanDataExport(r.db.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find data export", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("find data export %s: %w", id.String(), err)
+	}
+
+	return export, nil
+}
+
+func (r *dataExportRepository) FindLatestByUser(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error) {
+	query := `
+		SELECT ` + dataExportColumns + `
+		FROM data_exports
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	export, err := scanDataExport(r.db.QueryRow(ctx, query, userID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find latest data export", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("find latest data export for user %s: %w", userID.String(), err)
+	}
+
+	return export, nil
+}
+
+func (r *dataExportRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]*entity.DataExport, error) {
+	query := `
+		SELECT ` + dataExportColumns + `
+		FROM data_exports
+		WHERE status = 'completed' AND expires_at <= $1
+		ORDER BY expires_at
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, now, limit)
+	if err != nil {
+		r.log.Error("Failed to find expired data exports", zap.Error(err))
+		return nil, fmt.Errorf("find expired data exports: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanDataExports(rows)
+}
+
+func (r *dataExportRepository) Update(ctx context.Context, export *entity.DataExport) error {
+	query := `
+		UPDATE data_exports
+		SET status = $2, file_path = $3, size_bytes = $4, error = $5,
+		    completed_at = $6, expires_at = $7, downloaded_at = $8, updated_at = $9
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		export.ID,
+		export.Status,
+		export.FilePath,
+		export.SizeBytes,
+		export.Error,
+		export.CompletedAt,
+		export.ExpiresAt,
+		export.DownloadedAt,
+		export.UpdatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to update data export", zap.Error(err), zap.String("id", export.ID.String()))
+		return fmt.Errorf("update data export %s: %w", export.ID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("data export %s not found", export.ID.String())
+	}
+
+	return nil
+}
+
+func (r *dataExportRepository) FindAll(ctx context.Context, filter DataExportFilter, limit, offset int) ([]*entity.DataExport, error) {
+	query := `
+		SELECT ` + dataExportColumns + `
+		FROM data_exports
+		WHERE ` + dataExportFilterSQL + `
+		ORDER BY created_at DESC, id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, append(filter.args(), limit, offset)...)
+	if err != nil {
+		r.log.Error("Failed to find data exports", zap.Error(err))
+		return nil, fmt.Errorf("find data exports: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanDataExports(rows)
+}
+
+func (r *dataExportRepository) CountAll(ctx context.Context, filter DataExportFilter) (int64, error) {
+	query := `SELECT COUNT(*) FROM data_exports WHERE ` + dataExportFilterSQL
+
+	var total int64
+	if err := r.db.Reader().QueryRow(ctx, query, filter.args()...).Scan(&total); err != nil {
+		r.log.Error("Failed to count data exports", zap.Error(err))
+		return 0, fmt.Errorf("count data exports: %w", err)
+	}
+
+	return total, nil
+}
+
+func scanDataExport(row pgx.Row) (*entity.DataExport, error) {
+	var export entity.DataExport
+	err := row.Scan(
+		&export.ID,
+		&export.UserID,
+		&export.Format,
+		&export.Status,
+		&export.FilePath,
+		&export.SizeBytes,
+		&export.Error,
+		&export.CompletedAt,
+		&export.ExpiresAt,
+		&export.DownloadedAt,
+		&export.CreatedAt,
+		&export.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
+
+func (r *dataExportRepository) scanDataExports(rows pgx.Rows) ([]*entity.DataExport, error) {
+	exports := []*entity.DataExport{}
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			r.log.Error("Failed to scan data export row", zap.Error(err))
+			return nil, fmt.Errorf("scan data export row: %w", err)
+		}
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate data export rows: %w", err)
+	}
+
+	return exports, nil
+}
@@ -0,0 +1,83 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+
+	"github.com/google/uuid"
+)
+
+func TestDataExportRepository_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	user := newTestUser(t)
+
+	latest, err := testRepo.DataExport.FindLatestByUser(ctx, user.ID)
+	if err != nil || latest != nil {
+		t.Fatalf("latest export for new user = %+v, %v; want nil, nil", latest, err)
+	}
+
+	export := &entity.DataExport{
+		BaseNoDelete: entity.BaseNoDelete{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		UserID:       user.ID,
+		Format:       entity.DataExportFormatZIP,
+		Status:       entity.DataExportStatusPending,
+	}
+	if err := testRepo.DataExport.Create(ctx, export); err != nil {
+		t.Fatalf("create data export: %v", err)
+	}
+
+	latest, err = testRepo.DataExport.FindLatestByUser(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("find latest by user: %v", err)
+	}
+	if latest == nil || latest.ID != export.ID || !latest.InProgress() {
+		t.Fatalf("latest export = %+v, want pending export %s", latest, export.ID)
+	}
+
+	// Selesai dengan link yang sudah lewat, supaya langsung masuk antrian cleanup
+	path := "/tmp/exports/" + export.ID.String() + ".zip"
+	size := int64(2048)
+	expiresAt := now.Add(-time.Minute)
+	export.Status = entity.DataExportStatusCompleted
+	export.FilePath = &path
+	export.SizeBytes = &size
+	export.CompletedAt = &now
+	export.ExpiresAt = &expiresAt
+	export.UpdatedAt = now
+	if err := testRepo.DataExport.Update(ctx, export); err != nil {
+		t.Fatalf("update data export: %v", err)
+	}
+
+	got, err := testRepo.DataExport.FindByID(ctx, export.ID)
+	if err != nil {
+		t.Fatalf("find by id: %v", err)
+	}
+	if got == nil || got.Status != entity.DataExportStatusCompleted || got.FilePath == nil || *got.FilePath != path {
+		t.Fatalf("stored export = %+v, want completed with file %s", got, path)
+	}
+
+	expired, err := testRepo.DataExport.FindExpired(ctx, now, 100)
+	if err != nil {
+		t.Fatalf("find expired: %v", err)
+	}
+	found := false
+	for _, row := range expired {
+		found = found || row.ID == export.ID
+	}
+	if !found {
+		t.Fatalf("expired export %s not returned by FindExpired", export.ID)
+	}
+
+	status := entity.DataExportStatusCompleted
+	count, err := testRepo.DataExport.CountAll(ctx, repository.DataExportFilter{UserID: &user.ID, Status: &status})
+	if err != nil || count != 1 {
+		t.Fatalf("count completed exports for user = %d, %v; want 1, nil", count, err)
+	}
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// FeatureFlagRepository flag yang diatur admin; flag tanpa row memakai default di kode
+type FeatureFlagRepository interface {
+	FindAll(ctx context.Context) ([]*entity.FeatureFlag, error)
+	FindByKey(ctx context.Context, key string) (*entity.FeatureFlag, error)
+	Upsert(ctx context.Context, flag *entity.FeatureFlag) error
+	Delete(ctx context.Context, key string) error
+}
+
+type featureFlagRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewFeatureFlagRepository(db database.PgxIface, log *zap.Logger) FeatureFlagRepository {
+	return &featureFlagRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "feature_flag")),
+	}
+}
+
+const featureFlagColumns = `key, description, enabled, rollout_percent, environments, updated_by, created_at, updated_at`
+
+func scanFeatureFlag(row pgx.Row) (*entity.FeatureFlag, error) {
+	var flag entity.FeatureFlag
+	err := row.Scan(
+		&flag.Key,
+		&flag.Description,
+		&flag.Enabled,
+		&flag.RolloutPercent,
+		&flag.Environments,
+		&flag.UpdatedBy,
+		&flag.CreatedAt,
+		&flag.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
+
+func (r *featureFlagRepository) FindAll(ctx context.Context) ([]*entity.FeatureFlag, error) {
+	query := `SELECT ` + featureFlagColumns + ` FROM feature_flags ORDER BY key`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find feature flags", zap.Error(err))
+		return nil, fmt.Errorf("find feature flags: %w", err)
+	}
+	defer rows.Close()
+
+	flags := []*entity.FeatureFlag{}
+	for rows.Next() {
+		flag, err := scanFeatureFlag(rows)
+		if err != nil {
+			r.log.Error("Failed to scan feature flag row", zap.Error(err))
+			return nil, fmt.Errorf("scan feature flag row: %w", err)
+		}
+		flags = append(flags, flag)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate feature flag rows: %w", err)
+	}
+
+	return flags, nil
+}
+
+func (r *featureFlagRepository) FindByKey(ctx context.Context, key string) (*entity.FeatureFlag, error) {
+	query := `SELECT ` + featureFlagColumns + ` FROM feature_flags WHERE key = $1`
+
+	flag, err := scanFeatureFlag(r.db.QueryRow(ctx, query, key))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find feature flag",
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		return nil, fmt.Errorf("find feature flag %s: %w", key, err)
+	}
+
+	return flag, nil
+}
+
+func (r *featureFlagRepository) Upsert(ctx context.Context, flag *entity.FeatureFlag) error {
+	query := `
+		INSERT INTO feature_flags (key, description, enabled, rollout_percent, environments, updated_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (key) DO UPDATE
+		SET description = EXCLUDED.description,
+		    enabled = EXCLUDED.enabled,
+		    rollout_percent = EXCLUDED.rollout_percent,
+		    environments = EXCLUDED.environments,
+		    updated_by = EXCLUDED.updated_by,
+		    updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		flag.Key,
+		flag.Description,
+		flag.Enabled,
+		flag.RolloutPercent,
+		flag.Environments,
+		flag.UpdatedBy,
+		flag.CreatedAt,
+		flag.UpdatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to upsert feature flag",
+			zap.Error(err),
+			zap.String("key", flag.Key),
+		)
+		return fmt.Errorf("upsert feature flag %s: %w", flag.Key, err)
+	}
+
+	return nil
+}
+
+func (r *featureFlagRepository) Delete(ctx context.Context, key string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM feature_flags WHERE key = $1`, key); err != nil {
+		r.log.Error("Failed to delete feature flag",
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		return fmt.Errorf("delete feature flag %s: %w", key, err)
+	}
+
+	return nil
+}
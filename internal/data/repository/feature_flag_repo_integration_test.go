@@ -0,0 +1,75 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+
+	"github.com/google/uuid"
+)
+
+func TestFeatureFlagRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	key := "it_flag_" + uuid.NewString()[:8]
+
+	missing, err := testRepo.FeatureFlag.FindByKey(ctx, key)
+	if err != nil || missing != nil {
+		t.Fatalf("find unknown flag = %+v, %v; want nil, nil", missing, err)
+	}
+
+	flag := &entity.FeatureFlag{
+		Key:            key,
+		Description:    "integration smoke flag",
+		Enabled:        true,
+		RolloutPercent: 25,
+		Environments:   []string{"staging"},
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := testRepo.FeatureFlag.Upsert(ctx, flag); err != nil {
+		t.Fatalf("insert flag: %v", err)
+	}
+
+	flag.Enabled = false
+	flag.RolloutPercent = 100
+	flag.Environments = []string{"staging", "production"}
+	flag.CreatedAt = now.Add(time.Hour)
+	flag.UpdatedAt = now.Add(time.Minute)
+	if err := testRepo.FeatureFlag.Upsert(ctx, flag); err != nil {
+		t.Fatalf("update flag: %v", err)
+	}
+
+	got, err := testRepo.FeatureFlag.FindByKey(ctx, key)
+	if err != nil {
+		t.Fatalf("find flag: %v", err)
+	}
+	if got == nil || got.Enabled || got.RolloutPercent != 100 || !slices.Equal(got.Environments, flag.Environments) {
+		t.Fatalf("stored flag = %+v, want disabled, 100%%, %v", got, flag.Environments)
+	}
+	// Upsert tidak menimpa created_at dari insert pertama
+	if !got.CreatedAt.Equal(now) {
+		t.Fatalf("created_at = %s, want %s", got.CreatedAt, now)
+	}
+
+	all, err := testRepo.FeatureFlag.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("find all flags: %v", err)
+	}
+	if !slices.ContainsFunc(all, func(f *entity.FeatureFlag) bool { return f.Key == key }) {
+		t.Fatalf("flag %s missing from FindAll", key)
+	}
+
+	if err := testRepo.FeatureFlag.Delete(ctx, key); err != nil {
+		t.Fatalf("delete flag: %v", err)
+	}
+	deleted, err := testRepo.FeatureFlag.FindByKey(ctx, key)
+	if err != nil || deleted != nil {
+		t.Fatalf("find deleted flag = %+v, %v; want nil, nil", deleted, err)
+	}
+}
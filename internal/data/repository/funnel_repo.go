@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"go.uber.org/zap"
+)
+
+// FunnelRepository hanya menulis event; agregat funnel ada di ReportRepository.GetFunnel
+type FunnelRepository interface {
+	Record(ctx context.Context, event *entity.FunnelEvent) error
+}
+
+type funnelRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewFunnelRepository(db database.PgxIface, log *zap.Logger) FunnelRepository {
+	return &funnelRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "funnel")),
+	}
+}
+
+func (r *funnelRepository) Record(ctx context.Context, event *entity.FunnelEvent) error {
+	query := `
+		INSERT INTO funnel_events (id, step, schedule_id, user_id, booking_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		event.ID,
+		event.Step,
+		event.ScheduleID,
+		event.UserID,
+		event.BookingID,
+		event.CreatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to record funnel event",
+			zap.Error(err),
+			zap.String("step", string(event.Step)),
+			zap.String("schedule_id", event.ScheduleID.String()),
+		)
+		return fmt.Errorf("record funnel event %s: %w", event.Step, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+// Mock untuk semua interface repository, dipakai test di usecase. Regenerate dengan go generate ./...
+//go:generate mockgen -source=activity_repo.go -destination=mockrepo/activity_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=audit_repo.go -destination=mockrepo/audit_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=banner_repo.go -destination=mockrepo/banner_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=booking_history_repo.go -destination=mockrepo/booking_history_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=booking_note_repo.go -destination=mockrepo/booking_note_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=booking_repo.go -destination=mockrepo/booking_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=booking_seat_repo.go -destination=mockrepo/booking_seat_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=cinema_repo.go -destination=mockrepo/cinema_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=data_export_repo.go -destination=mockrepo/data_export_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=feature_flag_repo.go -destination=mockrepo/feature_flag_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=funnel_repo.go -destination=mockrepo/funnel_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=genre_repo.go -destination=mockrepo/genre_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=gift_card_repo.go -destination=mockrepo/gift_card_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=group_booking_repo.go -destination=mockrepo/group_booking_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=hall_repo.go -destination=mockrepo/hall_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=job_repo.go -destination=mockrepo/job_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=ledger_repo.go -destination=mockrepo/ledger_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=movie_genre_repo.go -destination=mockrepo/movie_genre_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=movie_repo.go -destination=mockrepo/movie_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=notification_setting_repo.go -destination=mockrepo/notification_setting_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=organization_repo.go -destination=mockrepo/organization_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=otp_repo.go -destination=mockrepo/otp_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=outbox_repo.go -destination=mockrepo/outbox_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=payment_history_repo.go -destination=mockrepo/payment_history_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=payment_method_repo.go -destination=mockrepo/payment_method_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=payment_repo.go -destination=mockrepo/payment_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=price_promotion_repo.go -destination=mockrepo/price_promotion_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=report_repo.go -destination=mockrepo/report_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=review_reply_repo.go -destination=mockrepo/review_reply_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=review_repo.go -destination=mockrepo/review_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=review_report_repo.go -destination=mockrepo/review_report_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=review_revision_repo.go -destination=mockrepo/review_revision_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=schedule_repo.go -destination=mockrepo/schedule_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=seat_block_repo.go -destination=mockrepo/seat_block_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=seat_hold_repo.go -destination=mockrepo/seat_hold_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=seat_repo.go -destination=mockrepo/seat_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=session_repo.go -destination=mockrepo/session_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=setting_repo.go -destination=mockrepo/setting_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=tx.go -destination=mockrepo/tx_mock.go -package=mockrepo
+//go:generate mockgen -source=user_device_repo.go -destination=mockrepo/user_device_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=user_repo.go -destination=mockrepo/user_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=user_stats_repo.go -destination=mockrepo/user_stats_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=waitlist_repo.go -destination=mockrepo/waitlist_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=watchlist_repo.go -destination=mockrepo/watchlist_repo_mock.go -package=mockrepo
+//go:generate mockgen -source=webhook_repo.go -destination=mockrepo/webhook_repo_mock.go -package=mockrepo
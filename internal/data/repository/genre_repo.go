@@ -14,6 +14,10 @@ import (
 type GenreRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Genre, error)
 	FindByMovieID(ctx context.Context, movieID uuid.UUID) ([]*entity.Genre, error)
+	FindAll(ctx context.Context) ([]*entity.Genre, error)
+	// FindNamesByMovieIDs returns nama genre per movie (urut nama) dalam satu query, untuk export
+	FindNamesByMovieIDs(ctx context.Context, movieIDs []uuid.UUID) (map[uuid.UUID][]string, error)
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 type genreRepository struct {
@@ -88,3 +92,92 @@ func (r *genreRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID)
 
 	return genres, nil
 }
+
+func (r *genreRepository) FindAll(ctx context.Context) ([]*entity.Genre, error) {
+	query := `SELECT id, name, created_at FROM genres ORDER BY name`
+
+	rows, err := r.db.Reader().Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find all genres", zap.Error(err))
+		return nil, fmt.Errorf("find all genres: %w", err)
+	}
+	defer rows.Close()
+
+	var genres []*entity.Genre
+	for rows.Next() {
+		var genre entity.Genre
+		if err := rows.Scan(&genre.ID, &genre.Name, &genre.CreatedAt); err != nil {
+			r.log.Error("Failed to scan genre row", zap.Error(err))
+			return nil, fmt.Errorf("scan genre row: %w", err)
+		}
+		genres = append(genres, &genre)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return genres, nil
+}
+
+func (r *genreRepository) FindNamesByMovieIDs(ctx context.Context, movieIDs []uuid.UUID) (map[uuid.UUID][]string, error) {
+	names := make(map[uuid.UUID][]string, len(movieIDs))
+	if len(movieIDs) == 0 {
+		return names, nil
+	}
+
+	query := `
+		SELECT mg.movie_id, g.name
+		FROM movie_genres mg
+		INNER JOIN genres g ON g.id = mg.genre_id
+		WHERE mg.movie_id = ANY($1)
+		ORDER BY mg.movie_id, g.name
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, movieIDs)
+	if err != nil {
+		r.log.Error("Failed to find genre names by movie IDs",
+			zap.Error(err),
+			zap.Int("movie_count", len(movieIDs)),
+		)
+		return nil, fmt.Errorf("find genre names by movie ids: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var movieID uuid.UUID
+		var name string
+		if err := rows.Scan(&movieID, &name); err != nil {
+			r.log.Error("Failed to scan movie genre row", zap.Error(err))
+			return nil, fmt.Errorf("scan movie genre row: %w", err)
+		}
+		names[movieID] = append(names[movieID], name)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return names, nil
+}
+
+// Delete removes genre secara permanen. Relasi movie_genres harus sudah dipindah atau dihapus dulu.
+func (r *genreRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM genres WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to delete genre",
+			zap.Error(err),
+			zap.String("genre_id", id.String()),
+		)
+		return fmt.Errorf("delete genre: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("genre not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,369 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type GiftCardRepository interface {
+	CreateBatch(ctx context.Context, cards []*entity.GiftCard) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.GiftCard, error)
+	// FindByCodeForUpdate locks kartu selama redeem supaya satu kode tidak diklaim dua akun
+	FindByCodeForUpdate(ctx context.Context, code string) (*entity.GiftCard, error)
+	FindAll(ctx context.Context, limit, offset int) ([]*entity.GiftCard, error)
+	CountAll(ctx context.Context) (int64, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.GiftCard, error)
+	// FindUsableByUserForUpdate locks kartu milik user yang masih bisa dipakai bayar,
+	// urut yang paling cepat expired dulu supaya saldo itu yang habis duluan
+	FindUsableByUserForUpdate(ctx context.Context, userID uuid.UUID, currency string, now time.Time) ([]*entity.GiftCard, error)
+	Redeem(ctx context.Context, id, userID uuid.UUID, redeemedAt time.Time) error
+	// AdjustBalance menambah (delta positif) atau memotong saldo; potongan melebihi saldo ditolak
+	AdjustBalance(ctx context.Context, id uuid.UUID, delta int64) error
+	Deactivate(ctx context.Context, id uuid.UUID) error
+
+	CreateTransactions(ctx context.Context, transactions []*entity.GiftCardTransaction) error
+	FindTransactionsByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*entity.GiftCardTransaction, error)
+}
+
+const giftCardColumns = `id, code, initial_amount, balance, currency, user_id, redeemed_at, expires_at, is_active,
+		created_at, updated_at`
+
+type giftCardRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewGiftCardRepository(db database.PgxIface, log *zap.Logger) GiftCardRepository {
+	return &giftCardRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "gift_card")),
+	}
+}
+
+func (r *giftCardRepository) CreateBatch(ctx context.Context, cards []*entity.GiftCard) error {
+	if len(cards) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO gift_cards (id, code, initial_amount, balance, currency, expires_at, is_active, created_at, updated_at) VALUES `
+	args := []interface{}{}
+
+	for i, card := range cards {
+		if i > 0 {
+			query += ", "
+		}
+		base := i * 9
+		query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			base+1, base+2, base+3, base+4, base+5, base+6, base+7, base+8, base+9)
+
+		args = append(args, card.ID, card.Code, card.InitialAmount, card.Balance, card.Currency,
+			card.ExpiresAt, card.IsActive, card.CreatedAt, card.UpdatedAt)
+	}
+
+	_, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to create batch gift cards",
+			zap.Error(err),
+			zap.Int("count", len(cards)),
+		)
+		return fmt.Errorf("create batch gift cards: %w", err)
+	}
+
+	return nil
+}
+
+func (r *giftCardRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.GiftCard, error) {
+	query := `SELECT ` + giftCardColumns + ` FROM gift_cards WHERE id = $1`
+
+	card, err := scanGiftCard(r.db.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find gift card by ID",
+			zap.Error(err),
+			zap.String("gift_card_id", id.String()),
+		)
+		return nil, fmt.Errorf("find gift card by ID %s: %w", id.String(), err)
+	}
+
+	return card, nil
+}
+
+func (r *giftCardRepository) FindByCodeForUpdate(ctx context.Context, code string) (*entity.GiftCard, error) {
+	query := `SELECT ` + giftCardColumns + ` FROM gift_cards WHERE code = $1 FOR UPDATE`
+
+	card, err := scanGiftCard(r.db.QueryRow(ctx, query, code))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		// Kode tidak ikut di-log, kode yang valid sama dengan saldo
+		r.log.Error("Failed to find gift card by code", zap.Error(err))
+		return nil, fmt.Errorf("find gift card by code: %w", err)
+	}
+
+	return card, nil
+}
+
+func (r *giftCardRepository) FindAll(ctx context.Context, limit, offset int) ([]*entity.GiftCard, error) {
+	query := `
+		SELECT ` + giftCardColumns + `
+		FROM gift_cards
+		ORDER BY created_at DESC, id
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, limit, offset)
+	if err != nil {
+		r.log.Error("Failed to find gift cards", zap.Error(err))
+		return nil, fmt.Errorf("find gift cards: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanGiftCards(rows)
+}
+
+func (r *giftCardRepository) CountAll(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.db.Reader().QueryRow(ctx, `SELECT COUNT(*) FROM gift_cards`).Scan(&count); err != nil {
+		r.log.Error("Failed to count gift cards", zap.Error(err))
+		return 0, fmt.Errorf("count gift cards: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *giftCardRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.GiftCard, error) {
+	query := `
+		SELECT ` + giftCardColumns + `
+		FROM gift_cards
+		WHERE user_id = $1
+		ORDER BY redeemed_at DESC, id
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to find gift cards by user",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find gift cards by user %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanGiftCards(rows)
+}
+
+func (r *giftCardRepository) FindUsableByUserForUpdate(ctx context.Context, userID uuid.UUID, currency string, now time.Time) ([]*entity.GiftCard, error) {
+	query := `
+		SELECT ` + giftCardColumns + `
+		FROM gift_cards
+		WHERE user_id = $1 AND currency = $2 AND is_active = TRUE AND balance > 0
+		  AND (expires_at IS NULL OR expires_at > $3)
+		ORDER BY expires_at NULLS LAST, redeemed_at, id
+		FOR UPDATE
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, currency, now)
+	if err != nil {
+		r.log.Error("Failed to find usable gift cards",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find usable gift cards for user %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanGiftCards(rows)
+}
+
+func (r *giftCardRepository) Redeem(ctx context.Context, id, userID uuid.UUID, redeemedAt time.Time) error {
+	query := `
+		UPDATE gift_cards
+		SET user_id = $2, redeemed_at = $3, updated_at = $3
+		WHERE id = $1 AND user_id IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, id, userID, redeemedAt)
+	if err != nil {
+		r.log.Error("Failed to redeem gift card",
+			zap.Error(err),
+			zap.String("gift_card_id", id.String()),
+		)
+		return fmt.Errorf("redeem gift card %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("gift card %s already redeemed", id.String())
+	}
+
+	return nil
+}
+
+func (r *giftCardRepository) AdjustBalance(ctx context.Context, id uuid.UUID, delta int64) error {
+	query := `
+		UPDATE gift_cards
+		SET balance = balance + $2, updated_at = NOW()
+		WHERE id = $1 AND balance + $2 >= 0
+	`
+
+	result, err := r.db.Exec(ctx, query, id, delta)
+	if err != nil {
+		r.log.Error("Failed to adjust gift card balance",
+			zap.Error(err),
+			zap.String("gift_card_id", id.String()),
+			zap.Int64("delta", delta),
+		)
+		return fmt.Errorf("adjust gift card balance %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("gift card %s not found or balance insufficient", id.String())
+	}
+
+	return nil
+}
+
+func (r *giftCardRepository) Deactivate(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE gift_cards SET is_active = FALSE, updated_at = NOW() WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to deactivate gift card",
+			zap.Error(err),
+			zap.String("gift_card_id", id.String()),
+		)
+		return fmt.Errorf("deactivate gift card %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("gift card %s not found", id.String())
+	}
+
+	r.log.Info("Gift card deactivated", zap.String("gift_card_id", id.String()))
+	return nil
+}
+
+func (r *giftCardRepository) CreateTransactions(ctx context.Context, transactions []*entity.GiftCardTransaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO gift_card_transactions (id, gift_card_id, payment_id, amount, created_at) VALUES `
+	args := []interface{}{}
+
+	for i, transaction := range transactions {
+		if i > 0 {
+			query += ", "
+		}
+		query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)",
+			i*5+1, i*5+2, i*5+3, i*5+4, i*5+5)
+
+		args = append(args, transaction.ID, transaction.GiftCardID, transaction.PaymentID,
+			transaction.Amount, transaction.CreatedAt)
+	}
+
+	_, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to create gift card transactions",
+			zap.Error(err),
+			zap.Int("count", len(transactions)),
+		)
+		return fmt.Errorf("create gift card transactions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *giftCardRepository) FindTransactionsByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*entity.GiftCardTransaction, error) {
+	query := `
+		SELECT id, gift_card_id, payment_id, amount, created_at
+		FROM gift_card_transactions
+		WHERE payment_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, paymentID)
+	if err != nil {
+		r.log.Error("Failed to find gift card transactions",
+			zap.Error(err),
+			zap.String("payment_id", paymentID.String()),
+		)
+		return nil, fmt.Errorf("find gift card transactions for payment %s: %w", paymentID.String(), err)
+	}
+	defer rows.Close()
+
+	var transactions []*entity.GiftCardTransaction
+	for rows.Next() {
+		var transaction entity.GiftCardTransaction
+		err := rows.Scan(
+			&transaction.ID,
+			&transaction.GiftCardID,
+			&transaction.PaymentID,
+			&transaction.Amount,
+			&transaction.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan gift card transaction row", zap.Error(err))
+			return nil, fmt.Errorf("scan gift card transaction row: %w", err)
+		}
+		transactions = append(transactions, &transaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate gift card transaction rows: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func scanGiftCard(row pgx.Row) (*entity.GiftCard, error) {
+	var card entity.GiftCard
+	err := row.Scan(
+		&card.ID,
+		&card.Code,
+		&card.InitialAmount,
+		&card.Balance,
+		&card.Currency,
+		&card.UserID,
+		&card.RedeemedAt,
+		&card.ExpiresAt,
+		&card.IsActive,
+		&card.CreatedAt,
+		&card.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+func (r *giftCardRepository) scanGiftCards(rows pgx.Rows) ([]*entity.GiftCard, error) {
+	cards := []*entity.GiftCard{}
+	for rows.Next() {
+		card, err := scanGiftCard(rows)
+		if err != nil {
+			r.log.Error("Failed to scan gift card row", zap.Error(err))
+			return nil, fmt.Errorf("scan gift card row: %w", err)
+		}
+		cards = append(cards, card)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate gift card rows: %w", err)
+	}
+
+	return cards, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type GroupBookingRepository interface {
+	Create(ctx context.Context, group *entity.GroupBooking) error
+	FindByBookingID(ctx context.Context, bookingID uuid.UUID) (*entity.GroupBooking, error)
+}
+
+type groupBookingRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewGroupBookingRepository(db database.PgxIface, log *zap.Logger) GroupBookingRepository {
+	return &groupBookingRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "group_booking")),
+	}
+}
+
+func (r *groupBookingRepository) Create(ctx context.Context, group *entity.GroupBooking) error {
+	query := `
+		INSERT INTO group_bookings (booking_id, group_name, notes, created_by, block_remaining, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		group.BookingID,
+		group.GroupName,
+		group.Notes,
+		group.CreatedBy,
+		group.BlockRemaining,
+		group.CreatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to create group booking",
+			zap.Error(err),
+			zap.String("booking_id", group.BookingID.String()),
+		)
+		return fmt.Errorf("create group booking for booking %s: %w", group.BookingID.String(), err)
+	}
+
+	return nil
+}
+
+// FindByBookingID returns nil kalau booking bukan group booking
+func (r *groupBookingRepository) FindByBookingID(ctx context.Context, bookingID uuid.UUID) (*entity.GroupBooking, error) {
+	query := `
+		SELECT booking_id, group_name, notes, created_by, block_remaining, created_at
+		FROM group_bookings
+		WHERE booking_id = $1
+	`
+
+	var group entity.GroupBooking
+	err := r.db.QueryRow(ctx, query, bookingID).Scan(
+		&group.BookingID,
+		&group.GroupName,
+		&group.Notes,
+		&group.CreatedBy,
+		&group.BlockRemaining,
+		&group.CreatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find group booking",
+			zap.Error(err),
+			zap.String("booking_id", bookingID.String()),
+		)
+		return nil, fmt.Errorf("find group booking %s: %w", bookingID.String(), err)
+	}
+
+	return &group, nil
+}
@@ -15,9 +15,22 @@ import (
 type HallRepository interface {
 	Create(ctx context.Context, hall *entity.Hall) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Hall, error)
+	// LockByID serializes schedule publishing per hall, hanya berguna di dalam WithTx
+	LockByID(ctx context.Context, id uuid.UUID) error
 	FindByCinemaID(ctx context.Context, cinemaID uuid.UUID) ([]*entity.Hall, error)
 	Update(ctx context.Context, hall *entity.Hall) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// FindCapacityMismatches returns hall aktif yang jumlah seat row-nya tidak sama dengan total_seats
+	FindCapacityMismatches(ctx context.Context) ([]*HallCapacityMismatch, error)
+}
+
+// HallCapacityMismatch hall yang seat map-nya kosong atau tidak sesuai total_seats
+type HallCapacityMismatch struct {
+	HallID     uuid.UUID
+	CinemaID   uuid.UUID
+	HallNumber int
+	TotalSeats int
+	SeatRows   int
 }
 
 type hallRepository struct {
@@ -34,8 +47,8 @@ func NewHallRepository(db database.PgxIface, log *zap.Logger) HallRepository {
 
 func (r *hallRepository) Create(ctx context.Context, hall *entity.Hall) error {
 	query := `
-		INSERT INTO halls (id, cinema_id, hall_number, total_seats, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO halls (id, cinema_id, hall_number, total_seats, hall_type, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
 	_, err := r.db.Exec(ctx, query,
@@ -43,6 +56,7 @@ func (r *hallRepository) Create(ctx context.Context, hall *entity.Hall) error {
 		hall.CinemaID,
 		hall.HallNumber,
 		hall.TotalSeats,
+		hall.HallType,
 		hall.CreatedAt,
 		hall.UpdatedAt,
 	)
@@ -61,7 +75,7 @@ func (r *hallRepository) Create(ctx context.Context, hall *entity.Hall) error {
 
 func (r *hallRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Hall, error) {
 	query := `
-		SELECT id, cinema_id, hall_number, total_seats, created_at, updated_at, deleted_at
+		SELECT id, cinema_id, hall_number, total_seats, hall_type, created_at, updated_at, deleted_at
 		FROM halls
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -72,6 +86,7 @@ func (r *hallRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Ha
 		&hall.CinemaID,
 		&hall.HallNumber,
 		&hall.TotalSeats,
+		&hall.HallType,
 		&hall.CreatedAt,
 		&hall.UpdatedAt,
 		&hall.DeletedAt,
@@ -91,9 +106,28 @@ func (r *hallRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Ha
 	return &hall, nil
 }
 
+func (r *hallRepository) LockByID(ctx context.Context, id uuid.UUID) error {
+	query := `SELECT id FROM halls WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+
+	var lockedID uuid.UUID
+	err := r.db.QueryRow(ctx, query, id).Scan(&lockedID)
+	if err == pgx.ErrNoRows {
+		return fmt.Errorf("hall %s not found", id.String())
+	}
+	if err != nil {
+		r.log.Error("Failed to lock hall",
+			zap.Error(err),
+			zap.String("hall_id", id.String()),
+		)
+		return fmt.Errorf("lock hall %s: %w", id.String(), err)
+	}
+
+	return nil
+}
+
 func (r *hallRepository) FindByCinemaID(ctx context.Context, cinemaID uuid.UUID) ([]*entity.Hall, error) {
 	query := `
-		SELECT id, cinema_id, hall_number, total_seats, created_at, updated_at
+		SELECT id, cinema_id, hall_number, total_seats, hall_type, created_at, updated_at
 		FROM halls
 		WHERE cinema_id = $1 AND deleted_at IS NULL
 		ORDER BY hall_number
@@ -117,6 +151,7 @@ func (r *hallRepository) FindByCinemaID(ctx context.Context, cinemaID uuid.UUID)
 			&hall.CinemaID,
 			&hall.HallNumber,
 			&hall.TotalSeats,
+			&hall.HallType,
 			&hall.CreatedAt,
 			&hall.UpdatedAt,
 		)
@@ -130,20 +165,31 @@ func (r *hallRepository) FindByCinemaID(ctx context.Context, cinemaID uuid.UUID)
 	return halls, nil
 }
 
+// Update ikut menyalin total_seats ke schedules.capacity di statement yang sama
 func (r *hallRepository) Update(ctx context.Context, hall *entity.Hall) error {
 	query := `
-		UPDATE halls
-		SET cinema_id = $2, hall_number = $3, total_seats = $4, updated_at = $5
-		WHERE id = $1 AND deleted_at IS NULL
+		WITH updated AS (
+			UPDATE halls
+			SET cinema_id = $2, hall_number = $3, total_seats = $4, hall_type = $5, updated_at = $6
+			WHERE id = $1 AND deleted_at IS NULL
+			RETURNING id, total_seats
+		), synced AS (
+			UPDATE schedules s SET capacity = updated.total_seats
+			FROM updated
+			WHERE s.hall_id = updated.id AND s.capacity <> updated.total_seats
+		)
+		SELECT COUNT(*) FROM updated
 	`
 
-	result, err := r.db.Exec(ctx, query,
+	var affected int
+	err := r.db.QueryRow(ctx, query,
 		hall.ID,
 		hall.CinemaID,
 		hall.HallNumber,
 		hall.TotalSeats,
+		hall.HallType,
 		hall.UpdatedAt,
-	)
+	).Scan(&affected)
 
 	if err != nil {
 		r.log.Error("Failed to update hall",
@@ -153,7 +199,7 @@ func (r *hallRepository) Update(ctx context.Context, hall *entity.Hall) error {
 		return fmt.Errorf("update hall %s: %w", hall.ID.String(), err)
 	}
 
-	if result.RowsAffected() == 0 {
+	if affected == 0 {
 		return fmt.Errorf("hall %s not found or already deleted", hall.ID.String())
 	}
 
@@ -179,3 +225,39 @@ func (r *hallRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.log.Info("Hall deleted", zap.String("hall_id", id.String()))
 	return nil
 }
+
+func (r *hallRepository) FindCapacityMismatches(ctx context.Context) ([]*HallCapacityMismatch, error) {
+	query := `
+		SELECT h.id, h.cinema_id, h.hall_number, h.total_seats, COUNT(s.id)
+		FROM halls h
+		LEFT JOIN seats s ON s.hall_id = h.id AND s.deleted_at IS NULL
+		WHERE h.deleted_at IS NULL
+		GROUP BY h.id, h.cinema_id, h.hall_number, h.total_seats
+		HAVING COUNT(s.id) <> h.total_seats
+		ORDER BY h.cinema_id, h.hall_number
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find hall capacity mismatches", zap.Error(err))
+		return nil, fmt.Errorf("find hall capacity mismatches: %w", err)
+	}
+	defer rows.Close()
+
+	mismatches := []*HallCapacityMismatch{}
+	for rows.Next() {
+		var m HallCapacityMismatch
+		if err := rows.Scan(&m.HallID, &m.CinemaID, &m.HallNumber, &m.TotalSeats, &m.SeatRows); err != nil {
+			r.log.Error("Failed to scan hall capacity row", zap.Error(err))
+			return nil, fmt.Errorf("scan hall capacity row: %w", err)
+		}
+		mismatches = append(mismatches, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate hall capacity rows: %w", err)
+	}
+
+	return mismatches, nil
+}
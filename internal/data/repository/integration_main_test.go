@@ -0,0 +1,253 @@
+//go:build integration
+
+// Integration test repository terhadap Postgres asli. Jalankan dengan:
+//
+//	docker compose -f docker-compose.test.yml up -d
+//	go test -tags integration ./internal/data/repository/...
+//
+// Setiap run membuat database baru, menerapkan testdata/base_schema.sql lalu semua
+// migrations/*.up.sql berurutan, dan menghapus database itu lagi setelah selesai.
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/database"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+var (
+	testDB   database.PgxIface
+	testRepo *repository.Repository
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(runIntegration(m))
+}
+
+func runIntegration(m *testing.M) int {
+	config := utils.DatabaseConfig{
+		Host:     envOr("TEST_DB_HOST", "localhost"),
+		Port:     envOr("TEST_DB_PORT", "55432"),
+		User:     envOr("TEST_DB_USER", "postgres"),
+		Password: envOr("TEST_DB_PASSWORD", "postgres"),
+		Name:     fmt.Sprintf("cinema_booking_it_%d", time.Now().UnixNano()),
+		MaxConns: 20,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	admin, err := pgx.Connect(ctx, connString(config, envOr("TEST_DB_ADMIN_NAME", "postgres")))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "integration: connect postgres: %v\n", err)
+		return 1
+	}
+	defer admin.Close(context.Background())
+
+	if _, err := admin.Exec(ctx, "CREATE DATABASE "+config.Name); err != nil {
+		fmt.Fprintf(os.Stderr, "integration: create database: %v\n", err)
+		return 1
+	}
+	defer func() {
+		dropCtx, dropCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer dropCancel()
+		if _, err := admin.Exec(dropCtx, "DROP DATABASE IF EXISTS "+config.Name+" WITH (FORCE)"); err != nil {
+			fmt.Fprintf(os.Stderr, "integration: drop database %s: %v\n", config.Name, err)
+		}
+	}()
+
+	if err := migrate(ctx, connString(config, config.Name)); err != nil {
+		fmt.Fprintf(os.Stderr, "integration: %v\n", err)
+		return 1
+	}
+
+	testDB, err = database.InitDB(config, zap.NewNop())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "integration: init db: %v\n", err)
+		return 1
+	}
+	defer testDB.Close()
+
+	testRepo = repository.NewRepository(testDB, zap.NewNop())
+
+	return m.Run()
+}
+
+// migrate menerapkan skema awal lalu migration up berurutan sesuai nomor file
+func migrate(ctx context.Context, connStr string) error {
+	conn, err := pgx.Connect(ctx, connStr)
+	if err != nil {
+		return fmt.Errorf("connect test database: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "migrations", "*.up.sql"))
+	if err != nil {
+		return fmt.Errorf("list migrations: %w", err)
+	}
+	sort.Strings(files)
+	files = append([]string{filepath.Join("testdata", "base_schema.sql")}, files...)
+
+	for _, file := range files {
+		sql, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", file, err)
+		}
+		// Tanpa argumen pgx memakai simple protocol, jadi satu file boleh berisi banyak statement
+		if _, err := conn.Exec(ctx, string(sql)); err != nil {
+			return fmt.Errorf("apply %s: %w", filepath.Base(file), err)
+		}
+	}
+
+	return nil
+}
+
+func connString(config utils.DatabaseConfig, dbName string) string {
+	return fmt.Sprintf("user=%s password=%s dbname=%s sslmode=disable host=%s port=%s",
+		config.User, config.Password, dbName, config.Host, config.Port)
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// showFixture satu schedule published lengkap dengan cinema, hall dan kursinya
+type showFixture struct {
+	Cinema   *entity.Cinema
+	Hall     *entity.Hall
+	Seats    []*entity.Seat
+	Movie    *entity.Movie
+	Schedule *entity.Schedule
+}
+
+func newShowFixture(t *testing.T, seatCount int) *showFixture {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	cinema := &entity.Cinema{
+		Base:     entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		Name:     "IT Cinema " + uuid.NewString()[:8],
+		Location: "Jl. Test No. 1",
+		City:     "Jakarta",
+		Timezone: "Asia/Jakarta",
+	}
+	if err := testRepo.Cinema.Create(ctx, cinema); err != nil {
+		t.Fatalf("create cinema: %v", err)
+	}
+
+	hall := &entity.Hall{
+		Base:       entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		CinemaID:   cinema.ID,
+		HallNumber: 1,
+		TotalSeats: seatCount,
+		HallType:   entity.HallType2D,
+	}
+	if err := testRepo.Hall.Create(ctx, hall); err != nil {
+		t.Fatalf("create hall: %v", err)
+	}
+
+	seats := make([]*entity.Seat, 0, seatCount)
+	for i := 1; i <= seatCount; i++ {
+		seats = append(seats, &entity.Seat{
+			Base:        entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			HallID:      hall.ID,
+			SeatNumber:  fmt.Sprintf("A%d", i),
+			SeatRow:     "A",
+			SeatColumn:  i,
+			IsAvailable: true,
+		})
+	}
+	if err := testRepo.Seat.CreateBatch(ctx, seats); err != nil {
+		t.Fatalf("create seats: %v", err)
+	}
+
+	movie := &entity.Movie{
+		Base:              entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		Title:             "IT Movie " + uuid.NewString()[:8],
+		ReleaseDate:       now.AddDate(0, 0, -7),
+		DurationInMinutes: 120,
+		ReleaseStatus:     entity.ReleaseStatusNowPlaying,
+		AgeRating:         entity.AgeRatingSU,
+	}
+	if err := testRepo.Movie.Create(ctx, movie); err != nil {
+		t.Fatalf("create movie: %v", err)
+	}
+
+	startsAt := now.Add(48 * time.Hour)
+	schedule := &entity.Schedule{
+		Base:        entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		MovieID:     movie.ID,
+		HallID:      hall.ID,
+		ShowDate:    startsAt,
+		ShowTime:    startsAt,
+		StartsAt:    startsAt,
+		Price:       5000000,
+		Currency:    "IDR",
+		Status:      entity.ScheduleStatusPublished,
+		PublishedAt: &now,
+	}
+	if err := testRepo.Schedule.Create(ctx, schedule); err != nil {
+		t.Fatalf("create schedule: %v", err)
+	}
+
+	return &showFixture{Cinema: cinema, Hall: hall, Seats: seats, Movie: movie, Schedule: schedule}
+}
+
+func newTestUser(t *testing.T) *entity.User {
+	t.Helper()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	suffix := uuid.NewString()[:8]
+
+	user := &entity.User{
+		Base:          entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		Username:      "it_" + suffix,
+		Email:         "it_" + suffix + "@example.com",
+		PasswordHash:  "not-a-real-hash",
+		Role:          entity.RoleCustomer,
+		EmailVerified: true,
+		IsActive:      true,
+		Language:      "en",
+		Tier:          entity.TierStandard,
+	}
+	if err := testRepo.User.Create(context.Background(), user); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	return user
+}
+
+func newPendingBooking(userID uuid.UUID, schedule *entity.Schedule, seats int) *entity.Booking {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	price := schedule.Price * int64(seats)
+
+	return &entity.Booking{
+		Base:       entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		OrderID:    utils.GenerateOrderID("IT", now),
+		UserID:     userID,
+		ScheduleID: schedule.ID,
+		TotalSeats: seats,
+		TotalPrice: price,
+		Status:     entity.BookingStatusPending,
+		BasePrice:  price,
+		Currency:   schedule.Currency,
+
+		CustomerTier: entity.TierStandard,
+	}
+}
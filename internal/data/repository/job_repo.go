@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type JobRepository interface {
+	Create(ctx context.Context, job *entity.Job) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Job, error)
+	// ClaimDue moves job pending yang run_at-nya lewat (dan running yang lease-nya habis) ke running
+	// sampai lockedUntil, sekaligus menaikkan attempts. SKIP LOCKED supaya instance lain tidak
+	// mengambil job yang sama.
+	ClaimDue(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Job, error)
+	MarkCompleted(ctx context.Context, id uuid.UUID, now time.Time) error
+	// MarkFailed menjadwalkan ulang job di retryAt; retryAt nil memindahkan job ke dead letter
+	MarkFailed(ctx context.Context, id uuid.UUID, lastError string, retryAt *time.Time, now time.Time) error
+	// Requeue mengembalikan job dead ke pending dengan attempts dari nol
+	Requeue(ctx context.Context, id uuid.UUID, now time.Time) error
+	// DeleteCompletedBefore menghapus job selesai yang lebih tua dari before, maksimal limit baris
+	DeleteCompletedBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+	FindAll(ctx context.Context, filter JobFilter, limit, offset int) ([]*entity.Job, error)
+	CountAll(ctx context.Context, filter JobFilter) (int64, error)
+	CountByStatus(ctx context.Context) (map[entity.JobStatus]int64, error)
+}
+
+// JobFilter untuk daftar job admin; nil field = tidak difilter
+type JobFilter struct {
+	Type   *string
+	Status *entity.JobStatus
+}
+
+// args returns $1..$2 for the admin list WHERE clause
+func (f JobFilter) args() []any {
+	var status *string
+	if f.Status != nil {
+		value := string(*f.Status)
+		status = &value
+	}
+	return []any{f.Type, status}
+}
+
+const jobFilterSQL = `($1::text IS NULL OR type = $1::text) AND ($2::text IS NULL OR status = $2::text)`
+
+const jobColumns = `id, type, payload, status, attempts, max_attempts, run_at, locked_until,
+		last_error, completed_at, created_at, updated_at`
+
+type jobRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewJobRepository(db database.PgxIface, log *zap.Logger) JobRepository {
+	return &jobRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "job")),
+	}
+}
+
+func (r *jobRepository) Create(ctx context.Context, job *entity.Job) error {
+	query := `
+		INSERT INTO jobs (id, type, payload, status, attempts, max_attempts, run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		job.ID,
+		job.Type,
+		job.Payload,
+		job.Status,
+		job.Attempts,
+		job.MaxAttempts,
+		job.RunAt,
+		job.CreatedAt,
+		job.UpdatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to create job", zap.Error(err), zap.String("type", job.Type))
+		return fmt.Errorf("create job %s: %w", job.Type, err)
+	}
+
+	return nil
+}
+
+func (r *jobRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE id = $1`
+
+	job, err := scanJob(r.db.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find job", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("find job %s: %w", id.String(), err)
+	}
+
+	return job, nil
+}
+
+func (r *jobRepository) ClaimDue(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Job, error) {
+	query := `
+		UPDATE jobs
+		SET status = 'running', attempts = attempts + 1, locked_until = $2, updated_at = $1
+		WHERE id IN (
+			SELECT id FROM jobs
+			WHERE (status = 'pending' AND run_at <= $1)
+			   OR (status = 'running' AND locked_until < $1)
+			ORDER BY run_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + jobColumns
+
+	rows, err := r.db.Query(ctx, query, now, lockedUntil, limit)
+	if err != nil {
+		r.log.Error("Failed to claim due jobs", zap.Error(err))
+		return nil, fmt.Errorf("claim due jobs: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanJobs(rows)
+}
+
+func (r *jobRepository) MarkCompleted(ctx context.Context, id uuid.UUID, now time.Time) error {
+	query := `
+		UPDATE jobs
+		SET status = 'completed', locked_until = NULL, last_error = NULL, completed_at = $2, updated_at = $2
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, id, now)
+	if err != nil {
+		r.log.Error("Failed to mark job completed", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("mark job %s completed: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("job %s not found", id.String())
+	}
+
+	return nil
+}
+
+func (r *jobRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string, retryAt *time.Time, now time.Time) error {
+	query := `
+		UPDATE jobs
+		SET status = CASE WHEN $3::timestamp IS NULL THEN 'dead' ELSE 'pending' END,
+		    run_at = COALESCE($3::timestamp, run_at),
+		    locked_until = NULL, last_error = $2, updated_at = $4
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, id, lastError, retryAt, now)
+	if err != nil {
+		r.log.Error("Failed to mark job failed", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("mark job %s failed: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("job %s not found", id.String())
+	}
+
+	return nil
+}
+
+func (r *jobRepository) Requeue(ctx context.Context, id uuid.UUID, now time.Time) error {
+	query := `
+		UPDATE jobs
+		SET status = 'pending', attempts = 0, run_at = $2, updated_at = $2
+		WHERE id = $1 AND status = 'dead'
+	`
+
+	result, err := r.db.Exec(ctx, query, id, now)
+	if err != nil {
+		r.log.Error("Failed to requeue job", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("requeue job %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("cannot requeue job %s: not in dead letter", id.String())
+	}
+
+	return nil
+}
+
+func (r *jobRepository) DeleteCompletedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM jobs
+		WHERE id IN (
+			SELECT id FROM jobs
+			WHERE status = 'completed' AND completed_at < $1
+			LIMIT $2
+		)
+	`
+
+	result, err := r.db.Exec(ctx, query, before, limit)
+	if err != nil {
+		r.log.Error("Failed to delete completed jobs", zap.Error(err))
+		return 0, fmt.Errorf("delete completed jobs: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *jobRepository) FindAll(ctx context.Context, filter JobFilter, limit, offset int) ([]*entity.Job, error) {
+	query := `
+		SELECT ` + jobColumns + `
+		FROM jobs
+		WHERE ` + jobFilterSQL + `
+		ORDER BY created_at DESC, id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, append(filter.args(), limit, offset)...)
+	if err != nil {
+		r.log.Error("Failed to find jobs", zap.Error(err))
+		return nil, fmt.Errorf("find jobs: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanJobs(rows)
+}
+
+func (r *jobRepository) CountAll(ctx context.Context, filter JobFilter) (int64, error) {
+	query := `SELECT COUNT(*) FROM jobs WHERE ` + jobFilterSQL
+
+	var total int64
+	if err := r.db.Reader().QueryRow(ctx, query, filter.args()...).Scan(&total); err != nil {
+		r.log.Error("Failed to count jobs", zap.Error(err))
+		return 0, fmt.Errorf("count jobs: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *jobRepository) CountByStatus(ctx context.Context) (map[entity.JobStatus]int64, error) {
+	query := `SELECT status, COUNT(*) FROM jobs GROUP BY status`
+
+	rows, err := r.db.Reader().Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to count jobs by status", zap.Error(err))
+		return nil, fmt.Errorf("count jobs by status: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[entity.JobStatus]int64)
+	for rows.Next() {
+		var status entity.JobStatus
+		var count int64
+		if err := rows.Scan(&status, &count); err != nil {
+			r.log.Error("Failed to scan job count row", zap.Error(err))
+			return nil, fmt.Errorf("scan job count row: %w", err)
+		}
+		counts[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate job count rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+func scanJob(row pgx.Row) (*entity.Job, error) {
+	var job entity.Job
+	err := row.Scan(
+		&job.ID,
+		&job.Type,
+		&job.Payload,
+		&job.Status,
+		&job.Attempts,
+		&job.MaxAttempts,
+		&job.RunAt,
+		&job.LockedUntil,
+		&job.LastError,
+		&job.CompletedAt,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (r *jobRepository) scanJobs(rows pgx.Rows) ([]*entity.Job, error) {
+	jobs := []*entity.Job{}
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			r.log.Error("Failed to scan job row", zap.Error(err))
+			return nil, fmt.Errorf("scan job row: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate job rows: %w", err)
+	}
+
+	return jobs, nil
+}
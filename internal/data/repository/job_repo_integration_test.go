@@ -0,0 +1,110 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+
+	"github.com/google/uuid"
+)
+
+func TestJobRepository_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	job := &entity.Job{
+		BaseNoDelete: entity.BaseNoDelete{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		Type:         "it.smoke." + uuid.NewString()[:8],
+		Payload:      json.RawMessage(`{"ok":true}`),
+		Status:       entity.JobStatusPending,
+		MaxAttempts:  3,
+		RunAt:        now.Add(-time.Second),
+	}
+	if err := testRepo.Job.Create(ctx, job); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+
+	claimed := claimJob(t, job.ID, now)
+	if claimed.Status != entity.JobStatusRunning || claimed.Attempts != 1 || claimed.LockedUntil == nil {
+		t.Fatalf("claimed job = %+v, want running, attempts 1 with lease", claimed)
+	}
+
+	// Lease masih berlaku, worker lain tidak boleh mengambil job yang sama
+	again, err := testRepo.Job.ClaimDue(ctx, now, now.Add(time.Minute), 100)
+	if err != nil {
+		t.Fatalf("claim again: %v", err)
+	}
+	for _, other := range again {
+		if other.ID == job.ID {
+			t.Fatalf("job %s claimed twice while leased", job.ID)
+		}
+	}
+
+	if err := testRepo.Job.MarkFailed(ctx, job.ID, "boom", nil, now); err != nil {
+		t.Fatalf("mark failed: %v", err)
+	}
+	dead := findJob(t, job.ID)
+	if dead.Status != entity.JobStatusDead || dead.LastError == nil || *dead.LastError != "boom" {
+		t.Fatalf("job after final failure = %+v, want dead with last error", dead)
+	}
+
+	if err := testRepo.Job.Requeue(ctx, job.ID, now); err != nil {
+		t.Fatalf("requeue: %v", err)
+	}
+	if err := testRepo.Job.Requeue(ctx, job.ID, now); err == nil {
+		t.Fatalf("requeue pending job: want error, got nil")
+	}
+	requeued := findJob(t, job.ID)
+	if requeued.Status != entity.JobStatusPending || requeued.Attempts != 0 {
+		t.Fatalf("requeued job = %+v, want pending with attempts 0", requeued)
+	}
+
+	claimJob(t, job.ID, now)
+	if err := testRepo.Job.MarkCompleted(ctx, job.ID, now); err != nil {
+		t.Fatalf("mark completed: %v", err)
+	}
+	completed := findJob(t, job.ID)
+	if completed.Status != entity.JobStatusCompleted || completed.CompletedAt == nil || completed.LockedUntil != nil {
+		t.Fatalf("completed job = %+v, want completed without lease", completed)
+	}
+
+	count, err := testRepo.Job.CountAll(ctx, repository.JobFilter{Type: &job.Type})
+	if err != nil || count != 1 {
+		t.Fatalf("count by type = %d, %v; want 1, nil", count, err)
+	}
+}
+
+func claimJob(t *testing.T, id uuid.UUID, now time.Time) *entity.Job {
+	t.Helper()
+
+	jobs, err := testRepo.Job.ClaimDue(context.Background(), now, now.Add(time.Minute), 100)
+	if err != nil {
+		t.Fatalf("claim due jobs: %v", err)
+	}
+	for _, job := range jobs {
+		if job.ID == id {
+			return job
+		}
+	}
+	t.Fatalf("job %s not claimed", id)
+	return nil
+}
+
+func findJob(t *testing.T, id uuid.UUID) *entity.Job {
+	t.Helper()
+
+	job, err := testRepo.Job.FindByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("find job: %v", err)
+	}
+	if job == nil {
+		t.Fatalf("job %s not found", id)
+	}
+	return job
+}
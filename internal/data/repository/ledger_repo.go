@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"go.uber.org/zap"
+)
+
+type LedgerRepository interface {
+	// Post inserts transaksi beserta entry-nya; false kalau (kind, reference_id) sudah pernah diposting.
+	// Harus dipanggil di dalam WithTx supaya header dan entry commit bersama.
+	Post(ctx context.Context, transaction *entity.LedgerTransaction) (bool, error)
+
+	GetAccountBalances(ctx context.Context) ([]*entity.LedgerAccountBalance, error)
+
+	// Invariant checks, semuanya baca primary supaya tidak ada false alarm karena replica lag
+	FindUnbalanced(ctx context.Context, limit int) ([]*entity.LedgerImbalance, error)
+	FindGiftCardLiabilityMismatches(ctx context.Context) ([]*entity.LedgerMismatch, error)
+	FindPaymentClearingMismatches(ctx context.Context) ([]*entity.LedgerMismatch, error)
+	FindPaymentMismatches(ctx context.Context, limit int) ([]*entity.LedgerMismatch, error)
+}
+
+type ledgerRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewLedgerRepository(db database.PgxIface, log *zap.Logger) LedgerRepository {
+	return &ledgerRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "ledger")),
+	}
+}
+
+func (r *ledgerRepository) Post(ctx context.Context, transaction *entity.LedgerTransaction) (bool, error) {
+	query := `
+		INSERT INTO ledger_transactions (id, kind, reference_id, booking_id, currency, description, occurred_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (kind, reference_id) DO NOTHING
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		transaction.ID,
+		transaction.Kind,
+		transaction.ReferenceID,
+		transaction.BookingID,
+		transaction.Currency,
+		transaction.Description,
+		transaction.OccurredAt,
+		transaction.CreatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to create ledger transaction",
+			zap.Error(err),
+			zap.String("kind", string(transaction.Kind)),
+			zap.String("reference_id", transaction.ReferenceID.String()),
+		)
+		return false, fmt.Errorf("create ledger transaction %s: %w", transaction.Kind, err)
+	}
+	if result.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	query = `INSERT INTO ledger_entries (id, transaction_id, account, debit, credit) VALUES `
+	args := []interface{}{}
+
+	for i, entry := range transaction.Entries {
+		if i > 0 {
+			query += ", "
+		}
+		query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)",
+			i*5+1, i*5+2, i*5+3, i*5+4, i*5+5)
+
+		args = append(args, entry.ID, transaction.ID, entry.Account, entry.Debit, entry.Credit)
+	}
+
+	if _, err := r.db.Exec(ctx, query, args...); err != nil {
+		r.log.Error("Failed to create ledger entries",
+			zap.Error(err),
+			zap.String("transaction_id", transaction.ID.String()),
+			zap.Int("count", len(transaction.Entries)),
+		)
+		return false, fmt.Errorf("create ledger entries: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *ledgerRepository) GetAccountBalances(ctx context.Context) ([]*entity.LedgerAccountBalance, error) {
+	query := `
+		SELECT t.currency, e.account, COALESCE(SUM(e.debit), 0), COALESCE(SUM(e.credit), 0)
+		FROM ledger_entries e
+		INNER JOIN ledger_transactions t ON t.id = e.transaction_id
+		GROUP BY t.currency, e.account
+		ORDER BY t.currency, e.account
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to get ledger balances", zap.Error(err))
+		return nil, fmt.Errorf("get ledger balances: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*entity.LedgerAccountBalance
+	for rows.Next() {
+		var balance entity.LedgerAccountBalance
+		if err := rows.Scan(&balance.Currency, &balance.Account, &balance.Debit, &balance.Credit); err != nil {
+			r.log.Error("Failed to scan ledger balance", zap.Error(err))
+			return nil, fmt.Errorf("scan ledger balance: %w", err)
+		}
+		result = append(result, &balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate ledger balances: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *ledgerRepository) FindUnbalanced(ctx context.Context, limit int) ([]*entity.LedgerImbalance, error) {
+	query := `
+		SELECT t.id, t.kind, t.reference_id, t.currency,
+		       COALESCE(SUM(e.debit), 0) AS debit, COALESCE(SUM(e.credit), 0) AS credit
+		FROM ledger_transactions t
+		LEFT JOIN ledger_entries e ON e.transaction_id = t.id
+		GROUP BY t.id, t.kind, t.reference_id, t.currency
+		HAVING COUNT(e.id) < 2 OR COALESCE(SUM(e.debit), 0) <> COALESCE(SUM(e.credit), 0)
+		ORDER BY t.occurred_at
+		LIMIT $1
+	`
+
+	rows, err := r.db.Query(ctx, query, limit)
+	if err != nil {
+		r.log.Error("Failed to find unbalanced ledger transactions", zap.Error(err))
+		return nil, fmt.Errorf("find unbalanced ledger transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*entity.LedgerImbalance
+	for rows.Next() {
+		var row entity.LedgerImbalance
+		if err := rows.Scan(&row.TransactionID, &row.Kind, &row.ReferenceID, &row.Currency, &row.Debit, &row.Credit); err != nil {
+			r.log.Error("Failed to scan unbalanced ledger transaction", zap.Error(err))
+			return nil, fmt.Errorf("scan unbalanced ledger transaction: %w", err)
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate unbalanced ledger transactions: %w", err)
+	}
+
+	return result, nil
+}
+
+// ledgerAccountSumSQL saldo credit bersih satu akun per currency; $1 = account
+const ledgerAccountSumSQL = `
+	SELECT t.currency, SUM(e.credit - e.debit) AS posted
+	FROM ledger_entries e
+	INNER JOIN ledger_transactions t ON t.id = e.transaction_id
+	WHERE e.account = $1
+	GROUP BY t.currency
+`
+
+// FindGiftCardLiabilityMismatches compares saldo akun gift_card_liability dengan total saldo semua gift card.
+// Kartu nonaktif / expired tetap dihitung: saldonya dibekukan, bukan dihapus.
+func (r *ledgerRepository) FindGiftCardLiabilityMismatches(ctx context.Context) ([]*entity.LedgerMismatch, error) {
+	query := `
+		WITH expected AS (
+			SELECT currency, SUM(balance) AS expected FROM gift_cards GROUP BY currency
+		), posted AS (` + ledgerAccountSumSQL + `)
+		SELECT NULL::uuid, COALESCE(x.currency, p.currency), COALESCE(x.expected, 0), COALESCE(p.posted, 0)
+		FROM expected x
+		FULL JOIN posted p ON p.currency = x.currency
+		WHERE COALESCE(x.expected, 0) <> COALESCE(p.posted, 0)
+	`
+
+	return r.findMismatches(ctx, "gift card liability", query, entity.LedgerAccountGiftCardLiability)
+}
+
+// FindPaymentClearingMismatches compares akun payment_clearing dengan saldo gift yang ditahan payment pending
+func (r *ledgerRepository) FindPaymentClearingMismatches(ctx context.Context) ([]*entity.LedgerMismatch, error) {
+	query := `
+		WITH expected AS (
+			SELECT currency, SUM(gift_card_amount) AS expected
+			FROM payments
+			WHERE status = 'pending' AND gift_card_amount > 0
+			GROUP BY currency
+		), posted AS (` + ledgerAccountSumSQL + `)
+		SELECT NULL::uuid, COALESCE(x.currency, p.currency), COALESCE(x.expected, 0), COALESCE(p.posted, 0)
+		FROM expected x
+		FULL JOIN posted p ON p.currency = x.currency
+		WHERE COALESCE(x.expected, 0) <> COALESCE(p.posted, 0)
+	`
+
+	return r.findMismatches(ctx, "payment clearing", query, entity.LedgerAccountPaymentClearing)
+}
+
+// FindPaymentMismatches returns payment yang pernah completed (termasuk yang sudah di-refund) yang debit cash + payment_clearing di transaksi
+// payment_completed-nya tidak sama dengan amount + gift_card_amount
+func (r *ledgerRepository) FindPaymentMismatches(ctx context.Context, limit int) ([]*entity.LedgerMismatch, error) {
+	query := `
+		SELECT p.id, p.currency, p.amount + p.gift_card_amount AS expected,
+		       COALESCE(SUM(e.debit) FILTER (WHERE e.account IN ($1, $2)), 0) AS posted
+		FROM payments p
+		LEFT JOIN ledger_transactions t ON t.kind = $3 AND t.reference_id = p.id
+		LEFT JOIN ledger_entries e ON e.transaction_id = t.id
+		WHERE p.status IN ('completed', 'refunded', 'partially_refunded')
+		GROUP BY p.id, p.currency, p.amount, p.gift_card_amount, p.created_at
+		HAVING p.amount + p.gift_card_amount <> COALESCE(SUM(e.debit) FILTER (WHERE e.account IN ($1, $2)), 0)
+		ORDER BY p.created_at
+		LIMIT $4
+	`
+
+	return r.findMismatches(ctx, "payment", query,
+		entity.LedgerAccountCash, entity.LedgerAccountPaymentClearing, entity.LedgerKindPaymentCompleted, limit)
+}
+
+func (r *ledgerRepository) findMismatches(ctx context.Context, check, query string, args ...any) ([]*entity.LedgerMismatch, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to check ledger", zap.Error(err), zap.String("check", check))
+		return nil, fmt.Errorf("check %s ledger: %w", check, err)
+	}
+
+	defer rows.Close()
+
+	var result []*entity.LedgerMismatch
+	for rows.Next() {
+		var mismatch entity.LedgerMismatch
+		if err := rows.Scan(&mismatch.ReferenceID, &mismatch.Currency, &mismatch.Expected, &mismatch.Posted); err != nil {
+			r.log.Error("Failed to scan ledger mismatch", zap.Error(err), zap.String("check", check))
+			return nil, fmt.Errorf("scan %s ledger mismatch: %w", check, err)
+		}
+		result = append(result, &mismatch)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate %s ledger mismatches: %w", check, err)
+	}
+
+	return result, nil
+}
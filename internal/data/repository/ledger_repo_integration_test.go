@@ -0,0 +1,119 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+
+	"github.com/google/uuid"
+)
+
+// Currency kode uji ISO 4217 supaya saldo tidak tercampur transaksi fixture lain
+const (
+	ledgerTestCurrency       = "XTS"
+	ledgerUnbalancedCurrency = "XXX"
+)
+
+func newLedgerTransaction(kind entity.LedgerKind, referenceID uuid.UUID, currency string) *entity.LedgerTransaction {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return &entity.LedgerTransaction{
+		BaseSimple:  entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+		Kind:        kind,
+		ReferenceID: referenceID,
+		Currency:    currency,
+		OccurredAt:  now,
+	}
+}
+
+func postLedger(t *testing.T, transaction *entity.LedgerTransaction) bool {
+	t.Helper()
+
+	var posted bool
+	err := testRepo.WithTx(context.Background(), func(tx *repository.Repository) error {
+		var err error
+		posted, err = tx.Ledger.Post(context.Background(), transaction)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("post ledger transaction: %v", err)
+	}
+	return posted
+}
+
+func TestLedgerRepository_PostIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	referenceID := uuid.New()
+
+	first := newLedgerTransaction(entity.LedgerKindGiftCardIssue, referenceID, ledgerTestCurrency).
+		Debit(entity.LedgerAccountCash, 250000).
+		Credit(entity.LedgerAccountGiftCardLiability, 250000)
+	if !postLedger(t, first) {
+		t.Fatalf("first post = false, want true")
+	}
+
+	// Retry worker dengan ID transaksi baru untuk kejadian yang sama tidak boleh memposting ulang
+	retry := newLedgerTransaction(entity.LedgerKindGiftCardIssue, referenceID, ledgerTestCurrency).
+		Debit(entity.LedgerAccountCash, 250000).
+		Credit(entity.LedgerAccountGiftCardLiability, 250000)
+	if postLedger(t, retry) {
+		t.Fatalf("duplicate (kind, reference_id) post = true, want false")
+	}
+
+	balances, err := testRepo.Ledger.GetAccountBalances(ctx)
+	if err != nil {
+		t.Fatalf("get account balances: %v", err)
+	}
+
+	got := map[entity.LedgerAccount]*entity.LedgerAccountBalance{}
+	for _, balance := range balances {
+		if balance.Currency == ledgerTestCurrency {
+			got[balance.Account] = balance
+		}
+	}
+	if cash := got[entity.LedgerAccountCash]; cash == nil || cash.Debit != 250000 || cash.Credit != 0 {
+		t.Fatalf("cash balance = %+v, want debit 250000", cash)
+	}
+	if liability := got[entity.LedgerAccountGiftCardLiability]; liability == nil || liability.Credit != 250000 || liability.Debit != 0 {
+		t.Fatalf("gift card liability balance = %+v, want credit 250000", liability)
+	}
+}
+
+func TestLedgerRepository_FindUnbalanced(t *testing.T) {
+	ctx := context.Background()
+
+	balanced := newLedgerTransaction(entity.LedgerKindGiftCardIssue, uuid.New(), ledgerUnbalancedCurrency).
+		Debit(entity.LedgerAccountCash, 1000).
+		Credit(entity.LedgerAccountGiftCardLiability, 1000)
+	postLedger(t, balanced)
+
+	// Repository tidak memvalidasi keseimbangan; itu tugas invariant check
+	broken := newLedgerTransaction(entity.LedgerKindGiftCardIssue, uuid.New(), ledgerUnbalancedCurrency).
+		Debit(entity.LedgerAccountCash, 1000).
+		Credit(entity.LedgerAccountGiftCardLiability, 900)
+	postLedger(t, broken)
+
+	unbalanced, err := testRepo.Ledger.FindUnbalanced(ctx, 100)
+	if err != nil {
+		t.Fatalf("find unbalanced: %v", err)
+	}
+
+	found := map[uuid.UUID]*entity.LedgerImbalance{}
+	for _, row := range unbalanced {
+		found[row.TransactionID] = row
+	}
+	if _, ok := found[balanced.ID]; ok {
+		t.Fatalf("balanced transaction %s reported as unbalanced", balanced.ID)
+	}
+	row, ok := found[broken.ID]
+	if !ok {
+		t.Fatalf("unbalanced transaction %s not reported", broken.ID)
+	}
+	if row.Debit != 1000 || row.Credit != 900 {
+		t.Fatalf("imbalance = debit %d credit %d, want 1000 / 900", row.Debit, row.Credit)
+	}
+}
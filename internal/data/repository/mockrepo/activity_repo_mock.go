@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: activity_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=activity_repo.go -destination=mockrepo/activity_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActivityRepository is a mock of ActivityRepository interface.
+type MockActivityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityRepositoryMockRecorder is the mock recorder for MockActivityRepository.
+type MockActivityRepositoryMockRecorder struct {
+	mock *MockActivityRepository
+}
+
+// NewMockActivityRepository creates a new mock instance.
+func NewMockActivityRepository(ctrl *gomock.Controller) *MockActivityRepository {
+	mock := &MockActivityRepository{ctrl: ctrl}
+	mock.recorder = &MockActivityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityRepository) EXPECT() *MockActivityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByUser mocks base method.
+func (m *MockActivityRepository) CountByUser(ctx context.Context, userID uuid.UUID, activityType *entity.ActivityType) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUser", ctx, userID, activityType)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUser indicates an expected call of CountByUser.
+func (mr *MockActivityRepositoryMockRecorder) CountByUser(ctx, userID, activityType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUser", reflect.TypeOf((*MockActivityRepository)(nil).CountByUser), ctx, userID, activityType)
+}
+
+// FindByUser mocks base method.
+func (m *MockActivityRepository) FindByUser(ctx context.Context, userID uuid.UUID, activityType *entity.ActivityType, limit, offset int) ([]*entity.UserActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUser", ctx, userID, activityType, limit, offset)
+	ret0, _ := ret[0].([]*entity.UserActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUser indicates an expected call of FindByUser.
+func (mr *MockActivityRepositoryMockRecorder) FindByUser(ctx, userID, activityType, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUser", reflect.TypeOf((*MockActivityRepository)(nil).FindByUser), ctx, userID, activityType, limit, offset)
+}
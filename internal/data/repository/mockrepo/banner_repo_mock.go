@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: banner_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=banner_repo.go -destination=mockrepo/banner_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBannerRepository is a mock of BannerRepository interface.
+type MockBannerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBannerRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBannerRepositoryMockRecorder is the mock recorder for MockBannerRepository.
+type MockBannerRepositoryMockRecorder struct {
+	mock *MockBannerRepository
+}
+
+// NewMockBannerRepository creates a new mock instance.
+func NewMockBannerRepository(ctrl *gomock.Controller) *MockBannerRepository {
+	mock := &MockBannerRepository{ctrl: ctrl}
+	mock.recorder = &MockBannerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBannerRepository) EXPECT() *MockBannerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBannerRepository) Create(ctx context.Context, banner *entity.Banner) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, banner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBannerRepositoryMockRecorder) Create(ctx, banner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBannerRepository)(nil).Create), ctx, banner)
+}
+
+// Delete mocks base method.
+func (m *MockBannerRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBannerRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBannerRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockBannerRepository) FindAll(ctx context.Context) ([]*entity.Banner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entity.Banner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockBannerRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockBannerRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockBannerRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Banner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Banner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockBannerRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBannerRepository)(nil).FindByID), ctx, id)
+}
+
+// FindLive mocks base method.
+func (m *MockBannerRepository) FindLive(ctx context.Context, now time.Time, city string) ([]*entity.Banner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLive", ctx, now, city)
+	ret0, _ := ret[0].([]*entity.Banner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLive indicates an expected call of FindLive.
+func (mr *MockBannerRepositoryMockRecorder) FindLive(ctx, now, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLive", reflect.TypeOf((*MockBannerRepository)(nil).FindLive), ctx, now, city)
+}
+
+// Update mocks base method.
+func (m *MockBannerRepository) Update(ctx context.Context, banner *entity.Banner) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, banner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBannerRepositoryMockRecorder) Update(ctx, banner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBannerRepository)(nil).Update), ctx, banner)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_history_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=booking_history_repo.go -destination=mockrepo/booking_history_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookingHistoryRepository is a mock of BookingHistoryRepository interface.
+type MockBookingHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBookingHistoryRepositoryMockRecorder is the mock recorder for MockBookingHistoryRepository.
+type MockBookingHistoryRepositoryMockRecorder struct {
+	mock *MockBookingHistoryRepository
+}
+
+// NewMockBookingHistoryRepository creates a new mock instance.
+func NewMockBookingHistoryRepository(ctrl *gomock.Controller) *MockBookingHistoryRepository {
+	mock := &MockBookingHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockBookingHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingHistoryRepository) EXPECT() *MockBookingHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBookingHistoryRepository) Create(ctx context.Context, change *entity.BookingStatusChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBookingHistoryRepositoryMockRecorder) Create(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookingHistoryRepository)(nil).Create), ctx, change)
+}
+
+// FindByBookingID mocks base method.
+func (m *MockBookingHistoryRepository) FindByBookingID(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]*entity.BookingStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBookingID indicates an expected call of FindByBookingID.
+func (mr *MockBookingHistoryRepositoryMockRecorder) FindByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBookingID", reflect.TypeOf((*MockBookingHistoryRepository)(nil).FindByBookingID), ctx, bookingID)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_note_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=booking_note_repo.go -destination=mockrepo/booking_note_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookingNoteRepository is a mock of BookingNoteRepository interface.
+type MockBookingNoteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingNoteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBookingNoteRepositoryMockRecorder is the mock recorder for MockBookingNoteRepository.
+type MockBookingNoteRepositoryMockRecorder struct {
+	mock *MockBookingNoteRepository
+}
+
+// NewMockBookingNoteRepository creates a new mock instance.
+func NewMockBookingNoteRepository(ctrl *gomock.Controller) *MockBookingNoteRepository {
+	mock := &MockBookingNoteRepository{ctrl: ctrl}
+	mock.recorder = &MockBookingNoteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingNoteRepository) EXPECT() *MockBookingNoteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateNote mocks base method.
+func (m *MockBookingNoteRepository) CreateNote(ctx context.Context, note *entity.BookingNote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNote", ctx, note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNote indicates an expected call of CreateNote.
+func (mr *MockBookingNoteRepositoryMockRecorder) CreateNote(ctx, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNote", reflect.TypeOf((*MockBookingNoteRepository)(nil).CreateNote), ctx, note)
+}
+
+// FindFlags mocks base method.
+func (m *MockBookingNoteRepository) FindFlags(ctx context.Context, bookingIDs []uuid.UUID) (map[uuid.UUID][]entity.BookingFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFlags", ctx, bookingIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]entity.BookingFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFlags indicates an expected call of FindFlags.
+func (mr *MockBookingNoteRepositoryMockRecorder) FindFlags(ctx, bookingIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlags", reflect.TypeOf((*MockBookingNoteRepository)(nil).FindFlags), ctx, bookingIDs)
+}
+
+// FindNotes mocks base method.
+func (m *MockBookingNoteRepository) FindNotes(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNotes", ctx, bookingID)
+	ret0, _ := ret[0].([]*entity.BookingNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNotes indicates an expected call of FindNotes.
+func (mr *MockBookingNoteRepositoryMockRecorder) FindNotes(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotes", reflect.TypeOf((*MockBookingNoteRepository)(nil).FindNotes), ctx, bookingID)
+}
+
+// ReplaceFlags mocks base method.
+func (m *MockBookingNoteRepository) ReplaceFlags(ctx context.Context, bookingID uuid.UUID, flags []entity.BookingFlag, createdBy uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceFlags", ctx, bookingID, flags, createdBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceFlags indicates an expected call of ReplaceFlags.
+func (mr *MockBookingNoteRepositoryMockRecorder) ReplaceFlags(ctx, bookingID, flags, createdBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFlags", reflect.TypeOf((*MockBookingNoteRepository)(nil).ReplaceFlags), ctx, bookingID, flags, createdBy)
+}
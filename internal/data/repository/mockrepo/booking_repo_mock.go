@@ -0,0 +1,338 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=booking_repo.go -destination=mockrepo/booking_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookingRepository is a mock of BookingRepository interface.
+type MockBookingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBookingRepositoryMockRecorder is the mock recorder for MockBookingRepository.
+type MockBookingRepositoryMockRecorder struct {
+	mock *MockBookingRepository
+}
+
+// NewMockBookingRepository creates a new mock instance.
+func NewMockBookingRepository(ctrl *gomock.Controller) *MockBookingRepository {
+	mock := &MockBookingRepository{ctrl: ctrl}
+	mock.recorder = &MockBookingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingRepository) EXPECT() *MockBookingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountAll mocks base method.
+func (m *MockBookingRepository) CountAll(ctx context.Context, filter repository.AdminBookingFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockBookingRepositoryMockRecorder) CountAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockBookingRepository)(nil).CountAll), ctx, filter)
+}
+
+// CountByUserID mocks base method.
+func (m *MockBookingRepository) CountByUserID(ctx context.Context, userID uuid.UUID, filter repository.BookingFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUserID", ctx, userID, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUserID indicates an expected call of CountByUserID.
+func (mr *MockBookingRepositoryMockRecorder) CountByUserID(ctx, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockBookingRepository)(nil).CountByUserID), ctx, userID, filter)
+}
+
+// Create mocks base method.
+func (m *MockBookingRepository) Create(ctx context.Context, booking *entity.Booking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, booking)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBookingRepositoryMockRecorder) Create(ctx, booking any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookingRepository)(nil).Create), ctx, booking)
+}
+
+// Delete mocks base method.
+func (m *MockBookingRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBookingRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookingRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockBookingRepository) FindAll(ctx context.Context, filter repository.AdminBookingFilter, limit, offset int) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockBookingRepositoryMockRecorder) FindAll(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockBookingRepository)(nil).FindAll), ctx, filter, limit, offset)
+}
+
+// FindAllAfter mocks base method.
+func (m *MockBookingRepository) FindAllAfter(ctx context.Context, filter repository.AdminBookingFilter, cursor *repository.Cursor, limit int) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllAfter", ctx, filter, cursor, limit)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllAfter indicates an expected call of FindAllAfter.
+func (mr *MockBookingRepositoryMockRecorder) FindAllAfter(ctx, filter, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllAfter", reflect.TypeOf((*MockBookingRepository)(nil).FindAllAfter), ctx, filter, cursor, limit)
+}
+
+// FindByID mocks base method.
+func (m *MockBookingRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockBookingRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBookingRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByIDForUpdate mocks base method.
+func (m *MockBookingRepository) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDForUpdate", ctx, id)
+	ret0, _ := ret[0].(*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDForUpdate indicates an expected call of FindByIDForUpdate.
+func (mr *MockBookingRepositoryMockRecorder) FindByIDForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDForUpdate", reflect.TypeOf((*MockBookingRepository)(nil).FindByIDForUpdate), ctx, id)
+}
+
+// FindByOrderID mocks base method.
+func (m *MockBookingRepository) FindByOrderID(ctx context.Context, orderID string) (*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderID", ctx, orderID)
+	ret0, _ := ret[0].(*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrderID indicates an expected call of FindByOrderID.
+func (mr *MockBookingRepositoryMockRecorder) FindByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderID", reflect.TypeOf((*MockBookingRepository)(nil).FindByOrderID), ctx, orderID)
+}
+
+// FindByScheduleID mocks base method.
+func (m *MockBookingRepository) FindByScheduleID(ctx context.Context, scheduleID uuid.UUID) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByScheduleID", ctx, scheduleID)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByScheduleID indicates an expected call of FindByScheduleID.
+func (mr *MockBookingRepositoryMockRecorder) FindByScheduleID(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByScheduleID", reflect.TypeOf((*MockBookingRepository)(nil).FindByScheduleID), ctx, scheduleID)
+}
+
+// FindByUserID mocks base method.
+func (m *MockBookingRepository) FindByUserID(ctx context.Context, userID uuid.UUID, filter repository.BookingFilter, limit, offset int) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID, filter, limit, offset)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockBookingRepositoryMockRecorder) FindByUserID(ctx, userID, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockBookingRepository)(nil).FindByUserID), ctx, userID, filter, limit, offset)
+}
+
+// FindByUserIDAfter mocks base method.
+func (m *MockBookingRepository) FindByUserIDAfter(ctx context.Context, userID uuid.UUID, filter repository.BookingFilter, cursor *repository.Cursor, limit int) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDAfter", ctx, userID, filter, cursor, limit)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDAfter indicates an expected call of FindByUserIDAfter.
+func (mr *MockBookingRepositoryMockRecorder) FindByUserIDAfter(ctx, userID, filter, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAfter", reflect.TypeOf((*MockBookingRepository)(nil).FindByUserIDAfter), ctx, userID, filter, cursor, limit)
+}
+
+// FindConfirmedByScheduleID mocks base method.
+func (m *MockBookingRepository) FindConfirmedByScheduleID(ctx context.Context, scheduleID uuid.UUID) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindConfirmedByScheduleID", ctx, scheduleID)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindConfirmedByScheduleID indicates an expected call of FindConfirmedByScheduleID.
+func (mr *MockBookingRepositoryMockRecorder) FindConfirmedByScheduleID(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindConfirmedByScheduleID", reflect.TypeOf((*MockBookingRepository)(nil).FindConfirmedByScheduleID), ctx, scheduleID)
+}
+
+// FindNextUpcomingByUserID mocks base method.
+func (m *MockBookingRepository) FindNextUpcomingByUserID(ctx context.Context, userID uuid.UUID) (*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNextUpcomingByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNextUpcomingByUserID indicates an expected call of FindNextUpcomingByUserID.
+func (mr *MockBookingRepositoryMockRecorder) FindNextUpcomingByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNextUpcomingByUserID", reflect.TypeOf((*MockBookingRepository)(nil).FindNextUpcomingByUserID), ctx, userID)
+}
+
+// FindPendingReminders mocks base method.
+func (m *MockBookingRepository) FindPendingReminders(ctx context.Context, from, to time.Time) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingReminders", ctx, from, to)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingReminders indicates an expected call of FindPendingReminders.
+func (mr *MockBookingRepositoryMockRecorder) FindPendingReminders(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingReminders", reflect.TypeOf((*MockBookingRepository)(nil).FindPendingReminders), ctx, from, to)
+}
+
+// MarkReminderSent mocks base method.
+func (m *MockBookingRepository) MarkReminderSent(ctx context.Context, bookingID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminderSent", ctx, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReminderSent indicates an expected call of MarkReminderSent.
+func (mr *MockBookingRepositoryMockRecorder) MarkReminderSent(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminderSent", reflect.TypeOf((*MockBookingRepository)(nil).MarkReminderSent), ctx, bookingID)
+}
+
+// Restore mocks base method.
+func (m *MockBookingRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockBookingRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockBookingRepository)(nil).Restore), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockBookingRepository) Update(ctx context.Context, booking *entity.Booking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, booking)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBookingRepositoryMockRecorder) Update(ctx, booking any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBookingRepository)(nil).Update), ctx, booking)
+}
+
+// UpdateCharges mocks base method.
+func (m *MockBookingRepository) UpdateCharges(ctx context.Context, booking *entity.Booking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCharges", ctx, booking)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCharges indicates an expected call of UpdateCharges.
+func (mr *MockBookingRepositoryMockRecorder) UpdateCharges(ctx, booking any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCharges", reflect.TypeOf((*MockBookingRepository)(nil).UpdateCharges), ctx, booking)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockBookingRepository) UpdateStatus(ctx context.Context, booking *entity.Booking, status entity.BookingStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, booking, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockBookingRepositoryMockRecorder) UpdateStatus(ctx, booking, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockBookingRepository)(nil).UpdateStatus), ctx, booking, status)
+}
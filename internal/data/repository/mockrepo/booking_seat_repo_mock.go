@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_seat_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=booking_seat_repo.go -destination=mockrepo/booking_seat_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookingSeatRepository is a mock of BookingSeatRepository interface.
+type MockBookingSeatRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingSeatRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBookingSeatRepositoryMockRecorder is the mock recorder for MockBookingSeatRepository.
+type MockBookingSeatRepositoryMockRecorder struct {
+	mock *MockBookingSeatRepository
+}
+
+// NewMockBookingSeatRepository creates a new mock instance.
+func NewMockBookingSeatRepository(ctrl *gomock.Controller) *MockBookingSeatRepository {
+	mock := &MockBookingSeatRepository{ctrl: ctrl}
+	mock.recorder = &MockBookingSeatRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingSeatRepository) EXPECT() *MockBookingSeatRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBookingSeatRepository) Create(ctx context.Context, bookingSeat *entity.BookingSeat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, bookingSeat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBookingSeatRepositoryMockRecorder) Create(ctx, bookingSeat any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookingSeatRepository)(nil).Create), ctx, bookingSeat)
+}
+
+// CreateBatch mocks base method.
+func (m *MockBookingSeatRepository) CreateBatch(ctx context.Context, bookingSeats []*entity.BookingSeat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, bookingSeats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockBookingSeatRepositoryMockRecorder) CreateBatch(ctx, bookingSeats any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockBookingSeatRepository)(nil).CreateBatch), ctx, bookingSeats)
+}
+
+// DeleteByBookingID mocks base method.
+func (m *MockBookingSeatRepository) DeleteByBookingID(ctx context.Context, bookingID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByBookingID indicates an expected call of DeleteByBookingID.
+func (mr *MockBookingSeatRepositoryMockRecorder) DeleteByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByBookingID", reflect.TypeOf((*MockBookingSeatRepository)(nil).DeleteByBookingID), ctx, bookingID)
+}
+
+// FindBookedSeatsBySchedule mocks base method.
+func (m *MockBookingSeatRepository) FindBookedSeatsBySchedule(ctx context.Context, scheduleID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBookedSeatsBySchedule", ctx, scheduleID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBookedSeatsBySchedule indicates an expected call of FindBookedSeatsBySchedule.
+func (mr *MockBookingSeatRepositoryMockRecorder) FindBookedSeatsBySchedule(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBookedSeatsBySchedule", reflect.TypeOf((*MockBookingSeatRepository)(nil).FindBookedSeatsBySchedule), ctx, scheduleID)
+}
+
+// FindByBookingID mocks base method.
+func (m *MockBookingSeatRepository) FindByBookingID(ctx context.Context, bookingID uuid.UUID) ([]*entity.BookingSeat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]*entity.BookingSeat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBookingID indicates an expected call of FindByBookingID.
+func (mr *MockBookingSeatRepositoryMockRecorder) FindByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBookingID", reflect.TypeOf((*MockBookingSeatRepository)(nil).FindByBookingID), ctx, bookingID)
+}
+
+// FindBySeatID mocks base method.
+func (m *MockBookingSeatRepository) FindBySeatID(ctx context.Context, seatID uuid.UUID) ([]*entity.BookingSeat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeatID", ctx, seatID)
+	ret0, _ := ret[0].([]*entity.BookingSeat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeatID indicates an expected call of FindBySeatID.
+func (mr *MockBookingSeatRepositoryMockRecorder) FindBySeatID(ctx, seatID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeatID", reflect.TypeOf((*MockBookingSeatRepository)(nil).FindBySeatID), ctx, seatID)
+}
+
+// FindSeatNumbersByBookingID mocks base method.
+func (m *MockBookingSeatRepository) FindSeatNumbersByBookingID(ctx context.Context, bookingID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSeatNumbersByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSeatNumbersByBookingID indicates an expected call of FindSeatNumbersByBookingID.
+func (mr *MockBookingSeatRepositoryMockRecorder) FindSeatNumbersByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSeatNumbersByBookingID", reflect.TypeOf((*MockBookingSeatRepository)(nil).FindSeatNumbersByBookingID), ctx, bookingID)
+}
+
+// ReleaseByBookingID mocks base method.
+func (m *MockBookingSeatRepository) ReleaseByBookingID(ctx context.Context, bookingID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseByBookingID indicates an expected call of ReleaseByBookingID.
+func (mr *MockBookingSeatRepositoryMockRecorder) ReleaseByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseByBookingID", reflect.TypeOf((*MockBookingSeatRepository)(nil).ReleaseByBookingID), ctx, bookingID)
+}
@@ -0,0 +1,189 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cinema_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cinema_repo.go -destination=mockrepo/cinema_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCinemaRepository is a mock of CinemaRepository interface.
+type MockCinemaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCinemaRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCinemaRepositoryMockRecorder is the mock recorder for MockCinemaRepository.
+type MockCinemaRepositoryMockRecorder struct {
+	mock *MockCinemaRepository
+}
+
+// NewMockCinemaRepository creates a new mock instance.
+func NewMockCinemaRepository(ctrl *gomock.Controller) *MockCinemaRepository {
+	mock := &MockCinemaRepository{ctrl: ctrl}
+	mock.recorder = &MockCinemaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCinemaRepository) EXPECT() *MockCinemaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountAll mocks base method.
+func (m *MockCinemaRepository) CountAll(ctx context.Context, filter repository.CinemaFilter, includeDeleted bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, filter, includeDeleted)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockCinemaRepositoryMockRecorder) CountAll(ctx, filter, includeDeleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockCinemaRepository)(nil).CountAll), ctx, filter, includeDeleted)
+}
+
+// Create mocks base method.
+func (m *MockCinemaRepository) Create(ctx context.Context, cinema *entity.Cinema) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, cinema)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCinemaRepositoryMockRecorder) Create(ctx, cinema any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCinemaRepository)(nil).Create), ctx, cinema)
+}
+
+// Delete mocks base method.
+func (m *MockCinemaRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCinemaRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCinemaRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockCinemaRepository) FindAll(ctx context.Context, limit, offset int, filter repository.CinemaFilter, includeDeleted bool) ([]*entity.Cinema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, limit, offset, filter, includeDeleted)
+	ret0, _ := ret[0].([]*entity.Cinema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCinemaRepositoryMockRecorder) FindAll(ctx, limit, offset, filter, includeDeleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCinemaRepository)(nil).FindAll), ctx, limit, offset, filter, includeDeleted)
+}
+
+// FindByID mocks base method.
+func (m *MockCinemaRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Cinema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Cinema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCinemaRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCinemaRepository)(nil).FindByID), ctx, id)
+}
+
+// FindCities mocks base method.
+func (m *MockCinemaRepository) FindCities(ctx context.Context) ([]*entity.CityCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCities", ctx)
+	ret0, _ := ret[0].([]*entity.CityCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCities indicates an expected call of FindCities.
+func (mr *MockCinemaRepositoryMockRecorder) FindCities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCities", reflect.TypeOf((*MockCinemaRepository)(nil).FindCities), ctx)
+}
+
+// FindNearby mocks base method.
+func (m *MockCinemaRepository) FindNearby(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*entity.NearbyCinema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNearby", ctx, lat, lng, radiusKm, limit)
+	ret0, _ := ret[0].([]*entity.NearbyCinema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNearby indicates an expected call of FindNearby.
+func (mr *MockCinemaRepositoryMockRecorder) FindNearby(ctx, lat, lng, radiusKm, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearby", reflect.TypeOf((*MockCinemaRepository)(nil).FindNearby), ctx, lat, lng, radiusKm, limit)
+}
+
+// Restore mocks base method.
+func (m *MockCinemaRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCinemaRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCinemaRepository)(nil).Restore), ctx, id)
+}
+
+// SetClosure mocks base method.
+func (m *MockCinemaRepository) SetClosure(ctx context.Context, cinema *entity.Cinema) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetClosure", ctx, cinema)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetClosure indicates an expected call of SetClosure.
+func (mr *MockCinemaRepositoryMockRecorder) SetClosure(ctx, cinema any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClosure", reflect.TypeOf((*MockCinemaRepository)(nil).SetClosure), ctx, cinema)
+}
+
+// Update mocks base method.
+func (m *MockCinemaRepository) Update(ctx context.Context, cinema *entity.Cinema) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, cinema)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCinemaRepositoryMockRecorder) Update(ctx, cinema any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCinemaRepository)(nil).Update), ctx, cinema)
+}
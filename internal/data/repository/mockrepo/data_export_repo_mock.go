@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: data_export_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=data_export_repo.go -destination=mockrepo/data_export_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDataExportRepository is a mock of DataExportRepository interface.
+type MockDataExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataExportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDataExportRepositoryMockRecorder is the mock recorder for MockDataExportRepository.
+type MockDataExportRepositoryMockRecorder struct {
+	mock *MockDataExportRepository
+}
+
+// NewMockDataExportRepository creates a new mock instance.
+func NewMockDataExportRepository(ctrl *gomock.Controller) *MockDataExportRepository {
+	mock := &MockDataExportRepository{ctrl: ctrl}
+	mock.recorder = &MockDataExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataExportRepository) EXPECT() *MockDataExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountAll mocks base method.
+func (m *MockDataExportRepository) CountAll(ctx context.Context, filter repository.DataExportFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockDataExportRepositoryMockRecorder) CountAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockDataExportRepository)(nil).CountAll), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockDataExportRepository) Create(ctx context.Context, export *entity.DataExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataExportRepositoryMockRecorder) Create(ctx, export any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataExportRepository)(nil).Create), ctx, export)
+}
+
+// FindAll mocks base method.
+func (m *MockDataExportRepository) FindAll(ctx context.Context, filter repository.DataExportFilter, limit, offset int) ([]*entity.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]*entity.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDataExportRepositoryMockRecorder) FindAll(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDataExportRepository)(nil).FindAll), ctx, filter, limit, offset)
+}
+
+// FindByID mocks base method.
+func (m *MockDataExportRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockDataExportRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDataExportRepository)(nil).FindByID), ctx, id)
+}
+
+// FindExpired mocks base method.
+func (m *MockDataExportRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]*entity.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpired", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpired indicates an expected call of FindExpired.
+func (mr *MockDataExportRepositoryMockRecorder) FindExpired(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpired", reflect.TypeOf((*MockDataExportRepository)(nil).FindExpired), ctx, now, limit)
+}
+
+// FindLatestByUser mocks base method.
+func (m *MockDataExportRepository) FindLatestByUser(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestByUser", ctx, userID)
+	ret0, _ := ret[0].(*entity.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestByUser indicates an expected call of FindLatestByUser.
+func (mr *MockDataExportRepositoryMockRecorder) FindLatestByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestByUser", reflect.TypeOf((*MockDataExportRepository)(nil).FindLatestByUser), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockDataExportRepository) Update(ctx context.Context, export *entity.DataExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataExportRepositoryMockRecorder) Update(ctx, export any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataExportRepository)(nil).Update), ctx, export)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: feature_flag_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=feature_flag_repo.go -destination=mockrepo/feature_flag_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeatureFlagRepository is a mock of FeatureFlagRepository interface.
+type MockFeatureFlagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeatureFlagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFeatureFlagRepositoryMockRecorder is the mock recorder for MockFeatureFlagRepository.
+type MockFeatureFlagRepositoryMockRecorder struct {
+	mock *MockFeatureFlagRepository
+}
+
+// NewMockFeatureFlagRepository creates a new mock instance.
+func NewMockFeatureFlagRepository(ctrl *gomock.Controller) *MockFeatureFlagRepository {
+	mock := &MockFeatureFlagRepository{ctrl: ctrl}
+	mock.recorder = &MockFeatureFlagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeatureFlagRepository) EXPECT() *MockFeatureFlagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFeatureFlagRepository) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFeatureFlagRepositoryMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFeatureFlagRepository)(nil).Delete), ctx, key)
+}
+
+// FindAll mocks base method.
+func (m *MockFeatureFlagRepository) FindAll(ctx context.Context) ([]*entity.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entity.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFeatureFlagRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFeatureFlagRepository)(nil).FindAll), ctx)
+}
+
+// FindByKey mocks base method.
+func (m *MockFeatureFlagRepository) FindByKey(ctx context.Context, key string) (*entity.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKey", ctx, key)
+	ret0, _ := ret[0].(*entity.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockFeatureFlagRepositoryMockRecorder) FindByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockFeatureFlagRepository)(nil).FindByKey), ctx, key)
+}
+
+// Upsert mocks base method.
+func (m *MockFeatureFlagRepository) Upsert(ctx context.Context, flag *entity.FeatureFlag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, flag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockFeatureFlagRepositoryMockRecorder) Upsert(ctx, flag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockFeatureFlagRepository)(nil).Upsert), ctx, flag)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: funnel_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=funnel_repo.go -destination=mockrepo/funnel_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFunnelRepository is a mock of FunnelRepository interface.
+type MockFunnelRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFunnelRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFunnelRepositoryMockRecorder is the mock recorder for MockFunnelRepository.
+type MockFunnelRepositoryMockRecorder struct {
+	mock *MockFunnelRepository
+}
+
+// NewMockFunnelRepository creates a new mock instance.
+func NewMockFunnelRepository(ctrl *gomock.Controller) *MockFunnelRepository {
+	mock := &MockFunnelRepository{ctrl: ctrl}
+	mock.recorder = &MockFunnelRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFunnelRepository) EXPECT() *MockFunnelRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockFunnelRepository) Record(ctx context.Context, event *entity.FunnelEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockFunnelRepositoryMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockFunnelRepository)(nil).Record), ctx, event)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: genre_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=genre_repo.go -destination=mockrepo/genre_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGenreRepository is a mock of GenreRepository interface.
+type MockGenreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenreRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGenreRepositoryMockRecorder is the mock recorder for MockGenreRepository.
+type MockGenreRepositoryMockRecorder struct {
+	mock *MockGenreRepository
+}
+
+// NewMockGenreRepository creates a new mock instance.
+func NewMockGenreRepository(ctrl *gomock.Controller) *MockGenreRepository {
+	mock := &MockGenreRepository{ctrl: ctrl}
+	mock.recorder = &MockGenreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenreRepository) EXPECT() *MockGenreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockGenreRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGenreRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGenreRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockGenreRepository) FindAll(ctx context.Context) ([]*entity.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entity.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockGenreRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockGenreRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockGenreRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockGenreRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockGenreRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByMovieID mocks base method.
+func (m *MockGenreRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID) ([]*entity.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMovieID", ctx, movieID)
+	ret0, _ := ret[0].([]*entity.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMovieID indicates an expected call of FindByMovieID.
+func (mr *MockGenreRepositoryMockRecorder) FindByMovieID(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMovieID", reflect.TypeOf((*MockGenreRepository)(nil).FindByMovieID), ctx, movieID)
+}
+
+// FindNamesByMovieIDs mocks base method.
+func (m *MockGenreRepository) FindNamesByMovieIDs(ctx context.Context, movieIDs []uuid.UUID) (map[uuid.UUID][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNamesByMovieIDs", ctx, movieIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNamesByMovieIDs indicates an expected call of FindNamesByMovieIDs.
+func (mr *MockGenreRepositoryMockRecorder) FindNamesByMovieIDs(ctx, movieIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNamesByMovieIDs", reflect.TypeOf((*MockGenreRepository)(nil).FindNamesByMovieIDs), ctx, movieIDs)
+}
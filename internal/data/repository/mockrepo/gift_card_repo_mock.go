@@ -0,0 +1,219 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: gift_card_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=gift_card_repo.go -destination=mockrepo/gift_card_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGiftCardRepository is a mock of GiftCardRepository interface.
+type MockGiftCardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGiftCardRepositoryMockRecorder is the mock recorder for MockGiftCardRepository.
+type MockGiftCardRepositoryMockRecorder struct {
+	mock *MockGiftCardRepository
+}
+
+// NewMockGiftCardRepository creates a new mock instance.
+func NewMockGiftCardRepository(ctrl *gomock.Controller) *MockGiftCardRepository {
+	mock := &MockGiftCardRepository{ctrl: ctrl}
+	mock.recorder = &MockGiftCardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardRepository) EXPECT() *MockGiftCardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AdjustBalance mocks base method.
+func (m *MockGiftCardRepository) AdjustBalance(ctx context.Context, id uuid.UUID, delta int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustBalance", ctx, id, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdjustBalance indicates an expected call of AdjustBalance.
+func (mr *MockGiftCardRepositoryMockRecorder) AdjustBalance(ctx, id, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustBalance", reflect.TypeOf((*MockGiftCardRepository)(nil).AdjustBalance), ctx, id, delta)
+}
+
+// CountAll mocks base method.
+func (m *MockGiftCardRepository) CountAll(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockGiftCardRepositoryMockRecorder) CountAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockGiftCardRepository)(nil).CountAll), ctx)
+}
+
+// CreateBatch mocks base method.
+func (m *MockGiftCardRepository) CreateBatch(ctx context.Context, cards []*entity.GiftCard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, cards)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockGiftCardRepositoryMockRecorder) CreateBatch(ctx, cards any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockGiftCardRepository)(nil).CreateBatch), ctx, cards)
+}
+
+// CreateTransactions mocks base method.
+func (m *MockGiftCardRepository) CreateTransactions(ctx context.Context, transactions []*entity.GiftCardTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactions", ctx, transactions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransactions indicates an expected call of CreateTransactions.
+func (mr *MockGiftCardRepositoryMockRecorder) CreateTransactions(ctx, transactions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactions", reflect.TypeOf((*MockGiftCardRepository)(nil).CreateTransactions), ctx, transactions)
+}
+
+// Deactivate mocks base method.
+func (m *MockGiftCardRepository) Deactivate(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockGiftCardRepositoryMockRecorder) Deactivate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockGiftCardRepository)(nil).Deactivate), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockGiftCardRepository) FindAll(ctx context.Context, limit, offset int) ([]*entity.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entity.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockGiftCardRepositoryMockRecorder) FindAll(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockGiftCardRepository)(nil).FindAll), ctx, limit, offset)
+}
+
+// FindByCodeForUpdate mocks base method.
+func (m *MockGiftCardRepository) FindByCodeForUpdate(ctx context.Context, code string) (*entity.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCodeForUpdate", ctx, code)
+	ret0, _ := ret[0].(*entity.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCodeForUpdate indicates an expected call of FindByCodeForUpdate.
+func (mr *MockGiftCardRepositoryMockRecorder) FindByCodeForUpdate(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCodeForUpdate", reflect.TypeOf((*MockGiftCardRepository)(nil).FindByCodeForUpdate), ctx, code)
+}
+
+// FindByID mocks base method.
+func (m *MockGiftCardRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockGiftCardRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockGiftCardRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByUserID mocks base method.
+func (m *MockGiftCardRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entity.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockGiftCardRepositoryMockRecorder) FindByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockGiftCardRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// FindTransactionsByPaymentID mocks base method.
+func (m *MockGiftCardRepository) FindTransactionsByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*entity.GiftCardTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactionsByPaymentID", ctx, paymentID)
+	ret0, _ := ret[0].([]*entity.GiftCardTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactionsByPaymentID indicates an expected call of FindTransactionsByPaymentID.
+func (mr *MockGiftCardRepositoryMockRecorder) FindTransactionsByPaymentID(ctx, paymentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactionsByPaymentID", reflect.TypeOf((*MockGiftCardRepository)(nil).FindTransactionsByPaymentID), ctx, paymentID)
+}
+
+// FindUsableByUserForUpdate mocks base method.
+func (m *MockGiftCardRepository) FindUsableByUserForUpdate(ctx context.Context, userID uuid.UUID, currency string, now time.Time) ([]*entity.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsableByUserForUpdate", ctx, userID, currency, now)
+	ret0, _ := ret[0].([]*entity.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsableByUserForUpdate indicates an expected call of FindUsableByUserForUpdate.
+func (mr *MockGiftCardRepositoryMockRecorder) FindUsableByUserForUpdate(ctx, userID, currency, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsableByUserForUpdate", reflect.TypeOf((*MockGiftCardRepository)(nil).FindUsableByUserForUpdate), ctx, userID, currency, now)
+}
+
+// Redeem mocks base method.
+func (m *MockGiftCardRepository) Redeem(ctx context.Context, id, userID uuid.UUID, redeemedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, id, userID, redeemedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockGiftCardRepositoryMockRecorder) Redeem(ctx, id, userID, redeemedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockGiftCardRepository)(nil).Redeem), ctx, id, userID, redeemedAt)
+}
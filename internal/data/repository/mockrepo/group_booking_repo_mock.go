@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group_booking_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=group_booking_repo.go -destination=mockrepo/group_booking_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupBookingRepository is a mock of GroupBookingRepository interface.
+type MockGroupBookingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupBookingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupBookingRepositoryMockRecorder is the mock recorder for MockGroupBookingRepository.
+type MockGroupBookingRepositoryMockRecorder struct {
+	mock *MockGroupBookingRepository
+}
+
+// NewMockGroupBookingRepository creates a new mock instance.
+func NewMockGroupBookingRepository(ctrl *gomock.Controller) *MockGroupBookingRepository {
+	mock := &MockGroupBookingRepository{ctrl: ctrl}
+	mock.recorder = &MockGroupBookingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupBookingRepository) EXPECT() *MockGroupBookingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockGroupBookingRepository) Create(ctx context.Context, group *entity.GroupBooking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGroupBookingRepositoryMockRecorder) Create(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupBookingRepository)(nil).Create), ctx, group)
+}
+
+// FindByBookingID mocks base method.
+func (m *MockGroupBookingRepository) FindByBookingID(ctx context.Context, bookingID uuid.UUID) (*entity.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].(*entity.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBookingID indicates an expected call of FindByBookingID.
+func (mr *MockGroupBookingRepositoryMockRecorder) FindByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBookingID", reflect.TypeOf((*MockGroupBookingRepository)(nil).FindByBookingID), ctx, bookingID)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hall_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=hall_repo.go -destination=mockrepo/hall_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHallRepository is a mock of HallRepository interface.
+type MockHallRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHallRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockHallRepositoryMockRecorder is the mock recorder for MockHallRepository.
+type MockHallRepositoryMockRecorder struct {
+	mock *MockHallRepository
+}
+
+// NewMockHallRepository creates a new mock instance.
+func NewMockHallRepository(ctrl *gomock.Controller) *MockHallRepository {
+	mock := &MockHallRepository{ctrl: ctrl}
+	mock.recorder = &MockHallRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHallRepository) EXPECT() *MockHallRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockHallRepository) Create(ctx context.Context, hall *entity.Hall) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, hall)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockHallRepositoryMockRecorder) Create(ctx, hall any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockHallRepository)(nil).Create), ctx, hall)
+}
+
+// Delete mocks base method.
+func (m *MockHallRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockHallRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockHallRepository)(nil).Delete), ctx, id)
+}
+
+// FindByCinemaID mocks base method.
+func (m *MockHallRepository) FindByCinemaID(ctx context.Context, cinemaID uuid.UUID) ([]*entity.Hall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCinemaID", ctx, cinemaID)
+	ret0, _ := ret[0].([]*entity.Hall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCinemaID indicates an expected call of FindByCinemaID.
+func (mr *MockHallRepositoryMockRecorder) FindByCinemaID(ctx, cinemaID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCinemaID", reflect.TypeOf((*MockHallRepository)(nil).FindByCinemaID), ctx, cinemaID)
+}
+
+// FindByID mocks base method.
+func (m *MockHallRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Hall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Hall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockHallRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockHallRepository)(nil).FindByID), ctx, id)
+}
+
+// FindCapacityMismatches mocks base method.
+func (m *MockHallRepository) FindCapacityMismatches(ctx context.Context) ([]*repository.HallCapacityMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCapacityMismatches", ctx)
+	ret0, _ := ret[0].([]*repository.HallCapacityMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCapacityMismatches indicates an expected call of FindCapacityMismatches.
+func (mr *MockHallRepositoryMockRecorder) FindCapacityMismatches(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCapacityMismatches", reflect.TypeOf((*MockHallRepository)(nil).FindCapacityMismatches), ctx)
+}
+
+// LockByID mocks base method.
+func (m *MockHallRepository) LockByID(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockByID indicates an expected call of LockByID.
+func (mr *MockHallRepositoryMockRecorder) LockByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByID", reflect.TypeOf((*MockHallRepository)(nil).LockByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockHallRepository) Update(ctx context.Context, hall *entity.Hall) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, hall)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockHallRepositoryMockRecorder) Update(ctx, hall any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockHallRepository)(nil).Update), ctx, hall)
+}
@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=job_repo.go -destination=mockrepo/job_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJobRepository is a mock of JobRepository interface.
+type MockJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockJobRepositoryMockRecorder is the mock recorder for MockJobRepository.
+type MockJobRepositoryMockRecorder struct {
+	mock *MockJobRepository
+}
+
+// NewMockJobRepository creates a new mock instance.
+func NewMockJobRepository(ctrl *gomock.Controller) *MockJobRepository {
+	mock := &MockJobRepository{ctrl: ctrl}
+	mock.recorder = &MockJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepository) EXPECT() *MockJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockJobRepository) ClaimDue(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, lockedUntil, limit)
+	ret0, _ := ret[0].([]*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockJobRepositoryMockRecorder) ClaimDue(ctx, now, lockedUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockJobRepository)(nil).ClaimDue), ctx, now, lockedUntil, limit)
+}
+
+// CountAll mocks base method.
+func (m *MockJobRepository) CountAll(ctx context.Context, filter repository.JobFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockJobRepositoryMockRecorder) CountAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockJobRepository)(nil).CountAll), ctx, filter)
+}
+
+// CountByStatus mocks base method.
+func (m *MockJobRepository) CountByStatus(ctx context.Context) (map[entity.JobStatus]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStatus", ctx)
+	ret0, _ := ret[0].(map[entity.JobStatus]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStatus indicates an expected call of CountByStatus.
+func (mr *MockJobRepositoryMockRecorder) CountByStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockJobRepository)(nil).CountByStatus), ctx)
+}
+
+// Create mocks base method.
+func (m *MockJobRepository) Create(ctx context.Context, job *entity.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockJobRepositoryMockRecorder) Create(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockJobRepository)(nil).Create), ctx, job)
+}
+
+// DeleteCompletedBefore mocks base method.
+func (m *MockJobRepository) DeleteCompletedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCompletedBefore indicates an expected call of DeleteCompletedBefore.
+func (mr *MockJobRepositoryMockRecorder) DeleteCompletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompletedBefore", reflect.TypeOf((*MockJobRepository)(nil).DeleteCompletedBefore), ctx, before, limit)
+}
+
+// FindAll mocks base method.
+func (m *MockJobRepository) FindAll(ctx context.Context, filter repository.JobFilter, limit, offset int) ([]*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockJobRepositoryMockRecorder) FindAll(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockJobRepository)(nil).FindAll), ctx, filter, limit, offset)
+}
+
+// FindByID mocks base method.
+func (m *MockJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockJobRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockJobRepository)(nil).FindByID), ctx, id)
+}
+
+// MarkCompleted mocks base method.
+func (m *MockJobRepository) MarkCompleted(ctx context.Context, id uuid.UUID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkCompleted", ctx, id, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkCompleted indicates an expected call of MarkCompleted.
+func (mr *MockJobRepositoryMockRecorder) MarkCompleted(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCompleted", reflect.TypeOf((*MockJobRepository)(nil).MarkCompleted), ctx, id, now)
+}
+
+// MarkFailed mocks base method.
+func (m *MockJobRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string, retryAt *time.Time, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, lastError, retryAt, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockJobRepositoryMockRecorder) MarkFailed(ctx, id, lastError, retryAt, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockJobRepository)(nil).MarkFailed), ctx, id, lastError, retryAt, now)
+}
+
+// Requeue mocks base method.
+func (m *MockJobRepository) Requeue(ctx context.Context, id uuid.UUID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", ctx, id, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockJobRepositoryMockRecorder) Requeue(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockJobRepository)(nil).Requeue), ctx, id, now)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ledger_repo.go -destination=mockrepo/ledger_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindGiftCardLiabilityMismatches mocks base method.
+func (m *MockLedgerRepository) FindGiftCardLiabilityMismatches(ctx context.Context) ([]*entity.LedgerMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGiftCardLiabilityMismatches", ctx)
+	ret0, _ := ret[0].([]*entity.LedgerMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGiftCardLiabilityMismatches indicates an expected call of FindGiftCardLiabilityMismatches.
+func (mr *MockLedgerRepositoryMockRecorder) FindGiftCardLiabilityMismatches(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGiftCardLiabilityMismatches", reflect.TypeOf((*MockLedgerRepository)(nil).FindGiftCardLiabilityMismatches), ctx)
+}
+
+// FindPaymentClearingMismatches mocks base method.
+func (m *MockLedgerRepository) FindPaymentClearingMismatches(ctx context.Context) ([]*entity.LedgerMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaymentClearingMismatches", ctx)
+	ret0, _ := ret[0].([]*entity.LedgerMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaymentClearingMismatches indicates an expected call of FindPaymentClearingMismatches.
+func (mr *MockLedgerRepositoryMockRecorder) FindPaymentClearingMismatches(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentClearingMismatches", reflect.TypeOf((*MockLedgerRepository)(nil).FindPaymentClearingMismatches), ctx)
+}
+
+// FindPaymentMismatches mocks base method.
+func (m *MockLedgerRepository) FindPaymentMismatches(ctx context.Context, limit int) ([]*entity.LedgerMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaymentMismatches", ctx, limit)
+	ret0, _ := ret[0].([]*entity.LedgerMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaymentMismatches indicates an expected call of FindPaymentMismatches.
+func (mr *MockLedgerRepositoryMockRecorder) FindPaymentMismatches(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentMismatches", reflect.TypeOf((*MockLedgerRepository)(nil).FindPaymentMismatches), ctx, limit)
+}
+
+// FindUnbalanced mocks base method.
+func (m *MockLedgerRepository) FindUnbalanced(ctx context.Context, limit int) ([]*entity.LedgerImbalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnbalanced", ctx, limit)
+	ret0, _ := ret[0].([]*entity.LedgerImbalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnbalanced indicates an expected call of FindUnbalanced.
+func (mr *MockLedgerRepositoryMockRecorder) FindUnbalanced(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnbalanced", reflect.TypeOf((*MockLedgerRepository)(nil).FindUnbalanced), ctx, limit)
+}
+
+// GetAccountBalances mocks base method.
+func (m *MockLedgerRepository) GetAccountBalances(ctx context.Context) ([]*entity.LedgerAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalances", ctx)
+	ret0, _ := ret[0].([]*entity.LedgerAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalances indicates an expected call of GetAccountBalances.
+func (mr *MockLedgerRepositoryMockRecorder) GetAccountBalances(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalances", reflect.TypeOf((*MockLedgerRepository)(nil).GetAccountBalances), ctx)
+}
+
+// Post mocks base method.
+func (m *MockLedgerRepository) Post(ctx context.Context, transaction *entity.LedgerTransaction) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, transaction)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockLedgerRepositoryMockRecorder) Post(ctx, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockLedgerRepository)(nil).Post), ctx, transaction)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: movie_genre_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=movie_genre_repo.go -destination=mockrepo/movie_genre_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMovieGenreRepository is a mock of MovieGenreRepository interface.
+type MockMovieGenreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieGenreRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMovieGenreRepositoryMockRecorder is the mock recorder for MockMovieGenreRepository.
+type MockMovieGenreRepositoryMockRecorder struct {
+	mock *MockMovieGenreRepository
+}
+
+// NewMockMovieGenreRepository creates a new mock instance.
+func NewMockMovieGenreRepository(ctrl *gomock.Controller) *MockMovieGenreRepository {
+	mock := &MockMovieGenreRepository{ctrl: ctrl}
+	mock.recorder = &MockMovieGenreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieGenreRepository) EXPECT() *MockMovieGenreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBatch mocks base method.
+func (m *MockMovieGenreRepository) CreateBatch(ctx context.Context, movieGenres []*entity.MovieGenre) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, movieGenres)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockMovieGenreRepositoryMockRecorder) CreateBatch(ctx, movieGenres any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockMovieGenreRepository)(nil).CreateBatch), ctx, movieGenres)
+}
+
+// DeleteByMovieID mocks base method.
+func (m *MockMovieGenreRepository) DeleteByMovieID(ctx context.Context, movieID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByMovieID", ctx, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByMovieID indicates an expected call of DeleteByMovieID.
+func (mr *MockMovieGenreRepositoryMockRecorder) DeleteByMovieID(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByMovieID", reflect.TypeOf((*MockMovieGenreRepository)(nil).DeleteByMovieID), ctx, movieID)
+}
+
+// ReassignGenre mocks base method.
+func (m *MockMovieGenreRepository) ReassignGenre(ctx context.Context, fromID, toID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignGenre", ctx, fromID, toID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignGenre indicates an expected call of ReassignGenre.
+func (mr *MockMovieGenreRepositoryMockRecorder) ReassignGenre(ctx, fromID, toID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignGenre", reflect.TypeOf((*MockMovieGenreRepository)(nil).ReassignGenre), ctx, fromID, toID)
+}
@@ -0,0 +1,264 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: movie_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=movie_repo.go -destination=mockrepo/movie_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMovieRepository is a mock of MovieRepository interface.
+type MockMovieRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMovieRepositoryMockRecorder is the mock recorder for MockMovieRepository.
+type MockMovieRepositoryMockRecorder struct {
+	mock *MockMovieRepository
+}
+
+// NewMockMovieRepository creates a new mock instance.
+func NewMockMovieRepository(ctrl *gomock.Controller) *MockMovieRepository {
+	mock := &MockMovieRepository{ctrl: ctrl}
+	mock.recorder = &MockMovieRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieRepository) EXPECT() *MockMovieRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ArchiveEnded mocks base method.
+func (m *MockMovieRepository) ArchiveEnded(ctx context.Context, today, releasedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveEnded", ctx, today, releasedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveEnded indicates an expected call of ArchiveEnded.
+func (mr *MockMovieRepositoryMockRecorder) ArchiveEnded(ctx, today, releasedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveEnded", reflect.TypeOf((*MockMovieRepository)(nil).ArchiveEnded), ctx, today, releasedBefore)
+}
+
+// CountAll mocks base method.
+func (m *MockMovieRepository) CountAll(ctx context.Context, releaseStatus *string, includeDeleted bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, releaseStatus, includeDeleted)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockMovieRepositoryMockRecorder) CountAll(ctx, releaseStatus, includeDeleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockMovieRepository)(nil).CountAll), ctx, releaseStatus, includeDeleted)
+}
+
+// Create mocks base method.
+func (m *MockMovieRepository) Create(ctx context.Context, movie *entity.Movie) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, movie)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMovieRepositoryMockRecorder) Create(ctx, movie any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMovieRepository)(nil).Create), ctx, movie)
+}
+
+// Delete mocks base method.
+func (m *MockMovieRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMovieRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMovieRepository)(nil).Delete), ctx, id)
+}
+
+// ExistsByTitleAndReleaseDate mocks base method.
+func (m *MockMovieRepository) ExistsByTitleAndReleaseDate(ctx context.Context, title string, releaseDate time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByTitleAndReleaseDate", ctx, title, releaseDate)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByTitleAndReleaseDate indicates an expected call of ExistsByTitleAndReleaseDate.
+func (mr *MockMovieRepositoryMockRecorder) ExistsByTitleAndReleaseDate(ctx, title, releaseDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByTitleAndReleaseDate", reflect.TypeOf((*MockMovieRepository)(nil).ExistsByTitleAndReleaseDate), ctx, title, releaseDate)
+}
+
+// FindAll mocks base method.
+func (m *MockMovieRepository) FindAll(ctx context.Context, limit, offset int, releaseStatus *string, includeDeleted bool, sort repository.MovieSort) ([]*entity.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, limit, offset, releaseStatus, includeDeleted, sort)
+	ret0, _ := ret[0].([]*entity.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockMovieRepositoryMockRecorder) FindAll(ctx, limit, offset, releaseStatus, includeDeleted, sort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockMovieRepository)(nil).FindAll), ctx, limit, offset, releaseStatus, includeDeleted, sort)
+}
+
+// FindByID mocks base method.
+func (m *MockMovieRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMovieRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMovieRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByReleaseStatuses mocks base method.
+func (m *MockMovieRepository) FindByReleaseStatuses(ctx context.Context, statuses []entity.ReleaseStatus) ([]*entity.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByReleaseStatuses", ctx, statuses)
+	ret0, _ := ret[0].([]*entity.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByReleaseStatuses indicates an expected call of FindByReleaseStatuses.
+func (mr *MockMovieRepositoryMockRecorder) FindByReleaseStatuses(ctx, statuses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByReleaseStatuses", reflect.TypeOf((*MockMovieRepository)(nil).FindByReleaseStatuses), ctx, statuses)
+}
+
+// FindTopRated mocks base method.
+func (m *MockMovieRepository) FindTopRated(ctx context.Context, limit int) ([]*entity.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTopRated", ctx, limit)
+	ret0, _ := ret[0].([]*entity.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTopRated indicates an expected call of FindTopRated.
+func (mr *MockMovieRepositoryMockRecorder) FindTopRated(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTopRated", reflect.TypeOf((*MockMovieRepository)(nil).FindTopRated), ctx, limit)
+}
+
+// FindTrending mocks base method.
+func (m *MockMovieRepository) FindTrending(ctx context.Context, since time.Time, limit int) ([]*repository.TrendingMovie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrending", ctx, since, limit)
+	ret0, _ := ret[0].([]*repository.TrendingMovie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrending indicates an expected call of FindTrending.
+func (mr *MockMovieRepositoryMockRecorder) FindTrending(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrending", reflect.TypeOf((*MockMovieRepository)(nil).FindTrending), ctx, since, limit)
+}
+
+// PromoteReleased mocks base method.
+func (m *MockMovieRepository) PromoteReleased(ctx context.Context, today time.Time) ([]*entity.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteReleased", ctx, today)
+	ret0, _ := ret[0].([]*entity.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteReleased indicates an expected call of PromoteReleased.
+func (mr *MockMovieRepositoryMockRecorder) PromoteReleased(ctx, today any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteReleased", reflect.TypeOf((*MockMovieRepository)(nil).PromoteReleased), ctx, today)
+}
+
+// Restore mocks base method.
+func (m *MockMovieRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockMovieRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMovieRepository)(nil).Restore), ctx, id)
+}
+
+// SetReleaseStatus mocks base method.
+func (m *MockMovieRepository) SetReleaseStatus(ctx context.Context, id uuid.UUID, status entity.ReleaseStatus, locked bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReleaseStatus", ctx, id, status, locked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReleaseStatus indicates an expected call of SetReleaseStatus.
+func (mr *MockMovieRepositoryMockRecorder) SetReleaseStatus(ctx, id, status, locked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReleaseStatus", reflect.TypeOf((*MockMovieRepository)(nil).SetReleaseStatus), ctx, id, status, locked)
+}
+
+// Update mocks base method.
+func (m *MockMovieRepository) Update(ctx context.Context, movie *entity.Movie) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, movie)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMovieRepositoryMockRecorder) Update(ctx, movie any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMovieRepository)(nil).Update), ctx, movie)
+}
+
+// UpdateRating mocks base method.
+func (m *MockMovieRepository) UpdateRating(ctx context.Context, movieID uuid.UUID, newRating float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRating", ctx, movieID, newRating)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRating indicates an expected call of UpdateRating.
+func (mr *MockMovieRepositoryMockRecorder) UpdateRating(ctx, movieID, newRating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRating", reflect.TypeOf((*MockMovieRepository)(nil).UpdateRating), ctx, movieID, newRating)
+}
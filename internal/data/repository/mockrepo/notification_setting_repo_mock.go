@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_setting_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_setting_repo.go -destination=mockrepo/notification_setting_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationSettingRepository is a mock of NotificationSettingRepository interface.
+type MockNotificationSettingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationSettingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationSettingRepositoryMockRecorder is the mock recorder for MockNotificationSettingRepository.
+type MockNotificationSettingRepositoryMockRecorder struct {
+	mock *MockNotificationSettingRepository
+}
+
+// NewMockNotificationSettingRepository creates a new mock instance.
+func NewMockNotificationSettingRepository(ctrl *gomock.Controller) *MockNotificationSettingRepository {
+	mock := &MockNotificationSettingRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationSettingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationSettingRepository) EXPECT() *MockNotificationSettingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockNotificationSettingRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserNotificationSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entity.UserNotificationSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockNotificationSettingRepositoryMockRecorder) FindByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockNotificationSettingRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// Upsert mocks base method.
+func (m *MockNotificationSettingRepository) Upsert(ctx context.Context, setting *entity.UserNotificationSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, setting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockNotificationSettingRepositoryMockRecorder) Upsert(ctx, setting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNotificationSettingRepository)(nil).Upsert), ctx, setting)
+}
@@ -0,0 +1,220 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=organization_repo.go -destination=mockrepo/organization_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganizationRepository is a mock of OrganizationRepository interface.
+type MockOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganizationRepositoryMockRecorder is the mock recorder for MockOrganizationRepository.
+type MockOrganizationRepositoryMockRecorder struct {
+	mock *MockOrganizationRepository
+}
+
+// NewMockOrganizationRepository creates a new mock instance.
+func NewMockOrganizationRepository(ctrl *gomock.Controller) *MockOrganizationRepository {
+	mock := &MockOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationRepository) EXPECT() *MockOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AssignCinemas mocks base method.
+func (m *MockOrganizationRepository) AssignCinemas(ctx context.Context, id *uuid.UUID, cinemaIDs []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCinemas", ctx, id, cinemaIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignCinemas indicates an expected call of AssignCinemas.
+func (mr *MockOrganizationRepositoryMockRecorder) AssignCinemas(ctx, id, cinemaIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCinemas", reflect.TypeOf((*MockOrganizationRepository)(nil).AssignCinemas), ctx, id, cinemaIDs)
+}
+
+// CountAdmins mocks base method.
+func (m *MockOrganizationRepository) CountAdmins(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAdmins", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAdmins indicates an expected call of CountAdmins.
+func (mr *MockOrganizationRepositoryMockRecorder) CountAdmins(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAdmins", reflect.TypeOf((*MockOrganizationRepository)(nil).CountAdmins), ctx, id)
+}
+
+// CountCinemas mocks base method.
+func (m *MockOrganizationRepository) CountCinemas(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCinemas", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCinemas indicates an expected call of CountCinemas.
+func (mr *MockOrganizationRepositoryMockRecorder) CountCinemas(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCinemas", reflect.TypeOf((*MockOrganizationRepository)(nil).CountCinemas), ctx, id)
+}
+
+// Create mocks base method.
+func (m *MockOrganizationRepository) Create(ctx context.Context, organization *entity.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, organization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationRepositoryMockRecorder) Create(ctx, organization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationRepository)(nil).Create), ctx, organization)
+}
+
+// Delete mocks base method.
+func (m *MockOrganizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOrganizationRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizationRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockOrganizationRepository) FindAll(ctx context.Context) ([]*entity.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entity.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockOrganizationRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrganizationRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockOrganizationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindByID), ctx, id)
+}
+
+// FindBySlug mocks base method.
+func (m *MockOrganizationRepository) FindBySlug(ctx context.Context, slug string) (*entity.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", ctx, slug)
+	ret0, _ := ret[0].(*entity.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockOrganizationRepositoryMockRecorder) FindBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockOrganizationRepository)(nil).FindBySlug), ctx, slug)
+}
+
+// OwnsCinema mocks base method.
+func (m *MockOrganizationRepository) OwnsCinema(ctx context.Context, id, cinemaID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OwnsCinema", ctx, id, cinemaID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OwnsCinema indicates an expected call of OwnsCinema.
+func (mr *MockOrganizationRepositoryMockRecorder) OwnsCinema(ctx, id, cinemaID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OwnsCinema", reflect.TypeOf((*MockOrganizationRepository)(nil).OwnsCinema), ctx, id, cinemaID)
+}
+
+// OwnsHall mocks base method.
+func (m *MockOrganizationRepository) OwnsHall(ctx context.Context, id, hallID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OwnsHall", ctx, id, hallID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OwnsHall indicates an expected call of OwnsHall.
+func (mr *MockOrganizationRepositoryMockRecorder) OwnsHall(ctx, id, hallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OwnsHall", reflect.TypeOf((*MockOrganizationRepository)(nil).OwnsHall), ctx, id, hallID)
+}
+
+// OwnsSchedule mocks base method.
+func (m *MockOrganizationRepository) OwnsSchedule(ctx context.Context, id, scheduleID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OwnsSchedule", ctx, id, scheduleID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OwnsSchedule indicates an expected call of OwnsSchedule.
+func (mr *MockOrganizationRepositoryMockRecorder) OwnsSchedule(ctx, id, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OwnsSchedule", reflect.TypeOf((*MockOrganizationRepository)(nil).OwnsSchedule), ctx, id, scheduleID)
+}
+
+// Update mocks base method.
+func (m *MockOrganizationRepository) Update(ctx context.Context, organization *entity.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, organization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOrganizationRepositoryMockRecorder) Update(ctx, organization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationRepository)(nil).Update), ctx, organization)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: otp_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=otp_repo.go -destination=mockrepo/otp_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOTPRepository is a mock of OTPRepository interface.
+type MockOTPRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOTPRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOTPRepositoryMockRecorder is the mock recorder for MockOTPRepository.
+type MockOTPRepositoryMockRecorder struct {
+	mock *MockOTPRepository
+}
+
+// NewMockOTPRepository creates a new mock instance.
+func NewMockOTPRepository(ctrl *gomock.Controller) *MockOTPRepository {
+	mock := &MockOTPRepository{ctrl: ctrl}
+	mock.recorder = &MockOTPRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOTPRepository) EXPECT() *MockOTPRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOTPRepository) Create(ctx context.Context, otp *entity.OTP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, otp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOTPRepositoryMockRecorder) Create(ctx, otp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOTPRepository)(nil).Create), ctx, otp)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockOTPRepository) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockOTPRepositoryMockRecorder) DeleteExpired(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockOTPRepository)(nil).DeleteExpired), ctx, before, limit)
+}
+
+// FindValidOTP mocks base method.
+func (m *MockOTPRepository) FindValidOTP(ctx context.Context, email, otpCode, otpType string) (*entity.OTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindValidOTP", ctx, email, otpCode, otpType)
+	ret0, _ := ret[0].(*entity.OTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindValidOTP indicates an expected call of FindValidOTP.
+func (mr *MockOTPRepositoryMockRecorder) FindValidOTP(ctx, email, otpCode, otpType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindValidOTP", reflect.TypeOf((*MockOTPRepository)(nil).FindValidOTP), ctx, email, otpCode, otpType)
+}
+
+// MarkAsUsed mocks base method.
+func (m *MockOTPRepository) MarkAsUsed(ctx context.Context, otpID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsUsed", ctx, otpID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsUsed indicates an expected call of MarkAsUsed.
+func (mr *MockOTPRepositoryMockRecorder) MarkAsUsed(ctx, otpID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsUsed", reflect.TypeOf((*MockOTPRepository)(nil).MarkAsUsed), ctx, otpID)
+}
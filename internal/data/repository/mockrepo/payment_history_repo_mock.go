@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_history_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_history_repo.go -destination=mockrepo/payment_history_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentHistoryRepository is a mock of PaymentHistoryRepository interface.
+type MockPaymentHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentHistoryRepositoryMockRecorder is the mock recorder for MockPaymentHistoryRepository.
+type MockPaymentHistoryRepositoryMockRecorder struct {
+	mock *MockPaymentHistoryRepository
+}
+
+// NewMockPaymentHistoryRepository creates a new mock instance.
+func NewMockPaymentHistoryRepository(ctrl *gomock.Controller) *MockPaymentHistoryRepository {
+	mock := &MockPaymentHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentHistoryRepository) EXPECT() *MockPaymentHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPaymentHistoryRepository) Create(ctx context.Context, change *entity.PaymentStatusChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentHistoryRepositoryMockRecorder) Create(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentHistoryRepository)(nil).Create), ctx, change)
+}
+
+// FindByPaymentID mocks base method.
+func (m *MockPaymentHistoryRepository) FindByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*entity.PaymentStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPaymentID", ctx, paymentID)
+	ret0, _ := ret[0].([]*entity.PaymentStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPaymentID indicates an expected call of FindByPaymentID.
+func (mr *MockPaymentHistoryRepositoryMockRecorder) FindByPaymentID(ctx, paymentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPaymentID", reflect.TypeOf((*MockPaymentHistoryRepository)(nil).FindByPaymentID), ctx, paymentID)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_method_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_method_repo.go -destination=mockrepo/payment_method_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentMethodRepository is a mock of PaymentMethodRepository interface.
+type MockPaymentMethodRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentMethodRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentMethodRepositoryMockRecorder is the mock recorder for MockPaymentMethodRepository.
+type MockPaymentMethodRepositoryMockRecorder struct {
+	mock *MockPaymentMethodRepository
+}
+
+// NewMockPaymentMethodRepository creates a new mock instance.
+func NewMockPaymentMethodRepository(ctrl *gomock.Controller) *MockPaymentMethodRepository {
+	mock := &MockPaymentMethodRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentMethodRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentMethodRepository) EXPECT() *MockPaymentMethodRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPaymentMethodRepository) Create(ctx context.Context, paymentMethod *entity.PaymentMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, paymentMethod)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentMethodRepositoryMockRecorder) Create(ctx, paymentMethod any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentMethodRepository)(nil).Create), ctx, paymentMethod)
+}
+
+// Delete mocks base method.
+func (m *MockPaymentMethodRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPaymentMethodRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPaymentMethodRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockPaymentMethodRepository) FindAll(ctx context.Context) ([]*entity.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entity.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPaymentMethodRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPaymentMethodRepository)(nil).FindAll), ctx)
+}
+
+// FindAllActive mocks base method.
+func (m *MockPaymentMethodRepository) FindAllActive(ctx context.Context) ([]*entity.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActive", ctx)
+	ret0, _ := ret[0].([]*entity.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActive indicates an expected call of FindAllActive.
+func (mr *MockPaymentMethodRepositoryMockRecorder) FindAllActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActive", reflect.TypeOf((*MockPaymentMethodRepository)(nil).FindAllActive), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockPaymentMethodRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPaymentMethodRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPaymentMethodRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByName mocks base method.
+func (m *MockPaymentMethodRepository) FindByName(ctx context.Context, name string) (*entity.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", ctx, name)
+	ret0, _ := ret[0].(*entity.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockPaymentMethodRepositoryMockRecorder) FindByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockPaymentMethodRepository)(nil).FindByName), ctx, name)
+}
+
+// Update mocks base method.
+func (m *MockPaymentMethodRepository) Update(ctx context.Context, paymentMethod *entity.PaymentMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, paymentMethod)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPaymentMethodRepositoryMockRecorder) Update(ctx, paymentMethod any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPaymentMethodRepository)(nil).Update), ctx, paymentMethod)
+}
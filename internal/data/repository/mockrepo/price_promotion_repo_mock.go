@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: price_promotion_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=price_promotion_repo.go -destination=mockrepo/price_promotion_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPricePromotionRepository is a mock of PricePromotionRepository interface.
+type MockPricePromotionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricePromotionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPricePromotionRepositoryMockRecorder is the mock recorder for MockPricePromotionRepository.
+type MockPricePromotionRepositoryMockRecorder struct {
+	mock *MockPricePromotionRepository
+}
+
+// NewMockPricePromotionRepository creates a new mock instance.
+func NewMockPricePromotionRepository(ctrl *gomock.Controller) *MockPricePromotionRepository {
+	mock := &MockPricePromotionRepository{ctrl: ctrl}
+	mock.recorder = &MockPricePromotionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricePromotionRepository) EXPECT() *MockPricePromotionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPricePromotionRepository) Create(ctx context.Context, promotion *entity.PricePromotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPricePromotionRepositoryMockRecorder) Create(ctx, promotion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPricePromotionRepository)(nil).Create), ctx, promotion)
+}
+
+// Delete mocks base method.
+func (m *MockPricePromotionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPricePromotionRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPricePromotionRepository)(nil).Delete), ctx, id)
+}
+
+// FindActive mocks base method.
+func (m *MockPricePromotionRepository) FindActive(ctx context.Context) ([]*entity.PricePromotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx)
+	ret0, _ := ret[0].([]*entity.PricePromotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockPricePromotionRepositoryMockRecorder) FindActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockPricePromotionRepository)(nil).FindActive), ctx)
+}
+
+// FindAll mocks base method.
+func (m *MockPricePromotionRepository) FindAll(ctx context.Context) ([]*entity.PricePromotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entity.PricePromotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPricePromotionRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPricePromotionRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockPricePromotionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PricePromotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.PricePromotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPricePromotionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPricePromotionRepository)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockPricePromotionRepository) Update(ctx context.Context, promotion *entity.PricePromotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPricePromotionRepositoryMockRecorder) Update(ctx, promotion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPricePromotionRepository)(nil).Update), ctx, promotion)
+}
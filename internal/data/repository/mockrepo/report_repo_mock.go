@@ -0,0 +1,180 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=report_repo.go -destination=mockrepo/report_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountPayments mocks base method.
+func (m *MockReportRepository) CountPayments(ctx context.Context, filter repository.PaymentReportFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPayments", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPayments indicates an expected call of CountPayments.
+func (mr *MockReportRepositoryMockRecorder) CountPayments(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPayments", reflect.TypeOf((*MockReportRepository)(nil).CountPayments), ctx, filter)
+}
+
+// FindBookingsForExport mocks base method.
+func (m *MockReportRepository) FindBookingsForExport(ctx context.Context, filter repository.BookingExportFilter, limit int) ([]*entity.BookingExportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBookingsForExport", ctx, filter, limit)
+	ret0, _ := ret[0].([]*entity.BookingExportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBookingsForExport indicates an expected call of FindBookingsForExport.
+func (mr *MockReportRepositoryMockRecorder) FindBookingsForExport(ctx, filter, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBookingsForExport", reflect.TypeOf((*MockReportRepository)(nil).FindBookingsForExport), ctx, filter, limit)
+}
+
+// FindPayments mocks base method.
+func (m *MockReportRepository) FindPayments(ctx context.Context, filter repository.PaymentReportFilter, limit, offset int) ([]*entity.PaymentReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayments", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]*entity.PaymentReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayments indicates an expected call of FindPayments.
+func (mr *MockReportRepositoryMockRecorder) FindPayments(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayments", reflect.TypeOf((*MockReportRepository)(nil).FindPayments), ctx, filter, limit, offset)
+}
+
+// FindPaymentsForReconciliation mocks base method.
+func (m *MockReportRepository) FindPaymentsForReconciliation(ctx context.Context, filter repository.PaymentReportFilter, transactionIDs []string) ([]*entity.PaymentReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaymentsForReconciliation", ctx, filter, transactionIDs)
+	ret0, _ := ret[0].([]*entity.PaymentReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaymentsForReconciliation indicates an expected call of FindPaymentsForReconciliation.
+func (mr *MockReportRepositoryMockRecorder) FindPaymentsForReconciliation(ctx, filter, transactionIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentsForReconciliation", reflect.TypeOf((*MockReportRepository)(nil).FindPaymentsForReconciliation), ctx, filter, transactionIDs)
+}
+
+// GetDailySummary mocks base method.
+func (m *MockReportRepository) GetDailySummary(ctx context.Context, date time.Time, organizationID *uuid.UUID) (*entity.SalesSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailySummary", ctx, date, organizationID)
+	ret0, _ := ret[0].(*entity.SalesSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailySummary indicates an expected call of GetDailySummary.
+func (mr *MockReportRepositoryMockRecorder) GetDailySummary(ctx, date, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailySummary", reflect.TypeOf((*MockReportRepository)(nil).GetDailySummary), ctx, date, organizationID)
+}
+
+// GetFunnel mocks base method.
+func (m *MockReportRepository) GetFunnel(ctx context.Context, from, to time.Time, organizationID *uuid.UUID) ([]*entity.FunnelStepCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFunnel", ctx, from, to, organizationID)
+	ret0, _ := ret[0].([]*entity.FunnelStepCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFunnel indicates an expected call of GetFunnel.
+func (mr *MockReportRepositoryMockRecorder) GetFunnel(ctx, from, to, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunnel", reflect.TypeOf((*MockReportRepository)(nil).GetFunnel), ctx, from, to, organizationID)
+}
+
+// GetPaymentTotals mocks base method.
+func (m *MockReportRepository) GetPaymentTotals(ctx context.Context, filter repository.PaymentReportFilter) ([]*entity.PaymentTotalRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentTotals", ctx, filter)
+	ret0, _ := ret[0].([]*entity.PaymentTotalRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentTotals indicates an expected call of GetPaymentTotals.
+func (mr *MockReportRepositoryMockRecorder) GetPaymentTotals(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentTotals", reflect.TypeOf((*MockReportRepository)(nil).GetPaymentTotals), ctx, filter)
+}
+
+// GetSales mocks base method.
+func (m *MockReportRepository) GetSales(ctx context.Context, from, to time.Time, groupBy repository.ReportGroupBy, organizationID *uuid.UUID) ([]*entity.SalesReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSales", ctx, from, to, groupBy, organizationID)
+	ret0, _ := ret[0].([]*entity.SalesReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSales indicates an expected call of GetSales.
+func (mr *MockReportRepositoryMockRecorder) GetSales(ctx, from, to, groupBy, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSales", reflect.TypeOf((*MockReportRepository)(nil).GetSales), ctx, from, to, groupBy, organizationID)
+}
+
+// GetScheduleOccupancy mocks base method.
+func (m *MockReportRepository) GetScheduleOccupancy(ctx context.Context, cinemaID uuid.UUID, date time.Time) ([]*entity.ScheduleOccupancyRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleOccupancy", ctx, cinemaID, date)
+	ret0, _ := ret[0].([]*entity.ScheduleOccupancyRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleOccupancy indicates an expected call of GetScheduleOccupancy.
+func (mr *MockReportRepositoryMockRecorder) GetScheduleOccupancy(ctx, cinemaID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleOccupancy", reflect.TypeOf((*MockReportRepository)(nil).GetScheduleOccupancy), ctx, cinemaID, date)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_reply_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_reply_repo.go -destination=mockrepo/review_reply_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewReplyRepository is a mock of ReviewReplyRepository interface.
+type MockReviewReplyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewReplyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewReplyRepositoryMockRecorder is the mock recorder for MockReviewReplyRepository.
+type MockReviewReplyRepositoryMockRecorder struct {
+	mock *MockReviewReplyRepository
+}
+
+// NewMockReviewReplyRepository creates a new mock instance.
+func NewMockReviewReplyRepository(ctrl *gomock.Controller) *MockReviewReplyRepository {
+	mock := &MockReviewReplyRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewReplyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewReplyRepository) EXPECT() *MockReviewReplyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReviewReplyRepository) Create(ctx context.Context, reply *entity.ReviewReply) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, reply)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReviewReplyRepositoryMockRecorder) Create(ctx, reply any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewReplyRepository)(nil).Create), ctx, reply)
+}
+
+// DeleteByReviewID mocks base method.
+func (m *MockReviewReplyRepository) DeleteByReviewID(ctx context.Context, reviewID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByReviewID", ctx, reviewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByReviewID indicates an expected call of DeleteByReviewID.
+func (mr *MockReviewReplyRepositoryMockRecorder) DeleteByReviewID(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByReviewID", reflect.TypeOf((*MockReviewReplyRepository)(nil).DeleteByReviewID), ctx, reviewID)
+}
+
+// FindByReviewID mocks base method.
+func (m *MockReviewReplyRepository) FindByReviewID(ctx context.Context, reviewID uuid.UUID) (*entity.ReviewReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByReviewID", ctx, reviewID)
+	ret0, _ := ret[0].(*entity.ReviewReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByReviewID indicates an expected call of FindByReviewID.
+func (mr *MockReviewReplyRepositoryMockRecorder) FindByReviewID(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByReviewID", reflect.TypeOf((*MockReviewReplyRepository)(nil).FindByReviewID), ctx, reviewID)
+}
+
+// FindByReviewIDs mocks base method.
+func (m *MockReviewReplyRepository) FindByReviewIDs(ctx context.Context, reviewIDs []uuid.UUID) (map[uuid.UUID]*entity.ReviewReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByReviewIDs", ctx, reviewIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]*entity.ReviewReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByReviewIDs indicates an expected call of FindByReviewIDs.
+func (mr *MockReviewReplyRepositoryMockRecorder) FindByReviewIDs(ctx, reviewIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByReviewIDs", reflect.TypeOf((*MockReviewReplyRepository)(nil).FindByReviewIDs), ctx, reviewIDs)
+}
+
+// Update mocks base method.
+func (m *MockReviewReplyRepository) Update(ctx context.Context, reply *entity.ReviewReply) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, reply)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReviewReplyRepositoryMockRecorder) Update(ctx, reply any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReviewReplyRepository)(nil).Update), ctx, reply)
+}
@@ -0,0 +1,297 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_repo.go -destination=mockrepo/review_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
+}
+
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClearReports mocks base method.
+func (m *MockReviewRepository) ClearReports(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearReports", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearReports indicates an expected call of ClearReports.
+func (mr *MockReviewRepositoryMockRecorder) ClearReports(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearReports", reflect.TypeOf((*MockReviewRepository)(nil).ClearReports), ctx, id)
+}
+
+// CountByMovieID mocks base method.
+func (m *MockReviewRepository) CountByMovieID(ctx context.Context, movieID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByMovieID", ctx, movieID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByMovieID indicates an expected call of CountByMovieID.
+func (mr *MockReviewRepositoryMockRecorder) CountByMovieID(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByMovieID", reflect.TypeOf((*MockReviewRepository)(nil).CountByMovieID), ctx, movieID)
+}
+
+// CountByUserID mocks base method.
+func (m *MockReviewRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUserID", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUserID indicates an expected call of CountByUserID.
+func (mr *MockReviewRepositoryMockRecorder) CountByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockReviewRepository)(nil).CountByUserID), ctx, userID)
+}
+
+// CountReported mocks base method.
+func (m *MockReviewRepository) CountReported(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReported", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReported indicates an expected call of CountReported.
+func (mr *MockReviewRepositoryMockRecorder) CountReported(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReported", reflect.TypeOf((*MockReviewRepository)(nil).CountReported), ctx)
+}
+
+// Create mocks base method.
+func (m *MockReviewRepository) Create(ctx context.Context, review *entity.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReviewRepositoryMockRecorder) Create(ctx, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewRepository)(nil).Create), ctx, review)
+}
+
+// Delete mocks base method.
+func (m *MockReviewRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReviewRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReviewRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockReviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockReviewRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReviewRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByMovieID mocks base method.
+func (m *MockReviewRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID, sort repository.ReviewSort, limit, offset int) ([]*entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMovieID", ctx, movieID, sort, limit, offset)
+	ret0, _ := ret[0].([]*entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMovieID indicates an expected call of FindByMovieID.
+func (mr *MockReviewRepositoryMockRecorder) FindByMovieID(ctx, movieID, sort, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMovieID", reflect.TypeOf((*MockReviewRepository)(nil).FindByMovieID), ctx, movieID, sort, limit, offset)
+}
+
+// FindByUserAndMovie mocks base method.
+func (m *MockReviewRepository) FindByUserAndMovie(ctx context.Context, userID, movieID uuid.UUID) (*entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserAndMovie", ctx, userID, movieID)
+	ret0, _ := ret[0].(*entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserAndMovie indicates an expected call of FindByUserAndMovie.
+func (mr *MockReviewRepositoryMockRecorder) FindByUserAndMovie(ctx, userID, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAndMovie", reflect.TypeOf((*MockReviewRepository)(nil).FindByUserAndMovie), ctx, userID, movieID)
+}
+
+// FindByUserID mocks base method.
+func (m *MockReviewRepository) FindByUserID(ctx context.Context, userID uuid.UUID, sort repository.ReviewSort, limit, offset int) ([]*entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID, sort, limit, offset)
+	ret0, _ := ret[0].([]*entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockReviewRepositoryMockRecorder) FindByUserID(ctx, userID, sort, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockReviewRepository)(nil).FindByUserID), ctx, userID, sort, limit, offset)
+}
+
+// FindReported mocks base method.
+func (m *MockReviewRepository) FindReported(ctx context.Context, limit, offset int) ([]*entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReported", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReported indicates an expected call of FindReported.
+func (mr *MockReviewRepositoryMockRecorder) FindReported(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReported", reflect.TypeOf((*MockReviewRepository)(nil).FindReported), ctx, limit, offset)
+}
+
+// GetAllMovieRatingStats mocks base method.
+func (m *MockReviewRepository) GetAllMovieRatingStats(ctx context.Context) ([]*repository.MovieRatingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMovieRatingStats", ctx)
+	ret0, _ := ret[0].([]*repository.MovieRatingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllMovieRatingStats indicates an expected call of GetAllMovieRatingStats.
+func (mr *MockReviewRepositoryMockRecorder) GetAllMovieRatingStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMovieRatingStats", reflect.TypeOf((*MockReviewRepository)(nil).GetAllMovieRatingStats), ctx)
+}
+
+// GetMovieAverageRating mocks base method.
+func (m *MockReviewRepository) GetMovieAverageRating(ctx context.Context, movieID uuid.UUID) (float64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovieAverageRating", ctx, movieID)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMovieAverageRating indicates an expected call of GetMovieAverageRating.
+func (mr *MockReviewRepositoryMockRecorder) GetMovieAverageRating(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovieAverageRating", reflect.TypeOf((*MockReviewRepository)(nil).GetMovieAverageRating), ctx, movieID)
+}
+
+// GetMovieReviewStats mocks base method.
+func (m *MockReviewRepository) GetMovieReviewStats(ctx context.Context, movieID uuid.UUID) (float64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovieReviewStats", ctx, movieID)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMovieReviewStats indicates an expected call of GetMovieReviewStats.
+func (mr *MockReviewRepositoryMockRecorder) GetMovieReviewStats(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovieReviewStats", reflect.TypeOf((*MockReviewRepository)(nil).GetMovieReviewStats), ctx, movieID)
+}
+
+// IncrementReportCount mocks base method.
+func (m *MockReviewRepository) IncrementReportCount(ctx context.Context, id uuid.UUID, hideThreshold int) (int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementReportCount", ctx, id, hideThreshold)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// IncrementReportCount indicates an expected call of IncrementReportCount.
+func (mr *MockReviewRepositoryMockRecorder) IncrementReportCount(ctx, id, hideThreshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementReportCount", reflect.TypeOf((*MockReviewRepository)(nil).IncrementReportCount), ctx, id, hideThreshold)
+}
+
+// Restore mocks base method.
+func (m *MockReviewRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockReviewRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockReviewRepository)(nil).Restore), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockReviewRepository) Update(ctx context.Context, review *entity.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReviewRepositoryMockRecorder) Update(ctx, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReviewRepository)(nil).Update), ctx, review)
+}
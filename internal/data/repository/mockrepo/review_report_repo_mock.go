@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_report_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_report_repo.go -destination=mockrepo/review_report_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewReportRepository is a mock of ReviewReportRepository interface.
+type MockReviewReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewReportRepositoryMockRecorder is the mock recorder for MockReviewReportRepository.
+type MockReviewReportRepositoryMockRecorder struct {
+	mock *MockReviewReportRepository
+}
+
+// NewMockReviewReportRepository creates a new mock instance.
+func NewMockReviewReportRepository(ctrl *gomock.Controller) *MockReviewReportRepository {
+	mock := &MockReviewReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewReportRepository) EXPECT() *MockReviewReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountOpenReasons mocks base method.
+func (m *MockReviewReportRepository) CountOpenReasons(ctx context.Context, reviewIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenReasons", ctx, reviewIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenReasons indicates an expected call of CountOpenReasons.
+func (mr *MockReviewReportRepositoryMockRecorder) CountOpenReasons(ctx, reviewIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenReasons", reflect.TypeOf((*MockReviewReportRepository)(nil).CountOpenReasons), ctx, reviewIDs)
+}
+
+// Create mocks base method.
+func (m *MockReviewReportRepository) Create(ctx context.Context, report *entity.ReviewReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReviewReportRepositoryMockRecorder) Create(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewReportRepository)(nil).Create), ctx, report)
+}
+
+// ResolveByReviewID mocks base method.
+func (m *MockReviewReportRepository) ResolveByReviewID(ctx context.Context, reviewID uuid.UUID, resolvedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveByReviewID", ctx, reviewID, resolvedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveByReviewID indicates an expected call of ResolveByReviewID.
+func (mr *MockReviewReportRepositoryMockRecorder) ResolveByReviewID(ctx, reviewID, resolvedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveByReviewID", reflect.TypeOf((*MockReviewReportRepository)(nil).ResolveByReviewID), ctx, reviewID, resolvedAt)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_revision_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_revision_repo.go -destination=mockrepo/review_revision_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewRevisionRepository is a mock of ReviewRevisionRepository interface.
+type MockReviewRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewRevisionRepositoryMockRecorder is the mock recorder for MockReviewRevisionRepository.
+type MockReviewRevisionRepositoryMockRecorder struct {
+	mock *MockReviewRevisionRepository
+}
+
+// NewMockReviewRevisionRepository creates a new mock instance.
+func NewMockReviewRevisionRepository(ctrl *gomock.Controller) *MockReviewRevisionRepository {
+	mock := &MockReviewRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRevisionRepository) EXPECT() *MockReviewRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReviewRevisionRepository) Create(ctx context.Context, revision *entity.ReviewRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReviewRevisionRepositoryMockRecorder) Create(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewRevisionRepository)(nil).Create), ctx, revision)
+}
+
+// FindByReviewID mocks base method.
+func (m *MockReviewRevisionRepository) FindByReviewID(ctx context.Context, reviewID uuid.UUID) ([]*entity.ReviewRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByReviewID", ctx, reviewID)
+	ret0, _ := ret[0].([]*entity.ReviewRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByReviewID indicates an expected call of FindByReviewID.
+func (mr *MockReviewRevisionRepositoryMockRecorder) FindByReviewID(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByReviewID", reflect.TypeOf((*MockReviewRevisionRepository)(nil).FindByReviewID), ctx, reviewID)
+}
@@ -0,0 +1,307 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: schedule_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=schedule_repo.go -destination=mockrepo/schedule_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	repository "cinema-booking/internal/data/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScheduleRepository is a mock of ScheduleRepository interface.
+type MockScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleRepositoryMockRecorder is the mock recorder for MockScheduleRepository.
+type MockScheduleRepositoryMockRecorder struct {
+	mock *MockScheduleRepository
+}
+
+// NewMockScheduleRepository creates a new mock instance.
+func NewMockScheduleRepository(ctrl *gomock.Controller) *MockScheduleRepository {
+	mock := &MockScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleRepository) EXPECT() *MockScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AdjustBookedSeats mocks base method.
+func (m *MockScheduleRepository) AdjustBookedSeats(ctx context.Context, id uuid.UUID, delta int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustBookedSeats", ctx, id, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdjustBookedSeats indicates an expected call of AdjustBookedSeats.
+func (mr *MockScheduleRepositoryMockRecorder) AdjustBookedSeats(ctx, id, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustBookedSeats", reflect.TypeOf((*MockScheduleRepository)(nil).AdjustBookedSeats), ctx, id, delta)
+}
+
+// CancelByCinema mocks base method.
+func (m *MockScheduleRepository) CancelByCinema(ctx context.Context, cinemaID uuid.UUID, from time.Time, until *time.Time, now time.Time) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelByCinema", ctx, cinemaID, from, until, now)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelByCinema indicates an expected call of CancelByCinema.
+func (mr *MockScheduleRepositoryMockRecorder) CancelByCinema(ctx, cinemaID, from, until, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelByCinema", reflect.TypeOf((*MockScheduleRepository)(nil).CancelByCinema), ctx, cinemaID, from, until, now)
+}
+
+// CountAll mocks base method.
+func (m *MockScheduleRepository) CountAll(ctx context.Context, filter repository.ScheduleFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockScheduleRepositoryMockRecorder) CountAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockScheduleRepository)(nil).CountAll), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockScheduleRepository) Create(ctx context.Context, schedule *entity.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockScheduleRepositoryMockRecorder) Create(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockScheduleRepository)(nil).Create), ctx, schedule)
+}
+
+// Delete mocks base method.
+func (m *MockScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockScheduleRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockScheduleRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockScheduleRepository) FindAll(ctx context.Context, filter repository.ScheduleFilter, limit, offset int) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockScheduleRepositoryMockRecorder) FindAll(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockScheduleRepository)(nil).FindAll), ctx, filter, limit, offset)
+}
+
+// FindByCinemaAndDateRange mocks base method.
+func (m *MockScheduleRepository) FindByCinemaAndDateRange(ctx context.Context, cinemaID uuid.UUID, from, to time.Time) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCinemaAndDateRange", ctx, cinemaID, from, to)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCinemaAndDateRange indicates an expected call of FindByCinemaAndDateRange.
+func (mr *MockScheduleRepositoryMockRecorder) FindByCinemaAndDateRange(ctx, cinemaID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCinemaAndDateRange", reflect.TypeOf((*MockScheduleRepository)(nil).FindByCinemaAndDateRange), ctx, cinemaID, from, to)
+}
+
+// FindByDateAndHall mocks base method.
+func (m *MockScheduleRepository) FindByDateAndHall(ctx context.Context, hallID uuid.UUID, date time.Time) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDateAndHall", ctx, hallID, date)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDateAndHall indicates an expected call of FindByDateAndHall.
+func (mr *MockScheduleRepositoryMockRecorder) FindByDateAndHall(ctx, hallID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDateAndHall", reflect.TypeOf((*MockScheduleRepository)(nil).FindByDateAndHall), ctx, hallID, date)
+}
+
+// FindByHallID mocks base method.
+func (m *MockScheduleRepository) FindByHallID(ctx context.Context, hallID uuid.UUID) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHallID", ctx, hallID)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHallID indicates an expected call of FindByHallID.
+func (mr *MockScheduleRepositoryMockRecorder) FindByHallID(ctx, hallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHallID", reflect.TypeOf((*MockScheduleRepository)(nil).FindByHallID), ctx, hallID)
+}
+
+// FindByID mocks base method.
+func (m *MockScheduleRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockScheduleRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockScheduleRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByMovieID mocks base method.
+func (m *MockScheduleRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMovieID", ctx, movieID)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMovieID indicates an expected call of FindByMovieID.
+func (mr *MockScheduleRepositoryMockRecorder) FindByMovieID(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMovieID", reflect.TypeOf((*MockScheduleRepository)(nil).FindByMovieID), ctx, movieID)
+}
+
+// FindCounterMismatches mocks base method.
+func (m *MockScheduleRepository) FindCounterMismatches(ctx context.Context, from time.Time, limit int) ([]*repository.ScheduleCounterMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCounterMismatches", ctx, from, limit)
+	ret0, _ := ret[0].([]*repository.ScheduleCounterMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCounterMismatches indicates an expected call of FindCounterMismatches.
+func (mr *MockScheduleRepositoryMockRecorder) FindCounterMismatches(ctx, from, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCounterMismatches", reflect.TypeOf((*MockScheduleRepository)(nil).FindCounterMismatches), ctx, from, limit)
+}
+
+// LockByID mocks base method.
+func (m *MockScheduleRepository) LockByID(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockByID indicates an expected call of LockByID.
+func (mr *MockScheduleRepositoryMockRecorder) LockByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByID", reflect.TypeOf((*MockScheduleRepository)(nil).LockByID), ctx, id)
+}
+
+// Publish mocks base method.
+func (m *MockScheduleRepository) Publish(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, id, publishedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockScheduleRepositoryMockRecorder) Publish(ctx, id, publishedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockScheduleRepository)(nil).Publish), ctx, id, publishedAt)
+}
+
+// RecomputeStartsAt mocks base method.
+func (m *MockScheduleRepository) RecomputeStartsAt(ctx context.Context, cinemaID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeStartsAt", ctx, cinemaID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeStartsAt indicates an expected call of RecomputeStartsAt.
+func (mr *MockScheduleRepositoryMockRecorder) RecomputeStartsAt(ctx, cinemaID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeStartsAt", reflect.TypeOf((*MockScheduleRepository)(nil).RecomputeStartsAt), ctx, cinemaID)
+}
+
+// Restore mocks base method.
+func (m *MockScheduleRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockScheduleRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockScheduleRepository)(nil).Restore), ctx, id)
+}
+
+// SetPriceOverride mocks base method.
+func (m *MockScheduleRepository) SetPriceOverride(ctx context.Context, id uuid.UUID, price *int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPriceOverride", ctx, id, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPriceOverride indicates an expected call of SetPriceOverride.
+func (mr *MockScheduleRepositoryMockRecorder) SetPriceOverride(ctx, id, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPriceOverride", reflect.TypeOf((*MockScheduleRepository)(nil).SetPriceOverride), ctx, id, price)
+}
+
+// Update mocks base method.
+func (m *MockScheduleRepository) Update(ctx context.Context, schedule *entity.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockScheduleRepositoryMockRecorder) Update(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockScheduleRepository)(nil).Update), ctx, schedule)
+}
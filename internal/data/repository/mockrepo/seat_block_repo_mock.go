@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: seat_block_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=seat_block_repo.go -destination=mockrepo/seat_block_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeatBlockRepository is a mock of SeatBlockRepository interface.
+type MockSeatBlockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeatBlockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSeatBlockRepositoryMockRecorder is the mock recorder for MockSeatBlockRepository.
+type MockSeatBlockRepositoryMockRecorder struct {
+	mock *MockSeatBlockRepository
+}
+
+// NewMockSeatBlockRepository creates a new mock instance.
+func NewMockSeatBlockRepository(ctrl *gomock.Controller) *MockSeatBlockRepository {
+	mock := &MockSeatBlockRepository{ctrl: ctrl}
+	mock.recorder = &MockSeatBlockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeatBlockRepository) EXPECT() *MockSeatBlockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBatch mocks base method.
+func (m *MockSeatBlockRepository) CreateBatch(ctx context.Context, blocks []*entity.SeatBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, blocks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockSeatBlockRepositoryMockRecorder) CreateBatch(ctx, blocks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockSeatBlockRepository)(nil).CreateBatch), ctx, blocks)
+}
+
+// DeleteBySeats mocks base method.
+func (m *MockSeatBlockRepository) DeleteBySeats(ctx context.Context, scheduleID uuid.UUID, seatIDs []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBySeats", ctx, scheduleID, seatIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBySeats indicates an expected call of DeleteBySeats.
+func (mr *MockSeatBlockRepositoryMockRecorder) DeleteBySeats(ctx, scheduleID, seatIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBySeats", reflect.TypeOf((*MockSeatBlockRepository)(nil).DeleteBySeats), ctx, scheduleID, seatIDs)
+}
+
+// FindBySchedule mocks base method.
+func (m *MockSeatBlockRepository) FindBySchedule(ctx context.Context, scheduleID uuid.UUID) ([]*entity.SeatBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySchedule", ctx, scheduleID)
+	ret0, _ := ret[0].([]*entity.SeatBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySchedule indicates an expected call of FindBySchedule.
+func (mr *MockSeatBlockRepositoryMockRecorder) FindBySchedule(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySchedule", reflect.TypeOf((*MockSeatBlockRepository)(nil).FindBySchedule), ctx, scheduleID)
+}
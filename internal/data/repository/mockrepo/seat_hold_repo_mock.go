@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: seat_hold_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=seat_hold_repo.go -destination=mockrepo/seat_hold_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeatHoldRepository is a mock of SeatHoldRepository interface.
+type MockSeatHoldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeatHoldRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSeatHoldRepositoryMockRecorder is the mock recorder for MockSeatHoldRepository.
+type MockSeatHoldRepositoryMockRecorder struct {
+	mock *MockSeatHoldRepository
+}
+
+// NewMockSeatHoldRepository creates a new mock instance.
+func NewMockSeatHoldRepository(ctrl *gomock.Controller) *MockSeatHoldRepository {
+	mock := &MockSeatHoldRepository{ctrl: ctrl}
+	mock.recorder = &MockSeatHoldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeatHoldRepository) EXPECT() *MockSeatHoldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBatch mocks base method.
+func (m *MockSeatHoldRepository) CreateBatch(ctx context.Context, holds []*entity.SeatHold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, holds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockSeatHoldRepositoryMockRecorder) CreateBatch(ctx, holds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockSeatHoldRepository)(nil).CreateBatch), ctx, holds)
+}
+
+// DeleteByWaitlistEntry mocks base method.
+func (m *MockSeatHoldRepository) DeleteByWaitlistEntry(ctx context.Context, entryID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByWaitlistEntry", ctx, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByWaitlistEntry indicates an expected call of DeleteByWaitlistEntry.
+func (mr *MockSeatHoldRepositoryMockRecorder) DeleteByWaitlistEntry(ctx, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByWaitlistEntry", reflect.TypeOf((*MockSeatHoldRepository)(nil).DeleteByWaitlistEntry), ctx, entryID)
+}
+
+// DeleteExpiredBySchedule mocks base method.
+func (m *MockSeatHoldRepository) DeleteExpiredBySchedule(ctx context.Context, scheduleID uuid.UUID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredBySchedule", ctx, scheduleID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredBySchedule indicates an expected call of DeleteExpiredBySchedule.
+func (mr *MockSeatHoldRepositoryMockRecorder) DeleteExpiredBySchedule(ctx, scheduleID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredBySchedule", reflect.TypeOf((*MockSeatHoldRepository)(nil).DeleteExpiredBySchedule), ctx, scheduleID, now)
+}
+
+// FindActiveBySchedule mocks base method.
+func (m *MockSeatHoldRepository) FindActiveBySchedule(ctx context.Context, scheduleID uuid.UUID, now time.Time) ([]*entity.SeatHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveBySchedule", ctx, scheduleID, now)
+	ret0, _ := ret[0].([]*entity.SeatHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveBySchedule indicates an expected call of FindActiveBySchedule.
+func (mr *MockSeatHoldRepositoryMockRecorder) FindActiveBySchedule(ctx, scheduleID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveBySchedule", reflect.TypeOf((*MockSeatHoldRepository)(nil).FindActiveBySchedule), ctx, scheduleID, now)
+}
+
+// FindByWaitlistEntry mocks base method.
+func (m *MockSeatHoldRepository) FindByWaitlistEntry(ctx context.Context, entryID uuid.UUID) ([]*entity.SeatHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByWaitlistEntry", ctx, entryID)
+	ret0, _ := ret[0].([]*entity.SeatHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByWaitlistEntry indicates an expected call of FindByWaitlistEntry.
+func (mr *MockSeatHoldRepositoryMockRecorder) FindByWaitlistEntry(ctx, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByWaitlistEntry", reflect.TypeOf((*MockSeatHoldRepository)(nil).FindByWaitlistEntry), ctx, entryID)
+}
@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: seat_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=seat_repo.go -destination=mockrepo/seat_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeatRepository is a mock of SeatRepository interface.
+type MockSeatRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeatRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSeatRepositoryMockRecorder is the mock recorder for MockSeatRepository.
+type MockSeatRepositoryMockRecorder struct {
+	mock *MockSeatRepository
+}
+
+// NewMockSeatRepository creates a new mock instance.
+func NewMockSeatRepository(ctrl *gomock.Controller) *MockSeatRepository {
+	mock := &MockSeatRepository{ctrl: ctrl}
+	mock.recorder = &MockSeatRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeatRepository) EXPECT() *MockSeatRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSeatRepository) Create(ctx context.Context, seat *entity.Seat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, seat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeatRepositoryMockRecorder) Create(ctx, seat any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeatRepository)(nil).Create), ctx, seat)
+}
+
+// CreateBatch mocks base method.
+func (m *MockSeatRepository) CreateBatch(ctx context.Context, seats []*entity.Seat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, seats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockSeatRepositoryMockRecorder) CreateBatch(ctx, seats any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockSeatRepository)(nil).CreateBatch), ctx, seats)
+}
+
+// Delete mocks base method.
+func (m *MockSeatRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSeatRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSeatRepository)(nil).Delete), ctx, id)
+}
+
+// FindAvailableByHallID mocks base method.
+func (m *MockSeatRepository) FindAvailableByHallID(ctx context.Context, hallID uuid.UUID) ([]*entity.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAvailableByHallID", ctx, hallID)
+	ret0, _ := ret[0].([]*entity.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAvailableByHallID indicates an expected call of FindAvailableByHallID.
+func (mr *MockSeatRepositoryMockRecorder) FindAvailableByHallID(ctx, hallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAvailableByHallID", reflect.TypeOf((*MockSeatRepository)(nil).FindAvailableByHallID), ctx, hallID)
+}
+
+// FindByHallID mocks base method.
+func (m *MockSeatRepository) FindByHallID(ctx context.Context, hallID uuid.UUID) ([]*entity.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHallID", ctx, hallID)
+	ret0, _ := ret[0].([]*entity.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHallID indicates an expected call of FindByHallID.
+func (mr *MockSeatRepositoryMockRecorder) FindByHallID(ctx, hallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHallID", reflect.TypeOf((*MockSeatRepository)(nil).FindByHallID), ctx, hallID)
+}
+
+// FindByID mocks base method.
+func (m *MockSeatRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSeatRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSeatRepository)(nil).FindByID), ctx, id)
+}
+
+// SetAvailability mocks base method.
+func (m *MockSeatRepository) SetAvailability(ctx context.Context, hallID uuid.UUID, seatIDs []uuid.UUID, available bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvailability", ctx, hallID, seatIDs, available)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAvailability indicates an expected call of SetAvailability.
+func (mr *MockSeatRepositoryMockRecorder) SetAvailability(ctx, hallID, seatIDs, available any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailability", reflect.TypeOf((*MockSeatRepository)(nil).SetAvailability), ctx, hallID, seatIDs, available)
+}
+
+// Update mocks base method.
+func (m *MockSeatRepository) Update(ctx context.Context, seat *entity.Seat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, seat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSeatRepositoryMockRecorder) Update(ctx, seat any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSeatRepository)(nil).Update), ctx, seat)
+}
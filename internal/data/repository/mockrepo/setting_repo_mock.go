@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: setting_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=setting_repo.go -destination=mockrepo/setting_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSettingRepository is a mock of SettingRepository interface.
+type MockSettingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSettingRepositoryMockRecorder is the mock recorder for MockSettingRepository.
+type MockSettingRepositoryMockRecorder struct {
+	mock *MockSettingRepository
+}
+
+// NewMockSettingRepository creates a new mock instance.
+func NewMockSettingRepository(ctrl *gomock.Controller) *MockSettingRepository {
+	mock := &MockSettingRepository{ctrl: ctrl}
+	mock.recorder = &MockSettingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettingRepository) EXPECT() *MockSettingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSettingRepository) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSettingRepositoryMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSettingRepository)(nil).Delete), ctx, key)
+}
+
+// FindAll mocks base method.
+func (m *MockSettingRepository) FindAll(ctx context.Context) ([]*entity.AppSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entity.AppSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockSettingRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockSettingRepository)(nil).FindAll), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockSettingRepository) Upsert(ctx context.Context, setting *entity.AppSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, setting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockSettingRepositoryMockRecorder) Upsert(ctx, setting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockSettingRepository)(nil).Upsert), ctx, setting)
+}
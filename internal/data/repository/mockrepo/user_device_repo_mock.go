@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_device_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_device_repo.go -destination=mockrepo/user_device_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserDeviceRepository is a mock of UserDeviceRepository interface.
+type MockUserDeviceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDeviceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserDeviceRepositoryMockRecorder is the mock recorder for MockUserDeviceRepository.
+type MockUserDeviceRepositoryMockRecorder struct {
+	mock *MockUserDeviceRepository
+}
+
+// NewMockUserDeviceRepository creates a new mock instance.
+func NewMockUserDeviceRepository(ctrl *gomock.Controller) *MockUserDeviceRepository {
+	mock := &MockUserDeviceRepository{ctrl: ctrl}
+	mock.recorder = &MockUserDeviceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDeviceRepository) EXPECT() *MockUserDeviceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByToken mocks base method.
+func (m *MockUserDeviceRepository) DeleteByToken(ctx context.Context, userID uuid.UUID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByToken", ctx, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByToken indicates an expected call of DeleteByToken.
+func (mr *MockUserDeviceRepositoryMockRecorder) DeleteByToken(ctx, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByToken", reflect.TypeOf((*MockUserDeviceRepository)(nil).DeleteByToken), ctx, userID, token)
+}
+
+// FindByUserID mocks base method.
+func (m *MockUserDeviceRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entity.UserDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockUserDeviceRepositoryMockRecorder) FindByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockUserDeviceRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// Upsert mocks base method.
+func (m *MockUserDeviceRepository) Upsert(ctx context.Context, device *entity.UserDevice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, device)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockUserDeviceRepositoryMockRecorder) Upsert(ctx, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockUserDeviceRepository)(nil).Upsert), ctx, device)
+}
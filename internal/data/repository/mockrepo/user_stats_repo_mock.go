@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_stats_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_stats_repo.go -destination=mockrepo/user_stats_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserStatsRepository is a mock of UserStatsRepository interface.
+type MockUserStatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserStatsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserStatsRepositoryMockRecorder is the mock recorder for MockUserStatsRepository.
+type MockUserStatsRepositoryMockRecorder struct {
+	mock *MockUserStatsRepository
+}
+
+// NewMockUserStatsRepository creates a new mock instance.
+func NewMockUserStatsRepository(ctrl *gomock.Controller) *MockUserStatsRepository {
+	mock := &MockUserStatsRepository{ctrl: ctrl}
+	mock.recorder = &MockUserStatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserStatsRepository) EXPECT() *MockUserStatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBookingStats mocks base method.
+func (m *MockUserStatsRepository) GetBookingStats(ctx context.Context, userID uuid.UUID) (*entity.UserBookingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingStats", ctx, userID)
+	ret0, _ := ret[0].(*entity.UserBookingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingStats indicates an expected call of GetBookingStats.
+func (mr *MockUserStatsRepositoryMockRecorder) GetBookingStats(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingStats", reflect.TypeOf((*MockUserStatsRepository)(nil).GetBookingStats), ctx, userID)
+}
+
+// GetFavoriteGenre mocks base method.
+func (m *MockUserStatsRepository) GetFavoriteGenre(ctx context.Context, userID uuid.UUID) (*entity.UserFavoriteGenre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoriteGenre", ctx, userID)
+	ret0, _ := ret[0].(*entity.UserFavoriteGenre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavoriteGenre indicates an expected call of GetFavoriteGenre.
+func (mr *MockUserStatsRepositoryMockRecorder) GetFavoriteGenre(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteGenre", reflect.TypeOf((*MockUserStatsRepository)(nil).GetFavoriteGenre), ctx, userID)
+}
+
+// GetLastLogin mocks base method.
+func (m *MockUserStatsRepository) GetLastLogin(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastLogin", ctx, userID)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastLogin indicates an expected call of GetLastLogin.
+func (mr *MockUserStatsRepositoryMockRecorder) GetLastLogin(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastLogin", reflect.TypeOf((*MockUserStatsRepository)(nil).GetLastLogin), ctx, userID)
+}
+
+// GetSpend mocks base method.
+func (m *MockUserStatsRepository) GetSpend(ctx context.Context, userID uuid.UUID) ([]*entity.UserSpend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpend", ctx, userID)
+	ret0, _ := ret[0].([]*entity.UserSpend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpend indicates an expected call of GetSpend.
+func (mr *MockUserStatsRepositoryMockRecorder) GetSpend(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpend", reflect.TypeOf((*MockUserStatsRepository)(nil).GetSpend), ctx, userID)
+}
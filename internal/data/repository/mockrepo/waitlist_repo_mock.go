@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: waitlist_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=waitlist_repo.go -destination=mockrepo/waitlist_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitlistRepository is a mock of WaitlistRepository interface.
+type MockWaitlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitlistRepositoryMockRecorder is the mock recorder for MockWaitlistRepository.
+type MockWaitlistRepositoryMockRecorder struct {
+	mock *MockWaitlistRepository
+}
+
+// NewMockWaitlistRepository creates a new mock instance.
+func NewMockWaitlistRepository(ctrl *gomock.Controller) *MockWaitlistRepository {
+	mock := &MockWaitlistRepository{ctrl: ctrl}
+	mock.recorder = &MockWaitlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistRepository) EXPECT() *MockWaitlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountAhead mocks base method.
+func (m *MockWaitlistRepository) CountAhead(ctx context.Context, entry *entity.WaitlistEntry) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAhead", ctx, entry)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAhead indicates an expected call of CountAhead.
+func (mr *MockWaitlistRepositoryMockRecorder) CountAhead(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAhead", reflect.TypeOf((*MockWaitlistRepository)(nil).CountAhead), ctx, entry)
+}
+
+// Create mocks base method.
+func (m *MockWaitlistRepository) Create(ctx context.Context, entry *entity.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWaitlistRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWaitlistRepository)(nil).Create), ctx, entry)
+}
+
+// FindActiveByScheduleAndUser mocks base method.
+func (m *MockWaitlistRepository) FindActiveByScheduleAndUser(ctx context.Context, scheduleID, userID uuid.UUID) (*entity.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByScheduleAndUser", ctx, scheduleID, userID)
+	ret0, _ := ret[0].(*entity.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByScheduleAndUser indicates an expected call of FindActiveByScheduleAndUser.
+func (mr *MockWaitlistRepositoryMockRecorder) FindActiveByScheduleAndUser(ctx, scheduleID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByScheduleAndUser", reflect.TypeOf((*MockWaitlistRepository)(nil).FindActiveByScheduleAndUser), ctx, scheduleID, userID)
+}
+
+// FindActiveByUserID mocks base method.
+func (m *MockWaitlistRepository) FindActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entity.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByUserID indicates an expected call of FindActiveByUserID.
+func (mr *MockWaitlistRepositoryMockRecorder) FindActiveByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByUserID", reflect.TypeOf((*MockWaitlistRepository)(nil).FindActiveByUserID), ctx, userID)
+}
+
+// FindByID mocks base method.
+func (m *MockWaitlistRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWaitlistRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWaitlistRepository)(nil).FindByID), ctx, id)
+}
+
+// FindExpiredOffersForUpdate mocks base method.
+func (m *MockWaitlistRepository) FindExpiredOffersForUpdate(ctx context.Context, now time.Time, limit int) ([]*entity.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredOffersForUpdate", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredOffersForUpdate indicates an expected call of FindExpiredOffersForUpdate.
+func (mr *MockWaitlistRepositoryMockRecorder) FindExpiredOffersForUpdate(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredOffersForUpdate", reflect.TypeOf((*MockWaitlistRepository)(nil).FindExpiredOffersForUpdate), ctx, now, limit)
+}
+
+// FindWaitingForUpdate mocks base method.
+func (m *MockWaitlistRepository) FindWaitingForUpdate(ctx context.Context, scheduleID uuid.UUID, limit int) ([]*entity.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWaitingForUpdate", ctx, scheduleID, limit)
+	ret0, _ := ret[0].([]*entity.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWaitingForUpdate indicates an expected call of FindWaitingForUpdate.
+func (mr *MockWaitlistRepositoryMockRecorder) FindWaitingForUpdate(ctx, scheduleID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWaitingForUpdate", reflect.TypeOf((*MockWaitlistRepository)(nil).FindWaitingForUpdate), ctx, scheduleID, limit)
+}
+
+// Update mocks base method.
+func (m *MockWaitlistRepository) Update(ctx context.Context, entry *entity.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWaitlistRepositoryMockRecorder) Update(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWaitlistRepository)(nil).Update), ctx, entry)
+}
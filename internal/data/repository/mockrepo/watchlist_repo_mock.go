@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: watchlist_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=watchlist_repo.go -destination=mockrepo/watchlist_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWatchlistRepository is a mock of WatchlistRepository interface.
+type MockWatchlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchlistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWatchlistRepositoryMockRecorder is the mock recorder for MockWatchlistRepository.
+type MockWatchlistRepositoryMockRecorder struct {
+	mock *MockWatchlistRepository
+}
+
+// NewMockWatchlistRepository creates a new mock instance.
+func NewMockWatchlistRepository(ctrl *gomock.Controller) *MockWatchlistRepository {
+	mock := &MockWatchlistRepository{ctrl: ctrl}
+	mock.recorder = &MockWatchlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchlistRepository) EXPECT() *MockWatchlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockWatchlistRepository) Add(ctx context.Context, item *entity.WatchlistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockWatchlistRepositoryMockRecorder) Add(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockWatchlistRepository)(nil).Add), ctx, item)
+}
+
+// FindMoviesByUserID mocks base method.
+func (m *MockWatchlistRepository) FindMoviesByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMoviesByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entity.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMoviesByUserID indicates an expected call of FindMoviesByUserID.
+func (mr *MockWatchlistRepositoryMockRecorder) FindMoviesByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMoviesByUserID", reflect.TypeOf((*MockWatchlistRepository)(nil).FindMoviesByUserID), ctx, userID)
+}
+
+// FindUserIDsByMovieID mocks base method.
+func (m *MockWatchlistRepository) FindUserIDsByMovieID(ctx context.Context, movieID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserIDsByMovieID", ctx, movieID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserIDsByMovieID indicates an expected call of FindUserIDsByMovieID.
+func (mr *MockWatchlistRepositoryMockRecorder) FindUserIDsByMovieID(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserIDsByMovieID", reflect.TypeOf((*MockWatchlistRepository)(nil).FindUserIDsByMovieID), ctx, movieID)
+}
+
+// FindWatchedMovieIDs mocks base method.
+func (m *MockWatchlistRepository) FindWatchedMovieIDs(ctx context.Context, userID uuid.UUID, movieIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWatchedMovieIDs", ctx, userID, movieIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWatchedMovieIDs indicates an expected call of FindWatchedMovieIDs.
+func (mr *MockWatchlistRepositoryMockRecorder) FindWatchedMovieIDs(ctx, userID, movieIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWatchedMovieIDs", reflect.TypeOf((*MockWatchlistRepository)(nil).FindWatchedMovieIDs), ctx, userID, movieIDs)
+}
+
+// Remove mocks base method.
+func (m *MockWatchlistRepository) Remove(ctx context.Context, userID, movieID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockWatchlistRepositoryMockRecorder) Remove(ctx, userID, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockWatchlistRepository)(nil).Remove), ctx, userID, movieID)
+}
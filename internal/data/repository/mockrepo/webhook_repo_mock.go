@@ -0,0 +1,218 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook_repo.go -destination=mockrepo/webhook_repo_mock.go -package=mockrepo
+//
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockWebhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockWebhookRepositoryMockRecorder) ClaimDue(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockWebhookRepository)(nil).ClaimDue), ctx, now, leaseUntil, limit)
+}
+
+// CountDeliveries mocks base method.
+func (m *MockWebhookRepository) CountDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *entity.WebhookDeliveryStatus) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeliveries", ctx, subscriptionID, status)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeliveries indicates an expected call of CountDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) CountDeliveries(ctx, subscriptionID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).CountDeliveries), ctx, subscriptionID, status)
+}
+
+// CreateDeliveries mocks base method.
+func (m *MockWebhookRepository) CreateDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveries", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeliveries indicates an expected call of CreateDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) CreateDeliveries(ctx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDeliveries), ctx, deliveries)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockWebhookRepository) CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) CreateSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).CreateSubscription), ctx, subscription)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteSubscription), ctx, id)
+}
+
+// FindActiveSubscriptions mocks base method.
+func (m *MockWebhookRepository) FindActiveSubscriptions(ctx context.Context, eventType string) ([]*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveSubscriptions", ctx, eventType)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveSubscriptions indicates an expected call of FindActiveSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) FindActiveSubscriptions(ctx, eventType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).FindActiveSubscriptions), ctx, eventType)
+}
+
+// FindDeliveries mocks base method.
+func (m *MockWebhookRepository) FindDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *entity.WebhookDeliveryStatus, limit, offset int) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveries", ctx, subscriptionID, status, limit, offset)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeliveries indicates an expected call of FindDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) FindDeliveries(ctx, subscriptionID, status, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).FindDeliveries), ctx, subscriptionID, status, limit, offset)
+}
+
+// FindSubscriptionByID mocks base method.
+func (m *MockWebhookRepository) FindSubscriptionByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubscriptionByID", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubscriptionByID indicates an expected call of FindSubscriptionByID.
+func (mr *MockWebhookRepositoryMockRecorder) FindSubscriptionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubscriptionByID", reflect.TypeOf((*MockWebhookRepository)(nil).FindSubscriptionByID), ctx, id)
+}
+
+// FindSubscriptions mocks base method.
+func (m *MockWebhookRepository) FindSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubscriptions", ctx)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubscriptions indicates an expected call of FindSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) FindSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).FindSubscriptions), ctx)
+}
+
+// MarkAttemptFailed mocks base method.
+func (m *MockWebhookRepository) MarkAttemptFailed(ctx context.Context, id uuid.UUID, statusCode *int, errMsg string, at time.Time, nextAttemptAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAttemptFailed", ctx, id, statusCode, errMsg, at, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAttemptFailed indicates an expected call of MarkAttemptFailed.
+func (mr *MockWebhookRepositoryMockRecorder) MarkAttemptFailed(ctx, id, statusCode, errMsg, at, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAttemptFailed", reflect.TypeOf((*MockWebhookRepository)(nil).MarkAttemptFailed), ctx, id, statusCode, errMsg, at, nextAttemptAt)
+}
+
+// MarkDelivered mocks base method.
+func (m *MockWebhookRepository) MarkDelivered(ctx context.Context, id uuid.UUID, statusCode int, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, id, statusCode, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDelivered indicates an expected call of MarkDelivered.
+func (mr *MockWebhookRepositoryMockRecorder) MarkDelivered(ctx, id, statusCode, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockWebhookRepository)(nil).MarkDelivered), ctx, id, statusCode, at)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookRepository) UpdateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateSubscription), ctx, subscription)
+}
@@ -5,6 +5,7 @@ import (
 	"cinema-booking/pkg/database"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -13,6 +14,9 @@ import (
 type MovieGenreRepository interface {
 	DeleteByMovieID(ctx context.Context, movieID uuid.UUID) error
 	CreateBatch(ctx context.Context, movieGenres []*entity.MovieGenre) error
+	// ReassignGenre moves semua movie dari genre fromID ke toID. Movie yang sudah punya toID
+	// tidak diduplikasi. Returns jumlah movie yang baru mendapat toID.
+	ReassignGenre(ctx context.Context, fromID, toID uuid.UUID) (int64, error)
 }
 
 type movieGenreRepository struct {
@@ -71,3 +75,36 @@ func (r *movieGenreRepository) CreateBatch(ctx context.Context, movieGenres []*e
 
 	return nil
 }
+
+func (r *movieGenreRepository) ReassignGenre(ctx context.Context, fromID, toID uuid.UUID) (int64, error) {
+	insertQuery := `
+		INSERT INTO movie_genres (id, movie_id, genre_id, created_at)
+		SELECT gen_random_uuid(), mg.movie_id, $2, $3
+		FROM movie_genres mg
+		WHERE mg.genre_id = $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM movie_genres existing
+			WHERE existing.movie_id = mg.movie_id AND existing.genre_id = $2
+		  )
+	`
+
+	result, err := r.db.Exec(ctx, insertQuery, fromID, toID, time.Now())
+	if err != nil {
+		r.log.Error("Failed to reassign movie_genres",
+			zap.Error(err),
+			zap.String("from_genre_id", fromID.String()),
+			zap.String("to_genre_id", toID.String()),
+		)
+		return 0, fmt.Errorf("reassign movie_genres: %w", err)
+	}
+
+	if _, err := r.db.Exec(ctx, `DELETE FROM movie_genres WHERE genre_id = $1`, fromID); err != nil {
+		r.log.Error("Failed to delete movie_genres by genre ID",
+			zap.Error(err),
+			zap.String("genre_id", fromID.String()),
+		)
+		return 0, fmt.Errorf("delete movie_genres by genre id: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
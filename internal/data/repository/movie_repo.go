@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/pkg/database"
@@ -13,14 +14,76 @@ import (
 	"go.uber.org/zap"
 )
 
+// MovieSortField kolom urutan listing movie; nilai di luar daftar ini jatuh ke release_date
+type MovieSortField string
+
+const (
+	MovieSortReleaseDate MovieSortField = "release_date"
+	MovieSortRating      MovieSortField = "rating"
+	MovieSortTitle       MovieSortField = "title"
+	MovieSortPopularity  MovieSortField = "popularity"
+)
+
+// movieTicketsSold tiket terjual per movie dari booking yang sudah dibayar, untuk sort popularity
+const movieTicketsSold = `(
+	SELECT COALESCE(SUM(b.total_seats), 0)
+	FROM bookings b
+	INNER JOIN schedules s ON s.id = b.schedule_id
+	WHERE s.movie_id = movies.id AND b.status IN ('confirmed', 'checked_in') AND b.deleted_at IS NULL
+)`
+
+// MovieSort urutan listing movie. Ascending nil memakai arah bawaan field-nya.
+type MovieSort struct {
+	Field     MovieSortField
+	Ascending *bool
+}
+
+// orderClause hanya menyusun SQL dari konstanta di atas; id di akhir menjaga urutan stabil antar halaman
+func (s MovieSort) orderClause() string {
+	expr, ascending := "release_date", false
+	switch s.Field {
+	case MovieSortRating:
+		expr = "rating"
+	case MovieSortTitle:
+		expr, ascending = "LOWER(title)", true
+	case MovieSortPopularity:
+		expr = movieTicketsSold
+	}
+	if s.Ascending != nil {
+		ascending = *s.Ascending
+	}
+
+	direction := "DESC"
+	if ascending {
+		direction = "ASC"
+	}
+	return fmt.Sprintf("%s %s, id %s", expr, direction, direction)
+}
+
+// TrendingMovie movie beserta jumlah tiket terjual dalam window trending
+type TrendingMovie struct {
+	Movie       *entity.Movie
+	TicketsSold int64
+}
+
 type MovieRepository interface {
 	Create(ctx context.Context, movie *entity.Movie) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Movie, error)
-	FindAll(ctx context.Context, limit, offset int, releaseStatus *string) ([]*entity.Movie, error)
-	CountAll(ctx context.Context, releaseStatus *string) (int64, error)
+	FindAll(ctx context.Context, limit, offset int, releaseStatus *string, includeDeleted bool, sort MovieSort) ([]*entity.Movie, error)
+	CountAll(ctx context.Context, releaseStatus *string, includeDeleted bool) (int64, error)
 	Update(ctx context.Context, movie *entity.Movie) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) error
 	UpdateRating(ctx context.Context, movieID uuid.UUID, newRating float64) error
+	FindTopRated(ctx context.Context, limit int) ([]*entity.Movie, error)
+	// FindTrending returns movie dengan tiket terjual terbanyak dari booking yang dibuat sejak since
+	FindTrending(ctx context.Context, since time.Time, limit int) ([]*TrendingMovie, error)
+	FindByReleaseStatuses(ctx context.Context, statuses []entity.ReleaseStatus) ([]*entity.Movie, error)
+	// ExistsByTitleAndReleaseDate cek duplikat saat import; judul dibandingkan case-insensitive
+	ExistsByTitleAndReleaseDate(ctx context.Context, title string, releaseDate time.Time) (bool, error)
+	SetReleaseStatus(ctx context.Context, id uuid.UUID, status entity.ReleaseStatus, locked bool) error
+	PromoteReleased(ctx context.Context, today time.Time) ([]*entity.Movie, error)
+	ArchiveEnded(ctx context.Context, today, releasedBefore time.Time) (int64, error)
 }
 
 type movieRepository struct {
@@ -39,8 +102,8 @@ func (r *movieRepository) Create(ctx context.Context, movie *entity.Movie) error
 	query := `
 		INSERT INTO movies (id, title, description, poster_url, rating,
 		                   release_date, duration_in_minutes, release_status,
-		                   created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		                   age_rating, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
 	_, err := r.db.Exec(ctx, query,
@@ -52,6 +115,7 @@ func (r *movieRepository) Create(ctx context.Context, movie *entity.Movie) error
 		movie.ReleaseDate,
 		movie.DurationInMinutes,
 		movie.ReleaseStatus,
+		movie.AgeRating,
 		movie.CreatedAt,
 		movie.UpdatedAt,
 	)
@@ -70,7 +134,7 @@ func (r *movieRepository) Create(ctx context.Context, movie *entity.Movie) error
 func (r *movieRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Movie, error) {
 	query := `
 		SELECT id, title, description, poster_url, rating, release_date,
-		       duration_in_minutes, release_status, created_at, updated_at, deleted_at
+		       duration_in_minutes, release_status, age_rating, created_at, updated_at, deleted_at
 		FROM movies
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -85,6 +149,7 @@ func (r *movieRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.M
 		&movie.ReleaseDate,
 		&movie.DurationInMinutes,
 		&movie.ReleaseStatus,
+		&movie.AgeRating,
 		&movie.CreatedAt,
 		&movie.UpdatedAt,
 		&movie.DeletedAt,
@@ -104,7 +169,7 @@ func (r *movieRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.M
 	return &movie, nil
 }
 
-func (r *movieRepository) FindAll(ctx context.Context, limit, offset int, releaseStatus *string) ([]*entity.Movie, error) {
+func (r *movieRepository) FindAll(ctx context.Context, limit, offset int, releaseStatus *string, includeDeleted bool, sort MovieSort) ([]*entity.Movie, error) {
 	// Build query dynamically based on filter
 	var queryBuilder strings.Builder
 	args := []interface{}{}
@@ -112,11 +177,16 @@ func (r *movieRepository) FindAll(ctx context.Context, limit, offset int, releas
 
 	queryBuilder.WriteString(`
 		SELECT id, title, description, poster_url, rating, release_date,
-		       duration_in_minutes, release_status, created_at, updated_at
+		       duration_in_minutes, release_status, age_rating, created_at, updated_at, deleted_at
 		FROM movies
-		WHERE deleted_at IS NULL
+		WHERE 1 = 1
 	`)
 
+	// Admin list boleh lihat movie yang sudah di-soft delete
+	if !includeDeleted {
+		queryBuilder.WriteString(" AND deleted_at IS NULL")
+	}
+
 	// Add release_status filter if provided
 	if releaseStatus != nil && *releaseStatus != "" {
 		queryBuilder.WriteString(fmt.Sprintf(" AND release_status = $%d", argCount))
@@ -125,11 +195,11 @@ func (r *movieRepository) FindAll(ctx context.Context, limit, offset int, releas
 	}
 
 	// Add pagination parameters
-	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY release_date DESC LIMIT $%d OFFSET $%d", argCount, argCount+1))
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", sort.orderClause(), argCount, argCount+1))
 	args = append(args, limit, offset)
 
 	// Execute dynamic query
-	rows, err := r.db.Query(ctx, queryBuilder.String(), args...)
+	rows, err := r.db.Reader().Query(ctx, queryBuilder.String(), args...)
 	if err != nil {
 		r.log.Error("Failed to find all movies",
 			zap.Error(err),
@@ -153,8 +223,10 @@ func (r *movieRepository) FindAll(ctx context.Context, limit, offset int, releas
 			&movie.ReleaseDate,
 			&movie.DurationInMinutes,
 			&movie.ReleaseStatus,
+			&movie.AgeRating,
 			&movie.CreatedAt,
 			&movie.UpdatedAt,
+			&movie.DeletedAt,
 		)
 		if err != nil {
 			r.log.Error("Failed to scan movie row", zap.Error(err))
@@ -177,18 +249,22 @@ func (r *movieRepository) FindAll(ctx context.Context, limit, offset int, releas
 	return movies, nil
 }
 
-func (r *movieRepository) CountAll(ctx context.Context, releaseStatus *string) (int64, error) {
+func (r *movieRepository) CountAll(ctx context.Context, releaseStatus *string, includeDeleted bool) (int64, error) {
 	// Build count query
-	query := `SELECT COUNT(*) FROM movies WHERE deleted_at IS NULL`
+	query := `SELECT COUNT(*) FROM movies WHERE 1 = 1`
 	args := []interface{}{}
 
+	if !includeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
+
 	if releaseStatus != nil && *releaseStatus != "" {
 		query += " AND release_status = $1"
 		args = append(args, *releaseStatus)
 	}
 
 	var total int64
-	err := r.db.QueryRow(ctx, query, args...).Scan(&total)
+	err := r.db.Reader().QueryRow(ctx, query, args...).Scan(&total)
 	if err != nil {
 		r.log.Error("Failed to count movies",
 			zap.Error(err),
@@ -205,7 +281,7 @@ func (r *movieRepository) Update(ctx context.Context, movie *entity.Movie) error
 		UPDATE movies
 		SET title = $2, description = $3, poster_url = $4, rating = $5,
 		    release_date = $6, duration_in_minutes = $7, release_status = $8,
-		    updated_at = $9
+		    age_rating = $9, updated_at = $10
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
@@ -218,6 +294,7 @@ func (r *movieRepository) Update(ctx context.Context, movie *entity.Movie) error
 		movie.ReleaseDate,
 		movie.DurationInMinutes,
 		movie.ReleaseStatus,
+		movie.AgeRating,
 		movie.UpdatedAt,
 	)
 
@@ -258,6 +335,26 @@ func (r *movieRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (r *movieRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE movies SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to restore movie",
+			zap.Error(err),
+			zap.String("movie_id", id.String()),
+		)
+		return fmt.Errorf("restore movie: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("movie not found or not deleted")
+	}
+
+	r.log.Info("Movie restored", zap.String("movie_id", id.String()))
+	return nil
+}
+
 func (r *movieRepository) UpdateRating(ctx context.Context, movieID uuid.UUID, newRating float64) error {
 	query := `UPDATE movies SET rating = $2, updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 
@@ -277,3 +374,286 @@ func (r *movieRepository) UpdateRating(ctx context.Context, movieID uuid.UUID, n
 
 	return nil
 }
+
+// FindTopRated returns movies yang sudah punya rating, tertinggi duluan
+func (r *movieRepository) FindTopRated(ctx context.Context, limit int) ([]*entity.Movie, error) {
+	query := `
+		SELECT id, title, description, poster_url, rating, release_date,
+		       duration_in_minutes, release_status, age_rating, created_at, updated_at, deleted_at
+		FROM movies
+		WHERE deleted_at IS NULL AND rating > 0
+		ORDER BY rating DESC, release_date DESC
+		LIMIT $1
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, limit)
+	if err != nil {
+		r.log.Error("Failed to find top rated movies", zap.Error(err), zap.Int("limit", limit))
+		return nil, fmt.Errorf("find top rated movies: %w", err)
+	}
+	defer rows.Close()
+
+	var movies []*entity.Movie
+	for rows.Next() {
+		var movie entity.Movie
+		err := rows.Scan(
+			&movie.ID,
+			&movie.Title,
+			&movie.Description,
+			&movie.PosterURL,
+			&movie.Rating,
+			&movie.ReleaseDate,
+			&movie.DurationInMinutes,
+			&movie.ReleaseStatus,
+			&movie.AgeRating,
+			&movie.CreatedAt,
+			&movie.UpdatedAt,
+			&movie.DeletedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan movie row", zap.Error(err))
+			return nil, fmt.Errorf("scan movie row: %w", err)
+		}
+		movies = append(movies, &movie)
+	}
+
+	return movies, nil
+}
+
+func (r *movieRepository) FindTrending(ctx context.Context, since time.Time, limit int) ([]*TrendingMovie, error) {
+	query := `
+		SELECT m.id, m.title, m.description, m.poster_url, m.rating, m.release_date,
+		       m.duration_in_minutes, m.release_status, m.age_rating, m.created_at, m.updated_at, m.deleted_at,
+		       sales.tickets_sold
+		FROM (
+			SELECT s.movie_id, SUM(b.total_seats) AS tickets_sold
+			FROM bookings b
+			INNER JOIN schedules s ON s.id = b.schedule_id
+			WHERE b.status IN ('confirmed', 'checked_in') AND b.deleted_at IS NULL AND b.created_at >= $1
+			GROUP BY s.movie_id
+		) sales
+		INNER JOIN movies m ON m.id = sales.movie_id
+		WHERE m.deleted_at IS NULL
+		ORDER BY sales.tickets_sold DESC, m.rating DESC, m.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, since, limit)
+	if err != nil {
+		r.log.Error("Failed to find trending movies", zap.Error(err), zap.Int("limit", limit))
+		return nil, fmt.Errorf("find trending movies: %w", err)
+	}
+	defer rows.Close()
+
+	trending := []*TrendingMovie{}
+	for rows.Next() {
+		var movie entity.Movie
+		var ticketsSold int64
+		err := rows.Scan(
+			&movie.ID,
+			&movie.Title,
+			&movie.Description,
+			&movie.PosterURL,
+			&movie.Rating,
+			&movie.ReleaseDate,
+			&movie.DurationInMinutes,
+			&movie.ReleaseStatus,
+			&movie.AgeRating,
+			&movie.CreatedAt,
+			&movie.UpdatedAt,
+			&movie.DeletedAt,
+			&ticketsSold,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan trending movie row", zap.Error(err))
+			return nil, fmt.Errorf("scan trending movie row: %w", err)
+		}
+		trending = append(trending, &TrendingMovie{Movie: &movie, TicketsSold: ticketsSold})
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate trending movie rows: %w", err)
+	}
+
+	return trending, nil
+}
+
+// FindByReleaseStatuses returns semua movie aktif dengan salah satu status, tanpa pagination (untuk sitemap dan feed)
+func (r *movieRepository) FindByReleaseStatuses(ctx context.Context, statuses []entity.ReleaseStatus) ([]*entity.Movie, error) {
+	query := `
+		SELECT id, title, description, poster_url, rating, release_date,
+		       duration_in_minutes, release_status, age_rating, created_at, updated_at, deleted_at
+		FROM movies
+		WHERE deleted_at IS NULL AND release_status = ANY($1)
+		ORDER BY release_date DESC, id
+	`
+
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = string(status)
+	}
+
+	rows, err := r.db.Reader().Query(ctx, query, values)
+	if err != nil {
+		r.log.Error("Failed to find movies by release status",
+			zap.Error(err),
+			zap.Strings("release_statuses", values),
+		)
+		return nil, fmt.Errorf("find movies by release status: %w", err)
+	}
+	defer rows.Close()
+
+	var movies []*entity.Movie
+	for rows.Next() {
+		var movie entity.Movie
+		err := rows.Scan(
+			&movie.ID,
+			&movie.Title,
+			&movie.Description,
+			&movie.PosterURL,
+			&movie.Rating,
+			&movie.ReleaseDate,
+			&movie.DurationInMinutes,
+			&movie.ReleaseStatus,
+			&movie.AgeRating,
+			&movie.CreatedAt,
+			&movie.UpdatedAt,
+			&movie.DeletedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan movie row", zap.Error(err))
+			return nil, fmt.Errorf("scan movie row: %w", err)
+		}
+		movies = append(movies, &movie)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return movies, nil
+}
+
+func (r *movieRepository) ExistsByTitleAndReleaseDate(ctx context.Context, title string, releaseDate time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM movies
+			WHERE LOWER(title) = LOWER($1) AND release_date = $2 AND deleted_at IS NULL
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, query, title, releaseDate).Scan(&exists); err != nil {
+		r.log.Error("Failed to check movie existence",
+			zap.Error(err),
+			zap.String("title", title),
+		)
+		return false, fmt.Errorf("check movie existence: %w", err)
+	}
+
+	return exists, nil
+}
+
+// SetReleaseStatus is the admin override; locked = true membuat job otomatis melewati movie ini
+func (r *movieRepository) SetReleaseStatus(ctx context.Context, id uuid.UUID, status entity.ReleaseStatus, locked bool) error {
+	query := `
+		UPDATE movies
+		SET release_status = $2, release_status_locked = $3, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, id, status, locked)
+	if err != nil {
+		r.log.Error("Failed to set movie release status",
+			zap.Error(err),
+			zap.String("movie_id", id.String()),
+			zap.String("release_status", string(status)),
+		)
+		return fmt.Errorf("set release status: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("movie not found")
+	}
+
+	return nil
+}
+
+// PromoteReleased flips coming_soon ke now_playing untuk movie yang release date-nya sudah lewat.
+// Movie yang dipromosikan dikembalikan supaya watcher bisa dikabari.
+func (r *movieRepository) PromoteReleased(ctx context.Context, today time.Time) ([]*entity.Movie, error) {
+	query := `
+		UPDATE movies
+		SET release_status = 'now_playing', updated_at = NOW()
+		WHERE release_status = 'coming_soon'
+		  AND release_date <= $1
+		  AND deleted_at IS NULL
+		  AND NOT release_status_locked
+		RETURNING id, title, description, poster_url, rating, release_date,
+		          duration_in_minutes, release_status, age_rating, created_at, updated_at, deleted_at
+	`
+
+	rows, err := r.db.Query(ctx, query, today)
+	if err != nil {
+		r.log.Error("Failed to promote released movies", zap.Error(err))
+		return nil, fmt.Errorf("promote released movies: %w", err)
+	}
+	defer rows.Close()
+
+	var movies []*entity.Movie
+	for rows.Next() {
+		var movie entity.Movie
+		err := rows.Scan(
+			&movie.ID,
+			&movie.Title,
+			&movie.Description,
+			&movie.PosterURL,
+			&movie.Rating,
+			&movie.ReleaseDate,
+			&movie.DurationInMinutes,
+			&movie.ReleaseStatus,
+			&movie.AgeRating,
+			&movie.CreatedAt,
+			&movie.UpdatedAt,
+			&movie.DeletedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan promoted movie", zap.Error(err))
+			return nil, fmt.Errorf("scan promoted movie: %w", err)
+		}
+		movies = append(movies, &movie)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return movies, nil
+}
+
+// ArchiveEnded moves now_playing movies tanpa schedule mulai hari ini ke ended.
+// Draft ikut dihitung karena artinya admin masih merencanakan penayangan.
+func (r *movieRepository) ArchiveEnded(ctx context.Context, today, releasedBefore time.Time) (int64, error) {
+	query := `
+		UPDATE movies m
+		SET release_status = 'ended', updated_at = NOW()
+		WHERE m.release_status = 'now_playing'
+		  AND m.release_date < $2
+		  AND m.deleted_at IS NULL
+		  AND NOT m.release_status_locked
+		  AND NOT EXISTS (
+		      SELECT 1 FROM schedules s
+		      WHERE s.movie_id = m.id AND s.deleted_at IS NULL AND s.show_date >= $1
+		  )
+	`
+
+	result, err := r.db.Exec(ctx, query, today, releasedBefore)
+	if err != nil {
+		r.log.Error("Failed to archive ended movies", zap.Error(err))
+		return 0, fmt.Errorf("archive ended movies: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type NotificationSettingRepository interface {
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserNotificationSetting, error)
+	Upsert(ctx context.Context, setting *entity.UserNotificationSetting) error
+}
+
+type notificationSettingRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewNotificationSettingRepository(db database.PgxIface, log *zap.Logger) NotificationSettingRepository {
+	return &notificationSettingRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "notification_setting")),
+	}
+}
+
+func (r *notificationSettingRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserNotificationSetting, error) {
+	query := `
+		SELECT id, user_id, channel, booking_confirmation, reminders, promotions, created_at, updated_at
+		FROM user_notification_settings
+		WHERE user_id = $1
+		ORDER BY channel
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to find notification settings by user ID",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find notification settings by user ID %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	var settings []*entity.UserNotificationSetting
+	for rows.Next() {
+		var setting entity.UserNotificationSetting
+		err := rows.Scan(
+			&setting.ID,
+			&setting.UserID,
+			&setting.Channel,
+			&setting.BookingConfirmation,
+			&setting.Reminders,
+			&setting.Promotions,
+			&setting.CreatedAt,
+			&setting.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan notification setting row", zap.Error(err))
+			return nil, fmt.Errorf("scan notification setting row: %w", err)
+		}
+		settings = append(settings, &setting)
+	}
+
+	return settings, nil
+}
+
+func (r *notificationSettingRepository) Upsert(ctx context.Context, setting *entity.UserNotificationSetting) error {
+	query := `
+		INSERT INTO user_notification_settings (id, user_id, channel, booking_confirmation,
+		                                        reminders, promotions, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id, channel) DO UPDATE
+		SET booking_confirmation = EXCLUDED.booking_confirmation,
+		    reminders = EXCLUDED.reminders,
+		    promotions = EXCLUDED.promotions,
+		    updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		setting.ID,
+		setting.UserID,
+		setting.Channel,
+		setting.BookingConfirmation,
+		setting.Reminders,
+		setting.Promotions,
+		setting.CreatedAt,
+		setting.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to upsert notification setting",
+			zap.Error(err),
+			zap.String("user_id", setting.UserID.String()),
+			zap.String("channel", string(setting.Channel)),
+		)
+		return fmt.Errorf("upsert notification setting for user %s channel %s: %w",
+			setting.UserID.String(), setting.Channel, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type OrganizationRepository interface {
+	Create(ctx context.Context, organization *entity.Organization) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error)
+	FindBySlug(ctx context.Context, slug string) (*entity.Organization, error)
+	FindAll(ctx context.Context) ([]*entity.Organization, error)
+	Update(ctx context.Context, organization *entity.Organization) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// AssignCinemas memindahkan cinema ke organization; id nil mengembalikannya ke platform
+	AssignCinemas(ctx context.Context, id *uuid.UUID, cinemaIDs []uuid.UUID) (int64, error)
+	CountCinemas(ctx context.Context, id uuid.UUID) (int64, error)
+	CountAdmins(ctx context.Context, id uuid.UUID) (int64, error)
+
+	// Cek kepemilikan untuk admin chain; cinema yang sudah soft delete tetap dihitung supaya bisa di-restore
+	OwnsCinema(ctx context.Context, id, cinemaID uuid.UUID) (bool, error)
+	OwnsHall(ctx context.Context, id, hallID uuid.UUID) (bool, error)
+	OwnsSchedule(ctx context.Context, id, scheduleID uuid.UUID) (bool, error)
+}
+
+type organizationRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewOrganizationRepository(db database.PgxIface, log *zap.Logger) OrganizationRepository {
+	return &organizationRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "organization")),
+	}
+}
+
+func (r *organizationRepository) Create(ctx context.Context, organization *entity.Organization) error {
+	query := `
+		INSERT INTO organizations (id, name, slug, order_prefix, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		organization.ID,
+		organization.Name,
+		organization.Slug,
+		organization.OrderPrefix,
+		organization.CreatedAt,
+		organization.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to create organization",
+			zap.Error(err),
+			zap.String("slug", organization.Slug),
+		)
+		return fmt.Errorf("create organization %s: %w", organization.Slug, err)
+	}
+
+	return nil
+}
+
+func (r *organizationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	query := `
+		SELECT id, name, slug, order_prefix, created_at, updated_at
+		FROM organizations
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	var organization entity.Organization
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&organization.ID,
+		&organization.Name,
+		&organization.Slug,
+		&organization.OrderPrefix,
+		&organization.CreatedAt,
+		&organization.UpdatedAt,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find organization by ID",
+			zap.Error(err),
+			zap.String("organization_id", id.String()),
+		)
+		return nil, fmt.Errorf("find organization by ID %s: %w", id.String(), err)
+	}
+
+	return &organization, nil
+}
+
+func (r *organizationRepository) FindBySlug(ctx context.Context, slug string) (*entity.Organization, error) {
+	query := `
+		SELECT id, name, slug, order_prefix, created_at, updated_at
+		FROM organizations
+		WHERE slug = $1 AND deleted_at IS NULL
+	`
+
+	var organization entity.Organization
+	err := r.db.QueryRow(ctx, query, slug).Scan(
+		&organization.ID,
+		&organization.Name,
+		&organization.Slug,
+		&organization.OrderPrefix,
+		&organization.CreatedAt,
+		&organization.UpdatedAt,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find organization by slug",
+			zap.Error(err),
+			zap.String("slug", slug),
+		)
+		return nil, fmt.Errorf("find organization by slug %s: %w", slug, err)
+	}
+
+	return &organization, nil
+}
+
+func (r *organizationRepository) FindAll(ctx context.Context) ([]*entity.Organization, error) {
+	query := `
+		SELECT id, name, slug, order_prefix, created_at, updated_at
+		FROM organizations
+		WHERE deleted_at IS NULL
+		ORDER BY name
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find organizations", zap.Error(err))
+		return nil, fmt.Errorf("find organizations: %w", err)
+	}
+	defer rows.Close()
+
+	organizations := []*entity.Organization{}
+	for rows.Next() {
+		var organization entity.Organization
+		err := rows.Scan(
+			&organization.ID,
+			&organization.Name,
+			&organization.Slug,
+			&organization.OrderPrefix,
+			&organization.CreatedAt,
+			&organization.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan organization row", zap.Error(err))
+			return nil, fmt.Errorf("scan organization row: %w", err)
+		}
+		organizations = append(organizations, &organization)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate organization rows: %w", err)
+	}
+
+	return organizations, nil
+}
+
+func (r *organizationRepository) Update(ctx context.Context, organization *entity.Organization) error {
+	query := `
+		UPDATE organizations
+		SET name = $2, slug = $3, order_prefix = $4, updated_at = $5
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		organization.ID,
+		organization.Name,
+		organization.Slug,
+		organization.OrderPrefix,
+		organization.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to update organization",
+			zap.Error(err),
+			zap.String("organization_id", organization.ID.String()),
+		)
+		return fmt.Errorf("update organization %s: %w", organization.ID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("organization %s not found", organization.ID.String())
+	}
+
+	return nil
+}
+
+func (r *organizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE organizations SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to delete organization",
+			zap.Error(err),
+			zap.String("organization_id", id.String()),
+		)
+		return fmt.Errorf("delete organization %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("organization %s not found", id.String())
+	}
+
+	r.log.Info("Organization deleted", zap.String("organization_id", id.String()))
+	return nil
+}
+
+func (r *organizationRepository) AssignCinemas(ctx context.Context, id *uuid.UUID, cinemaIDs []uuid.UUID) (int64, error) {
+	query := `UPDATE cinemas SET organization_id = $1, updated_at = NOW() WHERE id = ANY($2) AND deleted_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, id, cinemaIDs)
+	if err != nil {
+		r.log.Error("Failed to assign cinemas to organization",
+			zap.Error(err),
+			zap.Int("cinemas", len(cinemaIDs)),
+		)
+		return 0, fmt.Errorf("assign cinemas to organization: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *organizationRepository) CountCinemas(ctx context.Context, id uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM cinemas WHERE organization_id = $1 AND deleted_at IS NULL`
+
+	var count int64
+	if err := r.db.QueryRow(ctx, query, id).Scan(&count); err != nil {
+		r.log.Error("Failed to count organization cinemas", zap.Error(err))
+		return 0, fmt.Errorf("count cinemas of organization %s: %w", id.String(), err)
+	}
+
+	return count, nil
+}
+
+func (r *organizationRepository) CountAdmins(ctx context.Context, id uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM users WHERE organization_id = $1 AND role = 'admin' AND deleted_at IS NULL`
+
+	var count int64
+	if err := r.db.QueryRow(ctx, query, id).Scan(&count); err != nil {
+		r.log.Error("Failed to count organization admins", zap.Error(err))
+		return 0, fmt.Errorf("count admins of organization %s: %w", id.String(), err)
+	}
+
+	return count, nil
+}
+
+func (r *organizationRepository) OwnsCinema(ctx context.Context, id, cinemaID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM cinemas WHERE id = $1 AND organization_id = $2)`
+	return r.exists(ctx, query, cinemaID, id)
+}
+
+func (r *organizationRepository) OwnsHall(ctx context.Context, id, hallID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM halls h
+			INNER JOIN cinemas c ON c.id = h.cinema_id
+			WHERE h.id = $1 AND c.organization_id = $2
+		)
+	`
+	return r.exists(ctx, query, hallID, id)
+}
+
+func (r *organizationRepository) OwnsSchedule(ctx context.Context, id, scheduleID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM schedules s
+			INNER JOIN halls h ON h.id = s.hall_id
+			INNER JOIN cinemas c ON c.id = h.cinema_id
+			WHERE s.id = $1 AND c.organization_id = $2
+		)
+	`
+	return r.exists(ctx, query, scheduleID, id)
+}
+
+func (r *organizationRepository) exists(ctx context.Context, query string, resourceID, id uuid.UUID) (bool, error) {
+	var owned bool
+	if err := r.db.Reader().QueryRow(ctx, query, resourceID, id).Scan(&owned); err != nil {
+		r.log.Error("Failed to check organization ownership",
+			zap.Error(err),
+			zap.String("organization_id", id.String()),
+			zap.String("resource_id", resourceID.String()),
+		)
+		return false, fmt.Errorf("check organization %s ownership of %s: %w", id.String(), resourceID.String(), err)
+	}
+
+	return owned, nil
+}
+
+// organizationScheduleSQL seperti organizationCinemaSQL tapi untuk kolom schedule ID (booking, report)
+func organizationScheduleSQL(col string, argN int) string {
+	return fmt.Sprintf(`($%[2]d::uuid IS NULL OR %[1]s IN (
+			SELECT os.id FROM schedules os
+			INNER JOIN halls oh ON oh.id = os.hall_id
+			INNER JOIN cinemas oc ON oc.id = oh.cinema_id
+			WHERE oc.organization_id = $%[2]d::uuid))`, col, argN)
+}
+
+// organizationCinemaSQL kondisi "cinema col milik organization $argN"; $argN NULL berarti tanpa filter (admin platform)
+func organizationCinemaSQL(col string, argN int) string {
+	return fmt.Sprintf(`($%[2]d::uuid IS NULL OR %[1]s IN (SELECT id FROM cinemas WHERE organization_id = $%[2]d::uuid))`, col, argN)
+}
@@ -3,9 +3,11 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/pkg/database"
+	"cinema-booking/pkg/utils"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -16,6 +18,8 @@ type OTPRepository interface {
 	Create(ctx context.Context, otp *entity.OTP) error
 	FindValidOTP(ctx context.Context, email, otpCode, otpType string) (*entity.OTP, error)
 	MarkAsUsed(ctx context.Context, otpID uuid.UUID) error
+	// DeleteExpired menghapus OTP (terpakai atau tidak) yang expired sebelum before, maksimal limit baris
+	DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error)
 }
 
 type otpRepository struct {
@@ -51,10 +55,10 @@ func (r *otpRepository) Create(ctx context.Context, otp *entity.OTP) error {
 	if err != nil {
 		r.log.Error("Failed to create OTP",
 			zap.Error(err),
-			zap.String("email", otp.Email),
+			utils.EmailField(otp.Email),
 			zap.String("otp_type", string(otp.OTPType)),
 		)
-		return fmt.Errorf("create OTP for %s: %w", otp.Email, err)
+		return fmt.Errorf("create OTP for %s: %w", utils.MaskEmail(otp.Email), err)
 	}
 
 	return nil
@@ -92,10 +96,10 @@ func (r *otpRepository) FindValidOTP(ctx context.Context, email, otpCode, otpTyp
 	if err != nil {
 		r.log.Error("Failed to find valid OTP",
 			zap.Error(err),
-			zap.String("email", email),
+			utils.EmailField(email),
 			zap.String("otp_type", otpType),
 		)
-		return nil, fmt.Errorf("find valid OTP for %s type %s: %w", email, otpType, err)
+		return nil, fmt.Errorf("find valid OTP for %s type %s: %w", utils.MaskEmail(email), otpType, err)
 	}
 
 	return &otp, nil
@@ -123,3 +127,22 @@ func (r *otpRepository) MarkAsUsed(ctx context.Context, otpID uuid.UUID) error {
 
 	return nil
 }
+
+func (r *otpRepository) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM otps
+		WHERE id IN (
+			SELECT id FROM otps
+			WHERE expires_at < $1
+			LIMIT $2
+		)
+	`
+
+	result, err := r.db.Exec(ctx, query, before, limit)
+	if err != nil {
+		r.log.Error("Failed to delete expired OTPs", zap.Error(err))
+		return 0, fmt.Errorf("delete expired OTPs: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type OutboxRepository interface {
+	Create(ctx context.Context, event *entity.OutboxEvent) error
+	// FindPendingForUpdate locks rows (SKIP LOCKED), harus dipanggil di dalam WithTx
+	FindPendingForUpdate(ctx context.Context, maxAttempts, limit int) ([]*entity.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, errMsg string) error
+}
+
+type outboxRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewOutboxRepository(db database.PgxIface, log *zap.Logger) OutboxRepository {
+	return &outboxRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "outbox")),
+	}
+}
+
+func (r *outboxRepository) Create(ctx context.Context, event *entity.OutboxEvent) error {
+	query := `
+		INSERT INTO events_outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		event.ID,
+		event.AggregateType,
+		event.AggregateID,
+		event.EventType,
+		event.Payload,
+		event.CreatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to create outbox event",
+			zap.Error(err),
+			zap.String("event_type", event.EventType),
+			zap.String("aggregate_id", event.AggregateID.String()),
+		)
+		return fmt.Errorf("create outbox event %s: %w", event.EventType, err)
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) FindPendingForUpdate(ctx context.Context, maxAttempts, limit int) ([]*entity.OutboxEvent, error) {
+	query := `
+		SELECT id, aggregate_type, aggregate_id, event_type, payload, attempts, last_error, published_at, created_at
+		FROM events_outbox
+		WHERE published_at IS NULL AND attempts < $1
+		ORDER BY created_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := r.db.Query(ctx, query, maxAttempts, limit)
+	if err != nil {
+		r.log.Error("Failed to find pending outbox events", zap.Error(err))
+		return nil, fmt.Errorf("find pending outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*entity.OutboxEvent
+	for rows.Next() {
+		var event entity.OutboxEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.AggregateType,
+			&event.AggregateID,
+			&event.EventType,
+			&event.Payload,
+			&event.Attempts,
+			&event.LastError,
+			&event.PublishedAt,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan outbox event row", zap.Error(err))
+			return nil, fmt.Errorf("scan outbox event row: %w", err)
+		}
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate outbox events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *outboxRepository) MarkPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	query := `UPDATE events_outbox SET published_at = $2, attempts = attempts + 1, last_error = NULL WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id, publishedAt)
+	if err != nil {
+		r.log.Error("Failed to mark outbox event published",
+			zap.Error(err),
+			zap.String("event_id", id.String()),
+		)
+		return fmt.Errorf("mark outbox event %s published: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("outbox event %s not found", id.String())
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, errMsg string) error {
+	query := `UPDATE events_outbox SET attempts = attempts + 1, last_error = $2 WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id, errMsg)
+	if err != nil {
+		r.log.Error("Failed to mark outbox event failed",
+			zap.Error(err),
+			zap.String("event_id", id.String()),
+		)
+		return fmt.Errorf("mark outbox event %s failed: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("outbox event %s not found", id.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PaymentHistoryRepository riwayat perpindahan status payment, append-only
+type PaymentHistoryRepository interface {
+	Create(ctx context.Context, change *entity.PaymentStatusChange) error
+	// FindByPaymentID returns riwayat status payment, terlama dulu
+	FindByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*entity.PaymentStatusChange, error)
+}
+
+type paymentHistoryRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewPaymentHistoryRepository(db database.PgxIface, log *zap.Logger) PaymentHistoryRepository {
+	return &paymentHistoryRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "payment_history")),
+	}
+}
+
+func (r *paymentHistoryRepository) Create(ctx context.Context, change *entity.PaymentStatusChange) error {
+	query := `
+		INSERT INTO payment_status_history (id, payment_id, from_status, to_status, actor_id, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		change.ID,
+		change.PaymentID,
+		change.FromStatus,
+		change.ToStatus,
+		change.ActorID,
+		change.Reason,
+		change.CreatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to record payment status change",
+			zap.Error(err),
+			zap.String("payment_id", change.PaymentID.String()),
+			zap.String("to_status", string(change.ToStatus)),
+		)
+		return fmt.Errorf("record status change for payment %s: %w", change.PaymentID.String(), err)
+	}
+
+	return nil
+}
+
+func (r *paymentHistoryRepository) FindByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*entity.PaymentStatusChange, error) {
+	query := `
+		SELECT id, payment_id, from_status, to_status, actor_id, reason, created_at
+		FROM payment_status_history
+		WHERE payment_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, paymentID)
+	if err != nil {
+		r.log.Error("Failed to find payment status history",
+			zap.Error(err),
+			zap.String("payment_id", paymentID.String()),
+		)
+		return nil, fmt.Errorf("find status history for payment %s: %w", paymentID.String(), err)
+	}
+	defer rows.Close()
+
+	history := []*entity.PaymentStatusChange{}
+	for rows.Next() {
+		var change entity.PaymentStatusChange
+		err := rows.Scan(
+			&change.ID,
+			&change.PaymentID,
+			&change.FromStatus,
+			&change.ToStatus,
+			&change.ActorID,
+			&change.Reason,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan payment status history row", zap.Error(err))
+			return nil, fmt.Errorf("scan payment status history row: %w", err)
+		}
+		history = append(history, &change)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate payment status history rows: %w", err)
+	}
+
+	return history, nil
+}
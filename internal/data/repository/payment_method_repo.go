@@ -15,7 +15,10 @@ import (
 type PaymentMethodRepository interface {
 	Create(ctx context.Context, paymentMethod *entity.PaymentMethod) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.PaymentMethod, error)
+	FindByName(ctx context.Context, name string) (*entity.PaymentMethod, error)
 	FindAllActive(ctx context.Context) ([]*entity.PaymentMethod, error)
+	// FindAll includes inactive methods, untuk admin
+	FindAll(ctx context.Context) ([]*entity.PaymentMethod, error)
 	Update(ctx context.Context, paymentMethod *entity.PaymentMethod) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -34,14 +37,20 @@ func NewPaymentMethodRepository(db database.PgxIface, log *zap.Logger) PaymentMe
 
 func (r *paymentMethodRepository) Create(ctx context.Context, paymentMethod *entity.PaymentMethod) error {
 	query := `
-		INSERT INTO payment_methods (id, name, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO payment_methods (id, name, payment_type, is_active, logo_url, instructions,
+		                             convenience_fee, fee_percent, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
 	_, err := r.db.Exec(ctx, query,
 		paymentMethod.ID,
 		paymentMethod.Name,
+		paymentMethod.Type,
 		paymentMethod.IsActive,
+		paymentMethod.LogoURL,
+		paymentMethod.Instructions,
+		paymentMethod.ConvenienceFee,
+		paymentMethod.FeePercent,
 		paymentMethod.CreatedAt,
 		paymentMethod.UpdatedAt,
 	)
@@ -59,7 +68,8 @@ func (r *paymentMethodRepository) Create(ctx context.Context, paymentMethod *ent
 
 func (r *paymentMethodRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PaymentMethod, error) {
 	query := `
-		SELECT id, name, is_active, created_at, updated_at, deleted_at
+		SELECT id, name, payment_type, is_active, logo_url, instructions, convenience_fee, fee_percent,
+		       created_at, updated_at, deleted_at
 		FROM payment_methods
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -68,7 +78,12 @@ func (r *paymentMethodRepository) FindByID(ctx context.Context, id uuid.UUID) (*
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&paymentMethod.ID,
 		&paymentMethod.Name,
+		&paymentMethod.Type,
 		&paymentMethod.IsActive,
+		&paymentMethod.LogoURL,
+		&paymentMethod.Instructions,
+		&paymentMethod.ConvenienceFee,
+		&paymentMethod.FeePercent,
 		&paymentMethod.CreatedAt,
 		&paymentMethod.UpdatedAt,
 		&paymentMethod.DeletedAt,
@@ -88,9 +103,48 @@ func (r *paymentMethodRepository) FindByID(ctx context.Context, id uuid.UUID) (*
 	return &paymentMethod, nil
 }
 
+// FindByName matches case-insensitive, sama dengan unique index nama
+func (r *paymentMethodRepository) FindByName(ctx context.Context, name string) (*entity.PaymentMethod, error) {
+	query := `
+		SELECT id, name, payment_type, is_active, logo_url, instructions, convenience_fee, fee_percent,
+		       created_at, updated_at, deleted_at
+		FROM payment_methods
+		WHERE LOWER(name) = LOWER($1) AND deleted_at IS NULL
+	`
+
+	var paymentMethod entity.PaymentMethod
+	err := r.db.QueryRow(ctx, query, name).Scan(
+		&paymentMethod.ID,
+		&paymentMethod.Name,
+		&paymentMethod.Type,
+		&paymentMethod.IsActive,
+		&paymentMethod.LogoURL,
+		&paymentMethod.Instructions,
+		&paymentMethod.ConvenienceFee,
+		&paymentMethod.FeePercent,
+		&paymentMethod.CreatedAt,
+		&paymentMethod.UpdatedAt,
+		&paymentMethod.DeletedAt,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find payment method by name",
+			zap.Error(err),
+			zap.String("name", name),
+		)
+		return nil, fmt.Errorf("find payment method by name %s: %w", name, err)
+	}
+
+	return &paymentMethod, nil
+}
+
 func (r *paymentMethodRepository) FindAllActive(ctx context.Context) ([]*entity.PaymentMethod, error) {
 	query := `
-		SELECT id, name, is_active, created_at, updated_at
+		SELECT id, name, payment_type, is_active, logo_url, instructions, convenience_fee, fee_percent,
+		       created_at, updated_at
 		FROM payment_methods
 		WHERE is_active = true AND deleted_at IS NULL
 		ORDER BY name
@@ -103,37 +157,45 @@ func (r *paymentMethodRepository) FindAllActive(ctx context.Context) ([]*entity.
 	}
 	defer rows.Close()
 
-	var paymentMethods []*entity.PaymentMethod
-	for rows.Next() {
-		var pm entity.PaymentMethod
-		err := rows.Scan(
-			&pm.ID,
-			&pm.Name,
-			&pm.IsActive,
-			&pm.CreatedAt,
-			&pm.UpdatedAt,
-		)
-		if err != nil {
-			r.log.Error("Failed to scan payment method row", zap.Error(err))
-			return nil, fmt.Errorf("scan payment method row: %w", err)
-		}
-		paymentMethods = append(paymentMethods, &pm)
+	return r.scanPaymentMethods(rows)
+}
+
+func (r *paymentMethodRepository) FindAll(ctx context.Context) ([]*entity.PaymentMethod, error) {
+	query := `
+		SELECT id, name, payment_type, is_active, logo_url, instructions, convenience_fee, fee_percent,
+		       created_at, updated_at
+		FROM payment_methods
+		WHERE deleted_at IS NULL
+		ORDER BY name
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find all payment methods", zap.Error(err))
+		return nil, fmt.Errorf("find all payment methods: %w", err)
 	}
+	defer rows.Close()
 
-	return paymentMethods, nil
+	return r.scanPaymentMethods(rows)
 }
 
 func (r *paymentMethodRepository) Update(ctx context.Context, paymentMethod *entity.PaymentMethod) error {
 	query := `
 		UPDATE payment_methods
-		SET name = $2, is_active = $3, updated_at = $4
+		SET name = $2, payment_type = $3, is_active = $4, logo_url = $5, instructions = $6,
+		    convenience_fee = $7, fee_percent = $8, updated_at = $9
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	result, err := r.db.Exec(ctx, query,
 		paymentMethod.ID,
 		paymentMethod.Name,
+		paymentMethod.Type,
 		paymentMethod.IsActive,
+		paymentMethod.LogoURL,
+		paymentMethod.Instructions,
+		paymentMethod.ConvenienceFee,
+		paymentMethod.FeePercent,
 		paymentMethod.UpdatedAt,
 	)
 
@@ -171,3 +233,34 @@ func (r *paymentMethodRepository) Delete(ctx context.Context, id uuid.UUID) erro
 	r.log.Info("Payment method deleted", zap.String("payment_method_id", id.String()))
 	return nil
 }
+
+func (r *paymentMethodRepository) scanPaymentMethods(rows pgx.Rows) ([]*entity.PaymentMethod, error) {
+	var paymentMethods []*entity.PaymentMethod
+	for rows.Next() {
+		var pm entity.PaymentMethod
+		err := rows.Scan(
+			&pm.ID,
+			&pm.Name,
+			&pm.Type,
+			&pm.IsActive,
+			&pm.LogoURL,
+			&pm.Instructions,
+			&pm.ConvenienceFee,
+			&pm.FeePercent,
+			&pm.CreatedAt,
+			&pm.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan payment method row", zap.Error(err))
+			return nil, fmt.Errorf("scan payment method row: %w", err)
+		}
+		paymentMethods = append(paymentMethods, &pm)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate payment method rows: %w", err)
+	}
+
+	return paymentMethods, nil
+}
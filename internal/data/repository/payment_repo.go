@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/pkg/database"
@@ -16,11 +17,18 @@ type PaymentRepository interface {
 	Create(ctx context.Context, payment *entity.Payment) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Payment, error)
 	FindByBookingID(ctx context.Context, bookingID uuid.UUID) (*entity.Payment, error)
+	// FindByUserID returns semua payment (termasuk percobaan gagal) dari booking milik user, untuk data export
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Payment, error)
 	Update(ctx context.Context, payment *entity.Payment) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) error
 
 	// Business queries
-	UpdateStatus(ctx context.Context, paymentID uuid.UUID, status entity.PaymentStatus, transactionID *string) error
+	UpdateStatus(ctx context.Context, payment *entity.Payment, status entity.PaymentStatus) error
+	// FindByIDForUpdate row-locks the payment, dipakai webhook dan expiry di dalam WithTx
+	FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.Payment, error)
+	// FindExpiredPendingForUpdate locks payment async yang lewat expires_at, skip yang sedang diproses
+	FindExpiredPendingForUpdate(ctx context.Context, now time.Time, limit int) ([]*entity.Payment, error)
 }
 
 type paymentRepository struct {
@@ -35,21 +43,31 @@ func NewPaymentRepository(db database.PgxIface, log *zap.Logger) PaymentReposito
 	}
 }
 
+const paymentColumns = `id, booking_id, payment_method_id, amount, currency, status, transaction_id,
+		payment_code, expires_at, paid_at, created_at, updated_at, gift_card_amount, version`
+
 func (r *paymentRepository) Create(ctx context.Context, payment *entity.Payment) error {
 	query := `
-		INSERT INTO payments (id, booking_id, payment_method_id, amount, status, transaction_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO payments (` + paymentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
 
+	payment.Version = 1
 	_, err := r.db.Exec(ctx, query,
 		payment.ID,
 		payment.BookingID,
 		payment.PaymentMethodID,
 		payment.Amount,
+		payment.Currency,
 		payment.Status,
 		payment.TransactionID,
+		payment.PaymentCode,
+		payment.ExpiresAt,
+		payment.PaidAt,
 		payment.CreatedAt,
 		payment.UpdatedAt,
+		payment.GiftCardAmount,
+		payment.Version,
 	)
 
 	if err != nil {
@@ -65,24 +83,24 @@ func (r *paymentRepository) Create(ctx context.Context, payment *entity.Payment)
 }
 
 func (r *paymentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Payment, error) {
+	return r.findByID(ctx, id, false)
+}
+
+func (r *paymentRepository) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.Payment, error) {
+	return r.findByID(ctx, id, true)
+}
+
+func (r *paymentRepository) findByID(ctx context.Context, id uuid.UUID, forUpdate bool) (*entity.Payment, error) {
 	query := `
-		SELECT id, booking_id, payment_method_id, amount, status, transaction_id, created_at, updated_at
+		SELECT ` + paymentColumns + `
 		FROM payments
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
 
-	var payment entity.Payment
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&payment.ID,
-		&payment.BookingID,
-		&payment.PaymentMethodID,
-		&payment.Amount,
-		&payment.Status,
-		&payment.TransactionID,
-		&payment.CreatedAt,
-		&payment.UpdatedAt,
-	)
-
+	payment, err := scanPayment(r.db.QueryRow(ctx, query, id))
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
@@ -94,30 +112,19 @@ func (r *paymentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity
 		return nil, fmt.Errorf("find payment by ID %s: %w", id.String(), err)
 	}
 
-	return &payment, nil
+	return payment, nil
 }
 
 func (r *paymentRepository) FindByBookingID(ctx context.Context, bookingID uuid.UUID) (*entity.Payment, error) {
 	query := `
-		SELECT id, booking_id, payment_method_id, amount, status, transaction_id, created_at, updated_at
+		SELECT ` + paymentColumns + `
 		FROM payments
-		WHERE booking_id = $1
+		WHERE booking_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at DESC
 		LIMIT 1
 	`
 
-	var payment entity.Payment
-	err := r.db.QueryRow(ctx, query, bookingID).Scan(
-		&payment.ID,
-		&payment.BookingID,
-		&payment.PaymentMethodID,
-		&payment.Amount,
-		&payment.Status,
-		&payment.TransactionID,
-		&payment.CreatedAt,
-		&payment.UpdatedAt,
-	)
-
+	payment, err := scanPayment(r.db.QueryRow(ctx, query, bookingID))
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
@@ -129,15 +136,54 @@ func (r *paymentRepository) FindByBookingID(ctx context.Context, bookingID uuid.
 		return nil, fmt.Errorf("find payment by booking ID %s: %w", bookingID.String(), err)
 	}
 
-	return &payment, nil
+	return payment, nil
+}
+
+func (r *paymentRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Payment, error) {
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payments
+		WHERE deleted_at IS NULL
+		  AND booking_id IN (SELECT id FROM bookings WHERE user_id = $1)
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to find payments by user ID",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find payments by user ID %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	var payments []*entity.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			r.log.Error("Failed to scan payment row", zap.Error(err))
+			return nil, fmt.Errorf("scan payment row: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return payments, nil
 }
 
+// Update is compare-and-swap on payment.Version, sama seperti bookingRepository.Update.
+// Status, transaction_id dan paid_at tidak ikut ditulis; itu bagian UpdateStatus.
 func (r *paymentRepository) Update(ctx context.Context, payment *entity.Payment) error {
 	query := `
 		UPDATE payments
-		SET booking_id = $2, payment_method_id = $3, amount = $4, 
-		    status = $5, transaction_id = $6, updated_at = $7
-		WHERE id = $1
+		SET booking_id = $2, payment_method_id = $3, amount = $4, currency = $5,
+		    payment_code = $6, expires_at = $7, updated_at = $8, version = version + 1
+		WHERE id = $1 AND version = $9 AND deleted_at IS NULL
 	`
 
 	result, err := r.db.Exec(ctx, query,
@@ -145,9 +191,11 @@ func (r *paymentRepository) Update(ctx context.Context, payment *entity.Payment)
 		payment.BookingID,
 		payment.PaymentMethodID,
 		payment.Amount,
-		payment.Status,
-		payment.TransactionID,
+		payment.Currency,
+		payment.PaymentCode,
+		payment.ExpiresAt,
 		payment.UpdatedAt,
+		payment.Version,
 	)
 
 	if err != nil {
@@ -159,14 +207,15 @@ func (r *paymentRepository) Update(ctx context.Context, payment *entity.Payment)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("payment %s not found", payment.ID.String())
+		return versionMiss(ctx, r.db, "payments", "payment", payment.ID)
 	}
 
+	payment.Version++
 	return nil
 }
 
 func (r *paymentRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM payments WHERE id = $1`
+	query := `UPDATE payments SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 
 	result, err := r.db.Exec(ctx, query, id)
 	if err != nil {
@@ -181,30 +230,111 @@ func (r *paymentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("payment %s not found", id.String())
 	}
 
-	r.log.Info("Payment deleted", zap.String("payment_id", id.String()))
+	r.log.Info("Payment soft deleted", zap.String("payment_id", id.String()))
+	return nil
+}
+
+func (r *paymentRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE payments SET deleted_at = NULL, updated_at = NOW(), version = version + 1 WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to restore payment",
+			zap.Error(err),
+			zap.String("payment_id", id.String()),
+		)
+		return fmt.Errorf("restore payment %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("payment %s not found or not deleted", id.String())
+	}
+
+	r.log.Info("Payment restored", zap.String("payment_id", id.String()))
 	return nil
 }
 
-func (r *paymentRepository) UpdateStatus(ctx context.Context, paymentID uuid.UUID, status entity.PaymentStatus, transactionID *string) error {
+// UpdateStatus writes status beserta transaction_id dan paid_at dari payment, compare-and-swap
+// pada payment.Version. Dipanggil lewat transitionPayment di usecase supaya riwayatnya tercatat.
+func (r *paymentRepository) UpdateStatus(ctx context.Context, payment *entity.Payment, status entity.PaymentStatus) error {
 	query := `
 		UPDATE payments
-		SET status = $2, transaction_id = $3, updated_at = NOW()
-		WHERE id = $1
+		SET status = $2, transaction_id = $3, paid_at = $4, updated_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $5 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.Exec(ctx, query, paymentID, status, transactionID)
+	result, err := r.db.Exec(ctx, query, payment.ID, status, payment.TransactionID, payment.PaidAt, payment.Version)
 	if err != nil {
 		r.log.Error("Failed to update payment status",
 			zap.Error(err),
-			zap.String("payment_id", paymentID.String()),
+			zap.String("payment_id", payment.ID.String()),
 			zap.String("status", string(status)),
 		)
-		return fmt.Errorf("update payment %s status to %s: %w", paymentID.String(), string(status), err)
+		return fmt.Errorf("update payment %s status to %s: %w", payment.ID.String(), string(status), err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("payment %s not found", paymentID.String())
+		return versionMiss(ctx, r.db, "payments", "payment", payment.ID)
 	}
 
+	payment.Version++
 	return nil
 }
+
+func (r *paymentRepository) FindExpiredPendingForUpdate(ctx context.Context, now time.Time, limit int) ([]*entity.Payment, error) {
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payments
+		WHERE status = 'pending' AND expires_at <= $1 AND deleted_at IS NULL
+		ORDER BY expires_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := r.db.Query(ctx, query, now, limit)
+	if err != nil {
+		r.log.Error("Failed to find expired pending payments", zap.Error(err))
+		return nil, fmt.Errorf("find expired pending payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*entity.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			r.log.Error("Failed to scan payment row", zap.Error(err))
+			return nil, fmt.Errorf("scan payment row: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate payment rows: %w", err)
+	}
+
+	return payments, nil
+}
+
+func scanPayment(row pgx.Row) (*entity.Payment, error) {
+	var payment entity.Payment
+	err := row.Scan(
+		&payment.ID,
+		&payment.BookingID,
+		&payment.PaymentMethodID,
+		&payment.Amount,
+		&payment.Currency,
+		&payment.Status,
+		&payment.TransactionID,
+		&payment.PaymentCode,
+		&payment.ExpiresAt,
+		&payment.PaidAt,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+		&payment.GiftCardAmount,
+		&payment.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type PricePromotionRepository interface {
+	Create(ctx context.Context, promotion *entity.PricePromotion) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.PricePromotion, error)
+	// FindAll includes promo nonaktif dan yang sudah lewat, untuk admin
+	FindAll(ctx context.Context) ([]*entity.PricePromotion, error)
+	// FindActive dipakai saat menghitung harga; pencocokan jam/hari dilakukan di pricing rules.
+	// Promo yang valid_until-nya lewat lebih dari sehari (toleransi zona waktu cinema) tidak ikut.
+	FindActive(ctx context.Context) ([]*entity.PricePromotion, error)
+	Update(ctx context.Context, promotion *entity.PricePromotion) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+const pricePromotionColumns = `id, name, discount_percent, weekdays, start_time, end_time, valid_from, valid_until,
+		cinema_id, tiers, is_active, created_at, updated_at`
+
+type pricePromotionRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewPricePromotionRepository(db database.PgxIface, log *zap.Logger) PricePromotionRepository {
+	return &pricePromotionRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "price_promotion")),
+	}
+}
+
+func (r *pricePromotionRepository) Create(ctx context.Context, promotion *entity.PricePromotion) error {
+	query := `
+		INSERT INTO price_promotions (id, name, discount_percent, weekdays, start_time, end_time, valid_from,
+		                              valid_until, cinema_id, tiers, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		promotion.ID,
+		promotion.Name,
+		promotion.DiscountPercent,
+		promotion.Weekdays,
+		promotion.StartTime,
+		promotion.EndTime,
+		promotion.ValidFrom,
+		promotion.ValidUntil,
+		promotion.CinemaID,
+		tierNames(promotion.Tiers),
+		promotion.IsActive,
+		promotion.CreatedAt,
+		promotion.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to create price promotion",
+			zap.Error(err),
+			zap.String("name", promotion.Name),
+		)
+		return fmt.Errorf("create price promotion %s: %w", promotion.Name, err)
+	}
+
+	return nil
+}
+
+func (r *pricePromotionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PricePromotion, error) {
+	query := `
+		SELECT ` + pricePromotionColumns + `
+		FROM price_promotions
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	promotion, err := scanPricePromotion(r.db.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find price promotion by ID",
+			zap.Error(err),
+			zap.String("promotion_id", id.String()),
+		)
+		return nil, fmt.Errorf("find price promotion by ID %s: %w", id.String(), err)
+	}
+
+	return promotion, nil
+}
+
+func (r *pricePromotionRepository) FindAll(ctx context.Context) ([]*entity.PricePromotion, error) {
+	query := `
+		SELECT ` + pricePromotionColumns + `
+		FROM price_promotions
+		WHERE deleted_at IS NULL
+		ORDER BY created_at DESC, id
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find price promotions", zap.Error(err))
+		return nil, fmt.Errorf("find price promotions: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanPricePromotions(rows)
+}
+
+func (r *pricePromotionRepository) FindActive(ctx context.Context) ([]*entity.PricePromotion, error) {
+	query := `
+		SELECT ` + pricePromotionColumns + `
+		FROM price_promotions
+		WHERE is_active = true AND deleted_at IS NULL
+		  AND (valid_until IS NULL OR valid_until >= CURRENT_DATE - 1)
+		ORDER BY id
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find active price promotions", zap.Error(err))
+		return nil, fmt.Errorf("find active price promotions: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanPricePromotions(rows)
+}
+
+func (r *pricePromotionRepository) Update(ctx context.Context, promotion *entity.PricePromotion) error {
+	query := `
+		UPDATE price_promotions
+		SET name = $2, discount_percent = $3, weekdays = $4, start_time = $5, end_time = $6, valid_from = $7,
+		    valid_until = $8, cinema_id = $9, tiers = $10, is_active = $11, updated_at = $12
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		promotion.ID,
+		promotion.Name,
+		promotion.DiscountPercent,
+		promotion.Weekdays,
+		promotion.StartTime,
+		promotion.EndTime,
+		promotion.ValidFrom,
+		promotion.ValidUntil,
+		promotion.CinemaID,
+		tierNames(promotion.Tiers),
+		promotion.IsActive,
+		promotion.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to update price promotion",
+			zap.Error(err),
+			zap.String("promotion_id", promotion.ID.String()),
+		)
+		return fmt.Errorf("update price promotion %s: %w", promotion.ID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("price promotion %s not found", promotion.ID.String())
+	}
+
+	return nil
+}
+
+func (r *pricePromotionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE price_promotions SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to delete price promotion",
+			zap.Error(err),
+			zap.String("promotion_id", id.String()),
+		)
+		return fmt.Errorf("delete price promotion %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("price promotion %s not found", id.String())
+	}
+
+	r.log.Info("Price promotion deleted", zap.String("promotion_id", id.String()))
+	return nil
+}
+
+func scanPricePromotion(row pgx.Row) (*entity.PricePromotion, error) {
+	var promotion entity.PricePromotion
+	var tiers []string
+	err := row.Scan(
+		&promotion.ID,
+		&promotion.Name,
+		&promotion.DiscountPercent,
+		&promotion.Weekdays,
+		&promotion.StartTime,
+		&promotion.EndTime,
+		&promotion.ValidFrom,
+		&promotion.ValidUntil,
+		&promotion.CinemaID,
+		&tiers,
+		&promotion.IsActive,
+		&promotion.CreatedAt,
+		&promotion.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	promotion.Tiers = make([]entity.UserTier, len(tiers))
+	for i, tier := range tiers {
+		promotion.Tiers[i] = entity.UserTier(tier)
+	}
+
+	return &promotion, nil
+}
+
+// tierNames parameter text[] untuk kolom tiers; nil jadi array kosong karena kolomnya NOT NULL
+func tierNames(tiers []entity.UserTier) []string {
+	names := make([]string, len(tiers))
+	for i, tier := range tiers {
+		names[i] = string(tier)
+	}
+	return names
+}
+
+func (r *pricePromotionRepository) scanPricePromotions(rows pgx.Rows) ([]*entity.PricePromotion, error) {
+	promotions := []*entity.PricePromotion{}
+	for rows.Next() {
+		promotion, err := scanPricePromotion(rows)
+		if err != nil {
+			r.log.Error("Failed to scan price promotion row", zap.Error(err))
+			return nil, fmt.Errorf("scan price promotion row: %w", err)
+		}
+		promotions = append(promotions, promotion)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate price promotion rows: %w", err)
+	}
+
+	return promotions, nil
+}
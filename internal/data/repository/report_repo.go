@@ -0,0 +1,582 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type ReportGroupBy string
+
+const (
+	ReportGroupByDay    ReportGroupBy = "day"
+	ReportGroupByCinema ReportGroupBy = "cinema"
+	ReportGroupByMovie  ReportGroupBy = "movie"
+)
+
+type ReportRepository interface {
+	// organizationID membatasi report ke cinema milik chain; nil = seluruh platform
+	GetSales(ctx context.Context, from, to time.Time, groupBy ReportGroupBy, organizationID *uuid.UUID) ([]*entity.SalesReportRow, error)
+	GetDailySummary(ctx context.Context, date time.Time, organizationID *uuid.UUID) (*entity.SalesSummary, error)
+	GetScheduleOccupancy(ctx context.Context, cinemaID uuid.UUID, date time.Time) ([]*entity.ScheduleOccupancyRow, error)
+	// GetFunnel counts funnel events per movie dan step dengan created_at di [from, to)
+	GetFunnel(ctx context.Context, from, to time.Time, organizationID *uuid.UUID) ([]*entity.FunnelStepCount, error)
+
+	// Exports
+	FindBookingsForExport(ctx context.Context, filter BookingExportFilter, limit int) ([]*entity.BookingExportRow, error)
+
+	// Payment reconciliation
+	FindPayments(ctx context.Context, filter PaymentReportFilter, limit, offset int) ([]*entity.PaymentReportRow, error)
+	CountPayments(ctx context.Context, filter PaymentReportFilter) (int64, error)
+	GetPaymentTotals(ctx context.Context, filter PaymentReportFilter) ([]*entity.PaymentTotalRow, error)
+	// FindPaymentsForReconciliation returns payments in the filter range plus any payment whose
+	// transaction_id appears in transactionIDs, so settlement rows outside the range still match
+	FindPaymentsForReconciliation(ctx context.Context, filter PaymentReportFilter, transactionIDs []string) ([]*entity.PaymentReportRow, error)
+}
+
+// BookingExportFilter filters bookings by creation date; AfterCreatedAt/AfterID is the keyset cursor of the previous batch
+type BookingExportFilter struct {
+	StartDate      time.Time
+	EndDate        time.Time
+	Status         *entity.BookingStatus
+	AfterCreatedAt *time.Time
+	AfterID        *uuid.UUID
+	OrganizationID *uuid.UUID
+}
+
+// PaymentReportFilter filters payments by creation date; nil field = tidak difilter
+type PaymentReportFilter struct {
+	StartDate       time.Time
+	EndDate         time.Time
+	Status          *entity.PaymentStatus
+	PaymentMethodID *uuid.UUID
+}
+
+// args returns $1..$4 for paymentReportWhere
+func (f PaymentReportFilter) args() []any {
+	var status *string
+	if f.Status != nil {
+		value := string(*f.Status)
+		status = &value
+	}
+	return []any{f.StartDate, f.EndDate, status, f.PaymentMethodID}
+}
+
+type reportRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewReportRepository(db database.PgxIface, log *zap.Logger) ReportRepository {
+	return &reportRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "report")),
+	}
+}
+
+// scheduleSalesCTE aggregates confirmed bookings per schedule so occupancy uses the hall capacity once per show.
+// Revenue diambil dari ledger (akun penjualan per booking), jadi booking yang di-refund otomatis bernilai nol.
+// $3 is the optional organization filter, $4 the sales accounts.
+const scheduleSalesCTE = `
+	WITH booking_revenue AS (
+		SELECT t.booking_id, SUM(e.credit - e.debit) AS revenue
+		FROM ledger_transactions t
+		INNER JOIN ledger_entries e ON e.transaction_id = t.id
+		WHERE e.account = ANY($4::text[])
+		  AND t.booking_id IN (
+			SELECT rb.id FROM bookings rb
+			INNER JOIN schedules rs ON rs.id = rb.schedule_id
+			WHERE rs.show_date BETWEEN $1 AND $2)
+		GROUP BY t.booking_id
+	), schedule_sales AS (
+		SELECT s.id AS schedule_id,
+		       s.show_date,
+		       s.movie_id,
+		       h.cinema_id,
+		       h.total_seats AS capacity,
+		       COALESCE(SUM(br.revenue), 0) AS revenue,
+		       COALESCE(SUM(b.total_seats) FILTER (WHERE b.status IN ('confirmed', 'checked_in')), 0) AS tickets_sold,
+		       COUNT(b.id) FILTER (WHERE b.status IN ('confirmed', 'checked_in')) AS bookings
+		FROM schedules s
+		INNER JOIN halls h ON h.id = s.hall_id
+		LEFT JOIN bookings b ON b.schedule_id = s.id AND b.deleted_at IS NULL
+		LEFT JOIN booking_revenue br ON br.booking_id = b.id
+		WHERE s.show_date BETWEEN $1 AND $2 AND s.status = 'published' AND s.deleted_at IS NULL
+		  AND ($3::uuid IS NULL OR h.cinema_id IN (SELECT id FROM cinemas WHERE organization_id = $3::uuid))
+		GROUP BY s.id, s.show_date, s.movie_id, h.cinema_id, h.total_seats
+	)
+`
+
+// ledgerSalesAccounts parameter text[] untuk akun penjualan di query report
+func ledgerSalesAccounts() []string {
+	accounts := make([]string, len(entity.LedgerSalesAccounts))
+	for i, account := range entity.LedgerSalesAccounts {
+		accounts[i] = string(account)
+	}
+	return accounts
+}
+
+// GetSales returns revenue, tickets and capacity grouped by show day, cinema, or movie
+func (r *reportRepository) GetSales(ctx context.Context, from, to time.Time, groupBy ReportGroupBy, organizationID *uuid.UUID) ([]*entity.SalesReportRow, error) {
+	var selectClause, joinClause, groupClause, orderClause string
+
+	switch groupBy {
+	case ReportGroupByDay:
+		selectClause = `to_char(ss.show_date, 'YYYY-MM-DD') AS group_key, to_char(ss.show_date, 'YYYY-MM-DD') AS label`
+		groupClause = `ss.show_date`
+		orderClause = `ss.show_date`
+	case ReportGroupByCinema:
+		selectClause = `c.id::text AS group_key, c.name AS label`
+		joinClause = `INNER JOIN cinemas c ON c.id = ss.cinema_id`
+		groupClause = `c.id, c.name`
+		orderClause = `revenue DESC, c.name`
+	case ReportGroupByMovie:
+		selectClause = `m.id::text AS group_key, m.title AS label`
+		joinClause = `INNER JOIN movies m ON m.id = ss.movie_id`
+		groupClause = `m.id, m.title`
+		orderClause = `revenue DESC, m.title`
+	default:
+		return nil, fmt.Errorf("invalid report group_by %s", groupBy)
+	}
+
+	query := scheduleSalesCTE + fmt.Sprintf(`
+		SELECT %s,
+		       COALESCE(SUM(ss.revenue), 0) AS revenue,
+		       COALESCE(SUM(ss.tickets_sold), 0) AS tickets_sold,
+		       COALESCE(SUM(ss.bookings), 0) AS bookings,
+		       COALESCE(SUM(ss.capacity), 0) AS capacity
+		FROM schedule_sales ss
+		%s
+		GROUP BY %s
+		ORDER BY %s
+	`, selectClause, joinClause, groupClause, orderClause)
+
+	rows, err := r.db.Query(ctx, query, from, to, organizationID, ledgerSalesAccounts())
+	if err != nil {
+		r.log.Error("Failed to get sales report",
+			zap.Error(err),
+			zap.Time("from", from),
+			zap.Time("to", to),
+			zap.String("group_by", string(groupBy)),
+		)
+		return nil, fmt.Errorf("get sales report by %s: %w", groupBy, err)
+	}
+	defer rows.Close()
+
+	var result []*entity.SalesReportRow
+	for rows.Next() {
+		var row entity.SalesReportRow
+		err := rows.Scan(
+			&row.GroupKey,
+			&row.Label,
+			&row.Revenue,
+			&row.TicketsSold,
+			&row.Bookings,
+			&row.Capacity,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan sales report row", zap.Error(err))
+			return nil, fmt.Errorf("scan sales report row: %w", err)
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate sales report rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetDailySummary returns KPIs for the given day: money collected, bookings made, and today's show occupancy
+func (r *reportRepository) GetDailySummary(ctx context.Context, date time.Time, organizationID *uuid.UUID) (*entity.SalesSummary, error) {
+	bookingInOrg := organizationScheduleSQL("b.schedule_id", 2)
+	scheduleInOrg := organizationScheduleSQL("s.id", 2)
+
+	// Revenue = penjualan bersih yang diposting ke ledger hari itu: pembayaran dikurangi refund.
+	// Porsi gift card ikut dihitung karena uangnya diterima saat kartu dijual dan diakui saat dipakai.
+	query := `
+		SELECT
+			(SELECT COALESCE(SUM(e.credit - e.debit), 0)
+			   FROM ledger_transactions t
+			   INNER JOIN ledger_entries e ON e.transaction_id = t.id
+			   INNER JOIN bookings b ON b.id = t.booking_id
+			  WHERE e.account = ANY($3::text[]) AND t.occurred_at::date = $1::date
+			    AND ` + bookingInOrg + `) AS revenue,
+			(SELECT COUNT(*)
+			   FROM bookings b
+			  WHERE b.deleted_at IS NULL AND b.created_at::date = $1::date AND ` + bookingInOrg + `) AS bookings_created,
+			(SELECT COALESCE(SUM(b.total_seats), 0)
+			   FROM bookings b
+			  WHERE b.status IN ('confirmed', 'checked_in') AND b.deleted_at IS NULL AND b.created_at::date = $1::date
+			    AND ` + bookingInOrg + `) AS tickets_sold,
+			(SELECT COUNT(*)
+			   FROM bookings b
+			  WHERE b.status = 'pending' AND b.deleted_at IS NULL AND ` + bookingInOrg + `) AS pending_bookings,
+			(SELECT COUNT(*)
+			   FROM schedules s
+			  WHERE s.show_date = $1::date AND s.status = 'published' AND s.deleted_at IS NULL
+			    AND ` + scheduleInOrg + `) AS shows_today,
+			(SELECT COALESCE(SUM(b.total_seats), 0)
+			   FROM bookings b
+			   INNER JOIN schedules s ON s.id = b.schedule_id
+			  WHERE b.status IN ('confirmed', 'checked_in') AND b.deleted_at IS NULL AND s.show_date = $1::date AND s.deleted_at IS NULL
+			    AND ` + scheduleInOrg + `) AS seats_sold_today,
+			(SELECT COALESCE(SUM(h.total_seats), 0)
+			   FROM schedules s
+			   INNER JOIN halls h ON h.id = s.hall_id
+			  WHERE s.show_date = $1::date AND s.status = 'published' AND s.deleted_at IS NULL
+			    AND ` + scheduleInOrg + `) AS capacity_today
+	`
+
+	summary := entity.SalesSummary{Date: date}
+	err := r.db.QueryRow(ctx, query, date, organizationID, ledgerSalesAccounts()).Scan(
+		&summary.Revenue,
+		&summary.BookingsCreated,
+		&summary.TicketsSold,
+		&summary.PendingBookings,
+		&summary.ShowsToday,
+		&summary.SeatsSoldToday,
+		&summary.CapacityToday,
+	)
+	if err != nil {
+		r.log.Error("Failed to get daily sales summary",
+			zap.Error(err),
+			zap.Time("date", date),
+		)
+		return nil, fmt.Errorf("get daily sales summary %s: %w", date.Format("2006-01-02"), err)
+	}
+
+	return &summary, nil
+}
+
+// GetScheduleOccupancy returns sold and pending seats per schedule of a cinema on the given show date
+func (r *reportRepository) GetScheduleOccupancy(ctx context.Context, cinemaID uuid.UUID, date time.Time) ([]*entity.ScheduleOccupancyRow, error) {
+	query := `
+		SELECT s.id, s.movie_id, m.title, h.id, h.hall_number, s.show_date, s.show_time, h.total_seats,
+		       COUNT(bs.id) FILTER (WHERE b.status IN ('confirmed', 'checked_in')) AS sold_seats,
+		       COUNT(bs.id) FILTER (WHERE b.status = 'pending') AS pending_seats
+		FROM schedules s
+		INNER JOIN halls h ON h.id = s.hall_id
+		INNER JOIN movies m ON m.id = s.movie_id
+		LEFT JOIN bookings b ON b.schedule_id = s.id AND b.deleted_at IS NULL
+		LEFT JOIN booking_seats bs ON bs.booking_id = b.id
+		WHERE h.cinema_id = $1 AND s.show_date = $2::date AND s.status = 'published' AND s.deleted_at IS NULL
+		GROUP BY s.id, s.movie_id, m.title, h.id, h.hall_number, s.show_date, s.show_time, h.total_seats
+		ORDER BY h.hall_number, s.show_time
+	`
+
+	rows, err := r.db.Query(ctx, query, cinemaID, date)
+	if err != nil {
+		r.log.Error("Failed to get schedule occupancy",
+			zap.Error(err),
+			zap.String("cinema_id", cinemaID.String()),
+			zap.Time("date", date),
+		)
+		return nil, fmt.Errorf("get schedule occupancy for cinema %s: %w", cinemaID.String(), err)
+	}
+	defer rows.Close()
+
+	var result []*entity.ScheduleOccupancyRow
+	for rows.Next() {
+		var row entity.ScheduleOccupancyRow
+		err := rows.Scan(
+			&row.ScheduleID,
+			&row.MovieID,
+			&row.MovieTitle,
+			&row.HallID,
+			&row.HallNumber,
+			&row.ShowDate,
+			&row.ShowTime,
+			&row.Capacity,
+			&row.SoldSeats,
+			&row.PendingSeats,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan schedule occupancy row", zap.Error(err))
+			return nil, fmt.Errorf("scan schedule occupancy row: %w", err)
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate schedule occupancy rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// FindBookingsForExport returns one batch of bookings ordered by (created_at, id), starting after the filter cursor
+func (r *reportRepository) FindBookingsForExport(ctx context.Context, filter BookingExportFilter, limit int) ([]*entity.BookingExportRow, error) {
+	query := `
+		SELECT b.id, b.order_id, u.username, u.email, m.title, c.name, h.hall_number,
+		       s.show_date, s.show_time,
+		       COALESCE((SELECT string_agg(st.seat_number, ' ' ORDER BY st.seat_number)
+		                   FROM booking_seats bs
+		                   INNER JOIN seats st ON st.id = bs.seat_id
+		                  WHERE bs.booking_id = b.id AND NOT bs.is_blocked), '') AS seat_numbers,
+		       b.total_seats, b.total_price, b.currency, b.status, b.created_at
+		FROM bookings b
+		INNER JOIN users u ON u.id = b.user_id
+		INNER JOIN schedules s ON s.id = b.schedule_id
+		INNER JOIN halls h ON h.id = s.hall_id
+		INNER JOIN cinemas c ON c.id = h.cinema_id
+		INNER JOIN movies m ON m.id = s.movie_id
+		WHERE b.deleted_at IS NULL
+		  AND b.created_at::date BETWEEN $1 AND $2
+		  AND ($3::text IS NULL OR b.status = $3::text)
+		  AND ($4::timestamp IS NULL OR (b.created_at, b.id) > ($4::timestamp, $5::uuid))
+		  AND ($7::uuid IS NULL OR c.organization_id = $7::uuid)
+		ORDER BY b.created_at, b.id
+		LIMIT $6
+	`
+
+	var status *string
+	if filter.Status != nil {
+		value := string(*filter.Status)
+		status = &value
+	}
+
+	rows, err := r.db.Query(ctx, query,
+		filter.StartDate, filter.EndDate, status, filter.AfterCreatedAt, filter.AfterID, limit, filter.OrganizationID)
+	if err != nil {
+		r.log.Error("Failed to find bookings for export",
+			zap.Error(err),
+			zap.Time("start_date", filter.StartDate),
+			zap.Time("end_date", filter.EndDate),
+		)
+		return nil, fmt.Errorf("find bookings for export: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*entity.BookingExportRow
+	for rows.Next() {
+		var row entity.BookingExportRow
+		err := rows.Scan(
+			&row.ID,
+			&row.OrderID,
+			&row.Username,
+			&row.Email,
+			&row.MovieTitle,
+			&row.CinemaName,
+			&row.HallNumber,
+			&row.ShowDate,
+			&row.ShowTime,
+			&row.SeatNumbers,
+			&row.TotalSeats,
+			&row.TotalPrice,
+			&row.Currency,
+			&row.Status,
+			&row.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan booking export row", zap.Error(err))
+			return nil, fmt.Errorf("scan booking export row: %w", err)
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate booking export rows: %w", err)
+	}
+
+	return result, nil
+}
+
+const paymentReportSelect = `
+	SELECT p.id, p.booking_id, b.order_id, p.payment_method_id, pm.name, pm.payment_type,
+	       p.amount, p.currency, p.status, p.transaction_id, p.created_at, p.paid_at
+	FROM payments p
+	INNER JOIN bookings b ON b.id = p.booking_id
+	INNER JOIN payment_methods pm ON pm.id = p.payment_method_id
+`
+
+// paymentReportWhere uses PaymentReportFilter.args as $1..$4
+const paymentReportWhere = `
+	p.deleted_at IS NULL
+	AND p.created_at::date BETWEEN $1 AND $2
+	AND ($3::text IS NULL OR p.status = $3::text)
+	AND ($4::uuid IS NULL OR p.payment_method_id = $4::uuid)
+`
+
+// FindPayments returns one page of payments, newest first
+func (r *reportRepository) FindPayments(ctx context.Context, filter PaymentReportFilter, limit, offset int) ([]*entity.PaymentReportRow, error) {
+	query := paymentReportSelect + `WHERE` + paymentReportWhere + `
+		ORDER BY p.created_at DESC, p.id
+		LIMIT $5 OFFSET $6
+	`
+
+	rows, err := r.db.Query(ctx, query, append(filter.args(), limit, offset)...)
+	if err != nil {
+		r.log.Error("Failed to find payments for report",
+			zap.Error(err),
+			zap.Time("start_date", filter.StartDate),
+			zap.Time("end_date", filter.EndDate),
+		)
+		return nil, fmt.Errorf("find payments for report: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanPaymentReportRows(rows)
+}
+
+func (r *reportRepository) CountPayments(ctx context.Context, filter PaymentReportFilter) (int64, error) {
+	query := `SELECT COUNT(*) FROM payments p WHERE` + paymentReportWhere
+
+	var total int64
+	if err := r.db.QueryRow(ctx, query, filter.args()...).Scan(&total); err != nil {
+		r.log.Error("Failed to count payments for report", zap.Error(err))
+		return 0, fmt.Errorf("count payments for report: %w", err)
+	}
+
+	return total, nil
+}
+
+// GetPaymentTotals aggregates count and amount per status and payment method
+func (r *reportRepository) GetPaymentTotals(ctx context.Context, filter PaymentReportFilter) ([]*entity.PaymentTotalRow, error) {
+	query := `
+		SELECT p.status, p.payment_method_id, pm.name, COUNT(*) AS payments, COALESCE(SUM(p.amount), 0) AS amount
+		FROM payments p
+		INNER JOIN payment_methods pm ON pm.id = p.payment_method_id
+		WHERE` + paymentReportWhere + `
+		GROUP BY p.status, p.payment_method_id, pm.name
+		ORDER BY pm.name, p.status
+	`
+
+	rows, err := r.db.Query(ctx, query, filter.args()...)
+	if err != nil {
+		r.log.Error("Failed to get payment totals", zap.Error(err))
+		return nil, fmt.Errorf("get payment totals: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*entity.PaymentTotalRow
+	for rows.Next() {
+		var row entity.PaymentTotalRow
+		if err := rows.Scan(&row.Status, &row.PaymentMethodID, &row.PaymentMethodName, &row.Payments, &row.Amount); err != nil {
+			r.log.Error("Failed to scan payment total row", zap.Error(err))
+			return nil, fmt.Errorf("scan payment total row: %w", err)
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate payment total rows: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *reportRepository) FindPaymentsForReconciliation(ctx context.Context, filter PaymentReportFilter, transactionIDs []string) ([]*entity.PaymentReportRow, error) {
+	query := paymentReportSelect + `
+		WHERE (` + paymentReportWhere + `)
+		   OR (p.deleted_at IS NULL AND p.transaction_id = ANY($5::text[]))
+		ORDER BY p.created_at, p.id
+	`
+
+	rows, err := r.db.Query(ctx, query, append(filter.args(), transactionIDs)...)
+	if err != nil {
+		r.log.Error("Failed to find payments for reconciliation",
+			zap.Error(err),
+			zap.Time("start_date", filter.StartDate),
+			zap.Time("end_date", filter.EndDate),
+			zap.Int("transaction_ids", len(transactionIDs)),
+		)
+		return nil, fmt.Errorf("find payments for reconciliation: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanPaymentReportRows(rows)
+}
+
+func (r *reportRepository) scanPaymentReportRows(rows pgx.Rows) ([]*entity.PaymentReportRow, error) {
+	var result []*entity.PaymentReportRow
+	for rows.Next() {
+		var row entity.PaymentReportRow
+		err := rows.Scan(
+			&row.ID,
+			&row.BookingID,
+			&row.OrderID,
+			&row.PaymentMethodID,
+			&row.PaymentMethodName,
+			&row.PaymentMethodType,
+			&row.Amount,
+			&row.Currency,
+			&row.Status,
+			&row.TransactionID,
+			&row.CreatedAt,
+			&row.PaidAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan payment report row", zap.Error(err))
+			return nil, fmt.Errorf("scan payment report row: %w", err)
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate payment report rows: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *reportRepository) GetFunnel(ctx context.Context, from, to time.Time, organizationID *uuid.UUID) ([]*entity.FunnelStepCount, error) {
+	// Refresh seat map atau retry pembayaran tidak boleh menggelembungkan angka: hitung unik per booking / viewer
+	query := `
+		SELECT s.movie_id, COALESCE(m.title, '') AS movie_title, f.step,
+		       COUNT(DISTINCT CASE
+		           WHEN f.booking_id IS NOT NULL THEN f.booking_id::text
+		           WHEN f.user_id IS NOT NULL THEN f.user_id::text || ':' || f.schedule_id::text
+		           ELSE f.id::text
+		       END) AS count
+		FROM funnel_events f
+		INNER JOIN schedules s ON s.id = f.schedule_id
+		INNER JOIN halls h ON h.id = s.hall_id
+		LEFT JOIN movies m ON m.id = s.movie_id
+		WHERE f.created_at >= $1 AND f.created_at < $2
+		  AND ($3::uuid IS NULL OR h.cinema_id IN (SELECT id FROM cinemas WHERE organization_id = $3::uuid))
+		GROUP BY s.movie_id, m.title, f.step
+		ORDER BY m.title, s.movie_id
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, from, to, organizationID)
+	if err != nil {
+		r.log.Error("Failed to get funnel report",
+			zap.Error(err),
+			zap.Time("from", from),
+			zap.Time("to", to),
+		)
+		return nil, fmt.Errorf("get funnel report: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*entity.FunnelStepCount
+	for rows.Next() {
+		var row entity.FunnelStepCount
+		if err := rows.Scan(&row.MovieID, &row.MovieTitle, &row.Step, &row.Count); err != nil {
+			r.log.Error("Failed to scan funnel row", zap.Error(err))
+			return nil, fmt.Errorf("scan funnel row: %w", err)
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return result, nil
+}
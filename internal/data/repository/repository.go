@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"cinema-booking/pkg/database"
 
 	"go.uber.org/zap"
@@ -16,12 +18,45 @@ type Repository struct {
 	Cinema        CinemaRepository
 	Hall          HallRepository
 	Seat          SeatRepository
+	SeatBlock     SeatBlockRepository
 	Schedule      ScheduleRepository
 	PaymentMethod PaymentMethodRepository
 	Booking       BookingRepository
 	BookingSeat   BookingSeatRepository
 	Payment       PaymentRepository
 	Review        ReviewRepository
+	ReviewReply   ReviewReplyRepository
+	ReviewReport  ReviewReportRepository
+
+	NotificationSetting NotificationSettingRepository
+	UserDevice          UserDeviceRepository
+	Report              ReportRepository
+	Outbox              OutboxRepository
+	Waitlist            WaitlistRepository
+	SeatHold            SeatHoldRepository
+	Watchlist           WatchlistRepository
+	GroupBooking        GroupBookingRepository
+	PricePromotion      PricePromotionRepository
+	Organization        OrganizationRepository
+	Webhook             WebhookRepository
+	Banner              BannerRepository
+	GiftCard            GiftCardRepository
+	Funnel              FunnelRepository
+	Activity            ActivityRepository
+	DataExport          DataExportRepository
+	Audit               AuditRepository
+	BookingNote         BookingNoteRepository
+	Ledger              LedgerRepository
+	BookingHistory      BookingHistoryRepository
+	PaymentHistory      PaymentHistoryRepository
+	ReviewRevision      ReviewRevisionRepository
+	Setting             SettingRepository
+	FeatureFlag         FeatureFlagRepository
+	Job                 JobRepository
+	UserStats           UserStatsRepository
+
+	db  database.PgxIface
+	log *zap.Logger
 }
 
 func NewRepository(db database.PgxIface, log *zap.Logger) *Repository {
@@ -35,11 +70,57 @@ func NewRepository(db database.PgxIface, log *zap.Logger) *Repository {
 		Cinema:        NewCinemaRepository(db, log),
 		Hall:          NewHallRepository(db, log),
 		Seat:          NewSeatRepository(db, log),
+		SeatBlock:     NewSeatBlockRepository(db, log),
 		Schedule:      NewScheduleRepository(db, log),
 		PaymentMethod: NewPaymentMethodRepository(db, log),
 		Booking:       NewBookingRepository(db, log),
 		BookingSeat:   NewBookingSeatRepository(db, log),
 		Payment:       NewPaymentRepository(db, log),
 		Review:        NewReviewRepository(db, log),
+		ReviewReply:   NewReviewReplyRepository(db, log),
+		ReviewReport:  NewReviewReportRepository(db, log),
+
+		NotificationSetting: NewNotificationSettingRepository(db, log),
+		UserDevice:          NewUserDeviceRepository(db, log),
+		Report:              NewReportRepository(db, log),
+		Outbox:              NewOutboxRepository(db, log),
+		Waitlist:            NewWaitlistRepository(db, log),
+		SeatHold:            NewSeatHoldRepository(db, log),
+		Watchlist:           NewWatchlistRepository(db, log),
+		GroupBooking:        NewGroupBookingRepository(db, log),
+		PricePromotion:      NewPricePromotionRepository(db, log),
+		Organization:        NewOrganizationRepository(db, log),
+		Webhook:             NewWebhookRepository(db, log),
+		Banner:              NewBannerRepository(db, log),
+		GiftCard:            NewGiftCardRepository(db, log),
+		Funnel:              NewFunnelRepository(db, log),
+		Activity:            NewActivityRepository(db, log),
+		DataExport:          NewDataExportRepository(db, log),
+		Audit:               NewAuditRepository(db, log),
+		BookingNote:         NewBookingNoteRepository(db, log),
+		Ledger:              NewLedgerRepository(db, log),
+		BookingHistory:      NewBookingHistoryRepository(db, log),
+		PaymentHistory:      NewPaymentHistoryRepository(db, log),
+		ReviewRevision:      NewReviewRevisionRepository(db, log),
+		Setting:             NewSettingRepository(db, log),
+		FeatureFlag:         NewFeatureFlagRepository(db, log),
+		Job:                 NewJobRepository(db, log),
+		UserStats:           NewUserStatsRepository(db, log),
+
+		db:  db,
+		log: log,
+	}
+}
+
+// Ping checks koneksi ke primary database
+func (r *Repository) Ping(ctx context.Context) error {
+	return r.db.Ping(ctx)
+}
+
+// PoolStats returns statistik connection pool, nil kalau repository jalan di dalam transaction
+func (r *Repository) PoolStats() []database.PoolStats {
+	if reporter, ok := r.db.(database.StatsReporter); ok {
+		return reporter.PoolStats()
 	}
+	return nil
 }
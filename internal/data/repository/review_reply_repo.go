@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type ReviewReplyRepository interface {
+	Create(ctx context.Context, reply *entity.ReviewReply) error
+	FindByReviewID(ctx context.Context, reviewID uuid.UUID) (*entity.ReviewReply, error)
+	// FindByReviewIDs loads replies untuk satu halaman review sekaligus, key-nya review ID
+	FindByReviewIDs(ctx context.Context, reviewIDs []uuid.UUID) (map[uuid.UUID]*entity.ReviewReply, error)
+	Update(ctx context.Context, reply *entity.ReviewReply) error
+	DeleteByReviewID(ctx context.Context, reviewID uuid.UUID) error
+}
+
+type reviewReplyRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewReviewReplyRepository(db database.PgxIface, log *zap.Logger) ReviewReplyRepository {
+	return &reviewReplyRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "review_reply")),
+	}
+}
+
+// Create inserts reply; unique review_id menjamin satu reply per review walaupun dua staff submit bersamaan
+func (r *reviewReplyRepository) Create(ctx context.Context, reply *entity.ReviewReply) error {
+	query := `
+		INSERT INTO review_replies (id, review_id, author_id, message, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (review_id) DO NOTHING
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		reply.ID,
+		reply.ReviewID,
+		reply.AuthorID,
+		reply.Message,
+		reply.CreatedAt,
+		reply.UpdatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to create review reply",
+			zap.Error(err),
+			zap.String("review_id", reply.ReviewID.String()),
+		)
+		return fmt.Errorf("create reply for review %s: %w", reply.ReviewID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("review %s already has a reply", reply.ReviewID.String())
+	}
+
+	return nil
+}
+
+func (r *reviewReplyRepository) FindByReviewID(ctx context.Context, reviewID uuid.UUID) (*entity.ReviewReply, error) {
+	query := `
+		SELECT id, review_id, author_id, message, created_at, updated_at
+		FROM review_replies
+		WHERE review_id = $1
+	`
+
+	var reply entity.ReviewReply
+	err := r.db.QueryRow(ctx, query, reviewID).Scan(
+		&reply.ID,
+		&reply.ReviewID,
+		&reply.AuthorID,
+		&reply.Message,
+		&reply.CreatedAt,
+		&reply.UpdatedAt,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find review reply",
+			zap.Error(err),
+			zap.String("review_id", reviewID.String()),
+		)
+		return nil, fmt.Errorf("find reply for review %s: %w", reviewID.String(), err)
+	}
+
+	return &reply, nil
+}
+
+func (r *reviewReplyRepository) FindByReviewIDs(ctx context.Context, reviewIDs []uuid.UUID) (map[uuid.UUID]*entity.ReviewReply, error) {
+	replies := make(map[uuid.UUID]*entity.ReviewReply, len(reviewIDs))
+	if len(reviewIDs) == 0 {
+		return replies, nil
+	}
+
+	query := `
+		SELECT id, review_id, author_id, message, created_at, updated_at
+		FROM review_replies
+		WHERE review_id = ANY($1)
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, reviewIDs)
+	if err != nil {
+		r.log.Error("Failed to find review replies",
+			zap.Error(err),
+			zap.Int("review_count", len(reviewIDs)),
+		)
+		return nil, fmt.Errorf("find replies for %d reviews: %w", len(reviewIDs), err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reply entity.ReviewReply
+		err := rows.Scan(
+			&reply.ID,
+			&reply.ReviewID,
+			&reply.AuthorID,
+			&reply.Message,
+			&reply.CreatedAt,
+			&reply.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan review reply row", zap.Error(err))
+			return nil, fmt.Errorf("scan review reply row: %w", err)
+		}
+		replies[reply.ReviewID] = &reply
+	}
+
+	return replies, rows.Err()
+}
+
+func (r *reviewReplyRepository) Update(ctx context.Context, reply *entity.ReviewReply) error {
+	query := `
+		UPDATE review_replies
+		SET message = $2, author_id = $3, updated_at = $4
+		WHERE review_id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		reply.ReviewID,
+		reply.Message,
+		reply.AuthorID,
+		reply.UpdatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to update review reply",
+			zap.Error(err),
+			zap.String("review_id", reply.ReviewID.String()),
+		)
+		return fmt.Errorf("update reply for review %s: %w", reply.ReviewID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("reply for review %s not found", reply.ReviewID.String())
+	}
+
+	return nil
+}
+
+func (r *reviewReplyRepository) DeleteByReviewID(ctx context.Context, reviewID uuid.UUID) error {
+	query := `DELETE FROM review_replies WHERE review_id = $1`
+
+	result, err := r.db.Exec(ctx, query, reviewID)
+	if err != nil {
+		r.log.Error("Failed to delete review reply",
+			zap.Error(err),
+			zap.String("review_id", reviewID.String()),
+		)
+		return fmt.Errorf("delete reply for review %s: %w", reviewID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("reply for review %s not found", reviewID.String())
+	}
+
+	r.log.Info("Review reply deleted", zap.String("review_id", reviewID.String()))
+	return nil
+}
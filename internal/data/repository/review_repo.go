@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cinema-booking/internal/data/entity"
@@ -12,18 +13,60 @@ import (
 	"go.uber.org/zap"
 )
 
+// ReviewSort urutan listing review
+type ReviewSort string
+
+const (
+	ReviewSortNewest        ReviewSort = "newest"
+	ReviewSortHighestRating ReviewSort = "highest_rating"
+)
+
+// orderClause selalu diakhiri id supaya urutan stabil antar halaman
+func (s ReviewSort) orderClause() string {
+	switch s {
+	case ReviewSortHighestRating:
+		return "rating DESC, created_at DESC, id DESC"
+	default:
+		return "created_at DESC, id DESC"
+	}
+}
+
+// ErrAlreadyReviewed dikembalikan Create kalau user sudah punya review aktif untuk movie itu.
+// Dijaga unique index uq_reviews_user_movie_active, jadi tetap benar saat dua request balapan.
+var ErrAlreadyReviewed = errors.New("user already reviewed this movie")
+
+// reviewUniqueIndex partial unique index (user_id, movie_id) untuk review yang belum dihapus
+const reviewUniqueIndex = "uq_reviews_user_movie_active"
+
+// MovieRatingStats input reconciliation rating satu movie
+type MovieRatingStats struct {
+	MovieID       uuid.UUID
+	CurrentRating float64
+	AverageRating float64
+	ReviewCount   int64
+}
+
 type ReviewRepository interface {
 	Create(ctx context.Context, review *entity.Review) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Review, error)
-	FindByMovieID(ctx context.Context, movieID uuid.UUID, limit, offset int) ([]*entity.Review, error)
-	FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.Review, error)
+	FindByMovieID(ctx context.Context, movieID uuid.UUID, sort ReviewSort, limit, offset int) ([]*entity.Review, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID, sort ReviewSort, limit, offset int) ([]*entity.Review, error)
 	FindByUserAndMovie(ctx context.Context, userID, movieID uuid.UUID) (*entity.Review, error)
 	CountByMovieID(ctx context.Context, movieID uuid.UUID) (int64, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 	Update(ctx context.Context, review *entity.Review) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) error
+
+	// Moderation
+	IncrementReportCount(ctx context.Context, id uuid.UUID, hideThreshold int) (int, bool, error) // count, hidden
+	ClearReports(ctx context.Context, id uuid.UUID) error
+	FindReported(ctx context.Context, limit, offset int) ([]*entity.Review, error)
+	CountReported(ctx context.Context) (int64, error)
 
 	// Business queries
-	GetMovieAverageRating(ctx context.Context, movieID uuid.UUID) (float64, error)
+	GetMovieAverageRating(ctx context.Context, movieID uuid.UUID) (float64, int64, error) // average, count
+	GetAllMovieRatingStats(ctx context.Context) ([]*MovieRatingStats, error)
 	GetMovieReviewStats(ctx context.Context, movieID uuid.UUID) (float64, int64, error) // rating, count
 }
 
@@ -54,6 +97,9 @@ func (r *reviewRepository) Create(ctx context.Context, review *entity.Review) er
 		review.CreatedAt,
 	)
 
+	if isUniqueViolation(err, reviewUniqueIndex) {
+		return ErrAlreadyReviewed
+	}
 	if err != nil {
 		r.log.Error("Failed to create review",
 			zap.Error(err),
@@ -69,9 +115,9 @@ func (r *reviewRepository) Create(ctx context.Context, review *entity.Review) er
 
 func (r *reviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Review, error) {
 	query := `
-		SELECT id, user_id, movie_id, rating, comment, created_at
+		SELECT id, user_id, movie_id, rating, comment, created_at, report_count, hidden_at, edited_at
 		FROM reviews
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var review entity.Review
@@ -82,6 +128,9 @@ func (r *reviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.
 		&review.Rating,
 		&review.Comment,
 		&review.CreatedAt,
+		&review.ReportCount,
+		&review.HiddenAt,
+		&review.EditedAt,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -98,16 +147,16 @@ func (r *reviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.
 	return &review, nil
 }
 
-func (r *reviewRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID, limit, offset int) ([]*entity.Review, error) {
-	query := `
-		SELECT id, user_id, movie_id, rating, comment, created_at
+func (r *reviewRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID, sort ReviewSort, limit, offset int) ([]*entity.Review, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, movie_id, rating, comment, created_at, report_count, hidden_at, edited_at
 		FROM reviews
-		WHERE movie_id = $1
-		ORDER BY created_at DESC
+		WHERE movie_id = $1 AND deleted_at IS NULL AND hidden_at IS NULL
+		ORDER BY %s
 		LIMIT $2 OFFSET $3
-	`
+	`, sort.orderClause())
 
-	rows, err := r.db.Query(ctx, query, movieID, limit, offset)
+	rows, err := r.db.Reader().Query(ctx, query, movieID, limit, offset)
 	if err != nil {
 		r.log.Error("Failed to find reviews by movie ID",
 			zap.Error(err),
@@ -129,6 +178,9 @@ func (r *reviewRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID,
 			&review.Rating,
 			&review.Comment,
 			&review.CreatedAt,
+			&review.ReportCount,
+			&review.HiddenAt,
+			&review.EditedAt,
 		)
 		if err != nil {
 			r.log.Error("Failed to scan review row", zap.Error(err))
@@ -140,16 +192,16 @@ func (r *reviewRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID,
 	return reviews, nil
 }
 
-func (r *reviewRepository) FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.Review, error) {
-	query := `
-		SELECT id, user_id, movie_id, rating, comment, created_at
+func (r *reviewRepository) FindByUserID(ctx context.Context, userID uuid.UUID, sort ReviewSort, limit, offset int) ([]*entity.Review, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, movie_id, rating, comment, created_at, report_count, hidden_at, edited_at
 		FROM reviews
-		WHERE user_id = $1
-		ORDER BY created_at DESC
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY %s
 		LIMIT $2 OFFSET $3
-	`
+	`, sort.orderClause())
 
-	rows, err := r.db.Query(ctx, query, userID, limit, offset)
+	rows, err := r.db.Reader().Query(ctx, query, userID, limit, offset)
 	if err != nil {
 		r.log.Error("Failed to find reviews by user ID",
 			zap.Error(err),
@@ -171,6 +223,9 @@ func (r *reviewRepository) FindByUserID(ctx context.Context, userID uuid.UUID, l
 			&review.Rating,
 			&review.Comment,
 			&review.CreatedAt,
+			&review.ReportCount,
+			&review.HiddenAt,
+			&review.EditedAt,
 		)
 		if err != nil {
 			r.log.Error("Failed to scan review row", zap.Error(err))
@@ -184,9 +239,9 @@ func (r *reviewRepository) FindByUserID(ctx context.Context, userID uuid.UUID, l
 
 func (r *reviewRepository) FindByUserAndMovie(ctx context.Context, userID, movieID uuid.UUID) (*entity.Review, error) {
 	query := `
-		SELECT id, user_id, movie_id, rating, comment, created_at
+		SELECT id, user_id, movie_id, rating, comment, created_at, report_count, hidden_at, edited_at
 		FROM reviews
-		WHERE user_id = $1 AND movie_id = $2
+		WHERE user_id = $1 AND movie_id = $2 AND deleted_at IS NULL
 		LIMIT 1
 	`
 
@@ -198,6 +253,9 @@ func (r *reviewRepository) FindByUserAndMovie(ctx context.Context, userID, movie
 		&review.Rating,
 		&review.Comment,
 		&review.CreatedAt,
+		&review.ReportCount,
+		&review.HiddenAt,
+		&review.EditedAt,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -217,10 +275,10 @@ func (r *reviewRepository) FindByUserAndMovie(ctx context.Context, userID, movie
 }
 
 func (r *reviewRepository) CountByMovieID(ctx context.Context, movieID uuid.UUID) (int64, error) {
-	query := `SELECT COUNT(*) FROM reviews WHERE movie_id = $1`
+	query := `SELECT COUNT(*) FROM reviews WHERE movie_id = $1 AND deleted_at IS NULL AND hidden_at IS NULL`
 
 	var count int64
-	err := r.db.QueryRow(ctx, query, movieID).Scan(&count)
+	err := r.db.Reader().QueryRow(ctx, query, movieID).Scan(&count)
 	if err != nil {
 		r.log.Error("Failed to count reviews by movie ID",
 			zap.Error(err),
@@ -232,17 +290,34 @@ func (r *reviewRepository) CountByMovieID(ctx context.Context, movieID uuid.UUID
 	return count, nil
 }
 
+func (r *reviewRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM reviews WHERE user_id = $1 AND deleted_at IS NULL`
+
+	var count int64
+	err := r.db.Reader().QueryRow(ctx, query, userID).Scan(&count)
+	if err != nil {
+		r.log.Error("Failed to count reviews by user ID",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return 0, fmt.Errorf("count reviews by user ID %s: %w", userID.String(), err)
+	}
+
+	return count, nil
+}
+
 func (r *reviewRepository) Update(ctx context.Context, review *entity.Review) error {
 	query := `
 		UPDATE reviews
-		SET rating = $2, comment = $3
-		WHERE id = $1
+		SET rating = $2, comment = $3, edited_at = $4
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	result, err := r.db.Exec(ctx, query,
 		review.ID,
 		review.Rating,
 		review.Comment,
+		review.EditedAt,
 	)
 
 	if err != nil {
@@ -261,7 +336,7 @@ func (r *reviewRepository) Update(ctx context.Context, review *entity.Review) er
 }
 
 func (r *reviewRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM reviews WHERE id = $1`
+	query := `UPDATE reviews SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 
 	result, err := r.db.Exec(ctx, query, id)
 	if err != nil {
@@ -276,24 +351,206 @@ func (r *reviewRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("review %s not found", id.String())
 	}
 
-	r.log.Info("Review deleted", zap.String("review_id", id.String()))
+	r.log.Info("Review soft deleted", zap.String("review_id", id.String()))
 	return nil
 }
 
-func (r *reviewRepository) GetMovieAverageRating(ctx context.Context, movieID uuid.UUID) (float64, error) {
-	query := `SELECT COALESCE(AVG(rating), 0) FROM reviews WHERE movie_id = $1`
+// Restore undeletes a review, kecuali user sudah menulis review baru untuk movie yang sama
+func (r *reviewRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE reviews
+		SET deleted_at = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL
+		  AND NOT EXISTS (
+			SELECT 1 FROM reviews active
+			WHERE active.user_id = reviews.user_id
+			  AND active.movie_id = reviews.movie_id
+			  AND active.deleted_at IS NULL
+		  )
+	`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if isUniqueViolation(err, reviewUniqueIndex) {
+		return ErrAlreadyReviewed
+	}
+	if err != nil {
+		r.log.Error("Failed to restore review",
+			zap.Error(err),
+			zap.String("review_id", id.String()),
+		)
+		return fmt.Errorf("restore review %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("review %s not found, not deleted, or user already has an active review", id.String())
+	}
+
+	r.log.Info("Review restored", zap.String("review_id", id.String()))
+	return nil
+}
+
+// IncrementReportCount menambah counter report dan menyembunyikan review begitu mencapai hideThreshold.
+// Returns counter baru dan apakah review sekarang tersembunyi.
+func (r *reviewRepository) IncrementReportCount(ctx context.Context, id uuid.UUID, hideThreshold int) (int, bool, error) {
+	query := `
+		UPDATE reviews
+		SET report_count = report_count + 1,
+		    hidden_at = CASE
+		        WHEN hidden_at IS NULL AND report_count + 1 >= $2 THEN NOW()
+		        ELSE hidden_at
+		    END
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING report_count, hidden_at IS NOT NULL
+	`
+
+	var count int
+	var hidden bool
+	err := r.db.QueryRow(ctx, query, id, hideThreshold).Scan(&count, &hidden)
+	if err == pgx.ErrNoRows {
+		return 0, false, fmt.Errorf("review %s not found", id.String())
+	}
+	if err != nil {
+		r.log.Error("Failed to increment review report count",
+			zap.Error(err),
+			zap.String("review_id", id.String()),
+		)
+		return 0, false, fmt.Errorf("increment report count for review %s: %w", id.String(), err)
+	}
+
+	return count, hidden, nil
+}
+
+// ClearReports resets counter dan menampilkan lagi review yang lolos moderasi
+func (r *reviewRepository) ClearReports(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE reviews
+		SET report_count = 0, hidden_at = NULL
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to clear review reports",
+			zap.Error(err),
+			zap.String("review_id", id.String()),
+		)
+		return fmt.Errorf("clear reports for review %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("review %s not found", id.String())
+	}
+
+	return nil
+}
+
+// FindReported returns antrian moderasi: review tersembunyi dulu, lalu yang paling banyak di-report
+func (r *reviewRepository) FindReported(ctx context.Context, limit, offset int) ([]*entity.Review, error) {
+	query := `
+		SELECT id, user_id, movie_id, rating, comment, created_at, report_count, hidden_at, edited_at
+		FROM reviews
+		WHERE report_count > 0 AND deleted_at IS NULL
+		ORDER BY hidden_at IS NULL, report_count DESC, created_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.Query(ctx, query, limit, offset)
+	if err != nil {
+		r.log.Error("Failed to find reported reviews",
+			zap.Error(err),
+			zap.Int("limit", limit),
+			zap.Int("offset", offset),
+		)
+		return nil, fmt.Errorf("find reported reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []*entity.Review
+	for rows.Next() {
+		var review entity.Review
+		err := rows.Scan(
+			&review.ID,
+			&review.UserID,
+			&review.MovieID,
+			&review.Rating,
+			&review.Comment,
+			&review.CreatedAt,
+			&review.ReportCount,
+			&review.HiddenAt,
+			&review.EditedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan review row", zap.Error(err))
+			return nil, fmt.Errorf("scan review row: %w", err)
+		}
+		reviews = append(reviews, &review)
+	}
+
+	return reviews, nil
+}
+
+func (r *reviewRepository) CountReported(ctx context.Context) (int64, error) {
+	query := `SELECT COUNT(*) FROM reviews WHERE report_count > 0 AND deleted_at IS NULL`
+
+	var count int64
+	if err := r.db.QueryRow(ctx, query).Scan(&count); err != nil {
+		r.log.Error("Failed to count reported reviews", zap.Error(err))
+		return 0, fmt.Errorf("count reported reviews: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetMovieAverageRating dibaca dari primary karena dipakai tepat setelah review berubah
+func (r *reviewRepository) GetMovieAverageRating(ctx context.Context, movieID uuid.UUID) (float64, int64, error) {
+	query := `
+		SELECT COALESCE(AVG(rating), 0), COUNT(*) FROM reviews
+		WHERE movie_id = $1 AND deleted_at IS NULL AND hidden_at IS NULL
+	`
 
 	var avgRating float64
-	err := r.db.QueryRow(ctx, query, movieID).Scan(&avgRating)
+	var count int64
+	err := r.db.QueryRow(ctx, query, movieID).Scan(&avgRating, &count)
 	if err != nil {
 		r.log.Error("Failed to get movie average rating",
 			zap.Error(err),
 			zap.String("movie_id", movieID.String()),
 		)
-		return 0, fmt.Errorf("get movie average rating for %s: %w", movieID.String(), err)
+		return 0, 0, fmt.Errorf("get movie average rating for %s: %w", movieID.String(), err)
+	}
+
+	return avgRating, count, nil
+}
+
+// GetAllMovieRatingStats returns rating tersimpan dan agregat review untuk semua movie aktif,
+// termasuk movie tanpa review (count 0)
+func (r *reviewRepository) GetAllMovieRatingStats(ctx context.Context) ([]*MovieRatingStats, error) {
+	query := `
+		SELECT m.id, m.rating, COALESCE(AVG(rv.rating), 0), COUNT(rv.id)
+		FROM movies m
+		LEFT JOIN reviews rv ON rv.movie_id = m.id AND rv.deleted_at IS NULL AND rv.hidden_at IS NULL
+		WHERE m.deleted_at IS NULL
+		GROUP BY m.id, m.rating
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to get movie rating stats", zap.Error(err))
+		return nil, fmt.Errorf("get movie rating stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*MovieRatingStats
+	for rows.Next() {
+		var s MovieRatingStats
+		if err := rows.Scan(&s.MovieID, &s.CurrentRating, &s.AverageRating, &s.ReviewCount); err != nil {
+			r.log.Error("Failed to scan movie rating stats row", zap.Error(err))
+			return nil, fmt.Errorf("scan movie rating stats row: %w", err)
+		}
+		stats = append(stats, &s)
 	}
 
-	return avgRating, nil
+	return stats, rows.Err()
 }
 
 func (r *reviewRepository) GetMovieReviewStats(ctx context.Context, movieID uuid.UUID) (float64, int64, error) {
@@ -302,12 +559,12 @@ func (r *reviewRepository) GetMovieReviewStats(ctx context.Context, movieID uuid
 			COALESCE(AVG(rating), 0) as avg_rating,
 			COUNT(*) as review_count
 		FROM reviews 
-		WHERE movie_id = $1
+		WHERE movie_id = $1 AND deleted_at IS NULL AND hidden_at IS NULL
 	`
 
 	var avgRating float64
 	var reviewCount int64
-	err := r.db.QueryRow(ctx, query, movieID).Scan(&avgRating, &reviewCount)
+	err := r.db.Reader().QueryRow(ctx, query, movieID).Scan(&avgRating, &reviewCount)
 	if err != nil {
 		r.log.Error("Failed to get movie review stats",
 			zap.Error(err),
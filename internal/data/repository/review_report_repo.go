@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ReviewReportRepository interface {
+	Create(ctx context.Context, report *entity.ReviewReport) error
+	// CountOpenReasons returns jumlah report yang belum di-resolve per alasan, key-nya review ID
+	CountOpenReasons(ctx context.Context, reviewIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error)
+	ResolveByReviewID(ctx context.Context, reviewID uuid.UUID, resolvedAt time.Time) error
+}
+
+type reviewReportRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewReviewReportRepository(db database.PgxIface, log *zap.Logger) ReviewReportRepository {
+	return &reviewReportRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "review_report")),
+	}
+}
+
+func (r *reviewReportRepository) Create(ctx context.Context, report *entity.ReviewReport) error {
+	query := `
+		INSERT INTO review_reports (id, review_id, reporter_id, reason, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (review_id, reporter_id) DO NOTHING
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		report.ID,
+		report.ReviewID,
+		report.ReporterID,
+		report.Reason,
+		report.Details,
+		report.CreatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to create review report",
+			zap.Error(err),
+			zap.String("review_id", report.ReviewID.String()),
+			zap.String("reporter_id", report.ReporterID.String()),
+		)
+		return fmt.Errorf("create report for review %s: %w", report.ReviewID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user already reported review %s", report.ReviewID.String())
+	}
+
+	return nil
+}
+
+func (r *reviewReportRepository) CountOpenReasons(ctx context.Context, reviewIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+	counts := make(map[uuid.UUID]map[string]int, len(reviewIDs))
+	if len(reviewIDs) == 0 {
+		return counts, nil
+	}
+
+	query := `
+		SELECT review_id, reason, COUNT(*)
+		FROM review_reports
+		WHERE review_id = ANY($1) AND resolved_at IS NULL
+		GROUP BY review_id, reason
+	`
+
+	rows, err := r.db.Query(ctx, query, reviewIDs)
+	if err != nil {
+		r.log.Error("Failed to count review report reasons",
+			zap.Error(err),
+			zap.Int("review_count", len(reviewIDs)),
+		)
+		return nil, fmt.Errorf("count report reasons for %d reviews: %w", len(reviewIDs), err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewID uuid.UUID
+		var reason string
+		var count int
+		if err := rows.Scan(&reviewID, &reason, &count); err != nil {
+			r.log.Error("Failed to scan review report reason row", zap.Error(err))
+			return nil, fmt.Errorf("scan review report reason row: %w", err)
+		}
+		if counts[reviewID] == nil {
+			counts[reviewID] = make(map[string]int)
+		}
+		counts[reviewID][reason] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func (r *reviewReportRepository) ResolveByReviewID(ctx context.Context, reviewID uuid.UUID, resolvedAt time.Time) error {
+	query := `UPDATE review_reports SET resolved_at = $2 WHERE review_id = $1 AND resolved_at IS NULL`
+
+	if _, err := r.db.Exec(ctx, query, reviewID, resolvedAt); err != nil {
+		r.log.Error("Failed to resolve review reports",
+			zap.Error(err),
+			zap.String("review_id", reviewID.String()),
+		)
+		return fmt.Errorf("resolve reports for review %s: %w", reviewID.String(), err)
+	}
+
+	return nil
+}
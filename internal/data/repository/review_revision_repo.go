@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ReviewRevisionRepository versi lama review sebelum diedit pemiliknya
+type ReviewRevisionRepository interface {
+	Create(ctx context.Context, revision *entity.ReviewRevision) error
+	// FindByReviewID returns revisi review, terbaru dulu
+	FindByReviewID(ctx context.Context, reviewID uuid.UUID) ([]*entity.ReviewRevision, error)
+}
+
+type reviewRevisionRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewReviewRevisionRepository(db database.PgxIface, log *zap.Logger) ReviewRevisionRepository {
+	return &reviewRevisionRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "review_revision")),
+	}
+}
+
+func (r *reviewRevisionRepository) Create(ctx context.Context, revision *entity.ReviewRevision) error {
+	query := `
+		INSERT INTO review_revisions (id, review_id, rating, comment, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		revision.ID,
+		revision.ReviewID,
+		revision.Rating,
+		revision.Comment,
+		revision.CreatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to create review revision",
+			zap.Error(err),
+			zap.String("review_id", revision.ReviewID.String()),
+		)
+		return fmt.Errorf("create revision for review %s: %w", revision.ReviewID.String(), err)
+	}
+
+	return nil
+}
+
+func (r *reviewRevisionRepository) FindByReviewID(ctx context.Context, reviewID uuid.UUID) ([]*entity.ReviewRevision, error) {
+	query := `
+		SELECT id, review_id, rating, comment, created_at
+		FROM review_revisions
+		WHERE review_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, reviewID)
+	if err != nil {
+		r.log.Error("Failed to find review revisions",
+			zap.Error(err),
+			zap.String("review_id", reviewID.String()),
+		)
+		return nil, fmt.Errorf("find revisions for review %s: %w", reviewID.String(), err)
+	}
+	defer rows.Close()
+
+	revisions := []*entity.ReviewRevision{}
+	for rows.Next() {
+		var revision entity.ReviewRevision
+		err := rows.Scan(
+			&revision.ID,
+			&revision.ReviewID,
+			&revision.Rating,
+			&revision.Comment,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan review revision row", zap.Error(err))
+			return nil, fmt.Errorf("scan review revision row: %w", err)
+		}
+		revisions = append(revisions, &revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate review revision rows: %w", err)
+	}
+
+	return revisions, nil
+}
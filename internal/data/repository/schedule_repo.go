@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -16,13 +17,67 @@ import (
 type ScheduleRepository interface {
 	Create(ctx context.Context, schedule *entity.Schedule) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Schedule, error)
+	// LockByID serializes bookings per schedule, hanya berguna di dalam WithTx
+	LockByID(ctx context.Context, id uuid.UUID) error
+	// FindByMovieID dan FindByDateAndHall hanya mengembalikan schedule published (listing publik)
 	FindByMovieID(ctx context.Context, movieID uuid.UUID) ([]*entity.Schedule, error)
 	FindByHallID(ctx context.Context, hallID uuid.UUID) ([]*entity.Schedule, error)
 	FindByDateAndHall(ctx context.Context, hallID uuid.UUID, date time.Time) ([]*entity.Schedule, error)
+	// FindByCinemaAndDateRange returns draft dan published di semua hall cinema, show_date di [from, to)
+	FindByCinemaAndDateRange(ctx context.Context, cinemaID uuid.UUID, from, to time.Time) ([]*entity.Schedule, error)
+	FindAll(ctx context.Context, filter ScheduleFilter, limit, offset int) ([]*entity.Schedule, error)
+	CountAll(ctx context.Context, filter ScheduleFilter) (int64, error)
 	Update(ctx context.Context, schedule *entity.Schedule) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) error
+	// Publish moves a draft to published; error not found kalau bukan draft
+	Publish(ctx context.Context, id uuid.UUID, publishedAt time.Time) error
+	// SetPriceOverride berlaku untuk draft maupun published; nil menghapus override
+	SetPriceOverride(ctx context.Context, id uuid.UUID, price *int64) error
+	// AdjustBookedSeats menambah (delta negatif = mengurangi) counter booked_seats; panggil di tx yang
+	// sama dengan perubahan booking_seats supaya counter tidak pernah drift. Penambahan yang melewati
+	// capacity ditolak dengan ErrScheduleFull.
+	AdjustBookedSeats(ctx context.Context, id uuid.UUID, delta int) error
+	// FindCounterMismatches returns schedule mendatang yang booked_seats-nya berbeda dari booking_seats
+	// aktif atau melebihi capacity
+	FindCounterMismatches(ctx context.Context, from time.Time, limit int) ([]*ScheduleCounterMismatch, error)
+	// RecomputeStartsAt menghitung ulang starts_at semua schedule di cinema dari jam dinding + timezone cinema
+	RecomputeStartsAt(ctx context.Context, cinemaID uuid.UUID) (int64, error)
+	// CancelByCinema membatalkan schedule draft / published di cinema yang belum mulai (starts_at >= now)
+	// dengan show_date di [from, until); until nil = tanpa batas. Returns the cancelled schedules.
+	CancelByCinema(ctx context.Context, cinemaID uuid.UUID, from time.Time, until *time.Time, now time.Time) ([]*entity.Schedule, error)
 }
 
+// ScheduleFilter narrows schedule listings; StartsFrom selalu dipakai supaya show yang sudah mulai tidak ikut
+type ScheduleFilter struct {
+	StartsFrom time.Time
+	MovieID    *uuid.UUID
+	CinemaID   *uuid.UUID
+	ShowDate   *time.Time
+	HallType   *entity.HallType
+	Status     *entity.ScheduleStatus // nil = semua status, hanya untuk admin
+	// OrganizationID membatasi ke cinema milik chain, untuk listing admin chain
+	OrganizationID *uuid.UUID
+}
+
+// ErrScheduleFull dikembalikan AdjustBookedSeats kalau kursi yang ditambahkan melebihi capacity schedule
+var ErrScheduleFull = errors.New("schedule has no capacity left")
+
+// ScheduleCounterMismatch hasil pengecekan counter kursi satu schedule
+type ScheduleCounterMismatch struct {
+	ScheduleID  uuid.UUID
+	HallID      uuid.UUID
+	Capacity    int
+	BookedSeats int
+	ActiveSeats int // booking_seats yang belum dilepas
+}
+
+const scheduleColumns = `id, movie_id, hall_id, show_date, show_time, starts_at, price, currency, created_at, updated_at,
+		status, published_at, price_override, capacity, booked_seats`
+
+const scheduleColumnsAliased = `s.id, s.movie_id, s.hall_id, s.show_date, s.show_time, s.starts_at, s.price, s.currency, s.created_at, s.updated_at,
+		s.status, s.published_at, s.price_override, s.capacity, s.booked_seats`
+
 type scheduleRepository struct {
 	db  database.PgxIface
 	log *zap.Logger
@@ -37,20 +92,27 @@ func NewScheduleRepository(db database.PgxIface, log *zap.Logger) ScheduleReposi
 
 func (r *scheduleRepository) Create(ctx context.Context, schedule *entity.Schedule) error {
 	query := `
-		INSERT INTO schedules (id, movie_id, hall_id, show_date, show_time, price, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO schedules (id, movie_id, hall_id, show_date, show_time, starts_at, price, currency,
+		                       created_at, updated_at, status, published_at, capacity)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+		        (SELECT total_seats FROM halls WHERE id = $3))
+		RETURNING capacity
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	err := r.db.QueryRow(ctx, query,
 		schedule.ID,
 		schedule.MovieID,
 		schedule.HallID,
 		schedule.ShowDate,
 		schedule.ShowTime,
+		schedule.StartsAt,
 		schedule.Price,
+		schedule.Currency,
 		schedule.CreatedAt,
 		schedule.UpdatedAt,
-	)
+		schedule.Status,
+		schedule.PublishedAt,
+	).Scan(&schedule.Capacity)
 
 	if err != nil {
 		r.log.Error("Failed to create schedule",
@@ -68,23 +130,12 @@ func (r *scheduleRepository) Create(ctx context.Context, schedule *entity.Schedu
 
 func (r *scheduleRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Schedule, error) {
 	query := `
-		SELECT id, movie_id, hall_id, show_date, show_time, price, created_at, updated_at
+		SELECT ` + scheduleColumns + `
 		FROM schedules
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
-	var schedule entity.Schedule
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&schedule.ID,
-		&schedule.MovieID,
-		&schedule.HallID,
-		&schedule.ShowDate,
-		&schedule.ShowTime,
-		&schedule.Price,
-		&schedule.CreatedAt,
-		&schedule.UpdatedAt,
-	)
-
+	schedule, err := scanSchedule(r.db.QueryRow(ctx, query, id))
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
@@ -96,18 +147,37 @@ func (r *scheduleRepository) FindByID(ctx context.Context, id uuid.UUID) (*entit
 		return nil, fmt.Errorf("find schedule by ID %s: %w", id.String(), err)
 	}
 
-	return &schedule, nil
+	return schedule, nil
+}
+
+func (r *scheduleRepository) LockByID(ctx context.Context, id uuid.UUID) error {
+	query := `SELECT id FROM schedules WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+
+	var lockedID uuid.UUID
+	err := r.db.QueryRow(ctx, query, id).Scan(&lockedID)
+	if err == pgx.ErrNoRows {
+		return fmt.Errorf("schedule %s not found", id.String())
+	}
+	if err != nil {
+		r.log.Error("Failed to lock schedule",
+			zap.Error(err),
+			zap.String("schedule_id", id.String()),
+		)
+		return fmt.Errorf("lock schedule %s: %w", id.String(), err)
+	}
+
+	return nil
 }
 
 func (r *scheduleRepository) FindByMovieID(ctx context.Context, movieID uuid.UUID) ([]*entity.Schedule, error) {
 	query := `
-		SELECT id, movie_id, hall_id, show_date, show_time, price, created_at, updated_at
+		SELECT ` + scheduleColumns + `
 		FROM schedules
-		WHERE movie_id = $1
-		ORDER BY show_date, show_time
+		WHERE movie_id = $1 AND status = 'published' AND deleted_at IS NULL
+		ORDER BY starts_at
 	`
 
-	rows, err := r.db.Query(ctx, query, movieID)
+	rows, err := r.db.Reader().Query(ctx, query, movieID)
 	if err != nil {
 		r.log.Error("Failed to find schedules by movie ID",
 			zap.Error(err),
@@ -117,35 +187,15 @@ func (r *scheduleRepository) FindByMovieID(ctx context.Context, movieID uuid.UUI
 	}
 	defer rows.Close()
 
-	var schedules []*entity.Schedule
-	for rows.Next() {
-		var schedule entity.Schedule
-		err := rows.Scan(
-			&schedule.ID,
-			&schedule.MovieID,
-			&schedule.HallID,
-			&schedule.ShowDate,
-			&schedule.ShowTime,
-			&schedule.Price,
-			&schedule.CreatedAt,
-			&schedule.UpdatedAt,
-		)
-		if err != nil {
-			r.log.Error("Failed to scan schedule row", zap.Error(err))
-			return nil, fmt.Errorf("scan schedule row: %w", err)
-		}
-		schedules = append(schedules, &schedule)
-	}
-
-	return schedules, nil
+	return r.scanSchedules(rows)
 }
 
 func (r *scheduleRepository) FindByHallID(ctx context.Context, hallID uuid.UUID) ([]*entity.Schedule, error) {
 	query := `
-		SELECT id, movie_id, hall_id, show_date, show_time, price, created_at, updated_at
+		SELECT ` + scheduleColumns + `
 		FROM schedules
-		WHERE hall_id = $1
-		ORDER BY show_date, show_time
+		WHERE hall_id = $1 AND deleted_at IS NULL
+		ORDER BY starts_at
 	`
 
 	rows, err := r.db.Query(ctx, query, hallID)
@@ -158,34 +208,14 @@ func (r *scheduleRepository) FindByHallID(ctx context.Context, hallID uuid.UUID)
 	}
 	defer rows.Close()
 
-	var schedules []*entity.Schedule
-	for rows.Next() {
-		var schedule entity.Schedule
-		err := rows.Scan(
-			&schedule.ID,
-			&schedule.MovieID,
-			&schedule.HallID,
-			&schedule.ShowDate,
-			&schedule.ShowTime,
-			&schedule.Price,
-			&schedule.CreatedAt,
-			&schedule.UpdatedAt,
-		)
-		if err != nil {
-			r.log.Error("Failed to scan schedule row", zap.Error(err))
-			return nil, fmt.Errorf("scan schedule row: %w", err)
-		}
-		schedules = append(schedules, &schedule)
-	}
-
-	return schedules, nil
+	return r.scanSchedules(rows)
 }
 
 func (r *scheduleRepository) FindByDateAndHall(ctx context.Context, hallID uuid.UUID, date time.Time) ([]*entity.Schedule, error) {
 	query := `
-		SELECT id, movie_id, hall_id, show_date, show_time, price, created_at, updated_at
+		SELECT ` + scheduleColumns + `
 		FROM schedules
-		WHERE hall_id = $1 AND show_date = $2
+		WHERE hall_id = $1 AND show_date = $2 AND status = 'published' AND deleted_at IS NULL
 		ORDER BY show_time
 	`
 
@@ -201,46 +231,57 @@ func (r *scheduleRepository) FindByDateAndHall(ctx context.Context, hallID uuid.
 	}
 	defer rows.Close()
 
-	var schedules []*entity.Schedule
-	for rows.Next() {
-		var schedule entity.Schedule
-		err := rows.Scan(
-			&schedule.ID,
-			&schedule.MovieID,
-			&schedule.HallID,
-			&schedule.ShowDate,
-			&schedule.ShowTime,
-			&schedule.Price,
-			&schedule.CreatedAt,
-			&schedule.UpdatedAt,
+	return r.scanSchedules(rows)
+}
+
+func (r *scheduleRepository) FindByCinemaAndDateRange(ctx context.Context, cinemaID uuid.UUID, from, to time.Time) ([]*entity.Schedule, error) {
+	query := `
+		SELECT ` + scheduleColumnsAliased + `
+		FROM schedules s
+		JOIN halls h ON h.id = s.hall_id AND h.deleted_at IS NULL
+		WHERE h.cinema_id = $1 AND s.show_date >= $2 AND s.show_date < $3 AND s.deleted_at IS NULL
+		ORDER BY s.show_date, s.show_time, s.hall_id
+	`
+
+	rows, err := r.db.Query(ctx, query, cinemaID, from, to)
+	if err != nil {
+		r.log.Error("Failed to find schedules by cinema and date range",
+			zap.Error(err),
+			zap.String("cinema_id", cinemaID.String()),
+			zap.Time("from", from),
+			zap.Time("to", to),
 		)
-		if err != nil {
-			r.log.Error("Failed to scan schedule row", zap.Error(err))
-			return nil, fmt.Errorf("scan schedule row: %w", err)
-		}
-		schedules = append(schedules, &schedule)
+		return nil, fmt.Errorf("find schedules by cinema %s: %w", cinemaID.String(), err)
 	}
+	defer rows.Close()
 
-	return schedules, nil
+	return r.scanSchedules(rows)
 }
 
 func (r *scheduleRepository) Update(ctx context.Context, schedule *entity.Schedule) error {
 	query := `
 		UPDATE schedules
-		SET movie_id = $2, hall_id = $3, show_date = $4, show_time = $5, price = $6, updated_at = $7
-		WHERE id = $1
+		SET movie_id = $2, hall_id = $3, show_date = $4, show_time = $5, starts_at = $6, price = $7, currency = $8,
+		    updated_at = $9, capacity = (SELECT total_seats FROM halls WHERE id = $3)
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING capacity
 	`
 
-	result, err := r.db.Exec(ctx, query,
+	err := r.db.QueryRow(ctx, query,
 		schedule.ID,
 		schedule.MovieID,
 		schedule.HallID,
 		schedule.ShowDate,
 		schedule.ShowTime,
+		schedule.StartsAt,
 		schedule.Price,
+		schedule.Currency,
 		schedule.UpdatedAt,
-	)
+	).Scan(&schedule.Capacity)
 
+	if err == pgx.ErrNoRows {
+		return fmt.Errorf("schedule %s not found", schedule.ID.String())
+	}
 	if err != nil {
 		r.log.Error("Failed to update schedule",
 			zap.Error(err),
@@ -249,15 +290,11 @@ func (r *scheduleRepository) Update(ctx context.Context, schedule *entity.Schedu
 		return fmt.Errorf("update schedule %s: %w", schedule.ID.String(), err)
 	}
 
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("schedule %s not found", schedule.ID.String())
-	}
-
 	return nil
 }
 
 func (r *scheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM schedules WHERE id = $1`
+	query := `UPDATE schedules SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 
 	result, err := r.db.Exec(ctx, query, id)
 	if err != nil {
@@ -272,6 +309,316 @@ func (r *scheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("schedule %s not found", id.String())
 	}
 
-	r.log.Info("Schedule deleted", zap.String("schedule_id", id.String()))
+	r.log.Info("Schedule soft deleted", zap.String("schedule_id", id.String()))
+	return nil
+}
+
+func (r *scheduleRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE schedules SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to restore schedule",
+			zap.Error(err),
+			zap.String("schedule_id", id.String()),
+		)
+		return fmt.Errorf("restore schedule %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("schedule %s not found or not deleted", id.String())
+	}
+
+	r.log.Info("Schedule restored", zap.String("schedule_id", id.String()))
 	return nil
 }
+
+func (r *scheduleRepository) FindAll(ctx context.Context, filter ScheduleFilter, limit, offset int) ([]*entity.Schedule, error) {
+	where, args := filter.sql()
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM schedules s
+		JOIN halls h ON h.id = s.hall_id AND h.deleted_at IS NULL
+		WHERE s.deleted_at IS NULL %s
+		ORDER BY s.starts_at, s.id
+		LIMIT $%d OFFSET $%d
+	`, scheduleColumnsAliased, where, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.Reader().Query(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to find schedules",
+			zap.Error(err),
+			zap.Int("limit", limit),
+			zap.Int("offset", offset),
+		)
+		return nil, fmt.Errorf("find schedules: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanSchedules(rows)
+}
+
+func (r *scheduleRepository) CountAll(ctx context.Context, filter ScheduleFilter) (int64, error) {
+	where, args := filter.sql()
+	query := `
+		SELECT COUNT(*)
+		FROM schedules s
+		JOIN halls h ON h.id = s.hall_id AND h.deleted_at IS NULL
+		WHERE s.deleted_at IS NULL ` + where
+
+	var total int64
+	if err := r.db.Reader().QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		r.log.Error("Failed to count schedules", zap.Error(err))
+		return 0, fmt.Errorf("count schedules: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *scheduleRepository) Publish(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	query := `
+		UPDATE schedules
+		SET status = 'published', published_at = $2, updated_at = $2
+		WHERE id = $1 AND status = 'draft' AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, id, publishedAt)
+	if err != nil {
+		r.log.Error("Failed to publish schedule",
+			zap.Error(err),
+			zap.String("schedule_id", id.String()),
+		)
+		return fmt.Errorf("publish schedule %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("draft schedule %s not found", id.String())
+	}
+
+	return nil
+}
+
+func (r *scheduleRepository) SetPriceOverride(ctx context.Context, id uuid.UUID, price *int64) error {
+	query := `UPDATE schedules SET price_override = $2, updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, id, price)
+	if err != nil {
+		r.log.Error("Failed to set schedule price override",
+			zap.Error(err),
+			zap.String("schedule_id", id.String()),
+		)
+		return fmt.Errorf("set price override for schedule %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("schedule %s not found", id.String())
+	}
+
+	return nil
+}
+
+func (r *scheduleRepository) AdjustBookedSeats(ctx context.Context, id uuid.UUID, delta int) error {
+	if delta == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE schedules SET booked_seats = booked_seats + $2
+		WHERE id = $1 AND ($2 < 0 OR booked_seats + $2 <= capacity)
+	`
+
+	result, err := r.db.Exec(ctx, query, id, delta)
+	if err != nil {
+		r.log.Error("Failed to adjust booked seats",
+			zap.Error(err),
+			zap.String("schedule_id", id.String()),
+			zap.Int("delta", delta),
+		)
+		return fmt.Errorf("adjust booked seats of schedule %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		if delta > 0 {
+			var exists bool
+			err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM schedules WHERE id = $1)`, id).Scan(&exists)
+			if err != nil {
+				return fmt.Errorf("check schedule %s after capacity miss: %w", id.String(), err)
+			}
+			if exists {
+				r.log.Warn("Booking would exceed schedule capacity",
+					zap.String("schedule_id", id.String()),
+					zap.Int("delta", delta),
+				)
+				return ErrScheduleFull
+			}
+		}
+		return fmt.Errorf("schedule %s not found", id.String())
+	}
+
+	return nil
+}
+
+func (r *scheduleRepository) FindCounterMismatches(ctx context.Context, from time.Time, limit int) ([]*ScheduleCounterMismatch, error) {
+	query := `
+		SELECT s.id, s.hall_id, s.capacity, s.booked_seats, COALESCE(active.seats, 0)
+		FROM schedules s
+		LEFT JOIN (
+			SELECT schedule_id, COUNT(*) AS seats
+			FROM booking_seats
+			WHERE released_at IS NULL
+			GROUP BY schedule_id
+		) active ON active.schedule_id = s.id
+		WHERE s.deleted_at IS NULL AND s.starts_at >= $1
+		  AND (s.booked_seats <> COALESCE(active.seats, 0) OR s.booked_seats > s.capacity)
+		ORDER BY s.starts_at, s.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, from, limit)
+	if err != nil {
+		r.log.Error("Failed to find schedule counter mismatches", zap.Error(err))
+		return nil, fmt.Errorf("find schedule counter mismatches: %w", err)
+	}
+	defer rows.Close()
+
+	mismatches := []*ScheduleCounterMismatch{}
+	for rows.Next() {
+		var m ScheduleCounterMismatch
+		if err := rows.Scan(&m.ScheduleID, &m.HallID, &m.Capacity, &m.BookedSeats, &m.ActiveSeats); err != nil {
+			r.log.Error("Failed to scan schedule counter row", zap.Error(err))
+			return nil, fmt.Errorf("scan schedule counter row: %w", err)
+		}
+		mismatches = append(mismatches, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate schedule counter rows: %w", err)
+	}
+
+	return mismatches, nil
+}
+
+func (r *scheduleRepository) RecomputeStartsAt(ctx context.Context, cinemaID uuid.UUID) (int64, error) {
+	query := `
+		UPDATE schedules s
+		SET starts_at = (s.show_date + s.show_time) AT TIME ZONE c.timezone, updated_at = NOW()
+		FROM halls h
+		JOIN cinemas c ON c.id = h.cinema_id
+		WHERE h.id = s.hall_id AND c.id = $1 AND s.deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, cinemaID)
+	if err != nil {
+		r.log.Error("Failed to recompute schedule start times",
+			zap.Error(err),
+			zap.String("cinema_id", cinemaID.String()),
+		)
+		return 0, fmt.Errorf("recompute schedule start times for cinema %s: %w", cinemaID.String(), err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *scheduleRepository) CancelByCinema(ctx context.Context, cinemaID uuid.UUID, from time.Time, until *time.Time, now time.Time) ([]*entity.Schedule, error) {
+	query := `
+		UPDATE schedules s
+		SET status = 'cancelled', updated_at = $4
+		FROM halls h
+		WHERE h.id = s.hall_id AND h.cinema_id = $1
+		  AND s.show_date >= $2 AND ($3::date IS NULL OR s.show_date < $3::date)
+		  AND s.starts_at >= $4 AND s.status IN ('draft', 'published') AND s.deleted_at IS NULL
+		RETURNING ` + scheduleColumnsAliased
+
+	rows, err := r.db.Query(ctx, query, cinemaID, from, until, now)
+	if err != nil {
+		r.log.Error("Failed to cancel schedules by cinema",
+			zap.Error(err),
+			zap.String("cinema_id", cinemaID.String()),
+			zap.Time("from", from),
+		)
+		return nil, fmt.Errorf("cancel schedules of cinema %s: %w", cinemaID.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanSchedules(rows)
+}
+
+func scanSchedule(row pgx.Row) (*entity.Schedule, error) {
+	var schedule entity.Schedule
+	err := row.Scan(
+		&schedule.ID,
+		&schedule.MovieID,
+		&schedule.HallID,
+		&schedule.ShowDate,
+		&schedule.ShowTime,
+		&schedule.StartsAt,
+		&schedule.Price,
+		&schedule.Currency,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+		&schedule.Status,
+		&schedule.PublishedAt,
+		&schedule.PriceOverride,
+		&schedule.Capacity,
+		&schedule.BookedSeats,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+func (r *scheduleRepository) scanSchedules(rows pgx.Rows) ([]*entity.Schedule, error) {
+	var schedules []*entity.Schedule
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			r.log.Error("Failed to scan schedule row", zap.Error(err))
+			return nil, fmt.Errorf("scan schedule row: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate schedule rows: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// sql builds conditions untuk alias s (schedules) dan h (halls)
+func (f ScheduleFilter) sql() (string, []interface{}) {
+	args := []interface{}{f.StartsFrom}
+	where := " AND s.starts_at >= $1"
+
+	if f.MovieID != nil {
+		args = append(args, *f.MovieID)
+		where += fmt.Sprintf(" AND s.movie_id = $%d", len(args))
+	}
+	if f.CinemaID != nil {
+		args = append(args, *f.CinemaID)
+		where += fmt.Sprintf(" AND h.cinema_id = $%d", len(args))
+	}
+	if f.ShowDate != nil {
+		args = append(args, *f.ShowDate)
+		where += fmt.Sprintf(" AND s.show_date = $%d", len(args))
+	}
+	if f.HallType != nil {
+		args = append(args, *f.HallType)
+		where += fmt.Sprintf(" AND h.hall_type = $%d", len(args))
+	}
+	if f.Status != nil {
+		args = append(args, *f.Status)
+		where += fmt.Sprintf(" AND s.status = $%d", len(args))
+	}
+	if f.OrganizationID != nil {
+		args = append(args, *f.OrganizationID)
+		where += " AND " + organizationCinemaSQL("h.cinema_id", len(args))
+	}
+
+	return where, args
+}
@@ -0,0 +1,60 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+)
+
+func TestScheduleRepository_CreateAndFind(t *testing.T) {
+	ctx := context.Background()
+	show := newShowFixture(t, 1)
+
+	got, err := testRepo.Schedule.FindByID(ctx, show.Schedule.ID)
+	if err != nil {
+		t.Fatalf("find schedule: %v", err)
+	}
+	if got == nil || !got.StartsAt.Equal(show.Schedule.StartsAt) {
+		t.Fatalf("stored schedule = %+v, want starts_at %s", got, show.Schedule.StartsAt)
+	}
+	if got.Status != entity.ScheduleStatusPublished || got.Price != show.Schedule.Price {
+		t.Fatalf("stored status %q price %d, want published %d", got.Status, got.Price, show.Schedule.Price)
+	}
+}
+
+func TestScheduleRepository_CapacityFromHall(t *testing.T) {
+	show := newShowFixture(t, 3)
+
+	if show.Schedule.Capacity != 3 {
+		t.Fatalf("capacity = %d, want hall total_seats 3", show.Schedule.Capacity)
+	}
+}
+
+func TestScheduleRepository_AdjustBookedSeats(t *testing.T) {
+	ctx := context.Background()
+	show := newShowFixture(t, 2)
+	id := show.Schedule.ID
+
+	if err := testRepo.Schedule.AdjustBookedSeats(ctx, id, 2); err != nil {
+		t.Fatalf("fill schedule: %v", err)
+	}
+	if err := testRepo.Schedule.AdjustBookedSeats(ctx, id, 1); !errors.Is(err, repository.ErrScheduleFull) {
+		t.Fatalf("overbook = %v, want ErrScheduleFull", err)
+	}
+	if err := testRepo.Schedule.AdjustBookedSeats(ctx, id, -1); err != nil {
+		t.Fatalf("release seat: %v", err)
+	}
+
+	schedule, err := testRepo.Schedule.FindByID(ctx, id)
+	if err != nil {
+		t.Fatalf("find schedule: %v", err)
+	}
+	if schedule.BookedSeats != 1 {
+		t.Fatalf("booked_seats = %d, want 1", schedule.BookedSeats)
+	}
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type SeatBlockRepository interface {
+	// CreateBatch blocks seats untuk schedule; kursi yang sudah diblokir diperbarui alasannya
+	CreateBatch(ctx context.Context, blocks []*entity.SeatBlock) error
+	FindBySchedule(ctx context.Context, scheduleID uuid.UUID) ([]*entity.SeatBlock, error)
+	DeleteBySeats(ctx context.Context, scheduleID uuid.UUID, seatIDs []uuid.UUID) (int64, error)
+}
+
+type seatBlockRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewSeatBlockRepository(db database.PgxIface, log *zap.Logger) SeatBlockRepository {
+	return &seatBlockRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "seat_block")),
+	}
+}
+
+func (r *seatBlockRepository) CreateBatch(ctx context.Context, blocks []*entity.SeatBlock) error {
+	query := `
+		INSERT INTO seat_blocks (id, schedule_id, seat_id, reason, note, blocked_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (schedule_id, seat_id) DO UPDATE
+		SET reason = EXCLUDED.reason, note = EXCLUDED.note, blocked_by = EXCLUDED.blocked_by
+	`
+
+	for _, block := range blocks {
+		_, err := r.db.Exec(ctx, query,
+			block.ID,
+			block.ScheduleID,
+			block.SeatID,
+			block.Reason,
+			block.Note,
+			block.BlockedBy,
+			block.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to create seat block",
+				zap.Error(err),
+				zap.String("schedule_id", block.ScheduleID.String()),
+				zap.String("seat_id", block.SeatID.String()),
+			)
+			return fmt.Errorf("block seat %s: %w", block.SeatID.String(), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *seatBlockRepository) FindBySchedule(ctx context.Context, scheduleID uuid.UUID) ([]*entity.SeatBlock, error) {
+	query := `
+		SELECT id, schedule_id, seat_id, reason, note, blocked_by, created_at
+		FROM seat_blocks
+		WHERE schedule_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, scheduleID)
+	if err != nil {
+		r.log.Error("Failed to find seat blocks",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID.String()),
+		)
+		return nil, fmt.Errorf("find seat blocks for schedule %s: %w", scheduleID.String(), err)
+	}
+	defer rows.Close()
+
+	var blocks []*entity.SeatBlock
+	for rows.Next() {
+		var block entity.SeatBlock
+		if err := rows.Scan(
+			&block.ID,
+			&block.ScheduleID,
+			&block.SeatID,
+			&block.Reason,
+			&block.Note,
+			&block.BlockedBy,
+			&block.CreatedAt,
+		); err != nil {
+			r.log.Error("Failed to scan seat block row", zap.Error(err))
+			return nil, fmt.Errorf("scan seat block row: %w", err)
+		}
+		blocks = append(blocks, &block)
+	}
+
+	return blocks, rows.Err()
+}
+
+func (r *seatBlockRepository) DeleteBySeats(ctx context.Context, scheduleID uuid.UUID, seatIDs []uuid.UUID) (int64, error) {
+	query := `DELETE FROM seat_blocks WHERE schedule_id = $1 AND seat_id = ANY($2)`
+
+	result, err := r.db.Exec(ctx, query, scheduleID, seatIDs)
+	if err != nil {
+		r.log.Error("Failed to delete seat blocks",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID.String()),
+		)
+		return 0, fmt.Errorf("unblock seats for schedule %s: %w", scheduleID.String(), err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type SeatHoldRepository interface {
+	CreateBatch(ctx context.Context, holds []*entity.SeatHold) error
+	FindActiveBySchedule(ctx context.Context, scheduleID uuid.UUID, now time.Time) ([]*entity.SeatHold, error)
+	FindByWaitlistEntry(ctx context.Context, entryID uuid.UUID) ([]*entity.SeatHold, error)
+	DeleteByWaitlistEntry(ctx context.Context, entryID uuid.UUID) error
+	// DeleteExpiredBySchedule clears stale holds sebelum seat di-hold ulang
+	DeleteExpiredBySchedule(ctx context.Context, scheduleID uuid.UUID, now time.Time) error
+}
+
+type seatHoldRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewSeatHoldRepository(db database.PgxIface, log *zap.Logger) SeatHoldRepository {
+	return &seatHoldRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "seat_hold")),
+	}
+}
+
+func (r *seatHoldRepository) CreateBatch(ctx context.Context, holds []*entity.SeatHold) error {
+	query := `
+		INSERT INTO seat_holds (id, schedule_id, seat_id, user_id, waitlist_entry_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	for _, hold := range holds {
+		_, err := r.db.Exec(ctx, query,
+			hold.ID,
+			hold.ScheduleID,
+			hold.SeatID,
+			hold.UserID,
+			hold.WaitlistEntryID,
+			hold.ExpiresAt,
+			hold.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to create seat hold",
+				zap.Error(err),
+				zap.String("schedule_id", hold.ScheduleID.String()),
+				zap.String("seat_id", hold.SeatID.String()),
+			)
+			return fmt.Errorf("create seat hold for seat %s: %w", hold.SeatID.String(), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *seatHoldRepository) FindActiveBySchedule(ctx context.Context, scheduleID uuid.UUID, now time.Time) ([]*entity.SeatHold, error) {
+	query := `
+		SELECT id, schedule_id, seat_id, user_id, waitlist_entry_id, expires_at, created_at
+		FROM seat_holds
+		WHERE schedule_id = $1 AND expires_at > $2
+	`
+
+	rows, err := r.db.Query(ctx, query, scheduleID, now)
+	if err != nil {
+		r.log.Error("Failed to find active seat holds",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID.String()),
+		)
+		return nil, fmt.Errorf("find active seat holds for schedule %s: %w", scheduleID.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanHolds(rows)
+}
+
+func (r *seatHoldRepository) FindByWaitlistEntry(ctx context.Context, entryID uuid.UUID) ([]*entity.SeatHold, error) {
+	query := `
+		SELECT id, schedule_id, seat_id, user_id, waitlist_entry_id, expires_at, created_at
+		FROM seat_holds
+		WHERE waitlist_entry_id = $1
+	`
+
+	rows, err := r.db.Query(ctx, query, entryID)
+	if err != nil {
+		r.log.Error("Failed to find seat holds by waitlist entry",
+			zap.Error(err),
+			zap.String("waitlist_id", entryID.String()),
+		)
+		return nil, fmt.Errorf("find seat holds for waitlist entry %s: %w", entryID.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanHolds(rows)
+}
+
+func (r *seatHoldRepository) DeleteByWaitlistEntry(ctx context.Context, entryID uuid.UUID) error {
+	query := `DELETE FROM seat_holds WHERE waitlist_entry_id = $1`
+
+	if _, err := r.db.Exec(ctx, query, entryID); err != nil {
+		r.log.Error("Failed to delete seat holds",
+			zap.Error(err),
+			zap.String("waitlist_id", entryID.String()),
+		)
+		return fmt.Errorf("delete seat holds for waitlist entry %s: %w", entryID.String(), err)
+	}
+
+	return nil
+}
+
+func (r *seatHoldRepository) DeleteExpiredBySchedule(ctx context.Context, scheduleID uuid.UUID, now time.Time) error {
+	query := `DELETE FROM seat_holds WHERE schedule_id = $1 AND expires_at <= $2`
+
+	if _, err := r.db.Exec(ctx, query, scheduleID, now); err != nil {
+		r.log.Error("Failed to delete expired seat holds",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID.String()),
+		)
+		return fmt.Errorf("delete expired seat holds for schedule %s: %w", scheduleID.String(), err)
+	}
+
+	return nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func (r *seatHoldRepository) scanHolds(rows pgx.Rows) ([]*entity.SeatHold, error) {
+	var holds []*entity.SeatHold
+	for rows.Next() {
+		var hold entity.SeatHold
+		err := rows.Scan(
+			&hold.ID,
+			&hold.ScheduleID,
+			&hold.SeatID,
+			&hold.UserID,
+			&hold.WaitlistEntryID,
+			&hold.ExpiresAt,
+			&hold.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan seat hold row", zap.Error(err))
+			return nil, fmt.Errorf("scan seat hold row: %w", err)
+		}
+		holds = append(holds, &hold)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate seat hold rows: %w", err)
+	}
+
+	return holds, nil
+}
@@ -19,6 +19,8 @@ type SeatRepository interface {
 	FindAvailableByHallID(ctx context.Context, hallID uuid.UUID) ([]*entity.Seat, error)
 	Update(ctx context.Context, seat *entity.Seat) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// SetAvailability blocks/unblocks kursi di hall untuk semua schedule; kursi di hall lain tidak tersentuh
+	SetAvailability(ctx context.Context, hallID uuid.UUID, seatIDs []uuid.UUID, available bool) (int64, error)
 
 	// Batch operations
 	CreateBatch(ctx context.Context, seats []*entity.Seat) error
@@ -214,6 +216,26 @@ func (r *seatRepository) Update(ctx context.Context, seat *entity.Seat) error {
 	return nil
 }
 
+func (r *seatRepository) SetAvailability(ctx context.Context, hallID uuid.UUID, seatIDs []uuid.UUID, available bool) (int64, error) {
+	query := `
+		UPDATE seats
+		SET is_available = $3, updated_at = NOW()
+		WHERE hall_id = $1 AND id = ANY($2) AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, hallID, seatIDs, available)
+	if err != nil {
+		r.log.Error("Failed to set seat availability",
+			zap.Error(err),
+			zap.String("hall_id", hallID.String()),
+			zap.Bool("available", available),
+		)
+		return 0, fmt.Errorf("set seat availability in hall %s: %w", hallID.String(), err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
 func (r *seatRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `UPDATE seats SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 
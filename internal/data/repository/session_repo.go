@@ -3,10 +3,13 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/pkg/database"
+	"cinema-booking/pkg/utils"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
@@ -15,6 +18,10 @@ type SessionRepository interface {
 	Create(ctx context.Context, session *entity.Session) error
 	FindValidSession(ctx context.Context, token string) (*entity.Session, error)
 	Revoke(ctx context.Context, token string) error
+	// FindByUserID returns semua session user termasuk yang expired / revoked, terbaru dulu
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+	// DeleteExpired menghapus session yang expired atau di-revoke sebelum before, maksimal limit baris
+	DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error)
 }
 
 type sessionRepository struct {
@@ -32,8 +39,8 @@ func NewSessionRepository(db database.PgxIface, log *zap.Logger) SessionReposito
 func (r *sessionRepository) Create(ctx context.Context, session *entity.Session) error {
 	query := `
 		INSERT INTO sessions (id, user_id, token, user_agent, ip_address,
-		                     expires_at, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		                     expires_at, created_at, impersonator_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
 	_, err := r.db.Exec(ctx, query,
@@ -44,13 +51,14 @@ func (r *sessionRepository) Create(ctx context.Context, session *entity.Session)
 		session.IPAddress,
 		session.ExpiresAt,
 		session.CreatedAt,
+		session.ImpersonatorID,
 	)
 
 	if err != nil {
 		r.log.Error("Failed to create session",
 			zap.Error(err),
 			zap.String("user_id", session.UserID.String()),
-			zap.String("token", session.Token.String()),
+			utils.TokenField(session.Token.String()),
 		)
 		return fmt.Errorf("create session for user %s: %w", session.UserID.String(), err)
 	}
@@ -61,7 +69,7 @@ func (r *sessionRepository) Create(ctx context.Context, session *entity.Session)
 func (r *sessionRepository) FindValidSession(ctx context.Context, token string) (*entity.Session, error) {
 	query := `
 		SELECT id, user_id, token, user_agent, ip_address,
-		       expires_at, revoked_at, created_at
+		       expires_at, revoked_at, created_at, impersonator_id
 		FROM sessions
 		WHERE token = $1
 		  AND revoked_at IS NULL
@@ -78,6 +86,7 @@ func (r *sessionRepository) FindValidSession(ctx context.Context, token string)
 		&session.ExpiresAt,
 		&session.RevokedAt,
 		&session.CreatedAt,
+		&session.ImpersonatorID,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -86,7 +95,7 @@ func (r *sessionRepository) FindValidSession(ctx context.Context, token string)
 	if err != nil {
 		r.log.Error("Failed to find valid session",
 			zap.Error(err),
-			zap.String("token", token),
+			utils.TokenField(token),
 		)
 		return nil, fmt.Errorf("find valid session for token %s: %w", token, err)
 	}
@@ -105,7 +114,7 @@ func (r *sessionRepository) Revoke(ctx context.Context, token string) error {
 	if err != nil {
 		r.log.Error("Failed to revoke session",
 			zap.Error(err),
-			zap.String("token", token),
+			utils.TokenField(token),
 		)
 		return fmt.Errorf("revoke session token %s: %w", token, err)
 	}
@@ -116,3 +125,70 @@ func (r *sessionRepository) Revoke(ctx context.Context, token string) error {
 
 	return nil
 }
+
+func (r *sessionRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	query := `
+		SELECT id, user_id, token, user_agent, ip_address,
+		       expires_at, revoked_at, created_at, impersonator_id
+		FROM sessions
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to find sessions by user ID",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find sessions for user %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	var sessions []*entity.Session
+	for rows.Next() {
+		var session entity.Session
+		err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.Token,
+			&session.UserAgent,
+			&session.IPAddress,
+			&session.ExpiresAt,
+			&session.RevokedAt,
+			&session.CreatedAt,
+			&session.ImpersonatorID,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan session row", zap.Error(err))
+			return nil, fmt.Errorf("scan session row: %w", err)
+		}
+		sessions = append(sessions, &session)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func (r *sessionRepository) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM sessions
+		WHERE id IN (
+			SELECT id FROM sessions
+			WHERE expires_at < $1 OR revoked_at < $1
+			LIMIT $2
+		)
+	`
+
+	result, err := r.db.Exec(ctx, query, before, limit)
+	if err != nil {
+		r.log.Error("Failed to delete expired sessions", zap.Error(err))
+		return 0, fmt.Errorf("delete expired sessions: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
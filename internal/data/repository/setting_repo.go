@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"go.uber.org/zap"
+)
+
+// SettingRepository override setting aplikasi yang diubah admin
+type SettingRepository interface {
+	FindAll(ctx context.Context) ([]*entity.AppSetting, error)
+	Upsert(ctx context.Context, setting *entity.AppSetting) error
+	// Delete menghapus override sehingga setting kembali ke default config
+	Delete(ctx context.Context, key string) error
+}
+
+type settingRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewSettingRepository(db database.PgxIface, log *zap.Logger) SettingRepository {
+	return &settingRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "setting")),
+	}
+}
+
+func (r *settingRepository) FindAll(ctx context.Context) ([]*entity.AppSetting, error) {
+	query := `SELECT key, value, updated_by, updated_at FROM app_settings ORDER BY key`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find app settings", zap.Error(err))
+		return nil, fmt.Errorf("find app settings: %w", err)
+	}
+	defer rows.Close()
+
+	settings := []*entity.AppSetting{}
+	for rows.Next() {
+		var setting entity.AppSetting
+		if err := rows.Scan(&setting.Key, &setting.Value, &setting.UpdatedBy, &setting.UpdatedAt); err != nil {
+			r.log.Error("Failed to scan app setting row", zap.Error(err))
+			return nil, fmt.Errorf("scan app setting row: %w", err)
+		}
+		settings = append(settings, &setting)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate app setting rows: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (r *settingRepository) Upsert(ctx context.Context, setting *entity.AppSetting) error {
+	query := `
+		INSERT INTO app_settings (key, value, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key) DO UPDATE
+		SET value = EXCLUDED.value, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query, setting.Key, setting.Value, setting.UpdatedBy, setting.UpdatedAt)
+	if err != nil {
+		r.log.Error("Failed to upsert app setting",
+			zap.Error(err),
+			zap.String("key", setting.Key),
+		)
+		return fmt.Errorf("upsert app setting %s: %w", setting.Key, err)
+	}
+
+	return nil
+}
+
+func (r *settingRepository) Delete(ctx context.Context, key string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM app_settings WHERE key = $1`, key); err != nil {
+		r.log.Error("Failed to delete app setting",
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		return fmt.Errorf("delete app setting %s: %w", key, err)
+	}
+
+	return nil
+}
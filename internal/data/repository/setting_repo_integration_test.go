@@ -0,0 +1,66 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+
+	"github.com/google/uuid"
+)
+
+func TestSettingRepository_UpsertAndDelete(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	admin := newTestUser(t)
+	key := "it_setting_" + uuid.NewString()[:8]
+
+	setting := &entity.AppSetting{Key: key, Value: "15", UpdatedBy: &admin.ID, UpdatedAt: now}
+	if err := testRepo.Setting.Upsert(ctx, setting); err != nil {
+		t.Fatalf("insert setting: %v", err)
+	}
+
+	setting.Value = "30"
+	setting.UpdatedAt = now.Add(time.Minute)
+	if err := testRepo.Setting.Upsert(ctx, setting); err != nil {
+		t.Fatalf("update setting: %v", err)
+	}
+
+	got := findSetting(t, key)
+	if got == nil || got.Value != "30" || got.UpdatedBy == nil || *got.UpdatedBy != admin.ID {
+		t.Fatalf("stored setting = %+v, want value 30 updated by %s", got, admin.ID)
+	}
+	if !got.UpdatedAt.Equal(setting.UpdatedAt) {
+		t.Fatalf("updated_at = %s, want %s", got.UpdatedAt, setting.UpdatedAt)
+	}
+
+	if err := testRepo.Setting.Delete(ctx, key); err != nil {
+		t.Fatalf("delete setting: %v", err)
+	}
+	if got := findSetting(t, key); got != nil {
+		t.Fatalf("setting %s still present after delete: %+v", key, got)
+	}
+
+	// Menghapus override yang tidak ada bukan error
+	if err := testRepo.Setting.Delete(ctx, key); err != nil {
+		t.Fatalf("delete missing setting: %v", err)
+	}
+}
+
+func findSetting(t *testing.T, key string) *entity.AppSetting {
+	t.Helper()
+
+	settings, err := testRepo.Setting.FindAll(context.Background())
+	if err != nil {
+		t.Fatalf("find settings: %v", err)
+	}
+	for _, setting := range settings {
+		if setting.Key == key {
+			return setting
+		}
+	}
+	return nil
+}
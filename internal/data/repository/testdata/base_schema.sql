@@ -0,0 +1,161 @@
+-- Skema awal sebelum migrations/000001, direkonstruksi dari repository versi pertama.
+-- Hanya dipakai integration test; database production sudah punya tabel-tabel ini.
+CREATE TABLE IF NOT EXISTS users (
+    id             UUID PRIMARY KEY,
+    username       VARCHAR(50)  NOT NULL UNIQUE,
+    email          VARCHAR(255) NOT NULL UNIQUE,
+    password       VARCHAR(255) NOT NULL,
+    phone          VARCHAR(20),
+    role           VARCHAR(20)  NOT NULL DEFAULT 'customer' CHECK (role IN ('customer', 'admin')),
+    email_verified BOOLEAN      NOT NULL DEFAULT FALSE,
+    is_active      BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at     TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP    NOT NULL DEFAULT NOW(),
+    deleted_at     TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS sessions (
+    id         UUID PRIMARY KEY,
+    user_id    UUID      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token      UUID      NOT NULL UNIQUE,
+    user_agent TEXT,
+    ip_address VARCHAR(45),
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS otps (
+    id         UUID PRIMARY KEY,
+    user_id    UUID         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email      VARCHAR(255) NOT NULL,
+    otp_code   VARCHAR(10)  NOT NULL,
+    otp_type   VARCHAR(30)  NOT NULL CHECK (otp_type IN ('email_verification', 'password_reset')),
+    expires_at TIMESTAMP    NOT NULL,
+    is_used    BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS movies (
+    id                  UUID PRIMARY KEY,
+    title               VARCHAR(255)  NOT NULL,
+    description         TEXT,
+    poster_url          TEXT,
+    rating              NUMERIC(3, 2) NOT NULL DEFAULT 0,
+    release_date        DATE          NOT NULL,
+    duration_in_minutes INT           NOT NULL CHECK (duration_in_minutes > 0),
+    release_status      VARCHAR(20)   NOT NULL CHECK (release_status IN ('coming_soon', 'now_playing')),
+    created_at          TIMESTAMP     NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMP     NOT NULL DEFAULT NOW(),
+    deleted_at          TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS genres (
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(50) NOT NULL UNIQUE,
+    created_at TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS movie_genres (
+    id         UUID PRIMARY KEY,
+    movie_id   UUID      NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    genre_id   UUID      NOT NULL REFERENCES genres(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (movie_id, genre_id)
+);
+
+CREATE TABLE IF NOT EXISTS cinemas (
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    location   TEXT         NOT NULL,
+    city       VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS halls (
+    id          UUID PRIMARY KEY,
+    cinema_id   UUID      NOT NULL REFERENCES cinemas(id),
+    hall_number INT       NOT NULL,
+    total_seats INT       NOT NULL CHECK (total_seats >= 0),
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS seats (
+    id           UUID PRIMARY KEY,
+    hall_id      UUID        NOT NULL REFERENCES halls(id),
+    seat_number  VARCHAR(10) NOT NULL,
+    seat_row     VARCHAR(5)  NOT NULL,
+    seat_column  INT         NOT NULL,
+    is_available BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+    deleted_at   TIMESTAMP,
+    UNIQUE (hall_id, seat_number)
+);
+
+CREATE TABLE IF NOT EXISTS schedules (
+    id         UUID PRIMARY KEY,
+    movie_id   UUID           NOT NULL REFERENCES movies(id),
+    hall_id    UUID           NOT NULL REFERENCES halls(id),
+    show_date  DATE           NOT NULL,
+    show_time  TIME           NOT NULL,
+    price      NUMERIC(10, 2) NOT NULL CHECK (price >= 0),
+    created_at TIMESTAMP      NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP      NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS payment_methods (
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(100) NOT NULL,
+    is_active  BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS bookings (
+    id          UUID PRIMARY KEY,
+    order_id    VARCHAR(50)    NOT NULL,
+    user_id     UUID           NOT NULL REFERENCES users(id),
+    schedule_id UUID           NOT NULL REFERENCES schedules(id),
+    total_seats INT            NOT NULL CHECK (total_seats > 0),
+    total_price NUMERIC(10, 2) NOT NULL,
+    status      VARCHAR(20)    NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'confirmed', 'cancelled', 'expired')),
+    created_at  TIMESTAMP      NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_order_id ON bookings(order_id);
+
+CREATE TABLE IF NOT EXISTS booking_seats (
+    id         UUID PRIMARY KEY,
+    booking_id UUID      NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    seat_id    UUID      NOT NULL REFERENCES seats(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS payments (
+    id                UUID PRIMARY KEY,
+    booking_id        UUID           NOT NULL REFERENCES bookings(id),
+    payment_method_id UUID           NOT NULL REFERENCES payment_methods(id),
+    amount            NUMERIC(10, 2) NOT NULL,
+    status            VARCHAR(20)    NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'completed', 'failed')),
+    transaction_id    VARCHAR(255),
+    created_at        TIMESTAMP      NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMP      NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id         UUID PRIMARY KEY,
+    user_id    UUID      NOT NULL REFERENCES users(id),
+    movie_id   UUID      NOT NULL REFERENCES movies(id),
+    rating     INT       NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment    TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
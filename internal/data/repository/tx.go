@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cinema-booking/pkg/database"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// TxManager is the unit of work untuk operasi yang menyentuh beberapa repository.
+// Repository di dalam fn terikat ke transaction yang sama; WithTx bersarang jadi savepoint.
+type TxManager interface {
+	WithTx(ctx context.Context, fn func(tx *Repository) error) error
+}
+
+var _ TxManager = (*Repository)(nil)
+
+// WithTx runs fn with repositories bound to a single transaction.
+// Commit kalau fn return nil, selain itu (termasuk panic) di-rollback.
+func (r *Repository) WithTx(ctx context.Context, fn func(tx *Repository) error) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+		if err != nil {
+			// Commit yang gagal sudah menutup tx, jadi ErrTxClosed diabaikan
+			if rbErr := tx.Rollback(ctx); rbErr != nil && !errors.Is(rbErr, pgx.ErrTxClosed) {
+				r.log.Error("Failed to rollback transaction", zap.Error(rbErr))
+			}
+		}
+	}()
+
+	if err = fn(NewRepository(database.NewTxDB(tx), r.log)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
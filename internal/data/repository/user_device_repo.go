@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type UserDeviceRepository interface {
+	Upsert(ctx context.Context, device *entity.UserDevice) error
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserDevice, error)
+	DeleteByToken(ctx context.Context, userID uuid.UUID, token string) error
+}
+
+type userDeviceRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewUserDeviceRepository(db database.PgxIface, log *zap.Logger) UserDeviceRepository {
+	return &userDeviceRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "user_device")),
+	}
+}
+
+// Upsert registers a device token, memindahkan token ke user baru kalau sudah terdaftar
+func (r *userDeviceRepository) Upsert(ctx context.Context, device *entity.UserDevice) error {
+	query := `
+		INSERT INTO user_devices (id, user_id, token, platform, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (token) DO UPDATE
+		SET user_id = EXCLUDED.user_id,
+		    platform = EXCLUDED.platform,
+		    updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		device.ID,
+		device.UserID,
+		device.Token,
+		device.Platform,
+		device.CreatedAt,
+		device.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to upsert user device",
+			zap.Error(err),
+			zap.String("user_id", device.UserID.String()),
+			zap.String("platform", string(device.Platform)),
+		)
+		return fmt.Errorf("upsert device for user %s: %w", device.UserID.String(), err)
+	}
+
+	return nil
+}
+
+func (r *userDeviceRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserDevice, error) {
+	query := `
+		SELECT id, user_id, token, platform, created_at, updated_at
+		FROM user_devices
+		WHERE user_id = $1
+		ORDER BY updated_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to find devices by user ID",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find devices by user ID %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	var devices []*entity.UserDevice
+	for rows.Next() {
+		var device entity.UserDevice
+		err := rows.Scan(
+			&device.ID,
+			&device.UserID,
+			&device.Token,
+			&device.Platform,
+			&device.CreatedAt,
+			&device.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan device row", zap.Error(err))
+			return nil, fmt.Errorf("scan device row: %w", err)
+		}
+		devices = append(devices, &device)
+	}
+
+	return devices, nil
+}
+
+func (r *userDeviceRepository) DeleteByToken(ctx context.Context, userID uuid.UUID, token string) error {
+	query := `DELETE FROM user_devices WHERE user_id = $1 AND token = $2`
+
+	result, err := r.db.Exec(ctx, query, userID, token)
+	if err != nil {
+		r.log.Error("Failed to delete device",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return fmt.Errorf("delete device for user %s: %w", userID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("device not found")
+	}
+
+	return nil
+}
@@ -3,9 +3,12 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/pkg/database"
+	"cinema-booking/pkg/utils"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -17,10 +20,87 @@ type UserRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 	FindByUsername(ctx context.Context, username string) (*entity.User, error)
-	FindAll(ctx context.Context, limit, offset int) ([]*entity.User, error)
-	CountAll(ctx context.Context) (int64, error)
+	FindAll(ctx context.Context, filter UserFilter, limit, offset int) ([]*entity.User, error)
+	CountAll(ctx context.Context, filter UserFilter) (int64, error)
+	FindAllAfter(ctx context.Context, filter UserFilter, cursor *Cursor, limit int) ([]*entity.User, error)
+	// Update tidak menulis tier; tier hanya diubah lewat UpdateTiers
 	Update(ctx context.Context, user *entity.User) error
+	// UpdateTiers menghitung ulang tier semua customer aktif dari spend di ledger, returns jumlah user
+	// yang tier-nya berubah
+	UpdateTiers(ctx context.Context, rule TierRule, now time.Time) (int64, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) error
+}
+
+// UserFilter search user di list admin; nil field = tidak difilter. Email, Username dan Phone
+// partial match tanpa beda huruf besar/kecil (dibantu index trigram), sisanya exact.
+type UserFilter struct {
+	IncludeDeleted bool
+	Email          *string
+	Username       *string
+	Phone          *string
+	Role           *entity.UserRole
+	EmailVerified  *bool
+	IsActive       *bool
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time // eksklusif
+}
+
+// sql builds the filter conditions, placeholder dimulai dari $argStart. Kondisi hanya ditulis
+// untuk field yang diisi supaya planner bisa memakai index yang cocok.
+func (f UserFilter) sql(argStart int) (string, []any) {
+	var where strings.Builder
+	args := []any{}
+	add := func(condition string, value any) {
+		where.WriteString(fmt.Sprintf(" AND "+condition, argStart+len(args)))
+		args = append(args, value)
+	}
+
+	if !f.IncludeDeleted {
+		where.WriteString(" AND deleted_at IS NULL")
+	}
+	if f.Email != nil {
+		add("email ILIKE $%d", containsPattern(*f.Email))
+	}
+	if f.Username != nil {
+		add("username ILIKE $%d", containsPattern(*f.Username))
+	}
+	if f.Phone != nil {
+		add("phone ILIKE $%d", containsPattern(*f.Phone))
+	}
+	if f.Role != nil {
+		add("role = $%d", string(*f.Role))
+	}
+	if f.EmailVerified != nil {
+		add("email_verified = $%d", *f.EmailVerified)
+	}
+	if f.IsActive != nil {
+		add("is_active = $%d", *f.IsActive)
+	}
+	if f.CreatedFrom != nil {
+		add("created_at >= $%d", *f.CreatedFrom)
+	}
+	if f.CreatedTo != nil {
+		add("created_at < $%d", *f.CreatedTo)
+	}
+
+	return where.String(), args
+}
+
+// containsPattern pola ILIKE "mengandung value"; % dan _ dari input di-escape supaya dicari apa adanya
+func containsPattern(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+	return "%" + escaped + "%"
+}
+
+// TierRule parameter UpdateTiers: spend bersih (penjualan dikurangi refund) di akun penjualan ledger
+// dalam Currency sejak Since, dibandingkan dengan batas minimum tiap tier (minor unit)
+type TierRule struct {
+	Currency      string
+	Since         time.Time
+	SilverSpend   int64
+	GoldSpend     int64
+	PlatinumSpend int64
 }
 
 type userRepository struct {
@@ -40,8 +120,8 @@ func (ur *userRepository) Create(ctx context.Context, user *entity.User) error {
 	// SQL query
 	query := `
 		INSERT INTO users (id, username, email, password, phone, role,
-		                  email_verified, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		                  email_verified, is_active, language, organization_id, date_of_birth, tier, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
 
 	// Execute query
@@ -54,6 +134,10 @@ func (ur *userRepository) Create(ctx context.Context, user *entity.User) error {
 		user.Role,
 		user.EmailVerified,
 		user.IsActive,
+		user.Language,
+		user.OrganizationID,
+		user.DateOfBirth,
+		user.Tier,
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
@@ -61,10 +145,10 @@ func (ur *userRepository) Create(ctx context.Context, user *entity.User) error {
 	if err != nil {
 		ur.log.Error("Failed to create user",
 			zap.Error(err),
-			zap.String("email", user.Email),
+			utils.EmailField(user.Email),
 			zap.String("username", user.Username),
 		)
-		return fmt.Errorf("create user %s: %w", user.Email, err)
+		return fmt.Errorf("create user %s: %w", utils.MaskEmail(user.Email), err)
 	}
 
 	return nil
@@ -73,7 +157,7 @@ func (ur *userRepository) Create(ctx context.Context, user *entity.User) error {
 func (ur *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	query := `
 		SELECT id, username, email, password, phone, role,
-		       email_verified, is_active, created_at, updated_at, deleted_at
+		       email_verified, is_active, language, organization_id, date_of_birth, tier, created_at, updated_at, deleted_at
 		FROM users
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -89,6 +173,10 @@ func (ur *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.U
 		&user.Role,
 		&user.EmailVerified,
 		&user.IsActive,
+		&user.Language,
+		&user.OrganizationID,
+		&user.DateOfBirth,
+		&user.Tier,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.DeletedAt,
@@ -111,7 +199,7 @@ func (ur *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.U
 func (ur *userRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	query := `
 		SELECT id, username, email, password, phone, role,
-		       email_verified, is_active, created_at, updated_at, deleted_at
+		       email_verified, is_active, language, organization_id, date_of_birth, tier, created_at, updated_at, deleted_at
 		FROM users
 		WHERE email = $1 AND deleted_at IS NULL
 	`
@@ -127,6 +215,10 @@ func (ur *userRepository) FindByEmail(ctx context.Context, email string) (*entit
 		&user.Role,
 		&user.EmailVerified,
 		&user.IsActive,
+		&user.Language,
+		&user.OrganizationID,
+		&user.DateOfBirth,
+		&user.Tier,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.DeletedAt,
@@ -138,9 +230,9 @@ func (ur *userRepository) FindByEmail(ctx context.Context, email string) (*entit
 	if err != nil {
 		ur.log.Error("Failed to find user by email",
 			zap.Error(err),
-			zap.String("email", email),
+			utils.EmailField(email),
 		)
-		return nil, fmt.Errorf("find user by email %s: %w", email, err)
+		return nil, fmt.Errorf("find user by email %s: %w", utils.MaskEmail(email), err)
 	}
 
 	return &user, nil
@@ -149,7 +241,7 @@ func (ur *userRepository) FindByEmail(ctx context.Context, email string) (*entit
 func (ur *userRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
 	query := `
 		SELECT id, username, email, password, phone, role,
-		       email_verified, is_active, created_at, updated_at, deleted_at
+		       email_verified, is_active, language, organization_id, date_of_birth, tier, created_at, updated_at, deleted_at
 		FROM users
 		WHERE username = $1 AND deleted_at IS NULL
 	`
@@ -165,6 +257,10 @@ func (ur *userRepository) FindByUsername(ctx context.Context, username string) (
 		&user.Role,
 		&user.EmailVerified,
 		&user.IsActive,
+		&user.Language,
+		&user.OrganizationID,
+		&user.DateOfBirth,
+		&user.Tier,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.DeletedAt,
@@ -184,19 +280,20 @@ func (ur *userRepository) FindByUsername(ctx context.Context, username string) (
 	return &user, nil
 }
 
-// FindAll retrieves paginated list of users
-func (ur *userRepository) FindAll(ctx context.Context, limit, offset int) ([]*entity.User, error) {
+// FindAll retrieves paginated list of users yang cocok dengan filter
+func (ur *userRepository) FindAll(ctx context.Context, filter UserFilter, limit, offset int) ([]*entity.User, error) {
+	where, args := filter.sql(3)
 	query := `
 		SELECT id, username, email, password, phone, role,
-		       email_verified, is_active, created_at, updated_at
+		       email_verified, is_active, language, organization_id, date_of_birth, tier, created_at, updated_at, deleted_at
 		FROM users
-		WHERE deleted_at IS NULL
-		ORDER BY created_at DESC
+		WHERE TRUE` + where + `
+		ORDER BY created_at DESC, id DESC
 		LIMIT $1 OFFSET $2
 	`
 
 	// Query returns multiple rows
-	rows, err := ur.db.Query(ctx, query, limit, offset)
+	rows, err := ur.db.Query(ctx, query, append([]any{limit, offset}, args...)...)
 	if err != nil {
 		ur.log.Error("Failed to get all users",
 			zap.Error(err),
@@ -221,8 +318,13 @@ func (ur *userRepository) FindAll(ctx context.Context, limit, offset int) ([]*en
 			&user.Role,
 			&user.EmailVerified,
 			&user.IsActive,
+			&user.Language,
+			&user.OrganizationID,
+			&user.DateOfBirth,
+			&user.Tier,
 			&user.CreatedAt,
 			&user.UpdatedAt,
+			&user.DeletedAt,
 		)
 		if err != nil {
 			ur.log.Error("Failed to scan user row", zap.Error(err))
@@ -240,11 +342,70 @@ func (ur *userRepository) FindAll(ctx context.Context, limit, offset int) ([]*en
 	return users, nil
 }
 
-func (ur *userRepository) CountAll(ctx context.Context) (int64, error) {
-	query := `SELECT COUNT(*) FROM users WHERE deleted_at IS NULL`
+// FindAllAfter is the keyset variant of FindAll, ordered by (created_at DESC, id DESC)
+func (ur *userRepository) FindAllAfter(ctx context.Context, filter UserFilter, cursor *Cursor, limit int) ([]*entity.User, error) {
+	where, args := filter.sql(4)
+	query := `
+		SELECT id, username, email, password, phone, role,
+		       email_verified, is_active, language, organization_id, date_of_birth, tier, created_at, updated_at, deleted_at
+		FROM users
+		WHERE ($1::timestamp IS NULL OR (created_at, id) < ($1::timestamp, $2::uuid))` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3
+	`
+
+	createdAt, id := cursorArgs(cursor)
+	rows, err := ur.db.Query(ctx, query, append([]any{createdAt, id, limit}, args...)...)
+	if err != nil {
+		ur.log.Error("Failed to get users after cursor",
+			zap.Error(err),
+			zap.Int("limit", limit),
+		)
+		return nil, fmt.Errorf("find all users after cursor: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*entity.User
+	for rows.Next() {
+		var user entity.User
+		err := rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.Email,
+			&user.PasswordHash,
+			&user.Phone,
+			&user.Role,
+			&user.EmailVerified,
+			&user.IsActive,
+			&user.Language,
+			&user.OrganizationID,
+			&user.DateOfBirth,
+			&user.Tier,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+			&user.DeletedAt,
+		)
+		if err != nil {
+			ur.log.Error("Failed to scan user row", zap.Error(err))
+			return nil, fmt.Errorf("scan user row: %w", err)
+		}
+		users = append(users, &user)
+	}
+
+	if err := rows.Err(); err != nil {
+		ur.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate users rows: %w", err)
+	}
+
+	return users, nil
+}
+
+func (ur *userRepository) CountAll(ctx context.Context, filter UserFilter) (int64, error) {
+	where, args := filter.sql(1)
+	query := `SELECT COUNT(*) FROM users WHERE TRUE` + where
 
 	var count int64
-	err := ur.db.QueryRow(ctx, query).Scan(&count)
+	err := ur.db.QueryRow(ctx, query, args...).Scan(&count)
 	if err != nil {
 		ur.log.Error("Database error counting users",
 			zap.Error(err),
@@ -260,7 +421,7 @@ func (ur *userRepository) Update(ctx context.Context, user *entity.User) error {
 		UPDATE users
 		SET username = $2, email = $3, password = $4, phone = $5,
 		    role = $6, email_verified = $7, is_active = $8,
-		    updated_at = $9
+		    language = $9, organization_id = $10, date_of_birth = $11, updated_at = $12
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
@@ -274,6 +435,9 @@ func (ur *userRepository) Update(ctx context.Context, user *entity.User) error {
 		user.Role,
 		user.EmailVerified,
 		user.IsActive,
+		user.Language,
+		user.OrganizationID,
+		user.DateOfBirth,
 		user.UpdatedAt,
 	)
 
@@ -281,7 +445,7 @@ func (ur *userRepository) Update(ctx context.Context, user *entity.User) error {
 		ur.log.Error("Failed to update user",
 			zap.Error(err),
 			zap.String("user_id", user.ID.String()),
-			zap.String("email", user.Email),
+			utils.EmailField(user.Email),
 		)
 		return fmt.Errorf("update user %s: %w", user.ID.String(), err)
 	}
@@ -314,3 +478,68 @@ func (ur *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	ur.log.Info("User deleted", zap.String("id", id.String()))
 	return nil
 }
+
+func (ur *userRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE users SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	result, err := ur.db.Exec(ctx, query, id)
+	if err != nil {
+		ur.log.Error("Failed to restore user",
+			zap.Error(err),
+			zap.String("id", id.String()),
+		)
+		return fmt.Errorf("restore user %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user %s not found or not deleted", id.String())
+	}
+
+	ur.log.Info("User restored", zap.String("id", id.String()))
+	return nil
+}
+
+func (ur *userRepository) UpdateTiers(ctx context.Context, rule TierRule, now time.Time) (int64, error) {
+	// Hanya baris yang tier-nya berubah yang di-update, jadi run tanpa perubahan tidak menulis apa pun
+	query := `
+		WITH spend AS (
+			SELECT b.user_id, SUM(e.credit - e.debit) AS amount
+			FROM ledger_transactions t
+			INNER JOIN ledger_entries e ON e.transaction_id = t.id
+			INNER JOIN bookings b ON b.id = t.booking_id
+			WHERE e.account = ANY($1::text[]) AND t.currency = $2 AND t.occurred_at >= $3
+			GROUP BY b.user_id
+		), computed AS (
+			SELECT u.id,
+			       CASE
+			           WHEN COALESCE(s.amount, 0) >= $6 THEN 'platinum'
+			           WHEN COALESCE(s.amount, 0) >= $5 THEN 'gold'
+			           WHEN COALESCE(s.amount, 0) >= $4 THEN 'silver'
+			           ELSE 'standard'
+			       END AS tier
+			FROM users u
+			LEFT JOIN spend s ON s.user_id = u.id
+			WHERE u.role = 'customer' AND u.deleted_at IS NULL
+		)
+		UPDATE users u
+		SET tier = c.tier, updated_at = $7
+		FROM computed c
+		WHERE u.id = c.id AND u.tier <> c.tier
+	`
+
+	result, err := ur.db.Exec(ctx, query,
+		ledgerSalesAccounts(),
+		rule.Currency,
+		rule.Since,
+		rule.SilverSpend,
+		rule.GoldSpend,
+		rule.PlatinumSpend,
+		now,
+	)
+	if err != nil {
+		ur.log.Error("Failed to update user tiers", zap.Error(err))
+		return 0, fmt.Errorf("update user tiers: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,42 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"cinema-booking/internal/data/entity"
+)
+
+func TestUserRepository_CreateAndFind(t *testing.T) {
+	ctx := context.Background()
+	user := newTestUser(t)
+
+	byEmail, err := testRepo.User.FindByEmail(ctx, user.Email)
+	if err != nil {
+		t.Fatalf("find by email: %v", err)
+	}
+	if byEmail == nil || byEmail.ID != user.ID {
+		t.Fatalf("find by email = %+v, want user %s", byEmail, user.ID)
+	}
+	if byEmail.Tier != entity.TierStandard || byEmail.Language != "en" {
+		t.Fatalf("stored user tier %q language %q, want standard en", byEmail.Tier, byEmail.Language)
+	}
+
+	if err := testRepo.User.Delete(ctx, user.ID); err != nil {
+		t.Fatalf("delete user: %v", err)
+	}
+	deleted, err := testRepo.User.FindByID(ctx, user.ID)
+	if err != nil || deleted != nil {
+		t.Fatalf("find soft-deleted user = %+v, %v; want nil, nil", deleted, err)
+	}
+
+	if err := testRepo.User.Restore(ctx, user.ID); err != nil {
+		t.Fatalf("restore user: %v", err)
+	}
+	restored, err := testRepo.User.FindByID(ctx, user.ID)
+	if err != nil || restored == nil {
+		t.Fatalf("find restored user = %+v, %v", restored, err)
+	}
+}
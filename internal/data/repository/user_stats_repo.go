@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// UserStatsRepository agregat per user untuk detail user admin. Semua query lewat reader karena
+// hanya dibaca support dan tidak perlu data yang baru saja ditulis.
+type UserStatsRepository interface {
+	GetBookingStats(ctx context.Context, userID uuid.UUID) (*entity.UserBookingStats, error)
+	// GetSpend returns total booking yang dibayar (confirmed, checked in) per currency
+	GetSpend(ctx context.Context, userID uuid.UUID) ([]*entity.UserSpend, error)
+	// GetLastLogin returns waktu login terakhir, tanpa session impersonation; nil kalau belum pernah login
+	GetLastLogin(ctx context.Context, userID uuid.UUID) (*time.Time, error)
+	// GetFavoriteGenre returns genre dengan booking dibayar terbanyak; nil kalau belum ada
+	GetFavoriteGenre(ctx context.Context, userID uuid.UUID) (*entity.UserFavoriteGenre, error)
+}
+
+type userStatsRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewUserStatsRepository(db database.PgxIface, log *zap.Logger) UserStatsRepository {
+	return &userStatsRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "user_stats")),
+	}
+}
+
+func (r *userStatsRepository) GetBookingStats(ctx context.Context, userID uuid.UUID) (*entity.UserBookingStats, error) {
+	query := `
+		SELECT COUNT(*),
+		       COUNT(*) FILTER (WHERE status IN ('cancelled', 'refunded')),
+		       MAX(created_at)
+		FROM bookings
+		WHERE user_id = $1 AND deleted_at IS NULL
+	`
+
+	var stats entity.UserBookingStats
+	err := r.db.Reader().QueryRow(ctx, query, userID).Scan(
+		&stats.TotalBookings,
+		&stats.Cancellations,
+		&stats.LastBookingAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to get user booking stats", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("get booking stats for user %s: %w", userID.String(), err)
+	}
+
+	return &stats, nil
+}
+
+func (r *userStatsRepository) GetSpend(ctx context.Context, userID uuid.UUID) ([]*entity.UserSpend, error) {
+	query := `
+		SELECT currency, SUM(total_price)
+		FROM bookings
+		WHERE user_id = $1 AND deleted_at IS NULL AND status IN ('confirmed', 'checked_in')
+		GROUP BY currency
+		ORDER BY currency
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to get user spend", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("get spend for user %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	spend := []*entity.UserSpend{}
+	for rows.Next() {
+		var row entity.UserSpend
+		if err := rows.Scan(&row.Currency, &row.Amount); err != nil {
+			r.log.Error("Failed to scan user spend row", zap.Error(err))
+			return nil, fmt.Errorf("scan user spend row: %w", err)
+		}
+		spend = append(spend, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate user spend rows: %w", err)
+	}
+
+	return spend, nil
+}
+
+func (r *userStatsRepository) GetLastLogin(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	query := `SELECT MAX(created_at) FROM sessions WHERE user_id = $1 AND impersonator_id IS NULL`
+
+	var lastLogin *time.Time
+	if err := r.db.Reader().QueryRow(ctx, query, userID).Scan(&lastLogin); err != nil {
+		r.log.Error("Failed to get user last login", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("get last login for user %s: %w", userID.String(), err)
+	}
+
+	return lastLogin, nil
+}
+
+func (r *userStatsRepository) GetFavoriteGenre(ctx context.Context, userID uuid.UUID) (*entity.UserFavoriteGenre, error) {
+	// Seri diputus berdasarkan nama supaya hasil stabil antar request
+	query := `
+		SELECT g.id::text, g.name, COUNT(DISTINCT b.id) AS bookings
+		FROM bookings b
+		INNER JOIN schedules s ON s.id = b.schedule_id
+		INNER JOIN movie_genres mg ON mg.movie_id = s.movie_id
+		INNER JOIN genres g ON g.id = mg.genre_id
+		WHERE b.user_id = $1 AND b.deleted_at IS NULL AND b.status IN ('confirmed', 'checked_in')
+		GROUP BY g.id, g.name
+		ORDER BY bookings DESC, g.name
+		LIMIT 1
+	`
+
+	var genre entity.UserFavoriteGenre
+	err := r.db.Reader().QueryRow(ctx, query, userID).Scan(&genre.GenreID, &genre.Name, &genre.Bookings)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to get user favorite genre", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("get favorite genre for user %s: %w", userID.String(), err)
+	}
+
+	return &genre, nil
+}
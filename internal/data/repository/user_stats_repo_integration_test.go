@@ -0,0 +1,76 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"cinema-booking/internal/data/entity"
+)
+
+func TestUserStatsRepository_NewUser(t *testing.T) {
+	ctx := context.Background()
+	user := newTestUser(t)
+
+	stats, err := testRepo.UserStats.GetBookingStats(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("get booking stats: %v", err)
+	}
+	if stats.TotalBookings != 0 || stats.Cancellations != 0 || stats.LastBookingAt != nil {
+		t.Fatalf("booking stats = %+v, want zero", stats)
+	}
+
+	spend, err := testRepo.UserStats.GetSpend(ctx, user.ID)
+	if err != nil || len(spend) != 0 {
+		t.Fatalf("spend = %d rows, %v; want 0, nil", len(spend), err)
+	}
+
+	lastLogin, err := testRepo.UserStats.GetLastLogin(ctx, user.ID)
+	if err != nil || lastLogin != nil {
+		t.Fatalf("last login = %v, %v; want nil, nil", lastLogin, err)
+	}
+
+	genre, err := testRepo.UserStats.GetFavoriteGenre(ctx, user.ID)
+	if err != nil || genre != nil {
+		t.Fatalf("favorite genre = %+v, %v; want nil, nil", genre, err)
+	}
+}
+
+func TestUserStatsRepository_BookingsAndSpend(t *testing.T) {
+	ctx := context.Background()
+	show := newShowFixture(t, 3)
+	user := newTestUser(t)
+
+	paid := newPendingBooking(user.ID, show.Schedule, 2)
+	cancelled := newPendingBooking(user.ID, show.Schedule, 1)
+	pending := newPendingBooking(user.ID, show.Schedule, 1)
+	for _, booking := range []*entity.Booking{paid, cancelled, pending} {
+		if err := testRepo.Booking.Create(ctx, booking); err != nil {
+			t.Fatalf("create booking: %v", err)
+		}
+	}
+	if err := testRepo.Booking.UpdateStatus(ctx, paid, entity.BookingStatusConfirmed); err != nil {
+		t.Fatalf("confirm booking: %v", err)
+	}
+	if err := testRepo.Booking.UpdateStatus(ctx, cancelled, entity.BookingStatusCancelled); err != nil {
+		t.Fatalf("cancel booking: %v", err)
+	}
+
+	stats, err := testRepo.UserStats.GetBookingStats(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("get booking stats: %v", err)
+	}
+	if stats.TotalBookings != 3 || stats.Cancellations != 1 || stats.LastBookingAt == nil {
+		t.Fatalf("booking stats = %+v, want 3 bookings, 1 cancellation", stats)
+	}
+
+	// Hanya booking yang dibayar dihitung sebagai belanja
+	spend, err := testRepo.UserStats.GetSpend(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("get spend: %v", err)
+	}
+	if len(spend) != 1 || spend[0].Currency != paid.Currency || spend[0].Amount != paid.TotalPrice {
+		t.Fatalf("spend = %+v, want %d %s", spend, paid.TotalPrice, paid.Currency)
+	}
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type WaitlistRepository interface {
+	Create(ctx context.Context, entry *entity.WaitlistEntry) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.WaitlistEntry, error)
+	// FindActiveByScheduleAndUser returns the waiting/offered entry, nil kalau tidak ada
+	FindActiveByScheduleAndUser(ctx context.Context, scheduleID, userID uuid.UUID) (*entity.WaitlistEntry, error)
+	FindActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.WaitlistEntry, error)
+	// CountAhead counts waiting entries yang join lebih dulu di schedule yang sama
+	CountAhead(ctx context.Context, entry *entity.WaitlistEntry) (int64, error)
+	Update(ctx context.Context, entry *entity.WaitlistEntry) error
+
+	// Dipakai di dalam WithTx
+	FindWaitingForUpdate(ctx context.Context, scheduleID uuid.UUID, limit int) ([]*entity.WaitlistEntry, error)
+	FindExpiredOffersForUpdate(ctx context.Context, now time.Time, limit int) ([]*entity.WaitlistEntry, error)
+}
+
+type waitlistRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewWaitlistRepository(db database.PgxIface, log *zap.Logger) WaitlistRepository {
+	return &waitlistRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "waitlist")),
+	}
+}
+
+const waitlistColumns = `id, schedule_id, user_id, seats_requested, status, offered_at, hold_expires_at, created_at, updated_at`
+
+func (r *waitlistRepository) Create(ctx context.Context, entry *entity.WaitlistEntry) error {
+	query := `
+		INSERT INTO schedule_waitlist (id, schedule_id, user_id, seats_requested, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		entry.ID,
+		entry.ScheduleID,
+		entry.UserID,
+		entry.SeatsRequested,
+		entry.Status,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to create waitlist entry",
+			zap.Error(err),
+			zap.String("schedule_id", entry.ScheduleID.String()),
+			zap.String("user_id", entry.UserID.String()),
+		)
+		return fmt.Errorf("create waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *waitlistRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.WaitlistEntry, error) {
+	query := `SELECT ` + waitlistColumns + ` FROM schedule_waitlist WHERE id = $1`
+
+	entry, err := scanWaitlistEntry(r.db.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find waitlist entry by ID",
+			zap.Error(err),
+			zap.String("waitlist_id", id.String()),
+		)
+		return nil, fmt.Errorf("find waitlist entry by ID %s: %w", id.String(), err)
+	}
+
+	return entry, nil
+}
+
+func (r *waitlistRepository) FindActiveByScheduleAndUser(ctx context.Context, scheduleID, userID uuid.UUID) (*entity.WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM schedule_waitlist
+		WHERE schedule_id = $1 AND user_id = $2 AND status IN ('waiting', 'offered')
+	`
+
+	entry, err := scanWaitlistEntry(r.db.QueryRow(ctx, query, scheduleID, userID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find active waitlist entry",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID.String()),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find active waitlist entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r *waitlistRepository) FindActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM schedule_waitlist
+		WHERE user_id = $1 AND status IN ('waiting', 'offered')
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to find waitlist entries by user ID",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find waitlist entries by user ID %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanEntries(rows)
+}
+
+func (r *waitlistRepository) CountAhead(ctx context.Context, entry *entity.WaitlistEntry) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM schedule_waitlist
+		WHERE schedule_id = $1 AND status = 'waiting'
+		  AND (created_at, id) < ($2, $3)
+	`
+
+	var count int64
+	if err := r.db.QueryRow(ctx, query, entry.ScheduleID, entry.CreatedAt, entry.ID).Scan(&count); err != nil {
+		r.log.Error("Failed to count waitlist position",
+			zap.Error(err),
+			zap.String("waitlist_id", entry.ID.String()),
+		)
+		return 0, fmt.Errorf("count waitlist position: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *waitlistRepository) Update(ctx context.Context, entry *entity.WaitlistEntry) error {
+	query := `
+		UPDATE schedule_waitlist
+		SET status = $2, offered_at = $3, hold_expires_at = $4, updated_at = $5
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		entry.ID,
+		entry.Status,
+		entry.OfferedAt,
+		entry.HoldExpiresAt,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		r.log.Error("Failed to update waitlist entry",
+			zap.Error(err),
+			zap.String("waitlist_id", entry.ID.String()),
+		)
+		return fmt.Errorf("update waitlist entry %s: %w", entry.ID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("waitlist entry %s not found", entry.ID.String())
+	}
+
+	return nil
+}
+
+func (r *waitlistRepository) FindWaitingForUpdate(ctx context.Context, scheduleID uuid.UUID, limit int) ([]*entity.WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM schedule_waitlist
+		WHERE schedule_id = $1 AND status = 'waiting'
+		ORDER BY created_at, id
+		LIMIT $2
+		FOR UPDATE
+	`
+
+	rows, err := r.db.Query(ctx, query, scheduleID, limit)
+	if err != nil {
+		r.log.Error("Failed to find waiting entries",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID.String()),
+		)
+		return nil, fmt.Errorf("find waiting entries for schedule %s: %w", scheduleID.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanEntries(rows)
+}
+
+func (r *waitlistRepository) FindExpiredOffersForUpdate(ctx context.Context, now time.Time, limit int) ([]*entity.WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM schedule_waitlist
+		WHERE status = 'offered' AND hold_expires_at <= $1
+		ORDER BY hold_expires_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := r.db.Query(ctx, query, now, limit)
+	if err != nil {
+		r.log.Error("Failed to find expired waitlist offers", zap.Error(err))
+		return nil, fmt.Errorf("find expired waitlist offers: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanEntries(rows)
+}
+
+// ==================== HELPER METHODS ====================
+
+func scanWaitlistEntry(row pgx.Row) (*entity.WaitlistEntry, error) {
+	var entry entity.WaitlistEntry
+	err := row.Scan(
+		&entry.ID,
+		&entry.ScheduleID,
+		&entry.UserID,
+		&entry.SeatsRequested,
+		&entry.Status,
+		&entry.OfferedAt,
+		&entry.HoldExpiresAt,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *waitlistRepository) scanEntries(rows pgx.Rows) ([]*entity.WaitlistEntry, error) {
+	var entries []*entity.WaitlistEntry
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			r.log.Error("Failed to scan waitlist row", zap.Error(err))
+			return nil, fmt.Errorf("scan waitlist row: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate waitlist rows: %w", err)
+	}
+
+	return entries, nil
+}
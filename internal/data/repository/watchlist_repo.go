@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type WatchlistRepository interface {
+	Add(ctx context.Context, item *entity.WatchlistItem) error
+	Remove(ctx context.Context, userID, movieID uuid.UUID) error
+	FindMoviesByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Movie, error)
+	FindWatchedMovieIDs(ctx context.Context, userID uuid.UUID, movieIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+	FindUserIDsByMovieID(ctx context.Context, movieID uuid.UUID) ([]uuid.UUID, error)
+}
+
+type watchlistRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewWatchlistRepository(db database.PgxIface, log *zap.Logger) WatchlistRepository {
+	return &watchlistRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "watchlist")),
+	}
+}
+
+// Add inserts a movie ke watchlist user, idempotent kalau sudah ada
+func (r *watchlistRepository) Add(ctx context.Context, item *entity.WatchlistItem) error {
+	query := `
+		INSERT INTO user_watchlist (id, user_id, movie_id, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, movie_id) DO NOTHING
+	`
+
+	_, err := r.db.Exec(ctx, query, item.ID, item.UserID, item.MovieID, item.CreatedAt)
+	if err != nil {
+		r.log.Error("Failed to add movie to watchlist",
+			zap.Error(err),
+			zap.String("user_id", item.UserID.String()),
+			zap.String("movie_id", item.MovieID.String()),
+		)
+		return fmt.Errorf("add movie %s to watchlist: %w", item.MovieID.String(), err)
+	}
+
+	return nil
+}
+
+func (r *watchlistRepository) Remove(ctx context.Context, userID, movieID uuid.UUID) error {
+	query := `DELETE FROM user_watchlist WHERE user_id = $1 AND movie_id = $2`
+
+	result, err := r.db.Exec(ctx, query, userID, movieID)
+	if err != nil {
+		r.log.Error("Failed to remove movie from watchlist",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+			zap.String("movie_id", movieID.String()),
+		)
+		return fmt.Errorf("remove movie %s from watchlist: %w", movieID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("watchlist item not found")
+	}
+
+	return nil
+}
+
+// FindMoviesByUserID returns watchlisted movies, yang terakhir ditambahkan duluan
+func (r *watchlistRepository) FindMoviesByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Movie, error) {
+	query := `
+		SELECT m.id, m.title, m.description, m.poster_url, m.rating, m.release_date,
+		       m.duration_in_minutes, m.release_status, m.age_rating, m.created_at, m.updated_at, m.deleted_at
+		FROM user_watchlist w
+		JOIN movies m ON m.id = w.movie_id
+		WHERE w.user_id = $1 AND m.deleted_at IS NULL
+		ORDER BY w.created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to find watchlist movies",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("find watchlist movies for user %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	var movies []*entity.Movie
+	for rows.Next() {
+		var movie entity.Movie
+		err := rows.Scan(
+			&movie.ID,
+			&movie.Title,
+			&movie.Description,
+			&movie.PosterURL,
+			&movie.Rating,
+			&movie.ReleaseDate,
+			&movie.DurationInMinutes,
+			&movie.ReleaseStatus,
+			&movie.AgeRating,
+			&movie.CreatedAt,
+			&movie.UpdatedAt,
+			&movie.DeletedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan watchlist movie row", zap.Error(err))
+			return nil, fmt.Errorf("scan watchlist movie row: %w", err)
+		}
+		movies = append(movies, &movie)
+	}
+
+	return movies, nil
+}
+
+// FindWatchedMovieIDs checks which of the given movies ada di watchlist user (satu query untuk satu halaman)
+func (r *watchlistRepository) FindWatchedMovieIDs(ctx context.Context, userID uuid.UUID, movieIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	watched := make(map[uuid.UUID]bool, len(movieIDs))
+	if len(movieIDs) == 0 {
+		return watched, nil
+	}
+
+	query := `SELECT movie_id FROM user_watchlist WHERE user_id = $1 AND movie_id = ANY($2)`
+
+	rows, err := r.db.Query(ctx, query, userID, movieIDs)
+	if err != nil {
+		r.log.Error("Failed to check watchlist movies",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("check watchlist for user %s: %w", userID.String(), err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var movieID uuid.UUID
+		if err := rows.Scan(&movieID); err != nil {
+			r.log.Error("Failed to scan watchlist movie ID", zap.Error(err))
+			return nil, fmt.Errorf("scan watchlist movie ID: %w", err)
+		}
+		watched[movieID] = true
+	}
+
+	return watched, nil
+}
+
+func (r *watchlistRepository) FindUserIDsByMovieID(ctx context.Context, movieID uuid.UUID) ([]uuid.UUID, error) {
+	query := `SELECT user_id FROM user_watchlist WHERE movie_id = $1`
+
+	rows, err := r.db.Query(ctx, query, movieID)
+	if err != nil {
+		r.log.Error("Failed to find watchers by movie ID",
+			zap.Error(err),
+			zap.String("movie_id", movieID.String()),
+		)
+		return nil, fmt.Errorf("find watchers for movie %s: %w", movieID.String(), err)
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			r.log.Error("Failed to scan watcher row", zap.Error(err))
+			return nil, fmt.Errorf("scan watcher row: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
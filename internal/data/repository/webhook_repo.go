@@ -0,0 +1,420 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error
+	FindSubscriptionByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+	FindSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	// FindActiveSubscriptions returns subscription aktif yang mendaftar eventType
+	FindActiveSubscriptions(ctx context.Context, eventType string) ([]*entity.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	CreateDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+	// ClaimDue mengambil delivery pending yang sudah waktunya dan menggeser next_attempt_at ke leaseUntil,
+	// jadi instance lain tidak mengirim delivery yang sama selama request ke partner berjalan
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, id uuid.UUID, statusCode int, at time.Time) error
+	// MarkAttemptFailed menjadwalkan retry di nextAttemptAt; nil berarti tidak dicoba lagi (status failed)
+	MarkAttemptFailed(ctx context.Context, id uuid.UUID, statusCode *int, errMsg string, at time.Time, nextAttemptAt *time.Time) error
+	FindDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *entity.WebhookDeliveryStatus, limit, offset int) ([]*entity.WebhookDelivery, error)
+	CountDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *entity.WebhookDeliveryStatus) (int64, error)
+}
+
+const webhookSubscriptionColumns = `id, name, url, secret, event_types, is_active, created_at, updated_at`
+
+const webhookDeliveryColumns = `id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+		last_attempt_at, last_status_code, last_error, delivered_at, created_at`
+
+type webhookRepository struct {
+	db  database.PgxIface
+	log *zap.Logger
+}
+
+func NewWebhookRepository(db database.PgxIface, log *zap.Logger) WebhookRepository {
+	return &webhookRepository{
+		db:  db,
+		log: log.With(zap.String("repository", "webhook")),
+	}
+}
+
+// ==================== SUBSCRIPTIONS ====================
+
+func (r *webhookRepository) CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (id, name, url, secret, event_types, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		subscription.ID,
+		subscription.Name,
+		subscription.URL,
+		subscription.Secret,
+		subscription.EventTypes,
+		subscription.IsActive,
+		subscription.CreatedAt,
+		subscription.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to create webhook subscription",
+			zap.Error(err),
+			zap.String("name", subscription.Name),
+		)
+		return fmt.Errorf("create webhook subscription %s: %w", subscription.Name, err)
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) FindSubscriptionByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	subscription, err := scanWebhookSubscription(r.db.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("Failed to find webhook subscription by ID",
+			zap.Error(err),
+			zap.String("subscription_id", id.String()),
+		)
+		return nil, fmt.Errorf("find webhook subscription by ID %s: %w", id.String(), err)
+	}
+
+	return subscription, nil
+}
+
+func (r *webhookRepository) FindSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE deleted_at IS NULL
+		ORDER BY created_at DESC, id
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to find webhook subscriptions", zap.Error(err))
+		return nil, fmt.Errorf("find webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanWebhookSubscriptions(rows)
+}
+
+func (r *webhookRepository) FindActiveSubscriptions(ctx context.Context, eventType string) ([]*entity.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE is_active = true AND deleted_at IS NULL AND $1 = ANY(event_types)
+		ORDER BY id
+	`
+
+	rows, err := r.db.Query(ctx, query, eventType)
+	if err != nil {
+		r.log.Error("Failed to find active webhook subscriptions",
+			zap.Error(err),
+			zap.String("event_type", eventType),
+		)
+		return nil, fmt.Errorf("find webhook subscriptions for %s: %w", eventType, err)
+	}
+	defer rows.Close()
+
+	return r.scanWebhookSubscriptions(rows)
+}
+
+func (r *webhookRepository) UpdateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET name = $2, url = $3, secret = $4, event_types = $5, is_active = $6, updated_at = $7
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		subscription.ID,
+		subscription.Name,
+		subscription.URL,
+		subscription.Secret,
+		subscription.EventTypes,
+		subscription.IsActive,
+		subscription.UpdatedAt,
+	)
+
+	if err != nil {
+		r.log.Error("Failed to update webhook subscription",
+			zap.Error(err),
+			zap.String("subscription_id", subscription.ID.String()),
+		)
+		return fmt.Errorf("update webhook subscription %s: %w", subscription.ID.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook subscription %s not found", subscription.ID.String())
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE webhook_subscriptions SET deleted_at = NOW(), is_active = false WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to delete webhook subscription",
+			zap.Error(err),
+			zap.String("subscription_id", id.String()),
+		)
+		return fmt.Errorf("delete webhook subscription %s: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook subscription %s not found", id.String())
+	}
+
+	r.log.Info("Webhook subscription deleted", zap.String("subscription_id", id.String()))
+	return nil
+}
+
+// ==================== DELIVERIES ====================
+
+func (r *webhookRepository) CreateDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, status, next_attempt_at, created_at) VALUES `
+	args := []interface{}{}
+
+	for i, delivery := range deliveries {
+		if i > 0 {
+			query += ", "
+		}
+		query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*8+1, i*8+2, i*8+3, i*8+4, i*8+5, i*8+6, i*8+7, i*8+8)
+
+		args = append(args,
+			delivery.ID,
+			delivery.SubscriptionID,
+			delivery.EventID,
+			delivery.EventType,
+			delivery.Payload,
+			delivery.Status,
+			delivery.NextAttemptAt,
+			delivery.CreatedAt,
+		)
+	}
+
+	_, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to create webhook deliveries",
+			zap.Error(err),
+			zap.String("event_type", deliveries[0].EventType),
+			zap.Int("count", len(deliveries)),
+		)
+		return fmt.Errorf("create webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliveryColumns + `
+	`
+
+	rows, err := r.db.Query(ctx, query, now, leaseUntil, limit)
+	if err != nil {
+		r.log.Error("Failed to claim due webhook deliveries", zap.Error(err))
+		return nil, fmt.Errorf("claim due webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanWebhookDeliveries(rows)
+}
+
+func (r *webhookRepository) MarkDelivered(ctx context.Context, id uuid.UUID, statusCode int, at time.Time) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'delivered', attempts = attempts + 1, last_attempt_at = $2, last_status_code = $3,
+		    last_error = NULL, delivered_at = $2
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, id, at, statusCode)
+	if err != nil {
+		r.log.Error("Failed to mark webhook delivery delivered",
+			zap.Error(err),
+			zap.String("delivery_id", id.String()),
+		)
+		return fmt.Errorf("mark webhook delivery %s delivered: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook delivery %s not found", id.String())
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) MarkAttemptFailed(ctx context.Context, id uuid.UUID, statusCode *int, errMsg string, at time.Time, nextAttemptAt *time.Time) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET attempts = attempts + 1, last_attempt_at = $2, last_status_code = $3, last_error = $4,
+		    status = CASE WHEN $5::timestamp IS NULL THEN 'failed' ELSE 'pending' END,
+		    next_attempt_at = COALESCE($5::timestamp, next_attempt_at)
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, id, at, statusCode, errMsg, nextAttemptAt)
+	if err != nil {
+		r.log.Error("Failed to mark webhook delivery attempt failed",
+			zap.Error(err),
+			zap.String("delivery_id", id.String()),
+		)
+		return fmt.Errorf("mark webhook delivery %s failed: %w", id.String(), err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook delivery %s not found", id.String())
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) FindDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *entity.WebhookDeliveryStatus, limit, offset int) ([]*entity.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE subscription_id = $1 AND ($2::varchar IS NULL OR status = $2)
+		ORDER BY created_at DESC, id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Reader().Query(ctx, query, subscriptionID, status, limit, offset)
+	if err != nil {
+		r.log.Error("Failed to find webhook deliveries",
+			zap.Error(err),
+			zap.String("subscription_id", subscriptionID.String()),
+		)
+		return nil, fmt.Errorf("find webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanWebhookDeliveries(rows)
+}
+
+func (r *webhookRepository) CountDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *entity.WebhookDeliveryStatus) (int64, error) {
+	query := `SELECT COUNT(*) FROM webhook_deliveries WHERE subscription_id = $1 AND ($2::varchar IS NULL OR status = $2)`
+
+	var count int64
+	if err := r.db.Reader().QueryRow(ctx, query, subscriptionID, status).Scan(&count); err != nil {
+		r.log.Error("Failed to count webhook deliveries",
+			zap.Error(err),
+			zap.String("subscription_id", subscriptionID.String()),
+		)
+		return 0, fmt.Errorf("count webhook deliveries: %w", err)
+	}
+
+	return count, nil
+}
+
+// ==================== SCAN HELPERS ====================
+
+func scanWebhookSubscription(row pgx.Row) (*entity.WebhookSubscription, error) {
+	var subscription entity.WebhookSubscription
+	err := row.Scan(
+		&subscription.ID,
+		&subscription.Name,
+		&subscription.URL,
+		&subscription.Secret,
+		&subscription.EventTypes,
+		&subscription.IsActive,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (r *webhookRepository) scanWebhookSubscriptions(rows pgx.Rows) ([]*entity.WebhookSubscription, error) {
+	subscriptions := []*entity.WebhookSubscription{}
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			r.log.Error("Failed to scan webhook subscription row", zap.Error(err))
+			return nil, fmt.Errorf("scan webhook subscription row: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate webhook subscription rows: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (r *webhookRepository) scanWebhookDeliveries(rows pgx.Rows) ([]*entity.WebhookDelivery, error) {
+	deliveries := []*entity.WebhookDelivery{}
+	for rows.Next() {
+		var delivery entity.WebhookDelivery
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.SubscriptionID,
+			&delivery.EventID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.LastAttemptAt,
+			&delivery.LastStatusCode,
+			&delivery.LastError,
+			&delivery.DeliveredAt,
+			&delivery.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan webhook delivery row", zap.Error(err))
+			return nil, fmt.Errorf("scan webhook delivery row: %w", err)
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Rows iteration error", zap.Error(err))
+		return nil, fmt.Errorf("iterate webhook delivery rows: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,133 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+
+	"github.com/google/uuid"
+)
+
+func TestWebhookRepository_SubscriptionCRUD(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	eventType := "it.smoke." + uuid.NewString()[:8]
+
+	subscription := &entity.WebhookSubscription{
+		Base:       entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		Name:       "IT Partner",
+		URL:        "https://partner.example.com/hooks",
+		Secret:     "whsec_test",
+		EventTypes: []string{eventType},
+		IsActive:   true,
+	}
+	if err := testRepo.Webhook.CreateSubscription(ctx, subscription); err != nil {
+		t.Fatalf("create subscription: %v", err)
+	}
+
+	active, err := testRepo.Webhook.FindActiveSubscriptions(ctx, eventType)
+	if err != nil {
+		t.Fatalf("find active subscriptions: %v", err)
+	}
+	if len(active) != 1 || active[0].ID != subscription.ID {
+		t.Fatalf("active subscriptions for %s = %d rows, want subscription %s", eventType, len(active), subscription.ID)
+	}
+
+	subscription.IsActive = false
+	subscription.UpdatedAt = now.Add(time.Second)
+	if err := testRepo.Webhook.UpdateSubscription(ctx, subscription); err != nil {
+		t.Fatalf("update subscription: %v", err)
+	}
+	active, err = testRepo.Webhook.FindActiveSubscriptions(ctx, eventType)
+	if err != nil || len(active) != 0 {
+		t.Fatalf("active subscriptions after deactivate = %d, %v; want 0, nil", len(active), err)
+	}
+
+	if err := testRepo.Webhook.DeleteSubscription(ctx, subscription.ID); err != nil {
+		t.Fatalf("delete subscription: %v", err)
+	}
+	deleted, err := testRepo.Webhook.FindSubscriptionByID(ctx, subscription.ID)
+	if err != nil || deleted != nil {
+		t.Fatalf("find deleted subscription = %+v, %v; want nil, nil", deleted, err)
+	}
+}
+
+func TestWebhookRepository_DeliveryLifecycle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	subscription := &entity.WebhookSubscription{
+		Base:       entity.Base{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		Name:       "IT Partner",
+		URL:        "https://partner.example.com/hooks",
+		Secret:     "whsec_test",
+		EventTypes: []string{"booking.confirmed"},
+		IsActive:   true,
+	}
+	if err := testRepo.Webhook.CreateSubscription(ctx, subscription); err != nil {
+		t.Fatalf("create subscription: %v", err)
+	}
+
+	newDelivery := func() *entity.WebhookDelivery {
+		return &entity.WebhookDelivery{
+			BaseSimple:     entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+			SubscriptionID: subscription.ID,
+			EventID:        uuid.New(),
+			EventType:      "booking.confirmed",
+			Payload:        []byte(`{"type":"booking.confirmed"}`),
+			Status:         entity.WebhookDeliveryPending,
+			NextAttemptAt:  now.Add(-time.Second),
+		}
+	}
+	delivered, failed := newDelivery(), newDelivery()
+	if err := testRepo.Webhook.CreateDeliveries(ctx, []*entity.WebhookDelivery{delivered, failed}); err != nil {
+		t.Fatalf("create deliveries: %v", err)
+	}
+
+	claimed, err := testRepo.Webhook.ClaimDue(ctx, now, now.Add(time.Minute), 100)
+	if err != nil {
+		t.Fatalf("claim due deliveries: %v", err)
+	}
+	ours := 0
+	for _, delivery := range claimed {
+		if delivery.SubscriptionID == subscription.ID {
+			ours++
+		}
+	}
+	if ours != 2 {
+		t.Fatalf("claimed %d deliveries for subscription, want 2", ours)
+	}
+
+	if err := testRepo.Webhook.MarkDelivered(ctx, delivered.ID, 200, now); err != nil {
+		t.Fatalf("mark delivered: %v", err)
+	}
+	statusCode := 500
+	if err := testRepo.Webhook.MarkAttemptFailed(ctx, failed.ID, &statusCode, "server error", now, nil); err != nil {
+		t.Fatalf("mark attempt failed: %v", err)
+	}
+
+	for status, want := range map[entity.WebhookDeliveryStatus]int64{
+		entity.WebhookDeliveryPending:   0,
+		entity.WebhookDeliveryDelivered: 1,
+		entity.WebhookDeliveryFailed:    1,
+	} {
+		count, err := testRepo.Webhook.CountDeliveries(ctx, subscription.ID, &status)
+		if err != nil || count != want {
+			t.Fatalf("count %s deliveries = %d, %v; want %d, nil", status, count, err, want)
+		}
+	}
+
+	deliveries, err := testRepo.Webhook.FindDeliveries(ctx, subscription.ID, nil, 10, 0)
+	if err != nil {
+		t.Fatalf("find deliveries: %v", err)
+	}
+	for _, delivery := range deliveries {
+		if delivery.Attempts != 1 || delivery.LastAttemptAt == nil {
+			t.Fatalf("delivery %s = %+v, want one recorded attempt", delivery.ID, delivery)
+		}
+	}
+}
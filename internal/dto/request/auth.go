@@ -21,3 +21,17 @@ type SendOTPRequest struct {
 	Email string `json:"email" validate:"required,email"`
 	Type  string `json:"type" validate:"required,oneof=email_verification password_reset"`
 }
+
+// ImpersonateRequest alasan support wajib diisi, disimpan di audit log
+type ImpersonateRequest struct {
+	Reason string `json:"reason" validate:"required,min=5,max=500"`
+
+	IPAddress string `json:"-"` // diisi handler dari request admin
+}
+
+// AuditLogFilter is parsed dari query ?actor_id=&target_id=&action=
+type AuditLogFilter struct {
+	ActorID  string `validate:"omitempty,uuid"`
+	TargetID string `validate:"omitempty,uuid"`
+	Action   string `validate:"omitempty,max=64"`
+}
@@ -0,0 +1,14 @@
+package request
+
+// BannerRequest dipakai untuk create dan PUT (replace penuh).
+// starts_at/ends_at RFC3339 dengan offset, mis. 2026-12-01T00:00:00+07:00.
+type BannerRequest struct {
+	Title     string  `json:"title" validate:"required,min=1,max=100"`
+	ImageURL  string  `json:"image_url" validate:"required,url,max=2048"`
+	LinkURL   *string `json:"link_url,omitempty" validate:"omitempty,url,max=2048"` // URL web atau deep link app
+	City      *string `json:"city,omitempty" validate:"omitempty,min=1,max=100"`    // kosong = semua kota
+	StartsAt  string  `json:"starts_at" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndsAt    *string `json:"ends_at,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	SortOrder int     `json:"sort_order" validate:"min=0,max=1000"`
+	IsActive  *bool   `json:"is_active,omitempty"`
+}
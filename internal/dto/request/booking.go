@@ -6,9 +6,63 @@ type CreateBookingRequest struct {
 	PaymentMethodID string   `json:"payment_method_id" validate:"required,uuid4"`
 }
 
+// ProcessPaymentRequest tidak menentukan harga; server selalu charge total booking.
+// Amount opsional, hanya untuk cek harga yang dilihat user belum berubah.
 type ProcessPaymentRequest struct {
-	BookingID       string  `json:"booking_id" validate:"required,uuid4"`
-	PaymentMethodID string  `json:"payment_method_id" validate:"required,uuid4"`
-	Amount          float64 `json:"amount" validate:"required,min=1000"`
-	TransactionID   *string `json:"transaction_id,omitempty"`
+	BookingID       string   `json:"booking_id" validate:"required,uuid4"`
+	PaymentMethodID string   `json:"payment_method_id" validate:"required,uuid4"`
+	Amount          *float64 `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	TransactionID   *string  `json:"transaction_id,omitempty"`
+	// UseGiftBalance memotong saldo gift card dulu; sisanya (kalau ada) ditagih ke payment method
+	UseGiftBalance bool `json:"use_gift_balance,omitempty"`
+}
+
+// PaymentWebhookRequest callback gateway untuk payment VA / QRIS yang masih pending
+type PaymentWebhookRequest struct {
+	PaymentID     string  `json:"payment_id" validate:"required,uuid4"`
+	Status        string  `json:"status" validate:"required,oneof=processing paid failed expired"`
+	TransactionID *string `json:"transaction_id,omitempty" validate:"omitempty,max=100"`
+}
+
+// BookingHistoryFilter optional filters untuk GET /api/user/bookings; date range berdasarkan show date
+type BookingHistoryFilter struct {
+	Status    string `json:"status" validate:"omitempty,oneof=pending confirmed checked_in cancelled expired refunded"`
+	When      string `json:"when" validate:"omitempty,oneof=upcoming past"`
+	StartDate string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// GroupBookingRequest block booking admin untuk event; isi SeatIDs atau WholeHall, tidak keduanya.
+// Batas kursi per user dan aturan gap tidak berlaku.
+type GroupBookingRequest struct {
+	ScheduleID     string   `json:"schedule_id" validate:"required,uuid4"`
+	SeatIDs        []string `json:"seat_ids" validate:"omitempty,dive,uuid4"`
+	WholeHall      bool     `json:"whole_hall"`
+	GroupName      string   `json:"group_name" validate:"required,min=3,max=150"`
+	Notes          *string  `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	UserID         *string  `json:"user_id,omitempty" validate:"omitempty,uuid4"` // pemilik booking, default admin yang membuat
+	PricePerSeat   *float64 `json:"price_per_seat,omitempty" validate:"omitempty,min=0"`
+	BlockRemaining bool     `json:"block_remaining"`
+}
+
+// AdminBookingFilter is parsed dari query ?flag= di list booking admin
+type AdminBookingFilter struct {
+	Flag string `validate:"omitempty,oneof=disputed chargeback vip"`
+}
+
+// CreateBookingNoteRequest catatan internal support, tidak terlihat oleh pemilik booking
+type CreateBookingNoteRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=2000"`
+}
+
+// UpdateBookingFlagsRequest mengganti seluruh flag booking; array kosong menghapus semua flag
+type UpdateBookingFlagsRequest struct {
+	Flags []string `json:"flags" validate:"max=3,dive,oneof=disputed chargeback vip"`
+}
+
+// TransitionBookingRequest perpindahan status manual oleh admin. Cancel tetap lewat endpoint cancel
+// karena ikut melepas kursi ke waitlist.
+type TransitionBookingRequest struct {
+	Status string `json:"status" validate:"required,oneof=checked_in refunded"`
+	Reason string `json:"reason" validate:"omitempty,max=500"`
 }
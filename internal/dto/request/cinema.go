@@ -1,15 +1,62 @@
 package request
 
 type CinemaRequest struct {
-	Name     string `json:"name" validate:"required,min=1,max=100"`
-	Location string `json:"location" validate:"required,min=1,max=200"`
-	City     string `json:"city" validate:"required,min=1,max=100"`
+	Name      string   `json:"name" validate:"required,min=1,max=100"`
+	Location  string   `json:"location" validate:"required,min=1,max=200"`
+	City      string   `json:"city" validate:"required,min=1,max=100"`
+	Latitude  *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+
+	Facilities   []string                   `json:"facilities,omitempty" validate:"omitempty,unique,dive,oneof=imax dolby parking food_beverage"`
+	OpeningHours map[string]DayHoursRequest `json:"opening_hours,omitempty" validate:"omitempty,dive,keys,oneof=monday tuesday wednesday thursday friday saturday sunday,endkeys"`
+
+	// TaxRate override pajak cinema (0.1 = 10%), kosong = default config
+	TaxRate *float64 `json:"tax_rate,omitempty" validate:"omitempty,min=0,max=1"`
+
+	// Timezone nama zona IANA (mis. Asia/Makassar), kosong = Asia/Jakarta
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,timezone"`
 }
 
 type CinemaUpdateRequest struct {
-	Name     *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
-	Location *string `json:"location,omitempty" validate:"omitempty,min=1,max=200"`
-	City     *string `json:"city,omitempty" validate:"omitempty,min=1,max=100"`
+	Name      *string  `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Location  *string  `json:"location,omitempty" validate:"omitempty,min=1,max=200"`
+	City      *string  `json:"city,omitempty" validate:"omitempty,min=1,max=100"`
+	Latitude  *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+
+	// Facilities/OpeningHours menggantikan seluruh nilai lama kalau dikirim
+	Facilities   *[]string                   `json:"facilities,omitempty" validate:"omitempty,unique,dive,oneof=imax dolby parking food_beverage"`
+	OpeningHours *map[string]DayHoursRequest `json:"opening_hours,omitempty" validate:"omitempty,dive,keys,oneof=monday tuesday wednesday thursday friday saturday sunday,endkeys"`
+	TaxRate      *float64                    `json:"tax_rate,omitempty" validate:"omitempty,min=0,max=1"`
+	// Timezone berubah = jam mulai (UTC) semua schedule di cinema ikut dihitung ulang
+	Timezone *string `json:"timezone,omitempty" validate:"omitempty,timezone"`
+}
+
+// CloseCinemaRequest menutup cinema mulai closed_from. Temporary wajib closed_until (tanggal buka
+// lagi, eksklusif); permanent tidak boleh mengisinya.
+type CloseCinemaRequest struct {
+	Type        string `json:"type" validate:"required,oneof=temporary permanent"`
+	ClosedFrom  string `json:"closed_from" validate:"required,datetime=2006-01-02"`
+	ClosedUntil string `json:"closed_until,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Reason      string `json:"reason" validate:"required,min=1,max=500"`
+}
+
+type DayHoursRequest struct {
+	Open  string `json:"open" validate:"required,datetime=15:04"`
+	Close string `json:"close" validate:"required,datetime=15:04"`
+}
+
+// CinemaListFilter is parsed dari query ?city=&facilities=imax,parking
+type CinemaListFilter struct {
+	City       *string
+	Facilities []string `validate:"omitempty,dive,oneof=imax dolby parking food_beverage"`
+}
+
+// NearbyCinemasRequest is parsed dari query ?lat=&lng=&radius_km=
+type NearbyCinemasRequest struct {
+	Latitude  float64 `validate:"min=-90,max=90"`
+	Longitude float64 `validate:"min=-180,max=180"`
+	RadiusKm  float64 `validate:"gt=0,max=100"`
 }
 
 type SeatAvailabilityRequest struct {
@@ -0,0 +1,12 @@
+package request
+
+// DataExportRequest is parsed dari query ?format=, default json
+type DataExportRequest struct {
+	Format string `validate:"omitempty,oneof=json zip"`
+}
+
+// DataExportFilter query log export untuk admin (?user_id=&status=)
+type DataExportFilter struct {
+	UserID string `validate:"omitempty,uuid"`
+	Status string `validate:"omitempty,oneof=pending processing completed failed expired"`
+}
@@ -0,0 +1,10 @@
+package request
+
+// UpdateFeatureFlagRequest membuat atau mengganti flag. RolloutPercent kosong berarti 100 (semua user),
+// Environments kosong berarti semua environment.
+type UpdateFeatureFlagRequest struct {
+	Enabled        *bool    `json:"enabled" validate:"required"`
+	RolloutPercent *int     `json:"rollout_percent" validate:"omitempty,min=0,max=100"`
+	Environments   []string `json:"environments" validate:"omitempty,dive,required,max=32"`
+	Description    string   `json:"description" validate:"omitempty,max=255"`
+}
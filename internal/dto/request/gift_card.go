@@ -0,0 +1,14 @@
+package request
+
+// IssueGiftCardsRequest menerbitkan Quantity kartu dengan nominal sama; kode dibuat server.
+// ExpiresOn inklusif: kartu masih bisa dipakai sampai akhir hari itu.
+type IssueGiftCardsRequest struct {
+	Amount    float64 `json:"amount" validate:"required,gt=0"`             // major unit
+	Quantity  int     `json:"quantity" validate:"omitempty,min=1,max=500"` // default 1
+	ExpiresOn *string `json:"expires_on,omitempty" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// RedeemGiftCardRequest code boleh dengan tanda hubung / huruf kecil, dinormalisasi server
+type RedeemGiftCardRequest struct {
+	Code string `json:"code" validate:"required,min=8,max=40"`
+}
@@ -0,0 +1,7 @@
+package request
+
+// JobFilter query daftar job untuk admin (?type=&status=)
+type JobFilter struct {
+	Type   string `validate:"omitempty,max=64"`
+	Status string `validate:"omitempty,oneof=pending running completed dead"`
+}
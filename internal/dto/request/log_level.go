@@ -0,0 +1,6 @@
+package request
+
+// LogLevelRequest mengubah level logger saat runtime
+type LogLevelRequest struct {
+	Level string `json:"level" validate:"required,oneof=debug info warn error"`
+}
@@ -7,6 +7,7 @@ type MovieRequest struct {
 	ReleaseDate       string   `json:"release_date" validate:"required,datetime=2006-01-02"`
 	DurationInMinutes int      `json:"duration_in_minutes" validate:"required,min=1,max=999"`
 	ReleaseStatus     string   `json:"release_status" validate:"required,oneof=now_playing coming_soon"`
+	AgeRating         string   `json:"age_rating,omitempty" validate:"omitempty,oneof=SU 13+ 17+ R"` // default SU
 	GenreIDs          []string `json:"genre_ids,omitempty" validate:"dive,uuid4"`
 }
 
@@ -16,5 +17,41 @@ type MovieUpdateRequest struct {
 	PosterURL         *string `json:"poster_url,omitempty"`
 	ReleaseDate       *string `json:"release_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
 	DurationInMinutes *int    `json:"duration_in_minutes,omitempty" validate:"omitempty,min=1,max=999"`
-	ReleaseStatus     *string `json:"release_status,omitempty" validate:"omitempty,oneof=now_playing coming_soon"`
+	ReleaseStatus     *string `json:"release_status,omitempty" validate:"omitempty,oneof=now_playing coming_soon ended"`
+	AgeRating         *string `json:"age_rating,omitempty" validate:"omitempty,oneof=SU 13+ 17+ R"`
+}
+
+// MovieReleaseStatusRequest overrides release status secara manual.
+// Locked default true: job otomatis tidak akan mengubah status ini lagi sampai di-unlock.
+type MovieReleaseStatusRequest struct {
+	ReleaseStatus string `json:"release_status" validate:"required,oneof=now_playing coming_soon ended"`
+	Locked        *bool  `json:"locked,omitempty"`
+}
+
+// MovieListFilter is parsed dari query ?sort=release_date|rating|title|popularity&order=asc|desc.
+// Order kosong memakai arah bawaan tiap sort (title A-Z, sisanya terbesar/terbaru dulu).
+type MovieListFilter struct {
+	Sort  string `validate:"omitempty,oneof=release_date rating title popularity"`
+	Order string `validate:"omitempty,oneof=asc desc"`
+}
+
+// MovieDetailRequest is parsed dari query ?include=schedules&date=&city=.
+// Date dan City hanya dipakai kalau schedules di-include.
+type MovieDetailRequest struct {
+	Include []string `validate:"omitempty,dive,oneof=schedules"`
+	Date    string   `validate:"omitempty,datetime=2006-01-02"`
+	City    *string
+}
+
+// IncludesSchedules reports whether showtimes perlu di-expand
+func (r *MovieDetailRequest) IncludesSchedules() bool {
+	if r == nil {
+		return false
+	}
+	for _, include := range r.Include {
+		if include == "schedules" {
+			return true
+		}
+	}
+	return false
 }
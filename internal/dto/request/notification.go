@@ -0,0 +1,17 @@
+package request
+
+type NotificationChannelSettingRequest struct {
+	Channel             string `json:"channel" validate:"required,oneof=email push sms"`
+	BookingConfirmation *bool  `json:"booking_confirmation,omitempty"`
+	Reminders           *bool  `json:"reminders,omitempty"`
+	Promotions          *bool  `json:"promotions,omitempty"`
+}
+
+type UpdateNotificationSettingsRequest struct {
+	Settings []NotificationChannelSettingRequest `json:"settings" validate:"required,min=1,dive"`
+}
+
+type RegisterDeviceRequest struct {
+	Token    string `json:"token" validate:"required,min=10,max=512"`
+	Platform string `json:"platform" validate:"required,oneof=android ios web"`
+}
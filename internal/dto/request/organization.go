@@ -0,0 +1,20 @@
+package request
+
+// OrganizationRequest dipakai untuk create dan PUT (replace penuh)
+type OrganizationRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	// Slug huruf kecil, angka dan tanda hubung, mis. "cinema-xxi"
+	Slug string `json:"slug" validate:"required,min=2,max=50"`
+	// OrderPrefix 2-8 huruf / angka untuk order ID booking chain ini (disimpan uppercase), kosong = prefix default
+	OrderPrefix *string `json:"order_prefix" validate:"omitempty,max=8"`
+}
+
+// OrganizationCinemasRequest memindahkan cinema ke organization, termasuk dari chain lain
+type OrganizationCinemasRequest struct {
+	CinemaIDs []string `json:"cinema_ids" validate:"required,min=1,max=100,unique,dive,uuid"`
+}
+
+// OrganizationAdminRequest menjadikan user admin chain
+type OrganizationAdminRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+}
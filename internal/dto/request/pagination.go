@@ -1,8 +1,16 @@
 package request
 
+import "net/url"
+
 type PaginatedRequest struct {
 	Page    int `json:"page" validate:"min=1"`
 	PerPage int `json:"per_page" validate:"min=1,max=100"`
+
+	// IncludeDeleted only honored by admin list endpoints
+	IncludeDeleted bool `json:"include_deleted"`
+
+	// Cursor switches to keyset pagination when set; empty string means first page
+	Cursor *string `json:"cursor,omitempty"`
 }
 
 func (p PaginatedRequest) Offset() int {
@@ -21,3 +29,22 @@ func (p PaginatedRequest) Limit() int {
 	}
 	return p.PerPage
 }
+
+// UseCursor reports whether the client asked for keyset pagination
+func (p PaginatedRequest) UseCursor() bool {
+	return p.Cursor != nil
+}
+
+// CursorFromQuery returns the cursor param if present (even empty), nil kalau client pakai page/per_page
+func CursorFromQuery(query url.Values) *string {
+	values, ok := query["cursor"]
+	if !ok {
+		return nil
+	}
+
+	cursor := ""
+	if len(values) > 0 {
+		cursor = values[0]
+	}
+	return &cursor
+}
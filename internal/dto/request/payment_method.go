@@ -0,0 +1,23 @@
+package request
+
+type PaymentMethodRequest struct {
+	Name         string  `json:"name" validate:"required,min=1,max=50"`
+	Type         string  `json:"type" validate:"required,oneof=e_wallet virtual_account card qris other"`
+	IsActive     *bool   `json:"is_active,omitempty"`
+	LogoURL      *string `json:"logo_url,omitempty" validate:"omitempty,url,max=500"`
+	Instructions *string `json:"instructions,omitempty" validate:"omitempty,max=2000"`
+
+	// ConvenienceFee per kursi dalam major unit default currency; kosong = default config
+	ConvenienceFee *float64 `json:"convenience_fee,omitempty" validate:"omitempty,min=0"`
+	FeePercent     *float64 `json:"fee_percent,omitempty" validate:"omitempty,min=0,max=1"`
+}
+
+type PaymentMethodUpdateRequest struct {
+	Name           *string  `json:"name,omitempty" validate:"omitempty,min=1,max=50"`
+	Type           *string  `json:"type,omitempty" validate:"omitempty,oneof=e_wallet virtual_account card qris other"`
+	IsActive       *bool    `json:"is_active,omitempty"`
+	LogoURL        *string  `json:"logo_url,omitempty" validate:"omitempty,url,max=500"`
+	Instructions   *string  `json:"instructions,omitempty" validate:"omitempty,max=2000"`
+	ConvenienceFee *float64 `json:"convenience_fee,omitempty" validate:"omitempty,min=0"`
+	FeePercent     *float64 `json:"fee_percent,omitempty" validate:"omitempty,min=0,max=1"`
+}
@@ -0,0 +1,18 @@
+package request
+
+// PricePromotionRequest dipakai untuk create dan PUT (replace penuh).
+// Jendela jam [start_time, end_time) dibandingkan dengan show_time lokal cinema.
+type PricePromotionRequest struct {
+	Name            string  `json:"name" validate:"required,min=1,max=100"`
+	DiscountPercent float64 `json:"discount_percent" validate:"required,gt=0,lte=1"` // fraction, 0.25 = 25%
+	// Weekdays 0 = Minggu sampai 6 = Sabtu; kosong berarti setiap hari
+	Weekdays   []int   `json:"weekdays,omitempty" validate:"omitempty,max=7,unique,dive,min=0,max=6"`
+	StartTime  string  `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime    string  `json:"end_time" validate:"required,datetime=15:04"`
+	ValidFrom  *string `json:"valid_from,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	ValidUntil *string `json:"valid_until,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	CinemaID   *string `json:"cinema_id,omitempty" validate:"omitempty,uuid"`
+	// Tiers membatasi promo ke customer tier tertentu; kosong berarti untuk semua customer
+	Tiers    []string `json:"tiers,omitempty" validate:"omitempty,max=4,unique,dive,oneof=standard silver gold platinum"`
+	IsActive *bool    `json:"is_active,omitempty"`
+}
@@ -0,0 +1,40 @@
+package request
+
+type SalesReportRequest struct {
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"required,datetime=2006-01-02"`
+	GroupBy   string `json:"group_by" validate:"required,oneof=day cinema movie"`
+}
+
+type OccupancyReportRequest struct {
+	CinemaID string `json:"cinema_id" validate:"required,uuid"`
+	Date     string `json:"date" validate:"required,datetime=2006-01-02"`
+}
+
+// FunnelReportRequest range tanggal event funnel (?from=&to=), keduanya inklusif
+type FunnelReportRequest struct {
+	From string `json:"from" validate:"required,datetime=2006-01-02"`
+	To   string `json:"to" validate:"required,datetime=2006-01-02"`
+}
+
+type ExportBookingsRequest struct {
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"required,datetime=2006-01-02"`
+	Status    string `json:"status" validate:"omitempty,oneof=pending confirmed checked_in cancelled expired refunded"`
+}
+
+type PaymentReportRequest struct {
+	StartDate       string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate         string `json:"end_date" validate:"required,datetime=2006-01-02"`
+	Status          string `json:"status" validate:"omitempty,oneof=pending processing completed failed expired refunded partially_refunded"`
+	PaymentMethodID string `json:"payment_method_id" validate:"omitempty,uuid"`
+	Page            int    `json:"page" validate:"min=1"`
+	PerPage         int    `json:"per_page" validate:"min=1,max=100"`
+}
+
+// ReconcilePaymentsRequest period settlement file; PaymentMethodID membatasi ke satu gateway
+type ReconcilePaymentsRequest struct {
+	StartDate       string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate         string `json:"end_date" validate:"required,datetime=2006-01-02"`
+	PaymentMethodID string `json:"payment_method_id" validate:"omitempty,uuid"`
+}
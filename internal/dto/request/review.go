@@ -10,3 +10,24 @@ type UpdateReviewRequest struct {
 	Rating  *int    `json:"rating,omitempty" validate:"omitempty,min=1,max=5"`
 	Comment *string `json:"comment,omitempty" validate:"omitempty,max=500"`
 }
+
+// ReviewReplyRequest balasan resmi cinema, dipakai untuk create dan update
+type ReviewReplyRequest struct {
+	Message string `json:"message" validate:"required,max=1000"`
+}
+
+// ReviewListFilter is parsed dari query ?sort=newest|highest_rating
+type ReviewListFilter struct {
+	Sort string `validate:"omitempty,oneof=newest highest_rating"`
+}
+
+// ReportReviewRequest flag review yang melanggar aturan
+type ReportReviewRequest struct {
+	Reason  string  `json:"reason" validate:"required,oneof=spam offensive spoiler harassment off_topic other"`
+	Details *string `json:"details,omitempty" validate:"omitempty,max=500"`
+}
+
+// ModerateReviewRequest keputusan admin untuk review di antrian report
+type ModerateReviewRequest struct {
+	Action string `json:"action" validate:"required,oneof=approve remove"`
+}
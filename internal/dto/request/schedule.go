@@ -0,0 +1,65 @@
+package request
+
+// ScheduleListFilter is parsed dari query ?movie_id=&cinema_id=&date=&format=
+type ScheduleListFilter struct {
+	MovieID  string `validate:"omitempty,uuid"`
+	CinemaID string `validate:"omitempty,uuid"`
+	Date     string `validate:"omitempty,datetime=2006-01-02"`
+	Format   string `validate:"omitempty,oneof=2D 3D IMAX 4DX"`
+}
+
+// AdminScheduleListFilter ScheduleListFilter plus status, draft hanya terlihat di endpoint admin
+type AdminScheduleListFilter struct {
+	ScheduleListFilter
+	Status string `validate:"omitempty,oneof=draft published cancelled"`
+}
+
+// ScheduleRequest membuat schedule baru sebagai draft; price dalam major unit
+type ScheduleRequest struct {
+	MovieID  string  `json:"movie_id" validate:"required,uuid4"`
+	HallID   string  `json:"hall_id" validate:"required,uuid4"`
+	ShowDate string  `json:"show_date" validate:"required,datetime=2006-01-02"`
+	ShowTime string  `json:"show_time" validate:"required,datetime=15:04"`
+	Price    float64 `json:"price" validate:"required,gt=0"`
+	Currency string  `json:"currency,omitempty" validate:"omitempty,len=3"`
+}
+
+// ScheduleUpdateRequest partial update, hanya untuk schedule yang masih draft
+type ScheduleUpdateRequest struct {
+	MovieID  *string  `json:"movie_id,omitempty" validate:"omitempty,uuid4"`
+	HallID   *string  `json:"hall_id,omitempty" validate:"omitempty,uuid4"`
+	ShowDate *string  `json:"show_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	ShowTime *string  `json:"show_time,omitempty" validate:"omitempty,datetime=15:04"`
+	Price    *float64 `json:"price,omitempty" validate:"omitempty,gt=0"`
+	Currency *string  `json:"currency,omitempty" validate:"omitempty,len=3"`
+}
+
+// SchedulePriceOverrideRequest price per kursi dalam major unit; null menghapus override
+type SchedulePriceOverrideRequest struct {
+	Price *float64 `json:"price" validate:"omitempty,gt=0"`
+}
+
+// CloneScheduleWeekRequest menyalin grid jadwal satu minggu (Senin-Minggu) sebuah cinema ke minggu lain.
+// Tanggal minggu harus hari Senin; hasil clone selalu draft.
+type CloneScheduleWeekRequest struct {
+	CinemaID        string `json:"cinema_id" validate:"required,uuid"`
+	SourceWeekStart string `json:"source_week_start" validate:"required,datetime=2006-01-02"`
+	TargetWeekStart string `json:"target_week_start" validate:"required,datetime=2006-01-02"`
+	DryRun          bool   `json:"dry_run"`
+}
+
+type PublishSchedulesRequest struct {
+	ScheduleIDs []string `json:"schedule_ids" validate:"required,min=1,max=200,dive,uuid4"`
+}
+
+// SeatBlockRequest blocks kursi untuk satu schedule saja, mis. jarak antar penonton
+type SeatBlockRequest struct {
+	SeatIDs []string `json:"seat_ids" validate:"required,min=1,max=500,dive,uuid"`
+	Reason  string   `json:"reason" validate:"required,oneof=maintenance distancing other"`
+	Note    *string  `json:"note,omitempty" validate:"omitempty,max=500"`
+}
+
+// SeatIDsRequest daftar kursi untuk unblock (schedule) dan block/unblock di level hall
+type SeatIDsRequest struct {
+	SeatIDs []string `json:"seat_ids" validate:"required,min=1,max=500,dive,uuid"`
+}
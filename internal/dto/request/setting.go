@@ -0,0 +1,6 @@
+package request
+
+// UpdateSettingsRequest mengubah beberapa setting sekaligus; nilai null mengembalikan setting ke default config
+type UpdateSettingsRequest struct {
+	Settings map[string]*int `json:"settings" validate:"required,min=1"`
+}
@@ -0,0 +1,29 @@
+package request
+
+// UpdateLanguageRequest sets bahasa email / push notification user
+type UpdateLanguageRequest struct {
+	Language string `json:"language" validate:"required,oneof=en id"`
+}
+
+// UpdateDateOfBirthRequest sets tanggal lahir untuk cek klasifikasi usia film
+type UpdateDateOfBirthRequest struct {
+	DateOfBirth string `json:"date_of_birth" validate:"required,datetime=2006-01-02"`
+}
+
+// ActivityFilter is parsed dari query ?type=, kosong berarti semua jenis activity
+type ActivityFilter struct {
+	Type string `validate:"omitempty,oneof=booking payment review login"`
+}
+
+// UserSearchFilter is parsed dari query list user admin (?email=&username=&phone=&role=&verified=
+// &active=&created_from=&created_to=). Email, username dan phone partial match; tanggal inklusif.
+type UserSearchFilter struct {
+	Email       string `validate:"omitempty,max=255"`
+	Username    string `validate:"omitempty,max=100"`
+	Phone       string `validate:"omitempty,max=20"`
+	Role        string `validate:"omitempty,oneof=customer admin staff"`
+	Verified    string `validate:"omitempty,oneof=true false"`
+	Active      string `validate:"omitempty,oneof=true false"`
+	CreatedFrom string `validate:"omitempty,datetime=2006-01-02"`
+	CreatedTo   string `validate:"omitempty,datetime=2006-01-02"`
+}
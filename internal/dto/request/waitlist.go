@@ -0,0 +1,6 @@
+package request
+
+type JoinWaitlistRequest struct {
+	// SeatsRequested defaults to 1 kalau tidak diisi
+	SeatsRequested int `json:"seats_requested" validate:"omitempty,min=1,max=10"`
+}
@@ -0,0 +1,15 @@
+package request
+
+// WebhookSubscriptionRequest dipakai untuk create dan PUT (replace penuh); secret tidak ikut diganti
+type WebhookSubscriptionRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	URL  string `json:"url" validate:"required,url,max=500"`
+	// EventTypes mis. ["booking.confirmed", "schedule.created"]
+	EventTypes []string `json:"event_types" validate:"required,min=1,unique,dive,required"`
+	IsActive   *bool    `json:"is_active,omitempty"`
+}
+
+// WebhookDeliveryFilter query string delivery log
+type WebhookDeliveryFilter struct {
+	Status string `json:"status" validate:"omitempty,oneof=pending delivered failed"`
+}
@@ -0,0 +1,44 @@
+package response
+
+import (
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+)
+
+// ActivityResponse satu item timeline akun; field opsional hanya muncul untuk jenis yang relevan
+type ActivityResponse struct {
+	Type        entity.ActivityType `json:"type"`
+	ReferenceID string              `json:"reference_id"`
+	OccurredAt  time.Time           `json:"occurred_at"`
+
+	OrderID    *string  `json:"order_id,omitempty"`
+	MovieTitle *string  `json:"movie_title,omitempty"`
+	Status     *string  `json:"status,omitempty"`
+	Amount     *float64 `json:"amount,omitempty"`
+	Currency   *string  `json:"currency,omitempty"`
+	Rating     *int     `json:"rating,omitempty"`
+	IPAddress  *string  `json:"ip_address,omitempty"`
+	UserAgent  *string  `json:"user_agent,omitempty"`
+}
+
+func ActivityToResponse(activity *entity.UserActivity) ActivityResponse {
+	resp := ActivityResponse{
+		Type:        activity.Type,
+		ReferenceID: activity.ReferenceID.String(),
+		OccurredAt:  activity.OccurredAt,
+		OrderID:     activity.OrderID,
+		MovieTitle:  activity.MovieTitle,
+		Status:      activity.Status,
+		Currency:    activity.Currency,
+		Rating:      activity.Rating,
+		IPAddress:   activity.IPAddress,
+		UserAgent:   activity.UserAgent,
+	}
+	if activity.Amount != nil && activity.Currency != nil {
+		amount := utils.CurrencyOf(*activity.Currency).ToMajor(*activity.Amount)
+		resp.Amount = &amount
+	}
+	return resp
+}
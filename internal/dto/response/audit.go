@@ -0,0 +1,36 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+)
+
+type AuditLogResponse struct {
+	ID         string          `json:"id"`
+	ActorID    string          `json:"actor_id"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   *string         `json:"target_id,omitempty"`
+	Metadata   json.RawMessage `json:"metadata"`
+	IPAddress  *string         `json:"ip_address,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+func AuditLogToResponse(entry *entity.AuditLog) AuditLogResponse {
+	resp := AuditLogResponse{
+		ID:         entry.ID.String(),
+		ActorID:    entry.ActorID.String(),
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		Metadata:   entry.Metadata,
+		IPAddress:  entry.IPAddress,
+		CreatedAt:  entry.CreatedAt,
+	}
+	if entry.TargetID != nil {
+		targetID := entry.TargetID.String()
+		resp.TargetID = &targetID
+	}
+	return resp
+}
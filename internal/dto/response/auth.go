@@ -2,6 +2,7 @@ package response
 
 import (
 	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
 	"time"
 )
 
@@ -22,20 +23,86 @@ type UserResponse struct {
 	Phone      *string         `json:"phone,omitempty"`
 	Role       entity.UserRole `json:"role"`
 	IsVerified bool            `json:"is_verified"`
+	Language   string          `json:"language"`
+	Tier       entity.UserTier `json:"tier"`
 	CreatedAt  time.Time       `json:"created_at"`
+
+	// DateOfBirth format 2006-01-02, kosong kalau belum diisi
+	DateOfBirth *string `json:"date_of_birth,omitempty"`
+
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 // Helper converters
 func UserToResponse(user *entity.User) UserResponse {
-	return UserResponse{
+	resp := UserResponse{
 		ID:         user.ID.String(),
 		Username:   user.Username,
 		Email:      user.Email,
 		Phone:      user.Phone,
 		Role:       user.Role,
 		IsVerified: user.EmailVerified,
+		Language:   user.Language,
+		Tier:       user.Tier,
 		CreatedAt:  user.CreatedAt,
+		DeletedAt:  user.DeletedAt,
+	}
+	if user.DateOfBirth != nil {
+		dob := user.DateOfBirth.Format("2006-01-02")
+		resp.DateOfBirth = &dob
 	}
+	return resp
+}
+
+// UserDetailResponse profil user plus agregat booking untuk halaman detail admin
+type UserDetailResponse struct {
+	UserResponse
+	IsActive bool              `json:"is_active"`
+	Stats    UserStatsResponse `json:"stats"`
+}
+
+type UserStatsResponse struct {
+	TotalBookings int64                  `json:"total_bookings"`
+	Cancellations int64                  `json:"cancellations"`
+	TotalSpend    []UserSpendResponse    `json:"total_spend"` // per currency, booking confirmed dan checked in
+	LastBookingAt *time.Time             `json:"last_booking_at,omitempty"`
+	LastLoginAt   *time.Time             `json:"last_login_at,omitempty"`
+	FavoriteGenre *FavoriteGenreResponse `json:"favorite_genre,omitempty"`
+}
+
+type UserSpendResponse struct {
+	Currency string  `json:"currency"`
+	Amount   float64 `json:"amount"`
+}
+
+type FavoriteGenreResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Bookings int64  `json:"bookings"`
+}
+
+func UserDetailToResponse(user *entity.User, bookings *entity.UserBookingStats, spend []*entity.UserSpend, lastLogin *time.Time, genre *entity.UserFavoriteGenre) UserDetailResponse {
+	resp := UserDetailResponse{
+		UserResponse: UserToResponse(user),
+		IsActive:     user.IsActive,
+		Stats: UserStatsResponse{
+			TotalBookings: bookings.TotalBookings,
+			Cancellations: bookings.Cancellations,
+			TotalSpend:    make([]UserSpendResponse, len(spend)),
+			LastBookingAt: bookings.LastBookingAt,
+			LastLoginAt:   lastLogin,
+		},
+	}
+	for i, row := range spend {
+		resp.Stats.TotalSpend[i] = UserSpendResponse{
+			Currency: row.Currency,
+			Amount:   utils.CurrencyOf(row.Currency).ToMajor(row.Amount),
+		}
+	}
+	if genre != nil {
+		resp.Stats.FavoriteGenre = &FavoriteGenreResponse{ID: genre.GenreID, Name: genre.Name, Bookings: genre.Bookings}
+	}
+	return resp
 }
 
 func AuthToResponse(user *entity.User, session *entity.Session) AuthResponse {
@@ -54,3 +121,29 @@ func AuthToResponse(user *entity.User, session *entity.Session) AuthResponse {
 
 	return resp
 }
+
+// ImpersonationResponse token session yang bertindak sebagai user; Impersonation selalu true
+// supaya client bisa menampilkan banner "sedang login sebagai"
+type ImpersonationResponse struct {
+	Token          string          `json:"token"`
+	ExpiresAt      time.Time       `json:"expires_at"`
+	UserID         string          `json:"user_id"`
+	Username       string          `json:"username"`
+	Email          string          `json:"email"`
+	Role           entity.UserRole `json:"role"`
+	Impersonation  bool            `json:"impersonation"`
+	ImpersonatorID string          `json:"impersonator_id"`
+}
+
+func ImpersonationToResponse(user *entity.User, session *entity.Session) ImpersonationResponse {
+	return ImpersonationResponse{
+		Token:          session.Token.String(),
+		ExpiresAt:      session.ExpiresAt,
+		UserID:         user.ID.String(),
+		Username:       user.Username,
+		Email:          user.Email,
+		Role:           user.Role,
+		Impersonation:  true,
+		ImpersonatorID: session.ImpersonatorID.String(),
+	}
+}
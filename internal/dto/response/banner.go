@@ -0,0 +1,59 @@
+package response
+
+import (
+	"time"
+
+	"cinema-booking/internal/data/entity"
+)
+
+// BannerResponse untuk admin; IsLive menunjukkan banner sedang tampil saat response dibuat
+type BannerResponse struct {
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	ImageURL  string     `json:"image_url"`
+	LinkURL   *string    `json:"link_url,omitempty"`
+	City      *string    `json:"city,omitempty"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at,omitempty"`
+	SortOrder int        `json:"sort_order"`
+	IsActive  bool       `json:"is_active"`
+	IsLive    bool       `json:"is_live"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// PublicBannerResponse hanya field yang dibutuhkan app untuk render carousel
+type PublicBannerResponse struct {
+	ID       string     `json:"id"`
+	Title    string     `json:"title"`
+	ImageURL string     `json:"image_url"`
+	LinkURL  *string    `json:"link_url,omitempty"`
+	EndsAt   *time.Time `json:"ends_at,omitempty"`
+}
+
+func BannerToResponse(banner *entity.Banner, now time.Time) BannerResponse {
+	return BannerResponse{
+		ID:        banner.ID.String(),
+		Title:     banner.Title,
+		ImageURL:  banner.ImageURL,
+		LinkURL:   banner.LinkURL,
+		City:      banner.City,
+		StartsAt:  banner.StartsAt,
+		EndsAt:    banner.EndsAt,
+		SortOrder: banner.SortOrder,
+		IsActive:  banner.IsActive,
+		IsLive:    banner.IsLive(now, ""),
+		CreatedAt: banner.CreatedAt,
+		UpdatedAt: banner.UpdatedAt,
+	}
+}
+
+func BannerToPublicResponse(banner *entity.Banner) PublicBannerResponse {
+	return PublicBannerResponse{
+		ID:       banner.ID.String(),
+		Title:    banner.Title,
+		ImageURL: banner.ImageURL,
+		LinkURL:  banner.LinkURL,
+		EndsAt:   banner.EndsAt,
+	}
+}
@@ -2,15 +2,10 @@ package response
 
 import (
 	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
 	"time"
 )
 
-type PaymentMethodResponse struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	IsActive bool   `json:"is_active"`
-}
-
 type BookingResponse struct {
 	ID          string               `json:"id"`
 	OrderID     string               `json:"order_id"`
@@ -27,49 +22,269 @@ type BookingResponse struct {
 	SeatNumbers []string             `json:"seat_numbers,omitempty"`
 	Payment     *PaymentResponse     `json:"payment,omitempty"`
 	CreatedAt   time.Time            `json:"created_at"`
+
+	Currency            string          `json:"currency"`
+	TotalPriceFormatted string          `json:"total_price_formatted"`
+	PriceBreakdown      *PriceBreakdown `json:"price_breakdown,omitempty"`
+
+	// Batas bayar booking pending; kursi dilepas setelah ExpiresAt, client menampilkan countdown
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	RemainingSeconds *int64     `json:"remaining_seconds,omitempty"`
+
+	// Warnings hanya diisi saat create, mis. klasifikasi usia dengan AGE_RATING_ENFORCEMENT=warn
+	Warnings []string `json:"warnings,omitempty"`
+
+	// Flags penanda support, hanya diisi di endpoint admin
+	Flags []entity.BookingFlag `json:"flags,omitempty"`
 }
 
 type PaymentResponse struct {
-	ID            string                `json:"id"`
-	BookingID     string                `json:"booking_id"`
-	PaymentMethod PaymentMethodResponse `json:"payment_method"`
-	Amount        float64               `json:"amount"`
-	Status        entity.PaymentStatus  `json:"status"`
-	TransactionID *string               `json:"transaction_id,omitempty"`
-	CreatedAt     time.Time             `json:"created_at"`
+	ID              string                `json:"id"`
+	BookingID       string                `json:"booking_id"`
+	PaymentMethod   PaymentMethodResponse `json:"payment_method"`
+	Amount          float64               `json:"amount"`
+	Currency        string                `json:"currency"`
+	AmountFormatted string                `json:"amount_formatted"`
+	PriceBreakdown  *PriceBreakdown       `json:"price_breakdown,omitempty"`
+	Status          entity.PaymentStatus  `json:"status"`
+	TransactionID   *string               `json:"transaction_id,omitempty"`
+	CreatedAt       time.Time             `json:"created_at"`
+
+	// Bagian total yang dibayar dari saldo gift card; Amount di atas hanya porsi payment method
+	GiftCardAmount *float64 `json:"gift_card_amount,omitempty"`
+
+	// Diisi untuk method async: user transfer ke VANumber / scan QRISString sebelum ExpiresAt
+	VANumber         *string    `json:"va_number,omitempty"`
+	QRISString       *string    `json:"qris_string,omitempty"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	RemainingSeconds *int64     `json:"remaining_seconds,omitempty"`
+	PaidAt           *time.Time `json:"paid_at,omitempty"`
+
+	// StatusHistory hanya diisi di detail booking admin
+	StatusHistory []PaymentStatusChangeResponse `json:"status_history,omitempty"`
+}
+
+// PaymentStatusResponse ringkas untuk polling GET /api/payments/{id}/status
+type PaymentStatusResponse struct {
+	PaymentID     string               `json:"payment_id"`
+	BookingID     string               `json:"booking_id"`
+	OrderID       string               `json:"order_id"`
+	Status        entity.PaymentStatus `json:"status"`
+	BookingStatus entity.BookingStatus `json:"booking_status"`
+	ExpiresAt     *time.Time           `json:"expires_at,omitempty"`
+	// RemainingSeconds hanya selama payment masih pending
+	RemainingSeconds *int64     `json:"remaining_seconds,omitempty"`
+	PaidAt           *time.Time `json:"paid_at,omitempty"`
+}
+
+// PriceBreakdown rincian harga yang dihitung server (major unit); Total = Base - Discount + Fees + Tax
+type PriceBreakdown struct {
+	Currency       string  `json:"currency"`
+	SeatPrice      float64 `json:"seat_price"`
+	Seats          int     `json:"seats"`
+	Base           float64 `json:"base"`
+	Discount       float64 `json:"discount"`
+	Fees           float64 `json:"fees"`
+	Tax            float64 `json:"tax"`
+	Total          float64 `json:"total"`
+	TotalFormatted string  `json:"total_formatted"`
 }
 
 type BookingDetailResponse struct {
 	BookingResponse
 	ScheduleDetails ScheduleDetails `json:"schedule_details"`
+
+	// InternalNotes catatan support, hanya diisi di endpoint admin
+	InternalNotes []BookingNoteResponse `json:"internal_notes,omitempty"`
+	// StatusHistory riwayat perpindahan status, juga khusus admin
+	StatusHistory []BookingStatusChangeResponse `json:"status_history,omitempty"`
+}
+
+type BookingNoteResponse struct {
+	ID         string    `json:"id"`
+	BookingID  string    `json:"booking_id"`
+	AuthorID   string    `json:"author_id"`
+	AuthorName string    `json:"author_name,omitempty"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// BookingNoteToResponse; authorName kosong kalau akun penulis sudah dihapus
+func BookingNoteToResponse(note *entity.BookingNote, authorName string) BookingNoteResponse {
+	return BookingNoteResponse{
+		ID:         note.ID.String(),
+		BookingID:  note.BookingID.String(),
+		AuthorID:   note.AuthorID.String(),
+		AuthorName: authorName,
+		Body:       note.Body,
+		CreatedAt:  note.CreatedAt,
+	}
+}
+
+type BookingStatusChangeResponse struct {
+	From      entity.BookingStatus `json:"from"`
+	To        entity.BookingStatus `json:"to"`
+	ActorID   *string              `json:"actor_id,omitempty"`
+	Reason    *string              `json:"reason,omitempty"`
+	ChangedAt time.Time            `json:"changed_at"`
+}
+
+func BookingStatusChangeToResponse(change *entity.BookingStatusChange) BookingStatusChangeResponse {
+	resp := BookingStatusChangeResponse{
+		From:      change.FromStatus,
+		To:        change.ToStatus,
+		Reason:    change.Reason,
+		ChangedAt: change.CreatedAt,
+	}
+	if change.ActorID != nil {
+		actorID := change.ActorID.String()
+		resp.ActorID = &actorID
+	}
+	return resp
+}
+
+type PaymentStatusChangeResponse struct {
+	From      entity.PaymentStatus `json:"from"`
+	To        entity.PaymentStatus `json:"to"`
+	ActorID   *string              `json:"actor_id,omitempty"`
+	Reason    *string              `json:"reason,omitempty"`
+	ChangedAt time.Time            `json:"changed_at"`
+}
+
+func PaymentStatusChangeToResponse(change *entity.PaymentStatusChange) PaymentStatusChangeResponse {
+	resp := PaymentStatusChangeResponse{
+		From:      change.FromStatus,
+		To:        change.ToStatus,
+		Reason:    change.Reason,
+		ChangedAt: change.CreatedAt,
+	}
+	if change.ActorID != nil {
+		actorID := change.ActorID.String()
+		resp.ActorID = &actorID
+	}
+	return resp
+}
+
+// GroupBookingResponse block booking event beserta ticket per kursi
+type GroupBookingResponse struct {
+	BookingResponse
+	GroupName    string           `json:"group_name"`
+	Notes        *string          `json:"notes,omitempty"`
+	CreatedBy    string           `json:"created_by"`
+	Tickets      []TicketResponse `json:"tickets"`
+	BlockedSeats []string         `json:"blocked_seats"`
+}
+
+type TicketResponse struct {
+	SeatNumber string `json:"seat_number"`
+	TicketCode string `json:"ticket_code"`
 }
 
 type ScheduleDetails struct {
 	MovieTitle string  `json:"movie_title"`
 	CinemaName string  `json:"cinema_name"`
 	HallNumber int     `json:"hall_number"`
+	HallType   string  `json:"hall_type,omitempty"`
 	ShowDate   string  `json:"show_date"`
 	ShowTime   string  `json:"show_time"`
 	Price      float64 `json:"price"`
-}
 
-// Helper converters
-func PaymentMethodToResponse(pm *entity.PaymentMethod) PaymentMethodResponse {
-	return PaymentMethodResponse{
-		ID:       pm.ID.String(),
-		Name:     pm.Name,
-		IsActive: pm.IsActive,
-	}
+	Currency       string `json:"currency,omitempty"`
+	PriceFormatted string `json:"price_formatted,omitempty"`
 }
 
+// Helper converters
 func PaymentToResponse(payment *entity.Payment, paymentMethod *entity.PaymentMethod) PaymentResponse {
-	return PaymentResponse{
+	resp := PaymentResponse{
 		ID:            payment.ID.String(),
 		BookingID:     payment.BookingID.String(),
-		PaymentMethod: PaymentMethodToResponse(paymentMethod),
-		Amount:        payment.Amount,
+		PaymentMethod: PaymentMethodToResponse(paymentMethod, payment.Currency),
+		Amount:        utils.CurrencyOf(payment.Currency).ToMajor(payment.Amount),
 		Status:        payment.Status,
 		TransactionID: payment.TransactionID,
 		CreatedAt:     payment.CreatedAt,
+
+		Currency:        payment.Currency,
+		AmountFormatted: utils.CurrencyOf(payment.Currency).Format(payment.Amount),
+
+		ExpiresAt: payment.ExpiresAt,
+		PaidAt:    payment.PaidAt,
+	}
+
+	if payment.GiftCardAmount > 0 {
+		giftCardAmount := utils.CurrencyOf(payment.Currency).ToMajor(payment.GiftCardAmount)
+		resp.GiftCardAmount = &giftCardAmount
+	}
+
+	// Kode bayar hanya relevan selama payment masih menunggu transfer
+	if payment.Status == entity.PaymentStatusPending {
+		resp.RemainingSeconds = RemainingSeconds(payment.ExpiresAt)
+		switch paymentMethod.Type {
+		case entity.PaymentMethodTypeVirtualAccount:
+			resp.VANumber = payment.PaymentCode
+		case entity.PaymentMethodTypeQRIS:
+			resp.QRISString = payment.PaymentCode
+		}
+	}
+
+	return resp
+}
+
+func PaymentStatusToResponse(payment *entity.Payment, booking *entity.Booking) PaymentStatusResponse {
+	resp := PaymentStatusResponse{
+		PaymentID:     payment.ID.String(),
+		BookingID:     booking.ID.String(),
+		OrderID:       booking.OrderID,
+		Status:        payment.Status,
+		BookingStatus: booking.Status,
+		ExpiresAt:     payment.ExpiresAt,
+		PaidAt:        payment.PaidAt,
+	}
+	if payment.Status == entity.PaymentStatusPending {
+		resp.RemainingSeconds = RemainingSeconds(payment.ExpiresAt)
+	}
+	return resp
+}
+
+// SetBookingExpiry copies batas bayar dari payment pending ke booking yang masih pending
+func SetBookingExpiry(resp *BookingResponse) {
+	if resp.Status != entity.BookingStatusPending || resp.Payment == nil || resp.Payment.Status != entity.PaymentStatusPending {
+		return
+	}
+	resp.ExpiresAt = resp.Payment.ExpiresAt
+	resp.RemainingSeconds = resp.Payment.RemainingSeconds
+}
+
+// RemainingSeconds returns sisa detik sampai expiresAt (minimal 0), nil kalau tidak ada batas waktu
+func RemainingSeconds(expiresAt *time.Time) *int64 {
+	if expiresAt == nil {
+		return nil
+	}
+	remaining := int64(time.Until(*expiresAt).Seconds())
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &remaining
+}
+
+// BookingPriceBreakdown maps the stored price columns booking ke major unit currency-nya
+func BookingPriceBreakdown(booking *entity.Booking) PriceBreakdown {
+	currency := utils.CurrencyOf(booking.Currency)
+
+	var seatPrice int64
+	if booking.TotalSeats > 0 {
+		seatPrice = booking.BasePrice / int64(booking.TotalSeats)
+	}
+
+	return PriceBreakdown{
+		Currency:       currency.Code,
+		SeatPrice:      currency.ToMajor(seatPrice),
+		Seats:          booking.TotalSeats,
+		Base:           currency.ToMajor(booking.BasePrice),
+		Discount:       currency.ToMajor(booking.DiscountAmount),
+		Fees:           currency.ToMajor(booking.FeeAmount),
+		Tax:            currency.ToMajor(booking.TaxAmount),
+		Total:          currency.ToMajor(booking.TotalPrice),
+		TotalFormatted: currency.Format(booking.TotalPrice),
 	}
 }
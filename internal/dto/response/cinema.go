@@ -2,26 +2,80 @@ package response
 
 import (
 	"cinema-booking/internal/data/entity"
+	"math"
 	"time"
 )
 
 type CinemaResponse struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Location  string    `json:"location"`
-	City      string    `json:"city"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Location   string     `json:"location"`
+	City       string     `json:"city"`
+	Latitude   *float64   `json:"latitude,omitempty"`
+	Longitude  *float64   `json:"longitude,omitempty"`
+	Facilities []string   `json:"facilities"`
+	Timezone   string     `json:"timezone"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+
+	// OrganizationID chain pemilik; kosong untuk cinema yang dikelola platform
+	OrganizationID *string `json:"organization_id,omitempty"`
+
+	// Closure diisi kalau cinema dijadwalkan atau sedang tutup
+	Closure *CinemaClosureResponse `json:"closure,omitempty"`
+}
+
+type CinemaClosureResponse struct {
+	Type        string  `json:"type"`
+	ClosedFrom  string  `json:"closed_from"`
+	ClosedUntil *string `json:"closed_until,omitempty"`
+	Reason      string  `json:"reason,omitempty"`
+}
+
+// CloseCinemaResponse hasil penutupan: schedule yang dibatalkan dan booking yang terdampak
+type CloseCinemaResponse struct {
+	Cinema             CinemaResponse `json:"cinema"`
+	SchedulesCancelled int            `json:"schedules_cancelled"`
+	BookingsCancelled  int            `json:"bookings_cancelled"`
+	BookingsRefunded   int            `json:"bookings_refunded"`
 }
 
 type CinemaDetailResponse struct {
 	CinemaResponse
-	Halls []HallResponse `json:"halls,omitempty"`
+	OpeningHours entity.OpeningHours `json:"opening_hours,omitempty"`
+	TaxRate      *float64            `json:"tax_rate,omitempty"`
+	Halls        []HallResponse      `json:"halls,omitempty"`
+
+	// Showtimes hanya diisi kalau ?date= dikirim, dikelompokkan per movie
+	ShowDate  string           `json:"show_date,omitempty"`
+	Showtimes []MovieShowtimes `json:"showtimes,omitempty"`
+}
+
+// MovieShowtimes jadwal satu movie di cinema pada satu tanggal, urut berdasarkan starts_at
+type MovieShowtimes struct {
+	MovieID           string             `json:"movie_id"`
+	Title             string             `json:"title"`
+	PosterURL         *string            `json:"poster_url,omitempty"`
+	Rating            float64            `json:"rating"`
+	DurationInMinutes int                `json:"duration_in_minutes"`
+	Schedules         []ScheduleResponse `json:"schedules"`
+}
+
+type NearbyCinemaResponse struct {
+	CinemaResponse
+	DistanceKm float64 `json:"distance_km"`
+}
+
+type CityResponse struct {
+	City        string `json:"city"`
+	CinemaCount int64  `json:"cinema_count"`
 }
 
 type HallResponse struct {
 	ID         string `json:"id"`
 	HallNumber int    `json:"hall_number"`
+	HallType   string `json:"hall_type"`
 	TotalSeats int    `json:"total_seats"`
 }
 
@@ -31,24 +85,90 @@ type SeatResponse struct {
 	SeatRow     string `json:"seat_row"`
 	SeatColumn  int    `json:"seat_column"`
 	IsAvailable bool   `json:"is_available"`
+	// Blocked kursi diblokir admin (rusak / jaga jarak), bukan karena sudah dipesan
+	Blocked bool `json:"blocked,omitempty"`
 }
 
 type SeatAvailabilityResponse struct {
-	HallID string         `json:"hall_id"`
-	Date   string         `json:"date"`
-	Time   string         `json:"time"`
-	Seats  []SeatResponse `json:"seats"`
+	HallID   string         `json:"hall_id"`
+	HallType string         `json:"hall_type"`
+	Date     string         `json:"date"`
+	Time     string         `json:"time"`
+	Seats    []SeatResponse `json:"seats"`
 }
 
 // Helper converters
+// Nama pengecekan di CapacityViolationResponse.Check
+const (
+	CapacityCheckHallSeatMap     = "hall_seat_map"
+	CapacityCheckScheduleCounter = "schedule_counter"
+)
+
+// CapacityCheckResponse hasil pengecekan kapasitas hall dan counter kursi schedule
+type CapacityCheckResponse struct {
+	CheckedAt  time.Time                   `json:"checked_at"`
+	OK         bool                        `json:"ok"`
+	Violations []CapacityViolationResponse `json:"violations"`
+}
+
+// CapacityViolationResponse satu hall atau schedule yang tidak konsisten. Untuk hall_seat_map
+// Expected = total_seats dan Actual = jumlah seat row; untuk schedule_counter Expected = kursi
+// aktif di booking_seats dan Actual = booked_seats.
+type CapacityViolationResponse struct {
+	Check      string  `json:"check"`
+	HallID     string  `json:"hall_id"`
+	ScheduleID *string `json:"schedule_id,omitempty"`
+	Expected   int     `json:"expected"`
+	Actual     int     `json:"actual"`
+	Message    string  `json:"message"`
+}
+
 func CinemaToResponse(cinema *entity.Cinema) CinemaResponse {
-	return CinemaResponse{
-		ID:        cinema.ID.String(),
-		Name:      cinema.Name,
-		Location:  cinema.Location,
-		City:      cinema.City,
-		CreatedAt: cinema.CreatedAt,
-		UpdatedAt: cinema.UpdatedAt,
+	facilities := make([]string, len(cinema.Facilities))
+	for i, facility := range cinema.Facilities {
+		facilities[i] = string(facility)
+	}
+
+	resp := CinemaResponse{
+		ID:         cinema.ID.String(),
+		Name:       cinema.Name,
+		Location:   cinema.Location,
+		City:       cinema.City,
+		Latitude:   cinema.Latitude,
+		Longitude:  cinema.Longitude,
+		Facilities: facilities,
+		Timezone:   cinema.TimeLocation().String(),
+		CreatedAt:  cinema.CreatedAt,
+		UpdatedAt:  cinema.UpdatedAt,
+		DeletedAt:  cinema.DeletedAt,
+	}
+	if cinema.OrganizationID != nil {
+		organizationID := cinema.OrganizationID.String()
+		resp.OrganizationID = &organizationID
+	}
+	if cinema.ClosureType != nil && cinema.ClosedFrom != nil {
+		closure := &CinemaClosureResponse{
+			Type:       string(*cinema.ClosureType),
+			ClosedFrom: cinema.ClosedFrom.Format("2006-01-02"),
+		}
+		if cinema.ClosedUntil != nil {
+			closedUntil := cinema.ClosedUntil.Format("2006-01-02")
+			closure.ClosedUntil = &closedUntil
+		}
+		if cinema.ClosureReason != nil {
+			closure.Reason = *cinema.ClosureReason
+		}
+		resp.Closure = closure
+	}
+
+	return resp
+}
+
+func NearbyCinemaToResponse(cinema *entity.NearbyCinema) NearbyCinemaResponse {
+	return NearbyCinemaResponse{
+		CinemaResponse: CinemaToResponse(&cinema.Cinema),
+		// Dibulatkan ke 2 desimal (10 meter) untuk tampilan
+		DistanceKm: math.Round(cinema.DistanceKm*100) / 100,
 	}
 }
 
@@ -56,6 +176,7 @@ func HallToResponse(hall *entity.Hall) HallResponse {
 	return HallResponse{
 		ID:         hall.ID.String(),
 		HallNumber: hall.HallNumber,
+		HallType:   string(hall.HallType),
 		TotalSeats: hall.TotalSeats,
 	}
 }
@@ -67,5 +188,6 @@ func SeatToResponse(seat *entity.Seat) SeatResponse {
 		SeatRow:     seat.SeatRow,
 		SeatColumn:  seat.SeatColumn,
 		IsAvailable: seat.IsAvailable,
+		Blocked:     !seat.IsAvailable,
 	}
 }
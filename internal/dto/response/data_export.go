@@ -0,0 +1,163 @@
+package response
+
+import (
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+)
+
+// DataExportResponse status permintaan export. DownloadURL relatif terhadap host API
+// dan hanya ada selama file masih tersedia; Error dan UserID untuk log admin.
+type DataExportResponse struct {
+	ID           string                  `json:"id"`
+	UserID       string                  `json:"user_id,omitempty"`
+	Format       entity.DataExportFormat `json:"format"`
+	Status       entity.DataExportStatus `json:"status"`
+	SizeBytes    *int64                  `json:"size_bytes,omitempty"`
+	Error        *string                 `json:"error,omitempty"`
+	CreatedAt    time.Time               `json:"created_at"`
+	CompletedAt  *time.Time              `json:"completed_at,omitempty"`
+	ExpiresAt    *time.Time              `json:"expires_at,omitempty"`
+	DownloadedAt *time.Time              `json:"downloaded_at,omitempty"`
+	DownloadURL  *string                 `json:"download_url,omitempty"`
+}
+
+func DataExportToResponse(export *entity.DataExport) DataExportResponse {
+	return DataExportResponse{
+		ID:           export.ID.String(),
+		Format:       export.Format,
+		Status:       export.Status,
+		SizeBytes:    export.SizeBytes,
+		CreatedAt:    export.CreatedAt,
+		CompletedAt:  export.CompletedAt,
+		ExpiresAt:    export.ExpiresAt,
+		DownloadedAt: export.DownloadedAt,
+	}
+}
+
+// DataExportToAdminResponse menambah pemilik dan pesan error untuk log admin, tanpa link download
+func DataExportToAdminResponse(export *entity.DataExport) DataExportResponse {
+	resp := DataExportToResponse(export)
+	resp.UserID = export.UserID.String()
+	resp.Error = export.Error
+	return resp
+}
+
+// PersonalDataResponse isi file export. Format zip menyimpan tiap section sebagai file JSON terpisah.
+type PersonalDataResponse struct {
+	ExportedAt time.Time               `json:"exported_at"`
+	Profile    UserResponse            `json:"profile"`
+	Bookings   []ExportBookingResponse `json:"bookings"`
+	Payments   []ExportPaymentResponse `json:"payments"`
+	Reviews    []ExportReviewResponse  `json:"reviews"`
+	Sessions   []ExportSessionResponse `json:"sessions"`
+}
+
+type ExportBookingResponse struct {
+	ID             string               `json:"id"`
+	OrderID        string               `json:"order_id"`
+	ScheduleID     string               `json:"schedule_id"`
+	TotalSeats     int                  `json:"total_seats"`
+	BasePrice      float64              `json:"base_price"`
+	DiscountAmount float64              `json:"discount_amount"`
+	FeeAmount      float64              `json:"fee_amount"`
+	TaxAmount      float64              `json:"tax_amount"`
+	TotalPrice     float64              `json:"total_price"`
+	Currency       string               `json:"currency"`
+	Status         entity.BookingStatus `json:"status"`
+	CreatedAt      time.Time            `json:"created_at"`
+}
+
+type ExportPaymentResponse struct {
+	ID              string               `json:"id"`
+	BookingID       string               `json:"booking_id"`
+	PaymentMethodID string               `json:"payment_method_id"`
+	Amount          float64              `json:"amount"`
+	GiftCardAmount  float64              `json:"gift_card_amount"`
+	Currency        string               `json:"currency"`
+	Status          entity.PaymentStatus `json:"status"`
+	TransactionID   *string              `json:"transaction_id,omitempty"`
+	PaidAt          *time.Time           `json:"paid_at,omitempty"`
+	CreatedAt       time.Time            `json:"created_at"`
+}
+
+type ExportReviewResponse struct {
+	ID        string    `json:"id"`
+	MovieID   string    `json:"movie_id"`
+	Rating    int       `json:"rating"`
+	Comment   *string   `json:"comment,omitempty"`
+	Hidden    bool      `json:"hidden"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportSessionResponse riwayat login; token session sengaja tidak ikut diekspor
+type ExportSessionResponse struct {
+	ID        string     `json:"id"`
+	UserAgent *string    `json:"user_agent,omitempty"`
+	IPAddress *string    `json:"ip_address,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+	// Impersonated session dibuat admin support atas nama user
+	Impersonated bool `json:"impersonated,omitempty"`
+}
+
+func BookingToExportResponse(booking *entity.Booking) ExportBookingResponse {
+	currency := utils.CurrencyOf(booking.Currency)
+	return ExportBookingResponse{
+		ID:             booking.ID.String(),
+		OrderID:        booking.OrderID,
+		ScheduleID:     booking.ScheduleID.String(),
+		TotalSeats:     booking.TotalSeats,
+		BasePrice:      currency.ToMajor(booking.BasePrice),
+		DiscountAmount: currency.ToMajor(booking.DiscountAmount),
+		FeeAmount:      currency.ToMajor(booking.FeeAmount),
+		TaxAmount:      currency.ToMajor(booking.TaxAmount),
+		TotalPrice:     currency.ToMajor(booking.TotalPrice),
+		Currency:       booking.Currency,
+		Status:         booking.Status,
+		CreatedAt:      booking.CreatedAt,
+	}
+}
+
+func PaymentToExportResponse(payment *entity.Payment) ExportPaymentResponse {
+	currency := utils.CurrencyOf(payment.Currency)
+	return ExportPaymentResponse{
+		ID:              payment.ID.String(),
+		BookingID:       payment.BookingID.String(),
+		PaymentMethodID: payment.PaymentMethodID.String(),
+		Amount:          currency.ToMajor(payment.Amount),
+		GiftCardAmount:  currency.ToMajor(payment.GiftCardAmount),
+		Currency:        payment.Currency,
+		Status:          payment.Status,
+		TransactionID:   payment.TransactionID,
+		PaidAt:          payment.PaidAt,
+		CreatedAt:       payment.CreatedAt,
+	}
+}
+
+func ReviewToExportResponse(review *entity.Review) ExportReviewResponse {
+	return ExportReviewResponse{
+		ID:        review.ID.String(),
+		MovieID:   review.MovieID.String(),
+		Rating:    review.Rating,
+		Comment:   review.Comment,
+		Hidden:    review.IsHidden(),
+		CreatedAt: review.CreatedAt,
+	}
+}
+
+func SessionToExportResponse(session *entity.Session) ExportSessionResponse {
+	return ExportSessionResponse{
+		ID:        session.ID.String(),
+		UserAgent: session.UserAgent,
+		IPAddress: session.IPAddress,
+		CreatedAt: session.CreatedAt,
+		ExpiresAt: session.ExpiresAt,
+		RevokedAt: session.RevokedAt,
+
+		Impersonated: session.IsImpersonation(),
+	}
+}
@@ -0,0 +1,23 @@
+package response
+
+import "time"
+
+// FeatureFlagResponse status satu flag; Default dipakai kalau belum pernah diatur admin
+type FeatureFlagResponse struct {
+	Key            string     `json:"key"`
+	Description    string     `json:"description"`
+	Enabled        bool       `json:"enabled"`
+	RolloutPercent int        `json:"rollout_percent"`
+	Environments   []string   `json:"environments"`
+	Default        bool       `json:"default"`
+	Overridden     bool       `json:"overridden"`
+	ActiveHere     bool       `json:"active_here"`
+	UpdatedBy      *string    `json:"updated_by,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// FeatureFlagsResponse semua flag beserta environment instance yang menjawab request
+type FeatureFlagsResponse struct {
+	Environment string                `json:"environment"`
+	Flags       []FeatureFlagResponse `json:"flags"`
+}
@@ -14,3 +14,10 @@ func GenreToResponse(genre *entity.Genre) GenreResponse {
 		Name: genre.Name,
 	}
 }
+
+// GenreMergeResponse hasil merge genre duplikat ke genre target
+type GenreMergeResponse struct {
+	Target      GenreResponse `json:"target"`
+	MergedGenre GenreResponse `json:"merged_genre"`
+	MoviesMoved int64         `json:"movies_moved"`
+}
@@ -0,0 +1,119 @@
+package response
+
+import (
+	"strings"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+)
+
+// Status kartu dihitung dari flag, saldo dan masa berlaku; tidak disimpan di database
+const (
+	GiftCardStatusActive   = "active"
+	GiftCardStatusUsed     = "used" // saldo habis
+	GiftCardStatusExpired  = "expired"
+	GiftCardStatusInactive = "inactive"
+)
+
+// GiftCardResponse untuk admin, kode ditampilkan lengkap
+type GiftCardResponse struct {
+	ID               string     `json:"id"`
+	Code             string     `json:"code"`
+	InitialAmount    float64    `json:"initial_amount"`
+	Balance          float64    `json:"balance"`
+	BalanceFormatted string     `json:"balance_formatted"`
+	Currency         string     `json:"currency"`
+	Status           string     `json:"status"`
+	UserID           *string    `json:"user_id,omitempty"`
+	RedeemedAt       *time.Time `json:"redeemed_at,omitempty"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// UserGiftCardResponse kartu milik user; kode disamarkan kecuali 4 karakter terakhir
+type UserGiftCardResponse struct {
+	ID               string     `json:"id"`
+	Code             string     `json:"code"`
+	Balance          float64    `json:"balance"`
+	BalanceFormatted string     `json:"balance_formatted"`
+	Status           string     `json:"status"`
+	RedeemedAt       *time.Time `json:"redeemed_at,omitempty"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+}
+
+// GiftBalanceResponse total saldo yang bisa dipakai bayar sekarang plus rincian per kartu
+type GiftBalanceResponse struct {
+	Currency         string                 `json:"currency"`
+	Balance          float64                `json:"balance"`
+	BalanceFormatted string                 `json:"balance_formatted"`
+	Cards            []UserGiftCardResponse `json:"cards"`
+}
+
+func GiftCardToResponse(card *entity.GiftCard, now time.Time) GiftCardResponse {
+	currency := utils.CurrencyOf(card.Currency)
+	resp := GiftCardResponse{
+		ID:               card.ID.String(),
+		Code:             FormatGiftCardCode(card.Code),
+		InitialAmount:    currency.ToMajor(card.InitialAmount),
+		Balance:          currency.ToMajor(card.Balance),
+		BalanceFormatted: currency.Format(card.Balance),
+		Currency:         card.Currency,
+		Status:           GiftCardStatus(card, now),
+		RedeemedAt:       card.RedeemedAt,
+		ExpiresAt:        card.ExpiresAt,
+		CreatedAt:        card.CreatedAt,
+	}
+	if card.UserID != nil {
+		userID := card.UserID.String()
+		resp.UserID = &userID
+	}
+
+	return resp
+}
+
+func GiftCardToUserResponse(card *entity.GiftCard, now time.Time) UserGiftCardResponse {
+	currency := utils.CurrencyOf(card.Currency)
+	return UserGiftCardResponse{
+		ID:               card.ID.String(),
+		Code:             maskGiftCardCode(card.Code),
+		Balance:          currency.ToMajor(card.Balance),
+		BalanceFormatted: currency.Format(card.Balance),
+		Status:           GiftCardStatus(card, now),
+		RedeemedAt:       card.RedeemedAt,
+		ExpiresAt:        card.ExpiresAt,
+	}
+}
+
+// GiftCardStatus returns status kartu pada waktu now
+func GiftCardStatus(card *entity.GiftCard, now time.Time) string {
+	switch {
+	case !card.IsActive:
+		return GiftCardStatusInactive
+	case card.Expired(now):
+		return GiftCardStatusExpired
+	case card.Balance == 0:
+		return GiftCardStatusUsed
+	default:
+		return GiftCardStatusActive
+	}
+}
+
+// FormatGiftCardCode groups kode per 4 karakter, mis. ABCD-EFGH-JKLM-NPQR
+func FormatGiftCardCode(code string) string {
+	var b strings.Builder
+	for i, r := range code {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte('-')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func maskGiftCardCode(code string) string {
+	if len(code) <= 4 {
+		return FormatGiftCardCode(code)
+	}
+	return FormatGiftCardCode(strings.Repeat("*", len(code)-4) + code[len(code)-4:])
+}
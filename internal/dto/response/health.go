@@ -0,0 +1,35 @@
+package response
+
+const (
+	ReadinessReady    = "ready"
+	ReadinessDegraded = "degraded"
+	ReadinessNotReady = "not_ready"
+
+	DatabaseUp   = "up"
+	DatabaseDown = "down"
+)
+
+type ReadinessResponse struct {
+	Status   string            `json:"status"`
+	Database DatabaseReadiness `json:"database"`
+}
+
+type DatabaseReadiness struct {
+	Status string          `json:"status"`
+	Pools  []PoolReadiness `json:"pools"`
+}
+
+type PoolReadiness struct {
+	Pool          string  `json:"pool"`
+	Healthy       bool    `json:"healthy"`
+	MaxConns      int32   `json:"max_conns"`
+	TotalConns    int32   `json:"total_conns"`
+	AcquiredConns int32   `json:"acquired_conns"`
+	IdleConns     int32   `json:"idle_conns"`
+	Saturation    float64 `json:"saturation"`
+	Saturated     bool    `json:"saturated"`
+
+	// Kumulatif sejak start: acquire yang harus menunggu karena semua koneksi terpakai
+	EmptyAcquireCount  int64 `json:"empty_acquire_count"`
+	EmptyAcquireWaitMs int64 `json:"empty_acquire_wait_ms"`
+}
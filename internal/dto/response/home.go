@@ -0,0 +1,9 @@
+package response
+
+// HomeResponse aggregates the sections shown on the app home screen
+type HomeResponse struct {
+	NowPlaying      []MovieResponse  `json:"now_playing"`
+	ComingSoon      []MovieResponse  `json:"coming_soon"`
+	TopRated        []MovieResponse  `json:"top_rated"`
+	UpcomingBooking *BookingResponse `json:"upcoming_booking,omitempty"`
+}
@@ -0,0 +1,48 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+)
+
+type JobResponse struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Status      string          `json:"status"`
+	Payload     json.RawMessage `json:"payload"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"`
+	LockedUntil *time.Time      `json:"locked_until,omitempty"`
+	LastError   *string         `json:"last_error,omitempty"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+func JobToResponse(job *entity.Job) JobResponse {
+	return JobResponse{
+		ID:          job.ID.String(),
+		Type:        job.Type,
+		Status:      string(job.Status),
+		Payload:     job.Payload,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		LockedUntil: job.LockedUntil,
+		LastError:   job.LastError,
+		CompletedAt: job.CompletedAt,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+}
+
+// JobStatsResponse jumlah job per status; Dead yang naik berarti ada handler atau dependency yang rusak
+type JobStatsResponse struct {
+	Pending   int64 `json:"pending"`
+	Running   int64 `json:"running"`
+	Completed int64 `json:"completed"`
+	Dead      int64 `json:"dead"`
+}
@@ -0,0 +1,55 @@
+package response
+
+import (
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+)
+
+// Nama pengecekan di LedgerViolationResponse.Check
+const (
+	LedgerCheckUnbalanced        = "unbalanced_transaction"
+	LedgerCheckGiftCardLiability = "gift_card_liability"
+	LedgerCheckPaymentClearing   = "payment_clearing"
+	LedgerCheckPaymentPosting    = "payment_posting"
+)
+
+// LedgerBalanceResponse saldo satu akun; Balance = debit - credit, negatif berarti saldo credit
+type LedgerBalanceResponse struct {
+	Currency         string               `json:"currency"`
+	Account          entity.LedgerAccount `json:"account"`
+	Debit            float64              `json:"debit"`
+	Credit           float64              `json:"credit"`
+	Balance          float64              `json:"balance"`
+	BalanceFormatted string               `json:"balance_formatted"`
+}
+
+type LedgerCheckResponse struct {
+	CheckedAt  time.Time                 `json:"checked_at"`
+	OK         bool                      `json:"ok"`
+	Violations []LedgerViolationResponse `json:"violations"`
+}
+
+// LedgerViolationResponse satu invariant yang dilanggar. ReferenceID transaksi ledger atau payment,
+// kosong untuk selisih saldo akun per currency.
+type LedgerViolationResponse struct {
+	Check       string  `json:"check"`
+	Currency    string  `json:"currency"`
+	ReferenceID *string `json:"reference_id,omitempty"`
+	Expected    float64 `json:"expected"`
+	Actual      float64 `json:"actual"`
+	Message     string  `json:"message"`
+}
+
+func LedgerBalanceToResponse(balance *entity.LedgerAccountBalance) LedgerBalanceResponse {
+	currency := utils.CurrencyOf(balance.Currency)
+	return LedgerBalanceResponse{
+		Currency:         balance.Currency,
+		Account:          balance.Account,
+		Debit:            currency.ToMajor(balance.Debit),
+		Credit:           currency.ToMajor(balance.Credit),
+		Balance:          currency.ToMajor(balance.Debit - balance.Credit),
+		BalanceFormatted: currency.Format(balance.Debit - balance.Credit),
+	}
+}
@@ -0,0 +1,5 @@
+package response
+
+type LogLevelResponse struct {
+	Level string `json:"level"`
+}
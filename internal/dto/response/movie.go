@@ -7,23 +7,45 @@ import (
 )
 
 type MovieResponse struct {
-	ID                string    `json:"id"`
-	Title             string    `json:"title"`
-	Description       *string   `json:"description,omitempty"`
-	PosterURL         *string   `json:"poster_url,omitempty"`
-	Rating            float64   `json:"rating"`
-	ReviewCount       int       `json:"review_count"`
-	ReleaseDate       string    `json:"release_date"`
-	DurationInMinutes string    `json:"duration_in_minutes"`
-	Genres            []string  `json:"genres"`
-	ReleaseStatus     string    `json:"release_status"`
-	CreatedAt         time.Time `json:"created_at,omitempty"`
+	ID                string     `json:"id"`
+	Title             string     `json:"title"`
+	Description       *string    `json:"description,omitempty"`
+	PosterURL         *string    `json:"poster_url,omitempty"`
+	Rating            float64    `json:"rating"`
+	ReviewCount       int        `json:"review_count"`
+	ReleaseDate       string     `json:"release_date"`
+	DurationInMinutes string     `json:"duration_in_minutes"`
+	Genres            []string   `json:"genres"`
+	ReleaseStatus     string     `json:"release_status"`
+	AgeRating         string     `json:"age_rating"`
+	InWatchlist       *bool      `json:"in_watchlist,omitempty"`
+	CreatedAt         time.Time  `json:"created_at,omitempty"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+}
+
+// TrendingMovieResponse satu item carousel "popular now"; TicketsSold dihitung dalam window trending
+type TrendingMovieResponse struct {
+	MovieResponse
+	TicketsSold int64 `json:"tickets_sold"`
 }
 
 type MovieDetailResponse struct {
 	MovieResponse
 	Description *string    `json:"description,omitempty"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// Showtimes hanya diisi dengan ?include=schedules, dikelompokkan per cinema
+	Showtimes []CinemaShowtimes `json:"showtimes,omitempty"`
+}
+
+// CinemaShowtimes jadwal satu movie di satu cinema, urut berdasarkan starts_at
+type CinemaShowtimes struct {
+	CinemaID   string             `json:"cinema_id"`
+	CinemaName string             `json:"cinema_name"`
+	City       string             `json:"city"`
+	Location   string             `json:"location"`
+	Timezone   string             `json:"timezone,omitempty"`
+	Schedules  []ScheduleResponse `json:"schedules"`
 }
 
 // Helper converters
@@ -53,7 +75,9 @@ func MovieToResponse(movie *entity.Movie, genres []string, reviewCount int) Movi
 		DurationInMinutes: durationStr,
 		Genres:            genres,
 		ReleaseStatus:     statusStr,
+		AgeRating:         string(movie.AgeRating),
 		CreatedAt:         movie.CreatedAt,
+		DeletedAt:         movie.DeletedAt,
 	}
 }
 
@@ -65,3 +89,21 @@ func MovieToDetailResponse(movie *entity.Movie, genres []string, reviewCount int
 		UpdatedAt:     &movie.UpdatedAt,
 	}
 }
+
+// MovieImportRowResponse hasil per baris CSV; Line nomor baris di file (header = 1)
+type MovieImportRowResponse struct {
+	Line    int      `json:"line"`
+	Title   string   `json:"title"`
+	Status  string   `json:"status"` // imported, valid (dry run), failed
+	MovieID string   `json:"movie_id,omitempty"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+type MovieImportResponse struct {
+	DryRun    bool                      `json:"dry_run"`
+	TotalRows int                       `json:"total_rows"`
+	Imported  int                       `json:"imported"`
+	Valid     int                       `json:"valid"`
+	Failed    int                       `json:"failed"`
+	Rows      []*MovieImportRowResponse `json:"rows"`
+}
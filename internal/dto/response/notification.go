@@ -0,0 +1,50 @@
+package response
+
+import (
+	"cinema-booking/internal/data/entity"
+	"time"
+)
+
+type NotificationChannelSettingResponse struct {
+	Channel             entity.NotificationChannel `json:"channel"`
+	BookingConfirmation bool                       `json:"booking_confirmation"`
+	Reminders           bool                       `json:"reminders"`
+	Promotions          bool                       `json:"promotions"`
+	UpdatedAt           *time.Time                 `json:"updated_at,omitempty"`
+}
+
+type NotificationSettingsResponse struct {
+	Settings []NotificationChannelSettingResponse `json:"settings"`
+}
+
+// Helper converter
+func NotificationSettingToResponse(setting *entity.UserNotificationSetting) NotificationChannelSettingResponse {
+	resp := NotificationChannelSettingResponse{
+		Channel:             setting.Channel,
+		BookingConfirmation: setting.BookingConfirmation,
+		Reminders:           setting.Reminders,
+		Promotions:          setting.Promotions,
+	}
+
+	if !setting.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &setting.UpdatedAt
+	}
+
+	return resp
+}
+
+type DeviceResponse struct {
+	ID        string                `json:"id"`
+	Platform  entity.DevicePlatform `json:"platform"`
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+func DeviceToResponse(device *entity.UserDevice) DeviceResponse {
+	return DeviceResponse{
+		ID:        device.ID.String(),
+		Platform:  device.Platform,
+		CreatedAt: device.CreatedAt,
+		UpdatedAt: device.UpdatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package response
+
+import (
+	"time"
+
+	"cinema-booking/internal/data/entity"
+)
+
+type OrganizationResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+	// OrderPrefix nil berarti booking chain ini memakai prefix default
+	OrderPrefix *string   `json:"order_prefix"`
+	Cinemas     int64     `json:"cinemas"`
+	Admins      int64     `json:"admins"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// OrganizationToResponse; jumlah cinema dan admin dihitung terpisah oleh service
+func OrganizationToResponse(organization *entity.Organization, cinemas, admins int64) OrganizationResponse {
+	return OrganizationResponse{
+		ID:          organization.ID.String(),
+		Name:        organization.Name,
+		Slug:        organization.Slug,
+		OrderPrefix: organization.OrderPrefix,
+		Cinemas:     cinemas,
+		Admins:      admins,
+		CreatedAt:   organization.CreatedAt,
+		UpdatedAt:   organization.UpdatedAt,
+	}
+}
@@ -1,18 +1,23 @@
 package response
 
+import (
+	"time"
+
+	"cinema-booking/pkg/utils"
+)
+
 type PaginatedResponse[T any] struct {
 	Data       []T            `json:"data"`
 	Pagination PaginationMeta `json:"pagination"`
-}
 
-// PaginationMeta
-type PaginationMeta struct {
-	Total      int64 `json:"total"`
-	Page       int   `json:"page"`
-	PerPage    int   `json:"per_page"`
-	TotalPages int   `json:"total_pages"`
+	// LastModified updated_at terbaru dari data yang membentuk halaman ini, dasar ETag listing publik.
+	// Zero kalau service tidak mengisinya.
+	LastModified time.Time `json:"-"`
 }
 
+// PaginationMeta shares the shape written by utils.ResponsePaginated
+type PaginationMeta = utils.Pagination
+
 func NewPaginatedResponse[T any](data []T, page, perPage int, total int64) *PaginatedResponse[T] {
 	totalPages := 0
 	if perPage > 0 {
@@ -22,10 +27,23 @@ func NewPaginatedResponse[T any](data []T, page, perPage int, total int64) *Pagi
 	return &PaginatedResponse[T]{
 		Data: data,
 		Pagination: PaginationMeta{
-			Page:       page,
-			PerPage:    perPage,
-			Total:      total,
-			TotalPages: totalPages,
+			CurrentPage:  page,
+			Limit:        perPage,
+			TotalRecords: total,
+			TotalPages:   totalPages,
+		},
+	}
+}
+
+func NewCursorPaginatedResponse[T any](data []T, perPage int, nextCursor string) *PaginatedResponse[T] {
+	hasMore := nextCursor != ""
+
+	return &PaginatedResponse[T]{
+		Data: data,
+		Pagination: PaginationMeta{
+			Limit:      perPage,
+			NextCursor: nextCursor,
+			HasMore:    &hasMore,
 		},
 	}
 }
@@ -0,0 +1,53 @@
+package response
+
+import (
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+)
+
+type PaymentMethodResponse struct {
+	ID           string                    `json:"id"`
+	Name         string                    `json:"name"`
+	Type         entity.PaymentMethodType  `json:"type"`
+	IsActive     bool                      `json:"is_active"`
+	LogoURL      *string                   `json:"logo_url,omitempty"`
+	Instructions *string                   `json:"instructions,omitempty"`
+	Fee          *PaymentMethodFeeResponse `json:"fee,omitempty"`
+}
+
+// PaymentMethodFeeResponse override fee method; field kosong berarti pakai default
+type PaymentMethodFeeResponse struct {
+	Currency                string   `json:"currency"`
+	ConvenienceFee          *float64 `json:"convenience_fee,omitempty"` // per kursi, major unit
+	ConvenienceFeeFormatted *string  `json:"convenience_fee_formatted,omitempty"`
+	Percent                 *float64 `json:"percent,omitempty"`
+}
+
+// PaymentMethodToResponse converts fee ke major unit currencyCode
+func PaymentMethodToResponse(pm *entity.PaymentMethod, currencyCode string) PaymentMethodResponse {
+	resp := PaymentMethodResponse{
+		ID:           pm.ID.String(),
+		Name:         pm.Name,
+		Type:         pm.Type,
+		IsActive:     pm.IsActive,
+		LogoURL:      pm.LogoURL,
+		Instructions: pm.Instructions,
+	}
+
+	if pm.ConvenienceFee != nil || pm.FeePercent != nil {
+		currency := utils.CurrencyOf(currencyCode)
+		fee := &PaymentMethodFeeResponse{
+			Currency: currency.Code,
+			Percent:  pm.FeePercent,
+		}
+		if pm.ConvenienceFee != nil {
+			amount := currency.ToMajor(*pm.ConvenienceFee)
+			formatted := currency.Format(*pm.ConvenienceFee)
+			fee.ConvenienceFee = &amount
+			fee.ConvenienceFeeFormatted = &formatted
+		}
+		resp.Fee = fee
+	}
+
+	return resp
+}
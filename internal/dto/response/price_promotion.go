@@ -0,0 +1,59 @@
+package response
+
+import (
+	"time"
+
+	"cinema-booking/internal/data/entity"
+)
+
+type PricePromotionResponse struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	DiscountPercent float64   `json:"discount_percent"`
+	Weekdays        []int     `json:"weekdays"`
+	StartTime       string    `json:"start_time"`
+	EndTime         string    `json:"end_time"`
+	ValidFrom       *string   `json:"valid_from,omitempty"`
+	ValidUntil      *string   `json:"valid_until,omitempty"`
+	CinemaID        *string   `json:"cinema_id,omitempty"`
+	Tiers           []string  `json:"tiers"`
+	IsActive        bool      `json:"is_active"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func PricePromotionToResponse(promotion *entity.PricePromotion) PricePromotionResponse {
+	resp := PricePromotionResponse{
+		ID:              promotion.ID.String(),
+		Name:            promotion.Name,
+		DiscountPercent: promotion.DiscountPercent,
+		Weekdays:        make([]int, len(promotion.Weekdays)),
+		Tiers:           make([]string, len(promotion.Tiers)),
+		StartTime:       promotion.StartTime.Format("15:04"),
+		EndTime:         promotion.EndTime.Format("15:04"),
+		IsActive:        promotion.IsActive,
+		CreatedAt:       promotion.CreatedAt,
+		UpdatedAt:       promotion.UpdatedAt,
+	}
+
+	for i, day := range promotion.Weekdays {
+		resp.Weekdays[i] = int(day)
+	}
+	for i, tier := range promotion.Tiers {
+		resp.Tiers[i] = string(tier)
+	}
+	if promotion.ValidFrom != nil {
+		validFrom := promotion.ValidFrom.Format("2006-01-02")
+		resp.ValidFrom = &validFrom
+	}
+	if promotion.ValidUntil != nil {
+		validUntil := promotion.ValidUntil.Format("2006-01-02")
+		resp.ValidUntil = &validUntil
+	}
+	if promotion.CinemaID != nil {
+		cinemaID := promotion.CinemaID.String()
+		resp.CinemaID = &cinemaID
+	}
+
+	return resp
+}
@@ -0,0 +1,177 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Fields daftar field JSON yang diminta client lewat ?fields=; kosong berarti response lengkap
+type Fields []string
+
+// jsonField satu field yang bisa dipilih: nama di JSON, index reflect dan apakah omitempty
+type jsonField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// projectionFields cache daftar field per response type, diurutkan sesuai deklarasi struct
+var projectionFields sync.Map // reflect.Type -> []jsonField
+
+// ParseFields splits ?fields=id,title dan menolak nama yang tidak ada di JSON response T
+func ParseFields[T any](raw string) (Fields, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	known := fieldsOf(reflect.TypeFor[T]())
+	var fields Fields
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !hasJSONField(known, name) {
+			return nil, fmt.Errorf("invalid field %q, allowed: %s", name, strings.Join(fieldNames(known), ", "))
+		}
+		fields = append(fields, name)
+	}
+
+	return fields, nil
+}
+
+// Project returns items apa adanya kalau fields kosong, atau salinan yang hanya berisi field terpilih.
+// Aturan omitempty tetap berlaku, jadi field yang diminta bisa absen kalau nilainya kosong.
+func Project[T any](items []T, fields Fields) any {
+	if len(fields) == 0 {
+		return items
+	}
+
+	var selected []jsonField
+	for _, field := range fieldsOf(reflect.TypeFor[T]()) {
+		if slices.Contains(fields, field.name) {
+			selected = append(selected, field)
+		}
+	}
+
+	projected := make([]projection, len(items))
+	for i := range items {
+		value := reflect.ValueOf(&items[i]).Elem()
+		for _, field := range selected {
+			fieldValue := value.FieldByIndex(field.index)
+			if field.omitEmpty && fieldValue.IsZero() {
+				continue
+			}
+			projected[i] = append(projected[i], projectedValue{name: field.name, value: fieldValue.Interface()})
+		}
+	}
+
+	return projected
+}
+
+// projection satu item hasil Project; MarshalJSON menjaga urutan field seperti struct aslinya
+type projection []projectedValue
+
+type projectedValue struct {
+	name  string
+	value any
+}
+
+func (p projection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range p {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(field.name)
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("encode field %s: %w", field.name, err)
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// fieldsOf reads json tag dari struct, termasuk field embedded tanpa nama JSON
+func fieldsOf(t reflect.Type) []jsonField {
+	if cached, ok := projectionFields.Load(t); ok {
+		return cached.([]jsonField)
+	}
+
+	fields := collectFields(t, nil)
+	projectionFields.Store(t, fields)
+	return fields
+}
+
+func collectFields(t reflect.Type, parent []int) []jsonField {
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" || (!sf.IsExported() && !sf.Anonymous) {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		index := append(append([]int{}, parent...), i)
+
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			// Field di struct luar menimpa field embedded dengan nama sama, seperti encoding/json
+			for _, embedded := range collectFields(sf.Type, index) {
+				if !hasJSONField(fields, embedded.name) && !declaresJSONField(t, embedded.name) {
+					fields = append(fields, embedded)
+				}
+			}
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, jsonField{name: name, index: index, omitEmpty: strings.Contains(opts, "omitempty")})
+	}
+	return fields
+}
+
+// declaresJSONField reports whether struct t sendiri (bukan embedded) punya field JSON name
+func declaresJSONField(t reflect.Type, name string) bool {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous {
+			continue
+		}
+		tagName, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if tagName == name || (tagName == "" && sf.Name == name) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasJSONField(fields []jsonField, name string) bool {
+	for _, field := range fields {
+		if field.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldNames(fields []jsonField) []string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.name
+	}
+	return names
+}
@@ -0,0 +1,245 @@
+package response
+
+import (
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+)
+
+type SalesReportRowResponse struct {
+	Key           string  `json:"key"`
+	Label         string  `json:"label"`
+	Revenue       float64 `json:"revenue"`
+	TicketsSold   int64   `json:"tickets_sold"`
+	Bookings      int64   `json:"bookings"`
+	Capacity      int64   `json:"capacity"`
+	OccupancyRate float64 `json:"occupancy_rate"`
+}
+
+type SalesReportResponse struct {
+	StartDate string                    `json:"start_date"`
+	EndDate   string                    `json:"end_date"`
+	GroupBy   string                    `json:"group_by"`
+	Currency  string                    `json:"currency"`
+	Rows      []*SalesReportRowResponse `json:"rows"`
+	Totals    SalesReportRowResponse    `json:"totals"`
+}
+
+type SalesSummaryResponse struct {
+	Date            string  `json:"date"`
+	Currency        string  `json:"currency"`
+	Revenue         float64 `json:"revenue"`
+	BookingsCreated int64   `json:"bookings_created"`
+	TicketsSold     int64   `json:"tickets_sold"`
+	PendingBookings int64   `json:"pending_bookings"`
+	ShowsToday      int64   `json:"shows_today"`
+	SeatsSoldToday  int64   `json:"seats_sold_today"`
+	CapacityToday   int64   `json:"capacity_today"`
+	OccupancyRate   float64 `json:"occupancy_rate"`
+}
+
+// OccupancyRate returns sold/capacity as a percentage rounded to 2 decimals
+func OccupancyRate(sold, capacity int64) float64 {
+	if capacity <= 0 {
+		return 0
+	}
+	rate := float64(sold) / float64(capacity) * 100
+	return float64(int64(rate*100+0.5)) / 100
+}
+
+// SalesReportRowToResponse revenue row diasumsikan dalam currency laporan
+func SalesReportRowToResponse(row *entity.SalesReportRow, currency utils.Currency) *SalesReportRowResponse {
+	return &SalesReportRowResponse{
+		Key:           row.GroupKey,
+		Label:         row.Label,
+		Revenue:       currency.ToMajor(row.Revenue),
+		TicketsSold:   row.TicketsSold,
+		Bookings:      row.Bookings,
+		Capacity:      row.Capacity,
+		OccupancyRate: OccupancyRate(row.TicketsSold, row.Capacity),
+	}
+}
+
+func SalesSummaryToResponse(summary *entity.SalesSummary, currency utils.Currency) *SalesSummaryResponse {
+	return &SalesSummaryResponse{
+		Date:            summary.Date.Format("2006-01-02"),
+		Currency:        currency.Code,
+		Revenue:         currency.ToMajor(summary.Revenue),
+		BookingsCreated: summary.BookingsCreated,
+		TicketsSold:     summary.TicketsSold,
+		PendingBookings: summary.PendingBookings,
+		ShowsToday:      summary.ShowsToday,
+		SeatsSoldToday:  summary.SeatsSoldToday,
+		CapacityToday:   summary.CapacityToday,
+		OccupancyRate:   OccupancyRate(summary.SeatsSoldToday, summary.CapacityToday),
+	}
+}
+
+type ScheduleOccupancyResponse struct {
+	ScheduleID    string  `json:"schedule_id"`
+	MovieID       string  `json:"movie_id"`
+	MovieTitle    string  `json:"movie_title"`
+	HallID        string  `json:"hall_id"`
+	HallNumber    int     `json:"hall_number"`
+	ShowTime      string  `json:"show_time"`
+	Capacity      int     `json:"capacity"`
+	SoldSeats     int     `json:"sold_seats"`
+	PendingSeats  int     `json:"pending_seats"`
+	OccupancyRate float64 `json:"occupancy_rate"`
+}
+
+type OccupancyReportResponse struct {
+	CinemaID      string                       `json:"cinema_id"`
+	CinemaName    string                       `json:"cinema_name"`
+	Date          string                       `json:"date"`
+	Schedules     []*ScheduleOccupancyResponse `json:"schedules"`
+	TotalCapacity int                          `json:"total_capacity"`
+	TotalSold     int                          `json:"total_sold"`
+	OccupancyRate float64                      `json:"occupancy_rate"`
+}
+
+func ScheduleOccupancyToResponse(row *entity.ScheduleOccupancyRow) *ScheduleOccupancyResponse {
+	return &ScheduleOccupancyResponse{
+		ScheduleID:    row.ScheduleID.String(),
+		MovieID:       row.MovieID.String(),
+		MovieTitle:    row.MovieTitle,
+		HallID:        row.HallID.String(),
+		HallNumber:    row.HallNumber,
+		ShowTime:      row.ShowTime.Format("15:04"),
+		Capacity:      row.Capacity,
+		SoldSeats:     row.SoldSeats,
+		PendingSeats:  row.PendingSeats,
+		OccupancyRate: OccupancyRate(int64(row.SoldSeats), int64(row.Capacity)),
+	}
+}
+
+type PaymentReportRowResponse struct {
+	ID                string                   `json:"id"`
+	BookingID         string                   `json:"booking_id"`
+	OrderID           string                   `json:"order_id"`
+	PaymentMethodID   string                   `json:"payment_method_id"`
+	PaymentMethodName string                   `json:"payment_method_name"`
+	PaymentMethodType entity.PaymentMethodType `json:"payment_method_type"`
+	Amount            float64                  `json:"amount"`
+	Currency          string                   `json:"currency"`
+	AmountFormatted   string                   `json:"amount_formatted"`
+	Status            entity.PaymentStatus     `json:"status"`
+	TransactionID     *string                  `json:"transaction_id,omitempty"`
+	CreatedAt         time.Time                `json:"created_at"`
+	PaidAt            *time.Time               `json:"paid_at,omitempty"`
+}
+
+// PaymentTotalBucketResponse jumlah payment per status atau per payment method
+type PaymentTotalBucketResponse struct {
+	Key      string  `json:"key"`
+	Label    string  `json:"label"`
+	Payments int64   `json:"payments"`
+	Amount   float64 `json:"amount"`
+}
+
+type PaymentReportTotalsResponse struct {
+	Payments int64                         `json:"payments"`
+	Amount   float64                       `json:"amount"`
+	ByStatus []*PaymentTotalBucketResponse `json:"by_status"`
+	ByMethod []*PaymentTotalBucketResponse `json:"by_method"`
+}
+
+type PaymentReportResponse struct {
+	StartDate  string                      `json:"start_date"`
+	EndDate    string                      `json:"end_date"`
+	Currency   string                      `json:"currency"`
+	Totals     PaymentReportTotalsResponse `json:"totals"`
+	Payments   []*PaymentReportRowResponse `json:"payments"`
+	Pagination PaginationMeta              `json:"pagination"`
+}
+
+func PaymentReportRowToResponse(row *entity.PaymentReportRow) *PaymentReportRowResponse {
+	currency := utils.CurrencyOf(row.Currency)
+
+	return &PaymentReportRowResponse{
+		ID:                row.ID.String(),
+		BookingID:         row.BookingID.String(),
+		OrderID:           row.OrderID,
+		PaymentMethodID:   row.PaymentMethodID.String(),
+		PaymentMethodName: row.PaymentMethodName,
+		PaymentMethodType: row.PaymentMethodType,
+		Amount:            currency.ToMajor(row.Amount),
+		Currency:          currency.Code,
+		AmountFormatted:   currency.Format(row.Amount),
+		Status:            row.Status,
+		TransactionID:     row.TransactionID,
+		CreatedAt:         row.CreatedAt,
+		PaidAt:            row.PaidAt,
+	}
+}
+
+// ReconciliationMismatchResponse satu temuan; Line nomor baris di settlement file (header = 1)
+type ReconciliationMismatchResponse struct {
+	Type             string               `json:"type"`
+	Line             int                  `json:"line,omitempty"`
+	TransactionID    string               `json:"transaction_id,omitempty"`
+	PaymentID        string               `json:"payment_id,omitempty"`
+	OrderID          string               `json:"order_id,omitempty"`
+	LocalStatus      entity.PaymentStatus `json:"local_status,omitempty"`
+	LocalAmount      *float64             `json:"local_amount,omitempty"`
+	SettlementAmount *float64             `json:"settlement_amount,omitempty"`
+	Detail           string               `json:"detail"`
+}
+
+type PaymentReconciliationResponse struct {
+	StartDate      string                            `json:"start_date"`
+	EndDate        string                            `json:"end_date"`
+	Currency       string                            `json:"currency"`
+	SettlementRows int                               `json:"settlement_rows"`
+	LocalPayments  int                               `json:"local_payments"`
+	Matched        int                               `json:"matched"`
+	Summary        map[string]int                    `json:"summary"` // jumlah mismatch per type
+	Mismatches     []*ReconciliationMismatchResponse `json:"mismatches"`
+}
+
+// FunnelReportResponse conversion funnel seluruh movie plus rincian per movie
+type FunnelReportResponse struct {
+	From   string                `json:"from"`
+	To     string                `json:"to"`
+	Steps  []FunnelStepResponse  `json:"steps"`
+	Movies []MovieFunnelResponse `json:"movies"`
+}
+
+type MovieFunnelResponse struct {
+	MovieID string               `json:"movie_id"`
+	Title   string               `json:"title"`
+	Steps   []FunnelStepResponse `json:"steps"`
+}
+
+// FunnelStepResponse persentase dalam 0-100. ConversionRate relatif ke step sebelumnya (step pertama 100),
+// DropOffRate sisanya, OverallRate relatif ke step pertama.
+type FunnelStepResponse struct {
+	Step           entity.FunnelStep `json:"step"`
+	Count          int64             `json:"count"`
+	ConversionRate float64           `json:"conversion_rate"`
+	DropOffRate    float64           `json:"drop_off_rate"`
+	OverallRate    float64           `json:"overall_rate"`
+}
+
+// FunnelStepsToResponse urut sesuai entity.FunnelSteps; step tanpa event diisi 0
+func FunnelStepsToResponse(counts map[entity.FunnelStep]int64) []FunnelStepResponse {
+	steps := make([]FunnelStepResponse, len(entity.FunnelSteps))
+	for i, step := range entity.FunnelSteps {
+		count := counts[step]
+		resp := FunnelStepResponse{Step: step, Count: count}
+		if i == 0 {
+			if count > 0 {
+				resp.ConversionRate, resp.OverallRate = 100, 100
+			}
+		} else {
+			// Event bisa hilang di tengah (mis. seat map di-cache client), jadi rate dibatasi 100
+			previous := counts[entity.FunnelSteps[i-1]]
+			resp.ConversionRate = min(OccupancyRate(count, previous), 100)
+			resp.DropOffRate = OccupancyRate(max(previous-count, 0), previous)
+			resp.OverallRate = min(OccupancyRate(count, counts[entity.FunnelSteps[0]]), 100)
+		}
+		steps[i] = resp
+	}
+	return steps
+}
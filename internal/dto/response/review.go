@@ -14,6 +14,31 @@ type ReviewResponse struct {
 	Rating     int       `json:"rating"`
 	Comment    *string   `json:"comment,omitempty"`
 	CreatedAt  time.Time `json:"created_at"`
+
+	// Hidden true kalau review disembunyikan dari publik menunggu moderasi (hanya terlihat oleh penulisnya)
+	Hidden bool `json:"hidden,omitempty"`
+
+	// Edited true kalau pemilik pernah mengubah review; EditedAt waktu edit terakhir
+	Edited   bool       `json:"edited"`
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+
+	// Reply balasan resmi cinema, nil kalau belum dibalas
+	Reply *ReviewReplyResponse `json:"reply,omitempty"`
+}
+
+type ReviewReplyResponse struct {
+	ID        string    `json:"id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ReportedReviewResponse item antrian moderasi admin
+type ReportedReviewResponse struct {
+	ReviewResponse
+	ReportCount int            `json:"report_count"`
+	HiddenAt    *time.Time     `json:"hidden_at,omitempty"`
+	Reasons     map[string]int `json:"reasons"`
 }
 
 type MovieReviewStats struct {
@@ -32,5 +57,38 @@ func ReviewToResponse(review *entity.Review, username, movieTitle string) Review
 		Rating:     review.Rating,
 		Comment:    review.Comment,
 		CreatedAt:  review.CreatedAt,
+		Hidden:     review.IsHidden(),
+		Edited:     review.EditedAt != nil,
+		EditedAt:   review.EditedAt,
+	}
+}
+
+// ReviewRevisionResponse isi review sebelum satu kali edit; CreatedAt = waktu edit tersebut
+type ReviewRevisionResponse struct {
+	ID        string    `json:"id"`
+	Rating    int       `json:"rating"`
+	Comment   *string   `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ReviewRevisionToResponse(revision *entity.ReviewRevision) ReviewRevisionResponse {
+	return ReviewRevisionResponse{
+		ID:        revision.ID.String(),
+		Rating:    revision.Rating,
+		Comment:   revision.Comment,
+		CreatedAt: revision.CreatedAt,
+	}
+}
+
+// ReviewReplyToResponse returns nil kalau review belum punya reply
+func ReviewReplyToResponse(reply *entity.ReviewReply) *ReviewReplyResponse {
+	if reply == nil {
+		return nil
+	}
+	return &ReviewReplyResponse{
+		ID:        reply.ID.String(),
+		Message:   reply.Message,
+		CreatedAt: reply.CreatedAt,
+		UpdatedAt: reply.UpdatedAt,
 	}
 }
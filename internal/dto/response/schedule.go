@@ -0,0 +1,154 @@
+package response
+
+import (
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+)
+
+type ScheduleResponse struct {
+	ID         string  `json:"id"`
+	MovieID    string  `json:"movie_id"`
+	HallID     string  `json:"hall_id"`
+	HallNumber int     `json:"hall_number"`
+	HallType   string  `json:"hall_type,omitempty"`
+	CinemaID   string  `json:"cinema_id"`
+	CinemaName string  `json:"cinema_name"`
+	ShowDate   string  `json:"show_date"`
+	ShowTime   string  `json:"show_time"`
+	Price      float64 `json:"price"`
+
+	// StartsAt dalam offset cinema, Timezone nama IANA-nya; show_date/show_time adalah jam lokal cinema
+	StartsAt time.Time `json:"starts_at"`
+	Timezone string    `json:"timezone,omitempty"`
+
+	Currency       string `json:"currency"`
+	PriceFormatted string `json:"price_formatted"`
+
+	// RegularPrice dan Promotion hanya diisi kalau Price sudah dipotong promo
+	RegularPrice          *float64 `json:"regular_price,omitempty"`
+	RegularPriceFormatted *string  `json:"regular_price_formatted,omitempty"`
+	Promotion             *string  `json:"promotion,omitempty"`
+	// PriceOverridden true kalau admin menetapkan harga khusus untuk schedule ini
+	PriceOverridden bool `json:"price_overridden,omitempty"`
+
+	Status      entity.ScheduleStatus `json:"status"`
+	PublishedAt *time.Time            `json:"published_at,omitempty"`
+
+	// SeatsLeft dari counter schedule; IsAlmostFull kalau sisa kursi <= almostFullRatio kapasitas
+	SeatsLeft    int  `json:"seats_left"`
+	IsAlmostFull bool `json:"is_almost_full"`
+}
+
+// PublishSchedulesResponse hasil bulk publish; schedule yang gagal validasi tetap draft
+type PublishSchedulesResponse struct {
+	Published []string                 `json:"published"`
+	Failed    []SchedulePublishFailure `json:"failed"`
+}
+
+// ScheduleCloneResponse hasil clone minggu. Dengan dry_run, Created berisi schedule yang akan dibuat
+// (ID-nya belum tersimpan) dan tidak ada yang ditulis ke database.
+type ScheduleCloneResponse struct {
+	DryRun          bool                    `json:"dry_run"`
+	SourceWeekStart string                  `json:"source_week_start"`
+	TargetWeekStart string                  `json:"target_week_start"`
+	Created         []ScheduleResponse      `json:"created"`
+	Skipped         []ScheduleCloneConflict `json:"skipped"`
+}
+
+// ScheduleCloneConflict satu schedule sumber yang tidak disalin beserta alasannya
+type ScheduleCloneConflict struct {
+	SourceScheduleID string `json:"source_schedule_id"`
+	HallID           string `json:"hall_id"`
+	ShowDate         string `json:"show_date"` // tanggal di minggu target
+	ShowTime         string `json:"show_time"`
+	Reason           string `json:"reason"`
+}
+
+type SchedulePublishFailure struct {
+	ScheduleID string `json:"schedule_id"`
+	Reason     string `json:"reason"`
+}
+
+// ScheduleToResponse hall dan cinema boleh nil kalau sudah dihapus
+// almostFullRatio batas sisa kursi (proporsi kapasitas) sebelum schedule ditandai hampir penuh
+const almostFullRatio = 0.1
+
+func ScheduleToResponse(schedule *entity.Schedule, hall *entity.Hall, cinema *entity.Cinema) ScheduleResponse {
+	resp := ScheduleResponse{
+		ID:       schedule.ID.String(),
+		MovieID:  schedule.MovieID.String(),
+		HallID:   schedule.HallID.String(),
+		ShowDate: schedule.ShowDate.Format("2006-01-02"),
+		ShowTime: schedule.ShowTime.Format("15:04"),
+		StartsAt: schedule.StartsAt.UTC(),
+
+		Status:      schedule.Status,
+		PublishedAt: schedule.PublishedAt,
+
+		PriceOverridden: schedule.PriceOverride != nil,
+
+		SeatsLeft: schedule.SeatsLeft(),
+	}
+	resp.IsAlmostFull = schedule.Capacity > 0 && float64(resp.SeatsLeft) <= float64(schedule.Capacity)*almostFullRatio
+	SetSchedulePrice(&resp, schedule.Price, schedule.Currency)
+
+	if hall != nil {
+		resp.HallNumber = hall.HallNumber
+		resp.HallType = string(hall.HallType)
+		resp.CinemaID = hall.CinemaID.String()
+	}
+	if cinema != nil {
+		resp.CinemaName = cinema.Name
+		loc := cinema.TimeLocation()
+		resp.StartsAt = schedule.StartsAt.In(loc)
+		resp.Timezone = loc.String()
+	}
+
+	return resp
+}
+
+// SetSchedulePrice mengisi price (minor unit) ke major unit beserta format tampilannya
+func SetSchedulePrice(resp *ScheduleResponse, price int64, currencyCode string) {
+	currency := utils.CurrencyOf(currencyCode)
+	resp.Price = currency.ToMajor(price)
+	resp.Currency = currency.Code
+	resp.PriceFormatted = currency.Format(price)
+}
+
+// SetSchedulePromotion menampilkan harga normal di samping Price yang sudah dipotong promo
+func SetSchedulePromotion(resp *ScheduleResponse, regularPrice int64, promotion *entity.PricePromotion) {
+	currency := utils.CurrencyOf(resp.Currency)
+	amount := currency.ToMajor(regularPrice)
+	formatted := currency.Format(regularPrice)
+
+	resp.RegularPrice = &amount
+	resp.RegularPriceFormatted = &formatted
+	resp.Promotion = &promotion.Name
+}
+
+// SeatBlockResponse kursi yang diblokir admin untuk satu schedule
+type SeatBlockResponse struct {
+	SeatID     string                 `json:"seat_id"`
+	SeatNumber string                 `json:"seat_number"`
+	Reason     entity.SeatBlockReason `json:"reason"`
+	Note       *string                `json:"note,omitempty"`
+	BlockedBy  *string                `json:"blocked_by,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+func SeatBlockToResponse(block *entity.SeatBlock, seatNumber string) SeatBlockResponse {
+	resp := SeatBlockResponse{
+		SeatID:     block.SeatID.String(),
+		SeatNumber: seatNumber,
+		Reason:     block.Reason,
+		Note:       block.Note,
+		CreatedAt:  block.CreatedAt,
+	}
+	if block.BlockedBy != nil {
+		blockedBy := block.BlockedBy.String()
+		resp.BlockedBy = &blockedBy
+	}
+	return resp
+}
@@ -0,0 +1,16 @@
+package response
+
+import "time"
+
+// SettingResponse nilai efektif satu setting beserta default config dan batas yang diizinkan
+type SettingResponse struct {
+	Key         string     `json:"key"`
+	Value       int        `json:"value"`
+	Default     int        `json:"default"`
+	Min         int        `json:"min"`
+	Max         int        `json:"max"`
+	Description string     `json:"description"`
+	Overridden  bool       `json:"overridden"`
+	UpdatedBy   *string    `json:"updated_by,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,45 @@
+package response
+
+import (
+	"cinema-booking/internal/data/entity"
+	"time"
+)
+
+type WaitlistEntryResponse struct {
+	ID             string                `json:"id"`
+	ScheduleID     string                `json:"schedule_id"`
+	SeatsRequested int                   `json:"seats_requested"`
+	Status         entity.WaitlistStatus `json:"status"`
+	Position       int64                 `json:"position,omitempty"`
+	OfferedAt      *time.Time            `json:"offered_at,omitempty"`
+	HoldExpiresAt  *time.Time            `json:"hold_expires_at,omitempty"`
+	HeldSeatIDs    []string              `json:"held_seat_ids,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+
+	// HoldRemainingSeconds countdown selama offer masih aktif
+	HoldRemainingSeconds *int64 `json:"hold_remaining_seconds,omitempty"`
+}
+
+// WaitlistEntryToResponse position hanya relevan untuk status waiting (0 = tidak ditampilkan)
+func WaitlistEntryToResponse(entry *entity.WaitlistEntry, position int64, holds []*entity.SeatHold) WaitlistEntryResponse {
+	resp := WaitlistEntryResponse{
+		ID:             entry.ID.String(),
+		ScheduleID:     entry.ScheduleID.String(),
+		SeatsRequested: entry.SeatsRequested,
+		Status:         entry.Status,
+		Position:       position,
+		OfferedAt:      entry.OfferedAt,
+		HoldExpiresAt:  entry.HoldExpiresAt,
+		CreatedAt:      entry.CreatedAt,
+	}
+
+	if entry.Status == entity.WaitlistStatusOffered {
+		resp.HoldRemainingSeconds = RemainingSeconds(entry.HoldExpiresAt)
+	}
+
+	for _, hold := range holds {
+		resp.HeldSeatIDs = append(resp.HeldSeatIDs, hold.SeatID.String())
+	}
+
+	return resp
+}
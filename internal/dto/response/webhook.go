@@ -0,0 +1,70 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+)
+
+type WebhookSubscriptionResponse struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	IsActive   bool     `json:"is_active"`
+	// Secret hanya dikembalikan saat create dan rotate
+	Secret    *string   `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func WebhookSubscriptionToResponse(subscription *entity.WebhookSubscription) WebhookSubscriptionResponse {
+	return WebhookSubscriptionResponse{
+		ID:         subscription.ID.String(),
+		Name:       subscription.Name,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		IsActive:   subscription.IsActive,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string                       `json:"id"`
+	EventID        string                       `json:"event_id"`
+	EventType      string                       `json:"event_type"`
+	Status         entity.WebhookDeliveryStatus `json:"status"`
+	Attempts       int                          `json:"attempts"`
+	NextAttemptAt  *time.Time                   `json:"next_attempt_at,omitempty"` // hanya untuk pending
+	LastAttemptAt  *time.Time                   `json:"last_attempt_at,omitempty"`
+	LastStatusCode *int                         `json:"last_status_code,omitempty"`
+	LastError      *string                      `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time                   `json:"delivered_at,omitempty"`
+	Payload        json.RawMessage              `json:"payload"`
+	CreatedAt      time.Time                    `json:"created_at"`
+}
+
+func WebhookDeliveryToResponse(delivery *entity.WebhookDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:             delivery.ID.String(),
+		EventID:        delivery.EventID.String(),
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		Payload:        delivery.Payload,
+		CreatedAt:      delivery.CreatedAt,
+	}
+
+	if delivery.Status == entity.WebhookDeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		resp.NextAttemptAt = &nextAttemptAt
+	}
+
+	return resp
+}
@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/internal/usecase"
+	cinemav1 "cinema-booking/pkg/pb/cinema/v1"
+
+	"go.uber.org/zap"
+)
+
+type bookingServer struct {
+	cinemav1.UnimplementedBookingServiceServer
+	service usecase.BookingService
+	log     *zap.Logger
+}
+
+func newBookingServer(service usecase.BookingService, log *zap.Logger) *bookingServer {
+	return &bookingServer{
+		service: service,
+		log:     log.With(zap.String("rpc", "booking")),
+	}
+}
+
+func (s *bookingServer) CreateBooking(ctx context.Context, in *cinemav1.CreateBookingRequest) (*cinemav1.CreateBookingResponse, error) {
+	req := &request.CreateBookingRequest{
+		ScheduleID:      in.GetScheduleId(),
+		SeatIDs:         in.GetSeatIds(),
+		PaymentMethodID: in.GetPaymentMethodId(),
+	}
+
+	booking, err := s.service.CreateBooking(ctx, in.GetUserId(), req)
+	if err != nil {
+		return nil, toStatus(s.log, err, "create booking")
+	}
+
+	return &cinemav1.CreateBookingResponse{
+		Booking: &cinemav1.Booking{
+			Id:          booking.ID,
+			OrderId:     booking.OrderID,
+			UserId:      booking.UserID,
+			ScheduleId:  booking.ScheduleID,
+			MovieTitle:  booking.MovieTitle,
+			CinemaName:  booking.CinemaName,
+			HallNumber:  int32(booking.HallNumber),
+			ShowDate:    booking.ShowDate,
+			ShowTime:    booking.ShowTime,
+			TotalSeats:  int32(booking.TotalSeats),
+			TotalPrice:  booking.TotalPrice,
+			Status:      string(booking.Status),
+			SeatNumbers: booking.SeatNumbers,
+			CreatedAt:   booking.CreatedAt.Format(time.RFC3339),
+
+			Currency:       booking.Currency,
+			PriceBreakdown: priceBreakdownToProto(booking.PriceBreakdown),
+		},
+	}, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func priceBreakdownToProto(breakdown *response.PriceBreakdown) *cinemav1.PriceBreakdown {
+	if breakdown == nil {
+		return nil
+	}
+
+	return &cinemav1.PriceBreakdown{
+		SeatPrice: breakdown.SeatPrice,
+		Seats:     int32(breakdown.Seats),
+		Base:      breakdown.Base,
+		Discount:  breakdown.Discount,
+		Fees:      breakdown.Fees,
+		Tax:       breakdown.Tax,
+		Total:     breakdown.Total,
+	}
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+
+	"cinema-booking/internal/usecase"
+	cinemav1 "cinema-booking/pkg/pb/cinema/v1"
+
+	"go.uber.org/zap"
+)
+
+type cinemaServer struct {
+	cinemav1.UnimplementedCinemaServiceServer
+	service usecase.CinemaService
+	log     *zap.Logger
+}
+
+func newCinemaServer(service usecase.CinemaService, log *zap.Logger) *cinemaServer {
+	return &cinemaServer{
+		service: service,
+		log:     log.With(zap.String("rpc", "cinema")),
+	}
+}
+
+func (s *cinemaServer) GetSeatAvailability(ctx context.Context, in *cinemav1.GetSeatAvailabilityRequest) (*cinemav1.GetSeatAvailabilityResponse, error) {
+	halls, err := s.service.GetSeatAvailability(ctx, in.GetCinemaId(), in.GetDate(), in.GetTime())
+	if err != nil {
+		return nil, toStatus(s.log, err, "get seat availability")
+	}
+
+	out := &cinemav1.GetSeatAvailabilityResponse{
+		Halls: make([]*cinemav1.HallSeats, len(halls)),
+	}
+	for i, hall := range halls {
+		seats := make([]*cinemav1.Seat, len(hall.Seats))
+		for j, seat := range hall.Seats {
+			seats[j] = &cinemav1.Seat{
+				Id:          seat.ID,
+				SeatNumber:  seat.SeatNumber,
+				SeatRow:     seat.SeatRow,
+				SeatColumn:  int32(seat.SeatColumn),
+				IsAvailable: seat.IsAvailable,
+			}
+		}
+
+		out.Halls[i] = &cinemav1.HallSeats{
+			HallId:   hall.HallID,
+			HallType: hall.HallType,
+			Date:     hall.Date,
+			Time:     hall.Time,
+			Seats:    seats,
+		}
+	}
+
+	return out, nil
+}
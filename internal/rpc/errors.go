@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"errors"
+	"strings"
+
+	"cinema-booking/internal/usecase"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus maps service errors ke gRPC status, sama seperti handleServiceError di adaptor
+func toStatus(log *zap.Logger, err error, operation string) error {
+	errMsg := err.Error()
+
+	switch {
+	case errors.Is(err, usecase.ErrSalesClosed):
+		log.Warn(operation+" failed - sales closed", zap.Error(err))
+		return status.Error(codes.FailedPrecondition, errMsg)
+
+	case strings.Contains(errMsg, "not found"):
+		log.Warn(operation+" failed - not found", zap.Error(err))
+		return status.Error(codes.NotFound, errMsg)
+
+	case strings.Contains(errMsg, "validation failed"),
+		strings.Contains(errMsg, "invalid"):
+		log.Warn("Invalid input for "+operation, zap.Error(err))
+		return status.Error(codes.InvalidArgument, errMsg)
+
+	case strings.Contains(errMsg, "already booked"):
+		log.Warn(operation+" failed - seat already booked", zap.Error(err))
+		return status.Error(codes.AlreadyExists, errMsg)
+
+	case strings.Contains(errMsg, "cannot"):
+		log.Warn(operation+" failed - invalid state", zap.Error(err))
+		return status.Error(codes.FailedPrecondition, errMsg)
+
+	default:
+		log.Error("Failed to "+operation, zap.Error(err))
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
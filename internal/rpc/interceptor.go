@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/reporting"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyHeader metadata key yang wajib dikirim internal client
+const apiKeyHeader = "x-api-key"
+
+// apiKeyInterceptor authenticates internal services via shared API key
+func apiKeyInterceptor(apiKey string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(apiKeyHeader)
+		if len(values) == 0 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(apiKey)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// requestIDHeader metadata opsional dari client untuk menyambung log antar service
+const requestIDHeader = "x-request-id"
+
+// loggerInterceptor logs setiap RPC call beserta durasinya. Request ID dan method ikut disimpan di
+// context supaya log service (utils.LoggerFromContext) bisa dilacak ke call ini.
+func loggerInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		requestID := uuid.NewString()
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(requestIDHeader); len(values) > 0 && values[0] != "" && len(values[0]) <= 64 {
+				requestID = values[0]
+			}
+		}
+		ctx = utils.SetRequestIDContext(ctx, requestID)
+		ctx = utils.SetRouteContext(ctx, info.FullMethod)
+
+		resp, err := handler(ctx, req)
+
+		log.Info("RPC request",
+			zap.String("method", info.FullMethod),
+			zap.String("code", status.Code(err).String()),
+			zap.Duration("duration", time.Since(start)),
+			zap.String("request_id", requestID),
+		)
+
+		return resp, err
+	}
+}
+
+// recoverInterceptor converts panic jadi codes.Internal dan meneruskannya ke error reporter.
+// Dipasang setelah loggerInterceptor supaya request ID sudah ada di context dan call yang panic tetap tercatat.
+func recoverInterceptor(reporter reporting.ErrorReporter, log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				stack := string(debug.Stack())
+				utils.LoggerFromContext(ctx, log).Error("PANIC recovered",
+					zap.Any("error", r),
+					zap.String("method", info.FullMethod),
+					zap.String("stack", stack),
+				)
+				requestID, _ := utils.GetRequestIDFromContext(ctx)
+				middleware.ReportPanic(reporter, log, reporting.Event{
+					Message:   fmt.Sprintf("panic: %v", r),
+					Stack:     stack,
+					RequestID: requestID,
+					Route:     info.FullMethod,
+					Tags:      map[string]string{"transport": "grpc"},
+				})
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/internal/usecase"
+	cinemav1 "cinema-booking/pkg/pb/cinema/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type movieServer struct {
+	cinemav1.UnimplementedMovieServiceServer
+	service usecase.MovieService
+	log     *zap.Logger
+}
+
+func newMovieServer(service usecase.MovieService, log *zap.Logger) *movieServer {
+	return &movieServer{
+		service: service,
+		log:     log.With(zap.String("rpc", "movie")),
+	}
+}
+
+func (s *movieServer) ListMovies(ctx context.Context, in *cinemav1.ListMoviesRequest) (*cinemav1.ListMoviesResponse, error) {
+	req := &request.PaginatedRequest{
+		Page:    int(in.GetPage()),
+		PerPage: int(in.GetPerPage()),
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PerPage < 1 {
+		req.PerPage = 10
+	}
+
+	// Filter sama dengan HTTP: "now" alias untuk "now_playing"
+	var releaseStatus *string
+	switch st := in.GetReleaseStatus(); st {
+	case "":
+	case "now", "now_playing":
+		st = "now_playing"
+		releaseStatus = &st
+	case "coming_soon", "ended":
+		releaseStatus = &st
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid release_status %q", st)
+	}
+
+	movies, err := s.service.GetMovies(ctx, req, releaseStatus, nil, "")
+	if err != nil {
+		return nil, toStatus(s.log, err, "list movies")
+	}
+
+	out := &cinemav1.ListMoviesResponse{
+		Movies: make([]*cinemav1.Movie, len(movies.Data)),
+		Pagination: &cinemav1.Pagination{
+			CurrentPage:  int32(movies.Pagination.CurrentPage),
+			Limit:        int32(movies.Pagination.Limit),
+			TotalPages:   int32(movies.Pagination.TotalPages),
+			TotalRecords: movies.Pagination.TotalRecords,
+		},
+	}
+	for i := range movies.Data {
+		out.Movies[i] = movieToProto(&movies.Data[i])
+	}
+
+	return out, nil
+}
+
+func (s *movieServer) GetMovie(ctx context.Context, in *cinemav1.GetMovieRequest) (*cinemav1.GetMovieResponse, error) {
+	movie, err := s.service.GetMovieByID(ctx, in.GetId(), "", nil)
+	if err != nil {
+		return nil, toStatus(s.log, err, "get movie")
+	}
+
+	return &cinemav1.GetMovieResponse{Movie: movieToProto(&movie.MovieResponse)}, nil
+}
+
+func (s *movieServer) ListSchedules(ctx context.Context, in *cinemav1.ListSchedulesRequest) (*cinemav1.ListSchedulesResponse, error) {
+	schedules, err := s.service.GetMovieSchedules(ctx, in.GetMovieId())
+	if err != nil {
+		return nil, toStatus(s.log, err, "list schedules")
+	}
+
+	out := &cinemav1.ListSchedulesResponse{
+		Schedules: make([]*cinemav1.Schedule, len(schedules)),
+	}
+	for i, schedule := range schedules {
+		out.Schedules[i] = &cinemav1.Schedule{
+			Id:         schedule.ID,
+			MovieId:    schedule.MovieID,
+			HallId:     schedule.HallID,
+			HallNumber: int32(schedule.HallNumber),
+			CinemaId:   schedule.CinemaID,
+			CinemaName: schedule.CinemaName,
+			ShowDate:   schedule.ShowDate,
+			ShowTime:   schedule.ShowTime,
+			Price:      schedule.Price,
+			HallType:   schedule.HallType,
+			Currency:   schedule.Currency,
+			StartsAt:   schedule.StartsAt.Format(time.RFC3339),
+			Timezone:   schedule.Timezone,
+		}
+	}
+
+	return out, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func movieToProto(movie *response.MovieResponse) *cinemav1.Movie {
+	duration, _ := strconv.Atoi(movie.DurationInMinutes)
+
+	out := &cinemav1.Movie{
+		Id:                movie.ID,
+		Title:             movie.Title,
+		Rating:            movie.Rating,
+		ReviewCount:       int32(movie.ReviewCount),
+		ReleaseDate:       movie.ReleaseDate,
+		DurationInMinutes: int32(duration),
+		Genres:            movie.Genres,
+		ReleaseStatus:     movie.ReleaseStatus,
+	}
+	if movie.Description != nil {
+		out.Description = *movie.Description
+	}
+	if movie.PosterURL != nil {
+		out.PosterUrl = *movie.PosterURL
+	}
+
+	return out
+}
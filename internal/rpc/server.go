@@ -0,0 +1,30 @@
+package rpc
+
+import (
+	"cinema-booking/internal/usecase"
+	cinemav1 "cinema-booking/pkg/pb/cinema/v1"
+	"cinema-booking/pkg/reporting"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// NewServer builds gRPC server yang memakai usecase layer yang sama dengan HTTP API
+func NewServer(service *usecase.Service, reporter reporting.ErrorReporter, config *utils.Config, log *zap.Logger) *grpc.Server {
+	log = log.With(zap.String("component", "grpc"))
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			loggerInterceptor(log),
+			recoverInterceptor(reporter, log),
+			apiKeyInterceptor(config.GRPC.APIKey),
+		),
+	)
+
+	cinemav1.RegisterMovieServiceServer(server, newMovieServer(service.Movie, log))
+	cinemav1.RegisterCinemaServiceServer(server, newCinemaServer(service.Cinema, log))
+	cinemav1.RegisterBookingServiceServer(server, newBookingServer(service.Booking, log))
+
+	return server
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/i18n"
+
+	"github.com/google/uuid"
+)
+
+// ageRatingEnforcement mode cek klasifikasi usia saat CreateBooking (AGE_RATING_ENFORCEMENT)
+type ageRatingEnforcement string
+
+const (
+	ageRatingOff    ageRatingEnforcement = "off"
+	ageRatingWarn   ageRatingEnforcement = "warn"
+	ageRatingReject ageRatingEnforcement = "reject"
+)
+
+// checkAgeRating membandingkan umur pemilik akun pada hari tayang dengan rating movie.
+// Mode warn mengembalikan peringatan (sudah dalam bahasa request) tanpa error; mode reject
+// mengembalikan error "cannot ..." kalau tanggal lahir kosong atau umurnya belum cukup.
+func checkAgeRating(ctx context.Context, repo *repository.Repository, mode ageRatingEnforcement, userID uuid.UUID, schedule *entity.Schedule) ([]string, error) {
+	if mode == ageRatingOff {
+		return nil, nil
+	}
+
+	movie, err := repo.Movie.FindByID(ctx, schedule.MovieID)
+	if err != nil {
+		return nil, fmt.Errorf("find movie for age rating: %w", err)
+	}
+	if movie == nil || movie.AgeRating.MinimumAge() == 0 {
+		return nil, nil
+	}
+
+	user, err := repo.User.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find user for age rating: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user %s not found", userID)
+	}
+
+	minAge := movie.AgeRating.MinimumAge()
+	age, known := user.AgeOn(schedule.ShowDate)
+
+	// Key reject berisi "cannot" untuk handler; key warn dipakai kalau booking tetap jalan
+	rejectKey, warnKey := "booking.age_restricted", "booking.age_warning"
+	if !known {
+		rejectKey, warnKey = "booking.age_unverified", "booking.age_warning_unverified"
+	} else if age >= minAge {
+		return nil, nil
+	}
+
+	if mode == ageRatingReject {
+		return nil, i18n.Errorf(rejectKey, movie.Title, movie.AgeRating, minAge)
+	}
+	return []string{i18n.T(i18n.FromContext(ctx), warnKey, movie.Title, movie.AgeRating, minAge)}, nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AuditService read-only; entry ditulis lewat recordAudit di service yang melakukan aksinya
+type AuditService interface {
+	GetAuditLogs(ctx context.Context, req *request.PaginatedRequest, filter *request.AuditLogFilter) (*response.PaginatedResponse[response.AuditLogResponse], error)
+}
+
+type auditService struct {
+	repo *repository.Repository
+	log  *zap.Logger
+}
+
+func NewAuditService(repo *repository.Repository, log *zap.Logger) AuditService {
+	return &auditService{
+		repo: repo,
+		log:  log.With(zap.String("service", "audit")),
+	}
+}
+
+func (s *auditService) GetAuditLogs(ctx context.Context, req *request.PaginatedRequest, filter *request.AuditLogFilter) (*response.PaginatedResponse[response.AuditLogResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
+	// ID sudah divalidasi tag uuid
+	var repoFilter repository.AuditFilter
+	if filter.ActorID != "" {
+		actorID := uuid.MustParse(filter.ActorID)
+		repoFilter.ActorID = &actorID
+	}
+	if filter.TargetID != "" {
+		targetID := uuid.MustParse(filter.TargetID)
+		repoFilter.TargetID = &targetID
+	}
+	if filter.Action != "" {
+		repoFilter.Action = &filter.Action
+	}
+
+	entries, err := s.repo.Audit.FindAll(ctx, repoFilter, req.Limit(), req.Offset())
+	if err != nil {
+		return nil, fmt.Errorf("get audit logs: %w", err)
+	}
+
+	total, err := s.repo.Audit.CountAll(ctx, repoFilter)
+	if err != nil {
+		return nil, fmt.Errorf("count audit logs: %w", err)
+	}
+
+	result := make([]response.AuditLogResponse, len(entries))
+	for i, entry := range entries {
+		result[i] = response.AuditLogToResponse(entry)
+	}
+
+	return response.NewPaginatedResponse(result, req.Page, req.PerPage, total), nil
+}
+
+// recordAudit writes satu entry audit log. Dipanggil di dalam tx aksi yang diaudit,
+// jadi aksi gagal kalau audit-nya gagal ditulis.
+func recordAudit(ctx context.Context, repo *repository.Repository, actorID uuid.UUID, action, targetType string, targetID *uuid.UUID, metadata map[string]any, ipAddress string) error {
+	payload, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("marshal audit metadata: %w", err)
+	}
+
+	entry := &entity.AuditLog{
+		BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: time.Now()},
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Metadata:   payload,
+	}
+	if ipAddress != "" {
+		entry.IPAddress = &ipAddress
+	}
+
+	return repo.Audit.Create(ctx, entry)
+}
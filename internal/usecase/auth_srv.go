@@ -9,6 +9,7 @@ import (
 	"cinema-booking/internal/data/repository"
 	"cinema-booking/internal/dto/request"
 	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/i18n"
 	"cinema-booking/pkg/utils"
 
 	"github.com/google/uuid"
@@ -21,47 +22,64 @@ type AuthService interface {
 	Logout(ctx context.Context, token string) error
 	SendOTP(ctx context.Context, email, otpType string) error
 	VerifyEmail(ctx context.Context, req *request.VerifyEmailRequest) error
+
+	// Impersonate / StopImpersonation dicatat di audit log
+	Impersonate(ctx context.Context, adminID, targetUserID string, req *request.ImpersonateRequest) (*response.ImpersonationResponse, error)
+	StopImpersonation(ctx context.Context, token, ipAddress string) error
 }
 
 type authService struct {
-	repo   *repository.Repository
-	config *utils.Config
-	log    *zap.Logger
+	repo     *repository.Repository
+	settings *appSettings // umur session
+	jobs     *jobQueue
+	config   *utils.Config
+	log      *zap.Logger
+}
+
+// verificationOTPJob payload job kirim OTP verifikasi; email dibaca ulang dari user saat job jalan
+type verificationOTPJob struct {
+	UserID uuid.UUID `json:"user_id"`
 }
 
 func NewAuthService(
 	repo *repository.Repository,
+	settings *appSettings,
+	jobs *jobQueue,
 	config *utils.Config,
 	log *zap.Logger,
 ) AuthService {
-	return &authService{
-		repo:   repo,
-		config: config,
-		log:    log,
-	}
+	s := &authService{
+		repo:     repo,
+		settings: settings,
+		jobs:     jobs,
+		config:   config,
+		log:      log,
+	}
+	jobs.handle(jobVerificationOTP, s.sendVerificationOTP)
+	return s
 }
 
 func (s *authService) Register(ctx context.Context, req *request.RegisterRequest) (*response.AuthResponse, error) {
 	// Validate input
 	if errs := utils.ValidateStruct(req); len(errs) > 0 {
-		s.log.Warn("Register validation failed", zap.Any("errors", errs))
-		return nil, fmt.Errorf("validation failed: %s", utils.FormatValidationErrors(errs))
+		utils.LoggerFromContext(ctx, s.log).Warn("Register validation failed", zap.Any("errors", errs))
+		return nil, errs
 	}
 
 	// Check if email already exists (prevent duplicate registration)
 	existingUser, err := s.repo.User.FindByEmail(ctx, req.Email)
 	if err != nil {
-		s.log.Error("Failed to check email", zap.Error(err), zap.String("email", req.Email))
-		return nil, fmt.Errorf("check email %s: %w", req.Email, err)
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to check email", zap.Error(err), utils.EmailField(req.Email))
+		return nil, fmt.Errorf("check email %s: %w", utils.MaskEmail(req.Email), err)
 	}
 	if existingUser != nil {
-		return nil, fmt.Errorf("email %s already registered", req.Email)
+		return nil, fmt.Errorf("email %s already registered", utils.MaskEmail(req.Email))
 	}
 
 	// Check if username already taken
 	existingUser, err = s.repo.User.FindByUsername(ctx, req.Username)
 	if err != nil {
-		s.log.Error("Failed to check username", zap.Error(err), zap.String("username", req.Username))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to check username", zap.Error(err), zap.String("username", req.Username))
 		return nil, fmt.Errorf("check username %s: %w", req.Username, err)
 	}
 	if existingUser != nil {
@@ -71,7 +89,7 @@ func (s *authService) Register(ctx context.Context, req *request.RegisterRequest
 	// Hash password using bcrypt before storing
 	hashedPassword, err := utils.HashPassword(req.Password)
 	if err != nil {
-		s.log.Error("Failed to hash password", zap.Error(err))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to hash password", zap.Error(err))
 		return nil, fmt.Errorf("hash password: %w", err)
 	}
 
@@ -90,27 +108,32 @@ func (s *authService) Register(ctx context.Context, req *request.RegisterRequest
 		Role:          entity.RoleCustomer, // Default role: customer
 		EmailVerified: false,               // Email not verified yet
 		IsActive:      true,                // Account is active by default
+		Language:      string(i18n.FromContext(ctx)),
+		Tier:          entity.TierStandard, // Naik otomatis dari belanja lewat customer tier worker
 	}
 
-	// Save to database
-	if err := s.repo.User.Create(ctx, user); err != nil {
-		s.log.Error("Failed to create user", zap.Error(err), zap.String("email", req.Email))
+	// Save to database; OTP verifikasi dikirim job queue setelah commit, tetap terkirim walau instance restart
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.User.Create(ctx, user); err != nil {
+			return err
+		}
+		return s.jobs.enqueue(ctx, tx, jobVerificationOTP, verificationOTPJob{UserID: user.ID})
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create user", zap.Error(err), utils.EmailField(req.Email))
 		return nil, fmt.Errorf("create user account: %w", err)
 	}
 
-	// Send verification OTP asynchronously (using goroutine)
-	go s.sendVerificationOTP(user.Email) // Non-blocking
-
 	// Create session for auto-login after registration
 	session, err := s.createSession(ctx, user.ID)
 	if err != nil {
-		s.log.Warn("Failed to create session after register",
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to create session after register",
 			zap.Error(err), zap.String("user_id", user.ID.String()))
 	}
 
-	s.log.Info("User registered",
+	utils.LoggerFromContext(ctx, s.log).Info("User registered",
 		zap.String("user_id", user.ID.String()),
-		zap.String("email", user.Email),
+		utils.EmailField(user.Email),
 		zap.String("username", user.Username))
 
 	// Convert to response DTO
@@ -121,8 +144,8 @@ func (s *authService) Register(ctx context.Context, req *request.RegisterRequest
 func (s *authService) Login(ctx context.Context, req *request.LoginRequest) (*response.AuthResponse, error) {
 	// Validate input
 	if errs := utils.ValidateStruct(req); len(errs) > 0 {
-		s.log.Warn("Login validation failed", zap.Any("errors", errs))
-		return nil, fmt.Errorf("validation failed: %s", utils.FormatValidationErrors(errs))
+		utils.LoggerFromContext(ctx, s.log).Warn("Login validation failed", zap.Any("errors", errs))
+		return nil, errs
 	}
 
 	// Try to find user by email first, then by username
@@ -131,7 +154,7 @@ func (s *authService) Login(ctx context.Context, req *request.LoginRequest) (*re
 
 	user, err = s.repo.User.FindByEmail(ctx, req.Username)
 	if err != nil {
-		s.log.Error("Failed to find user by email", zap.Error(err), zap.String("identifier", req.Username))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to find user by email", zap.Error(err), zap.String("identifier", req.Username))
 		return nil, fmt.Errorf("find user by email %s: %w", req.Username, err)
 	}
 
@@ -139,37 +162,37 @@ func (s *authService) Login(ctx context.Context, req *request.LoginRequest) (*re
 	if user == nil {
 		user, err = s.repo.User.FindByUsername(ctx, req.Username)
 		if err != nil {
-			s.log.Error("Failed to find user by username", zap.Error(err), zap.String("identifier", req.Username))
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to find user by username", zap.Error(err), zap.String("identifier", req.Username))
 			return nil, fmt.Errorf("find user by username %s: %w", req.Username, err)
 		}
 	}
 
 	// User not found
 	if user == nil {
-		s.log.Warn("User not found for login", zap.String("identifier", req.Username))
+		utils.LoggerFromContext(ctx, s.log).Warn("User not found for login", zap.String("identifier", req.Username))
 		return nil, fmt.Errorf("user %s not found", req.Username)
 	}
 
 	// Verify password using bcrypt compare
 	if !utils.CheckPasswordHash(req.Password, user.PasswordHash) {
-		s.log.Warn("Invalid password", zap.String("user_id", user.ID.String()))
+		utils.LoggerFromContext(ctx, s.log).Warn("Invalid password", zap.String("user_id", user.ID.String()))
 		return nil, fmt.Errorf("invalid password for user %s", req.Username)
 	}
 
 	// Check if account is active (not banned/deactivated)
 	if !user.IsActive {
-		s.log.Warn("Inactive user tried to login", zap.String("user_id", user.ID.String()))
+		utils.LoggerFromContext(ctx, s.log).Warn("Inactive user tried to login", zap.String("user_id", user.ID.String()))
 		return nil, fmt.Errorf("account %s is deactivated", req.Username)
 	}
 
 	// Create new session
 	session, err := s.createSession(ctx, user.ID)
 	if err != nil {
-		s.log.Error("Failed to create session", zap.Error(err), zap.String("user_id", user.ID.String()))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create session", zap.Error(err), zap.String("user_id", user.ID.String()))
 		return nil, fmt.Errorf("create session for user %s: %w", user.ID.String(), err)
 	}
 
-	s.log.Info("User logged in",
+	utils.LoggerFromContext(ctx, s.log).Info("User logged in",
 		zap.String("user_id", user.ID.String()),
 		zap.String("username", user.Username))
 
@@ -182,17 +205,17 @@ func (s *authService) Logout(ctx context.Context, token string) error {
 	// Parse string token to UUID
 	tokenUUID, err := uuid.Parse(token)
 	if err != nil {
-		s.log.Warn("Invalid token format", zap.String("token", token), zap.Error(err))
+		utils.LoggerFromContext(ctx, s.log).Warn("Invalid token format", utils.TokenField(token), zap.Error(err))
 		return fmt.Errorf("invalid token format %s: %w", token, err)
 	}
 
 	// Revoke session
 	if err := s.repo.Session.Revoke(ctx, tokenUUID.String()); err != nil {
-		s.log.Error("Failed to revoke session", zap.Error(err), zap.String("token", token))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to revoke session", zap.Error(err), utils.TokenField(token))
 		return fmt.Errorf("revoke session token %s: %w", token, err)
 	}
 
-	s.log.Info("User logged out", zap.String("token", token))
+	utils.LoggerFromContext(ctx, s.log).Info("User logged out", utils.TokenField(token))
 	return nil
 }
 
@@ -200,16 +223,16 @@ func (s *authService) SendOTP(ctx context.Context, email, otpType string) error
 	// Find user
 	user, err := s.repo.User.FindByEmail(ctx, email)
 	if err != nil {
-		s.log.Error("Failed to find user for OTP", zap.Error(err), zap.String("email", email))
-		return fmt.Errorf("find user for OTP %s: %w", email, err)
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to find user for OTP", zap.Error(err), utils.EmailField(email))
+		return fmt.Errorf("find user for OTP %s: %w", utils.MaskEmail(email), err)
 	}
 	if user == nil {
-		return fmt.Errorf("user with email %s not found", email)
+		return fmt.Errorf("user with email %s not found", utils.MaskEmail(email))
 	}
 
 	// Check if already verified (for email verification)
 	if otpType == string(entity.OTPTypeEmailVerification) && user.EmailVerified {
-		return fmt.Errorf("email %s already verified", email)
+		return fmt.Errorf("email %s already verified", utils.MaskEmail(email))
 	}
 
 	// Generate OTP
@@ -232,20 +255,22 @@ func (s *authService) SendOTP(ctx context.Context, email, otpType string) error
 
 	// Save OTP
 	if err := s.repo.OTP.Create(ctx, otp); err != nil {
-		s.log.Error("Failed to save OTP", zap.Error(err), zap.String("email", email))
-		return fmt.Errorf("save OTP for %s: %w", email, err)
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to save OTP", zap.Error(err), utils.EmailField(email))
+		return fmt.Errorf("save OTP for %s: %w", utils.MaskEmail(email), err)
 	}
 
-	// Log OTP (in development)
-	s.log.Info("OTP generated",
-		zap.String("email", email),
+	// Kode OTP tidak pernah masuk log
+	utils.LoggerFromContext(ctx, s.log).Info("OTP generated",
+		utils.EmailField(email),
 		zap.String("otp_type", otpType),
 		zap.Time("expires_at", expiresAt),
 	)
 
-	// Print to console for development
-	fmt.Printf("\n📧 OTP for %s (%s): %s (Expires: %s)\n\n",
-		email, otpType, otpCode, expiresAt.Format("15:04:05"))
+	// Development tanpa SMTP: kode dicetak ke stdout (OTP_PRINT_CONSOLE, hanya boleh dengan DEBUG)
+	if s.config.OTP.PrintToConsole {
+		fmt.Printf("\n📧 OTP for %s (%s): %s (Expires: %s)\n\n",
+			email, otpType, otpCode, expiresAt.Format("15:04:05"))
+	}
 
 	return nil
 }
@@ -253,31 +278,31 @@ func (s *authService) SendOTP(ctx context.Context, email, otpType string) error
 func (s *authService) VerifyEmail(ctx context.Context, req *request.VerifyEmailRequest) error {
 	// Validate input
 	if errs := utils.ValidateStruct(req); len(errs) > 0 {
-		s.log.Warn("Verify email validation failed", zap.Any("errors", errs))
-		return fmt.Errorf("validation failed: %s", utils.FormatValidationErrors(errs))
+		utils.LoggerFromContext(ctx, s.log).Warn("Verify email validation failed", zap.Any("errors", errs))
+		return errs
 	}
 
 	// Find valid OTP
 	otp, err := s.repo.OTP.FindValidOTP(ctx, req.Email, req.OTP, string(entity.OTPTypeEmailVerification))
 	if err != nil {
-		s.log.Error("Failed to find OTP", zap.Error(err), zap.String("email", req.Email))
-		return fmt.Errorf("find OTP for %s: %w", req.Email, err)
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to find OTP", zap.Error(err), utils.EmailField(req.Email))
+		return fmt.Errorf("find OTP for %s: %w", utils.MaskEmail(req.Email), err)
 	}
 	if otp == nil {
-		return fmt.Errorf("invalid or expired OTP for email %s", req.Email)
+		return fmt.Errorf("invalid or expired OTP for email %s", utils.MaskEmail(req.Email))
 	}
 
 	// Mark OTP as used
 	if err := s.repo.OTP.MarkAsUsed(ctx, otp.ID); err != nil {
-		s.log.Warn("Failed to mark OTP as used", zap.Error(err), zap.String("otp_id", otp.ID.String()))
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to mark OTP as used", zap.Error(err), zap.String("otp_id", otp.ID.String()))
 		// Continue anyway
 	}
 
 	// Find user
 	user, err := s.repo.User.FindByEmail(ctx, req.Email)
 	if err != nil || user == nil {
-		s.log.Error("User not found for verification", zap.Error(err), zap.String("email", req.Email))
-		return fmt.Errorf("find user for verification %s: %w", req.Email, err)
+		utils.LoggerFromContext(ctx, s.log).Error("User not found for verification", zap.Error(err), utils.EmailField(req.Email))
+		return fmt.Errorf("find user for verification %s: %w", utils.MaskEmail(req.Email), err)
 	}
 
 	// Update user verification status
@@ -285,12 +310,12 @@ func (s *authService) VerifyEmail(ctx context.Context, req *request.VerifyEmailR
 	user.UpdatedAt = time.Now()
 
 	if err := s.repo.User.Update(ctx, user); err != nil {
-		s.log.Error("Failed to update user verification", zap.Error(err), zap.String("user_id", user.ID.String()))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update user verification", zap.Error(err), zap.String("user_id", user.ID.String()))
 		return fmt.Errorf("update user verification %s: %w", user.ID.String(), err)
 	}
 
-	s.log.Info("Email verified",
-		zap.String("email", req.Email),
+	utils.LoggerFromContext(ctx, s.log).Info("Email verified",
+		utils.EmailField(req.Email),
 		zap.String("user_id", user.ID.String()))
 
 	return nil
@@ -306,7 +331,7 @@ func (s *authService) createSession(ctx context.Context, userID uuid.UUID) (*ent
 		},
 		UserID:    userID,
 		Token:     uuid.New(),
-		ExpiresAt: time.Now().Add(24 * time.Hour),
+		ExpiresAt: time.Now().Add(s.settings.sessionTTL(ctx)),
 	}
 
 	if err := s.repo.Session.Create(ctx, session); err != nil {
@@ -316,11 +341,21 @@ func (s *authService) createSession(ctx context.Context, userID uuid.UUID) (*ent
 	return session, nil
 }
 
-func (s *authService) sendVerificationOTP(email string) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+// sendVerificationOTP handler job jobVerificationOTP. User yang sudah verifikasi (mis. lewat OTP yang
+// diminta manual) atau sudah dihapus tidak perlu dikirimi lagi.
+func (s *authService) sendVerificationOTP(ctx context.Context, job *entity.Job) error {
+	payload, err := decodeJobPayload[verificationOTPJob](job)
+	if err != nil {
+		return err
+	}
 
-	if err := s.SendOTP(ctx, email, string(entity.OTPTypeEmailVerification)); err != nil {
-		s.log.Error("Failed to send verification OTP", zap.Error(err), zap.String("email", email))
+	user, err := s.repo.User.FindByID(ctx, payload.UserID)
+	if err != nil {
+		return fmt.Errorf("find user %s for verification OTP: %w", payload.UserID.String(), err)
+	}
+	if user == nil || user.EmailVerified {
+		return nil
 	}
+
+	return s.SendOTP(ctx, user.Email, string(entity.OTPTypeEmailVerification))
 }
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BannerService mengelola banner home screen app. Admin menjadwalkan jendela tampil,
+// endpoint publik hanya mengembalikan banner yang sedang live.
+type BannerService interface {
+	GetBanners(ctx context.Context) ([]response.BannerResponse, error)
+	GetBannerByID(ctx context.Context, bannerID string) (*response.BannerResponse, error)
+	CreateBanner(ctx context.Context, req *request.BannerRequest) (*response.BannerResponse, error)
+	UpdateBanner(ctx context.Context, bannerID string, req *request.BannerRequest) (*response.BannerResponse, error)
+	DeleteBanner(ctx context.Context, bannerID string) error
+
+	// GetLiveBanners untuk app; city kosong berarti semua banner live
+	GetLiveBanners(ctx context.Context, city string) ([]response.PublicBannerResponse, error)
+}
+
+type bannerService struct {
+	repo *repository.Repository
+	log  *zap.Logger
+}
+
+func NewBannerService(repo *repository.Repository, log *zap.Logger) BannerService {
+	return &bannerService{
+		repo: repo,
+		log:  log.With(zap.String("service", "banner")),
+	}
+}
+
+func (s *bannerService) GetBanners(ctx context.Context) ([]response.BannerResponse, error) {
+	banners, err := s.repo.Banner.FindAll(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get banners", zap.Error(err))
+		return nil, fmt.Errorf("get banners: %w", err)
+	}
+
+	now := time.Now()
+	result := make([]response.BannerResponse, len(banners))
+	for i, banner := range banners {
+		result[i] = response.BannerToResponse(banner, now)
+	}
+
+	return result, nil
+}
+
+func (s *bannerService) GetBannerByID(ctx context.Context, bannerID string) (*response.BannerResponse, error) {
+	banner, err := s.findBanner(ctx, bannerID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response.BannerToResponse(banner, time.Now())
+	return &resp, nil
+}
+
+func (s *bannerService) CreateBanner(ctx context.Context, req *request.BannerRequest) (*response.BannerResponse, error) {
+	now := time.Now()
+	banner := &entity.Banner{
+		Base: entity.Base{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	if err := s.applyRequest(ctx, banner, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Banner.Create(ctx, banner); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create banner",
+			zap.Error(err),
+			zap.String("title", req.Title),
+		)
+		return nil, fmt.Errorf("create banner: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Banner created",
+		zap.String("banner_id", banner.ID.String()),
+		zap.String("title", banner.Title),
+		zap.Time("starts_at", banner.StartsAt),
+	)
+
+	resp := response.BannerToResponse(banner, now)
+	return &resp, nil
+}
+
+func (s *bannerService) UpdateBanner(ctx context.Context, bannerID string, req *request.BannerRequest) (*response.BannerResponse, error) {
+	banner, err := s.findBanner(ctx, bannerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyRequest(ctx, banner, req); err != nil {
+		return nil, err
+	}
+
+	banner.UpdatedAt = time.Now()
+	if err := s.repo.Banner.Update(ctx, banner); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update banner",
+			zap.Error(err),
+			zap.String("banner_id", bannerID),
+		)
+		return nil, fmt.Errorf("update banner %s: %w", bannerID, err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Banner updated",
+		zap.String("banner_id", bannerID),
+		zap.String("title", banner.Title),
+	)
+
+	resp := response.BannerToResponse(banner, banner.UpdatedAt)
+	return &resp, nil
+}
+
+func (s *bannerService) DeleteBanner(ctx context.Context, bannerID string) error {
+	id, err := uuid.Parse(bannerID)
+	if err != nil {
+		return fmt.Errorf("invalid banner ID format %s: %w", bannerID, err)
+	}
+
+	if err := s.repo.Banner.Delete(ctx, id); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to delete banner",
+			zap.Error(err),
+			zap.String("banner_id", bannerID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *bannerService) GetLiveBanners(ctx context.Context, city string) ([]response.PublicBannerResponse, error) {
+	banners, err := s.repo.Banner.FindLive(ctx, time.Now(), strings.TrimSpace(city))
+	if err != nil {
+		return nil, fmt.Errorf("get live banners: %w", err)
+	}
+
+	result := make([]response.PublicBannerResponse, len(banners))
+	for i, banner := range banners {
+		result[i] = response.BannerToPublicResponse(banner)
+	}
+
+	return result, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func (s *bannerService) findBanner(ctx context.Context, bannerID string) (*entity.Banner, error) {
+	id, err := uuid.Parse(bannerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid banner ID format %s: %w", bannerID, err)
+	}
+
+	banner, err := s.repo.Banner.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find banner: %w", err)
+	}
+	if banner == nil {
+		return nil, fmt.Errorf("banner %s not found", bannerID)
+	}
+
+	return banner, nil
+}
+
+// applyRequest validates req lalu menimpa semua field banner (PUT adalah replace penuh)
+func (s *bannerService) applyRequest(ctx context.Context, banner *entity.Banner, req *request.BannerRequest) error {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Banner validation failed", zap.Any("errors", errs))
+		return errs
+	}
+
+	startsAt, _ := time.Parse(time.RFC3339, req.StartsAt)
+	var endsAt *time.Time
+	if req.EndsAt != nil {
+		end, _ := time.Parse(time.RFC3339, *req.EndsAt)
+		if !end.After(startsAt) {
+			return fmt.Errorf("invalid display window: ends_at %s must be after starts_at %s", *req.EndsAt, req.StartsAt)
+		}
+		endsAt = &end
+	}
+
+	var city *string
+	if req.City != nil && strings.TrimSpace(*req.City) != "" {
+		trimmed := strings.TrimSpace(*req.City)
+		city = &trimmed
+	}
+
+	banner.Title = req.Title
+	banner.ImageURL = req.ImageURL
+	banner.LinkURL = req.LinkURL
+	banner.City = city
+	banner.StartsAt = startsAt
+	banner.EndsAt = endsAt
+	banner.SortOrder = req.SortOrder
+	banner.IsActive = req.IsActive == nil || *req.IsActive
+
+	return nil
+}
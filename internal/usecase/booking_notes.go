@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (s *bookingService) AddBookingNote(ctx context.Context, authorID, bookingID string, req *request.CreateBookingNoteRequest) (*response.BookingNoteResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	author, err := uuid.Parse(authorID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", authorID, err)
+	}
+
+	booking, err := s.findScopedBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &entity.BookingNote{
+		BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: time.Now()},
+		BookingID:  booking.ID,
+		AuthorID:   author,
+		Body:       req.Body,
+	}
+	if err := s.repo.BookingNote.CreateNote(ctx, note); err != nil {
+		return nil, fmt.Errorf("add booking note: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Booking note added",
+		zap.String("booking_id", booking.ID.String()),
+		zap.String("author_id", authorID),
+	)
+
+	resp := response.BookingNoteToResponse(note, s.noteAuthorNames(ctx, []*entity.BookingNote{note})[author])
+	return &resp, nil
+}
+
+func (s *bookingService) GetBookingNotes(ctx context.Context, bookingID string) ([]response.BookingNoteResponse, error) {
+	booking, err := s.findScopedBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.loadBookingNotes(ctx, booking.ID)
+}
+
+func (s *bookingService) UpdateBookingFlags(ctx context.Context, adminID, bookingID string, req *request.UpdateBookingFlagsRequest) ([]entity.BookingFlag, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	actorID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", adminID, err)
+	}
+
+	booking, err := s.findScopedBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	flags := make([]entity.BookingFlag, 0, len(req.Flags))
+	for _, flag := range req.Flags {
+		if !slices.Contains(flags, entity.BookingFlag(flag)) {
+			flags = append(flags, entity.BookingFlag(flag))
+		}
+	}
+	slices.Sort(flags)
+
+	previous, err := s.repo.BookingNote.FindFlags(ctx, []uuid.UUID{booking.ID})
+	if err != nil {
+		return nil, fmt.Errorf("find booking flags: %w", err)
+	}
+
+	// Flag chargeback / disputed berpengaruh ke keuangan, jadi perubahannya ikut diaudit
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.BookingNote.ReplaceFlags(ctx, booking.ID, flags, actorID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, actorID, entity.AuditActionBookingFlags, entity.AuditTargetBooking, &booking.ID,
+			map[string]any{
+				"order_id": booking.OrderID,
+				"before":   previous[booking.ID],
+				"after":    flags,
+			}, "")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update booking flags: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Booking flags updated",
+		zap.String("booking_id", booking.ID.String()),
+		zap.String("admin_id", adminID),
+		zap.Any("flags", flags),
+	)
+
+	return flags, nil
+}
+
+// findScopedBooking loads booking untuk endpoint admin / staff dengan cek scope organization
+func (s *bookingService) findScopedBooking(ctx context.Context, bookingID string) (*entity.Booking, error) {
+	id, err := uuid.Parse(bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid booking ID format %s: %w", bookingID, err)
+	}
+
+	booking, err := s.repo.Booking.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find booking %s: %w", bookingID, err)
+	}
+	if booking == nil {
+		return nil, i18n.Errorf("booking.not_found", bookingID)
+	}
+	if err := s.requireBookingScope(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// buildAdminBookingDetail adds flag, catatan internal dan riwayat status booking / payment ke detail booking
+func (s *bookingService) buildAdminBookingDetail(ctx context.Context, booking *entity.Booking) (*response.BookingDetailResponse, error) {
+	detail := s.buildBookingDetail(ctx, booking)
+
+	flags, err := s.repo.BookingNote.FindFlags(ctx, []uuid.UUID{booking.ID})
+	if err != nil {
+		return nil, fmt.Errorf("find booking flags: %w", err)
+	}
+	detail.Flags = flags[booking.ID]
+
+	detail.InternalNotes, err = s.loadBookingNotes(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail.StatusHistory, err = s.loadStatusHistory(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if detail.Payment != nil {
+		if paymentID, err := uuid.Parse(detail.Payment.ID); err == nil {
+			detail.Payment.StatusHistory, err = s.loadPaymentStatusHistory(ctx, paymentID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return detail, nil
+}
+
+// attachBookingFlags fills Flags untuk satu halaman list admin dengan satu query
+func (s *bookingService) attachBookingFlags(ctx context.Context, items []response.BookingResponse) error {
+	ids := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		if id, err := uuid.Parse(item.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	flags, err := s.repo.BookingNote.FindFlags(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("find booking flags: %w", err)
+	}
+
+	for i := range items {
+		if id, err := uuid.Parse(items[i].ID); err == nil {
+			items[i].Flags = flags[id]
+		}
+	}
+	return nil
+}
+
+func (s *bookingService) loadBookingNotes(ctx context.Context, bookingID uuid.UUID) ([]response.BookingNoteResponse, error) {
+	notes, err := s.repo.BookingNote.FindNotes(ctx, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("find booking notes: %w", err)
+	}
+
+	names := s.noteAuthorNames(ctx, notes)
+	result := make([]response.BookingNoteResponse, len(notes))
+	for i, note := range notes {
+		result[i] = response.BookingNoteToResponse(note, names[note.AuthorID])
+	}
+	return result, nil
+}
+
+// noteAuthorNames looks up username penulis catatan, best-effort; catatan per booking hanya sedikit
+func (s *bookingService) noteAuthorNames(ctx context.Context, notes []*entity.BookingNote) map[uuid.UUID]string {
+	names := make(map[uuid.UUID]string)
+	for _, note := range notes {
+		if _, ok := names[note.AuthorID]; ok {
+			continue
+		}
+		names[note.AuthorID] = ""
+		if author, _ := s.repo.User.FindByID(ctx, note.AuthorID); author != nil {
+			names[note.AuthorID] = author.Username
+		}
+	}
+	return names
+}
@@ -2,50 +2,152 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/internal/data/repository"
 	"cinema-booking/internal/dto/request"
 	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/events"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/notification"
 	"cinema-booking/pkg/utils"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
+// hydrationConcurrency membatasi jumlah item list yang di-hydrate bersamaan.
+// Tiap item bisa memakai beberapa koneksi, jadi dijaga jauh di bawah DB_MAX_CONNS (default 10).
+const hydrationConcurrency = 4
+
+// paymentExpiryBatch jumlah payment async yang di-expire per run worker
+const paymentExpiryBatch = 100
+
+// vaNumberPrefix kode perusahaan di nomor VA simulasi
+const vaNumberPrefix = "8808"
+
+// webhookPaymentStatus maps status callback gateway ke status payment
+var webhookPaymentStatus = map[string]entity.PaymentStatus{
+	"processing": entity.PaymentStatusProcessing,
+	"paid":       entity.PaymentStatusCompleted,
+	"failed":     entity.PaymentStatusFailed,
+	"expired":    entity.PaymentStatusExpired,
+}
+
+// ErrSalesClosed dikembalikan kalau jam mulai show ditambah cutoff sudah lewat.
+// Handler memetakannya ke error code SALES_CLOSED.
+var ErrSalesClosed = i18n.Errorf("booking.sales_closed")
+
+// ConflictError is the repository's optimistic-lock error, di-alias supaya handler tidak perlu
+// import repository. Handler memetakannya ke 409.
+type ConflictError = repository.ConflictError
+
 type BookingService interface {
 	// Public endpoints (butuh auth)
 	CreateBooking(ctx context.Context, userID string, req *request.CreateBookingRequest) (*response.BookingResponse, error)
-	GetUserBookings(ctx context.Context, userID string, req *request.PaginatedRequest) (*response.PaginatedResponse[response.BookingResponse], error)
+	GetUserBookings(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.BookingHistoryFilter) (*response.PaginatedResponse[response.BookingResponse], error)
+	GetUserBookingByOrderID(ctx context.Context, userID, orderID string) (*response.BookingDetailResponse, error)
+	// GetNextUpcomingBooking returns nil tanpa error kalau user tidak punya booking mendatang
+	GetNextUpcomingBooking(ctx context.Context, userID string) (*response.BookingResponse, error)
+	// GetBookingReceipt renders the PDF invoice (content, filename) untuk booking yang sudah dibayar
+	GetBookingReceipt(ctx context.Context, userID, bookingID string) ([]byte, string, error)
 
 	// Payment
 	ProcessPayment(ctx context.Context, userID string, req *request.ProcessPaymentRequest) (*response.PaymentResponse, error)
 	GetPaymentMethods(ctx context.Context) ([]*response.PaymentMethodResponse, error)
+	// GetPaymentStatus untuk polling client selama payment VA / QRIS pending (owner only)
+	GetPaymentStatus(ctx context.Context, userID, paymentID string) (*response.PaymentStatusResponse, error)
+	// HandlePaymentWebhook finalizes payment async dari callback gateway; aman dipanggil ulang
+	HandlePaymentWebhook(ctx context.Context, req *request.PaymentWebhookRequest) (*response.PaymentStatusResponse, error)
 
 	// Admin endpoints (optional)
+	GetAllBookings(ctx context.Context, req *request.PaginatedRequest, filter *request.AdminBookingFilter) (*response.PaginatedResponse[response.BookingResponse], error)
+	// GetBookingByID dan GetBookingByOrderID versi admin, termasuk flag dan catatan internal
 	GetBookingByID(ctx context.Context, bookingID string) (*response.BookingDetailResponse, error)
+	GetBookingByOrderID(ctx context.Context, orderID string) (*response.BookingDetailResponse, error)
 	CancelBooking(ctx context.Context, bookingID string) error
+	// TransitionBooking perpindahan status manual (check-in, refund); transisi yang tidak sah ditolak
+	TransitionBooking(ctx context.Context, bookingID string, req *request.TransitionBookingRequest) (*response.BookingDetailResponse, error)
+	// CreateGroupBooking block-books seats atau satu hall penuh untuk event, langsung confirmed
+	CreateGroupBooking(ctx context.Context, adminID string, req *request.GroupBookingRequest) (*response.GroupBookingResponse, error)
+
+	// Catatan internal dan flag support; tidak pernah muncul di endpoint milik user
+	AddBookingNote(ctx context.Context, authorID, bookingID string, req *request.CreateBookingNoteRequest) (*response.BookingNoteResponse, error)
+	GetBookingNotes(ctx context.Context, bookingID string) ([]response.BookingNoteResponse, error)
+	UpdateBookingFlags(ctx context.Context, adminID, bookingID string, req *request.UpdateBookingFlagsRequest) ([]entity.BookingFlag, error)
+
+	// Background jobs
+	SendShowReminders(ctx context.Context, lead time.Duration) (int, error)
+	ExpirePendingPayments(ctx context.Context) (int, error)
 }
 
 type bookingService struct {
-	repo *repository.Repository // grouping semua booking-related repos
-	log  *zap.Logger
+	repo     *repository.Repository // grouping semua booking-related repos
+	notifier NotificationService
+	waitlist WaitlistService
+	seats    *seatAvailability
+	rules    seatRules
+	pricing  pricingRules
+	log      *zap.Logger
+
+	// settings sumber batas kursi dan sales cutoff, bisa diubah admin saat runtime
+	settings *appSettings
+
+	ageRating ageRatingEnforcement
+
+	// orderPrefix default order ID; chain bisa override lewat organizations.order_prefix
+	orderPrefix string
+
+	// paymentDeadlines batas bayar per jenis method async, dihitung sejak kode diterbitkan
+	paymentDeadlines map[entity.PaymentMethodType]time.Duration
+
+	// jobs mengirim email konfirmasi (dengan receipt PDF) dan notice expired setelah tx commit
+	jobs *jobQueue
 }
 
-func NewBookingService(repo *repository.Repository, log *zap.Logger) BookingService {
-	return &bookingService{
-		repo: repo,
-		log:  log.With(zap.String("service", "booking")),
+// bookingJob payload job notifikasi booking; booking dibaca ulang saat job jalan
+type bookingJob struct {
+	BookingID uuid.UUID `json:"booking_id"`
+}
+
+func NewBookingService(repo *repository.Repository, notifier NotificationService, waitlist WaitlistService, seats *seatAvailability, settings *appSettings, jobs *jobQueue, config utils.BookingConfig, pricing utils.PricingConfig, payment utils.PaymentConfig, log *zap.Logger) BookingService {
+	s := &bookingService{
+		repo:     repo,
+		notifier: notifier,
+		waitlist: waitlist,
+		seats:    seats,
+		rules: seatRules{
+			noSingleSeatGap: config.NoSingleSeatGap,
+		},
+		pricing: newPricingRules(pricing),
+		log:     log.With(zap.String("service", "booking")),
+
+		settings:    settings,
+		ageRating:   ageRatingEnforcement(config.AgeRatingEnforcement),
+		orderPrefix: config.OrderIDPrefix,
+
+		paymentDeadlines: map[entity.PaymentMethodType]time.Duration{
+			entity.PaymentMethodTypeQRIS:           time.Duration(payment.QRISExpiryMinutes) * time.Minute,
+			entity.PaymentMethodTypeVirtualAccount: time.Duration(payment.VAExpiryMinutes) * time.Minute,
+		},
+
+		jobs: jobs,
 	}
+	jobs.handle(jobBookingConfirmation, s.sendBookingConfirmation)
+	jobs.handle(jobPaymentExpiredNotice, s.sendPaymentExpiredNotice)
+	return s
 }
 
 func (s *bookingService) CreateBooking(ctx context.Context, userID string, req *request.CreateBookingRequest) (*response.BookingResponse, error) {
 	// Validate request
 	if errs := utils.ValidateStruct(req); len(errs) > 0 {
-		s.log.Warn("Create booking validation failed", zap.Any("errors", errs))
-		return nil, fmt.Errorf("validation failed: %s", utils.FormatValidationErrors(errs))
+		utils.LoggerFromContext(ctx, s.log).Warn("Create booking validation failed", zap.Any("errors", errs))
+		return nil, errs
 	}
 
 	// Parse IDs
@@ -59,15 +161,19 @@ func (s *bookingService) CreateBooking(ctx context.Context, userID string, req *
 		return nil, fmt.Errorf("invalid schedule ID format %s: %w", req.ScheduleID, err)
 	}
 
-	// Validate schedule exists
+	// Validate schedule exists; draft belum boleh dibooking
 	schedule, err := s.repo.Schedule.FindByID(ctx, scheduleID)
-	if err != nil || schedule == nil {
-		return nil, fmt.Errorf("schedule %s not found", req.ScheduleID)
+	if err != nil || schedule == nil || !schedule.IsPublished() {
+		return nil, i18n.Errorf("booking.schedule_not_found", req.ScheduleID)
 	}
 
-	// Check if schedule is in the future
-	if schedule.ShowDate.Before(time.Now().Add(-24 * time.Hour)) {
-		return nil, fmt.Errorf("cannot book for past schedule")
+	if salesClosed(schedule, s.settings.salesCutoff(ctx), time.Now()) {
+		return nil, ErrSalesClosed
+	}
+
+	ageWarnings, err := checkAgeRating(ctx, s.repo, s.ageRating, userUUID, schedule)
+	if err != nil {
+		return nil, err
 	}
 
 	// Parse seat IDs
@@ -80,11 +186,11 @@ func (s *bookingService) CreateBooking(ctx context.Context, userID string, req *
 		seatUUIDs[i] = seatID
 	}
 
-	// Check seat availability
-	bookedSeats, err := s.repo.BookingSeat.FindBookedSeatsBySchedule(ctx, scheduleID)
-	if err != nil {
-		s.log.Error("Failed to check booked seats", zap.Error(err))
-		return nil, fmt.Errorf("check seat availability: %w", err)
+	// Business rules: jumlah kursi & duplikat
+	rules := s.rules
+	rules.maxSeats = s.settings.maxSeatsPerBooking(ctx)
+	if err := rules.checkSelection(seatUUIDs); err != nil {
+		return nil, err
 	}
 
 	// Check each seat
@@ -92,30 +198,55 @@ func (s *bookingService) CreateBooking(ctx context.Context, userID string, req *
 		// Check if seat exists and in correct hall
 		seat, err := s.repo.Seat.FindByID(ctx, seatID)
 		if err != nil || seat == nil {
-			return nil, fmt.Errorf("seat %s not found", seatID.String())
+			return nil, i18n.Errorf("booking.seat_not_found", seatID.String())
 		}
 
 		// Check if seat is in the correct hall for this schedule
 		if seat.HallID != schedule.HallID {
-			return nil, fmt.Errorf("seat %s not in schedule hall", seatID.String())
+			return nil, i18n.Errorf("booking.seat_wrong_hall", seatID.String())
 		}
 
-		// Check if seat is already booked
-		for _, bookedSeatID := range bookedSeats {
-			if seatID == bookedSeatID {
-				return nil, fmt.Errorf("seat %s is already booked", seatID.String())
-			}
+		// Diblokir admin di level hall (rusak / maintenance)
+		if !seat.IsAvailable {
+			return nil, newSeatSelectionError(SeatRuleBlocked, "booking.seat_blocked", seat.SeatNumber)
 		}
 	}
 
 	// Get hall for price calculation
 	hall, err := s.repo.Hall.FindByID(ctx, schedule.HallID)
 	if err != nil || hall == nil {
-		return nil, fmt.Errorf("hall not found for schedule")
+		return nil, i18n.Errorf("booking.hall_not_found")
+	}
+
+	// Cinema dan payment method menentukan pajak dan convenience fee
+	cinema, err := s.repo.Cinema.FindByID(ctx, hall.CinemaID)
+	if err != nil || cinema == nil {
+		return nil, i18n.Errorf("booking.cinema_not_found")
+	}
+
+	paymentMethod, err := s.findActivePaymentMethod(ctx, req.PaymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tier customer menentukan promo khusus tier dan bebas convenience fee
+	tier, err := s.customerTier(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Promo jam tertentu masuk sebagai diskon supaya BasePrice tetap harga normal di receipt
+	promotions, err := s.repo.PricePromotion.FindActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find price promotions: %w", err)
 	}
+	regularPrice := s.pricing.seatPrice(schedule, hall)
+	promotedPrice, _ := s.pricing.promotedPrice(schedule, hall, promotions, tier)
 
-	// Calculate total price
-	totalPrice := schedule.Price * float64(len(seatUUIDs))
+	orderID, err := s.newOrderID(ctx, cinema)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create booking entity
 	now := time.Now()
@@ -125,24 +256,18 @@ func (s *bookingService) CreateBooking(ctx context.Context, userID string, req *
 			CreatedAt: now,
 			UpdatedAt: now,
 		},
-		OrderID:    utils.GenerateOrderID(),
+		OrderID:    orderID,
 		UserID:     userUUID,
 		ScheduleID: scheduleID,
 		TotalSeats: len(seatUUIDs),
-		TotalPrice: totalPrice,
 		Status:     entity.BookingStatusPending,
-	}
+		BasePrice:  regularPrice * int64(len(seatUUIDs)),
+		Currency:   schedule.Currency,
 
-	// Start transaction (simplified - kita pakai sequential untuk sekarang)
-	// Save booking
-	if err := s.repo.Booking.Create(ctx, booking); err != nil {
-		s.log.Error("Failed to create booking",
-			zap.Error(err),
-			zap.String("user_id", userID),
-			zap.String("schedule_id", req.ScheduleID),
-		)
-		return nil, fmt.Errorf("create booking: %w", err)
+		DiscountAmount: (regularPrice - promotedPrice) * int64(len(seatUUIDs)),
+		CustomerTier:   tier,
 	}
+	s.pricing.applyCharges(booking, paymentMethod, cinema, tier)
 
 	// Create booking seats
 	bookingSeats := make([]*entity.BookingSeat, len(seatUUIDs))
@@ -152,23 +277,136 @@ func (s *bookingService) CreateBooking(ctx context.Context, userID string, req *
 				ID:        uuid.New(),
 				CreatedAt: now,
 			},
-			BookingID: booking.ID,
-			SeatID:    seatID,
+			BookingID:  booking.ID,
+			SeatID:     seatID,
+			ScheduleID: booking.ScheduleID,
+		}
+	}
+
+	// Booking, seats dan outbox event disimpan dalam satu transaction
+	releasedHolds := false
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		// Lock schedule dulu supaya dua request tidak bisa lolos cek kursi bersamaan
+		if err := tx.Schedule.LockByID(ctx, scheduleID); err != nil {
+			return err
+		}
+
+		bookedSeats, err := tx.BookingSeat.FindBookedSeatsBySchedule(ctx, scheduleID)
+		if err != nil {
+			return fmt.Errorf("check seat availability: %w", err)
+		}
+
+		booked := make(map[uuid.UUID]bool, len(bookedSeats))
+		for _, bookedSeatID := range bookedSeats {
+			booked[bookedSeatID] = true
+		}
+
+		// Seat yang di-hold untuk waitlist user lain diperlakukan seperti sudah dibooking
+		holds, err := tx.SeatHold.FindActiveBySchedule(ctx, scheduleID, now)
+		if err != nil {
+			return fmt.Errorf("check seat holds: %w", err)
+		}
+		for _, hold := range holds {
+			if hold.UserID != userUUID {
+				booked[hold.SeatID] = true
+			}
+		}
+		for _, seatID := range seatUUIDs {
+			if booked[seatID] {
+				return i18n.Errorf("booking.seat_already_booked", seatID.String())
+			}
+		}
+
+		// Blokir khusus schedule ini dicek di dalam lock supaya tidak balapan dengan admin
+		blocks, err := tx.SeatBlock.FindBySchedule(ctx, scheduleID)
+		if err != nil {
+			return fmt.Errorf("check seat blocks: %w", err)
+		}
+		selectedSeats := make(map[uuid.UUID]bool, len(seatUUIDs))
+		for _, seatID := range seatUUIDs {
+			selectedSeats[seatID] = true
 		}
+		for _, block := range blocks {
+			if selectedSeats[block.SeatID] {
+				return newSeatSelectionError(SeatRuleBlocked, "booking.seat_blocked", block.SeatID.String())
+			}
+			booked[block.SeatID] = true
+		}
+
+		if s.rules.noSingleSeatGap {
+			hallSeats, err := tx.Seat.FindByHallID(ctx, schedule.HallID)
+			if err != nil {
+				return fmt.Errorf("load hall seats: %w", err)
+			}
+			if err := s.rules.checkGaps(hallSeats, booked, seatUUIDs); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Booking.Create(ctx, booking); err != nil {
+			return fmt.Errorf("create booking: %w", err)
+		}
+
+		if err := createBookingSeats(ctx, tx, booking.ScheduleID, bookingSeats); err != nil {
+			return err
+		}
+
+		// Booking ini menyelesaikan entry waitlist user (kalau ada) dan melepas hold-nya
+		entry, err := tx.Waitlist.FindActiveByScheduleAndUser(ctx, scheduleID, userUUID)
+		if err != nil {
+			return fmt.Errorf("check waitlist entry: %w", err)
+		}
+		if entry != nil {
+			releasedHolds = entry.Status == entity.WaitlistStatusOffered
+			entry.Status = entity.WaitlistStatusFulfilled
+			entry.UpdatedAt = now
+			if err := tx.Waitlist.Update(ctx, entry); err != nil {
+				return err
+			}
+			if err := tx.SeatHold.DeleteByWaitlistEntry(ctx, entry.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := recordFunnel(ctx, tx, entity.FunnelStepHold, scheduleID, &userUUID, &booking.ID); err != nil {
+			return err
+		}
+
+		return enqueueEvent(ctx, tx, events.AggregateBooking, booking.ID, events.TypeBookingCreated, events.BookingCreated{
+			BookingID:  booking.ID.String(),
+			OrderID:    booking.OrderID,
+			UserID:     booking.UserID.String(),
+			ScheduleID: booking.ScheduleID.String(),
+			SeatIDs:    req.SeatIDs,
+			TotalSeats: booking.TotalSeats,
+			TotalPrice: utils.CurrencyOf(booking.Currency).ToMajor(booking.TotalPrice),
+			Currency:   booking.Currency,
+			CreatedAt:  booking.CreatedAt,
+		})
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create booking",
+			zap.Error(err),
+			zap.String("user_id", userID),
+			zap.String("schedule_id", req.ScheduleID),
+		)
+		return nil, err
 	}
+	s.seats.invalidate(scheduleID)
 
-	if err := s.repo.BookingSeat.CreateBatch(ctx, bookingSeats); err != nil {
-		// Rollback: delete booking
-		s.repo.Booking.Delete(ctx, booking.ID)
-		return nil, fmt.Errorf("create booking seats: %w", err)
+	// Hold yang tidak terpakai (user pilih seat lain) ditawarkan ke antrian berikutnya
+	if releasedHolds {
+		if err := s.waitlist.OfferFreedSeats(ctx, scheduleID); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to re-offer released holds", zap.Error(err), zap.String("schedule_id", req.ScheduleID))
+		}
 	}
 
-	s.log.Info("Booking created",
+	utils.LoggerFromContext(ctx, s.log).Info("Booking created",
 		zap.String("booking_id", booking.ID.String()),
 		zap.String("order_id", booking.OrderID),
 		zap.String("user_id", userID),
 		zap.Int("seat_count", len(seatUUIDs)),
-		zap.Float64("total_price", totalPrice),
+		zap.Int64("total_price", booking.TotalPrice),
 	)
 
 	// Get seat numbers for response
@@ -181,23 +419,34 @@ func (s *bookingService) CreateBooking(ctx context.Context, userID string, req *
 	}
 
 	// Build response
-	return s.buildBookingResponse(ctx, booking, seatNumbers), nil
+	resp := s.buildBookingResponse(ctx, booking, seatNumbers)
+	resp.Warnings = ageWarnings
+	return resp, nil
 }
 
-func (s *bookingService) GetUserBookings(ctx context.Context, userID string, req *request.PaginatedRequest) (*response.PaginatedResponse[response.BookingResponse], error) {
+func (s *bookingService) GetUserBookings(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.BookingHistoryFilter) (*response.PaginatedResponse[response.BookingResponse], error) {
 	// Parse user ID
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
 	}
 
+	bookingFilter, err := s.parseBookingFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UseCursor() {
+		return s.getUserBookingsByCursor(ctx, userUUID, bookingFilter, req)
+	}
+
 	limit := req.Limit()
 	offset := req.Offset()
 
 	// Get bookings
-	bookings, err := s.repo.Booking.FindByUserID(ctx, userUUID, limit, offset)
+	bookings, err := s.repo.Booking.FindByUserID(ctx, userUUID, bookingFilter, limit, offset)
 	if err != nil {
-		s.log.Error("Failed to get user bookings",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get user bookings",
 			zap.Error(err),
 			zap.String("user_id", userID),
 			zap.Int("page", req.Page),
@@ -207,82 +456,16 @@ func (s *bookingService) GetUserBookings(ctx context.Context, userID string, req
 	}
 
 	// Get total count
-	total, err := s.repo.Booking.CountByUserID(ctx, userUUID)
+	total, err := s.repo.Booking.CountByUserID(ctx, userUUID, bookingFilter)
 	if err != nil {
-		s.log.Error("Failed to count user bookings", zap.Error(err))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count user bookings", zap.Error(err))
 		return nil, fmt.Errorf("count user bookings: %w", err)
 	}
 
 	// Convert to response
-	bookingResponses := make([]response.BookingResponse, len(bookings))
-	for i, booking := range bookings {
-		// Get seat numbers
-		bookingSeats, _ := s.repo.BookingSeat.FindByBookingID(ctx, booking.ID)
-		seatNumbers := make([]string, len(bookingSeats))
-		for j, bs := range bookingSeats {
-			seat, _ := s.repo.Seat.FindByID(ctx, bs.SeatID)
-			if seat != nil {
-				seatNumbers[j] = seat.SeatNumber
-			}
-		}
-
-		// Get schedule details
-		var movieTitle, cinemaName string
-		var hallNumber int
-		var showDate, showTime string
-
-		schedule, _ := s.repo.Schedule.FindByID(ctx, booking.ScheduleID)
-		if schedule != nil {
-			movie, _ := s.repo.Movie.FindByID(ctx, schedule.MovieID)
-			if movie != nil {
-				movieTitle = movie.Title
-			}
-
-			hall, _ := s.repo.Hall.FindByID(ctx, schedule.HallID)
-			if hall != nil {
-				hallNumber = hall.HallNumber
-
-				cinema, _ := s.repo.Cinema.FindByID(ctx, hall.CinemaID)
-				if cinema != nil {
-					cinemaName = cinema.Name
-				}
-			}
-
-			showDate = schedule.ShowDate.Format("2006-01-02")
-			showTime = schedule.ShowTime.Format("15:04")
-		}
-
-		// Get payment
-		var paymentResp *response.PaymentResponse
-		payment, _ := s.repo.Payment.FindByBookingID(ctx, booking.ID)
-		if payment != nil {
-			paymentMethod, _ := s.repo.PaymentMethod.FindByID(ctx, payment.PaymentMethodID)
-			if paymentMethod != nil {
-				paymentRespValue := response.PaymentToResponse(payment, paymentMethod)
-				paymentResp = &paymentRespValue
-			}
-		}
-
-		bookingResponses[i] = response.BookingResponse{
-			ID:          booking.ID.String(),
-			OrderID:     booking.OrderID,
-			UserID:      booking.UserID.String(),
-			ScheduleID:  booking.ScheduleID.String(),
-			MovieTitle:  movieTitle,
-			CinemaName:  cinemaName,
-			HallNumber:  hallNumber,
-			ShowDate:    showDate,
-			ShowTime:    showTime,
-			TotalSeats:  booking.TotalSeats,
-			TotalPrice:  booking.TotalPrice,
-			Status:      booking.Status,
-			SeatNumbers: seatNumbers,
-			Payment:     paymentResp,
-			CreatedAt:   booking.CreatedAt,
-		}
-	}
+	bookingResponses := s.buildBookingList(ctx, bookings)
 
-	s.log.Info("User bookings retrieved",
+	utils.LoggerFromContext(ctx, s.log).Info("User bookings retrieved",
 		zap.String("user_id", userID),
 		zap.Int("count", len(bookings)),
 		zap.Int64("total", total),
@@ -293,11 +476,29 @@ func (s *bookingService) GetUserBookings(ctx context.Context, userID string, req
 	return response.NewPaginatedResponse(bookingResponses, req.Page, req.PerPage, total), nil
 }
 
+func (s *bookingService) GetNextUpcomingBooking(ctx context.Context, userID string) (*response.BookingResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	booking, err := s.repo.Booking.FindNextUpcomingByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("get next upcoming booking: %w", err)
+	}
+	if booking == nil {
+		return nil, nil
+	}
+
+	bookingResp := s.buildBookingListItem(ctx, booking)
+	return &bookingResp, nil
+}
+
 func (s *bookingService) ProcessPayment(ctx context.Context, userID string, req *request.ProcessPaymentRequest) (*response.PaymentResponse, error) {
 	// Validate request
 	if errs := utils.ValidateStruct(req); len(errs) > 0 {
-		s.log.Warn("Process payment validation failed", zap.Any("errors", errs))
-		return nil, fmt.Errorf("validation failed: %s", utils.FormatValidationErrors(errs))
+		utils.LoggerFromContext(ctx, s.log).Warn("Process payment validation failed", zap.Any("errors", errs))
+		return nil, errs
 	}
 
 	// Parse IDs
@@ -311,40 +512,40 @@ func (s *bookingService) ProcessPayment(ctx context.Context, userID string, req
 		return nil, fmt.Errorf("invalid booking ID format %s: %w", req.BookingID, err)
 	}
 
-	paymentMethodID, err := uuid.Parse(req.PaymentMethodID)
-	if err != nil {
-		return nil, fmt.Errorf("invalid payment method ID format %s: %w", req.PaymentMethodID, err)
-	}
-
 	// Get booking
 	booking, err := s.repo.Booking.FindByID(ctx, bookingID)
 	if err != nil || booking == nil {
-		return nil, fmt.Errorf("booking %s not found", req.BookingID)
+		return nil, i18n.Errorf("booking.not_found", req.BookingID)
 	}
 
 	// Check if booking belongs to user
 	if booking.UserID != userUUID {
-		return nil, fmt.Errorf("unauthorized to process payment for this booking")
+		return nil, i18n.Errorf("booking.payment_unauthorized")
 	}
 
 	// Check booking status
 	if booking.Status != entity.BookingStatusPending {
-		return nil, fmt.Errorf("booking status is %s, cannot process payment", booking.Status)
+		return nil, i18n.Errorf("booking.payment_status", booking.Status)
 	}
 
-	// Check if amount matches
-	if req.Amount != booking.TotalPrice {
-		return nil, fmt.Errorf("payment amount %.2f does not match booking total %.2f", req.Amount, booking.TotalPrice)
+	// Check payment method
+	paymentMethod, err := s.findActivePaymentMethod(ctx, req.PaymentMethodID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check payment method
-	paymentMethod, err := s.repo.PaymentMethod.FindByID(ctx, paymentMethodID)
-	if err != nil || paymentMethod == nil {
-		return nil, fmt.Errorf("payment method %s not found", req.PaymentMethodID)
+	// Fee bisa beda per payment method, jadi total dihitung ulang dengan method yang dipakai bayar
+	cinema, err := s.findScheduleCinema(ctx, booking.ScheduleID)
+	if err != nil {
+		return nil, err
 	}
+	// Tier yang dipakai adalah tier saat booking dibuat, jadi perubahan tier di antaranya tidak mengubah harga
+	s.pricing.applyCharges(booking, paymentMethod, cinema, booking.CustomerTier)
 
-	if !paymentMethod.IsActive {
-		return nil, fmt.Errorf("payment method %s is not active", paymentMethod.Name)
+	// Harga selalu dari server; amount client hanya dicek kalau dikirim
+	if req.Amount != nil && !s.pricing.amountMatches(*req.Amount, booking.TotalPrice, booking.Currency) {
+		return nil, i18n.Errorf("booking.payment_amount",
+			*req.Amount, utils.CurrencyOf(booking.Currency).Format(booking.TotalPrice))
 	}
 
 	// Create payment
@@ -356,227 +557,1540 @@ func (s *bookingService) ProcessPayment(ctx context.Context, userID string, req
 			UpdatedAt: now,
 		},
 		BookingID:       bookingID,
-		PaymentMethodID: paymentMethodID,
-		Amount:          req.Amount,
+		PaymentMethodID: paymentMethod.ID,
+		Amount:          booking.TotalPrice,
+		Currency:        booking.Currency,
 		Status:          entity.PaymentStatusPending,
 		TransactionID:   req.TransactionID,
 	}
 
-	// Simulate payment processing (dummy implementation)
-	// In real app, integrate with payment gateway
-	payment.Status = entity.PaymentStatusCompleted
+	// Payment, potongan saldo gift card, status booking dan outbox event harus commit bersama
+	var async bool
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		// Re-read dengan row lock, mencegah booking yang sama dibayar dua kali
+		locked, err := tx.Booking.FindByIDForUpdate(ctx, bookingID)
+		if err != nil {
+			return err
+		}
+		if locked == nil {
+			return i18n.Errorf("booking.not_found", req.BookingID)
+		}
+		if locked.Status != entity.BookingStatusPending {
+			return i18n.Errorf("booking.payment_status", locked.Status)
+		}
 
-	// Update booking status
-	booking.Status = entity.BookingStatusConfirmed
-	booking.UpdatedAt = now
+		// Kode bayar yang masih aktif tidak diganti: transfer ke VA lama tetap harus bisa dicocokkan
+		existing, err := tx.Payment.FindByBookingID(ctx, bookingID)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.Status.Open() {
+			return i18n.Errorf("booking.payment_pending", req.BookingID, existing.ID)
+		}
 
-	// Save payment and update booking (simplified - no transaction)
-	if err := s.repo.Payment.Create(ctx, payment); err != nil {
-		s.log.Error("Failed to create payment",
-			zap.Error(err),
-			zap.String("booking_id", req.BookingID),
-		)
-		return nil, fmt.Errorf("create payment: %w", err)
-	}
+		// Fee dan pajak dari method yang dipakai bayar disimpan, supaya rincian booking sama dengan
+		// payments.amount dan ledger / refund memakai angka yang benar-benar ditagih
+		if err := tx.Booking.UpdateCharges(ctx, booking); err != nil {
+			return err
+		}
+
+		var giftLedger []*entity.GiftCardTransaction
+		if req.UseGiftBalance {
+			giftLedger, err = debitGiftBalance(ctx, tx, userUUID, payment, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Kalau saldo gift menutup seluruh total, tidak ada yang perlu ditransfer lewat VA / QRIS
+		async = paymentMethod.IsAsync() && payment.Amount > 0
+		if async {
+			// VA / QRIS dibayar di luar app; booking tetap pending sampai webhook gateway masuk
+			code := issuePaymentCode(paymentMethod, booking, payment)
+			expiresAt := now.Add(s.paymentDeadlines[paymentMethod.Type])
+			payment.PaymentCode = &code
+			payment.ExpiresAt = &expiresAt
+		}
+
+		if err := tx.Payment.Create(ctx, payment); err != nil {
+			return fmt.Errorf("create payment: %w", err)
+		}
+
+		if err := tx.GiftCard.CreateTransactions(ctx, giftLedger); err != nil {
+			return err
+		}
+		if err := postPaymentGiftHold(ctx, tx, payment, now); err != nil {
+			return err
+		}
+
+		if err := recordFunnel(ctx, tx, entity.FunnelStepBooking, booking.ScheduleID, &booking.UserID, &booking.ID); err != nil {
+			return err
+		}
+
+		if async {
+			return nil
+		}
+
+		// Simulate payment processing (dummy implementation)
+		// In real app, integrate with payment gateway
+		payment.PaidAt = &now
+		if err := transitionPayment(ctx, tx, payment, entity.PaymentStatusCompleted, "charged by payment method"); err != nil {
+			return err
+		}
 
-	if err := s.repo.Booking.Update(ctx, booking); err != nil {
-		s.log.Error("Failed to update booking status",
+		// booking dibaca sebelum tx, jadi version-nya sekaligus menjaga dari cancel admin di tengah jalan
+		if err := transitionBooking(ctx, tx, booking, entity.BookingStatusConfirmed, "payment completed"); err != nil {
+			return fmt.Errorf("update booking status: %w", err)
+		}
+		if err := postPaymentCompleted(ctx, tx, booking, payment); err != nil {
+			return err
+		}
+		if err := enqueuePaymentCompleted(ctx, tx, booking, payment); err != nil {
+			return err
+		}
+		return s.jobs.enqueue(ctx, tx, jobBookingConfirmation, bookingJob{BookingID: booking.ID})
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to process payment",
 			zap.Error(err),
 			zap.String("booking_id", req.BookingID),
 		)
-		// Continue anyway
+		return nil, err
 	}
 
-	s.log.Info("Payment processed",
+	utils.LoggerFromContext(ctx, s.log).Info("Payment processed",
 		zap.String("payment_id", payment.ID.String()),
 		zap.String("booking_id", req.BookingID),
 		zap.String("payment_method", paymentMethod.Name),
-		zap.Float64("amount", req.Amount),
+		zap.Int64("amount", payment.Amount),
+		zap.Int64("gift_card_amount", payment.GiftCardAmount),
 		zap.String("status", string(payment.Status)),
 	)
 
 	// Build response
 	paymentResp := response.PaymentToResponse(payment, paymentMethod)
+	paymentResp.PriceBreakdown = priceBreakdown(booking)
 	return &paymentResp, nil
 }
 
 func (s *bookingService) GetPaymentMethods(ctx context.Context) ([]*response.PaymentMethodResponse, error) {
 	paymentMethods, err := s.repo.PaymentMethod.FindAllActive(ctx)
 	if err != nil {
-		s.log.Error("Failed to get payment methods", zap.Error(err))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get payment methods", zap.Error(err))
 		return nil, fmt.Errorf("get payment methods: %w", err)
 	}
 
 	paymentMethodResponses := make([]*response.PaymentMethodResponse, len(paymentMethods))
 	for i, pm := range paymentMethods {
-		pmResp := response.PaymentMethodToResponse(pm)
+		pmResp := response.PaymentMethodToResponse(pm, s.pricing.currency.Code)
 		paymentMethodResponses[i] = &pmResp
 	}
 
-	s.log.Info("Payment methods retrieved", zap.Int("count", len(paymentMethods)))
+	utils.LoggerFromContext(ctx, s.log).Info("Payment methods retrieved", zap.Int("count", len(paymentMethods)))
 	return paymentMethodResponses, nil
 }
 
-// ==================== ADMIN METHODS ====================
-
-func (s *bookingService) GetBookingByID(ctx context.Context, bookingID string) (*response.BookingDetailResponse, error) {
-	// Parse booking ID
-	id, err := uuid.Parse(bookingID)
+func (s *bookingService) GetPaymentStatus(ctx context.Context, userID, paymentID string) (*response.PaymentStatusResponse, error) {
+	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid booking ID format %s: %w", bookingID, err)
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
 	}
 
-	booking, err := s.repo.Booking.FindByID(ctx, id)
-	if err != nil || booking == nil {
-		return nil, fmt.Errorf("booking %s not found", bookingID)
+	id, err := uuid.Parse(paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment ID format %s: %w", paymentID, err)
 	}
 
-	// Get seat numbers
-	bookingSeats, _ := s.repo.BookingSeat.FindByBookingID(ctx, booking.ID)
-	seatNumbers := make([]string, len(bookingSeats))
-	for i, bs := range bookingSeats {
-		seat, _ := s.repo.Seat.FindByID(ctx, bs.SeatID)
-		if seat != nil {
-			seatNumbers[i] = seat.SeatNumber
-		}
+	payment, err := s.repo.Payment.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find payment: %w", err)
+	}
+	if payment == nil {
+		return nil, i18n.Errorf("booking.payment_not_found", paymentID)
 	}
 
-	// Get schedule details
-	var scheduleDetails response.ScheduleDetails
-	schedule, _ := s.repo.Schedule.FindByID(ctx, booking.ScheduleID)
-	if schedule != nil {
-		movie, _ := s.repo.Movie.FindByID(ctx, schedule.MovieID)
-		if movie != nil {
-			scheduleDetails.MovieTitle = movie.Title
-		}
+	// Payment milik user lain dianggap tidak ada
+	booking, err := s.repo.Booking.FindByID(ctx, payment.BookingID)
+	if err != nil {
+		return nil, fmt.Errorf("find booking: %w", err)
+	}
+	if booking == nil || booking.UserID != userUUID {
+		return nil, i18n.Errorf("booking.payment_not_found", paymentID)
+	}
 
-		hall, _ := s.repo.Hall.FindByID(ctx, schedule.HallID)
-		if hall != nil {
-			scheduleDetails.HallNumber = hall.HallNumber
+	resp := response.PaymentStatusToResponse(payment, booking)
 
-			cinema, _ := s.repo.Cinema.FindByID(ctx, hall.CinemaID)
-			if cinema != nil {
-				scheduleDetails.CinemaName = cinema.Name
-			}
+	// Worker expiry jalan per menit; client langsung lihat expired begitu batas bayar lewat
+	if payment.Status == entity.PaymentStatusPending && payment.ExpiresAt != nil && !payment.ExpiresAt.After(time.Now()) {
+		resp.Status = entity.PaymentStatusExpired
+		if booking.Status == entity.BookingStatusPending {
+			resp.BookingStatus = entity.BookingStatusExpired
 		}
-
-		scheduleDetails.ShowDate = schedule.ShowDate.Format("2006-01-02")
-		scheduleDetails.ShowTime = schedule.ShowTime.Format("15:04")
-		scheduleDetails.Price = schedule.Price
 	}
 
-	// Get payment
-	var paymentResp *response.PaymentResponse
-	payment, _ := s.repo.Payment.FindByBookingID(ctx, booking.ID)
-	if payment != nil {
-		paymentMethod, _ := s.repo.PaymentMethod.FindByID(ctx, payment.PaymentMethodID)
-		if paymentMethod != nil {
-			paymentRespValue := response.PaymentToResponse(payment, paymentMethod)
-			paymentResp = &paymentRespValue
-		}
-	}
+	return &resp, nil
+}
 
-	bookingResp := response.BookingResponse{
-		ID:          booking.ID.String(),
-		OrderID:     booking.OrderID,
-		UserID:      booking.UserID.String(),
-		ScheduleID:  booking.ScheduleID.String(),
-		MovieTitle:  scheduleDetails.MovieTitle,
-		CinemaName:  scheduleDetails.CinemaName,
-		HallNumber:  scheduleDetails.HallNumber,
-		ShowDate:    scheduleDetails.ShowDate,
-		ShowTime:    scheduleDetails.ShowTime,
-		TotalSeats:  booking.TotalSeats,
-		TotalPrice:  booking.TotalPrice,
-		Status:      booking.Status,
-		SeatNumbers: seatNumbers,
-		Payment:     paymentResp,
-		CreatedAt:   booking.CreatedAt,
+func (s *bookingService) HandlePaymentWebhook(ctx context.Context, req *request.PaymentWebhookRequest) (*response.PaymentStatusResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Payment webhook validation failed", zap.Any("errors", errs))
+		return nil, errs
 	}
 
-	return &response.BookingDetailResponse{
-		BookingResponse: bookingResp,
-		ScheduleDetails: scheduleDetails,
-	}, nil
-}
-
-func (s *bookingService) CancelBooking(ctx context.Context, bookingID string) error {
-	// Parse booking ID
-	id, err := uuid.Parse(bookingID)
+	paymentID, err := uuid.Parse(req.PaymentID)
 	if err != nil {
-		return fmt.Errorf("invalid booking ID format %s: %w", bookingID, err)
+		return nil, fmt.Errorf("invalid payment ID format %s: %w", req.PaymentID, err)
 	}
+	status := webhookPaymentStatus[req.Status]
 
-	booking, err := s.repo.Booking.FindByID(ctx, id)
-	if err != nil || booking == nil {
-		return fmt.Errorf("booking %s not found", bookingID)
-	}
+	var (
+		payment  *entity.Payment
+		booking  *entity.Booking
+		changed  bool
+		released bool
+	)
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		// Lock payment dulu baru booking, urutan yang sama dengan worker expiry
+		payment, err = tx.Payment.FindByIDForUpdate(ctx, paymentID)
+		if err != nil {
+			return err
+		}
+		if payment == nil {
+			return fmt.Errorf("payment %s not found", req.PaymentID)
+		}
 
-	// Check if booking can be cancelled
-	if booking.Status != entity.BookingStatusPending && booking.Status != entity.BookingStatusConfirmed {
-		return fmt.Errorf("booking status is %s, cannot cancel", booking.Status)
-	}
+		booking, err = tx.Booking.FindByIDForUpdate(ctx, payment.BookingID)
+		if err != nil {
+			return err
+		}
+		if booking == nil {
+			return fmt.Errorf("booking %s not found", payment.BookingID)
+		}
 
-	// Update booking status
-	if err := s.repo.Booking.UpdateStatus(ctx, booking.ID, entity.BookingStatusCancelled); err != nil {
-		s.log.Error("Failed to cancel booking",
-			zap.Error(err),
-			zap.String("booking_id", bookingID),
-		)
-		return fmt.Errorf("cancel booking %s: %w", bookingID, err)
-	}
+		// Gateway me-retry callback; status yang sama cukup di-ack
+		if payment.Status == status {
+			return nil
+		}
+		if !payment.Status.CanTransitionTo(status) {
+			return fmt.Errorf("payment %s is already %s, cannot apply %s callback", req.PaymentID, payment.Status, req.Status)
+		}
 
-	s.log.Info("Booking cancelled",
-		zap.String("booking_id", bookingID),
-		zap.String("order_id", booking.OrderID),
-	)
+		now := time.Now()
+		changed = true
 
-	return nil
-}
+		switch status {
+		case entity.PaymentStatusProcessing:
+			// Transfer sudah diterima gateway tapi belum settle; booking tetap pending
+			return transitionPayment(ctx, tx, payment, entity.PaymentStatusProcessing, "payment webhook")
 
-// ==================== HELPER METHODS ====================
+		case entity.PaymentStatusCompleted:
+			if booking.Status != entity.BookingStatusPending {
+				return fmt.Errorf("booking status is %s, cannot confirm payment %s", booking.Status, req.PaymentID)
+			}
 
-func (s *bookingService) buildBookingResponse(ctx context.Context, booking *entity.Booking, seatNumbers []string) *response.BookingResponse {
-	// Get schedule details
-	var movieTitle, cinemaName string
-	var hallNumber int
-	var showDate, showTime string
+			payment.PaidAt = &now
+			if req.TransactionID != nil {
+				payment.TransactionID = req.TransactionID
+			}
+			if err := transitionPayment(ctx, tx, payment, entity.PaymentStatusCompleted, "payment webhook"); err != nil {
+				return err
+			}
 
-	schedule, _ := s.repo.Schedule.FindByID(ctx, booking.ScheduleID)
-	if schedule != nil {
-		movie, _ := s.repo.Movie.FindByID(ctx, schedule.MovieID)
-		if movie != nil {
-			movieTitle = movie.Title
-		}
+			if err := transitionBooking(ctx, tx, booking, entity.BookingStatusConfirmed, "payment webhook"); err != nil {
+				return err
+			}
+			if err := reconcilePaidCharges(ctx, tx, booking, payment, s.log); err != nil {
+				return err
+			}
+			if err := postPaymentCompleted(ctx, tx, booking, payment); err != nil {
+				return err
+			}
+			if err := enqueuePaymentCompleted(ctx, tx, booking, payment); err != nil {
+				return err
+			}
 
-		hall, _ := s.repo.Hall.FindByID(ctx, schedule.HallID)
-		if hall != nil {
-			hallNumber = hall.HallNumber
+			return s.jobs.enqueue(ctx, tx, jobBookingConfirmation, bookingJob{BookingID: booking.ID})
+
+		case entity.PaymentStatusFailed:
+			// Booking tetap pending, user bisa bayar ulang dengan method lain
+			if err := transitionPayment(ctx, tx, payment, entity.PaymentStatusFailed, "payment webhook"); err != nil {
+				return err
+			}
+			return refundGiftBalance(ctx, tx, payment, now)
 
-			cinema, _ := s.repo.Cinema.FindByID(ctx, hall.CinemaID)
-			if cinema != nil {
-				cinemaName = cinema.Name
+		default:
+			released, err = expirePayment(ctx, tx, payment, booking)
+			if err != nil || !released {
+				return err
 			}
+			return s.jobs.enqueue(ctx, tx, jobPaymentExpiredNotice, bookingJob{BookingID: booking.ID})
 		}
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to apply payment webhook",
+			zap.Error(err),
+			zap.String("payment_id", req.PaymentID),
+			zap.String("status", req.Status),
+		)
+		return nil, err
+	}
 
-		showDate = schedule.ShowDate.Format("2006-01-02")
-		showTime = schedule.ShowTime.Format("15:04")
+	if changed {
+		utils.LoggerFromContext(ctx, s.log).Info("Payment webhook applied",
+			zap.String("payment_id", req.PaymentID),
+			zap.String("booking_id", booking.ID.String()),
+			zap.String("status", string(payment.Status)),
+		)
 	}
 
-	return &response.BookingResponse{
-		ID:          booking.ID.String(),
+	if released {
+		s.offerFreedSeats(ctx, booking.ScheduleID)
+	}
+
+	resp := response.PaymentStatusToResponse(payment, booking)
+	return &resp, nil
+}
+
+// ==================== ADMIN METHODS ====================
+
+func (s *bookingService) GetAllBookings(ctx context.Context, req *request.PaginatedRequest, filter *request.AdminBookingFilter) (*response.PaginatedResponse[response.BookingResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
+	repoFilter := repository.AdminBookingFilter{OrganizationID: adminOrganization(ctx)}
+	if filter.Flag != "" {
+		flag := entity.BookingFlag(filter.Flag)
+		repoFilter.Flag = &flag
+	}
+
+	limit := req.Limit()
+
+	if req.UseCursor() {
+		cursor, err := decodeCursor(*req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		bookings, err := s.repo.Booking.FindAllAfter(ctx, repoFilter, cursor, limit+1)
+		if err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to get all bookings by cursor", zap.Error(err))
+			return nil, fmt.Errorf("get all bookings: %w", err)
+		}
+
+		page := s.toCursorPage(ctx, bookings, limit)
+		if err := s.attachBookingFlags(ctx, page.Data); err != nil {
+			return nil, err
+		}
+		return page, nil
+	}
+
+	bookings, err := s.repo.Booking.FindAll(ctx, repoFilter, limit, req.Offset())
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get all bookings",
+			zap.Error(err),
+			zap.Int("page", req.Page),
+			zap.Int("per_page", req.PerPage),
+		)
+		return nil, fmt.Errorf("get all bookings: %w", err)
+	}
+
+	total, err := s.repo.Booking.CountAll(ctx, repoFilter)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count all bookings", zap.Error(err))
+		return nil, fmt.Errorf("count all bookings: %w", err)
+	}
+
+	bookingResponses := s.buildBookingList(ctx, bookings)
+	if err := s.attachBookingFlags(ctx, bookingResponses); err != nil {
+		return nil, err
+	}
+
+	return response.NewPaginatedResponse(bookingResponses, req.Page, req.PerPage, total), nil
+}
+
+func (s *bookingService) GetBookingByID(ctx context.Context, bookingID string) (*response.BookingDetailResponse, error) {
+	// Parse booking ID
+	id, err := uuid.Parse(bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid booking ID format %s: %w", bookingID, err)
+	}
+
+	booking, err := s.repo.Booking.FindByID(ctx, id)
+	if err != nil || booking == nil {
+		return nil, i18n.Errorf("booking.not_found", bookingID)
+	}
+	if err := s.requireBookingScope(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return s.buildAdminBookingDetail(ctx, booking)
+}
+
+// GetBookingByOrderID resolves a receipt order number (admin/support)
+func (s *bookingService) GetBookingByOrderID(ctx context.Context, orderID string) (*response.BookingDetailResponse, error) {
+	orderID = utils.NormalizeOrderID(orderID)
+	if orderID == "" {
+		return nil, i18n.Errorf("booking.order_id_empty")
+	}
+
+	booking, err := s.repo.Booking.FindByOrderID(ctx, orderID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to find booking by order ID",
+			zap.Error(err),
+			zap.String("order_id", orderID),
+		)
+		return nil, fmt.Errorf("get booking by order ID: %w", err)
+	}
+	if booking == nil {
+		return nil, i18n.Errorf("booking.order_not_found", orderID)
+	}
+	if err := s.requireBookingScope(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return s.buildAdminBookingDetail(ctx, booking)
+}
+
+// GetUserBookingByOrderID is the owner-only variant; booking milik user lain dianggap not found
+func (s *bookingService) GetUserBookingByOrderID(ctx context.Context, userID, orderID string) (*response.BookingDetailResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	orderID = utils.NormalizeOrderID(orderID)
+	if orderID == "" {
+		return nil, i18n.Errorf("booking.order_id_empty")
+	}
+
+	booking, err := s.repo.Booking.FindByOrderID(ctx, orderID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to find booking by order ID",
+			zap.Error(err),
+			zap.String("order_id", orderID),
+		)
+		return nil, fmt.Errorf("get booking by order ID: %w", err)
+	}
+	if booking == nil || booking.UserID != userUUID {
+		return nil, i18n.Errorf("booking.order_not_found", orderID)
+	}
+
+	return s.buildBookingDetail(ctx, booking), nil
+}
+
+// GetBookingReceipt is owner-only; booking milik user lain dianggap not found
+func (s *bookingService) GetBookingReceipt(ctx context.Context, userID, bookingID string) ([]byte, string, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	bookingUUID, err := uuid.Parse(bookingID)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid booking ID format %s: %w", bookingID, err)
+	}
+
+	booking, err := s.repo.Booking.FindByID(ctx, bookingUUID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to find booking for receipt",
+			zap.Error(err),
+			zap.String("booking_id", bookingID),
+		)
+		return nil, "", fmt.Errorf("get booking: %w", err)
+	}
+	if booking == nil || booking.UserID != userUUID {
+		return nil, "", i18n.Errorf("booking.not_found", bookingID)
+	}
+
+	content, err := s.buildReceipt(ctx, booking)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return content, receiptFilename(booking.OrderID), nil
+}
+
+func (s *bookingService) CancelBooking(ctx context.Context, bookingID string) error {
+	// Parse booking ID
+	id, err := uuid.Parse(bookingID)
+	if err != nil {
+		return fmt.Errorf("invalid booking ID format %s: %w", bookingID, err)
+	}
+
+	booking, err := s.repo.Booking.FindByID(ctx, id)
+	if err != nil || booking == nil {
+		return i18n.Errorf("booking.not_found", bookingID)
+	}
+	if err := s.requireBookingScope(ctx, booking); err != nil {
+		return err
+	}
+
+	// Check if booking can be cancelled
+	if booking.Status != entity.BookingStatusPending && booking.Status != entity.BookingStatusConfirmed {
+		return i18n.Errorf("booking.cancel_status", booking.Status)
+	}
+
+	// Update booking status
+	previousStatus := booking.Status
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := transitionBooking(ctx, tx, booking, entity.BookingStatusCancelled, "cancelled by admin"); err != nil {
+			return err
+		}
+
+		// Booking yang sudah dibayar dibalik penjualannya di ledger; group booking tidak punya payment
+		if previousStatus == entity.BookingStatusConfirmed {
+			payment, err := tx.Payment.FindByBookingID(ctx, booking.ID)
+			if err != nil {
+				return err
+			}
+			if payment != nil && payment.Status == entity.PaymentStatusCompleted {
+				if err := reconcilePaidCharges(ctx, tx, booking, payment, s.log); err != nil {
+					return err
+				}
+				if err := postBookingRefund(ctx, tx, booking, payment, time.Now()); err != nil {
+					return err
+				}
+			}
+		}
+
+		return enqueueEvent(ctx, tx, events.AggregateBooking, booking.ID, events.TypeBookingCancelled, events.BookingCancelled{
+			BookingID:      booking.ID.String(),
+			OrderID:        booking.OrderID,
+			UserID:         booking.UserID.String(),
+			PreviousStatus: string(previousStatus),
+			CancelledAt:    time.Now(),
+		})
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to cancel booking",
+			zap.Error(err),
+			zap.String("booking_id", bookingID),
+		)
+		return fmt.Errorf("cancel booking %s: %w", bookingID, err)
+	}
+
+	// Kursi yang dilepas ditawarkan ke waitlist
+	s.offerFreedSeats(ctx, booking.ScheduleID)
+
+	utils.LoggerFromContext(ctx, s.log).Info("Booking cancelled",
+		zap.String("booking_id", bookingID),
+		zap.String("order_id", booking.OrderID),
+	)
+
+	return nil
+}
+
+// CreateGroupBooking bypasses per-user seat limits and the gap rule. Ticket dibuat per kursi;
+// dengan BlockRemaining semua kursi sisa ikut ditutup supaya schedule tidak dijual ke publik.
+func (s *bookingService) CreateGroupBooking(ctx context.Context, adminID string, req *request.GroupBookingRequest) (*response.GroupBookingResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Create group booking validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+	if req.WholeHall == (len(req.SeatIDs) > 0) {
+		return nil, i18n.Errorf("booking.group_selection")
+	}
+
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", adminID, err)
+	}
+
+	ownerUUID := adminUUID
+	if req.UserID != nil {
+		ownerUUID, err = uuid.Parse(*req.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user ID format %s: %w", *req.UserID, err)
+		}
+		owner, err := s.repo.User.FindByID(ctx, ownerUUID)
+		if err != nil || owner == nil || !owner.IsActive {
+			return nil, i18n.Errorf("booking.user_not_found", *req.UserID)
+		}
+	}
+
+	scheduleID, err := uuid.Parse(req.ScheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule ID format %s: %w", req.ScheduleID, err)
+	}
+
+	schedule, err := s.repo.Schedule.FindByID(ctx, scheduleID)
+	if err != nil || schedule == nil {
+		return nil, i18n.Errorf("booking.schedule_not_found", req.ScheduleID)
+	}
+	if !schedule.IsPublished() {
+		return nil, i18n.Errorf("booking.schedule_unpublished", req.ScheduleID)
+	}
+	if salesClosed(schedule, s.settings.salesCutoff(ctx), time.Now()) {
+		return nil, ErrSalesClosed
+	}
+
+	hall, err := s.repo.Hall.FindByID(ctx, schedule.HallID)
+	if err != nil || hall == nil {
+		return nil, i18n.Errorf("booking.hall_not_found")
+	}
+
+	cinema, err := s.repo.Cinema.FindByID(ctx, hall.CinemaID)
+	if err != nil || cinema == nil {
+		return nil, i18n.Errorf("booking.cinema_not_found")
+	}
+	if orgID := adminOrganization(ctx); orgID != nil && (cinema.OrganizationID == nil || *cinema.OrganizationID != *orgID) {
+		return nil, i18n.Errorf("booking.schedule_not_found", req.ScheduleID)
+	}
+
+	hallSeats, err := s.repo.Seat.FindByHallID(ctx, hall.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load hall seats: %w", err)
+	}
+	seatsByID := make(map[uuid.UUID]*entity.Seat, len(hallSeats))
+	for _, seat := range hallSeats {
+		seatsByID[seat.ID] = seat
+	}
+
+	// Kursi yang diminta; seat rusak (is_available=false) tidak pernah dijual
+	var selected []*entity.Seat
+	if req.WholeHall {
+		for _, seat := range hallSeats {
+			if seat.IsAvailable {
+				selected = append(selected, seat)
+			}
+		}
+		if len(selected) == 0 {
+			return nil, i18n.Errorf("booking.whole_hall_empty")
+		}
+	} else {
+		seen := make(map[uuid.UUID]bool, len(req.SeatIDs))
+		for _, seatIDStr := range req.SeatIDs {
+			seatID, err := uuid.Parse(seatIDStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid seat ID format %s: %w", seatIDStr, err)
+			}
+			if seen[seatID] {
+				return nil, newSeatSelectionError(SeatRuleDuplicateSeat, "booking.seat_duplicate", seatIDStr)
+			}
+			seen[seatID] = true
+
+			seat, ok := seatsByID[seatID]
+			if !ok {
+				return nil, i18n.Errorf("booking.seat_wrong_hall", seatIDStr)
+			}
+			if !seat.IsAvailable {
+				return nil, newSeatSelectionError(SeatRuleUnavailable, "booking.seat_unavailable", seat.SeatNumber)
+			}
+			selected = append(selected, seat)
+		}
+	}
+
+	seatPrice := s.pricing.seatPrice(schedule, hall)
+	if req.PricePerSeat != nil {
+		seatPrice = utils.CurrencyOf(schedule.Currency).ToMinor(*req.PricePerSeat)
+	}
+
+	orderID, err := s.newOrderID(ctx, cinema)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	booking := &entity.Booking{
+		Base: entity.Base{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		OrderID:    orderID,
+		UserID:     ownerUUID,
+		ScheduleID: scheduleID,
+		TotalSeats: len(selected),
+		Status:     entity.BookingStatusConfirmed,
+		BasePrice:  seatPrice * int64(len(selected)),
+		Currency:   schedule.Currency,
+
+		// Group booking ditagih manual, tier pemiliknya tidak memberi potongan
+		CustomerTier: entity.TierStandard,
+	}
+	s.pricing.applyGroupCharges(booking, cinema)
+
+	group := &entity.GroupBooking{
+		BookingID:      booking.ID,
+		GroupName:      strings.TrimSpace(req.GroupName),
+		Notes:          req.Notes,
+		CreatedBy:      adminUUID,
+		BlockRemaining: req.BlockRemaining,
+		CreatedAt:      now,
+	}
+
+	var (
+		tickets      []response.TicketResponse
+		blockedSeats []string
+	)
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		// Lock yang sama dengan CreateBooking supaya tidak bentrok dengan booking user
+		if err := tx.Schedule.LockByID(ctx, scheduleID); err != nil {
+			return err
+		}
+
+		bookedSeats, err := tx.BookingSeat.FindBookedSeatsBySchedule(ctx, scheduleID)
+		if err != nil {
+			return fmt.Errorf("check seat availability: %w", err)
+		}
+		taken := make(map[uuid.UUID]bool, len(bookedSeats))
+		for _, seatID := range bookedSeats {
+			taken[seatID] = true
+		}
+
+		// Hold waitlist tetap dihormati, termasuk untuk admin
+		holds, err := tx.SeatHold.FindActiveBySchedule(ctx, scheduleID, now)
+		if err != nil {
+			return fmt.Errorf("check seat holds: %w", err)
+		}
+		for _, hold := range holds {
+			taken[hold.SeatID] = true
+		}
+
+		// Kursi yang diblokir untuk schedule ini tidak ikut whole hall dan tidak boleh dipilih manual
+		blocks, err := tx.SeatBlock.FindBySchedule(ctx, scheduleID)
+		if err != nil {
+			return fmt.Errorf("check seat blocks: %w", err)
+		}
+		blocked := make(map[uuid.UUID]bool, len(blocks))
+		for _, block := range blocks {
+			blocked[block.SeatID] = true
+		}
+		if len(blocked) > 0 {
+			available := selected[:0]
+			for _, seat := range selected {
+				if !blocked[seat.ID] {
+					available = append(available, seat)
+					continue
+				}
+				if !req.WholeHall {
+					return newSeatSelectionError(SeatRuleBlocked, "booking.seat_blocked", seat.SeatNumber)
+				}
+			}
+			if len(available) == 0 {
+				return i18n.Errorf("booking.whole_hall_empty")
+			}
+			if len(available) != len(selected) {
+				selected = available
+				booking.TotalSeats = len(selected)
+				booking.BasePrice = seatPrice * int64(len(selected))
+				s.pricing.applyGroupCharges(booking, cinema)
+			}
+		}
+
+		conflicts := 0
+		for _, seat := range selected {
+			if !taken[seat.ID] {
+				continue
+			}
+			if !req.WholeHall {
+				return i18n.Errorf("booking.seat_already_booked", seat.SeatNumber)
+			}
+			conflicts++
+		}
+		if conflicts > 0 {
+			return i18n.Errorf("booking.whole_hall_conflict", conflicts)
+		}
+
+		bookingSeats := make([]*entity.BookingSeat, 0, len(hallSeats))
+		selectedIDs := make(map[uuid.UUID]bool, len(selected))
+		for _, seat := range selected {
+			selectedIDs[seat.ID] = true
+			ticketCode := fmt.Sprintf("%s-%s", booking.OrderID, seat.SeatNumber)
+			bookingSeats = append(bookingSeats, &entity.BookingSeat{
+				BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+				BookingID:  booking.ID,
+				SeatID:     seat.ID,
+				ScheduleID: booking.ScheduleID,
+				TicketCode: &ticketCode,
+			})
+			tickets = append(tickets, response.TicketResponse{SeatNumber: seat.SeatNumber, TicketCode: ticketCode})
+		}
+
+		if req.BlockRemaining {
+			for _, seat := range hallSeats {
+				if selectedIDs[seat.ID] || taken[seat.ID] || blocked[seat.ID] || !seat.IsAvailable {
+					continue
+				}
+				bookingSeats = append(bookingSeats, &entity.BookingSeat{
+					BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+					BookingID:  booking.ID,
+					SeatID:     seat.ID,
+					ScheduleID: booking.ScheduleID,
+					IsBlocked:  true,
+				})
+				blockedSeats = append(blockedSeats, seat.SeatNumber)
+			}
+		}
+
+		if err := tx.Booking.Create(ctx, booking); err != nil {
+			return fmt.Errorf("create booking: %w", err)
+		}
+		if err := createBookingSeats(ctx, tx, booking.ScheduleID, bookingSeats); err != nil {
+			return err
+		}
+		if err := tx.GroupBooking.Create(ctx, group); err != nil {
+			return err
+		}
+
+		seatIDs := make([]string, len(selected))
+		for i, seat := range selected {
+			seatIDs[i] = seat.ID.String()
+		}
+
+		created := events.BookingCreated{
+			BookingID:  booking.ID.String(),
+			OrderID:    booking.OrderID,
+			UserID:     booking.UserID.String(),
+			ScheduleID: booking.ScheduleID.String(),
+			SeatIDs:    seatIDs,
+			TotalSeats: booking.TotalSeats,
+			TotalPrice: utils.CurrencyOf(booking.Currency).ToMajor(booking.TotalPrice),
+			Currency:   booking.Currency,
+			CreatedAt:  booking.CreatedAt,
+		}
+		if err := enqueueEvent(ctx, tx, events.AggregateBooking, booking.ID, events.TypeBookingCreated, created); err != nil {
+			return err
+		}
+
+		// Group booking langsung confirmed tanpa payment
+		return enqueueBookingConfirmed(ctx, tx, booking, booking.CreatedAt)
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create group booking",
+			zap.Error(err),
+			zap.String("admin_id", adminID),
+			zap.String("schedule_id", req.ScheduleID),
+		)
+		return nil, err
+	}
+	s.seats.invalidate(booking.ScheduleID)
+
+	utils.LoggerFromContext(ctx, s.log).Info("Group booking created",
+		zap.String("booking_id", booking.ID.String()),
+		zap.String("order_id", booking.OrderID),
+		zap.String("group_name", group.GroupName),
+		zap.String("admin_id", adminID),
+		zap.Int("seat_count", len(tickets)),
+		zap.Int("blocked_seats", len(blockedSeats)),
+	)
+
+	seatNumbers := make([]string, len(tickets))
+	for i, ticket := range tickets {
+		seatNumbers[i] = ticket.SeatNumber
+	}
+	if blockedSeats == nil {
+		blockedSeats = []string{}
+	}
+
+	return &response.GroupBookingResponse{
+		BookingResponse: *s.buildBookingResponse(ctx, booking, seatNumbers),
+		GroupName:       group.GroupName,
+		Notes:           group.Notes,
+		CreatedBy:       adminID,
+		Tickets:         tickets,
+		BlockedSeats:    blockedSeats,
+	}, nil
+}
+
+// ==================== BACKGROUND JOBS ====================
+
+// SendShowReminders notifies users whose confirmed show starts within the lead window
+func (s *bookingService) SendShowReminders(ctx context.Context, lead time.Duration) (int, error) {
+	now := time.Now()
+	bookings, err := s.repo.Booking.FindPendingReminders(ctx, now, now.Add(lead))
+	if err != nil {
+		return 0, fmt.Errorf("find bookings pending reminder: %w", err)
+	}
+
+	sent := 0
+	for _, booking := range bookings {
+		details := s.buildBookingResponse(ctx, booking, nil)
+
+		compose := func(lang i18n.Lang) notification.Message {
+			return notification.Message{
+				Subject: i18n.T(lang, "email.reminder.subject", details.MovieTitle),
+				Body: i18n.T(lang, "email.reminder.body",
+					details.MovieTitle, details.CinemaName, details.HallNumber, details.ShowDate, details.ShowTime, booking.OrderID),
+				Data: map[string]string{
+					"booking_id": booking.ID.String(),
+					"order_id":   booking.OrderID,
+				},
+			}
+		}
+
+		if err := s.notifier.Notify(ctx, booking.UserID, entity.NotificationCategoryReminder, compose); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to send show reminder",
+				zap.Error(err),
+				zap.String("booking_id", booking.ID.String()),
+			)
+			continue
+		}
+
+		if err := s.repo.Booking.MarkReminderSent(ctx, booking.ID); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to mark reminder sent",
+				zap.Error(err),
+				zap.String("booking_id", booking.ID.String()),
+			)
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Show reminders sent", zap.Int("count", sent))
+	}
+
+	return sent, nil
+}
+
+// ExpirePendingPayments expires payment VA / QRIS yang lewat batas bayar dan melepas kursinya
+func (s *bookingService) ExpirePendingPayments(ctx context.Context) (int, error) {
+	schedules := make(map[uuid.UUID]bool)
+	var releasedBookings []*entity.Booking
+	expired := 0
+
+	err := s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		payments, err := tx.Payment.FindExpiredPendingForUpdate(ctx, time.Now(), paymentExpiryBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, payment := range payments {
+			booking, err := tx.Booking.FindByIDForUpdate(ctx, payment.BookingID)
+			if err != nil {
+				return err
+			}
+
+			released, err := expirePayment(ctx, tx, payment, booking)
+			if err != nil {
+				return err
+			}
+			if released {
+				if err := s.jobs.enqueue(ctx, tx, jobPaymentExpiredNotice, bookingJob{BookingID: booking.ID}); err != nil {
+					return err
+				}
+				schedules[booking.ScheduleID] = true
+				releasedBookings = append(releasedBookings, booking)
+			}
+			expired++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("expire pending payments: %w", err)
+	}
+
+	for scheduleID := range schedules {
+		s.offerFreedSeats(ctx, scheduleID)
+	}
+
+	if expired > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Pending payments expired",
+			zap.Int("count", expired),
+			zap.Int("bookings_released", len(releasedBookings)),
+		)
+	}
+
+	return expired, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+// createBookingSeats menyimpan kursi booking dan menaikkan counter booked_seats schedule. Penolakan
+// unique index kursi aktif disamakan dengan hasil cek kursi di service, jadi request yang kalah
+// balapan tetap dapat "seat already booked", bukan error database. Panggil di dalam WithTx.
+func createBookingSeats(ctx context.Context, tx *repository.Repository, scheduleID uuid.UUID, bookingSeats []*entity.BookingSeat) error {
+	if err := tx.BookingSeat.CreateBatch(ctx, bookingSeats); err != nil {
+		var seatErr *repository.SeatAlreadyBookedError
+		if errors.As(err, &seatErr) {
+			return i18n.Errorf("booking.seat_already_booked", seatErr.SeatID.String())
+		}
+		return fmt.Errorf("create booking seats: %w", err)
+	}
+
+	// Jaring terakhir kalau seat map hall lebih besar dari kapasitasnya
+	err := tx.Schedule.AdjustBookedSeats(ctx, scheduleID, len(bookingSeats))
+	if errors.Is(err, repository.ErrScheduleFull) {
+		return i18n.Errorf("booking.capacity_exceeded", len(bookingSeats))
+	}
+	return err
+}
+
+// salesClosed reports whether penjualan schedule sudah ditutup pada waktu now.
+// Dibandingkan terhadap StartsAt (UTC), jadi timezone server tidak berpengaruh.
+func salesClosed(schedule *entity.Schedule, cutoff time.Duration, now time.Time) bool {
+	return !now.Before(schedule.StartsAt.Add(cutoff))
+}
+
+// expirePayment marks payment expired; booking yang masih pending ikut expired supaya kursinya lepas.
+// Returns true kalau booking di-expire. PaymentExpired event di-enqueue di tx yang sama.
+func expirePayment(ctx context.Context, tx *repository.Repository, payment *entity.Payment, booking *entity.Booking) (bool, error) {
+	now := time.Now()
+	if err := transitionPayment(ctx, tx, payment, entity.PaymentStatusExpired, "payment expired"); err != nil {
+		return false, err
+	}
+	if err := refundGiftBalance(ctx, tx, payment, now); err != nil {
+		return false, err
+	}
+
+	// Booking bisa sudah dibatalkan admin atau di-soft delete; cukup payment-nya yang expired
+	if booking == nil {
+		return false, nil
+	}
+
+	released := booking.Status == entity.BookingStatusPending
+	if released {
+		if err := transitionBooking(ctx, tx, booking, entity.BookingStatusExpired, "payment expired"); err != nil {
+			return false, err
+		}
+	}
+
+	err := enqueueEvent(ctx, tx, events.AggregatePayment, payment.ID, events.TypePaymentExpired, events.PaymentExpired{
+		PaymentID:       payment.ID.String(),
+		BookingID:       booking.ID.String(),
+		OrderID:         booking.OrderID,
+		UserID:          booking.UserID.String(),
+		PaymentMethodID: payment.PaymentMethodID.String(),
+		Amount:          utils.CurrencyOf(payment.Currency).ToMajor(payment.Amount),
+		Currency:        payment.Currency,
+		BookingReleased: released,
+		ExpiredAt:       now,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return released, nil
+}
+
+// enqueuePaymentCompleted writes payment.completed dan booking.confirmed, dipanggil di tx yang sama dengan konfirmasi booking
+func enqueuePaymentCompleted(ctx context.Context, tx *repository.Repository, booking *entity.Booking, payment *entity.Payment) error {
+	paidAt := payment.UpdatedAt
+	if payment.PaidAt != nil {
+		paidAt = *payment.PaidAt
+	}
+
+	if err := enqueueBookingConfirmed(ctx, tx, booking, paidAt); err != nil {
+		return err
+	}
+	if err := recordFunnel(ctx, tx, entity.FunnelStepPayment, booking.ScheduleID, &booking.UserID, &booking.ID); err != nil {
+		return err
+	}
+
+	return enqueueEvent(ctx, tx, events.AggregatePayment, payment.ID, events.TypePaymentCompleted, events.PaymentCompleted{
+		PaymentID:       payment.ID.String(),
+		BookingID:       booking.ID.String(),
+		OrderID:         booking.OrderID,
+		UserID:          booking.UserID.String(),
+		PaymentMethodID: payment.PaymentMethodID.String(),
+		Amount:          utils.CurrencyOf(payment.Currency).ToMajor(payment.Amount),
+		Currency:        payment.Currency,
+		TransactionID:   payment.TransactionID,
+		PaidAt:          paidAt,
+		GiftCardAmount:  utils.CurrencyOf(payment.Currency).ToMajor(payment.GiftCardAmount),
+	})
+}
+
+func enqueueBookingConfirmed(ctx context.Context, tx *repository.Repository, booking *entity.Booking, confirmedAt time.Time) error {
+	return enqueueEvent(ctx, tx, events.AggregateBooking, booking.ID, events.TypeBookingConfirmed, events.BookingConfirmed{
+		BookingID:   booking.ID.String(),
+		OrderID:     booking.OrderID,
+		UserID:      booking.UserID.String(),
+		ScheduleID:  booking.ScheduleID.String(),
+		TotalSeats:  booking.TotalSeats,
+		TotalPrice:  utils.CurrencyOf(booking.Currency).ToMajor(booking.TotalPrice),
+		Currency:    booking.Currency,
+		ConfirmedAt: confirmedAt,
+	})
+}
+
+// issuePaymentCode simulates the gateway: nomor VA atau payload QRIS dinamis.
+// Di production kode ini berasal dari response create-charge payment gateway.
+func issuePaymentCode(method *entity.PaymentMethod, booking *entity.Booking, payment *entity.Payment) string {
+	if method.Type == entity.PaymentMethodTypeQRIS {
+		return fmt.Sprintf("QRIS.SIM|%s|%s|%d", booking.OrderID, payment.Currency, payment.Amount)
+	}
+
+	return vaNumberPrefix + utils.GenerateOTP(12)
+}
+
+// offerFreedSeats menawarkan kursi yang baru lepas ke waitlist; gagal cukup di-log
+func (s *bookingService) offerFreedSeats(ctx context.Context, scheduleID uuid.UUID) {
+	if err := s.waitlist.OfferFreedSeats(ctx, scheduleID); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to offer freed seats to waitlist",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID.String()),
+		)
+	}
+}
+
+// buildBookingDetail loads seats, schedule details and payment untuk satu booking
+func (s *bookingService) buildBookingDetail(ctx context.Context, booking *entity.Booking) *response.BookingDetailResponse {
+	item, scheduleDetails := s.hydrateBooking(ctx, booking)
+
+	return &response.BookingDetailResponse{
+		BookingResponse: item,
+		ScheduleDetails: scheduleDetails,
+	}
+}
+
+// buildReceipt loads payment, user, seats dan schedule lalu render PDF receipt.
+// Hanya booking dengan payment completed yang punya receipt.
+func (s *bookingService) buildReceipt(ctx context.Context, booking *entity.Booking) ([]byte, error) {
+	payment, err := s.repo.Payment.FindByBookingID(ctx, booking.ID)
+	if err != nil {
+		return nil, fmt.Errorf("find payment for receipt: %w", err)
+	}
+	if payment == nil || payment.Status != entity.PaymentStatusCompleted {
+		return nil, i18n.Errorf("booking.receipt_unpaid", booking.OrderID)
+	}
+
+	paymentMethod, err := s.repo.PaymentMethod.FindByID(ctx, payment.PaymentMethodID)
+	if err != nil {
+		return nil, fmt.Errorf("find payment method for receipt: %w", err)
+	}
+	if paymentMethod == nil {
+		return nil, i18n.Errorf("booking.payment_method_not_found", payment.PaymentMethodID.String())
+	}
+
+	data := receiptData{
+		booking:       booking,
+		payment:       payment,
+		paymentMethod: paymentMethod,
+	}
+
+	// Sisanya best-effort, sama seperti hydrateBooking
+	var g errgroup.Group
+	g.Go(func() error {
+		data.user, _ = s.repo.User.FindByID(ctx, booking.UserID)
+		return nil
+	})
+	g.Go(func() error {
+		data.seatNumbers, _ = s.repo.BookingSeat.FindSeatNumbersByBookingID(ctx, booking.ID)
+		return nil
+	})
+	g.Go(func() error {
+		data.schedule = s.loadScheduleDetails(ctx, booking.ScheduleID)
+		return nil
+	})
+	_ = g.Wait()
+
+	return renderReceipt(data)
+}
+
+// sendBookingConfirmation mengirim email konfirmasi beserta receipt PDF, handler job jobBookingConfirmation
+func (s *bookingService) sendBookingConfirmation(ctx context.Context, job *entity.Job) error {
+	booking, err := s.loadJobBooking(ctx, job)
+	if err != nil || booking == nil {
+		return err
+	}
+	// Booking yang keburu dibatalkan / di-refund sebelum job jalan tidak perlu konfirmasi lagi
+	if booking.Status != entity.BookingStatusConfirmed && booking.Status != entity.BookingStatusCheckedIn {
+		return nil
+	}
+
+	// Receipt gagal dibuat tidak boleh menahan konfirmasi; user masih bisa download dari API
+	var attachments []notification.Attachment
+	receipt, err := s.buildReceipt(ctx, booking)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to build receipt for confirmation email",
+			zap.Error(err),
+			zap.String("booking_id", booking.ID.String()),
+		)
+	} else {
+		attachments = []notification.Attachment{{
+			Filename:    receiptFilename(booking.OrderID),
+			ContentType: receiptContentType,
+			Content:     receipt,
+		}}
+	}
+
+	compose := func(lang i18n.Lang) notification.Message {
+		return notification.Message{
+			Subject: i18n.T(lang, "email.booking_confirmed.subject", booking.OrderID),
+			Body: i18n.T(lang, "email.booking_confirmed.body",
+				booking.OrderID, booking.TotalSeats, utils.CurrencyOf(booking.Currency).Format(booking.TotalPrice)),
+			Data: map[string]string{
+				"booking_id": booking.ID.String(),
+				"order_id":   booking.OrderID,
+			},
+			Attachments: attachments,
+		}
+	}
+
+	return s.notifier.Notify(ctx, booking.UserID, entity.NotificationCategoryBookingConfirmation, compose)
+}
+
+// sendPaymentExpiredNotice memberi tahu user bahwa batas bayar lewat dan kursinya sudah dilepas
+func (s *bookingService) sendPaymentExpiredNotice(ctx context.Context, job *entity.Job) error {
+	booking, err := s.loadJobBooking(ctx, job)
+	if err != nil || booking == nil {
+		return err
+	}
+
+	compose := func(lang i18n.Lang) notification.Message {
+		return notification.Message{
+			Subject: i18n.T(lang, "email.payment_expired.subject", booking.OrderID),
+			Body:    i18n.T(lang, "email.payment_expired.body", booking.OrderID, booking.TotalSeats),
+			Data: map[string]string{
+				"booking_id": booking.ID.String(),
+				"order_id":   booking.OrderID,
+			},
+		}
+	}
+
+	return s.notifier.Notify(ctx, booking.UserID, entity.NotificationCategoryBookingConfirmation, compose)
+}
+
+// loadJobBooking reads booking dari payload bookingJob; nil kalau booking sudah dihapus
+func (s *bookingService) loadJobBooking(ctx context.Context, job *entity.Job) (*entity.Booking, error) {
+	payload, err := decodeJobPayload[bookingJob](job)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := s.repo.Booking.FindByID(ctx, payload.BookingID)
+	if err != nil {
+		return nil, fmt.Errorf("find booking %s for %s job: %w", payload.BookingID.String(), job.Type, err)
+	}
+	return booking, nil
+}
+
+func (s *bookingService) buildBookingResponse(ctx context.Context, booking *entity.Booking, seatNumbers []string) *response.BookingResponse {
+	details := s.loadScheduleDetails(ctx, booking.ScheduleID)
+
+	return &response.BookingResponse{
+		ID:          booking.ID.String(),
+		OrderID:     booking.OrderID,
+		UserID:      booking.UserID.String(),
+		ScheduleID:  booking.ScheduleID.String(),
+		MovieTitle:  details.MovieTitle,
+		CinemaName:  details.CinemaName,
+		HallNumber:  details.HallNumber,
+		ShowDate:    details.ShowDate,
+		ShowTime:    details.ShowTime,
+		TotalSeats:  booking.TotalSeats,
+		TotalPrice:  utils.CurrencyOf(booking.Currency).ToMajor(booking.TotalPrice),
+		Status:      booking.Status,
+		SeatNumbers: seatNumbers,
+		CreatedAt:   booking.CreatedAt,
+
+		Currency:            booking.Currency,
+		TotalPriceFormatted: utils.CurrencyOf(booking.Currency).Format(booking.TotalPrice),
+		PriceBreakdown:      priceBreakdown(booking),
+	}
+}
+
+// buildBookingListItem builds a list row with seats, schedule details, and payment
+func (s *bookingService) buildBookingListItem(ctx context.Context, booking *entity.Booking) response.BookingResponse {
+	item, _ := s.hydrateBooking(ctx, booking)
+	return item
+}
+
+// hydrateBooking menjalankan lookup seats, schedule dan payment secara paralel
+// karena ketiganya saling independen
+func (s *bookingService) hydrateBooking(ctx context.Context, booking *entity.Booking) (response.BookingResponse, response.ScheduleDetails) {
+	var (
+		seatNumbers []string
+		details     response.ScheduleDetails
+		paymentResp *response.PaymentResponse
+	)
+
+	// Lookup best-effort: error diabaikan seperti sebelumnya, field dibiarkan kosong
+	var g errgroup.Group
+	g.Go(func() error {
+		seatNumbers, _ = s.repo.BookingSeat.FindSeatNumbersByBookingID(ctx, booking.ID)
+		return nil
+	})
+	g.Go(func() error {
+		details = s.loadScheduleDetails(ctx, booking.ScheduleID)
+		return nil
+	})
+	g.Go(func() error {
+		paymentResp = s.loadPayment(ctx, booking.ID)
+		return nil
+	})
+	_ = g.Wait()
+
+	if seatNumbers == nil {
+		seatNumbers = []string{}
+	}
+
+	item := response.BookingResponse{
+		ID:          booking.ID.String(),
 		OrderID:     booking.OrderID,
 		UserID:      booking.UserID.String(),
 		ScheduleID:  booking.ScheduleID.String(),
-		MovieTitle:  movieTitle,
-		CinemaName:  cinemaName,
-		HallNumber:  hallNumber,
-		ShowDate:    showDate,
-		ShowTime:    showTime,
+		MovieTitle:  details.MovieTitle,
+		CinemaName:  details.CinemaName,
+		HallNumber:  details.HallNumber,
+		ShowDate:    details.ShowDate,
+		ShowTime:    details.ShowTime,
 		TotalSeats:  booking.TotalSeats,
-		TotalPrice:  booking.TotalPrice,
+		TotalPrice:  utils.CurrencyOf(booking.Currency).ToMajor(booking.TotalPrice),
 		Status:      booking.Status,
 		SeatNumbers: seatNumbers,
+		Payment:     paymentResp,
 		CreatedAt:   booking.CreatedAt,
+
+		Currency:            booking.Currency,
+		TotalPriceFormatted: utils.CurrencyOf(booking.Currency).Format(booking.TotalPrice),
+		PriceBreakdown:      priceBreakdown(booking),
+	}
+	response.SetBookingExpiry(&item)
+
+	return item, details
+}
+
+// buildBookingList hydrate banyak booking sekaligus, dibatasi hydrationConcurrency
+// supaya satu halaman besar tidak menghabiskan connection pool
+func (s *bookingService) buildBookingList(ctx context.Context, bookings []*entity.Booking) []response.BookingResponse {
+	items := make([]response.BookingResponse, len(bookings))
+
+	var g errgroup.Group
+	g.SetLimit(hydrationConcurrency)
+	for i, booking := range bookings {
+		g.Go(func() error {
+			items[i] = s.buildBookingListItem(ctx, booking)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return items
+}
+
+// loadScheduleDetails mengambil schedule, lalu movie dan hall->cinema secara paralel
+func (s *bookingService) loadScheduleDetails(ctx context.Context, scheduleID uuid.UUID) response.ScheduleDetails {
+	var details response.ScheduleDetails
+
+	schedule, _ := s.repo.Schedule.FindByID(ctx, scheduleID)
+	if schedule == nil {
+		return details
+	}
+
+	var (
+		movie  *entity.Movie
+		hall   *entity.Hall
+		cinema *entity.Cinema
+	)
+
+	var g errgroup.Group
+	g.Go(func() error {
+		movie, _ = s.repo.Movie.FindByID(ctx, schedule.MovieID)
+		return nil
+	})
+	g.Go(func() error {
+		hall, _ = s.repo.Hall.FindByID(ctx, schedule.HallID)
+		if hall != nil {
+			cinema, _ = s.repo.Cinema.FindByID(ctx, hall.CinemaID)
+		}
+		return nil
+	})
+	_ = g.Wait()
+
+	if movie != nil {
+		details.MovieTitle = movie.Title
+	}
+	if hall != nil {
+		details.HallNumber = hall.HallNumber
+		details.HallType = string(hall.HallType)
+	}
+	if cinema != nil {
+		details.CinemaName = cinema.Name
+	}
+
+	details.ShowDate = schedule.ShowDate.Format("2006-01-02")
+	details.ShowTime = schedule.ShowTime.Format("15:04")
+
+	currency := utils.CurrencyOf(schedule.Currency)
+	price := s.pricing.seatPrice(schedule, hall)
+	details.Price = currency.ToMajor(price)
+	details.Currency = currency.Code
+	details.PriceFormatted = currency.Format(price)
+
+	return details
+}
+
+// priceBreakdown wraps BookingPriceBreakdown untuk field pointer di response
+func priceBreakdown(booking *entity.Booking) *response.PriceBreakdown {
+	breakdown := response.BookingPriceBreakdown(booking)
+	return &breakdown
+}
+
+// requireBookingScope: chain admin hanya boleh melihat booking untuk schedule di cinema miliknya
+func (s *bookingService) requireBookingScope(ctx context.Context, booking *entity.Booking) error {
+	owned, err := scheduleInScope(ctx, s.repo, booking.ScheduleID)
+	if err != nil {
+		return fmt.Errorf("check booking scope: %w", err)
+	}
+	if !owned {
+		return i18n.Errorf("booking.not_found", booking.ID.String())
+	}
+	return nil
+}
+
+// findActivePaymentMethod parses and loads payment method yang boleh dipakai
+func (s *bookingService) findActivePaymentMethod(ctx context.Context, paymentMethodID string) (*entity.PaymentMethod, error) {
+	id, err := uuid.Parse(paymentMethodID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment method ID format %s: %w", paymentMethodID, err)
+	}
+
+	paymentMethod, err := s.repo.PaymentMethod.FindByID(ctx, id)
+	if err != nil || paymentMethod == nil {
+		return nil, i18n.Errorf("booking.payment_method_not_found", paymentMethodID)
+	}
+
+	if !paymentMethod.IsActive {
+		return nil, i18n.Errorf("booking.payment_method_inactive", paymentMethod.Name)
+	}
+
+	return paymentMethod, nil
+}
+
+// newOrderID generates order ID dengan prefix chain pemilik cinema, fallback ke prefix default
+func (s *bookingService) newOrderID(ctx context.Context, cinema *entity.Cinema) (string, error) {
+	prefix := s.orderPrefix
+	if cinema.OrganizationID != nil {
+		organization, err := s.repo.Organization.FindByID(ctx, *cinema.OrganizationID)
+		if err != nil {
+			return "", fmt.Errorf("find cinema organization: %w", err)
+		}
+		if organization != nil && organization.OrderPrefix != nil {
+			prefix = *organization.OrderPrefix
+		}
+	}
+
+	return utils.GenerateOrderID(prefix, time.Now()), nil
+}
+
+// findScheduleCinema resolves schedule -> hall -> cinema untuk tax rate
+func (s *bookingService) findScheduleCinema(ctx context.Context, scheduleID uuid.UUID) (*entity.Cinema, error) {
+	schedule, err := s.repo.Schedule.FindByID(ctx, scheduleID)
+	if err != nil || schedule == nil {
+		return nil, i18n.Errorf("booking.schedule_not_found", scheduleID.String())
+	}
+
+	hall, err := s.repo.Hall.FindByID(ctx, schedule.HallID)
+	if err != nil || hall == nil {
+		return nil, i18n.Errorf("booking.hall_not_found")
+	}
+
+	cinema, err := s.repo.Cinema.FindByID(ctx, hall.CinemaID)
+	if err != nil || cinema == nil {
+		return nil, i18n.Errorf("booking.cinema_not_found")
+	}
+
+	return cinema, nil
+}
+
+// customerTier returns tier user untuk harga booking; user yang tidak ditemukan dianggap standard
+func (s *bookingService) customerTier(ctx context.Context, userID uuid.UUID) (entity.UserTier, error) {
+	user, err := s.repo.User.FindByID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("find customer tier: %w", err)
+	}
+	if user == nil {
+		return entity.TierStandard, nil
+	}
+	return user.Tier, nil
+}
+
+// loadPayment returns payment booking beserta method-nya, nil kalau belum ada
+func (s *bookingService) loadPayment(ctx context.Context, bookingID uuid.UUID) *response.PaymentResponse {
+	payment, _ := s.repo.Payment.FindByBookingID(ctx, bookingID)
+	if payment == nil {
+		return nil
+	}
+
+	paymentMethod, _ := s.repo.PaymentMethod.FindByID(ctx, payment.PaymentMethodID)
+	if paymentMethod == nil {
+		return nil
+	}
+
+	paymentResp := response.PaymentToResponse(payment, paymentMethod)
+	return &paymentResp
+}
+
+// parseBookingFilter validates query filters dan convert ke repository filter
+func (s *bookingService) parseBookingFilter(filter *request.BookingHistoryFilter) (repository.BookingFilter, error) {
+	var result repository.BookingFilter
+	if filter == nil {
+		return result, nil
+	}
+
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return result, errs
 	}
+
+	if filter.Status != "" {
+		status := entity.BookingStatus(filter.Status)
+		result.Status = &status
+	}
+
+	if filter.When != "" {
+		upcoming := filter.When == "upcoming"
+		result.Upcoming = &upcoming
+	}
+
+	if filter.StartDate != "" {
+		startDate, err := time.Parse("2006-01-02", filter.StartDate)
+		if err != nil {
+			return result, fmt.Errorf("invalid start_date: %w", err)
+		}
+		result.ShowDateFrom = &startDate
+	}
+
+	if filter.EndDate != "" {
+		endDate, err := time.Parse("2006-01-02", filter.EndDate)
+		if err != nil {
+			return result, fmt.Errorf("invalid end_date: %w", err)
+		}
+		result.ShowDateTo = &endDate
+	}
+
+	if result.ShowDateFrom != nil && result.ShowDateTo != nil && result.ShowDateTo.Before(*result.ShowDateFrom) {
+		return result, i18n.Errorf("booking.date_range")
+	}
+
+	return result, nil
+}
+
+// getUserBookingsByCursor is the keyset-pagination path of GetUserBookings
+func (s *bookingService) getUserBookingsByCursor(ctx context.Context, userID uuid.UUID, filter repository.BookingFilter, req *request.PaginatedRequest) (*response.PaginatedResponse[response.BookingResponse], error) {
+	cursor, err := decodeCursor(*req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit()
+	// Fetch one extra row to know whether there is a next page
+	bookings, err := s.repo.Booking.FindByUserIDAfter(ctx, userID, filter, cursor, limit+1)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get user bookings by cursor",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("get user bookings: %w", err)
+	}
+
+	return s.toCursorPage(ctx, bookings, limit), nil
+}
+
+// toCursorPage trims the lookahead row and encodes the next cursor
+func (s *bookingService) toCursorPage(ctx context.Context, bookings []*entity.Booking, limit int) *response.PaginatedResponse[response.BookingResponse] {
+	nextCursor := ""
+	if len(bookings) > limit {
+		bookings = bookings[:limit]
+		last := bookings[len(bookings)-1]
+		nextCursor = utils.EncodeCursor(last.CreatedAt, last.ID)
+	}
+
+	bookingResponses := s.buildBookingList(ctx, bookings)
+
+	return response.NewCursorPaginatedResponse(bookingResponses, limit, nextCursor)
 }
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// TransitionBooking moves booking ke checked_in atau refunded atas permintaan admin.
+// Refund booking yang sudah dibayar ikut membalik penjualannya di ledger (idempotent kalau
+// sudah dibalik saat cancel) dan menandai payment-nya refunded.
+func (s *bookingService) TransitionBooking(ctx context.Context, bookingID string, req *request.TransitionBookingRequest) (*response.BookingDetailResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	booking, err := s.findScopedBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	to := entity.BookingStatus(req.Status)
+	from := booking.Status
+	if !from.CanTransitionTo(to) {
+		return nil, i18n.Errorf("booking.transition_invalid", from, to)
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := transitionBooking(ctx, tx, booking, to, req.Reason); err != nil {
+			return err
+		}
+		if to != entity.BookingStatusRefunded {
+			return nil
+		}
+
+		payment, err := tx.Payment.FindByBookingID(ctx, booking.ID)
+		if err != nil {
+			return err
+		}
+		if payment == nil || payment.Status != entity.PaymentStatusCompleted {
+			return nil
+		}
+		if err := reconcilePaidCharges(ctx, tx, booking, payment, s.log); err != nil {
+			return err
+		}
+		if err := postBookingRefund(ctx, tx, booking, payment, time.Now()); err != nil {
+			return err
+		}
+		return transitionPayment(ctx, tx, payment, entity.PaymentStatusRefunded, req.Reason)
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to transition booking",
+			zap.Error(err),
+			zap.String("booking_id", bookingID),
+			zap.String("to_status", string(to)),
+		)
+		return nil, fmt.Errorf("transition booking %s: %w", bookingID, err)
+	}
+
+	// Booking cancelled sudah melepas kursinya waktu dibatalkan
+	if to == entity.BookingStatusRefunded && from == entity.BookingStatusConfirmed {
+		s.offerFreedSeats(ctx, booking.ScheduleID)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Booking status changed",
+		zap.String("booking_id", bookingID),
+		zap.String("from_status", string(from)),
+		zap.String("to_status", string(to)),
+	)
+
+	return s.buildAdminBookingDetail(ctx, booking)
+}
+
+// transitionBooking adalah satu-satunya jalur yang mengubah status booking: cek transisi sah,
+// compare-and-swap lewat booking.Version, lalu catat riwayatnya. Panggil di dalam WithTx.
+// Actor diambil dari user di ctx, jadi webhook gateway dan background job tercatat tanpa actor.
+func transitionBooking(ctx context.Context, tx *repository.Repository, booking *entity.Booking, to entity.BookingStatus, reason string) error {
+	from := booking.Status
+	if !from.CanTransitionTo(to) {
+		return i18n.Errorf("booking.transition_invalid", from, to)
+	}
+
+	if err := tx.Booking.UpdateStatus(ctx, booking, to); err != nil {
+		return err
+	}
+	// Kursi keluar dari unique index dan counter schedule begitu booking tidak lagi memegangnya, di tx yang sama
+	if from.HoldsSeats() && !to.HoldsSeats() {
+		released, err := tx.BookingSeat.ReleaseByBookingID(ctx, booking.ID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Schedule.AdjustBookedSeats(ctx, booking.ScheduleID, -released); err != nil {
+			return err
+		}
+	}
+
+	change := &entity.BookingStatusChange{
+		BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: time.Now()},
+		BookingID:  booking.ID,
+		FromStatus: from,
+		ToStatus:   to,
+	}
+	if actorID, ok := utils.GetUserIDFromContext(ctx); ok {
+		change.ActorID = &actorID
+	}
+	if reason != "" {
+		change.Reason = &reason
+	}
+	if err := tx.BookingHistory.Create(ctx, change); err != nil {
+		return err
+	}
+
+	booking.Status = to
+	booking.UpdatedAt = change.CreatedAt
+	return nil
+}
+
+func (s *bookingService) loadStatusHistory(ctx context.Context, bookingID uuid.UUID) ([]response.BookingStatusChangeResponse, error) {
+	history, err := s.repo.BookingHistory.FindByBookingID(ctx, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("find booking status history: %w", err)
+	}
+
+	result := make([]response.BookingStatusChangeResponse, len(history))
+	for i, change := range history {
+		result[i] = response.BookingStatusChangeToResponse(change)
+	}
+	return result, nil
+}
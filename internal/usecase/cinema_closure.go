@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/events"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/notification"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// closureCancellation satu booking yang dibatalkan karena cinema tutup, untuk notifikasi
+type closureCancellation struct {
+	booking    *entity.Booking
+	movieTitle string
+	refunded   bool
+}
+
+// CloseCinema menandai cinema tutup mulai closed_from. Schedule di periode tutup dibatalkan (jadi
+// hilang dari semua listing publik dan tidak bisa dibooking), booking aktifnya dibatalkan dan yang
+// sudah dibayar di-refund. Semuanya satu tx bersama audit log; customer diberi tahu setelah commit.
+func (s *cinemaService) CloseCinema(ctx context.Context, adminID, cinemaID string, req *request.CloseCinemaRequest) (*response.CloseCinemaResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Close cinema validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	actorID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin ID format %s: %w", adminID, err)
+	}
+	id, err := uuid.Parse(cinemaID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cinema ID format %s: %w", cinemaID, err)
+	}
+
+	// Format tanggal sudah divalidasi tag datetime
+	closedFrom, _ := time.Parse("2006-01-02", req.ClosedFrom)
+	var closedUntil *time.Time
+	closureType := entity.CinemaClosureType(req.Type)
+	switch closureType {
+	case entity.CinemaClosureTemporary:
+		if req.ClosedUntil == "" {
+			return nil, fmt.Errorf("invalid closure: closed_until is required for a temporary closure")
+		}
+		until, _ := time.Parse("2006-01-02", req.ClosedUntil)
+		if !until.After(closedFrom) {
+			return nil, fmt.Errorf("invalid closure: closed_until must be after closed_from")
+		}
+		closedUntil = &until
+	case entity.CinemaClosurePermanent:
+		if req.ClosedUntil != "" {
+			return nil, fmt.Errorf("invalid closure: closed_until is not allowed for a permanent closure")
+		}
+	}
+
+	if err := requireCinemaScope(ctx, s.repo, id); err != nil {
+		return nil, err
+	}
+
+	cinema, err := s.repo.Cinema.FindByID(ctx, id)
+	if err != nil || cinema == nil {
+		return nil, fmt.Errorf("cinema %s not found", cinemaID)
+	}
+
+	now := time.Now()
+	cinema.ClosureType = &closureType
+	cinema.ClosedFrom = &closedFrom
+	cinema.ClosedUntil = closedUntil
+	cinema.ClosureReason = &req.Reason
+	cinema.UpdatedAt = now
+
+	result := &response.CloseCinemaResponse{}
+	var cancelled []*entity.Schedule
+	var affected []closureCancellation
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.Cinema.SetClosure(ctx, cinema); err != nil {
+			return err
+		}
+
+		var err error
+		cancelled, err = tx.Schedule.CancelByCinema(ctx, cinema.ID, closedFrom, closedUntil, now)
+		if err != nil {
+			return err
+		}
+
+		affected, err = s.cancelClosureBookings(ctx, tx, cancelled, req.Reason, now)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actorID, entity.AuditActionCinemaClose, entity.AuditTargetCinema, &cinema.ID,
+			map[string]any{
+				"type":                closureType,
+				"closed_from":         req.ClosedFrom,
+				"closed_until":        req.ClosedUntil,
+				"reason":              req.Reason,
+				"schedules_cancelled": len(cancelled),
+				"bookings_affected":   len(affected),
+			}, "")
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to close cinema",
+			zap.Error(err),
+			zap.String("cinema_id", cinemaID),
+		)
+		return nil, fmt.Errorf("close cinema %s: %w", cinemaID, err)
+	}
+
+	for _, schedule := range cancelled {
+		s.seats.invalidate(schedule.ID)
+	}
+	for _, item := range affected {
+		if item.refunded {
+			result.BookingsRefunded++
+		} else {
+			result.BookingsCancelled++
+		}
+	}
+	result.SchedulesCancelled = len(cancelled)
+	result.Cinema = response.CinemaToResponse(cinema)
+
+	go s.sendClosureNotices(cinema, affected)
+
+	utils.LoggerFromContext(ctx, s.log).Info("Cinema closed",
+		zap.String("cinema_id", cinemaID),
+		zap.String("admin_id", adminID),
+		zap.String("type", req.Type),
+		zap.String("closed_from", req.ClosedFrom),
+		zap.Int("schedules_cancelled", result.SchedulesCancelled),
+		zap.Int("bookings_cancelled", result.BookingsCancelled),
+		zap.Int("bookings_refunded", result.BookingsRefunded),
+	)
+
+	return result, nil
+}
+
+// cancelClosureBookings membatalkan booking aktif di schedule yang dibatalkan. Booking yang sudah
+// dibayar jadi refunded (penjualan dibalik di ledger, payment ikut refunded); sisanya cancelled.
+func (s *cinemaService) cancelClosureBookings(ctx context.Context, tx *repository.Repository, schedules []*entity.Schedule, reason string, now time.Time) ([]closureCancellation, error) {
+	var affected []closureCancellation
+	titles := make(map[uuid.UUID]string)
+
+	for _, schedule := range schedules {
+		bookings, err := tx.Booking.FindByScheduleID(ctx, schedule.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, booking := range bookings {
+			previousStatus := booking.Status
+			if previousStatus != entity.BookingStatusPending && previousStatus != entity.BookingStatusConfirmed {
+				continue
+			}
+
+			var payment *entity.Payment
+			if previousStatus == entity.BookingStatusConfirmed {
+				payment, err = tx.Payment.FindByBookingID(ctx, booking.ID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			paid := payment != nil && payment.Status == entity.PaymentStatusCompleted
+
+			to := entity.BookingStatusCancelled
+			if paid {
+				to = entity.BookingStatusRefunded
+			}
+			if err := transitionBooking(ctx, tx, booking, to, reason); err != nil {
+				return nil, err
+			}
+			if paid {
+				if err := reconcilePaidCharges(ctx, tx, booking, payment, s.log); err != nil {
+					return nil, err
+				}
+				if err := postBookingRefund(ctx, tx, booking, payment, now); err != nil {
+					return nil, err
+				}
+				if err := transitionPayment(ctx, tx, payment, entity.PaymentStatusRefunded, reason); err != nil {
+					return nil, err
+				}
+			}
+
+			err = enqueueEvent(ctx, tx, events.AggregateBooking, booking.ID, events.TypeBookingCancelled, events.BookingCancelled{
+				BookingID:      booking.ID.String(),
+				OrderID:        booking.OrderID,
+				UserID:         booking.UserID.String(),
+				PreviousStatus: string(previousStatus),
+				CancelledAt:    now,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			title, ok := titles[schedule.MovieID]
+			if !ok {
+				if movie, _ := tx.Movie.FindByID(ctx, schedule.MovieID); movie != nil {
+					title = movie.Title
+				}
+				titles[schedule.MovieID] = title
+			}
+			affected = append(affected, closureCancellation{booking: booking, movieTitle: title, refunded: paid})
+		}
+	}
+
+	return affected, nil
+}
+
+// sendClosureNotices memberi tahu setiap customer terdampak; gagal kirim hanya di-log
+func (s *cinemaService) sendClosureNotices(cinema *entity.Cinema, affected []closureCancellation) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	closedFrom := cinema.ClosedFrom.Format("2006-01-02")
+	for _, item := range affected {
+		booking := item.booking
+		compose := func(lang i18n.Lang) notification.Message {
+			body := i18n.T(lang, "email.cinema_closed.body", cinema.Name, closedFrom, booking.OrderID)
+			if item.refunded {
+				body = i18n.T(lang, "email.cinema_closed.refund", cinema.Name, closedFrom, booking.OrderID,
+					utils.CurrencyOf(booking.Currency).Format(booking.TotalPrice))
+			}
+			return notification.Message{
+				Subject: i18n.T(lang, "email.cinema_closed.subject", item.movieTitle, cinema.Name),
+				Body:    body,
+				Data: map[string]string{
+					"booking_id": booking.ID.String(),
+					"order_id":   booking.OrderID,
+					"cinema_id":  cinema.ID.String(),
+				},
+			}
+		}
+
+		if err := s.notifier.Notify(ctx, booking.UserID, entity.NotificationCategoryBookingConfirmation, compose); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to send cinema closure notice",
+				zap.Error(err),
+				zap.String("booking_id", booking.ID.String()),
+			)
+		}
+	}
+}
@@ -15,50 +15,90 @@ import (
 	"go.uber.org/zap"
 )
 
+// nearbyCinemaLimit batas hasil /api/cinemas/nearby
+const nearbyCinemaLimit = 50
+
+// capacityCheckLimit batas schedule bermasalah per laporan; lebih dari ini berarti ada bug sistemik
+const capacityCheckLimit = 200
+
+// cinemaDayScheduleLimit batas jadwal yang di-embed di cinema detail; satu cinema jarang lebih dari ini per hari
+const cinemaDayScheduleLimit = 500
+
 type CinemaService interface {
-	GetCinemas(ctx context.Context, req *request.PaginatedRequest, cityFilter *string) (*response.PaginatedResponse[response.CinemaResponse], error)
-	GetCinemaByID(ctx context.Context, cinemaID string) (*response.CinemaDetailResponse, error)
+	GetCinemas(ctx context.Context, req *request.PaginatedRequest, filter *request.CinemaListFilter) (*response.PaginatedResponse[response.CinemaResponse], error)
+	// date kosong berarti tanpa showtimes; "today" memakai tanggal lokal cinema
+	GetCinemaByID(ctx context.Context, cinemaID, date string) (*response.CinemaDetailResponse, error)
 	GetSeatAvailability(ctx context.Context, cinemaID, dateStr, timeStr string) ([]*response.SeatAvailabilityResponse, error)
+	GetCities(ctx context.Context) ([]response.CityResponse, error)
+	GetNearbyCinemas(ctx context.Context, req *request.NearbyCinemasRequest) ([]response.NearbyCinemaResponse, error)
 
 	CreateCinema(ctx context.Context, req *request.CinemaRequest) (*response.CinemaResponse, error)
 	UpdateCinema(ctx context.Context, cinemaID string, req *request.CinemaUpdateRequest) (*response.CinemaResponse, error)
 	DeleteCinema(ctx context.Context, cinemaID string) error
+	RestoreCinema(ctx context.Context, cinemaID string) error
+	// CloseCinema menutup cinema sementara / permanen dan membatalkan schedule serta booking terdampak
+	CloseCinema(ctx context.Context, adminID, cinemaID string, req *request.CloseCinemaRequest) (*response.CloseCinemaResponse, error)
+
+	// BlockHallSeats menandai kursi rusak / maintenance tidak tersedia untuk semua schedule di hall
+	BlockHallSeats(ctx context.Context, hallID string, req *request.SeatIDsRequest) ([]response.SeatResponse, error)
+	UnblockHallSeats(ctx context.Context, hallID string, req *request.SeatIDsRequest) ([]response.SeatResponse, error)
+
+	// CheckCapacity mencari hall yang seat map-nya tidak cocok dengan total_seats dan schedule mendatang
+	// yang counter kursinya drift; pelanggaran dilaporkan di response dan di-log level error
+	CheckCapacity(ctx context.Context) (*response.CapacityCheckResponse, error)
 }
 
 type cinemaService struct {
-	repo *repository.Repository // grouping semua cinema-related repos
-	log  *zap.Logger
+	repo     *repository.Repository // grouping semua cinema-related repos
+	seats    *seatAvailability
+	notifier NotificationService
+	pricing  pricingRules
+	log      *zap.Logger
 }
 
-func NewCinemaService(repo *repository.Repository, log *zap.Logger) CinemaService {
+func NewCinemaService(repo *repository.Repository, seats *seatAvailability, notifier NotificationService, pricing utils.PricingConfig, log *zap.Logger) CinemaService {
 	return &cinemaService{
-		repo: repo,
-		log:  log.With(zap.String("service", "cinema")),
+		repo:     repo,
+		seats:    seats,
+		notifier: notifier,
+		pricing:  newPricingRules(pricing),
+		log:      log.With(zap.String("service", "cinema")),
 	}
 }
 
-func (s *cinemaService) GetCinemas(ctx context.Context, req *request.PaginatedRequest, cityFilter *string) (*response.PaginatedResponse[response.CinemaResponse], error) {
+func (s *cinemaService) GetCinemas(ctx context.Context, req *request.PaginatedRequest, filter *request.CinemaListFilter) (*response.PaginatedResponse[response.CinemaResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
 	limit := req.Limit()
 	offset := req.Offset()
+	repoFilter := repository.CinemaFilter{
+		City:       filter.City,
+		Facilities: toCinemaFacilities(filter.Facilities),
+		// Listing admin chain hanya berisi cinema miliknya; endpoint publik tidak punya scope
+		OrganizationID: adminOrganization(ctx),
+	}
 
 	// Get cinemas from repository
-	cinemas, err := s.repo.Cinema.FindAll(ctx, limit, offset, cityFilter)
+	cinemas, err := s.repo.Cinema.FindAll(ctx, limit, offset, repoFilter, req.IncludeDeleted)
 	if err != nil {
-		s.log.Error("Failed to get cinemas from repository",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get cinemas from repository",
 			zap.Error(err),
 			zap.Int("page", req.Page),
 			zap.Int("per_page", req.PerPage),
-			zap.Stringp("city_filter", cityFilter),
+			zap.Stringp("city_filter", filter.City),
+			zap.Strings("facilities", filter.Facilities),
 		)
 		return nil, fmt.Errorf("get cinemas: %w", err)
 	}
 
 	// Get total count
-	total, err := s.repo.Cinema.CountAll(ctx, cityFilter)
+	total, err := s.repo.Cinema.CountAll(ctx, repoFilter, req.IncludeDeleted)
 	if err != nil {
-		s.log.Error("Failed to count cinemas",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count cinemas",
 			zap.Error(err),
-			zap.Stringp("city_filter", cityFilter),
+			zap.Stringp("city_filter", filter.City),
 		)
 		return nil, fmt.Errorf("count cinemas: %w", err)
 	}
@@ -69,21 +109,28 @@ func (s *cinemaService) GetCinemas(ctx context.Context, req *request.PaginatedRe
 		cinemaResponses[i] = response.CinemaToResponse(cinema)
 	}
 
-	s.log.Info("Cinemas retrieved",
+	utils.LoggerFromContext(ctx, s.log).Info("Cinemas retrieved",
 		zap.Int("count", len(cinemas)),
 		zap.Int64("total", total),
 		zap.Int("page", req.Page),
 		zap.Int("per_page", req.PerPage),
 	)
 
-	return response.NewPaginatedResponse(cinemaResponses, req.Page, req.PerPage, total), nil
+	result := response.NewPaginatedResponse(cinemaResponses, req.Page, req.PerPage, total)
+	for _, cinema := range cinemas {
+		if cinema.UpdatedAt.After(result.LastModified) {
+			result.LastModified = cinema.UpdatedAt
+		}
+	}
+
+	return result, nil
 }
 
-func (s *cinemaService) GetCinemaByID(ctx context.Context, cinemaID string) (*response.CinemaDetailResponse, error) {
+func (s *cinemaService) GetCinemaByID(ctx context.Context, cinemaID, date string) (*response.CinemaDetailResponse, error) {
 	// Parse cinema ID
 	id, err := uuid.Parse(cinemaID)
 	if err != nil {
-		s.log.Warn("Invalid cinema ID format",
+		utils.LoggerFromContext(ctx, s.log).Warn("Invalid cinema ID format",
 			zap.String("cinema_id", cinemaID),
 			zap.Error(err),
 		)
@@ -93,7 +140,7 @@ func (s *cinemaService) GetCinemaByID(ctx context.Context, cinemaID string) (*re
 	// Get cinema
 	cinema, err := s.repo.Cinema.FindByID(ctx, id)
 	if err != nil {
-		s.log.Error("Failed to get cinema by ID",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get cinema by ID",
 			zap.Error(err),
 			zap.String("cinema_id", cinemaID),
 		)
@@ -107,7 +154,7 @@ func (s *cinemaService) GetCinemaByID(ctx context.Context, cinemaID string) (*re
 	// Get halls for this cinema
 	halls, err := s.repo.Hall.FindByCinemaID(ctx, cinema.ID)
 	if err != nil {
-		s.log.Warn("Failed to get halls for cinema",
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to get halls for cinema",
 			zap.Error(err),
 			zap.String("cinema_id", cinemaID),
 		)
@@ -120,16 +167,108 @@ func (s *cinemaService) GetCinemaByID(ctx context.Context, cinemaID string) (*re
 		hallResponses[i] = response.HallToResponse(hall)
 	}
 
-	s.log.Info("Cinema retrieved",
+	utils.LoggerFromContext(ctx, s.log).Info("Cinema retrieved",
 		zap.String("cinema_id", cinemaID),
 		zap.String("name", cinema.Name),
 		zap.Int("hall_count", len(halls)),
 	)
 
-	return &response.CinemaDetailResponse{
+	detail := &response.CinemaDetailResponse{
 		CinemaResponse: response.CinemaToResponse(cinema),
+		OpeningHours:   cinema.OpeningHours,
+		TaxRate:        cinema.TaxRate,
 		Halls:          hallResponses,
-	}, nil
+	}
+
+	if date != "" {
+		showDate, err := cinemaShowDate(cinema, date)
+		if err != nil {
+			return nil, err
+		}
+
+		showtimes, err := s.cinemaShowtimes(ctx, cinema.ID, showDate)
+		if err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to get showtimes for cinema",
+				zap.Error(err),
+				zap.String("cinema_id", cinemaID),
+				zap.String("date", date),
+			)
+			return nil, fmt.Errorf("get cinema showtimes: %w", err)
+		}
+		detail.ShowDate = showDate.Format("2006-01-02")
+		detail.Showtimes = showtimes
+	}
+
+	return detail, nil
+}
+
+// cinemaShowDate parses ?date=; "today" dihitung di timezone cinema, bukan timezone server
+func cinemaShowDate(cinema *entity.Cinema, date string) (time.Time, error) {
+	if date == "today" {
+		now := time.Now().In(cinema.TimeLocation())
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+
+	showDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format %s: %w", date, err)
+	}
+	return showDate, nil
+}
+
+// cinemaShowtimes groups jadwal published yang belum mulai per movie. Urutan movie mengikuti
+// show paling awal hari itu, jadwal di dalamnya urut starts_at.
+func (s *cinemaService) cinemaShowtimes(ctx context.Context, cinemaID uuid.UUID, showDate time.Time) ([]response.MovieShowtimes, error) {
+	published := entity.ScheduleStatusPublished
+	schedules, err := s.repo.Schedule.FindAll(ctx, repository.ScheduleFilter{
+		StartsFrom: time.Now(),
+		CinemaID:   &cinemaID,
+		ShowDate:   &showDate,
+		Status:     &published,
+	}, cinemaDayScheduleLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("find schedules: %w", err)
+	}
+
+	scheduleResps, err := buildScheduleResponses(ctx, s.repo, s.pricing, schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	movies := make(map[uuid.UUID]*entity.Movie)
+	groups := make(map[uuid.UUID]int)
+	showtimes := make([]response.MovieShowtimes, 0)
+	for i, schedule := range schedules {
+		movie, ok := movies[schedule.MovieID]
+		if !ok {
+			movie, err = s.repo.Movie.FindByID(ctx, schedule.MovieID)
+			if err != nil {
+				return nil, fmt.Errorf("find movie: %w", err)
+			}
+			movies[schedule.MovieID] = movie
+		}
+
+		// Movie yang sudah dihapus tidak ditampilkan walaupun jadwalnya masih ada
+		if movie == nil {
+			continue
+		}
+
+		idx, ok := groups[movie.ID]
+		if !ok {
+			idx = len(showtimes)
+			groups[movie.ID] = idx
+			showtimes = append(showtimes, response.MovieShowtimes{
+				MovieID:           movie.ID.String(),
+				Title:             movie.Title,
+				PosterURL:         movie.PosterURL,
+				Rating:            movie.Rating,
+				DurationInMinutes: movie.DurationInMinutes,
+			})
+		}
+		showtimes[idx].Schedules = append(showtimes[idx].Schedules, scheduleResps[i])
+	}
+
+	return showtimes, nil
 }
 
 func (s *cinemaService) GetSeatAvailability(ctx context.Context, cinemaID, dateStr, timeStr string) ([]*response.SeatAvailabilityResponse, error) {
@@ -160,7 +299,7 @@ func (s *cinemaService) GetSeatAvailability(ctx context.Context, cinemaID, dateS
 	// Get halls for this cinema
 	halls, err := s.repo.Hall.FindByCinemaID(ctx, cinema.ID)
 	if err != nil {
-		s.log.Error("Failed to get halls for seat availability",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get halls for seat availability",
 			zap.Error(err),
 			zap.String("cinema_id", cinemaID),
 		)
@@ -177,7 +316,7 @@ func (s *cinemaService) GetSeatAvailability(ctx context.Context, cinemaID, dateS
 		// Cari schedule untuk hall, date, dan time tertentu
 		schedules, err := s.repo.Schedule.FindByDateAndHall(ctx, hall.ID, date)
 		if err != nil {
-			s.log.Warn("Failed to get schedules for hall",
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to get schedules for hall",
 				zap.Error(err),
 				zap.String("hall_id", hall.ID.String()),
 			)
@@ -196,7 +335,7 @@ func (s *cinemaService) GetSeatAvailability(ctx context.Context, cinemaID, dateS
 
 		// Jika tidak ada schedule di waktu tersebut, return semua seat unavailable
 		if targetSchedule == nil {
-			s.log.Warn("No schedule found for hall at specified time",
+			utils.LoggerFromContext(ctx, s.log).Warn("No schedule found for hall at specified time",
 				zap.String("hall_id", hall.ID.String()),
 				zap.String("date", dateStr),
 				zap.String("time", timeStr),
@@ -205,20 +344,29 @@ func (s *cinemaService) GetSeatAvailability(ctx context.Context, cinemaID, dateS
 			continue
 		}
 
+		// Awal funnel checkout; gagal mencatat tidak boleh menggagalkan seat map
+		var viewerID *uuid.UUID
+		if userID, ok := utils.GetUserIDFromContext(ctx); ok {
+			viewerID = &userID
+		}
+		if err := recordFunnel(ctx, s.repo, entity.FunnelStepSeatView, targetSchedule.ID, viewerID, nil); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to record seat view", zap.Error(err), zap.String("schedule_id", targetSchedule.ID.String()))
+		}
+
 		// Get all seats for this hall
 		seats, err := s.repo.Seat.FindByHallID(ctx, hall.ID)
 		if err != nil {
-			s.log.Warn("Failed to get seats for hall",
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to get seats for hall",
 				zap.Error(err),
 				zap.String("hall_id", hall.ID.String()),
 			)
 			continue
 		}
 
-		// Get booked seats untuk schedule ini
-		bookedSeats, err := s.repo.BookingSeat.FindBookedSeatsBySchedule(ctx, targetSchedule.ID)
+		// Booked, hold waitlist dan blokir per schedule dari cache pendek, di-invalidate setiap ada perubahan
+		taken, err := s.seats.taken(ctx, targetSchedule.ID)
 		if err != nil {
-			s.log.Warn("Failed to get booked seats for schedule",
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to get taken seats for schedule",
 				zap.Error(err),
 				zap.String("schedule_id", targetSchedule.ID.String()),
 			)
@@ -230,32 +378,26 @@ func (s *cinemaService) GetSeatAvailability(ctx context.Context, cinemaID, dateS
 		for i, seat := range seats {
 			seatResp := response.SeatToResponse(seat)
 
-			// Check if seat is booked
-			isBooked := false
-			for _, bookedSeatID := range bookedSeats {
-				if seat.ID == bookedSeatID {
-					isBooked = true
-					break
-				}
-			}
-
-			// Update availability status
-			seatResp.IsAvailable = !isBooked
+			// Update availability status; seat yang diblokir di level hall tetap tidak tersedia
+			status, isTaken := taken[seat.ID]
+			seatResp.IsAvailable = seat.IsAvailable && !isTaken
+			seatResp.Blocked = !seat.IsAvailable || status == seatStatusBlocked
 			seatResponses[i] = seatResp
 		}
 
 		// Create response for this hall
 		result := &response.SeatAvailabilityResponse{
-			HallID: hall.ID.String(),
-			Date:   date.Format("2006-01-02"),
-			Time:   showTime.Format("15:04"),
-			Seats:  seatResponses,
+			HallID:   hall.ID.String(),
+			HallType: string(hall.HallType),
+			Date:     date.Format("2006-01-02"),
+			Time:     showTime.Format("15:04"),
+			Seats:    seatResponses,
 		}
 
 		results = append(results, result)
 	}
 
-	s.log.Info("Seat availability checked",
+	utils.LoggerFromContext(ctx, s.log).Info("Seat availability checked",
 		zap.String("cinema_id", cinemaID),
 		zap.String("date", dateStr),
 		zap.String("time", timeStr),
@@ -266,11 +408,58 @@ func (s *cinemaService) GetSeatAvailability(ctx context.Context, cinemaID, dateS
 	return results, nil
 }
 
+func (s *cinemaService) GetCities(ctx context.Context) ([]response.CityResponse, error) {
+	cities, err := s.repo.Cinema.FindCities(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get cities", zap.Error(err))
+		return nil, fmt.Errorf("get cities: %w", err)
+	}
+
+	cityResponses := make([]response.CityResponse, len(cities))
+	for i, city := range cities {
+		cityResponses[i] = response.CityResponse{
+			City:        city.City,
+			CinemaCount: city.CinemaCount,
+		}
+	}
+
+	return cityResponses, nil
+}
+
+func (s *cinemaService) GetNearbyCinemas(ctx context.Context, req *request.NearbyCinemasRequest) ([]response.NearbyCinemaResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	cinemas, err := s.repo.Cinema.FindNearby(ctx, req.Latitude, req.Longitude, req.RadiusKm, nearbyCinemaLimit)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get nearby cinemas",
+			zap.Error(err),
+			zap.Float64("lat", req.Latitude),
+			zap.Float64("lng", req.Longitude),
+			zap.Float64("radius_km", req.RadiusKm),
+		)
+		return nil, fmt.Errorf("get nearby cinemas: %w", err)
+	}
+
+	cinemaResponses := make([]response.NearbyCinemaResponse, len(cinemas))
+	for i, cinema := range cinemas {
+		cinemaResponses[i] = response.NearbyCinemaToResponse(cinema)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Nearby cinemas retrieved",
+		zap.Int("count", len(cinemas)),
+		zap.Float64("radius_km", req.RadiusKm),
+	)
+
+	return cinemaResponses, nil
+}
+
 func (s *cinemaService) CreateCinema(ctx context.Context, req *request.CinemaRequest) (*response.CinemaResponse, error) {
 	// Validate request
 	if errs := utils.ValidateStruct(req); len(errs) > 0 {
-		s.log.Warn("Create cinema validation failed", zap.Any("errors", errs))
-		return nil, fmt.Errorf("validation failed: %s", utils.FormatValidationErrors(errs))
+		utils.LoggerFromContext(ctx, s.log).Warn("Create cinema validation failed", zap.Any("errors", errs))
+		return nil, errs
 	}
 
 	// Create cinema entity
@@ -281,21 +470,32 @@ func (s *cinemaService) CreateCinema(ctx context.Context, req *request.CinemaReq
 			CreatedAt: now,
 			UpdatedAt: now,
 		},
-		Name:     req.Name,
-		Location: req.Location,
-		City:     req.City,
+		Name:         req.Name,
+		Location:     req.Location,
+		City:         req.City,
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		Facilities:   toCinemaFacilities(req.Facilities),
+		OpeningHours: toOpeningHours(req.OpeningHours),
+		TaxRate:      req.TaxRate,
+		Timezone:     req.Timezone,
+		// Cinema buatan admin chain otomatis milik chain-nya
+		OrganizationID: adminOrganization(ctx),
+	}
+	if cinema.Timezone == "" {
+		cinema.Timezone = entity.DefaultCinemaTimezone
 	}
 
 	// Save cinema
 	if err := s.repo.Cinema.Create(ctx, cinema); err != nil {
-		s.log.Error("Failed to create cinema",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create cinema",
 			zap.Error(err),
 			zap.String("name", req.Name),
 		)
 		return nil, fmt.Errorf("create cinema: %w", err)
 	}
 
-	s.log.Info("Cinema created",
+	utils.LoggerFromContext(ctx, s.log).Info("Cinema created",
 		zap.String("cinema_id", cinema.ID.String()),
 		zap.String("name", cinema.Name),
 		zap.String("city", cinema.City),
@@ -306,12 +506,21 @@ func (s *cinemaService) CreateCinema(ctx context.Context, req *request.CinemaReq
 }
 
 func (s *cinemaService) UpdateCinema(ctx context.Context, cinemaID string, req *request.CinemaUpdateRequest) (*response.CinemaResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Update cinema validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
 	// Parse cinema ID
 	id, err := uuid.Parse(cinemaID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid cinema ID format %s: %w", cinemaID, err)
 	}
 
+	if err := requireCinemaScope(ctx, s.repo, id); err != nil {
+		return nil, err
+	}
+
 	// Get existing cinema
 	cinema, err := s.repo.Cinema.FindByID(ctx, id)
 	if err != nil || cinema == nil {
@@ -336,10 +545,58 @@ func (s *cinemaService) UpdateCinema(ctx context.Context, cinemaID string, req *
 		updated = true
 	}
 
+	// Koordinat selalu di-update berpasangan (dijaga validation required_with)
+	if req.Latitude != nil && req.Longitude != nil {
+		cinema.Latitude = req.Latitude
+		cinema.Longitude = req.Longitude
+		updated = true
+	}
+
+	if req.Facilities != nil {
+		cinema.Facilities = toCinemaFacilities(*req.Facilities)
+		updated = true
+	}
+
+	if req.OpeningHours != nil {
+		cinema.OpeningHours = toOpeningHours(*req.OpeningHours)
+		updated = true
+	}
+
+	if req.TaxRate != nil {
+		cinema.TaxRate = req.TaxRate
+		updated = true
+	}
+
+	timezoneChanged := req.Timezone != nil && *req.Timezone != cinema.Timezone
+	if timezoneChanged {
+		cinema.Timezone = *req.Timezone
+		updated = true
+	}
+
 	if updated {
 		cinema.UpdatedAt = time.Now()
-		if err := s.repo.Cinema.Update(ctx, cinema); err != nil {
-			s.log.Error("Failed to update cinema",
+		err := s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+			if err := tx.Cinema.Update(ctx, cinema); err != nil {
+				return err
+			}
+			if !timezoneChanged {
+				return nil
+			}
+
+			// show_date/show_time tetap jam lokal; yang bergeser adalah waktu mulai absolutnya
+			recomputed, err := tx.Schedule.RecomputeStartsAt(ctx, cinema.ID)
+			if err != nil {
+				return err
+			}
+			utils.LoggerFromContext(ctx, s.log).Info("Schedule start times recomputed for new cinema timezone",
+				zap.String("cinema_id", cinemaID),
+				zap.String("timezone", cinema.Timezone),
+				zap.Int64("schedules", recomputed),
+			)
+			return nil
+		})
+		if err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to update cinema",
 				zap.Error(err),
 				zap.String("cinema_id", cinemaID),
 			)
@@ -347,7 +604,7 @@ func (s *cinemaService) UpdateCinema(ctx context.Context, cinemaID string, req *
 		}
 	}
 
-	s.log.Info("Cinema updated",
+	utils.LoggerFromContext(ctx, s.log).Info("Cinema updated",
 		zap.String("cinema_id", cinemaID),
 		zap.String("name", cinema.Name),
 		zap.Bool("was_updated", updated),
@@ -364,6 +621,10 @@ func (s *cinemaService) DeleteCinema(ctx context.Context, cinemaID string) error
 		return fmt.Errorf("invalid cinema ID format %s: %w", cinemaID, err)
 	}
 
+	if err := requireCinemaScope(ctx, s.repo, id); err != nil {
+		return err
+	}
+
 	// Get cinema first for logging
 	cinema, err := s.repo.Cinema.FindByID(ctx, id)
 	if err != nil {
@@ -375,17 +636,190 @@ func (s *cinemaService) DeleteCinema(ctx context.Context, cinemaID string) error
 
 	// Soft delete cinema
 	if err := s.repo.Cinema.Delete(ctx, id); err != nil {
-		s.log.Error("Failed to delete cinema",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to delete cinema",
 			zap.Error(err),
 			zap.String("cinema_id", cinemaID),
 		)
 		return fmt.Errorf("delete cinema %s: %w", cinemaID, err)
 	}
 
-	s.log.Info("Cinema deleted",
+	utils.LoggerFromContext(ctx, s.log).Info("Cinema deleted",
 		zap.String("cinema_id", cinemaID),
 		zap.String("name", cinema.Name),
 	)
 
 	return nil
 }
+
+func (s *cinemaService) RestoreCinema(ctx context.Context, cinemaID string) error {
+	id, err := uuid.Parse(cinemaID)
+	if err != nil {
+		return fmt.Errorf("invalid cinema ID format %s: %w", cinemaID, err)
+	}
+
+	if err := requireCinemaScope(ctx, s.repo, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.Cinema.Restore(ctx, id); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to restore cinema",
+			zap.Error(err),
+			zap.String("cinema_id", cinemaID),
+		)
+		return fmt.Errorf("restore cinema: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Cinema restored", zap.String("cinema_id", cinemaID))
+	return nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func toCinemaFacilities(facilities []string) []entity.CinemaFacility {
+	result := make([]entity.CinemaFacility, len(facilities))
+	for i, facility := range facilities {
+		result[i] = entity.CinemaFacility(facility)
+	}
+	return result
+}
+
+// toOpeningHours returns nil untuk map kosong supaya kolom disimpan NULL
+func toOpeningHours(hours map[string]request.DayHoursRequest) entity.OpeningHours {
+	if len(hours) == 0 {
+		return nil
+	}
+
+	result := make(entity.OpeningHours, len(hours))
+	for day, h := range hours {
+		result[day] = entity.DayHours{Open: h.Open, Close: h.Close}
+	}
+	return result
+}
+
+func (s *cinemaService) BlockHallSeats(ctx context.Context, hallID string, req *request.SeatIDsRequest) ([]response.SeatResponse, error) {
+	return s.setHallSeatsAvailability(ctx, hallID, req, false)
+}
+
+func (s *cinemaService) UnblockHallSeats(ctx context.Context, hallID string, req *request.SeatIDsRequest) ([]response.SeatResponse, error) {
+	return s.setHallSeatsAvailability(ctx, hallID, req, true)
+}
+
+// setHallSeatsAvailability flips seats.is_available lalu mengembalikan seat map hall terbaru.
+// Booking yang sudah ada untuk kursi tersebut tidak ikut dibatalkan.
+func (s *cinemaService) setHallSeatsAvailability(ctx context.Context, hallID string, req *request.SeatIDsRequest, available bool) ([]response.SeatResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	id, err := uuid.Parse(hallID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hall ID format %s: %w", hallID, err)
+	}
+
+	if err := requireHallScope(ctx, s.repo, id); err != nil {
+		return nil, err
+	}
+
+	hall, err := s.repo.Hall.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find hall: %w", err)
+	}
+	if hall == nil {
+		return nil, fmt.Errorf("hall %s not found", hallID)
+	}
+
+	seatIDs, err := hallSeatIDs(ctx, s.repo, hall.ID, req.SeatIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Seat.SetAvailability(ctx, hall.ID, seatIDs, available)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update hall seat availability",
+			zap.Error(err),
+			zap.String("hall_id", hallID),
+			zap.Bool("available", available),
+		)
+		return nil, fmt.Errorf("update seat availability: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Hall seat availability updated",
+		zap.String("hall_id", hallID),
+		zap.Bool("available", available),
+		zap.Int64("seat_count", updated),
+	)
+
+	seats, err := s.repo.Seat.FindByHallID(ctx, hall.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load hall seats: %w", err)
+	}
+
+	result := make([]response.SeatResponse, len(seats))
+	for i, seat := range seats {
+		result[i] = response.SeatToResponse(seat)
+	}
+	return result, nil
+}
+
+func (s *cinemaService) CheckCapacity(ctx context.Context) (*response.CapacityCheckResponse, error) {
+	now := time.Now()
+	result := &response.CapacityCheckResponse{
+		CheckedAt:  now,
+		Violations: []response.CapacityViolationResponse{},
+	}
+
+	halls, err := s.repo.Hall.FindCapacityMismatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, hall := range halls {
+		message := fmt.Sprintf("hall %d has %d seats in its seat map but total_seats is %d", hall.HallNumber, hall.SeatRows, hall.TotalSeats)
+		if hall.SeatRows == 0 {
+			message = fmt.Sprintf("hall %d has no seat map", hall.HallNumber)
+		}
+		result.Violations = append(result.Violations, response.CapacityViolationResponse{
+			Check:    response.CapacityCheckHallSeatMap,
+			HallID:   hall.HallID.String(),
+			Expected: hall.TotalSeats,
+			Actual:   hall.SeatRows,
+			Message:  message,
+		})
+	}
+
+	schedules, err := s.repo.Schedule.FindCounterMismatches(ctx, now, capacityCheckLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, schedule := range schedules {
+		scheduleID := schedule.ScheduleID.String()
+		message := fmt.Sprintf("booked_seats is %d but %d seats are held by bookings", schedule.BookedSeats, schedule.ActiveSeats)
+		if schedule.BookedSeats > schedule.Capacity {
+			message = fmt.Sprintf("booked_seats %d exceeds capacity %d", schedule.BookedSeats, schedule.Capacity)
+		}
+		result.Violations = append(result.Violations, response.CapacityViolationResponse{
+			Check:      response.CapacityCheckScheduleCounter,
+			HallID:     schedule.HallID.String(),
+			ScheduleID: &scheduleID,
+			Expected:   schedule.ActiveSeats,
+			Actual:     schedule.BookedSeats,
+			Message:    message,
+		})
+	}
+
+	result.OK = len(result.Violations) == 0
+	if result.OK {
+		utils.LoggerFromContext(ctx, s.log).Info("Hall capacity checks passed")
+	}
+	for _, violation := range result.Violations {
+		utils.LoggerFromContext(ctx, s.log).Error("Capacity check failed",
+			zap.String("check", violation.Check),
+			zap.String("hall_id", violation.HallID),
+			zap.Stringp("schedule_id", violation.ScheduleID),
+			zap.Int("expected", violation.Expected),
+			zap.Int("actual", violation.Actual),
+			zap.String("message", violation.Message),
+		)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// Nama task cleanup, dipakai sebagai label metric dan field log
+const (
+	cleanupExpiredSessions = "expired_sessions"
+	cleanupExpiredOTPs     = "expired_otps"
+	cleanupCompletedJobs   = "completed_jobs"
+)
+
+// CleanupTask satu jenis data kedaluwarsa yang dihapus berkala; Purge returns jumlah baris terhapus
+type CleanupTask struct {
+	Name     string
+	Interval time.Duration
+	Purge    func(ctx context.Context) (int64, error)
+}
+
+// CleanupStats hasil run task cleanup sejak proses start, untuk /metrics
+type CleanupStats struct {
+	Task          string
+	Runs          int64
+	Failures      int64
+	RowsPurged    int64
+	LastRunAt     time.Time
+	LastSuccessAt time.Time
+	LastDuration  time.Duration
+}
+
+// CleanupService menghapus data kedaluwarsa (session, OTP, job selesai) per batch.
+// Scheduler worker menjalankan Tasks; setiap run tercatat di Stats dan log.
+type CleanupService interface {
+	CleanExpiredSessions(ctx context.Context) (int64, error)
+	CleanExpiredOTPs(ctx context.Context) (int64, error)
+	CleanCompletedJobs(ctx context.Context) (int64, error)
+
+	// Tasks returns semua task cleanup dengan interval dari config; Purge sudah mencatat stats
+	Tasks() []CleanupTask
+	Stats() []CleanupStats
+}
+
+type cleanupService struct {
+	repo   *repository.Repository
+	config utils.CleanupConfig
+	log    *zap.Logger
+
+	mu    sync.Mutex
+	stats map[string]*CleanupStats
+}
+
+func NewCleanupService(repo *repository.Repository, config utils.CleanupConfig, log *zap.Logger) CleanupService {
+	return &cleanupService{
+		repo:   repo,
+		config: config,
+		log:    log.With(zap.String("service", "cleanup")),
+		stats:  make(map[string]*CleanupStats),
+	}
+}
+
+// CleanExpiredSessions menghapus session yang expired / di-revoke lebih lama dari retention;
+// session yang baru lewat masih terlihat di riwayat login user
+func (s *cleanupService) CleanExpiredSessions(ctx context.Context) (int64, error) {
+	before := time.Now().AddDate(0, 0, -s.config.SessionRetentionDays)
+	return s.purgeInBatches(ctx, func(ctx context.Context) (int64, error) {
+		return s.repo.Session.DeleteExpired(ctx, before, s.config.BatchSize)
+	})
+}
+
+func (s *cleanupService) CleanExpiredOTPs(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-time.Duration(s.config.OTPRetentionHours) * time.Hour)
+	return s.purgeInBatches(ctx, func(ctx context.Context) (int64, error) {
+		return s.repo.OTP.DeleteExpired(ctx, before, s.config.BatchSize)
+	})
+}
+
+// CleanCompletedJobs menghapus job completed; job dead disimpan sampai di-retry admin
+func (s *cleanupService) CleanCompletedJobs(ctx context.Context) (int64, error) {
+	before := time.Now().AddDate(0, 0, -s.config.JobRetentionDays)
+	return s.purgeInBatches(ctx, func(ctx context.Context) (int64, error) {
+		return s.repo.Job.DeleteCompletedBefore(ctx, before, s.config.BatchSize)
+	})
+}
+
+// purgeInBatches mengulang delete sampai batch terakhir tidak penuh, supaya backlog besar
+// (mis. pertama kali cleanup jalan) habis tanpa satu DELETE raksasa
+func (s *cleanupService) purgeInBatches(ctx context.Context, deleteBatch func(ctx context.Context) (int64, error)) (int64, error) {
+	var total int64
+	for ctx.Err() == nil {
+		deleted, err := deleteBatch(ctx)
+		total += deleted
+		if err != nil {
+			return total, err
+		}
+		if deleted < int64(s.config.BatchSize) {
+			break
+		}
+	}
+	return total, nil
+}
+
+func (s *cleanupService) Tasks() []CleanupTask {
+	tasks := []CleanupTask{
+		{
+			Name:     cleanupExpiredSessions,
+			Interval: time.Duration(s.config.SessionIntervalMinutes) * time.Minute,
+			Purge:    s.CleanExpiredSessions,
+		},
+		{
+			Name:     cleanupExpiredOTPs,
+			Interval: time.Duration(s.config.OTPIntervalMinutes) * time.Minute,
+			Purge:    s.CleanExpiredOTPs,
+		},
+		{
+			Name:     cleanupCompletedJobs,
+			Interval: time.Duration(s.config.JobIntervalMinutes) * time.Minute,
+			Purge:    s.CleanCompletedJobs,
+		},
+	}
+
+	for i := range tasks {
+		tasks[i].Purge = s.recorded(tasks[i].Name, tasks[i].Purge)
+	}
+	return tasks
+}
+
+// recorded membungkus purge supaya setiap run tercatat di stats dan log
+func (s *cleanupService) recorded(task string, purge func(ctx context.Context) (int64, error)) func(ctx context.Context) (int64, error) {
+	return func(ctx context.Context) (int64, error) {
+		start := time.Now()
+		purged, err := purge(ctx)
+		duration := time.Since(start)
+
+		s.record(task, start, duration, purged, err)
+
+		log := utils.LoggerFromContext(ctx, s.log).With(
+			zap.String("task", task),
+			zap.Int64("rows_purged", purged),
+			zap.Duration("duration", duration),
+		)
+		switch {
+		case err != nil:
+			log.Error("Cleanup task failed", zap.Error(err))
+			return purged, fmt.Errorf("cleanup %s: %w", task, err)
+		case purged > 0:
+			log.Info("Cleanup task purged rows")
+		default:
+			log.Debug("Cleanup task found nothing to purge")
+		}
+		return purged, nil
+	}
+}
+
+func (s *cleanupService) record(task string, start time.Time, duration time.Duration, purged int64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.stats[task]
+	if !ok {
+		stats = &CleanupStats{Task: task}
+		s.stats[task] = stats
+	}
+	stats.Runs++
+	stats.RowsPurged += purged
+	stats.LastRunAt = start
+	stats.LastDuration = duration
+	if err != nil {
+		stats.Failures++
+	} else {
+		stats.LastSuccessAt = start
+	}
+}
+
+// Stats returns salinan stats semua task, urut sesuai Tasks; task yang belum pernah jalan bernilai nol
+func (s *cleanupService) Stats() []CleanupStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := []string{cleanupExpiredSessions, cleanupExpiredOTPs, cleanupCompletedJobs}
+	result := make([]CleanupStats, len(names))
+	for i, name := range names {
+		result[i] = CleanupStats{Task: name}
+		if stats, ok := s.stats[name]; ok {
+			result[i] = *stats
+		}
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// CustomerTierService menghitung ulang tier customer (silver / gold / platinum) dari belanja bersih
+// di ledger selama window terakhir. Refund ikut mengurangi belanja, jadi tier bisa turun.
+type CustomerTierService interface {
+	// RecomputeTiers returns jumlah user yang tier-nya berubah
+	RecomputeTiers(ctx context.Context) (int64, error)
+}
+
+type customerTierService struct {
+	repo     *repository.Repository
+	config   utils.TierConfig
+	currency string
+	log      *zap.Logger
+}
+
+func NewCustomerTierService(repo *repository.Repository, config utils.TierConfig, pricing utils.PricingConfig, log *zap.Logger) CustomerTierService {
+	return &customerTierService{
+		repo:     repo,
+		config:   config,
+		currency: pricing.Currency,
+		log:      log.With(zap.String("service", "customer_tier")),
+	}
+}
+
+func (s *customerTierService) RecomputeTiers(ctx context.Context) (int64, error) {
+	now := time.Now()
+	currency := utils.CurrencyOf(s.currency)
+	rule := repository.TierRule{
+		Currency:      s.currency,
+		Since:         now.AddDate(0, 0, -s.config.WindowDays),
+		SilverSpend:   currency.ToMinor(s.config.SilverSpend),
+		GoldSpend:     currency.ToMinor(s.config.GoldSpend),
+		PlatinumSpend: currency.ToMinor(s.config.PlatinumSpend),
+	}
+
+	changed, err := s.repo.User.UpdateTiers(ctx, rule, now)
+	if err != nil {
+		return 0, fmt.Errorf("recompute customer tiers: %w", err)
+	}
+
+	if changed > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Customer tiers updated", zap.Int64("users_changed", changed))
+	}
+	return changed, nil
+}
@@ -0,0 +1,478 @@
+package usecase
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// dataExportPageSize ukuran halaman saat membaca booking / review user
+const dataExportPageSize = 200
+
+// DataExportFile file hasil export yang siap dikirim ke client; Content wajib di-Close
+type DataExportFile struct {
+	Content     *os.File
+	Filename    string
+	ContentType string
+	ModTime     time.Time
+}
+
+// DataExportService export data pribadi user (profil, booking, payment, review, session).
+// Permintaan dicatat bersama job jobDataExportBuild yang menyusun file; link download ditandatangani HMAC
+// supaya bisa dibuka tanpa header Authorization (mis. langsung dari browser).
+type DataExportService interface {
+	// RequestExport returns export aktif user untuk format itu, atau membuat permintaan baru
+	RequestExport(ctx context.Context, userID string, req *request.DataExportRequest) (*response.DataExportResponse, error)
+	// ExpireFiles menghapus file yang sudah lewat masa berlaku; baris log tetap disimpan
+	ExpireFiles(ctx context.Context) (int, error)
+	OpenDownload(ctx context.Context, exportID, expires, signature string) (*DataExportFile, error)
+	GetDataExports(ctx context.Context, req *request.PaginatedRequest, filter *request.DataExportFilter) (*response.PaginatedResponse[response.DataExportResponse], error)
+}
+
+type dataExportService struct {
+	repo       *repository.Repository
+	dir        string
+	ttl        time.Duration
+	signingKey []byte
+	jobs       *jobQueue
+	log        *zap.Logger
+}
+
+// dataExportJob payload job penyusunan file export
+type dataExportJob struct {
+	ExportID uuid.UUID `json:"export_id"`
+}
+
+func NewDataExportService(repo *repository.Repository, jobs *jobQueue, config utils.DataExportConfig, log *zap.Logger) DataExportService {
+	log = log.With(zap.String("service", "data_export"))
+
+	signingKey := []byte(config.SigningKey)
+	if len(signingKey) == 0 {
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			panic(fmt.Sprintf("generate data export signing key: %v", err))
+		}
+		log.Warn("DATA_EXPORT_SIGNING_KEY not set, download links are only valid until restart")
+	}
+
+	s := &dataExportService{
+		repo:       repo,
+		dir:        config.Dir,
+		ttl:        time.Duration(config.TTLHours) * time.Hour,
+		signingKey: signingKey,
+		jobs:       jobs,
+		log:        log,
+	}
+	jobs.handle(jobDataExportBuild, s.buildExport)
+	return s
+}
+
+func (s *dataExportService) RequestExport(ctx context.Context, userID string, req *request.DataExportRequest) (*response.DataExportResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	format := entity.DataExportFormatJSON
+	if req.Format != "" {
+		format = entity.DataExportFormat(req.Format)
+	}
+
+	// Polling endpoint yang sama tidak membuat antrian baru selama export terakhir masih jalan / bisa diunduh
+	now := time.Now()
+	latest, err := s.repo.DataExport.FindLatestByUser(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find latest data export: %w", err)
+	}
+	if latest != nil && latest.Format == format && (latest.InProgress() || latest.Downloadable(now)) {
+		resp := s.toResponse(latest, now)
+		return &resp, nil
+	}
+
+	export := &entity.DataExport{
+		BaseNoDelete: entity.BaseNoDelete{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		UserID:       id,
+		Format:       format,
+		Status:       entity.DataExportStatusPending,
+	}
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.DataExport.Create(ctx, export); err != nil {
+			return err
+		}
+		return s.jobs.enqueue(ctx, tx, jobDataExportBuild, dataExportJob{ExportID: export.ID})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create data export: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Data export requested",
+		zap.String("export_id", export.ID.String()),
+		zap.String("user_id", userID),
+		zap.String("format", string(format)),
+	)
+
+	resp := s.toResponse(export, now)
+	return &resp, nil
+}
+
+// buildExport handler job jobDataExportBuild. Error membuat job di-retry; di percobaan terakhir export
+// ditandai failed supaya user bisa meminta export baru.
+func (s *dataExportService) buildExport(ctx context.Context, job *entity.Job) error {
+	payload, err := decodeJobPayload[dataExportJob](job)
+	if err != nil {
+		return err
+	}
+
+	export, err := s.repo.DataExport.FindByID(ctx, payload.ExportID)
+	if err != nil {
+		return fmt.Errorf("find data export %s: %w", payload.ExportID.String(), err)
+	}
+	// Sudah selesai di percobaan sebelumnya (instance mati sebelum job ditandai selesai)
+	if export == nil || !export.InProgress() {
+		return nil
+	}
+
+	export.Status = entity.DataExportStatusProcessing
+	export.UpdatedAt = time.Now()
+	if err := s.repo.DataExport.Update(ctx, export); err != nil {
+		return err
+	}
+
+	if err := s.process(ctx, export); err != nil {
+		if job.LastAttempt() {
+			msg := err.Error()
+			export.Status = entity.DataExportStatusFailed
+			export.Error = &msg
+			export.UpdatedAt = time.Now()
+			if updateErr := s.repo.DataExport.Update(ctx, export); updateErr != nil {
+				utils.LoggerFromContext(ctx, s.log).Error("Failed to mark data export failed",
+					zap.Error(updateErr),
+					zap.String("export_id", export.ID.String()),
+				)
+			}
+		}
+		return fmt.Errorf("build data export %s: %w", export.ID.String(), err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Data export completed", zap.String("export_id", export.ID.String()))
+	return nil
+}
+
+// process assembles data user lalu menulis file ke dir; rename di akhir supaya file setengah jadi tidak pernah terbaca
+func (s *dataExportService) process(ctx context.Context, export *entity.DataExport) error {
+	data, err := s.collect(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return fmt.Errorf("create export dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "export-*.tmp")
+	if err != nil {
+		return fmt.Errorf("create export file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op setelah rename berhasil
+
+	if export.Format == entity.DataExportFormatZIP {
+		err = writeDataExportZip(tmp, data)
+	} else {
+		err = writeDataExportJSON(tmp, data)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("write export file: %w", err)
+	}
+
+	path := filepath.Join(s.dir, export.ID.String()+"."+string(export.Format))
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("move export file: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("stat export file: %w", err)
+	}
+
+	now := time.Now()
+	size := info.Size()
+	expiresAt := now.Add(s.ttl)
+	export.Status = entity.DataExportStatusCompleted
+	export.FilePath = &path
+	export.SizeBytes = &size
+	export.Error = nil
+	export.CompletedAt = &now
+	export.ExpiresAt = &expiresAt
+	export.UpdatedAt = now
+
+	return s.repo.DataExport.Update(ctx, export)
+}
+
+// collect reads semua data pribadi user; booking dan review dibaca per halaman supaya query tetap kecil
+func (s *dataExportService) collect(ctx context.Context, userID uuid.UUID) (*response.PersonalDataResponse, error) {
+	user, err := s.repo.User.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user %s not found", userID.String())
+	}
+
+	data := &response.PersonalDataResponse{
+		ExportedAt: time.Now(),
+		Profile:    response.UserToResponse(user),
+		Bookings:   []response.ExportBookingResponse{},
+		Payments:   []response.ExportPaymentResponse{},
+		Reviews:    []response.ExportReviewResponse{},
+		Sessions:   []response.ExportSessionResponse{},
+	}
+
+	for offset := 0; ; offset += dataExportPageSize {
+		bookings, err := s.repo.Booking.FindByUserID(ctx, userID, repository.BookingFilter{}, dataExportPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("find bookings: %w", err)
+		}
+		for _, booking := range bookings {
+			data.Bookings = append(data.Bookings, response.BookingToExportResponse(booking))
+		}
+		if len(bookings) < dataExportPageSize {
+			break
+		}
+	}
+
+	payments, err := s.repo.Payment.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find payments: %w", err)
+	}
+	for _, payment := range payments {
+		data.Payments = append(data.Payments, response.PaymentToExportResponse(payment))
+	}
+
+	for offset := 0; ; offset += dataExportPageSize {
+		reviews, err := s.repo.Review.FindByUserID(ctx, userID, repository.ReviewSortNewest, dataExportPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("find reviews: %w", err)
+		}
+		for _, review := range reviews {
+			data.Reviews = append(data.Reviews, response.ReviewToExportResponse(review))
+		}
+		if len(reviews) < dataExportPageSize {
+			break
+		}
+	}
+
+	sessions, err := s.repo.Session.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find sessions: %w", err)
+	}
+	for _, session := range sessions {
+		data.Sessions = append(data.Sessions, response.SessionToExportResponse(session))
+	}
+
+	return data, nil
+}
+
+func writeDataExportJSON(w io.Writer, data *response.PersonalDataResponse) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
+
+// writeDataExportZip menulis satu file JSON per section, lebih mudah dibuka user daripada satu file besar
+func writeDataExportZip(w io.Writer, data *response.PersonalDataResponse) error {
+	archive := zip.NewWriter(w)
+
+	sections := []struct {
+		name  string
+		value any
+	}{
+		{"profile.json", data.Profile},
+		{"bookings.json", data.Bookings},
+		{"payments.json", data.Payments},
+		{"reviews.json", data.Reviews},
+		{"sessions.json", data.Sessions},
+	}
+	for _, section := range sections {
+		file, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     section.name,
+			Method:   zip.Deflate,
+			Modified: data.ExportedAt,
+		})
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(section.value); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+func (s *dataExportService) ExpireFiles(ctx context.Context) (int, error) {
+	exports, err := s.repo.DataExport.FindExpired(ctx, time.Now(), dataExportPageSize)
+	if err != nil {
+		return 0, fmt.Errorf("find expired data exports: %w", err)
+	}
+
+	expired := 0
+	for _, export := range exports {
+		if export.FilePath != nil {
+			if err := os.Remove(*export.FilePath); err != nil && !os.IsNotExist(err) {
+				utils.LoggerFromContext(ctx, s.log).Warn("Failed to remove expired export file", zap.Error(err), zap.String("export_id", export.ID.String()))
+				continue
+			}
+		}
+
+		export.Status = entity.DataExportStatusExpired
+		export.FilePath = nil
+		export.UpdatedAt = time.Now()
+		if err := s.repo.DataExport.Update(ctx, export); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+
+	if expired > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Expired data export files removed", zap.Int("count", expired))
+	}
+
+	return expired, nil
+}
+
+func (s *dataExportService) OpenDownload(ctx context.Context, exportID, expires, signature string) (*DataExportFile, error) {
+	id, err := uuid.Parse(exportID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid export ID format %s: %w", exportID, err)
+	}
+
+	expiresUnix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid download link: expires must be a unix timestamp")
+	}
+	// Signature dicek sebelum menyentuh database; link yang diubah diperlakukan sama dengan link expired
+	if !hmac.Equal([]byte(signature), []byte(s.sign(id, expiresUnix))) {
+		return nil, fmt.Errorf("forbidden: invalid download signature")
+	}
+
+	now := time.Now()
+	if now.Unix() >= expiresUnix {
+		return nil, fmt.Errorf("forbidden: download link has expired")
+	}
+
+	export, err := s.repo.DataExport.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find data export: %w", err)
+	}
+	if export == nil || !export.Downloadable(now) {
+		return nil, fmt.Errorf("data export %s not found", exportID)
+	}
+
+	file, err := os.Open(*export.FilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("data export %s not found", exportID)
+		}
+		return nil, fmt.Errorf("open export file: %w", err)
+	}
+
+	if export.DownloadedAt == nil {
+		export.DownloadedAt = &now
+		export.UpdatedAt = now
+		if err := s.repo.DataExport.Update(ctx, export); err != nil {
+			// Pencatatan download tidak boleh menggagalkan download-nya
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to record data export download", zap.Error(err), zap.String("export_id", exportID))
+		}
+	}
+
+	contentType := "application/json"
+	if export.Format == entity.DataExportFormatZIP {
+		contentType = "application/zip"
+	}
+
+	return &DataExportFile{
+		Content:     file,
+		Filename:    fmt.Sprintf("personal-data-%s.%s", export.CreatedAt.Format("20060102"), export.Format),
+		ContentType: contentType,
+		ModTime:     *export.CompletedAt,
+	}, nil
+}
+
+func (s *dataExportService) GetDataExports(ctx context.Context, req *request.PaginatedRequest, filter *request.DataExportFilter) (*response.PaginatedResponse[response.DataExportResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
+	var repoFilter repository.DataExportFilter
+	if filter.UserID != "" {
+		userID := uuid.MustParse(filter.UserID) // sudah divalidasi tag uuid
+		repoFilter.UserID = &userID
+	}
+	if filter.Status != "" {
+		status := entity.DataExportStatus(filter.Status)
+		repoFilter.Status = &status
+	}
+
+	exports, err := s.repo.DataExport.FindAll(ctx, repoFilter, req.Limit(), req.Offset())
+	if err != nil {
+		return nil, fmt.Errorf("get data exports: %w", err)
+	}
+
+	total, err := s.repo.DataExport.CountAll(ctx, repoFilter)
+	if err != nil {
+		return nil, fmt.Errorf("count data exports: %w", err)
+	}
+
+	result := make([]response.DataExportResponse, len(exports))
+	for i, export := range exports {
+		result[i] = response.DataExportToAdminResponse(export)
+	}
+
+	return response.NewPaginatedResponse(result, req.Page, req.PerPage, total), nil
+}
+
+// toResponse adds signed download URL selama file masih bisa diunduh
+func (s *dataExportService) toResponse(export *entity.DataExport, now time.Time) response.DataExportResponse {
+	resp := response.DataExportToResponse(export)
+	if export.Downloadable(now) {
+		expires := export.ExpiresAt.Unix()
+		url := fmt.Sprintf("/api/exports/%s/download?expires=%d&signature=%s", export.ID, expires, s.sign(export.ID, expires))
+		resp.DownloadURL = &url
+	}
+	return resp
+}
+
+// sign returns HMAC-SHA256 hex dari "<id>:<expires>"
+func (s *dataExportService) sign(id uuid.UUID, expires int64) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	fmt.Fprintf(mac, "%s:%d", id, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
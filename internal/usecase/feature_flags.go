@@ -0,0 +1,344 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/cache"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// featureFlagCacheTTL flag dicek di jalur waitlist per request, jadi tabelnya tidak dibaca setiap kali.
+// Toggle admin langsung berlaku di instance yang menerima update, instance lain menyusul saat entry habis.
+const featureFlagCacheTTL = 30 * time.Second
+
+// featureFlagCacheKey flag di-cache sebagai satu map, jadi key yang tidak punya row juga tidak memicu query
+const featureFlagCacheKey = "all"
+
+// featureFlagRetryInterval selama database gagal dibaca, default di kode di-cache sejauh ini
+// supaya tidak setiap Enabled ikut query ke database yang sedang bermasalah
+const featureFlagRetryInterval = 5 * time.Second
+
+var featureFlagKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,63}$`)
+
+// Flag untuk fitur yang bisa dimatikan atau di-rollout bertahap tanpa redeploy
+const (
+	// flagSeatHolds menahan kursi yang lepas untuk user waitlist berikutnya
+	flagSeatHolds = "seat_holds"
+	// flagWaitlist mengizinkan user masuk waitlist schedule yang penuh
+	flagWaitlist = "waitlist"
+)
+
+// featureFlagDefinition flag yang dipakai kode; default berlaku selama admin belum mengaturnya
+type featureFlagDefinition struct {
+	description string
+	enabled     bool
+}
+
+// Fitur yang sudah berjalan sebelum ada flag default menyala supaya perilaku tidak berubah
+var featureFlagDefinitions = map[string]featureFlagDefinition{
+	flagSeatHolds: {description: "Hold freed seats for the next waitlisted users", enabled: true},
+	flagWaitlist:  {description: "Allow users to join the waitlist of a full schedule", enabled: true},
+}
+
+// featureFlags evaluasi flag per environment dan user. NewService membuat satu instance untuk
+// WaitlistService dan FeatureFlagService; yang terakhir meng-invalidate cache setelah admin mengubah flag.
+// Key tanpa definisi dan tanpa row dianggap mati.
+type featureFlags struct {
+	repo        *repository.Repository
+	environment string
+	flags       *cache.TTL[string, map[string]*entity.FeatureFlag]
+	log         *zap.Logger
+
+	// generation naik setiap invalidate; flag yang dibaca sebelum perubahan admin tidak disimpan
+	generation atomic.Uint64
+}
+
+func newFeatureFlags(repo *repository.Repository, config utils.AppConfig, log *zap.Logger) *featureFlags {
+	return &featureFlags{
+		repo:        repo,
+		environment: config.Environment,
+		flags:       cache.NewTTL[string, map[string]*entity.FeatureFlag](featureFlagCacheTTL),
+		log:         log.With(zap.String("component", "feature_flags")),
+	}
+}
+
+// Enabled evaluates flag untuk user di context. Request tanpa login hanya kebagian flag
+// yang di-rollout ke 100% user.
+func (f *featureFlags) Enabled(ctx context.Context, key string) bool {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		userID = uuid.Nil
+	}
+	return f.EnabledFor(ctx, key, userID)
+}
+
+// EnabledFor untuk jalur tanpa user di context (job, offer waitlist); uuid.Nil berarti anonymous
+func (f *featureFlags) EnabledFor(ctx context.Context, key string, userID uuid.UUID) bool {
+	flag, ok := f.load(ctx)[key]
+	if !ok {
+		return featureFlagDefinitions[key].enabled
+	}
+	if !flag.ActiveIn(f.environment) {
+		return false
+	}
+	if flag.RolloutPercent >= 100 {
+		return true
+	}
+	if userID == uuid.Nil {
+		return false
+	}
+	return rolloutBucket(key, userID) < flag.RolloutPercent
+}
+
+// rolloutBucket 0-99 yang stabil per user dan per flag, jadi menaikkan persen hanya menambah user
+// dan user yang sama tidak selalu kebagian semua fitur baru
+func rolloutBucket(key string, userID uuid.UUID) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write(userID[:])
+	return int(h.Sum32() % 100)
+}
+
+func (f *featureFlags) load(ctx context.Context) map[string]*entity.FeatureFlag {
+	if flags, ok := f.flags.Get(featureFlagCacheKey); ok {
+		return flags
+	}
+
+	generation := f.generation.Load()
+
+	stored, err := f.repo.FeatureFlag.FindAll(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, f.log).Warn("Failed to load feature flags, using defaults", zap.Error(err))
+		// Map kosong berarti semua flag pakai default; request yang dibatalkan tidak ikut menahan database
+		if ctx.Err() == nil && f.generation.Load() == generation {
+			f.flags.SetFor(featureFlagCacheKey, map[string]*entity.FeatureFlag{}, featureFlagRetryInterval)
+		}
+		return nil
+	}
+
+	flags := make(map[string]*entity.FeatureFlag, len(stored))
+	for _, flag := range stored {
+		flags[flag.Key] = flag
+	}
+	if f.generation.Load() == generation {
+		f.flags.Set(featureFlagCacheKey, flags)
+	}
+	return flags
+}
+
+// invalidate dipanggil setelah perubahan flag commit, supaya instance ini langsung memakai nilai baru
+func (f *featureFlags) invalidate() {
+	f.generation.Add(1)
+	f.flags.Delete(featureFlagCacheKey)
+}
+
+type FeatureFlagService interface {
+	// ListFlags semua flag yang dikenal kode ditambah flag lain yang pernah dibuat admin
+	ListFlags(ctx context.Context) (*response.FeatureFlagsResponse, error)
+	UpdateFlag(ctx context.Context, adminID, key string, req *request.UpdateFeatureFlagRequest) (*response.FeatureFlagResponse, error)
+	// DeleteFlag mengembalikan flag ke default di kode
+	DeleteFlag(ctx context.Context, adminID, key string) error
+}
+
+type featureFlagService struct {
+	repo  *repository.Repository
+	flags *featureFlags
+	log   *zap.Logger
+}
+
+func NewFeatureFlagService(repo *repository.Repository, flags *featureFlags, log *zap.Logger) FeatureFlagService {
+	return &featureFlagService{
+		repo:  repo,
+		flags: flags,
+		log:   log.With(zap.String("service", "feature_flag")),
+	}
+}
+
+func (s *featureFlagService) ListFlags(ctx context.Context) (*response.FeatureFlagsResponse, error) {
+	// Admin selalu melihat isi tabel terbaru, bukan cache
+	stored, err := s.repo.FeatureFlag.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list feature flags: %w", err)
+	}
+
+	flags := make(map[string]*entity.FeatureFlag, len(stored))
+	for _, flag := range stored {
+		flags[flag.Key] = flag
+	}
+
+	keys := make([]string, 0, len(featureFlagDefinitions)+len(flags))
+	for key := range featureFlagDefinitions {
+		keys = append(keys, key)
+	}
+	for key := range flags {
+		if _, ok := featureFlagDefinitions[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := &response.FeatureFlagsResponse{
+		Environment: s.flags.environment,
+		Flags:       make([]response.FeatureFlagResponse, len(keys)),
+	}
+	for i, key := range keys {
+		result.Flags[i] = s.toResponse(key, flags[key])
+	}
+
+	return result, nil
+}
+
+func (s *featureFlagService) UpdateFlag(ctx context.Context, adminID, key string, req *request.UpdateFeatureFlagRequest) (*response.FeatureFlagResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+	if !featureFlagKeyPattern.MatchString(key) {
+		return nil, fmt.Errorf("invalid feature flag key %s: use lowercase letters, digits and underscores", key)
+	}
+
+	actorID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin ID format %s: %w", adminID, err)
+	}
+
+	existing, err := s.repo.FeatureFlag.FindByKey(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("find feature flag: %w", err)
+	}
+
+	now := time.Now()
+	flag := &entity.FeatureFlag{
+		Key:            key,
+		Description:    req.Description,
+		Enabled:        *req.Enabled,
+		RolloutPercent: 100,
+		Environments:   req.Environments,
+		UpdatedBy:      &actorID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if existing != nil {
+		flag.CreatedAt = existing.CreatedAt
+	}
+	if req.RolloutPercent != nil {
+		flag.RolloutPercent = *req.RolloutPercent
+	}
+	if flag.Environments == nil {
+		flag.Environments = []string{}
+	}
+	if flag.Description == "" {
+		if existing != nil {
+			flag.Description = existing.Description
+		} else {
+			flag.Description = featureFlagDefinitions[key].description
+		}
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.FeatureFlag.Upsert(ctx, flag); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, actorID, entity.AuditActionFeatureFlagUpdate, entity.AuditTargetFeatureFlag, nil,
+			map[string]any{
+				"key":             key,
+				"enabled":         flag.Enabled,
+				"rollout_percent": flag.RolloutPercent,
+				"environments":    flag.Environments,
+			}, "")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update feature flag %s: %w", key, err)
+	}
+	s.flags.invalidate()
+
+	utils.LoggerFromContext(ctx, s.log).Info("Feature flag updated",
+		zap.String("key", key),
+		zap.String("admin_id", adminID),
+		zap.Bool("enabled", flag.Enabled),
+		zap.Int("rollout_percent", flag.RolloutPercent),
+		zap.Strings("environments", flag.Environments),
+	)
+
+	resp := s.toResponse(key, flag)
+	return &resp, nil
+}
+
+func (s *featureFlagService) DeleteFlag(ctx context.Context, adminID, key string) error {
+	actorID, err := uuid.Parse(adminID)
+	if err != nil {
+		return fmt.Errorf("invalid admin ID format %s: %w", adminID, err)
+	}
+
+	existing, err := s.repo.FeatureFlag.FindByKey(ctx, key)
+	if err != nil {
+		return fmt.Errorf("find feature flag: %w", err)
+	}
+	if existing == nil {
+		return fmt.Errorf("feature flag %s not found", key)
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.FeatureFlag.Delete(ctx, key); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, actorID, entity.AuditActionFeatureFlagDelete, entity.AuditTargetFeatureFlag, nil,
+			map[string]any{"key": key}, "")
+	})
+	if err != nil {
+		return fmt.Errorf("delete feature flag %s: %w", key, err)
+	}
+	s.flags.invalidate()
+
+	utils.LoggerFromContext(ctx, s.log).Info("Feature flag reset to default",
+		zap.String("key", key),
+		zap.String("admin_id", adminID),
+	)
+
+	return nil
+}
+
+// toResponse menggabungkan row (boleh nil) dengan default di kode
+func (s *featureFlagService) toResponse(key string, flag *entity.FeatureFlag) response.FeatureFlagResponse {
+	definition := featureFlagDefinitions[key]
+	if flag == nil {
+		return response.FeatureFlagResponse{
+			Key:            key,
+			Description:    definition.description,
+			Enabled:        definition.enabled,
+			RolloutPercent: 100,
+			Environments:   []string{},
+			Default:        definition.enabled,
+			ActiveHere:     definition.enabled,
+		}
+	}
+
+	resp := response.FeatureFlagResponse{
+		Key:            key,
+		Description:    flag.Description,
+		Enabled:        flag.Enabled,
+		RolloutPercent: flag.RolloutPercent,
+		Environments:   flag.Environments,
+		Default:        definition.enabled,
+		Overridden:     true,
+		ActiveHere:     flag.ActiveIn(s.flags.environment),
+		UpdatedAt:      &flag.UpdatedAt,
+	}
+	if flag.UpdatedBy != nil {
+		updatedBy := flag.UpdatedBy.String()
+		resp.UpdatedBy = &updatedBy
+	}
+	return resp
+}
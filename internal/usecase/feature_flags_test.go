@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/data/repository/mockrepo"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newTestFeatureFlags(t *testing.T) (*featureFlags, *mockrepo.MockFeatureFlagRepository) {
+	t.Helper()
+
+	store := mockrepo.NewMockFeatureFlagRepository(gomock.NewController(t))
+	repo := &repository.Repository{FeatureFlag: store}
+	return newFeatureFlags(repo, utils.AppConfig{Environment: "production"}, zap.NewNop()), store
+}
+
+func TestFeatureFlags_DatabaseErrorUsesCachedDefaults(t *testing.T) {
+	ctx := context.Background()
+	flags, store := newTestFeatureFlags(t)
+
+	// Hanya satu query selama retry interval, walaupun flag dicek berkali-kali
+	store.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("connection refused")).Times(1)
+
+	for i := 0; i < 3; i++ {
+		if !flags.EnabledFor(ctx, flagWaitlist, uuid.Nil) {
+			t.Fatalf("check %d: %s disabled, want code default", i, flagWaitlist)
+		}
+	}
+}
+
+func TestFeatureFlags_InvalidateDuringLoadDropsStaleFlags(t *testing.T) {
+	ctx := context.Background()
+	flags, store := newTestFeatureFlags(t)
+
+	stale := []*entity.FeatureFlag{{Key: flagWaitlist, Enabled: true, RolloutPercent: 100}}
+	updated := []*entity.FeatureFlag{{Key: flagWaitlist, Enabled: false, RolloutPercent: 100}}
+
+	gomock.InOrder(
+		// Admin mematikan flag saat query pertama masih berjalan
+		store.EXPECT().FindAll(gomock.Any()).DoAndReturn(func(context.Context) ([]*entity.FeatureFlag, error) {
+			flags.invalidate()
+			return stale, nil
+		}),
+		store.EXPECT().FindAll(gomock.Any()).Return(updated, nil),
+	)
+
+	if !flags.EnabledFor(ctx, flagWaitlist, uuid.Nil) {
+		t.Fatalf("first check: want the value read by the in-flight query")
+	}
+	if flags.EnabledFor(ctx, flagWaitlist, uuid.Nil) {
+		t.Fatalf("second check: stale flag was cached across invalidate")
+	}
+	// Hasil kedua di-cache, tidak ada query ketiga
+	if flags.EnabledFor(ctx, flagWaitlist, uuid.Nil) {
+		t.Fatalf("third check: want cached updated flag")
+	}
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/cache"
+	"cinema-booking/pkg/feed"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// Key cache untuk dokumen yang sudah di-render
+const (
+	feedKeySitemap = "sitemap"
+	feedKeyJSON    = "movies.json"
+	feedKeyRSS     = "movies.rss"
+)
+
+// feedReleaseStatuses movie yang tampil di sitemap dan feed; ended tidak lagi dipromosikan
+var feedReleaseStatuses = []entity.ReleaseStatus{entity.ReleaseStatusNowPlaying, entity.ReleaseStatusComingSoon}
+
+// movieFeed menyimpan sitemap dan feed yang sudah di-render. movieService memanggil invalidate setiap
+// ada perubahan movie, jadi request berikutnya membangun ulang dari database.
+type movieFeed struct {
+	repo     *repository.Repository
+	siteURL  string
+	siteName string
+	cache    *cache.TTL[string, []byte]
+
+	// generation mencegah hasil render lama tersimpan kalau invalidate terjadi saat render berjalan
+	generation atomic.Uint64
+}
+
+func newMovieFeed(repo *repository.Repository, config utils.AppConfig) *movieFeed {
+	return &movieFeed{
+		repo:     repo,
+		siteURL:  config.PublicSiteURL,
+		siteName: config.Name,
+		cache:    cache.NewTTL[string, []byte](time.Duration(config.FeedCacheSeconds) * time.Second),
+	}
+}
+
+func (f *movieFeed) invalidate() {
+	f.generation.Add(1)
+	for _, key := range []string{feedKeySitemap, feedKeyJSON, feedKeyRSS} {
+		f.cache.Delete(key)
+	}
+}
+
+// get returns dokumen dari cache atau me-render ulang dari movie now_playing dan coming_soon
+func (f *movieFeed) get(ctx context.Context, key string, render func([]*entity.Movie) ([]byte, error)) ([]byte, error) {
+	if body, ok := f.cache.Get(key); ok {
+		return body, nil
+	}
+
+	generation := f.generation.Load()
+
+	movies, err := f.repo.Movie.FindByReleaseStatuses(ctx, feedReleaseStatuses)
+	if err != nil {
+		return nil, fmt.Errorf("load feed movies: %w", err)
+	}
+
+	body, err := render(movies)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.generation.Load() == generation {
+		f.cache.Set(key, body)
+	}
+	return body, nil
+}
+
+// movieURL canonical URL halaman detail movie di website publik
+func (f *movieFeed) movieURL(movie *entity.Movie) string {
+	return f.siteURL + "/movies/" + movie.ID.String()
+}
+
+type FeedService interface {
+	// Sitemap returns sitemap.xml berisi halaman utama, daftar movie dan detail setiap movie yang tayang
+	Sitemap(ctx context.Context) ([]byte, error)
+	// MovieFeed returns feed now playing dan coming soon dalam format JSON Feed atau RSS
+	MovieFeed(ctx context.Context, format feed.Format) ([]byte, error)
+}
+
+type feedService struct {
+	feed *movieFeed
+	log  *zap.Logger
+}
+
+func NewFeedService(movieFeed *movieFeed, log *zap.Logger) FeedService {
+	return &feedService{
+		feed: movieFeed,
+		log:  log.With(zap.String("service", "feed")),
+	}
+}
+
+func (s *feedService) Sitemap(ctx context.Context) ([]byte, error) {
+	body, err := s.feed.get(ctx, feedKeySitemap, s.renderSitemap)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to build sitemap", zap.Error(err))
+		return nil, fmt.Errorf("build sitemap: %w", err)
+	}
+	return body, nil
+}
+
+func (s *feedService) MovieFeed(ctx context.Context, format feed.Format) ([]byte, error) {
+	key := feedKeyJSON
+	if format == feed.FormatRSS {
+		key = feedKeyRSS
+	}
+
+	body, err := s.feed.get(ctx, key, func(movies []*entity.Movie) ([]byte, error) {
+		return s.renderMovieFeed(movies, format)
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to build movie feed", zap.Error(err), zap.String("format", string(format)))
+		return nil, fmt.Errorf("build movie feed: %w", err)
+	}
+	return body, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func (s *feedService) renderSitemap(movies []*entity.Movie) ([]byte, error) {
+	var latest time.Time
+	urls := make([]feed.SitemapURL, 0, len(movies)+2)
+	for _, movie := range movies {
+		if movie.UpdatedAt.After(latest) {
+			latest = movie.UpdatedAt
+		}
+	}
+
+	// Halaman listing berubah setiap ada movie yang berubah, jadi lastmod mengikuti movie terbaru
+	urls = append(urls,
+		feed.SitemapURL{Loc: s.feed.siteURL + "/", LastMod: latest, ChangeFreq: "daily", Priority: 1.0},
+		feed.SitemapURL{Loc: s.feed.siteURL + "/movies", LastMod: latest, ChangeFreq: "daily", Priority: 0.9},
+	)
+
+	for _, movie := range movies {
+		priority := 0.8
+		if movie.ReleaseStatus == entity.ReleaseStatusComingSoon {
+			priority = 0.6
+		}
+		urls = append(urls, feed.SitemapURL{
+			Loc:        s.feed.movieURL(movie),
+			LastMod:    movie.UpdatedAt,
+			ChangeFreq: "daily",
+			Priority:   priority,
+		})
+	}
+
+	return feed.Sitemap(urls)
+}
+
+func (s *feedService) renderMovieFeed(movies []*entity.Movie, format feed.Format) ([]byte, error) {
+	items := make([]feed.Item, len(movies))
+	for i, movie := range movies {
+		item := feed.Item{
+			ID:        movie.ID.String(),
+			Title:     movie.Title,
+			URL:       s.feed.movieURL(movie),
+			Tags:      []string{feedStatusLabel(movie.ReleaseStatus)},
+			Published: movie.ReleaseDate,
+			Updated:   movie.UpdatedAt,
+		}
+		// JSON Feed mewajibkan content_text, movie tanpa deskripsi memakai judulnya
+		item.Summary = movie.Title
+		if movie.Description != nil && *movie.Description != "" {
+			item.Summary = *movie.Description
+		}
+		if movie.PosterURL != nil {
+			item.ImageURL = *movie.PosterURL
+		}
+		items[i] = item
+	}
+
+	// Website publik mem-proxy /api ke service ini, jadi feed_url ikut base URL website
+	return feed.Render(feed.Feed{
+		Title:       s.feed.siteName + " - Movies",
+		Description: "Now playing and coming soon at " + s.feed.siteName,
+		HomeURL:     s.feed.siteURL + "/movies",
+		FeedURL:     s.feed.siteURL + "/api/feeds/movies." + string(format),
+		Items:       items,
+	}, format)
+}
+
+// feedStatusLabel label kategori yang dibaca manusia di feed reader
+func feedStatusLabel(status entity.ReleaseStatus) string {
+	if status == entity.ReleaseStatusComingSoon {
+		return "Coming Soon"
+	}
+	return "Now Playing"
+}
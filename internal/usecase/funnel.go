@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+
+	"github.com/google/uuid"
+)
+
+// recordFunnel writes satu funnel event. Di dalam tx (hold, booking, payment) error ikut
+// membatalkan tx supaya funnel selalu sejalan dengan data booking; seat_view dipanggil di luar tx.
+func recordFunnel(ctx context.Context, repo *repository.Repository, step entity.FunnelStep, scheduleID uuid.UUID, userID, bookingID *uuid.UUID) error {
+	return repo.Funnel.Record(ctx, &entity.FunnelEvent{
+		ID:         uuid.New(),
+		Step:       step,
+		ScheduleID: scheduleID,
+		UserID:     userID,
+		BookingID:  bookingID,
+		CreatedAt:  time.Now(),
+	})
+}
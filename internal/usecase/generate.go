@@ -0,0 +1,32 @@
+package usecase
+
+// Mock untuk semua service interface, dipakai handler test di adaptor. Regenerate dengan go generate ./...
+//go:generate mockgen -source=audit_srv.go -destination=mockusecase/audit_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=auth_srv.go -destination=mockusecase/auth_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=banner_srv.go -destination=mockusecase/banner_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=booking_srv.go -destination=mockusecase/booking_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=cinema_srv.go -destination=mockusecase/cinema_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=cleanup_srv.go -destination=mockusecase/cleanup_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=customer_tier_srv.go -destination=mockusecase/customer_tier_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=data_export_srv.go -destination=mockusecase/data_export_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=feature_flags.go -destination=mockusecase/feature_flags_mock.go -package=mockusecase
+//go:generate mockgen -source=feed_srv.go -destination=mockusecase/feed_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=gift_card_srv.go -destination=mockusecase/gift_card_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=health_srv.go -destination=mockusecase/health_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=home_srv.go -destination=mockusecase/home_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=job_srv.go -destination=mockusecase/job_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=ledger_srv.go -destination=mockusecase/ledger_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=movie_srv.go -destination=mockusecase/movie_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=notification_srv.go -destination=mockusecase/notification_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=organization_srv.go -destination=mockusecase/organization_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=outbox_srv.go -destination=mockusecase/outbox_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=payment_method_srv.go -destination=mockusecase/payment_method_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=price_promotion_srv.go -destination=mockusecase/price_promotion_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=report_srv.go -destination=mockusecase/report_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=review_srv.go -destination=mockusecase/review_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=schedule_srv.go -destination=mockusecase/schedule_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=settings_srv.go -destination=mockusecase/settings_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=user_srv.go -destination=mockusecase/user_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=waitlist_srv.go -destination=mockusecase/waitlist_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=watchlist_srv.go -destination=mockusecase/watchlist_srv_mock.go -package=mockusecase
+//go:generate mockgen -source=webhook_srv.go -destination=mockusecase/webhook_srv_mock.go -package=mockusecase
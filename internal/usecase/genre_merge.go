@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MergeGenre pindahkan semua movie dari genre duplikat ke target lalu hapus duplikatnya.
+// Semua langkah plus audit log ada di satu tx, jadi tidak ada movie yang kehilangan genre.
+func (s *movieService) MergeGenre(ctx context.Context, adminID, genreID, targetID string) (*response.GenreMergeResponse, error) {
+	actorID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin ID format %s: %w", adminID, err)
+	}
+	sourceUUID, err := uuid.Parse(genreID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid genre id: %w", err)
+	}
+	targetUUID, err := uuid.Parse(targetID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target genre id: %w", err)
+	}
+	if sourceUUID == targetUUID {
+		return nil, fmt.Errorf("invalid merge: genre cannot be merged into itself")
+	}
+
+	source, err := s.repo.Genre.FindByID(ctx, sourceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("find genre: %w", err)
+	}
+	if source == nil {
+		return nil, fmt.Errorf("genre not found: %s", genreID)
+	}
+	target, err := s.repo.Genre.FindByID(ctx, targetUUID)
+	if err != nil {
+		return nil, fmt.Errorf("find target genre: %w", err)
+	}
+	if target == nil {
+		return nil, fmt.Errorf("target genre not found: %s", targetID)
+	}
+
+	var moved int64
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		var err error
+		moved, err = tx.MovieGenre.ReassignGenre(ctx, source.ID, target.ID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Genre.Delete(ctx, source.ID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, actorID, entity.AuditActionGenreMerge, entity.AuditTargetGenre, &target.ID,
+			map[string]any{
+				"merged_genre_id":   source.ID,
+				"merged_genre_name": source.Name,
+				"target_genre_name": target.Name,
+				"movies_moved":      moved,
+			}, "")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("merge genre %s into %s: %w", genreID, targetID, err)
+	}
+	s.feed.invalidate()
+
+	utils.LoggerFromContext(ctx, s.log).Info("Genre merged",
+		zap.String("genre_id", genreID),
+		zap.String("target_genre_id", targetID),
+		zap.String("admin_id", adminID),
+		zap.Int64("movies_moved", moved),
+	)
+
+	return &response.GenreMergeResponse{
+		Target:      response.GenreToResponse(target),
+		MergedGenre: response.GenreToResponse(source),
+		MoviesMoved: moved,
+	}, nil
+}
@@ -0,0 +1,368 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// giftCardCodeAlphabet tanpa 0/O dan 1/I supaya kode yang diketik ulang dari kartu fisik tidak salah baca.
+// 32 karakter: byte acak mod 32 tetap uniform.
+const giftCardCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const giftCardCodeLength = 16
+
+// GiftCardService admin menerbitkan kode bersaldo, user me-redeem kode ke akunnya.
+// Pemotongan saldo saat bayar ada di debitGiftBalance, dipanggil dari transaksi ProcessPayment.
+type GiftCardService interface {
+	IssueGiftCards(ctx context.Context, req *request.IssueGiftCardsRequest) ([]response.GiftCardResponse, error)
+	GetGiftCards(ctx context.Context, req *request.PaginatedRequest) (*response.PaginatedResponse[response.GiftCardResponse], error)
+	GetGiftCardByID(ctx context.Context, giftCardID string) (*response.GiftCardResponse, error)
+	// DeactivateGiftCard membekukan sisa saldo; payment yang sudah memakai saldo tidak terpengaruh
+	DeactivateGiftCard(ctx context.Context, giftCardID string) error
+
+	RedeemGiftCard(ctx context.Context, userID string, req *request.RedeemGiftCardRequest) (*response.GiftBalanceResponse, error)
+	GetGiftBalance(ctx context.Context, userID string) (*response.GiftBalanceResponse, error)
+}
+
+type giftCardService struct {
+	repo     *repository.Repository
+	currency utils.Currency
+	log      *zap.Logger
+}
+
+func NewGiftCardService(repo *repository.Repository, pricing utils.PricingConfig, log *zap.Logger) GiftCardService {
+	return &giftCardService{
+		repo:     repo,
+		currency: utils.CurrencyOf(pricing.Currency),
+		log:      log.With(zap.String("service", "gift_card")),
+	}
+}
+
+func (s *giftCardService) IssueGiftCards(ctx context.Context, req *request.IssueGiftCardsRequest) ([]response.GiftCardResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Issue gift cards validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	amount := s.currency.ToMinor(req.Amount)
+	if amount <= 0 {
+		return nil, fmt.Errorf("invalid amount: %.2f is below the smallest %s unit", req.Amount, s.currency.Code)
+	}
+
+	now := time.Now()
+	var expiresAt *time.Time
+	if req.ExpiresOn != nil {
+		date, _ := time.ParseInLocation("2006-01-02", *req.ExpiresOn, time.Local)
+		// Berlaku sampai akhir hari expires_on
+		end := date.AddDate(0, 0, 1)
+		if !end.After(now) {
+			return nil, fmt.Errorf("invalid expires_on: %s is in the past", *req.ExpiresOn)
+		}
+		expiresAt = &end
+	}
+
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	cards := make([]*entity.GiftCard, quantity)
+	for i := range cards {
+		code, err := generateGiftCardCode()
+		if err != nil {
+			return nil, fmt.Errorf("generate gift card code: %w", err)
+		}
+		cards[i] = &entity.GiftCard{
+			BaseNoDelete: entity.BaseNoDelete{
+				ID:        uuid.New(),
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			Code:          code,
+			InitialAmount: amount,
+			Balance:       amount,
+			Currency:      s.currency.Code,
+			ExpiresAt:     expiresAt,
+			IsActive:      true,
+		}
+	}
+
+	err := s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.GiftCard.CreateBatch(ctx, cards); err != nil {
+			return err
+		}
+		for _, card := range cards {
+			if err := postGiftCardIssue(ctx, tx, card); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("issue gift cards: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Gift cards issued",
+		zap.Int("quantity", quantity),
+		zap.Int64("amount", amount),
+		zap.String("currency", s.currency.Code),
+	)
+
+	result := make([]response.GiftCardResponse, len(cards))
+	for i, card := range cards {
+		result[i] = response.GiftCardToResponse(card, now)
+	}
+
+	return result, nil
+}
+
+func (s *giftCardService) GetGiftCards(ctx context.Context, req *request.PaginatedRequest) (*response.PaginatedResponse[response.GiftCardResponse], error) {
+	cards, err := s.repo.GiftCard.FindAll(ctx, req.Limit(), req.Offset())
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get gift cards", zap.Error(err))
+		return nil, fmt.Errorf("get gift cards: %w", err)
+	}
+
+	total, err := s.repo.GiftCard.CountAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count gift cards: %w", err)
+	}
+
+	now := time.Now()
+	result := make([]response.GiftCardResponse, len(cards))
+	for i, card := range cards {
+		result[i] = response.GiftCardToResponse(card, now)
+	}
+
+	return response.NewPaginatedResponse(result, req.Page, req.PerPage, total), nil
+}
+
+func (s *giftCardService) GetGiftCardByID(ctx context.Context, giftCardID string) (*response.GiftCardResponse, error) {
+	id, err := uuid.Parse(giftCardID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gift card ID format %s: %w", giftCardID, err)
+	}
+
+	card, err := s.repo.GiftCard.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find gift card: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("gift card %s not found", giftCardID)
+	}
+
+	resp := response.GiftCardToResponse(card, time.Now())
+	return &resp, nil
+}
+
+func (s *giftCardService) DeactivateGiftCard(ctx context.Context, giftCardID string) error {
+	id, err := uuid.Parse(giftCardID)
+	if err != nil {
+		return fmt.Errorf("invalid gift card ID format %s: %w", giftCardID, err)
+	}
+
+	return s.repo.GiftCard.Deactivate(ctx, id)
+}
+
+func (s *giftCardService) RedeemGiftCard(ctx context.Context, userID string, req *request.RedeemGiftCardRequest) (*response.GiftBalanceResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	code := normalizeGiftCardCode(req.Code)
+	now := time.Now()
+
+	var card *entity.GiftCard
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		card, err = tx.GiftCard.FindByCodeForUpdate(ctx, code)
+		if err != nil {
+			return err
+		}
+		// Pesan sama untuk kode yang tidak ada maupun milik akun lain, supaya kode tidak bisa ditebak
+		if card == nil || (card.UserID != nil && *card.UserID != userUUID) {
+			return fmt.Errorf("gift card not found")
+		}
+
+		switch {
+		case card.UserID != nil:
+			return fmt.Errorf("cannot redeem gift card: already redeemed to your account")
+		case !card.IsActive:
+			return fmt.Errorf("cannot redeem gift card: card is inactive")
+		case card.Expired(now):
+			return fmt.Errorf("cannot redeem gift card: card expired")
+		case card.Currency != s.currency.Code:
+			return fmt.Errorf("cannot redeem gift card: currency %s is not accepted", card.Currency)
+		}
+
+		return tx.GiftCard.Redeem(ctx, card.ID, userUUID, now)
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Gift card redeem failed",
+			zap.Error(err),
+			zap.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Gift card redeemed",
+		zap.String("gift_card_id", card.ID.String()),
+		zap.String("user_id", userID),
+		zap.Int64("balance", card.Balance),
+	)
+
+	return s.GetGiftBalance(ctx, userID)
+}
+
+func (s *giftCardService) GetGiftBalance(ctx context.Context, userID string) (*response.GiftBalanceResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	cards, err := s.repo.GiftCard.FindByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("get gift cards: %w", err)
+	}
+
+	now := time.Now()
+	var balance int64
+	result := &response.GiftBalanceResponse{
+		Currency: s.currency.Code,
+		Cards:    make([]response.UserGiftCardResponse, len(cards)),
+	}
+	for i, card := range cards {
+		result.Cards[i] = response.GiftCardToUserResponse(card, now)
+		if card.Currency == s.currency.Code && response.GiftCardStatus(card, now) == response.GiftCardStatusActive {
+			balance += card.Balance
+		}
+	}
+	result.Balance = s.currency.ToMajor(balance)
+	result.BalanceFormatted = s.currency.Format(balance)
+
+	return result, nil
+}
+
+// ==================== PAYMENT HELPERS ====================
+
+// debitGiftBalance memotong saldo gift card user untuk payment, paling banyak sebesar payment.Amount.
+// Dipanggil di dalam tx ProcessPayment setelah booking di-lock; payment.Amount dikurangi porsi gift
+// dan ledger yang dikembalikan harus ditulis setelah payment tersimpan.
+func debitGiftBalance(ctx context.Context, tx *repository.Repository, userID uuid.UUID, payment *entity.Payment, now time.Time) ([]*entity.GiftCardTransaction, error) {
+	cards, err := tx.GiftCard.FindUsableByUserForUpdate(ctx, userID, payment.Currency, now)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := payment.Amount
+	var ledger []*entity.GiftCardTransaction
+	for _, card := range cards {
+		if remaining == 0 {
+			break
+		}
+
+		take := min(card.Balance, remaining)
+		if err := tx.GiftCard.AdjustBalance(ctx, card.ID, -take); err != nil {
+			return nil, err
+		}
+		ledger = append(ledger, &entity.GiftCardTransaction{
+			BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+			GiftCardID: card.ID,
+			PaymentID:  &payment.ID,
+			Amount:     -take,
+		})
+		remaining -= take
+	}
+
+	if len(ledger) == 0 {
+		return nil, i18n.Errorf("booking.gift_balance_empty")
+	}
+
+	payment.GiftCardAmount = payment.Amount - remaining
+	payment.Amount = remaining
+	return ledger, nil
+}
+
+// refundGiftBalance mengembalikan saldo yang dipotong payment yang gagal / expired.
+// Dihitung dari net ledger per kartu, jadi aman kalau terpanggil lebih dari sekali;
+// panggilan kedua tidak mengembalikan apa-apa dan tidak memposting ke ledger.
+func refundGiftBalance(ctx context.Context, tx *repository.Repository, payment *entity.Payment, now time.Time) error {
+	if payment.GiftCardAmount == 0 {
+		return nil
+	}
+
+	transactions, err := tx.GiftCard.FindTransactionsByPaymentID(ctx, payment.ID)
+	if err != nil {
+		return err
+	}
+
+	net := make(map[uuid.UUID]int64)
+	var cardIDs []uuid.UUID
+	for _, transaction := range transactions {
+		if _, ok := net[transaction.GiftCardID]; !ok {
+			cardIDs = append(cardIDs, transaction.GiftCardID)
+		}
+		net[transaction.GiftCardID] += transaction.Amount
+	}
+
+	var refunds []*entity.GiftCardTransaction
+	var refunded int64
+	for _, cardID := range cardIDs {
+		if net[cardID] >= 0 {
+			continue
+		}
+		if err := tx.GiftCard.AdjustBalance(ctx, cardID, -net[cardID]); err != nil {
+			return err
+		}
+		refunds = append(refunds, &entity.GiftCardTransaction{
+			BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+			GiftCardID: cardID,
+			PaymentID:  &payment.ID,
+			Amount:     -net[cardID],
+		})
+		refunded -= net[cardID]
+	}
+
+	if err := tx.GiftCard.CreateTransactions(ctx, refunds); err != nil {
+		return err
+	}
+	return postPaymentGiftRelease(ctx, tx, payment, refunded, now)
+}
+
+// normalizeGiftCardCode accepts kode dengan spasi / tanda hubung / huruf kecil
+func normalizeGiftCardCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+func generateGiftCardCode() (string, error) {
+	buf := make([]byte, giftCardCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = giftCardCodeAlphabet[int(b)%len(giftCardCodeAlphabet)]
+	}
+	return string(buf), nil
+}
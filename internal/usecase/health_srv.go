@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/database"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// poolSaturatedRatio pool dianggap saturated kalau koneksi terpakai mencapai porsi MaxConns ini
+	poolSaturatedRatio = 0.9
+
+	readinessPingTimeout = 2 * time.Second
+)
+
+type HealthService interface {
+	// Readiness returns status database dan pool; ready false hanya kalau primary tidak bisa di-ping
+	Readiness(ctx context.Context) (*response.ReadinessResponse, bool)
+	PoolStats() []database.PoolStats
+	CleanupStats() []CleanupStats
+}
+
+type healthService struct {
+	repo    *repository.Repository
+	cleanup CleanupService
+	log     *zap.Logger
+}
+
+func NewHealthService(repo *repository.Repository, cleanup CleanupService, log *zap.Logger) HealthService {
+	return &healthService{
+		repo:    repo,
+		cleanup: cleanup,
+		log:     log.With(zap.String("service", "health")),
+	}
+}
+
+func (s *healthService) Readiness(ctx context.Context) (*response.ReadinessResponse, bool) {
+	pingCtx, cancel := context.WithTimeout(ctx, readinessPingTimeout)
+	defer cancel()
+
+	result := &response.ReadinessResponse{
+		Status: response.ReadinessReady,
+		Database: response.DatabaseReadiness{
+			Status: response.DatabaseUp,
+		},
+	}
+
+	if err := s.repo.Ping(pingCtx); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Readiness check: database ping failed", zap.Error(err))
+		result.Status = response.ReadinessNotReady
+		result.Database.Status = response.DatabaseDown
+	}
+
+	// Pool penuh atau replica down tidak membuat instance not ready: melepasnya dari load balancer
+	// saat ticket sale ramai hanya memindahkan beban ke instance lain yang sama penuhnya
+	for _, stats := range s.repo.PoolStats() {
+		pool := toPoolReadiness(stats)
+		if (pool.Saturated || !pool.Healthy) && result.Status == response.ReadinessReady {
+			result.Status = response.ReadinessDegraded
+		}
+		result.Database.Pools = append(result.Database.Pools, pool)
+	}
+
+	return result, result.Status != response.ReadinessNotReady
+}
+
+func (s *healthService) PoolStats() []database.PoolStats {
+	return s.repo.PoolStats()
+}
+
+func (s *healthService) CleanupStats() []CleanupStats {
+	return s.cleanup.Stats()
+}
+
+func toPoolReadiness(stats database.PoolStats) response.PoolReadiness {
+	saturation := stats.Saturation()
+	return response.PoolReadiness{
+		Pool:          stats.Pool,
+		Healthy:       stats.Healthy,
+		MaxConns:      stats.MaxConns,
+		TotalConns:    stats.TotalConns,
+		AcquiredConns: stats.AcquiredConns,
+		IdleConns:     stats.IdleConns,
+		Saturation:    saturation,
+		Saturated:     saturation >= poolSaturatedRatio,
+
+		EmptyAcquireCount:  stats.EmptyAcquireCount,
+		EmptyAcquireWaitMs: stats.EmptyAcquireWaitTime.Milliseconds(),
+	}
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// homeSectionLimit jumlah movie per section di home
+const homeSectionLimit = 10
+
+type HomeService interface {
+	// GetHome builds every section concurrently; viewerID kosong berarti anonymous
+	GetHome(ctx context.Context, viewerID string) (*response.HomeResponse, error)
+}
+
+type homeService struct {
+	movie   MovieService
+	booking BookingService
+	log     *zap.Logger
+}
+
+func NewHomeService(movie MovieService, booking BookingService, log *zap.Logger) HomeService {
+	return &homeService{
+		movie:   movie,
+		booking: booking,
+		log:     log.With(zap.String("service", "home")),
+	}
+}
+
+func (s *homeService) GetHome(ctx context.Context, viewerID string) (*response.HomeResponse, error) {
+	home := &response.HomeResponse{}
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		movies, err := s.moviesByStatus(gctx, entity.ReleaseStatusNowPlaying, viewerID)
+		if err != nil {
+			return fmt.Errorf("now playing: %w", err)
+		}
+		home.NowPlaying = movies
+		return nil
+	})
+
+	g.Go(func() error {
+		movies, err := s.moviesByStatus(gctx, entity.ReleaseStatusComingSoon, viewerID)
+		if err != nil {
+			return fmt.Errorf("coming soon: %w", err)
+		}
+		home.ComingSoon = movies
+		return nil
+	})
+
+	g.Go(func() error {
+		movies, err := s.movie.GetTopRatedMovies(gctx, homeSectionLimit, viewerID)
+		if err != nil {
+			return fmt.Errorf("top rated: %w", err)
+		}
+		home.TopRated = movies
+		return nil
+	})
+
+	if viewerID != "" {
+		g.Go(func() error {
+			// Booking section optional, gagal tidak menggagalkan home
+			booking, err := s.booking.GetNextUpcomingBooking(gctx, viewerID)
+			if err != nil {
+				utils.LoggerFromContext(ctx, s.log).Warn("Failed to get upcoming booking for home", zap.Error(err), zap.String("user_id", viewerID))
+				return nil
+			}
+			home.UpcomingBooking = booking
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to build home", zap.Error(err))
+		return nil, fmt.Errorf("get home: %w", err)
+	}
+
+	return home, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func (s *homeService) moviesByStatus(ctx context.Context, status entity.ReleaseStatus, viewerID string) ([]response.MovieResponse, error) {
+	releaseStatus := string(status)
+	req := &request.PaginatedRequest{Page: 1, PerPage: homeSectionLimit}
+
+	movies, err := s.movie.GetMovies(ctx, req, &releaseStatus, nil, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return movies.Data, nil
+}
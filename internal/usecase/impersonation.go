@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Impersonate creates session pendek atas nama user untuk debugging support. Session ditandai
+// impersonator_id sehingga setiap request-nya bisa dibedakan dari aksi user sendiri.
+func (s *authService) Impersonate(ctx context.Context, adminID, targetUserID string, req *request.ImpersonateRequest) (*response.ImpersonationResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	actorID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin ID format %s: %w", adminID, err)
+	}
+	targetID, err := uuid.Parse(targetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", targetUserID, err)
+	}
+	if actorID == targetID {
+		return nil, fmt.Errorf("cannot impersonate your own account")
+	}
+
+	target, err := s.repo.User.FindByID(ctx, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("find user %s: %w", targetUserID, err)
+	}
+	if target == nil {
+		return nil, fmt.Errorf("user %s not found", targetUserID)
+	}
+	// Admin lain tidak boleh ditiru: impersonation tidak boleh jadi jalan pintas naik hak akses
+	if target.Role == entity.RoleAdmin {
+		return nil, fmt.Errorf("cannot impersonate an admin account")
+	}
+	if !target.IsActive {
+		return nil, fmt.Errorf("cannot impersonate a deactivated account")
+	}
+
+	now := time.Now()
+	session := &entity.Session{
+		BaseSimple:     entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+		UserID:         target.ID,
+		Token:          uuid.New(),
+		ExpiresAt:      now.Add(time.Duration(s.config.JWT.ImpersonationMinutes) * time.Minute),
+		ImpersonatorID: &actorID,
+	}
+	if req.IPAddress != "" {
+		session.IPAddress = &req.IPAddress
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.Session.Create(ctx, session); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, actorID, entity.AuditActionImpersonationStart, entity.AuditTargetUser, &target.ID,
+			map[string]any{
+				"reason":     req.Reason,
+				"session_id": session.ID,
+				"expires_at": session.ExpiresAt,
+			}, req.IPAddress)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start impersonation of user %s: %w", targetUserID, err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Warn("Impersonation started",
+		zap.Bool("impersonated", true),
+		zap.String("impersonator_id", adminID),
+		zap.String("impersonated_user_id", targetUserID),
+		zap.String("session_id", session.ID.String()),
+		zap.Time("expires_at", session.ExpiresAt),
+	)
+
+	resp := response.ImpersonationToResponse(target, session)
+	return &resp, nil
+}
+
+// StopImpersonation revokes session impersonation yang sedang dipakai; session biasa ditolak
+// supaya endpoint ini tidak jadi logout kedua
+func (s *authService) StopImpersonation(ctx context.Context, token, ipAddress string) error {
+	session, err := s.repo.Session.FindValidSession(ctx, token)
+	if err != nil {
+		return fmt.Errorf("find session: %w", err)
+	}
+	if session == nil {
+		return fmt.Errorf("session not found or already expired")
+	}
+	if !session.IsImpersonation() {
+		return fmt.Errorf("cannot stop impersonation: current session is not an impersonation session")
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.Session.Revoke(ctx, token); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, *session.ImpersonatorID, entity.AuditActionImpersonationStop, entity.AuditTargetUser, &session.UserID,
+			map[string]any{
+				"session_id": session.ID,
+				"duration":   time.Since(session.CreatedAt).Round(time.Second).String(),
+			}, ipAddress)
+	})
+	if err != nil {
+		return fmt.Errorf("stop impersonation session %s: %w", session.ID.String(), err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Warn("Impersonation stopped",
+		zap.Bool("impersonated", true),
+		zap.String("impersonator_id", session.ImpersonatorID.String()),
+		zap.String("impersonated_user_id", session.UserID.String()),
+		zap.String("session_id", session.ID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Jenis job di antrian, format <service>.<pekerjaan>
+const (
+	jobVerificationOTP      = "auth.verification_otp"
+	jobBookingConfirmation  = "booking.confirmation"
+	jobPaymentExpiredNotice = "booking.payment_expired_notice"
+	jobDataExportBuild      = "data_export.build"
+)
+
+// jobHandler mengerjakan satu job; error berarti job dijadwalkan ulang (atau dead di percobaan terakhir).
+// Job bisa jalan lebih dari sekali (instance mati sebelum sempat menandai selesai), jadi handler harus
+// aman diulang.
+type jobHandler func(ctx context.Context, job *entity.Job) error
+
+// jobQueue registry handler per jenis job plus enqueue-nya. NewService membuat satu instance yang
+// sudah terisi sebelum JobService memulai worker: auth, booking dan data export mendaftarkan handler
+// job miliknya di constructor, JobService mengambil handler dari sini saat memproses job.
+type jobQueue struct {
+	handlers    map[string]jobHandler
+	maxAttempts int
+	log         *zap.Logger
+}
+
+func newJobQueue(config utils.JobConfig, log *zap.Logger) *jobQueue {
+	return &jobQueue{
+		handlers:    make(map[string]jobHandler),
+		maxAttempts: config.MaxAttempts,
+		log:         log.With(zap.String("component", "job_queue")),
+	}
+}
+
+// handle registers handler untuk satu jenis job; hanya dipanggil saat wiring, sebelum worker jalan
+func (q *jobQueue) handle(jobType string, handler jobHandler) {
+	if _, exists := q.handlers[jobType]; exists {
+		panic(fmt.Sprintf("job handler %s registered twice", jobType))
+	}
+	q.handlers[jobType] = handler
+}
+
+// enqueue menyimpan job baru lewat repo. Panggil dengan tx supaya job ikut commit bersama
+// perubahan yang memicunya dan tidak pernah jalan untuk perubahan yang di-rollback.
+func (q *jobQueue) enqueue(ctx context.Context, repo *repository.Repository, jobType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal %s job payload: %w", jobType, err)
+	}
+
+	now := time.Now()
+	job := &entity.Job{
+		BaseNoDelete: entity.BaseNoDelete{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+		Type:         jobType,
+		Payload:      data,
+		Status:       entity.JobStatusPending,
+		MaxAttempts:  q.maxAttempts,
+		RunAt:        now,
+	}
+	if err := repo.Job.Create(ctx, job); err != nil {
+		return err
+	}
+
+	utils.LoggerFromContext(ctx, q.log).Debug("Job enqueued",
+		zap.String("job_id", job.ID.String()),
+		zap.String("type", jobType),
+	)
+	return nil
+}
+
+// decodeJobPayload unmarshals payload job ke T
+func decodeJobPayload[T any](job *entity.Job) (T, error) {
+	var payload T
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return payload, fmt.Errorf("decode %s job payload: %w", job.Type, err)
+	}
+	return payload, nil
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// jobTimeout batas satu percobaan job, termasuk menyusun export data terbesar
+	jobTimeout = 10 * time.Minute
+	// jobLease lama job dikunci untuk satu worker; harus lebih lama dari jobTimeout supaya job
+	// yang masih jalan tidak diambil instance lain
+	jobLease = 15 * time.Minute
+	// jobRetryBaseDelay jeda sebelum retry pertama, berlipat dua tiap percobaan sampai jobRetryMaxDelay
+	jobRetryBaseDelay = 30 * time.Second
+	jobRetryMaxDelay  = time.Hour
+)
+
+// JobService menjalankan antrian job persistent dan endpoint status job untuk admin
+type JobService interface {
+	// ProcessDue mengerjakan job yang jatuh tempo satu per satu, maksimal batchSize per panggilan
+	ProcessDue(ctx context.Context) (int, error)
+
+	GetJobs(ctx context.Context, req *request.PaginatedRequest, filter *request.JobFilter) (*response.PaginatedResponse[response.JobResponse], error)
+	GetJob(ctx context.Context, jobID string) (*response.JobResponse, error)
+	GetJobStats(ctx context.Context) (*response.JobStatsResponse, error)
+	// RetryJob mengembalikan job dari dead letter ke antrian dengan jatah percobaan baru
+	RetryJob(ctx context.Context, adminID, jobID string) (*response.JobResponse, error)
+}
+
+type jobService struct {
+	repo      *repository.Repository
+	queue     *jobQueue
+	batchSize int
+	log       *zap.Logger
+}
+
+func NewJobService(repo *repository.Repository, queue *jobQueue, config utils.JobConfig, log *zap.Logger) JobService {
+	return &jobService{
+		repo:      repo,
+		queue:     queue,
+		batchSize: config.BatchSize,
+		log:       log.With(zap.String("service", "job")),
+	}
+}
+
+func (s *jobService) ProcessDue(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < s.batchSize && ctx.Err() == nil {
+		// Diambil satu per satu supaya lease dihitung sejak job mulai dikerjakan, bukan sejak batch diambil
+		now := time.Now()
+		jobs, err := s.repo.Job.ClaimDue(ctx, now, now.Add(jobLease), 1)
+		if err != nil {
+			return processed, fmt.Errorf("claim due jobs: %w", err)
+		}
+		if len(jobs) == 0 {
+			break
+		}
+
+		if err := s.finish(ctx, jobs[0], s.run(ctx, jobs[0])); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// run executes handler job dengan timeout sendiri; panic di handler dianggap kegagalan biasa
+func (s *jobService) run(ctx context.Context, job *entity.Job) (err error) {
+	handler, ok := s.queue.handlers[job.Type]
+	if !ok {
+		// Bisa jadi job dari versi lain yang sedang rolling deploy; dicoba lagi nanti
+		return fmt.Errorf("no handler registered for job type %s", job.Type)
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, jobTimeout)
+	defer cancel()
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panicked: %v", p)
+		}
+	}()
+
+	return handler(jobCtx, job)
+}
+
+// finish records hasil percobaan: selesai, dijadwalkan ulang dengan backoff, atau dead letter
+func (s *jobService) finish(ctx context.Context, job *entity.Job, runErr error) error {
+	log := utils.LoggerFromContext(ctx, s.log).With(
+		zap.String("job_id", job.ID.String()),
+		zap.String("type", job.Type),
+		zap.Int("attempt", job.Attempts),
+	)
+
+	now := time.Now()
+	if runErr == nil {
+		return s.repo.Job.MarkCompleted(ctx, job.ID, now)
+	}
+
+	if job.LastAttempt() {
+		log.Error("Job moved to dead letter", zap.Error(runErr))
+		return s.repo.Job.MarkFailed(ctx, job.ID, runErr.Error(), nil, now)
+	}
+
+	retryAt := now.Add(jobRetryDelay(job.Attempts))
+	log.Warn("Job failed, will retry", zap.Error(runErr), zap.Time("retry_at", retryAt))
+	return s.repo.Job.MarkFailed(ctx, job.ID, runErr.Error(), &retryAt, now)
+}
+
+// jobRetryDelay backoff eksponensial setelah percobaan ke-attempt gagal
+func jobRetryDelay(attempt int) time.Duration {
+	delay := jobRetryBaseDelay
+	for i := 1; i < attempt && delay < jobRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, jobRetryMaxDelay)
+}
+
+// ==================== ADMIN METHODS ====================
+
+func (s *jobService) GetJobs(ctx context.Context, req *request.PaginatedRequest, filter *request.JobFilter) (*response.PaginatedResponse[response.JobResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
+	var repoFilter repository.JobFilter
+	if filter.Type != "" {
+		repoFilter.Type = &filter.Type
+	}
+	if filter.Status != "" {
+		status := entity.JobStatus(filter.Status)
+		repoFilter.Status = &status
+	}
+
+	jobs, err := s.repo.Job.FindAll(ctx, repoFilter, req.Limit(), req.Offset())
+	if err != nil {
+		return nil, fmt.Errorf("get jobs: %w", err)
+	}
+
+	total, err := s.repo.Job.CountAll(ctx, repoFilter)
+	if err != nil {
+		return nil, fmt.Errorf("count jobs: %w", err)
+	}
+
+	result := make([]response.JobResponse, len(jobs))
+	for i, job := range jobs {
+		result[i] = response.JobToResponse(job)
+	}
+
+	return response.NewPaginatedResponse(result, req.Page, req.PerPage, total), nil
+}
+
+func (s *jobService) GetJob(ctx context.Context, jobID string) (*response.JobResponse, error) {
+	id, err := uuid.Parse(jobID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job ID format %s: %w", jobID, err)
+	}
+
+	job, err := s.repo.Job.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get job: %w", err)
+	}
+	if job == nil {
+		return nil, fmt.Errorf("job %s not found", jobID)
+	}
+
+	resp := response.JobToResponse(job)
+	return &resp, nil
+}
+
+func (s *jobService) GetJobStats(ctx context.Context) (*response.JobStatsResponse, error) {
+	counts, err := s.repo.Job.CountByStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get job stats: %w", err)
+	}
+
+	return &response.JobStatsResponse{
+		Pending:   counts[entity.JobStatusPending],
+		Running:   counts[entity.JobStatusRunning],
+		Completed: counts[entity.JobStatusCompleted],
+		Dead:      counts[entity.JobStatusDead],
+	}, nil
+}
+
+func (s *jobService) RetryJob(ctx context.Context, adminID, jobID string) (*response.JobResponse, error) {
+	actorID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin ID format %s: %w", adminID, err)
+	}
+	id, err := uuid.Parse(jobID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job ID format %s: %w", jobID, err)
+	}
+
+	job, err := s.repo.Job.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get job: %w", err)
+	}
+	if job == nil {
+		return nil, fmt.Errorf("job %s not found", jobID)
+	}
+	if job.Status != entity.JobStatusDead {
+		return nil, fmt.Errorf("cannot retry job %s: status is %s, only dead jobs can be retried", jobID, job.Status)
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.Job.Requeue(ctx, id, time.Now()); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, actorID, entity.AuditActionJobRetry, entity.AuditTargetJob, &id,
+			map[string]any{
+				"type":       job.Type,
+				"attempts":   job.Attempts,
+				"last_error": job.LastError,
+			}, "")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retry job %s: %w", jobID, err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Dead job requeued",
+		zap.String("job_id", jobID),
+		zap.String("type", job.Type),
+		zap.String("admin_id", adminID),
+	)
+
+	return s.GetJob(ctx, jobID)
+}
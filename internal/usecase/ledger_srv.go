@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ledgerCheckLimit batas baris per pengecekan; lebih dari ini biasanya satu bug yang sama
+const ledgerCheckLimit = 50
+
+// LedgerService read-only untuk admin dan job malam. Posting dilakukan lewat helper post* di bawah,
+// selalu di tx yang sama dengan perubahan payment / booking / gift card-nya.
+type LedgerService interface {
+	GetBalances(ctx context.Context) ([]response.LedgerBalanceResponse, error)
+	// CheckInvariants runs semua pengecekan; pelanggaran dilaporkan di response, error hanya untuk kegagalan database
+	CheckInvariants(ctx context.Context) (*response.LedgerCheckResponse, error)
+}
+
+type ledgerService struct {
+	repo *repository.Repository
+	log  *zap.Logger
+}
+
+func NewLedgerService(repo *repository.Repository, log *zap.Logger) LedgerService {
+	return &ledgerService{
+		repo: repo,
+		log:  log.With(zap.String("service", "ledger")),
+	}
+}
+
+func (s *ledgerService) GetBalances(ctx context.Context) ([]response.LedgerBalanceResponse, error) {
+	balances, err := s.repo.Ledger.GetAccountBalances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get ledger balances: %w", err)
+	}
+
+	result := make([]response.LedgerBalanceResponse, len(balances))
+	for i, balance := range balances {
+		result[i] = response.LedgerBalanceToResponse(balance)
+	}
+
+	return result, nil
+}
+
+func (s *ledgerService) CheckInvariants(ctx context.Context) (*response.LedgerCheckResponse, error) {
+	result := &response.LedgerCheckResponse{
+		CheckedAt:  time.Now(),
+		Violations: []response.LedgerViolationResponse{},
+	}
+
+	unbalanced, err := s.repo.Ledger.FindUnbalanced(ctx, ledgerCheckLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range unbalanced {
+		transactionID := row.TransactionID.String()
+		currency := utils.CurrencyOf(row.Currency)
+		result.Violations = append(result.Violations, response.LedgerViolationResponse{
+			Check:       response.LedgerCheckUnbalanced,
+			Currency:    row.Currency,
+			ReferenceID: &transactionID,
+			Expected:    currency.ToMajor(row.Debit),
+			Actual:      currency.ToMajor(row.Credit),
+			Message:     fmt.Sprintf("%s transaction for %s: debit %d != credit %d", row.Kind, row.ReferenceID, row.Debit, row.Credit),
+		})
+	}
+
+	checks := []struct {
+		name    string
+		message string
+		find    func(ctx context.Context) ([]*entity.LedgerMismatch, error)
+	}{
+		{response.LedgerCheckGiftCardLiability, "gift card balances do not match gift_card_liability",
+			s.repo.Ledger.FindGiftCardLiabilityMismatches},
+		{response.LedgerCheckPaymentClearing, "gift balance held by pending payments does not match payment_clearing",
+			s.repo.Ledger.FindPaymentClearingMismatches},
+		{response.LedgerCheckPaymentPosting, "completed payment is missing or differs in the ledger",
+			func(ctx context.Context) ([]*entity.LedgerMismatch, error) {
+				return s.repo.Ledger.FindPaymentMismatches(ctx, ledgerCheckLimit)
+			}},
+	}
+	for _, check := range checks {
+		mismatches, err := check.find(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, mismatch := range mismatches {
+			violation := response.LedgerViolationResponse{
+				Check:    check.name,
+				Currency: mismatch.Currency,
+				Expected: utils.CurrencyOf(mismatch.Currency).ToMajor(mismatch.Expected),
+				Actual:   utils.CurrencyOf(mismatch.Currency).ToMajor(mismatch.Posted),
+				Message:  check.message,
+			}
+			if mismatch.ReferenceID != nil {
+				referenceID := mismatch.ReferenceID.String()
+				violation.ReferenceID = &referenceID
+			}
+			result.Violations = append(result.Violations, violation)
+		}
+	}
+
+	result.OK = len(result.Violations) == 0
+	if result.OK {
+		utils.LoggerFromContext(ctx, s.log).Info("Ledger invariants hold")
+	}
+	for _, violation := range result.Violations {
+		utils.LoggerFromContext(ctx, s.log).Error("Ledger invariant violated",
+			zap.String("check", violation.Check),
+			zap.String("currency", violation.Currency),
+			zap.Stringp("reference_id", violation.ReferenceID),
+			zap.Float64("expected", violation.Expected),
+			zap.Float64("actual", violation.Actual),
+			zap.String("message", violation.Message),
+		)
+	}
+
+	return result, nil
+}
+
+// ==================== POSTING HELPERS ====================
+
+func newLedgerTransaction(kind entity.LedgerKind, referenceID uuid.UUID, bookingID *uuid.UUID, currency string, occurredAt time.Time) *entity.LedgerTransaction {
+	return &entity.LedgerTransaction{
+		BaseSimple:  entity.BaseSimple{ID: uuid.New(), CreatedAt: time.Now()},
+		Kind:        kind,
+		ReferenceID: referenceID,
+		BookingID:   bookingID,
+		Currency:    currency,
+		OccurredAt:  occurredAt,
+	}
+}
+
+// postLedger menolak transaksi yang tidak balance sebelum menyentuh database, jadi operasi
+// keuangan yang rincian harganya tidak konsisten ikut gagal. Transaksi tanpa entry (nominal nol) dilewati.
+func postLedger(ctx context.Context, tx *repository.Repository, transaction *entity.LedgerTransaction) error {
+	if len(transaction.Entries) == 0 {
+		return nil
+	}
+	if !transaction.Balanced() {
+		return fmt.Errorf("ledger %s transaction for %s is not balanced", transaction.Kind, transaction.ReferenceID)
+	}
+
+	_, err := tx.Ledger.Post(ctx, transaction)
+	return err
+}
+
+// postGiftCardIssue: gift card dianggap sudah dibayar saat diterbitkan, saldonya jadi kewajiban
+func postGiftCardIssue(ctx context.Context, tx *repository.Repository, card *entity.GiftCard) error {
+	transaction := newLedgerTransaction(entity.LedgerKindGiftCardIssue, card.ID, nil, card.Currency, card.CreatedAt).
+		Debit(entity.LedgerAccountCash, card.InitialAmount).
+		Credit(entity.LedgerAccountGiftCardLiability, card.InitialAmount)
+	return postLedger(ctx, tx, transaction)
+}
+
+// postPaymentGiftHold memindahkan saldo gift yang dipotong payment ke clearing sampai payment-nya final
+func postPaymentGiftHold(ctx context.Context, tx *repository.Repository, payment *entity.Payment, now time.Time) error {
+	transaction := newLedgerTransaction(entity.LedgerKindPaymentGiftHold, payment.ID, &payment.BookingID, payment.Currency, now).
+		Debit(entity.LedgerAccountGiftCardLiability, payment.GiftCardAmount).
+		Credit(entity.LedgerAccountPaymentClearing, payment.GiftCardAmount)
+	return postLedger(ctx, tx, transaction)
+}
+
+// postPaymentGiftRelease kebalikan hold untuk payment yang gagal / expired
+func postPaymentGiftRelease(ctx context.Context, tx *repository.Repository, payment *entity.Payment, amount int64, now time.Time) error {
+	transaction := newLedgerTransaction(entity.LedgerKindPaymentGiftRelease, payment.ID, &payment.BookingID, payment.Currency, now).
+		Debit(entity.LedgerAccountPaymentClearing, amount).
+		Credit(entity.LedgerAccountGiftCardLiability, amount)
+	return postLedger(ctx, tx, transaction)
+}
+
+// reconcilePaidCharges menyamakan rincian booking dengan nominal yang benar-benar ditagih payment
+// (payment method + gift) sebelum ledger diposting. Booking yang dibayar sebelum charge ikut disimpan saat
+// bayar masih menyimpan fee dari method waktu booking; selisihnya dicatat sebagai fee lalu disimpan,
+// supaya entry ledger balance dan refund memakai angka yang sama.
+func reconcilePaidCharges(ctx context.Context, tx *repository.Repository, booking *entity.Booking, payment *entity.Payment, log *zap.Logger) error {
+	charged := payment.Amount + payment.GiftCardAmount
+	if charged == booking.TotalPrice {
+		return nil
+	}
+
+	utils.LoggerFromContext(ctx, log).Warn("Payment amount does not match booking total, reconciling charges",
+		zap.String("booking_id", booking.ID.String()),
+		zap.String("payment_id", payment.ID.String()),
+		zap.Int64("booking_total", booking.TotalPrice),
+		zap.Int64("charged", charged),
+	)
+
+	fee := booking.FeeAmount + charged - booking.TotalPrice
+	if fee < 0 {
+		return fmt.Errorf("cannot reconcile payment %s: charged %d is below booking %s total %d without fee",
+			payment.ID, charged, booking.ID, booking.TotalPrice-booking.FeeAmount)
+	}
+
+	booking.FeeAmount = fee
+	booking.TotalPrice = charged
+	return tx.Booking.UpdateCharges(ctx, booking)
+}
+
+// postPaymentCompleted mengakui penjualan: uang masuk (payment method + gift yang ditahan) sama dengan
+// rincian harga booking, yaitu base - discount + fee + tax
+func postPaymentCompleted(ctx context.Context, tx *repository.Repository, booking *entity.Booking, payment *entity.Payment) error {
+	occurredAt := payment.UpdatedAt
+	if payment.PaidAt != nil {
+		occurredAt = *payment.PaidAt
+	}
+
+	transaction := newLedgerTransaction(entity.LedgerKindPaymentCompleted, payment.ID, &booking.ID, payment.Currency, occurredAt).
+		Debit(entity.LedgerAccountCash, payment.Amount).
+		Debit(entity.LedgerAccountPaymentClearing, payment.GiftCardAmount).
+		Debit(entity.LedgerAccountDiscounts, booking.DiscountAmount).
+		Credit(entity.LedgerAccountTicketRevenue, booking.BasePrice).
+		Credit(entity.LedgerAccountFeeRevenue, booking.FeeAmount).
+		Credit(entity.LedgerAccountTaxPayable, booking.TaxAmount)
+	return postLedger(ctx, tx, transaction)
+}
+
+// postBookingRefund membalik penjualan booking yang sudah dibayar lalu dibatalkan. Uangnya belum
+// keluar dari sistem ini, jadi dicatat sebagai refunds_payable sampai dibayar ke customer.
+// Yang dikembalikan adalah yang benar-benar ditagih lewat payment; panggil reconcilePaidCharges
+// dulu supaya rincian booking yang dibalik sama dengan nominal itu.
+func postBookingRefund(ctx context.Context, tx *repository.Repository, booking *entity.Booking, payment *entity.Payment, now time.Time) error {
+	transaction := newLedgerTransaction(entity.LedgerKindBookingRefund, booking.ID, &booking.ID, booking.Currency, now).
+		Debit(entity.LedgerAccountTicketRevenue, booking.BasePrice).
+		Debit(entity.LedgerAccountFeeRevenue, booking.FeeAmount).
+		Debit(entity.LedgerAccountTaxPayable, booking.TaxAmount).
+		Credit(entity.LedgerAccountDiscounts, booking.DiscountAmount).
+		Credit(entity.LedgerAccountRefundsPayable, payment.Amount+payment.GiftCardAmount)
+	return postLedger(ctx, tx, transaction)
+}
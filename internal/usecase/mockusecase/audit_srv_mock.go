@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit_srv.go -destination=mockusecase/audit_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditService is a mock of AuditService interface.
+type MockAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditServiceMockRecorder is the mock recorder for MockAuditService.
+type MockAuditServiceMockRecorder struct {
+	mock *MockAuditService
+}
+
+// NewMockAuditService creates a new mock instance.
+func NewMockAuditService(ctrl *gomock.Controller) *MockAuditService {
+	mock := &MockAuditService{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditService) EXPECT() *MockAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAuditLogs mocks base method.
+func (m *MockAuditService) GetAuditLogs(ctx context.Context, req *request.PaginatedRequest, filter *request.AuditLogFilter) (*response.PaginatedResponse[response.AuditLogResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogs", ctx, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.AuditLogResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogs indicates an expected call of GetAuditLogs.
+func (mr *MockAuditServiceMockRecorder) GetAuditLogs(ctx, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogs", reflect.TypeOf((*MockAuditService)(nil).GetAuditLogs), ctx, req, filter)
+}
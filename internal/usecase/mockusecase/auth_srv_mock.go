@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: auth_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=auth_srv.go -destination=mockusecase/auth_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthService is a mock of AuthService interface.
+type MockAuthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthServiceMockRecorder is the mock recorder for MockAuthService.
+type MockAuthServiceMockRecorder struct {
+	mock *MockAuthService
+}
+
+// NewMockAuthService creates a new mock instance.
+func NewMockAuthService(ctrl *gomock.Controller) *MockAuthService {
+	mock := &MockAuthService{ctrl: ctrl}
+	mock.recorder = &MockAuthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
+	return m.recorder
+}
+
+// Impersonate mocks base method.
+func (m *MockAuthService) Impersonate(ctx context.Context, adminID, targetUserID string, req *request.ImpersonateRequest) (*response.ImpersonationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Impersonate", ctx, adminID, targetUserID, req)
+	ret0, _ := ret[0].(*response.ImpersonationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Impersonate indicates an expected call of Impersonate.
+func (mr *MockAuthServiceMockRecorder) Impersonate(ctx, adminID, targetUserID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Impersonate", reflect.TypeOf((*MockAuthService)(nil).Impersonate), ctx, adminID, targetUserID, req)
+}
+
+// Login mocks base method.
+func (m *MockAuthService) Login(ctx context.Context, req *request.LoginRequest) (*response.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, req)
+	ret0, _ := ret[0].(*response.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAuthServiceMockRecorder) Login(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), ctx, req)
+}
+
+// Logout mocks base method.
+func (m *MockAuthService) Logout(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceMockRecorder) Logout(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), ctx, token)
+}
+
+// Register mocks base method.
+func (m *MockAuthService) Register(ctx context.Context, req *request.RegisterRequest) (*response.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, req)
+	ret0, _ := ret[0].(*response.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockAuthServiceMockRecorder) Register(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthService)(nil).Register), ctx, req)
+}
+
+// SendOTP mocks base method.
+func (m *MockAuthService) SendOTP(ctx context.Context, email, otpType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendOTP", ctx, email, otpType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendOTP indicates an expected call of SendOTP.
+func (mr *MockAuthServiceMockRecorder) SendOTP(ctx, email, otpType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOTP", reflect.TypeOf((*MockAuthService)(nil).SendOTP), ctx, email, otpType)
+}
+
+// StopImpersonation mocks base method.
+func (m *MockAuthService) StopImpersonation(ctx context.Context, token, ipAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopImpersonation", ctx, token, ipAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopImpersonation indicates an expected call of StopImpersonation.
+func (mr *MockAuthServiceMockRecorder) StopImpersonation(ctx, token, ipAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopImpersonation", reflect.TypeOf((*MockAuthService)(nil).StopImpersonation), ctx, token, ipAddress)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockAuthService) VerifyEmail(ctx context.Context, req *request.VerifyEmailRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthServiceMockRecorder) VerifyEmail(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthService)(nil).VerifyEmail), ctx, req)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: banner_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=banner_srv.go -destination=mockusecase/banner_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBannerService is a mock of BannerService interface.
+type MockBannerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBannerServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBannerServiceMockRecorder is the mock recorder for MockBannerService.
+type MockBannerServiceMockRecorder struct {
+	mock *MockBannerService
+}
+
+// NewMockBannerService creates a new mock instance.
+func NewMockBannerService(ctrl *gomock.Controller) *MockBannerService {
+	mock := &MockBannerService{ctrl: ctrl}
+	mock.recorder = &MockBannerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBannerService) EXPECT() *MockBannerServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateBanner mocks base method.
+func (m *MockBannerService) CreateBanner(ctx context.Context, req *request.BannerRequest) (*response.BannerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBanner", ctx, req)
+	ret0, _ := ret[0].(*response.BannerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBanner indicates an expected call of CreateBanner.
+func (mr *MockBannerServiceMockRecorder) CreateBanner(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBanner", reflect.TypeOf((*MockBannerService)(nil).CreateBanner), ctx, req)
+}
+
+// DeleteBanner mocks base method.
+func (m *MockBannerService) DeleteBanner(ctx context.Context, bannerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBanner", ctx, bannerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBanner indicates an expected call of DeleteBanner.
+func (mr *MockBannerServiceMockRecorder) DeleteBanner(ctx, bannerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBanner", reflect.TypeOf((*MockBannerService)(nil).DeleteBanner), ctx, bannerID)
+}
+
+// GetBannerByID mocks base method.
+func (m *MockBannerService) GetBannerByID(ctx context.Context, bannerID string) (*response.BannerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBannerByID", ctx, bannerID)
+	ret0, _ := ret[0].(*response.BannerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBannerByID indicates an expected call of GetBannerByID.
+func (mr *MockBannerServiceMockRecorder) GetBannerByID(ctx, bannerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBannerByID", reflect.TypeOf((*MockBannerService)(nil).GetBannerByID), ctx, bannerID)
+}
+
+// GetBanners mocks base method.
+func (m *MockBannerService) GetBanners(ctx context.Context) ([]response.BannerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBanners", ctx)
+	ret0, _ := ret[0].([]response.BannerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBanners indicates an expected call of GetBanners.
+func (mr *MockBannerServiceMockRecorder) GetBanners(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBanners", reflect.TypeOf((*MockBannerService)(nil).GetBanners), ctx)
+}
+
+// GetLiveBanners mocks base method.
+func (m *MockBannerService) GetLiveBanners(ctx context.Context, city string) ([]response.PublicBannerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLiveBanners", ctx, city)
+	ret0, _ := ret[0].([]response.PublicBannerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLiveBanners indicates an expected call of GetLiveBanners.
+func (mr *MockBannerServiceMockRecorder) GetLiveBanners(ctx, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiveBanners", reflect.TypeOf((*MockBannerService)(nil).GetLiveBanners), ctx, city)
+}
+
+// UpdateBanner mocks base method.
+func (m *MockBannerService) UpdateBanner(ctx context.Context, bannerID string, req *request.BannerRequest) (*response.BannerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBanner", ctx, bannerID, req)
+	ret0, _ := ret[0].(*response.BannerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBanner indicates an expected call of UpdateBanner.
+func (mr *MockBannerServiceMockRecorder) UpdateBanner(ctx, bannerID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBanner", reflect.TypeOf((*MockBannerService)(nil).UpdateBanner), ctx, bannerID, req)
+}
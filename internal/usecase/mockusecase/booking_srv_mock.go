@@ -0,0 +1,345 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=booking_srv.go -destination=mockusecase/booking_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookingService is a mock of BookingService interface.
+type MockBookingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBookingServiceMockRecorder is the mock recorder for MockBookingService.
+type MockBookingServiceMockRecorder struct {
+	mock *MockBookingService
+}
+
+// NewMockBookingService creates a new mock instance.
+func NewMockBookingService(ctrl *gomock.Controller) *MockBookingService {
+	mock := &MockBookingService{ctrl: ctrl}
+	mock.recorder = &MockBookingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingService) EXPECT() *MockBookingServiceMockRecorder {
+	return m.recorder
+}
+
+// AddBookingNote mocks base method.
+func (m *MockBookingService) AddBookingNote(ctx context.Context, authorID, bookingID string, req *request.CreateBookingNoteRequest) (*response.BookingNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookingNote", ctx, authorID, bookingID, req)
+	ret0, _ := ret[0].(*response.BookingNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBookingNote indicates an expected call of AddBookingNote.
+func (mr *MockBookingServiceMockRecorder) AddBookingNote(ctx, authorID, bookingID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookingNote", reflect.TypeOf((*MockBookingService)(nil).AddBookingNote), ctx, authorID, bookingID, req)
+}
+
+// CancelBooking mocks base method.
+func (m *MockBookingService) CancelBooking(ctx context.Context, bookingID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBooking", ctx, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelBooking indicates an expected call of CancelBooking.
+func (mr *MockBookingServiceMockRecorder) CancelBooking(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingService)(nil).CancelBooking), ctx, bookingID)
+}
+
+// CreateBooking mocks base method.
+func (m *MockBookingService) CreateBooking(ctx context.Context, userID string, req *request.CreateBookingRequest) (*response.BookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBooking", ctx, userID, req)
+	ret0, _ := ret[0].(*response.BookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBooking indicates an expected call of CreateBooking.
+func (mr *MockBookingServiceMockRecorder) CreateBooking(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBooking", reflect.TypeOf((*MockBookingService)(nil).CreateBooking), ctx, userID, req)
+}
+
+// CreateGroupBooking mocks base method.
+func (m *MockBookingService) CreateGroupBooking(ctx context.Context, adminID string, req *request.GroupBookingRequest) (*response.GroupBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupBooking", ctx, adminID, req)
+	ret0, _ := ret[0].(*response.GroupBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupBooking indicates an expected call of CreateGroupBooking.
+func (mr *MockBookingServiceMockRecorder) CreateGroupBooking(ctx, adminID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupBooking", reflect.TypeOf((*MockBookingService)(nil).CreateGroupBooking), ctx, adminID, req)
+}
+
+// ExpirePendingPayments mocks base method.
+func (m *MockBookingService) ExpirePendingPayments(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePendingPayments", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePendingPayments indicates an expected call of ExpirePendingPayments.
+func (mr *MockBookingServiceMockRecorder) ExpirePendingPayments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePendingPayments", reflect.TypeOf((*MockBookingService)(nil).ExpirePendingPayments), ctx)
+}
+
+// GetAllBookings mocks base method.
+func (m *MockBookingService) GetAllBookings(ctx context.Context, req *request.PaginatedRequest, filter *request.AdminBookingFilter) (*response.PaginatedResponse[response.BookingResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBookings", ctx, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.BookingResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllBookings indicates an expected call of GetAllBookings.
+func (mr *MockBookingServiceMockRecorder) GetAllBookings(ctx, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBookings", reflect.TypeOf((*MockBookingService)(nil).GetAllBookings), ctx, req, filter)
+}
+
+// GetBookingByID mocks base method.
+func (m *MockBookingService) GetBookingByID(ctx context.Context, bookingID string) (*response.BookingDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByID", ctx, bookingID)
+	ret0, _ := ret[0].(*response.BookingDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingByID indicates an expected call of GetBookingByID.
+func (mr *MockBookingServiceMockRecorder) GetBookingByID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByID", reflect.TypeOf((*MockBookingService)(nil).GetBookingByID), ctx, bookingID)
+}
+
+// GetBookingByOrderID mocks base method.
+func (m *MockBookingService) GetBookingByOrderID(ctx context.Context, orderID string) (*response.BookingDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByOrderID", ctx, orderID)
+	ret0, _ := ret[0].(*response.BookingDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingByOrderID indicates an expected call of GetBookingByOrderID.
+func (mr *MockBookingServiceMockRecorder) GetBookingByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByOrderID", reflect.TypeOf((*MockBookingService)(nil).GetBookingByOrderID), ctx, orderID)
+}
+
+// GetBookingNotes mocks base method.
+func (m *MockBookingService) GetBookingNotes(ctx context.Context, bookingID string) ([]response.BookingNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingNotes", ctx, bookingID)
+	ret0, _ := ret[0].([]response.BookingNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingNotes indicates an expected call of GetBookingNotes.
+func (mr *MockBookingServiceMockRecorder) GetBookingNotes(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingNotes", reflect.TypeOf((*MockBookingService)(nil).GetBookingNotes), ctx, bookingID)
+}
+
+// GetBookingReceipt mocks base method.
+func (m *MockBookingService) GetBookingReceipt(ctx context.Context, userID, bookingID string) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingReceipt", ctx, userID, bookingID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBookingReceipt indicates an expected call of GetBookingReceipt.
+func (mr *MockBookingServiceMockRecorder) GetBookingReceipt(ctx, userID, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingReceipt", reflect.TypeOf((*MockBookingService)(nil).GetBookingReceipt), ctx, userID, bookingID)
+}
+
+// GetNextUpcomingBooking mocks base method.
+func (m *MockBookingService) GetNextUpcomingBooking(ctx context.Context, userID string) (*response.BookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextUpcomingBooking", ctx, userID)
+	ret0, _ := ret[0].(*response.BookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextUpcomingBooking indicates an expected call of GetNextUpcomingBooking.
+func (mr *MockBookingServiceMockRecorder) GetNextUpcomingBooking(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextUpcomingBooking", reflect.TypeOf((*MockBookingService)(nil).GetNextUpcomingBooking), ctx, userID)
+}
+
+// GetPaymentMethods mocks base method.
+func (m *MockBookingService) GetPaymentMethods(ctx context.Context) ([]*response.PaymentMethodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentMethods", ctx)
+	ret0, _ := ret[0].([]*response.PaymentMethodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentMethods indicates an expected call of GetPaymentMethods.
+func (mr *MockBookingServiceMockRecorder) GetPaymentMethods(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentMethods", reflect.TypeOf((*MockBookingService)(nil).GetPaymentMethods), ctx)
+}
+
+// GetPaymentStatus mocks base method.
+func (m *MockBookingService) GetPaymentStatus(ctx context.Context, userID, paymentID string) (*response.PaymentStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentStatus", ctx, userID, paymentID)
+	ret0, _ := ret[0].(*response.PaymentStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentStatus indicates an expected call of GetPaymentStatus.
+func (mr *MockBookingServiceMockRecorder) GetPaymentStatus(ctx, userID, paymentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentStatus", reflect.TypeOf((*MockBookingService)(nil).GetPaymentStatus), ctx, userID, paymentID)
+}
+
+// GetUserBookingByOrderID mocks base method.
+func (m *MockBookingService) GetUserBookingByOrderID(ctx context.Context, userID, orderID string) (*response.BookingDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBookingByOrderID", ctx, userID, orderID)
+	ret0, _ := ret[0].(*response.BookingDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBookingByOrderID indicates an expected call of GetUserBookingByOrderID.
+func (mr *MockBookingServiceMockRecorder) GetUserBookingByOrderID(ctx, userID, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBookingByOrderID", reflect.TypeOf((*MockBookingService)(nil).GetUserBookingByOrderID), ctx, userID, orderID)
+}
+
+// GetUserBookings mocks base method.
+func (m *MockBookingService) GetUserBookings(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.BookingHistoryFilter) (*response.PaginatedResponse[response.BookingResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBookings", ctx, userID, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.BookingResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBookings indicates an expected call of GetUserBookings.
+func (mr *MockBookingServiceMockRecorder) GetUserBookings(ctx, userID, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBookings", reflect.TypeOf((*MockBookingService)(nil).GetUserBookings), ctx, userID, req, filter)
+}
+
+// HandlePaymentWebhook mocks base method.
+func (m *MockBookingService) HandlePaymentWebhook(ctx context.Context, req *request.PaymentWebhookRequest) (*response.PaymentStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandlePaymentWebhook", ctx, req)
+	ret0, _ := ret[0].(*response.PaymentStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandlePaymentWebhook indicates an expected call of HandlePaymentWebhook.
+func (mr *MockBookingServiceMockRecorder) HandlePaymentWebhook(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePaymentWebhook", reflect.TypeOf((*MockBookingService)(nil).HandlePaymentWebhook), ctx, req)
+}
+
+// ProcessPayment mocks base method.
+func (m *MockBookingService) ProcessPayment(ctx context.Context, userID string, req *request.ProcessPaymentRequest) (*response.PaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessPayment", ctx, userID, req)
+	ret0, _ := ret[0].(*response.PaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessPayment indicates an expected call of ProcessPayment.
+func (mr *MockBookingServiceMockRecorder) ProcessPayment(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessPayment", reflect.TypeOf((*MockBookingService)(nil).ProcessPayment), ctx, userID, req)
+}
+
+// SendShowReminders mocks base method.
+func (m *MockBookingService) SendShowReminders(ctx context.Context, lead time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendShowReminders", ctx, lead)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendShowReminders indicates an expected call of SendShowReminders.
+func (mr *MockBookingServiceMockRecorder) SendShowReminders(ctx, lead any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendShowReminders", reflect.TypeOf((*MockBookingService)(nil).SendShowReminders), ctx, lead)
+}
+
+// TransitionBooking mocks base method.
+func (m *MockBookingService) TransitionBooking(ctx context.Context, bookingID string, req *request.TransitionBookingRequest) (*response.BookingDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionBooking", ctx, bookingID, req)
+	ret0, _ := ret[0].(*response.BookingDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionBooking indicates an expected call of TransitionBooking.
+func (mr *MockBookingServiceMockRecorder) TransitionBooking(ctx, bookingID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionBooking", reflect.TypeOf((*MockBookingService)(nil).TransitionBooking), ctx, bookingID, req)
+}
+
+// UpdateBookingFlags mocks base method.
+func (m *MockBookingService) UpdateBookingFlags(ctx context.Context, adminID, bookingID string, req *request.UpdateBookingFlagsRequest) ([]entity.BookingFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBookingFlags", ctx, adminID, bookingID, req)
+	ret0, _ := ret[0].([]entity.BookingFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBookingFlags indicates an expected call of UpdateBookingFlags.
+func (mr *MockBookingServiceMockRecorder) UpdateBookingFlags(ctx, adminID, bookingID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingFlags", reflect.TypeOf((*MockBookingService)(nil).UpdateBookingFlags), ctx, adminID, bookingID, req)
+}
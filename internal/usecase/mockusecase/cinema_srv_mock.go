@@ -0,0 +1,236 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cinema_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cinema_srv.go -destination=mockusecase/cinema_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCinemaService is a mock of CinemaService interface.
+type MockCinemaService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCinemaServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCinemaServiceMockRecorder is the mock recorder for MockCinemaService.
+type MockCinemaServiceMockRecorder struct {
+	mock *MockCinemaService
+}
+
+// NewMockCinemaService creates a new mock instance.
+func NewMockCinemaService(ctrl *gomock.Controller) *MockCinemaService {
+	mock := &MockCinemaService{ctrl: ctrl}
+	mock.recorder = &MockCinemaServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCinemaService) EXPECT() *MockCinemaServiceMockRecorder {
+	return m.recorder
+}
+
+// BlockHallSeats mocks base method.
+func (m *MockCinemaService) BlockHallSeats(ctx context.Context, hallID string, req *request.SeatIDsRequest) ([]response.SeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockHallSeats", ctx, hallID, req)
+	ret0, _ := ret[0].([]response.SeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockHallSeats indicates an expected call of BlockHallSeats.
+func (mr *MockCinemaServiceMockRecorder) BlockHallSeats(ctx, hallID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockHallSeats", reflect.TypeOf((*MockCinemaService)(nil).BlockHallSeats), ctx, hallID, req)
+}
+
+// CheckCapacity mocks base method.
+func (m *MockCinemaService) CheckCapacity(ctx context.Context) (*response.CapacityCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCapacity", ctx)
+	ret0, _ := ret[0].(*response.CapacityCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckCapacity indicates an expected call of CheckCapacity.
+func (mr *MockCinemaServiceMockRecorder) CheckCapacity(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCapacity", reflect.TypeOf((*MockCinemaService)(nil).CheckCapacity), ctx)
+}
+
+// CloseCinema mocks base method.
+func (m *MockCinemaService) CloseCinema(ctx context.Context, adminID, cinemaID string, req *request.CloseCinemaRequest) (*response.CloseCinemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseCinema", ctx, adminID, cinemaID, req)
+	ret0, _ := ret[0].(*response.CloseCinemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseCinema indicates an expected call of CloseCinema.
+func (mr *MockCinemaServiceMockRecorder) CloseCinema(ctx, adminID, cinemaID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseCinema", reflect.TypeOf((*MockCinemaService)(nil).CloseCinema), ctx, adminID, cinemaID, req)
+}
+
+// CreateCinema mocks base method.
+func (m *MockCinemaService) CreateCinema(ctx context.Context, req *request.CinemaRequest) (*response.CinemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCinema", ctx, req)
+	ret0, _ := ret[0].(*response.CinemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCinema indicates an expected call of CreateCinema.
+func (mr *MockCinemaServiceMockRecorder) CreateCinema(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCinema", reflect.TypeOf((*MockCinemaService)(nil).CreateCinema), ctx, req)
+}
+
+// DeleteCinema mocks base method.
+func (m *MockCinemaService) DeleteCinema(ctx context.Context, cinemaID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCinema", ctx, cinemaID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCinema indicates an expected call of DeleteCinema.
+func (mr *MockCinemaServiceMockRecorder) DeleteCinema(ctx, cinemaID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCinema", reflect.TypeOf((*MockCinemaService)(nil).DeleteCinema), ctx, cinemaID)
+}
+
+// GetCinemaByID mocks base method.
+func (m *MockCinemaService) GetCinemaByID(ctx context.Context, cinemaID, date string) (*response.CinemaDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCinemaByID", ctx, cinemaID, date)
+	ret0, _ := ret[0].(*response.CinemaDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCinemaByID indicates an expected call of GetCinemaByID.
+func (mr *MockCinemaServiceMockRecorder) GetCinemaByID(ctx, cinemaID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCinemaByID", reflect.TypeOf((*MockCinemaService)(nil).GetCinemaByID), ctx, cinemaID, date)
+}
+
+// GetCinemas mocks base method.
+func (m *MockCinemaService) GetCinemas(ctx context.Context, req *request.PaginatedRequest, filter *request.CinemaListFilter) (*response.PaginatedResponse[response.CinemaResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCinemas", ctx, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.CinemaResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCinemas indicates an expected call of GetCinemas.
+func (mr *MockCinemaServiceMockRecorder) GetCinemas(ctx, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCinemas", reflect.TypeOf((*MockCinemaService)(nil).GetCinemas), ctx, req, filter)
+}
+
+// GetCities mocks base method.
+func (m *MockCinemaService) GetCities(ctx context.Context) ([]response.CityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCities", ctx)
+	ret0, _ := ret[0].([]response.CityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCities indicates an expected call of GetCities.
+func (mr *MockCinemaServiceMockRecorder) GetCities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCities", reflect.TypeOf((*MockCinemaService)(nil).GetCities), ctx)
+}
+
+// GetNearbyCinemas mocks base method.
+func (m *MockCinemaService) GetNearbyCinemas(ctx context.Context, req *request.NearbyCinemasRequest) ([]response.NearbyCinemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNearbyCinemas", ctx, req)
+	ret0, _ := ret[0].([]response.NearbyCinemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNearbyCinemas indicates an expected call of GetNearbyCinemas.
+func (mr *MockCinemaServiceMockRecorder) GetNearbyCinemas(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearbyCinemas", reflect.TypeOf((*MockCinemaService)(nil).GetNearbyCinemas), ctx, req)
+}
+
+// GetSeatAvailability mocks base method.
+func (m *MockCinemaService) GetSeatAvailability(ctx context.Context, cinemaID, dateStr, timeStr string) ([]*response.SeatAvailabilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeatAvailability", ctx, cinemaID, dateStr, timeStr)
+	ret0, _ := ret[0].([]*response.SeatAvailabilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeatAvailability indicates an expected call of GetSeatAvailability.
+func (mr *MockCinemaServiceMockRecorder) GetSeatAvailability(ctx, cinemaID, dateStr, timeStr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeatAvailability", reflect.TypeOf((*MockCinemaService)(nil).GetSeatAvailability), ctx, cinemaID, dateStr, timeStr)
+}
+
+// RestoreCinema mocks base method.
+func (m *MockCinemaService) RestoreCinema(ctx context.Context, cinemaID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCinema", ctx, cinemaID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreCinema indicates an expected call of RestoreCinema.
+func (mr *MockCinemaServiceMockRecorder) RestoreCinema(ctx, cinemaID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCinema", reflect.TypeOf((*MockCinemaService)(nil).RestoreCinema), ctx, cinemaID)
+}
+
+// UnblockHallSeats mocks base method.
+func (m *MockCinemaService) UnblockHallSeats(ctx context.Context, hallID string, req *request.SeatIDsRequest) ([]response.SeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockHallSeats", ctx, hallID, req)
+	ret0, _ := ret[0].([]response.SeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockHallSeats indicates an expected call of UnblockHallSeats.
+func (mr *MockCinemaServiceMockRecorder) UnblockHallSeats(ctx, hallID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockHallSeats", reflect.TypeOf((*MockCinemaService)(nil).UnblockHallSeats), ctx, hallID, req)
+}
+
+// UpdateCinema mocks base method.
+func (m *MockCinemaService) UpdateCinema(ctx context.Context, cinemaID string, req *request.CinemaUpdateRequest) (*response.CinemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCinema", ctx, cinemaID, req)
+	ret0, _ := ret[0].(*response.CinemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCinema indicates an expected call of UpdateCinema.
+func (mr *MockCinemaServiceMockRecorder) UpdateCinema(ctx, cinemaID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCinema", reflect.TypeOf((*MockCinemaService)(nil).UpdateCinema), ctx, cinemaID, req)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cleanup_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cleanup_srv.go -destination=mockusecase/cleanup_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	usecase "cinema-booking/internal/usecase"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCleanupService is a mock of CleanupService interface.
+type MockCleanupService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCleanupServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCleanupServiceMockRecorder is the mock recorder for MockCleanupService.
+type MockCleanupServiceMockRecorder struct {
+	mock *MockCleanupService
+}
+
+// NewMockCleanupService creates a new mock instance.
+func NewMockCleanupService(ctrl *gomock.Controller) *MockCleanupService {
+	mock := &MockCleanupService{ctrl: ctrl}
+	mock.recorder = &MockCleanupServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCleanupService) EXPECT() *MockCleanupServiceMockRecorder {
+	return m.recorder
+}
+
+// CleanCompletedJobs mocks base method.
+func (m *MockCleanupService) CleanCompletedJobs(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanCompletedJobs", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanCompletedJobs indicates an expected call of CleanCompletedJobs.
+func (mr *MockCleanupServiceMockRecorder) CleanCompletedJobs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanCompletedJobs", reflect.TypeOf((*MockCleanupService)(nil).CleanCompletedJobs), ctx)
+}
+
+// CleanExpiredOTPs mocks base method.
+func (m *MockCleanupService) CleanExpiredOTPs(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanExpiredOTPs", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanExpiredOTPs indicates an expected call of CleanExpiredOTPs.
+func (mr *MockCleanupServiceMockRecorder) CleanExpiredOTPs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanExpiredOTPs", reflect.TypeOf((*MockCleanupService)(nil).CleanExpiredOTPs), ctx)
+}
+
+// CleanExpiredSessions mocks base method.
+func (m *MockCleanupService) CleanExpiredSessions(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanExpiredSessions", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanExpiredSessions indicates an expected call of CleanExpiredSessions.
+func (mr *MockCleanupServiceMockRecorder) CleanExpiredSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanExpiredSessions", reflect.TypeOf((*MockCleanupService)(nil).CleanExpiredSessions), ctx)
+}
+
+// Stats mocks base method.
+func (m *MockCleanupService) Stats() []usecase.CleanupStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].([]usecase.CleanupStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockCleanupServiceMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockCleanupService)(nil).Stats))
+}
+
+// Tasks mocks base method.
+func (m *MockCleanupService) Tasks() []usecase.CleanupTask {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tasks")
+	ret0, _ := ret[0].([]usecase.CleanupTask)
+	return ret0
+}
+
+// Tasks indicates an expected call of Tasks.
+func (mr *MockCleanupServiceMockRecorder) Tasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tasks", reflect.TypeOf((*MockCleanupService)(nil).Tasks))
+}
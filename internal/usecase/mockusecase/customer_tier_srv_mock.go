@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: customer_tier_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=customer_tier_srv.go -destination=mockusecase/customer_tier_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomerTierService is a mock of CustomerTierService interface.
+type MockCustomerTierService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerTierServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomerTierServiceMockRecorder is the mock recorder for MockCustomerTierService.
+type MockCustomerTierServiceMockRecorder struct {
+	mock *MockCustomerTierService
+}
+
+// NewMockCustomerTierService creates a new mock instance.
+func NewMockCustomerTierService(ctrl *gomock.Controller) *MockCustomerTierService {
+	mock := &MockCustomerTierService{ctrl: ctrl}
+	mock.recorder = &MockCustomerTierServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerTierService) EXPECT() *MockCustomerTierServiceMockRecorder {
+	return m.recorder
+}
+
+// RecomputeTiers mocks base method.
+func (m *MockCustomerTierService) RecomputeTiers(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeTiers", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeTiers indicates an expected call of RecomputeTiers.
+func (mr *MockCustomerTierServiceMockRecorder) RecomputeTiers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeTiers", reflect.TypeOf((*MockCustomerTierService)(nil).RecomputeTiers), ctx)
+}
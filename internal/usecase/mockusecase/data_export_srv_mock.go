@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: data_export_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=data_export_srv.go -destination=mockusecase/data_export_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	usecase "cinema-booking/internal/usecase"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDataExportService is a mock of DataExportService interface.
+type MockDataExportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataExportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDataExportServiceMockRecorder is the mock recorder for MockDataExportService.
+type MockDataExportServiceMockRecorder struct {
+	mock *MockDataExportService
+}
+
+// NewMockDataExportService creates a new mock instance.
+func NewMockDataExportService(ctrl *gomock.Controller) *MockDataExportService {
+	mock := &MockDataExportService{ctrl: ctrl}
+	mock.recorder = &MockDataExportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataExportService) EXPECT() *MockDataExportServiceMockRecorder {
+	return m.recorder
+}
+
+// ExpireFiles mocks base method.
+func (m *MockDataExportService) ExpireFiles(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireFiles", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireFiles indicates an expected call of ExpireFiles.
+func (mr *MockDataExportServiceMockRecorder) ExpireFiles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireFiles", reflect.TypeOf((*MockDataExportService)(nil).ExpireFiles), ctx)
+}
+
+// GetDataExports mocks base method.
+func (m *MockDataExportService) GetDataExports(ctx context.Context, req *request.PaginatedRequest, filter *request.DataExportFilter) (*response.PaginatedResponse[response.DataExportResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataExports", ctx, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.DataExportResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataExports indicates an expected call of GetDataExports.
+func (mr *MockDataExportServiceMockRecorder) GetDataExports(ctx, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataExports", reflect.TypeOf((*MockDataExportService)(nil).GetDataExports), ctx, req, filter)
+}
+
+// OpenDownload mocks base method.
+func (m *MockDataExportService) OpenDownload(ctx context.Context, exportID, expires, signature string) (*usecase.DataExportFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDownload", ctx, exportID, expires, signature)
+	ret0, _ := ret[0].(*usecase.DataExportFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenDownload indicates an expected call of OpenDownload.
+func (mr *MockDataExportServiceMockRecorder) OpenDownload(ctx, exportID, expires, signature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDownload", reflect.TypeOf((*MockDataExportService)(nil).OpenDownload), ctx, exportID, expires, signature)
+}
+
+// RequestExport mocks base method.
+func (m *MockDataExportService) RequestExport(ctx context.Context, userID string, req *request.DataExportRequest) (*response.DataExportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestExport", ctx, userID, req)
+	ret0, _ := ret[0].(*response.DataExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestExport indicates an expected call of RequestExport.
+func (mr *MockDataExportServiceMockRecorder) RequestExport(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestExport", reflect.TypeOf((*MockDataExportService)(nil).RequestExport), ctx, userID, req)
+}
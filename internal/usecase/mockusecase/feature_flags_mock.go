@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: feature_flags.go
+//
+// Generated by this command:
+//
+//	mockgen -source=feature_flags.go -destination=mockusecase/feature_flags_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeatureFlagService is a mock of FeatureFlagService interface.
+type MockFeatureFlagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeatureFlagServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFeatureFlagServiceMockRecorder is the mock recorder for MockFeatureFlagService.
+type MockFeatureFlagServiceMockRecorder struct {
+	mock *MockFeatureFlagService
+}
+
+// NewMockFeatureFlagService creates a new mock instance.
+func NewMockFeatureFlagService(ctrl *gomock.Controller) *MockFeatureFlagService {
+	mock := &MockFeatureFlagService{ctrl: ctrl}
+	mock.recorder = &MockFeatureFlagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeatureFlagService) EXPECT() *MockFeatureFlagServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteFlag mocks base method.
+func (m *MockFeatureFlagService) DeleteFlag(ctx context.Context, adminID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlag", ctx, adminID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFlag indicates an expected call of DeleteFlag.
+func (mr *MockFeatureFlagServiceMockRecorder) DeleteFlag(ctx, adminID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlag", reflect.TypeOf((*MockFeatureFlagService)(nil).DeleteFlag), ctx, adminID, key)
+}
+
+// ListFlags mocks base method.
+func (m *MockFeatureFlagService) ListFlags(ctx context.Context) (*response.FeatureFlagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlags", ctx)
+	ret0, _ := ret[0].(*response.FeatureFlagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlags indicates an expected call of ListFlags.
+func (mr *MockFeatureFlagServiceMockRecorder) ListFlags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlags", reflect.TypeOf((*MockFeatureFlagService)(nil).ListFlags), ctx)
+}
+
+// UpdateFlag mocks base method.
+func (m *MockFeatureFlagService) UpdateFlag(ctx context.Context, adminID, key string, req *request.UpdateFeatureFlagRequest) (*response.FeatureFlagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFlag", ctx, adminID, key, req)
+	ret0, _ := ret[0].(*response.FeatureFlagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFlag indicates an expected call of UpdateFlag.
+func (mr *MockFeatureFlagServiceMockRecorder) UpdateFlag(ctx, adminID, key, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFlag", reflect.TypeOf((*MockFeatureFlagService)(nil).UpdateFlag), ctx, adminID, key, req)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: feed_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=feed_srv.go -destination=mockusecase/feed_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	feed "cinema-booking/pkg/feed"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedService is a mock of FeedService interface.
+type MockFeedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedServiceMockRecorder is the mock recorder for MockFeedService.
+type MockFeedServiceMockRecorder struct {
+	mock *MockFeedService
+}
+
+// NewMockFeedService creates a new mock instance.
+func NewMockFeedService(ctrl *gomock.Controller) *MockFeedService {
+	mock := &MockFeedService{ctrl: ctrl}
+	mock.recorder = &MockFeedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedService) EXPECT() *MockFeedServiceMockRecorder {
+	return m.recorder
+}
+
+// MovieFeed mocks base method.
+func (m *MockFeedService) MovieFeed(ctx context.Context, format feed.Format) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieFeed", ctx, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieFeed indicates an expected call of MovieFeed.
+func (mr *MockFeedServiceMockRecorder) MovieFeed(ctx, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieFeed", reflect.TypeOf((*MockFeedService)(nil).MovieFeed), ctx, format)
+}
+
+// Sitemap mocks base method.
+func (m *MockFeedService) Sitemap(ctx context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sitemap", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sitemap indicates an expected call of Sitemap.
+func (mr *MockFeedServiceMockRecorder) Sitemap(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sitemap", reflect.TypeOf((*MockFeedService)(nil).Sitemap), ctx)
+}
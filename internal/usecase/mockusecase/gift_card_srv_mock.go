@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: gift_card_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=gift_card_srv.go -destination=mockusecase/gift_card_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGiftCardService is a mock of GiftCardService interface.
+type MockGiftCardService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGiftCardServiceMockRecorder is the mock recorder for MockGiftCardService.
+type MockGiftCardServiceMockRecorder struct {
+	mock *MockGiftCardService
+}
+
+// NewMockGiftCardService creates a new mock instance.
+func NewMockGiftCardService(ctrl *gomock.Controller) *MockGiftCardService {
+	mock := &MockGiftCardService{ctrl: ctrl}
+	mock.recorder = &MockGiftCardServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardService) EXPECT() *MockGiftCardServiceMockRecorder {
+	return m.recorder
+}
+
+// DeactivateGiftCard mocks base method.
+func (m *MockGiftCardService) DeactivateGiftCard(ctx context.Context, giftCardID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateGiftCard", ctx, giftCardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateGiftCard indicates an expected call of DeactivateGiftCard.
+func (mr *MockGiftCardServiceMockRecorder) DeactivateGiftCard(ctx, giftCardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateGiftCard", reflect.TypeOf((*MockGiftCardService)(nil).DeactivateGiftCard), ctx, giftCardID)
+}
+
+// GetGiftBalance mocks base method.
+func (m *MockGiftCardService) GetGiftBalance(ctx context.Context, userID string) (*response.GiftBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftBalance", ctx, userID)
+	ret0, _ := ret[0].(*response.GiftBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftBalance indicates an expected call of GetGiftBalance.
+func (mr *MockGiftCardServiceMockRecorder) GetGiftBalance(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftBalance", reflect.TypeOf((*MockGiftCardService)(nil).GetGiftBalance), ctx, userID)
+}
+
+// GetGiftCardByID mocks base method.
+func (m *MockGiftCardService) GetGiftCardByID(ctx context.Context, giftCardID string) (*response.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftCardByID", ctx, giftCardID)
+	ret0, _ := ret[0].(*response.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftCardByID indicates an expected call of GetGiftCardByID.
+func (mr *MockGiftCardServiceMockRecorder) GetGiftCardByID(ctx, giftCardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftCardByID", reflect.TypeOf((*MockGiftCardService)(nil).GetGiftCardByID), ctx, giftCardID)
+}
+
+// GetGiftCards mocks base method.
+func (m *MockGiftCardService) GetGiftCards(ctx context.Context, req *request.PaginatedRequest) (*response.PaginatedResponse[response.GiftCardResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftCards", ctx, req)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.GiftCardResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftCards indicates an expected call of GetGiftCards.
+func (mr *MockGiftCardServiceMockRecorder) GetGiftCards(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftCards", reflect.TypeOf((*MockGiftCardService)(nil).GetGiftCards), ctx, req)
+}
+
+// IssueGiftCards mocks base method.
+func (m *MockGiftCardService) IssueGiftCards(ctx context.Context, req *request.IssueGiftCardsRequest) ([]response.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueGiftCards", ctx, req)
+	ret0, _ := ret[0].([]response.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueGiftCards indicates an expected call of IssueGiftCards.
+func (mr *MockGiftCardServiceMockRecorder) IssueGiftCards(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueGiftCards", reflect.TypeOf((*MockGiftCardService)(nil).IssueGiftCards), ctx, req)
+}
+
+// RedeemGiftCard mocks base method.
+func (m *MockGiftCardService) RedeemGiftCard(ctx context.Context, userID string, req *request.RedeemGiftCardRequest) (*response.GiftBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemGiftCard", ctx, userID, req)
+	ret0, _ := ret[0].(*response.GiftBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemGiftCard indicates an expected call of RedeemGiftCard.
+func (mr *MockGiftCardServiceMockRecorder) RedeemGiftCard(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemGiftCard", reflect.TypeOf((*MockGiftCardService)(nil).RedeemGiftCard), ctx, userID, req)
+}
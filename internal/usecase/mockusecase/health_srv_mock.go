@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: health_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=health_srv.go -destination=mockusecase/health_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	response "cinema-booking/internal/dto/response"
+	usecase "cinema-booking/internal/usecase"
+	database "cinema-booking/pkg/database"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHealthService is a mock of HealthService interface.
+type MockHealthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockHealthServiceMockRecorder is the mock recorder for MockHealthService.
+type MockHealthServiceMockRecorder struct {
+	mock *MockHealthService
+}
+
+// NewMockHealthService creates a new mock instance.
+func NewMockHealthService(ctrl *gomock.Controller) *MockHealthService {
+	mock := &MockHealthService{ctrl: ctrl}
+	mock.recorder = &MockHealthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthService) EXPECT() *MockHealthServiceMockRecorder {
+	return m.recorder
+}
+
+// CleanupStats mocks base method.
+func (m *MockHealthService) CleanupStats() []usecase.CleanupStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupStats")
+	ret0, _ := ret[0].([]usecase.CleanupStats)
+	return ret0
+}
+
+// CleanupStats indicates an expected call of CleanupStats.
+func (mr *MockHealthServiceMockRecorder) CleanupStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupStats", reflect.TypeOf((*MockHealthService)(nil).CleanupStats))
+}
+
+// PoolStats mocks base method.
+func (m *MockHealthService) PoolStats() []database.PoolStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PoolStats")
+	ret0, _ := ret[0].([]database.PoolStats)
+	return ret0
+}
+
+// PoolStats indicates an expected call of PoolStats.
+func (mr *MockHealthServiceMockRecorder) PoolStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolStats", reflect.TypeOf((*MockHealthService)(nil).PoolStats))
+}
+
+// Readiness mocks base method.
+func (m *MockHealthService) Readiness(ctx context.Context) (*response.ReadinessResponse, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Readiness", ctx)
+	ret0, _ := ret[0].(*response.ReadinessResponse)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Readiness indicates an expected call of Readiness.
+func (mr *MockHealthServiceMockRecorder) Readiness(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readiness", reflect.TypeOf((*MockHealthService)(nil).Readiness), ctx)
+}
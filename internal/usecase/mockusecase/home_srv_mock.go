@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: home_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=home_srv.go -destination=mockusecase/home_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHomeService is a mock of HomeService interface.
+type MockHomeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHomeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockHomeServiceMockRecorder is the mock recorder for MockHomeService.
+type MockHomeServiceMockRecorder struct {
+	mock *MockHomeService
+}
+
+// NewMockHomeService creates a new mock instance.
+func NewMockHomeService(ctrl *gomock.Controller) *MockHomeService {
+	mock := &MockHomeService{ctrl: ctrl}
+	mock.recorder = &MockHomeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHomeService) EXPECT() *MockHomeServiceMockRecorder {
+	return m.recorder
+}
+
+// GetHome mocks base method.
+func (m *MockHomeService) GetHome(ctx context.Context, viewerID string) (*response.HomeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHome", ctx, viewerID)
+	ret0, _ := ret[0].(*response.HomeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHome indicates an expected call of GetHome.
+func (mr *MockHomeServiceMockRecorder) GetHome(ctx, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHome", reflect.TypeOf((*MockHomeService)(nil).GetHome), ctx, viewerID)
+}
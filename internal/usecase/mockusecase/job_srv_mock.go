@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=job_srv.go -destination=mockusecase/job_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJobService is a mock of JobService interface.
+type MockJobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockJobServiceMockRecorder is the mock recorder for MockJobService.
+type MockJobServiceMockRecorder struct {
+	mock *MockJobService
+}
+
+// NewMockJobService creates a new mock instance.
+func NewMockJobService(ctrl *gomock.Controller) *MockJobService {
+	mock := &MockJobService{ctrl: ctrl}
+	mock.recorder = &MockJobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobService) EXPECT() *MockJobServiceMockRecorder {
+	return m.recorder
+}
+
+// GetJob mocks base method.
+func (m *MockJobService) GetJob(ctx context.Context, jobID string) (*response.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, jobID)
+	ret0, _ := ret[0].(*response.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockJobServiceMockRecorder) GetJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockJobService)(nil).GetJob), ctx, jobID)
+}
+
+// GetJobStats mocks base method.
+func (m *MockJobService) GetJobStats(ctx context.Context) (*response.JobStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStats", ctx)
+	ret0, _ := ret[0].(*response.JobStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStats indicates an expected call of GetJobStats.
+func (mr *MockJobServiceMockRecorder) GetJobStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStats", reflect.TypeOf((*MockJobService)(nil).GetJobStats), ctx)
+}
+
+// GetJobs mocks base method.
+func (m *MockJobService) GetJobs(ctx context.Context, req *request.PaginatedRequest, filter *request.JobFilter) (*response.PaginatedResponse[response.JobResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobs", ctx, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.JobResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobs indicates an expected call of GetJobs.
+func (mr *MockJobServiceMockRecorder) GetJobs(ctx, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockJobService)(nil).GetJobs), ctx, req, filter)
+}
+
+// ProcessDue mocks base method.
+func (m *MockJobService) ProcessDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessDue indicates an expected call of ProcessDue.
+func (mr *MockJobServiceMockRecorder) ProcessDue(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessDue", reflect.TypeOf((*MockJobService)(nil).ProcessDue), ctx)
+}
+
+// RetryJob mocks base method.
+func (m *MockJobService) RetryJob(ctx context.Context, adminID, jobID string) (*response.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryJob", ctx, adminID, jobID)
+	ret0, _ := ret[0].(*response.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryJob indicates an expected call of RetryJob.
+func (mr *MockJobServiceMockRecorder) RetryJob(ctx, adminID, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryJob", reflect.TypeOf((*MockJobService)(nil).RetryJob), ctx, adminID, jobID)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ledger_srv.go -destination=mockusecase/ledger_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerService is a mock of LedgerService interface.
+type MockLedgerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerServiceMockRecorder is the mock recorder for MockLedgerService.
+type MockLedgerServiceMockRecorder struct {
+	mock *MockLedgerService
+}
+
+// NewMockLedgerService creates a new mock instance.
+func NewMockLedgerService(ctrl *gomock.Controller) *MockLedgerService {
+	mock := &MockLedgerService{ctrl: ctrl}
+	mock.recorder = &MockLedgerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerService) EXPECT() *MockLedgerServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckInvariants mocks base method.
+func (m *MockLedgerService) CheckInvariants(ctx context.Context) (*response.LedgerCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInvariants", ctx)
+	ret0, _ := ret[0].(*response.LedgerCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckInvariants indicates an expected call of CheckInvariants.
+func (mr *MockLedgerServiceMockRecorder) CheckInvariants(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInvariants", reflect.TypeOf((*MockLedgerService)(nil).CheckInvariants), ctx)
+}
+
+// GetBalances mocks base method.
+func (m *MockLedgerService) GetBalances(ctx context.Context) ([]response.LedgerBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalances", ctx)
+	ret0, _ := ret[0].([]response.LedgerBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalances indicates an expected call of GetBalances.
+func (mr *MockLedgerServiceMockRecorder) GetBalances(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalances", reflect.TypeOf((*MockLedgerService)(nil).GetBalances), ctx)
+}
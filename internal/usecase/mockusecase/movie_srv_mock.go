@@ -0,0 +1,253 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: movie_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=movie_srv.go -destination=mockusecase/movie_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	export "cinema-booking/pkg/export"
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMovieService is a mock of MovieService interface.
+type MockMovieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMovieServiceMockRecorder is the mock recorder for MockMovieService.
+type MockMovieServiceMockRecorder struct {
+	mock *MockMovieService
+}
+
+// NewMockMovieService creates a new mock instance.
+func NewMockMovieService(ctrl *gomock.Controller) *MockMovieService {
+	mock := &MockMovieService{ctrl: ctrl}
+	mock.recorder = &MockMovieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieService) EXPECT() *MockMovieServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateMovie mocks base method.
+func (m *MockMovieService) CreateMovie(ctx context.Context, req *request.MovieRequest) (*response.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMovie", ctx, req)
+	ret0, _ := ret[0].(*response.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMovie indicates an expected call of CreateMovie.
+func (mr *MockMovieServiceMockRecorder) CreateMovie(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMovie", reflect.TypeOf((*MockMovieService)(nil).CreateMovie), ctx, req)
+}
+
+// DeleteMovie mocks base method.
+func (m *MockMovieService) DeleteMovie(ctx context.Context, movieID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMovie", ctx, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMovie indicates an expected call of DeleteMovie.
+func (mr *MockMovieServiceMockRecorder) DeleteMovie(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMovie", reflect.TypeOf((*MockMovieService)(nil).DeleteMovie), ctx, movieID)
+}
+
+// ExportMovies mocks base method.
+func (m *MockMovieService) ExportMovies(ctx context.Context, format export.Format, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMovies", ctx, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportMovies indicates an expected call of ExportMovies.
+func (mr *MockMovieServiceMockRecorder) ExportMovies(ctx, format, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMovies", reflect.TypeOf((*MockMovieService)(nil).ExportMovies), ctx, format, w)
+}
+
+// GetMovieByID mocks base method.
+func (m *MockMovieService) GetMovieByID(ctx context.Context, movieID, viewerID string, opts *request.MovieDetailRequest) (*response.MovieDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovieByID", ctx, movieID, viewerID, opts)
+	ret0, _ := ret[0].(*response.MovieDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovieByID indicates an expected call of GetMovieByID.
+func (mr *MockMovieServiceMockRecorder) GetMovieByID(ctx, movieID, viewerID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovieByID", reflect.TypeOf((*MockMovieService)(nil).GetMovieByID), ctx, movieID, viewerID, opts)
+}
+
+// GetMovieSchedules mocks base method.
+func (m *MockMovieService) GetMovieSchedules(ctx context.Context, movieID string) ([]response.ScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovieSchedules", ctx, movieID)
+	ret0, _ := ret[0].([]response.ScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovieSchedules indicates an expected call of GetMovieSchedules.
+func (mr *MockMovieServiceMockRecorder) GetMovieSchedules(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovieSchedules", reflect.TypeOf((*MockMovieService)(nil).GetMovieSchedules), ctx, movieID)
+}
+
+// GetMovies mocks base method.
+func (m *MockMovieService) GetMovies(ctx context.Context, req *request.PaginatedRequest, releaseStatus *string, filter *request.MovieListFilter, viewerID string) (*response.PaginatedResponse[response.MovieResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovies", ctx, req, releaseStatus, filter, viewerID)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.MovieResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovies indicates an expected call of GetMovies.
+func (mr *MockMovieServiceMockRecorder) GetMovies(ctx, req, releaseStatus, filter, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovies", reflect.TypeOf((*MockMovieService)(nil).GetMovies), ctx, req, releaseStatus, filter, viewerID)
+}
+
+// GetTopRatedMovies mocks base method.
+func (m *MockMovieService) GetTopRatedMovies(ctx context.Context, limit int, viewerID string) ([]response.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopRatedMovies", ctx, limit, viewerID)
+	ret0, _ := ret[0].([]response.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopRatedMovies indicates an expected call of GetTopRatedMovies.
+func (mr *MockMovieServiceMockRecorder) GetTopRatedMovies(ctx, limit, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopRatedMovies", reflect.TypeOf((*MockMovieService)(nil).GetTopRatedMovies), ctx, limit, viewerID)
+}
+
+// GetTrendingMovies mocks base method.
+func (m *MockMovieService) GetTrendingMovies(ctx context.Context, limit int) ([]response.TrendingMovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrendingMovies", ctx, limit)
+	ret0, _ := ret[0].([]response.TrendingMovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingMovies indicates an expected call of GetTrendingMovies.
+func (mr *MockMovieServiceMockRecorder) GetTrendingMovies(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingMovies", reflect.TypeOf((*MockMovieService)(nil).GetTrendingMovies), ctx, limit)
+}
+
+// ImportMovies mocks base method.
+func (m *MockMovieService) ImportMovies(ctx context.Context, r io.Reader, dryRun bool) (*response.MovieImportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportMovies", ctx, r, dryRun)
+	ret0, _ := ret[0].(*response.MovieImportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportMovies indicates an expected call of ImportMovies.
+func (mr *MockMovieServiceMockRecorder) ImportMovies(ctx, r, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMovies", reflect.TypeOf((*MockMovieService)(nil).ImportMovies), ctx, r, dryRun)
+}
+
+// MergeGenre mocks base method.
+func (m *MockMovieService) MergeGenre(ctx context.Context, adminID, genreID, targetID string) (*response.GenreMergeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGenre", ctx, adminID, genreID, targetID)
+	ret0, _ := ret[0].(*response.GenreMergeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeGenre indicates an expected call of MergeGenre.
+func (mr *MockMovieServiceMockRecorder) MergeGenre(ctx, adminID, genreID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGenre", reflect.TypeOf((*MockMovieService)(nil).MergeGenre), ctx, adminID, genreID, targetID)
+}
+
+// RestoreMovie mocks base method.
+func (m *MockMovieService) RestoreMovie(ctx context.Context, movieID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreMovie", ctx, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreMovie indicates an expected call of RestoreMovie.
+func (mr *MockMovieServiceMockRecorder) RestoreMovie(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMovie", reflect.TypeOf((*MockMovieService)(nil).RestoreMovie), ctx, movieID)
+}
+
+// SetReleaseStatus mocks base method.
+func (m *MockMovieService) SetReleaseStatus(ctx context.Context, movieID string, req *request.MovieReleaseStatusRequest) (*response.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReleaseStatus", ctx, movieID, req)
+	ret0, _ := ret[0].(*response.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReleaseStatus indicates an expected call of SetReleaseStatus.
+func (mr *MockMovieServiceMockRecorder) SetReleaseStatus(ctx, movieID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReleaseStatus", reflect.TypeOf((*MockMovieService)(nil).SetReleaseStatus), ctx, movieID, req)
+}
+
+// SyncReleaseStatuses mocks base method.
+func (m *MockMovieService) SyncReleaseStatuses(ctx context.Context) (int, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncReleaseStatuses", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SyncReleaseStatuses indicates an expected call of SyncReleaseStatuses.
+func (mr *MockMovieServiceMockRecorder) SyncReleaseStatuses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncReleaseStatuses", reflect.TypeOf((*MockMovieService)(nil).SyncReleaseStatuses), ctx)
+}
+
+// UpdateMovie mocks base method.
+func (m *MockMovieService) UpdateMovie(ctx context.Context, movieID string, req *request.MovieUpdateRequest) (*response.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMovie", ctx, movieID, req)
+	ret0, _ := ret[0].(*response.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMovie indicates an expected call of UpdateMovie.
+func (mr *MockMovieServiceMockRecorder) UpdateMovie(ctx, movieID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMovie", reflect.TypeOf((*MockMovieService)(nil).UpdateMovie), ctx, movieID, req)
+}
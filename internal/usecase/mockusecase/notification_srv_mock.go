@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_srv.go -destination=mockusecase/notification_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	usecase "cinema-booking/internal/usecase"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationService is a mock of NotificationService interface.
+type MockNotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationServiceMockRecorder is the mock recorder for MockNotificationService.
+type MockNotificationServiceMockRecorder struct {
+	mock *MockNotificationService
+}
+
+// NewMockNotificationService creates a new mock instance.
+func NewMockNotificationService(ctrl *gomock.Controller) *MockNotificationService {
+	mock := &MockNotificationService{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationService) EXPECT() *MockNotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSettings mocks base method.
+func (m *MockNotificationService) GetSettings(ctx context.Context, userID string) (*response.NotificationSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettings", ctx, userID)
+	ret0, _ := ret[0].(*response.NotificationSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettings indicates an expected call of GetSettings.
+func (mr *MockNotificationServiceMockRecorder) GetSettings(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettings", reflect.TypeOf((*MockNotificationService)(nil).GetSettings), ctx, userID)
+}
+
+// Notify mocks base method.
+func (m *MockNotificationService) Notify(ctx context.Context, userID uuid.UUID, category entity.NotificationCategory, compose usecase.MessageComposer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, userID, category, compose)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationServiceMockRecorder) Notify(ctx, userID, category, compose any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotificationService)(nil).Notify), ctx, userID, category, compose)
+}
+
+// RegisterDevice mocks base method.
+func (m *MockNotificationService) RegisterDevice(ctx context.Context, userID string, req *request.RegisterDeviceRequest) (*response.DeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDevice", ctx, userID, req)
+	ret0, _ := ret[0].(*response.DeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDevice indicates an expected call of RegisterDevice.
+func (mr *MockNotificationServiceMockRecorder) RegisterDevice(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDevice", reflect.TypeOf((*MockNotificationService)(nil).RegisterDevice), ctx, userID, req)
+}
+
+// UnregisterDevice mocks base method.
+func (m *MockNotificationService) UnregisterDevice(ctx context.Context, userID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterDevice", ctx, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnregisterDevice indicates an expected call of UnregisterDevice.
+func (mr *MockNotificationServiceMockRecorder) UnregisterDevice(ctx, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterDevice", reflect.TypeOf((*MockNotificationService)(nil).UnregisterDevice), ctx, userID, token)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockNotificationService) UpdateSettings(ctx context.Context, userID string, req *request.UpdateNotificationSettingsRequest) (*response.NotificationSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, userID, req)
+	ret0, _ := ret[0].(*response.NotificationSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockNotificationServiceMockRecorder) UpdateSettings(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockNotificationService)(nil).UpdateSettings), ctx, userID, req)
+}
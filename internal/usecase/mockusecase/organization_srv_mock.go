@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=organization_srv.go -destination=mockusecase/organization_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganizationService is a mock of OrganizationService interface.
+type MockOrganizationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganizationServiceMockRecorder is the mock recorder for MockOrganizationService.
+type MockOrganizationServiceMockRecorder struct {
+	mock *MockOrganizationService
+}
+
+// NewMockOrganizationService creates a new mock instance.
+func NewMockOrganizationService(ctrl *gomock.Controller) *MockOrganizationService {
+	mock := &MockOrganizationService{ctrl: ctrl}
+	mock.recorder = &MockOrganizationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationService) EXPECT() *MockOrganizationServiceMockRecorder {
+	return m.recorder
+}
+
+// AddAdmin mocks base method.
+func (m *MockOrganizationService) AddAdmin(ctx context.Context, organizationID string, req *request.OrganizationAdminRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAdmin", ctx, organizationID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAdmin indicates an expected call of AddAdmin.
+func (mr *MockOrganizationServiceMockRecorder) AddAdmin(ctx, organizationID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAdmin", reflect.TypeOf((*MockOrganizationService)(nil).AddAdmin), ctx, organizationID, req)
+}
+
+// AssignCinemas mocks base method.
+func (m *MockOrganizationService) AssignCinemas(ctx context.Context, organizationID string, req *request.OrganizationCinemasRequest) (*response.OrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCinemas", ctx, organizationID, req)
+	ret0, _ := ret[0].(*response.OrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignCinemas indicates an expected call of AssignCinemas.
+func (mr *MockOrganizationServiceMockRecorder) AssignCinemas(ctx, organizationID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCinemas", reflect.TypeOf((*MockOrganizationService)(nil).AssignCinemas), ctx, organizationID, req)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockOrganizationService) CreateOrganization(ctx context.Context, req *request.OrganizationRequest) (*response.OrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, req)
+	ret0, _ := ret[0].(*response.OrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockOrganizationServiceMockRecorder) CreateOrganization(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockOrganizationService)(nil).CreateOrganization), ctx, req)
+}
+
+// DeleteOrganization mocks base method.
+func (m *MockOrganizationService) DeleteOrganization(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganization indicates an expected call of DeleteOrganization.
+func (mr *MockOrganizationServiceMockRecorder) DeleteOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*MockOrganizationService)(nil).DeleteOrganization), ctx, organizationID)
+}
+
+// GetOrganizationByID mocks base method.
+func (m *MockOrganizationService) GetOrganizationByID(ctx context.Context, organizationID string) (*response.OrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationByID", ctx, organizationID)
+	ret0, _ := ret[0].(*response.OrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationByID indicates an expected call of GetOrganizationByID.
+func (mr *MockOrganizationServiceMockRecorder) GetOrganizationByID(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByID", reflect.TypeOf((*MockOrganizationService)(nil).GetOrganizationByID), ctx, organizationID)
+}
+
+// GetOrganizations mocks base method.
+func (m *MockOrganizationService) GetOrganizations(ctx context.Context) ([]response.OrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizations", ctx)
+	ret0, _ := ret[0].([]response.OrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizations indicates an expected call of GetOrganizations.
+func (mr *MockOrganizationServiceMockRecorder) GetOrganizations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*MockOrganizationService)(nil).GetOrganizations), ctx)
+}
+
+// ReleaseCinema mocks base method.
+func (m *MockOrganizationService) ReleaseCinema(ctx context.Context, organizationID, cinemaID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseCinema", ctx, organizationID, cinemaID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseCinema indicates an expected call of ReleaseCinema.
+func (mr *MockOrganizationServiceMockRecorder) ReleaseCinema(ctx, organizationID, cinemaID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseCinema", reflect.TypeOf((*MockOrganizationService)(nil).ReleaseCinema), ctx, organizationID, cinemaID)
+}
+
+// RemoveAdmin mocks base method.
+func (m *MockOrganizationService) RemoveAdmin(ctx context.Context, organizationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAdmin", ctx, organizationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAdmin indicates an expected call of RemoveAdmin.
+func (mr *MockOrganizationServiceMockRecorder) RemoveAdmin(ctx, organizationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAdmin", reflect.TypeOf((*MockOrganizationService)(nil).RemoveAdmin), ctx, organizationID, userID)
+}
+
+// UpdateOrganization mocks base method.
+func (m *MockOrganizationService) UpdateOrganization(ctx context.Context, organizationID string, req *request.OrganizationRequest) (*response.OrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganization", ctx, organizationID, req)
+	ret0, _ := ret[0].(*response.OrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganization indicates an expected call of UpdateOrganization.
+func (mr *MockOrganizationServiceMockRecorder) UpdateOrganization(ctx, organizationID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*MockOrganizationService)(nil).UpdateOrganization), ctx, organizationID, req)
+}
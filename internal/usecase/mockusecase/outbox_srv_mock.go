@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=outbox_srv.go -destination=mockusecase/outbox_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxService is a mock of OutboxService interface.
+type MockOutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxServiceMockRecorder is the mock recorder for MockOutboxService.
+type MockOutboxServiceMockRecorder struct {
+	mock *MockOutboxService
+}
+
+// NewMockOutboxService creates a new mock instance.
+func NewMockOutboxService(ctrl *gomock.Controller) *MockOutboxService {
+	mock := &MockOutboxService{ctrl: ctrl}
+	mock.recorder = &MockOutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxService) EXPECT() *MockOutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// RelayPendingEvents mocks base method.
+func (m *MockOutboxService) RelayPendingEvents(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayPendingEvents", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayPendingEvents indicates an expected call of RelayPendingEvents.
+func (mr *MockOutboxServiceMockRecorder) RelayPendingEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayPendingEvents", reflect.TypeOf((*MockOutboxService)(nil).RelayPendingEvents), ctx)
+}
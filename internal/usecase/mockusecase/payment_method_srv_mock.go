@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_method_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_method_srv.go -destination=mockusecase/payment_method_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentMethodService is a mock of PaymentMethodService interface.
+type MockPaymentMethodService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentMethodServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentMethodServiceMockRecorder is the mock recorder for MockPaymentMethodService.
+type MockPaymentMethodServiceMockRecorder struct {
+	mock *MockPaymentMethodService
+}
+
+// NewMockPaymentMethodService creates a new mock instance.
+func NewMockPaymentMethodService(ctrl *gomock.Controller) *MockPaymentMethodService {
+	mock := &MockPaymentMethodService{ctrl: ctrl}
+	mock.recorder = &MockPaymentMethodServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentMethodService) EXPECT() *MockPaymentMethodServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePaymentMethod mocks base method.
+func (m *MockPaymentMethodService) CreatePaymentMethod(ctx context.Context, req *request.PaymentMethodRequest) (*response.PaymentMethodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentMethod", ctx, req)
+	ret0, _ := ret[0].(*response.PaymentMethodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentMethod indicates an expected call of CreatePaymentMethod.
+func (mr *MockPaymentMethodServiceMockRecorder) CreatePaymentMethod(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentMethod", reflect.TypeOf((*MockPaymentMethodService)(nil).CreatePaymentMethod), ctx, req)
+}
+
+// DeletePaymentMethod mocks base method.
+func (m *MockPaymentMethodService) DeletePaymentMethod(ctx context.Context, paymentMethodID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePaymentMethod", ctx, paymentMethodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePaymentMethod indicates an expected call of DeletePaymentMethod.
+func (mr *MockPaymentMethodServiceMockRecorder) DeletePaymentMethod(ctx, paymentMethodID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaymentMethod", reflect.TypeOf((*MockPaymentMethodService)(nil).DeletePaymentMethod), ctx, paymentMethodID)
+}
+
+// GetPaymentMethodByID mocks base method.
+func (m *MockPaymentMethodService) GetPaymentMethodByID(ctx context.Context, paymentMethodID string) (*response.PaymentMethodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentMethodByID", ctx, paymentMethodID)
+	ret0, _ := ret[0].(*response.PaymentMethodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentMethodByID indicates an expected call of GetPaymentMethodByID.
+func (mr *MockPaymentMethodServiceMockRecorder) GetPaymentMethodByID(ctx, paymentMethodID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentMethodByID", reflect.TypeOf((*MockPaymentMethodService)(nil).GetPaymentMethodByID), ctx, paymentMethodID)
+}
+
+// GetPaymentMethods mocks base method.
+func (m *MockPaymentMethodService) GetPaymentMethods(ctx context.Context) ([]response.PaymentMethodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentMethods", ctx)
+	ret0, _ := ret[0].([]response.PaymentMethodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentMethods indicates an expected call of GetPaymentMethods.
+func (mr *MockPaymentMethodServiceMockRecorder) GetPaymentMethods(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentMethods", reflect.TypeOf((*MockPaymentMethodService)(nil).GetPaymentMethods), ctx)
+}
+
+// UpdatePaymentMethod mocks base method.
+func (m *MockPaymentMethodService) UpdatePaymentMethod(ctx context.Context, paymentMethodID string, req *request.PaymentMethodUpdateRequest) (*response.PaymentMethodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentMethod", ctx, paymentMethodID, req)
+	ret0, _ := ret[0].(*response.PaymentMethodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentMethod indicates an expected call of UpdatePaymentMethod.
+func (mr *MockPaymentMethodServiceMockRecorder) UpdatePaymentMethod(ctx, paymentMethodID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentMethod", reflect.TypeOf((*MockPaymentMethodService)(nil).UpdatePaymentMethod), ctx, paymentMethodID, req)
+}
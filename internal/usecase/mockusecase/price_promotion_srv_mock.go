@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: price_promotion_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=price_promotion_srv.go -destination=mockusecase/price_promotion_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPricePromotionService is a mock of PricePromotionService interface.
+type MockPricePromotionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricePromotionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPricePromotionServiceMockRecorder is the mock recorder for MockPricePromotionService.
+type MockPricePromotionServiceMockRecorder struct {
+	mock *MockPricePromotionService
+}
+
+// NewMockPricePromotionService creates a new mock instance.
+func NewMockPricePromotionService(ctrl *gomock.Controller) *MockPricePromotionService {
+	mock := &MockPricePromotionService{ctrl: ctrl}
+	mock.recorder = &MockPricePromotionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricePromotionService) EXPECT() *MockPricePromotionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePromotion mocks base method.
+func (m *MockPricePromotionService) CreatePromotion(ctx context.Context, req *request.PricePromotionRequest) (*response.PricePromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", ctx, req)
+	ret0, _ := ret[0].(*response.PricePromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockPricePromotionServiceMockRecorder) CreatePromotion(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockPricePromotionService)(nil).CreatePromotion), ctx, req)
+}
+
+// DeletePromotion mocks base method.
+func (m *MockPricePromotionService) DeletePromotion(ctx context.Context, promotionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePromotion", ctx, promotionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePromotion indicates an expected call of DeletePromotion.
+func (mr *MockPricePromotionServiceMockRecorder) DeletePromotion(ctx, promotionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePromotion", reflect.TypeOf((*MockPricePromotionService)(nil).DeletePromotion), ctx, promotionID)
+}
+
+// GetPromotionByID mocks base method.
+func (m *MockPricePromotionService) GetPromotionByID(ctx context.Context, promotionID string) (*response.PricePromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotionByID", ctx, promotionID)
+	ret0, _ := ret[0].(*response.PricePromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotionByID indicates an expected call of GetPromotionByID.
+func (mr *MockPricePromotionServiceMockRecorder) GetPromotionByID(ctx, promotionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotionByID", reflect.TypeOf((*MockPricePromotionService)(nil).GetPromotionByID), ctx, promotionID)
+}
+
+// GetPromotions mocks base method.
+func (m *MockPricePromotionService) GetPromotions(ctx context.Context) ([]response.PricePromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotions", ctx)
+	ret0, _ := ret[0].([]response.PricePromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotions indicates an expected call of GetPromotions.
+func (mr *MockPricePromotionServiceMockRecorder) GetPromotions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotions", reflect.TypeOf((*MockPricePromotionService)(nil).GetPromotions), ctx)
+}
+
+// UpdatePromotion mocks base method.
+func (m *MockPricePromotionService) UpdatePromotion(ctx context.Context, promotionID string, req *request.PricePromotionRequest) (*response.PricePromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePromotion", ctx, promotionID, req)
+	ret0, _ := ret[0].(*response.PricePromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePromotion indicates an expected call of UpdatePromotion.
+func (mr *MockPricePromotionServiceMockRecorder) UpdatePromotion(ctx, promotionID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromotion", reflect.TypeOf((*MockPricePromotionService)(nil).UpdatePromotion), ctx, promotionID, req)
+}
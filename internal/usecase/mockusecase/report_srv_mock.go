@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=report_srv.go -destination=mockusecase/report_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	export "cinema-booking/pkg/export"
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportService is a mock of ReportService interface.
+type MockReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReportServiceMockRecorder is the mock recorder for MockReportService.
+type MockReportServiceMockRecorder struct {
+	mock *MockReportService
+}
+
+// NewMockReportService creates a new mock instance.
+func NewMockReportService(ctrl *gomock.Controller) *MockReportService {
+	mock := &MockReportService{ctrl: ctrl}
+	mock.recorder = &MockReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportService) EXPECT() *MockReportServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportBookings mocks base method.
+func (m *MockReportService) ExportBookings(ctx context.Context, req *request.ExportBookingsRequest, format export.Format, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBookings", ctx, req, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportBookings indicates an expected call of ExportBookings.
+func (mr *MockReportServiceMockRecorder) ExportBookings(ctx, req, format, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBookings", reflect.TypeOf((*MockReportService)(nil).ExportBookings), ctx, req, format, w)
+}
+
+// ExportSalesReport mocks base method.
+func (m *MockReportService) ExportSalesReport(ctx context.Context, req *request.SalesReportRequest, format export.Format, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSalesReport", ctx, req, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportSalesReport indicates an expected call of ExportSalesReport.
+func (mr *MockReportServiceMockRecorder) ExportSalesReport(ctx, req, format, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSalesReport", reflect.TypeOf((*MockReportService)(nil).ExportSalesReport), ctx, req, format, w)
+}
+
+// GetFunnelReport mocks base method.
+func (m *MockReportService) GetFunnelReport(ctx context.Context, req *request.FunnelReportRequest) (*response.FunnelReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFunnelReport", ctx, req)
+	ret0, _ := ret[0].(*response.FunnelReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFunnelReport indicates an expected call of GetFunnelReport.
+func (mr *MockReportServiceMockRecorder) GetFunnelReport(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunnelReport", reflect.TypeOf((*MockReportService)(nil).GetFunnelReport), ctx, req)
+}
+
+// GetOccupancyReport mocks base method.
+func (m *MockReportService) GetOccupancyReport(ctx context.Context, req *request.OccupancyReportRequest) (*response.OccupancyReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOccupancyReport", ctx, req)
+	ret0, _ := ret[0].(*response.OccupancyReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOccupancyReport indicates an expected call of GetOccupancyReport.
+func (mr *MockReportServiceMockRecorder) GetOccupancyReport(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOccupancyReport", reflect.TypeOf((*MockReportService)(nil).GetOccupancyReport), ctx, req)
+}
+
+// GetPaymentReport mocks base method.
+func (m *MockReportService) GetPaymentReport(ctx context.Context, req *request.PaymentReportRequest) (*response.PaymentReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentReport", ctx, req)
+	ret0, _ := ret[0].(*response.PaymentReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentReport indicates an expected call of GetPaymentReport.
+func (mr *MockReportServiceMockRecorder) GetPaymentReport(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentReport", reflect.TypeOf((*MockReportService)(nil).GetPaymentReport), ctx, req)
+}
+
+// GetSalesReport mocks base method.
+func (m *MockReportService) GetSalesReport(ctx context.Context, req *request.SalesReportRequest) (*response.SalesReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalesReport", ctx, req)
+	ret0, _ := ret[0].(*response.SalesReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalesReport indicates an expected call of GetSalesReport.
+func (mr *MockReportServiceMockRecorder) GetSalesReport(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalesReport", reflect.TypeOf((*MockReportService)(nil).GetSalesReport), ctx, req)
+}
+
+// GetTodaySummary mocks base method.
+func (m *MockReportService) GetTodaySummary(ctx context.Context) (*response.SalesSummaryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodaySummary", ctx)
+	ret0, _ := ret[0].(*response.SalesSummaryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodaySummary indicates an expected call of GetTodaySummary.
+func (mr *MockReportServiceMockRecorder) GetTodaySummary(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodaySummary", reflect.TypeOf((*MockReportService)(nil).GetTodaySummary), ctx)
+}
+
+// ReconcilePayments mocks base method.
+func (m *MockReportService) ReconcilePayments(ctx context.Context, req *request.ReconcilePaymentsRequest, settlement io.Reader) (*response.PaymentReconciliationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcilePayments", ctx, req, settlement)
+	ret0, _ := ret[0].(*response.PaymentReconciliationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcilePayments indicates an expected call of ReconcilePayments.
+func (mr *MockReportServiceMockRecorder) ReconcilePayments(ctx, req, settlement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcilePayments", reflect.TypeOf((*MockReportService)(nil).ReconcilePayments), ctx, req, settlement)
+}
@@ -0,0 +1,263 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_srv.go -destination=mockusecase/review_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewService is a mock of ReviewService interface.
+type MockReviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewServiceMockRecorder is the mock recorder for MockReviewService.
+type MockReviewServiceMockRecorder struct {
+	mock *MockReviewService
+}
+
+// NewMockReviewService creates a new mock instance.
+func NewMockReviewService(ctrl *gomock.Controller) *MockReviewService {
+	mock := &MockReviewService{ctrl: ctrl}
+	mock.recorder = &MockReviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewService) EXPECT() *MockReviewServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewService) CreateReview(ctx context.Context, userID string, req *request.CreateReviewRequest) (*response.ReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, userID, req)
+	ret0, _ := ret[0].(*response.ReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewServiceMockRecorder) CreateReview(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewService)(nil).CreateReview), ctx, userID, req)
+}
+
+// DeleteReply mocks base method.
+func (m *MockReviewService) DeleteReply(ctx context.Context, reviewID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReply", ctx, reviewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReply indicates an expected call of DeleteReply.
+func (mr *MockReviewServiceMockRecorder) DeleteReply(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReply", reflect.TypeOf((*MockReviewService)(nil).DeleteReply), ctx, reviewID)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewService) DeleteReview(ctx context.Context, reviewID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, reviewID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewServiceMockRecorder) DeleteReview(ctx, reviewID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewService)(nil).DeleteReview), ctx, reviewID, userID)
+}
+
+// GetMovieReviewStats mocks base method.
+func (m *MockReviewService) GetMovieReviewStats(ctx context.Context, movieID string) (*response.MovieReviewStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovieReviewStats", ctx, movieID)
+	ret0, _ := ret[0].(*response.MovieReviewStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovieReviewStats indicates an expected call of GetMovieReviewStats.
+func (mr *MockReviewServiceMockRecorder) GetMovieReviewStats(ctx, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovieReviewStats", reflect.TypeOf((*MockReviewService)(nil).GetMovieReviewStats), ctx, movieID)
+}
+
+// GetMovieReviews mocks base method.
+func (m *MockReviewService) GetMovieReviews(ctx context.Context, movieID string, req *request.PaginatedRequest, filter *request.ReviewListFilter) (*response.PaginatedResponse[response.ReviewResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovieReviews", ctx, movieID, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.ReviewResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovieReviews indicates an expected call of GetMovieReviews.
+func (mr *MockReviewServiceMockRecorder) GetMovieReviews(ctx, movieID, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovieReviews", reflect.TypeOf((*MockReviewService)(nil).GetMovieReviews), ctx, movieID, req, filter)
+}
+
+// GetReportedReviews mocks base method.
+func (m *MockReviewService) GetReportedReviews(ctx context.Context, req *request.PaginatedRequest) (*response.PaginatedResponse[response.ReportedReviewResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportedReviews", ctx, req)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.ReportedReviewResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportedReviews indicates an expected call of GetReportedReviews.
+func (mr *MockReviewServiceMockRecorder) GetReportedReviews(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportedReviews", reflect.TypeOf((*MockReviewService)(nil).GetReportedReviews), ctx, req)
+}
+
+// GetReviewRevisions mocks base method.
+func (m *MockReviewService) GetReviewRevisions(ctx context.Context, reviewID string) ([]response.ReviewRevisionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewRevisions", ctx, reviewID)
+	ret0, _ := ret[0].([]response.ReviewRevisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewRevisions indicates an expected call of GetReviewRevisions.
+func (mr *MockReviewServiceMockRecorder) GetReviewRevisions(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewRevisions", reflect.TypeOf((*MockReviewService)(nil).GetReviewRevisions), ctx, reviewID)
+}
+
+// GetUserReviews mocks base method.
+func (m *MockReviewService) GetUserReviews(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.ReviewListFilter) (*response.PaginatedResponse[response.ReviewResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserReviews", ctx, userID, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.ReviewResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReviews indicates an expected call of GetUserReviews.
+func (mr *MockReviewServiceMockRecorder) GetUserReviews(ctx, userID, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviews", reflect.TypeOf((*MockReviewService)(nil).GetUserReviews), ctx, userID, req, filter)
+}
+
+// ModerateReview mocks base method.
+func (m *MockReviewService) ModerateReview(ctx context.Context, reviewID, adminID string, req *request.ModerateReviewRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateReview", ctx, reviewID, adminID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModerateReview indicates an expected call of ModerateReview.
+func (mr *MockReviewServiceMockRecorder) ModerateReview(ctx, reviewID, adminID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateReview", reflect.TypeOf((*MockReviewService)(nil).ModerateReview), ctx, reviewID, adminID, req)
+}
+
+// RecalculateMovieRatings mocks base method.
+func (m *MockReviewService) RecalculateMovieRatings(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecalculateMovieRatings", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecalculateMovieRatings indicates an expected call of RecalculateMovieRatings.
+func (mr *MockReviewServiceMockRecorder) RecalculateMovieRatings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateMovieRatings", reflect.TypeOf((*MockReviewService)(nil).RecalculateMovieRatings), ctx)
+}
+
+// ReplyToReview mocks base method.
+func (m *MockReviewService) ReplyToReview(ctx context.Context, reviewID, staffID string, req *request.ReviewReplyRequest) (*response.ReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyToReview", ctx, reviewID, staffID, req)
+	ret0, _ := ret[0].(*response.ReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplyToReview indicates an expected call of ReplyToReview.
+func (mr *MockReviewServiceMockRecorder) ReplyToReview(ctx, reviewID, staffID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyToReview", reflect.TypeOf((*MockReviewService)(nil).ReplyToReview), ctx, reviewID, staffID, req)
+}
+
+// ReportReview mocks base method.
+func (m *MockReviewService) ReportReview(ctx context.Context, reviewID, userID string, req *request.ReportReviewRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportReview", ctx, reviewID, userID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportReview indicates an expected call of ReportReview.
+func (mr *MockReviewServiceMockRecorder) ReportReview(ctx, reviewID, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportReview", reflect.TypeOf((*MockReviewService)(nil).ReportReview), ctx, reviewID, userID, req)
+}
+
+// RestoreReview mocks base method.
+func (m *MockReviewService) RestoreReview(ctx context.Context, reviewID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreReview", ctx, reviewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreReview indicates an expected call of RestoreReview.
+func (mr *MockReviewServiceMockRecorder) RestoreReview(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreReview", reflect.TypeOf((*MockReviewService)(nil).RestoreReview), ctx, reviewID)
+}
+
+// UpdateReply mocks base method.
+func (m *MockReviewService) UpdateReply(ctx context.Context, reviewID, staffID string, req *request.ReviewReplyRequest) (*response.ReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReply", ctx, reviewID, staffID, req)
+	ret0, _ := ret[0].(*response.ReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReply indicates an expected call of UpdateReply.
+func (mr *MockReviewServiceMockRecorder) UpdateReply(ctx, reviewID, staffID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReply", reflect.TypeOf((*MockReviewService)(nil).UpdateReply), ctx, reviewID, staffID, req)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewService) UpdateReview(ctx context.Context, reviewID, userID string, req *request.UpdateReviewRequest) (*response.ReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, reviewID, userID, req)
+	ret0, _ := ret[0].(*response.ReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewServiceMockRecorder) UpdateReview(ctx, reviewID, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewService)(nil).UpdateReview), ctx, reviewID, userID, req)
+}
@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: schedule_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=schedule_srv.go -destination=mockusecase/schedule_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScheduleService is a mock of ScheduleService interface.
+type MockScheduleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleServiceMockRecorder is the mock recorder for MockScheduleService.
+type MockScheduleServiceMockRecorder struct {
+	mock *MockScheduleService
+}
+
+// NewMockScheduleService creates a new mock instance.
+func NewMockScheduleService(ctrl *gomock.Controller) *MockScheduleService {
+	mock := &MockScheduleService{ctrl: ctrl}
+	mock.recorder = &MockScheduleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleService) EXPECT() *MockScheduleServiceMockRecorder {
+	return m.recorder
+}
+
+// BlockSeats mocks base method.
+func (m *MockScheduleService) BlockSeats(ctx context.Context, adminID, scheduleID string, req *request.SeatBlockRequest) ([]response.SeatBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSeats", ctx, adminID, scheduleID, req)
+	ret0, _ := ret[0].([]response.SeatBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSeats indicates an expected call of BlockSeats.
+func (mr *MockScheduleServiceMockRecorder) BlockSeats(ctx, adminID, scheduleID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSeats", reflect.TypeOf((*MockScheduleService)(nil).BlockSeats), ctx, adminID, scheduleID, req)
+}
+
+// CloneWeek mocks base method.
+func (m *MockScheduleService) CloneWeek(ctx context.Context, req *request.CloneScheduleWeekRequest) (*response.ScheduleCloneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneWeek", ctx, req)
+	ret0, _ := ret[0].(*response.ScheduleCloneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneWeek indicates an expected call of CloneWeek.
+func (mr *MockScheduleServiceMockRecorder) CloneWeek(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneWeek", reflect.TypeOf((*MockScheduleService)(nil).CloneWeek), ctx, req)
+}
+
+// CreateSchedule mocks base method.
+func (m *MockScheduleService) CreateSchedule(ctx context.Context, req *request.ScheduleRequest) (*response.ScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, req)
+	ret0, _ := ret[0].(*response.ScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockScheduleServiceMockRecorder) CreateSchedule(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockScheduleService)(nil).CreateSchedule), ctx, req)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockScheduleService) DeleteSchedule(ctx context.Context, scheduleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockScheduleServiceMockRecorder) DeleteSchedule(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockScheduleService)(nil).DeleteSchedule), ctx, scheduleID)
+}
+
+// GetAdminSchedules mocks base method.
+func (m *MockScheduleService) GetAdminSchedules(ctx context.Context, req *request.PaginatedRequest, filter *request.AdminScheduleListFilter) (*response.PaginatedResponse[response.ScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminSchedules", ctx, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.ScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminSchedules indicates an expected call of GetAdminSchedules.
+func (mr *MockScheduleServiceMockRecorder) GetAdminSchedules(ctx, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminSchedules", reflect.TypeOf((*MockScheduleService)(nil).GetAdminSchedules), ctx, req, filter)
+}
+
+// GetSchedules mocks base method.
+func (m *MockScheduleService) GetSchedules(ctx context.Context, req *request.PaginatedRequest, filter *request.ScheduleListFilter) (*response.PaginatedResponse[response.ScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedules", ctx, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.ScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *MockScheduleServiceMockRecorder) GetSchedules(ctx, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*MockScheduleService)(nil).GetSchedules), ctx, req, filter)
+}
+
+// GetSeatBlocks mocks base method.
+func (m *MockScheduleService) GetSeatBlocks(ctx context.Context, scheduleID string) ([]response.SeatBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeatBlocks", ctx, scheduleID)
+	ret0, _ := ret[0].([]response.SeatBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeatBlocks indicates an expected call of GetSeatBlocks.
+func (mr *MockScheduleServiceMockRecorder) GetSeatBlocks(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeatBlocks", reflect.TypeOf((*MockScheduleService)(nil).GetSeatBlocks), ctx, scheduleID)
+}
+
+// PublishSchedules mocks base method.
+func (m *MockScheduleService) PublishSchedules(ctx context.Context, req *request.PublishSchedulesRequest) (*response.PublishSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishSchedules", ctx, req)
+	ret0, _ := ret[0].(*response.PublishSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishSchedules indicates an expected call of PublishSchedules.
+func (mr *MockScheduleServiceMockRecorder) PublishSchedules(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishSchedules", reflect.TypeOf((*MockScheduleService)(nil).PublishSchedules), ctx, req)
+}
+
+// SetPriceOverride mocks base method.
+func (m *MockScheduleService) SetPriceOverride(ctx context.Context, scheduleID string, req *request.SchedulePriceOverrideRequest) (*response.ScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPriceOverride", ctx, scheduleID, req)
+	ret0, _ := ret[0].(*response.ScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPriceOverride indicates an expected call of SetPriceOverride.
+func (mr *MockScheduleServiceMockRecorder) SetPriceOverride(ctx, scheduleID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPriceOverride", reflect.TypeOf((*MockScheduleService)(nil).SetPriceOverride), ctx, scheduleID, req)
+}
+
+// UnblockSeats mocks base method.
+func (m *MockScheduleService) UnblockSeats(ctx context.Context, scheduleID string, req *request.SeatIDsRequest) ([]response.SeatBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockSeats", ctx, scheduleID, req)
+	ret0, _ := ret[0].([]response.SeatBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockSeats indicates an expected call of UnblockSeats.
+func (mr *MockScheduleServiceMockRecorder) UnblockSeats(ctx, scheduleID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockSeats", reflect.TypeOf((*MockScheduleService)(nil).UnblockSeats), ctx, scheduleID, req)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockScheduleService) UpdateSchedule(ctx context.Context, scheduleID string, req *request.ScheduleUpdateRequest) (*response.ScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", ctx, scheduleID, req)
+	ret0, _ := ret[0].(*response.ScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockScheduleServiceMockRecorder) UpdateSchedule(ctx, scheduleID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockScheduleService)(nil).UpdateSchedule), ctx, scheduleID, req)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: settings_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=settings_srv.go -destination=mockusecase/settings_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSettingsService is a mock of SettingsService interface.
+type MockSettingsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettingsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSettingsServiceMockRecorder is the mock recorder for MockSettingsService.
+type MockSettingsServiceMockRecorder struct {
+	mock *MockSettingsService
+}
+
+// NewMockSettingsService creates a new mock instance.
+func NewMockSettingsService(ctrl *gomock.Controller) *MockSettingsService {
+	mock := &MockSettingsService{ctrl: ctrl}
+	mock.recorder = &MockSettingsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettingsService) EXPECT() *MockSettingsServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSettings mocks base method.
+func (m *MockSettingsService) GetSettings(ctx context.Context) ([]response.SettingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettings", ctx)
+	ret0, _ := ret[0].([]response.SettingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettings indicates an expected call of GetSettings.
+func (mr *MockSettingsServiceMockRecorder) GetSettings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettings", reflect.TypeOf((*MockSettingsService)(nil).GetSettings), ctx)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockSettingsService) UpdateSettings(ctx context.Context, adminID string, req *request.UpdateSettingsRequest) ([]response.SettingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, adminID, req)
+	ret0, _ := ret[0].([]response.SettingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockSettingsServiceMockRecorder) UpdateSettings(ctx, adminID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockSettingsService)(nil).UpdateSettings), ctx, adminID, req)
+}
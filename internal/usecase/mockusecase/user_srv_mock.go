@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_srv.go -destination=mockusecase/user_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserService is a mock of UserService interface.
+type MockUserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserServiceMockRecorder is the mock recorder for MockUserService.
+type MockUserServiceMockRecorder struct {
+	mock *MockUserService
+}
+
+// NewMockUserService creates a new mock instance.
+func NewMockUserService(ctrl *gomock.Controller) *MockUserService {
+	mock := &MockUserService{ctrl: ctrl}
+	mock.recorder = &MockUserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteUser mocks base method.
+func (m *MockUserService) DeleteUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockUserServiceMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserService)(nil).DeleteUser), ctx, userID)
+}
+
+// GetActivity mocks base method.
+func (m *MockUserService) GetActivity(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.ActivityFilter) (*response.PaginatedResponse[response.ActivityResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivity", ctx, userID, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.ActivityResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivity indicates an expected call of GetActivity.
+func (mr *MockUserServiceMockRecorder) GetActivity(ctx, userID, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivity", reflect.TypeOf((*MockUserService)(nil).GetActivity), ctx, userID, req, filter)
+}
+
+// GetAllUsers mocks base method.
+func (m *MockUserService) GetAllUsers(ctx context.Context, req *request.PaginatedRequest, filter *request.UserSearchFilter) (*response.PaginatedResponse[response.UserResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUsers", ctx, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.UserResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUsers indicates an expected call of GetAllUsers.
+func (mr *MockUserServiceMockRecorder) GetAllUsers(ctx, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserService)(nil).GetAllUsers), ctx, req, filter)
+}
+
+// GetProfile mocks base method.
+func (m *MockUserService) GetProfile(ctx context.Context, userID string) (*response.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, userID)
+	ret0, _ := ret[0].(*response.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockUserServiceMockRecorder) GetProfile(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockUserService)(nil).GetProfile), ctx, userID)
+}
+
+// GetUserDetail mocks base method.
+func (m *MockUserService) GetUserDetail(ctx context.Context, userID string) (*response.UserDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDetail", ctx, userID)
+	ret0, _ := ret[0].(*response.UserDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDetail indicates an expected call of GetUserDetail.
+func (mr *MockUserServiceMockRecorder) GetUserDetail(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDetail", reflect.TypeOf((*MockUserService)(nil).GetUserDetail), ctx, userID)
+}
+
+// RestoreUser mocks base method.
+func (m *MockUserService) RestoreUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockUserServiceMockRecorder) RestoreUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUserService)(nil).RestoreUser), ctx, userID)
+}
+
+// UpdateDateOfBirth mocks base method.
+func (m *MockUserService) UpdateDateOfBirth(ctx context.Context, userID string, req *request.UpdateDateOfBirthRequest) (*response.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDateOfBirth", ctx, userID, req)
+	ret0, _ := ret[0].(*response.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDateOfBirth indicates an expected call of UpdateDateOfBirth.
+func (mr *MockUserServiceMockRecorder) UpdateDateOfBirth(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDateOfBirth", reflect.TypeOf((*MockUserService)(nil).UpdateDateOfBirth), ctx, userID, req)
+}
+
+// UpdateLanguage mocks base method.
+func (m *MockUserService) UpdateLanguage(ctx context.Context, userID string, req *request.UpdateLanguageRequest) (*response.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLanguage", ctx, userID, req)
+	ret0, _ := ret[0].(*response.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLanguage indicates an expected call of UpdateLanguage.
+func (mr *MockUserServiceMockRecorder) UpdateLanguage(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLanguage", reflect.TypeOf((*MockUserService)(nil).UpdateLanguage), ctx, userID, req)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: waitlist_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=waitlist_srv.go -destination=mockusecase/waitlist_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitlistService is a mock of WaitlistService interface.
+type MockWaitlistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitlistServiceMockRecorder is the mock recorder for MockWaitlistService.
+type MockWaitlistServiceMockRecorder struct {
+	mock *MockWaitlistService
+}
+
+// NewMockWaitlistService creates a new mock instance.
+func NewMockWaitlistService(ctrl *gomock.Controller) *MockWaitlistService {
+	mock := &MockWaitlistService{ctrl: ctrl}
+	mock.recorder = &MockWaitlistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistService) EXPECT() *MockWaitlistServiceMockRecorder {
+	return m.recorder
+}
+
+// ExpireOffers mocks base method.
+func (m *MockWaitlistService) ExpireOffers(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOffers", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOffers indicates an expected call of ExpireOffers.
+func (mr *MockWaitlistServiceMockRecorder) ExpireOffers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOffers", reflect.TypeOf((*MockWaitlistService)(nil).ExpireOffers), ctx)
+}
+
+// GetUserWaitlist mocks base method.
+func (m *MockWaitlistService) GetUserWaitlist(ctx context.Context, userID string) ([]response.WaitlistEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWaitlist", ctx, userID)
+	ret0, _ := ret[0].([]response.WaitlistEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWaitlist indicates an expected call of GetUserWaitlist.
+func (mr *MockWaitlistServiceMockRecorder) GetUserWaitlist(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWaitlist", reflect.TypeOf((*MockWaitlistService)(nil).GetUserWaitlist), ctx, userID)
+}
+
+// JoinWaitlist mocks base method.
+func (m *MockWaitlistService) JoinWaitlist(ctx context.Context, userID, scheduleID string, req *request.JoinWaitlistRequest) (*response.WaitlistEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", ctx, userID, scheduleID, req)
+	ret0, _ := ret[0].(*response.WaitlistEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MockWaitlistServiceMockRecorder) JoinWaitlist(ctx, userID, scheduleID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MockWaitlistService)(nil).JoinWaitlist), ctx, userID, scheduleID, req)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MockWaitlistService) LeaveWaitlist(ctx context.Context, userID, scheduleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", ctx, userID, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MockWaitlistServiceMockRecorder) LeaveWaitlist(ctx, userID, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MockWaitlistService)(nil).LeaveWaitlist), ctx, userID, scheduleID)
+}
+
+// OfferFreedSeats mocks base method.
+func (m *MockWaitlistService) OfferFreedSeats(ctx context.Context, scheduleID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferFreedSeats", ctx, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OfferFreedSeats indicates an expected call of OfferFreedSeats.
+func (mr *MockWaitlistServiceMockRecorder) OfferFreedSeats(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferFreedSeats", reflect.TypeOf((*MockWaitlistService)(nil).OfferFreedSeats), ctx, scheduleID)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: watchlist_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=watchlist_srv.go -destination=mockusecase/watchlist_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	entity "cinema-booking/internal/data/entity"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWatchlistService is a mock of WatchlistService interface.
+type MockWatchlistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchlistServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWatchlistServiceMockRecorder is the mock recorder for MockWatchlistService.
+type MockWatchlistServiceMockRecorder struct {
+	mock *MockWatchlistService
+}
+
+// NewMockWatchlistService creates a new mock instance.
+func NewMockWatchlistService(ctrl *gomock.Controller) *MockWatchlistService {
+	mock := &MockWatchlistService{ctrl: ctrl}
+	mock.recorder = &MockWatchlistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchlistService) EXPECT() *MockWatchlistServiceMockRecorder {
+	return m.recorder
+}
+
+// AddToWatchlist mocks base method.
+func (m *MockWatchlistService) AddToWatchlist(ctx context.Context, userID, movieID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWatchlist", ctx, userID, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToWatchlist indicates an expected call of AddToWatchlist.
+func (mr *MockWatchlistServiceMockRecorder) AddToWatchlist(ctx, userID, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWatchlist", reflect.TypeOf((*MockWatchlistService)(nil).AddToWatchlist), ctx, userID, movieID)
+}
+
+// GetUserWatchlist mocks base method.
+func (m *MockWatchlistService) GetUserWatchlist(ctx context.Context, userID string) ([]response.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWatchlist", ctx, userID)
+	ret0, _ := ret[0].([]response.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWatchlist indicates an expected call of GetUserWatchlist.
+func (mr *MockWatchlistServiceMockRecorder) GetUserWatchlist(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWatchlist", reflect.TypeOf((*MockWatchlistService)(nil).GetUserWatchlist), ctx, userID)
+}
+
+// NotifyNowPlaying mocks base method.
+func (m *MockWatchlistService) NotifyNowPlaying(ctx context.Context, movie *entity.Movie) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyNowPlaying", ctx, movie)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyNowPlaying indicates an expected call of NotifyNowPlaying.
+func (mr *MockWatchlistServiceMockRecorder) NotifyNowPlaying(ctx, movie any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNowPlaying", reflect.TypeOf((*MockWatchlistService)(nil).NotifyNowPlaying), ctx, movie)
+}
+
+// RemoveFromWatchlist mocks base method.
+func (m *MockWatchlistService) RemoveFromWatchlist(ctx context.Context, userID, movieID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWatchlist", ctx, userID, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromWatchlist indicates an expected call of RemoveFromWatchlist.
+func (mr *MockWatchlistServiceMockRecorder) RemoveFromWatchlist(ctx, userID, movieID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWatchlist", reflect.TypeOf((*MockWatchlistService)(nil).RemoveFromWatchlist), ctx, userID, movieID)
+}
@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_srv.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook_srv.go -destination=mockusecase/webhook_srv_mock.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	request "cinema-booking/internal/dto/request"
+	response "cinema-booking/internal/dto/response"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookService is a mock of WebhookService interface.
+type MockWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookServiceMockRecorder is the mock recorder for MockWebhookService.
+type MockWebhookServiceMockRecorder struct {
+	mock *MockWebhookService
+}
+
+// NewMockWebhookService creates a new mock instance.
+func NewMockWebhookService(ctrl *gomock.Controller) *MockWebhookService {
+	mock := &MockWebhookService{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookService) EXPECT() *MockWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockWebhookService) CreateSubscription(ctx context.Context, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, req)
+	ret0, _ := ret[0].(*response.WebhookSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookServiceMockRecorder) CreateSubscription(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookService)(nil).CreateSubscription), ctx, req)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookService) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookServiceMockRecorder) DeleteSubscription(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookService)(nil).DeleteSubscription), ctx, subscriptionID)
+}
+
+// DeliverPending mocks base method.
+func (m *MockWebhookService) DeliverPending(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverPending", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverPending indicates an expected call of DeliverPending.
+func (mr *MockWebhookServiceMockRecorder) DeliverPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverPending", reflect.TypeOf((*MockWebhookService)(nil).DeliverPending), ctx)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookService) GetDeliveries(ctx context.Context, subscriptionID string, req *request.PaginatedRequest, filter *request.WebhookDeliveryFilter) (*response.PaginatedResponse[response.WebhookDeliveryResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, subscriptionID, req, filter)
+	ret0, _ := ret[0].(*response.PaginatedResponse[response.WebhookDeliveryResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookServiceMockRecorder) GetDeliveries(ctx, subscriptionID, req, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookService)(nil).GetDeliveries), ctx, subscriptionID, req, filter)
+}
+
+// GetSubscriptionByID mocks base method.
+func (m *MockWebhookService) GetSubscriptionByID(ctx context.Context, subscriptionID string) (*response.WebhookSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionByID", ctx, subscriptionID)
+	ret0, _ := ret[0].(*response.WebhookSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionByID indicates an expected call of GetSubscriptionByID.
+func (mr *MockWebhookServiceMockRecorder) GetSubscriptionByID(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionByID", reflect.TypeOf((*MockWebhookService)(nil).GetSubscriptionByID), ctx, subscriptionID)
+}
+
+// GetSubscriptions mocks base method.
+func (m *MockWebhookService) GetSubscriptions(ctx context.Context) ([]response.WebhookSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptions", ctx)
+	ret0, _ := ret[0].([]response.WebhookSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptions indicates an expected call of GetSubscriptions.
+func (mr *MockWebhookServiceMockRecorder) GetSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockWebhookService)(nil).GetSubscriptions), ctx)
+}
+
+// RotateSecret mocks base method.
+func (m *MockWebhookService) RotateSecret(ctx context.Context, subscriptionID string) (*response.WebhookSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecret", ctx, subscriptionID)
+	ret0, _ := ret[0].(*response.WebhookSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSecret indicates an expected call of RotateSecret.
+func (mr *MockWebhookServiceMockRecorder) RotateSecret(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecret", reflect.TypeOf((*MockWebhookService)(nil).RotateSecret), ctx, subscriptionID)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookService) UpdateSubscription(ctx context.Context, subscriptionID string, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, subscriptionID, req)
+	ret0, _ := ret[0].(*response.WebhookSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookServiceMockRecorder) UpdateSubscription(ctx, subscriptionID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookService)(nil).UpdateSubscription), ctx, subscriptionID, req)
+}
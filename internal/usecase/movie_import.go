@@ -0,0 +1,409 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/export"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxMovieImportRows batas baris per file import; katalog lebih besar di-split jadi beberapa file
+const maxMovieImportRows = 5000
+
+// movieGenreSeparator memisahkan nama genre dalam satu kolom, dipakai import maupun export
+const movieGenreSeparator = "|"
+
+// Status per baris di hasil import
+const (
+	movieImportImported = "imported"
+	movieImportValid    = "valid" // dry run: lolos validasi, tidak disimpan
+	movieImportFailed   = "failed"
+)
+
+// movieExportHeader juga header yang diterima import, jadi hasil export bisa di-import ulang
+var movieExportHeader = []string{
+	"id", "title", "description", "poster_url", "genres",
+	"duration_in_minutes", "release_date", "release_status", "age_rating", "rating",
+}
+
+// movieImportRow satu baris CSV mentah; field opsional kosong berarti tidak diisi
+type movieImportRow struct {
+	line          int
+	title         string
+	description   string
+	posterURL     string
+	genres        string
+	duration      string
+	releaseDate   string
+	releaseStatus string
+	ageRating     string
+}
+
+// parseMovieImportCSV reads a header-based CSV. Kolom wajib title, genres, duration_in_minutes
+// (atau duration) dan release_date; kolom lain seperti id dan rating diabaikan.
+func parseMovieImportCSV(r io.Reader) ([]movieImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid import file: empty file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid import file: %w", err)
+	}
+
+	cols := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if name == "duration" {
+			name = "duration_in_minutes"
+		}
+		if _, ok := cols[name]; !ok {
+			cols[name] = i
+		}
+	}
+	for _, required := range []string{"title", "genres", "duration_in_minutes", "release_date"} {
+		if _, ok := cols[required]; !ok {
+			return nil, fmt.Errorf("invalid import file: header must contain title, genres, duration_in_minutes and release_date")
+		}
+	}
+
+	var rows []movieImportRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid import file: %w", err)
+		}
+
+		// Kolom yang hilang di baris pendek dianggap kosong, nanti gagal di validasi per baris
+		value := func(name string) string {
+			i, ok := cols[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := movieImportRow{
+			line:          line,
+			title:         value("title"),
+			description:   value("description"),
+			posterURL:     value("poster_url"),
+			genres:        value("genres"),
+			duration:      value("duration_in_minutes"),
+			releaseDate:   value("release_date"),
+			releaseStatus: value("release_status"),
+			ageRating:     value("age_rating"),
+		}
+		if row == (movieImportRow{line: line}) {
+			continue // baris kosong
+		}
+
+		rows = append(rows, row)
+		if len(rows) > maxMovieImportRows {
+			return nil, fmt.Errorf("invalid import file: maximum %d rows", maxMovieImportRows)
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("invalid import file: no movie rows")
+	}
+
+	return rows, nil
+}
+
+func (s *movieService) ImportMovies(ctx context.Context, r io.Reader, dryRun bool) (*response.MovieImportResponse, error) {
+	rows, err := parseMovieImportCSV(r)
+	if err != nil {
+		return nil, err
+	}
+
+	genres, err := s.repo.Genre.FindAll(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to load genres for import", zap.Error(err))
+		return nil, fmt.Errorf("load genres: %w", err)
+	}
+	genresByName := make(map[string]*entity.Genre, len(genres))
+	for _, genre := range genres {
+		genresByName[strings.ToLower(genre.Name)] = genre
+	}
+
+	result := &response.MovieImportResponse{
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+		Rows:      make([]*response.MovieImportRowResponse, 0, len(rows)),
+	}
+
+	// Duplikat dalam satu file dicek pakai judul lowercase + release date
+	seen := make(map[string]int, len(rows))
+	for _, row := range rows {
+		rowResult := &response.MovieImportRowResponse{Line: row.line, Title: row.title}
+		result.Rows = append(result.Rows, rowResult)
+
+		movie, genreIDs, rowErrs := s.buildImportMovie(row, genresByName)
+		if len(rowErrs) == 0 {
+			key := strings.ToLower(movie.Title) + "|" + row.releaseDate
+			if line, ok := seen[key]; ok {
+				rowErrs = append(rowErrs, fmt.Sprintf("movie already exists on line %d", line))
+			} else {
+				seen[key] = row.line
+
+				exists, err := s.repo.Movie.ExistsByTitleAndReleaseDate(ctx, movie.Title, movie.ReleaseDate)
+				if err != nil {
+					return nil, fmt.Errorf("check movie existence: %w", err)
+				}
+				if exists {
+					rowErrs = append(rowErrs, "movie already exists with the same title and release date")
+				}
+			}
+		}
+
+		if len(rowErrs) > 0 {
+			rowResult.Status = movieImportFailed
+			rowResult.Errors = rowErrs
+			result.Failed++
+			continue
+		}
+
+		result.Valid++
+		if dryRun {
+			rowResult.Status = movieImportValid
+			continue
+		}
+
+		// Tiap baris punya transaksi sendiri, satu baris gagal tidak membatalkan baris lain
+		if err := s.createImportedMovie(ctx, movie, genreIDs); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to import movie",
+				zap.Error(err),
+				zap.Int("line", row.line),
+				zap.String("title", movie.Title),
+			)
+			rowResult.Status = movieImportFailed
+			rowResult.Errors = []string{"failed to save movie"}
+			result.Valid--
+			result.Failed++
+			continue
+		}
+
+		rowResult.Status = movieImportImported
+		rowResult.MovieID = movie.ID.String()
+		result.Imported++
+	}
+
+	if result.Imported > 0 {
+		s.feed.invalidate()
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Movie import finished",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("total_rows", result.TotalRows),
+		zap.Int("imported", result.Imported),
+		zap.Int("failed", result.Failed),
+	)
+
+	return result, nil
+}
+
+// buildImportMovie validates satu baris dengan aturan yang sama seperti CreateMovie.
+// Release status kosong diisi otomatis dari release date.
+func (s *movieService) buildImportMovie(row movieImportRow, genresByName map[string]*entity.Genre) (*entity.Movie, []uuid.UUID, []string) {
+	var rowErrs []string
+
+	duration, durationErr := strconv.Atoi(row.duration)
+	if row.duration != "" && durationErr != nil {
+		rowErrs = append(rowErrs, "duration_in_minutes: must be a whole number of minutes")
+	}
+
+	releaseStatus := strings.ToLower(row.releaseStatus)
+	releaseDate, dateErr := time.Parse("2006-01-02", row.releaseDate)
+	if releaseStatus == "" && dateErr == nil {
+		releaseStatus = string(entity.ReleaseStatusNowPlaying)
+		if releaseDate.After(time.Now()) {
+			releaseStatus = string(entity.ReleaseStatusComingSoon)
+		}
+	}
+
+	req := request.MovieRequest{
+		Title:             row.title,
+		ReleaseDate:       row.releaseDate,
+		DurationInMinutes: duration,
+		ReleaseStatus:     releaseStatus,
+		AgeRating:         strings.ToUpper(row.ageRating),
+	}
+	if row.description != "" {
+		req.Description = &row.description
+	}
+	if row.posterURL != "" {
+		req.PosterURL = &row.posterURL
+	}
+
+	for _, fe := range utils.ValidateStruct(&req) {
+		// Error parse durasi sudah dilaporkan, jangan dobel dengan "required"
+		if fe.Field == "duration_in_minutes" && row.duration != "" && durationErr != nil {
+			continue
+		}
+		// Status kosong cuma gagal karena release date-nya invalid, cukup error release_date
+		if fe.Field == "release_status" && releaseStatus == "" {
+			continue
+		}
+		rowErrs = append(rowErrs, fe.Field+": "+fe.Message)
+	}
+
+	var genreIDs []uuid.UUID
+	picked := map[uuid.UUID]bool{}
+	for _, name := range strings.Split(row.genres, movieGenreSeparator) {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		genre, ok := genresByName[strings.ToLower(name)]
+		if !ok {
+			rowErrs = append(rowErrs, fmt.Sprintf("genres: genre not found: %s", name))
+			continue
+		}
+		if !picked[genre.ID] {
+			picked[genre.ID] = true
+			genreIDs = append(genreIDs, genre.ID)
+		}
+	}
+	if len(genreIDs) == 0 && len(rowErrs) == 0 {
+		rowErrs = append(rowErrs, "genres: at least one genre is required")
+	}
+
+	if len(rowErrs) > 0 {
+		return nil, nil, rowErrs
+	}
+
+	now := time.Now()
+	movie := &entity.Movie{
+		Base: entity.Base{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Title:             req.Title,
+		Description:       req.Description,
+		PosterURL:         req.PosterURL,
+		ReleaseDate:       releaseDate,
+		DurationInMinutes: req.DurationInMinutes,
+		ReleaseStatus:     entity.ReleaseStatus(releaseStatus),
+		AgeRating:         entity.AgeRatingSU,
+	}
+	if req.AgeRating != "" {
+		movie.AgeRating = entity.AgeRating(req.AgeRating)
+	}
+
+	return movie, genreIDs, nil
+}
+
+func (s *movieService) createImportedMovie(ctx context.Context, movie *entity.Movie, genreIDs []uuid.UUID) error {
+	movieGenres := make([]*entity.MovieGenre, len(genreIDs))
+	for i, genreID := range genreIDs {
+		movieGenres[i] = &entity.MovieGenre{
+			BaseSimple: entity.BaseSimple{
+				ID:        uuid.New(),
+				CreatedAt: movie.CreatedAt,
+			},
+			MovieID: movie.ID,
+			GenreID: genreID,
+		}
+	}
+
+	return s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.Movie.Create(ctx, movie); err != nil {
+			return fmt.Errorf("create movie: %w", err)
+		}
+		if err := tx.MovieGenre.CreateBatch(ctx, movieGenres); err != nil {
+			return fmt.Errorf("create movie-genre relationships: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *movieService) ExportMovies(ctx context.Context, format export.Format, w io.Writer) error {
+	writer, err := export.NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.WriteRow(movieExportHeader); err != nil {
+		return fmt.Errorf("write movie export header: %w", err)
+	}
+
+	// Katalog tanpa movie yang sudah di-soft delete, per batch supaya memory tetap kecil
+	exported := 0
+	for offset := 0; ; offset += exportBatchSize {
+		movies, err := s.repo.Movie.FindAll(ctx, exportBatchSize, offset, nil, false, repository.MovieSort{})
+		if err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to export movies",
+				zap.Int("exported", exported),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to export movies")
+		}
+		if len(movies) == 0 {
+			break
+		}
+
+		movieIDs := make([]uuid.UUID, len(movies))
+		for i, movie := range movies {
+			movieIDs[i] = movie.ID
+		}
+		genreNames, err := s.repo.Genre.FindNamesByMovieIDs(ctx, movieIDs)
+		if err != nil {
+			return fmt.Errorf("failed to export movies")
+		}
+
+		for _, movie := range movies {
+			var description, posterURL string
+			if movie.Description != nil {
+				description = *movie.Description
+			}
+			if movie.PosterURL != nil {
+				posterURL = *movie.PosterURL
+			}
+
+			err := writer.WriteRow([]string{
+				movie.ID.String(),
+				movie.Title,
+				description,
+				posterURL,
+				strings.Join(genreNames[movie.ID], movieGenreSeparator),
+				strconv.Itoa(movie.DurationInMinutes),
+				movie.ReleaseDate.Format("2006-01-02"),
+				string(movie.ReleaseStatus),
+				string(movie.AgeRating),
+				strconv.FormatFloat(movie.Rating, 'f', 1, 64),
+			})
+			if err != nil {
+				return fmt.Errorf("write movie export row: %w", err)
+			}
+		}
+
+		exported += len(movies)
+		if len(movies) < exportBatchSize {
+			break
+		}
+	}
+
+	return writer.Close()
+}
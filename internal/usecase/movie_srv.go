@@ -3,49 +3,105 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/internal/data/repository"
 	"cinema-booking/internal/dto/request"
 	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/cache"
+	"cinema-booking/pkg/events"
+	"cinema-booking/pkg/export"
 	"cinema-booking/pkg/utils"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
+// movieEndGraceDays jumlah hari setelah release date sebelum movie tanpa jadwal boleh di-archive
+const movieEndGraceDays = 7
+
+// Trending dihitung dari tiket 7 hari terakhir. Hasilnya disimpan sebentar karena agregasinya
+// menyapu semua booking dalam window, sementara carousel dipanggil di setiap buka aplikasi.
+const (
+	trendingWindow   = 7 * 24 * time.Hour
+	trendingCacheTTL = 5 * time.Minute
+)
+
+// viewerID kosong berarti anonymous, in_watchlist tidak diisi
 type MovieService interface {
-	GetMovies(ctx context.Context, req *request.PaginatedRequest, releaseStatus *string) (*response.PaginatedResponse[response.MovieResponse], error)
-	GetMovieByID(ctx context.Context, movieID string) (*response.MovieDetailResponse, error)
+	// filter nil = urutan bawaan (release_date terbaru dulu)
+	GetMovies(ctx context.Context, req *request.PaginatedRequest, releaseStatus *string, filter *request.MovieListFilter, viewerID string) (*response.PaginatedResponse[response.MovieResponse], error)
+	GetMovieByID(ctx context.Context, movieID, viewerID string, opts *request.MovieDetailRequest) (*response.MovieDetailResponse, error)
+	GetMovieSchedules(ctx context.Context, movieID string) ([]response.ScheduleResponse, error)
+	GetTopRatedMovies(ctx context.Context, limit int, viewerID string) ([]response.MovieResponse, error)
+	// GetTrendingMovies sama untuk semua user (tanpa in_watchlist) supaya satu cache cukup
+	GetTrendingMovies(ctx context.Context, limit int) ([]response.TrendingMovieResponse, error)
 	CreateMovie(ctx context.Context, req *request.MovieRequest) (*response.MovieResponse, error)
 	UpdateMovie(ctx context.Context, movieID string, req *request.MovieUpdateRequest) (*response.MovieResponse, error)
 	DeleteMovie(ctx context.Context, movieID string) error
+	RestoreMovie(ctx context.Context, movieID string) error
+	SetReleaseStatus(ctx context.Context, movieID string, req *request.MovieReleaseStatusRequest) (*response.MovieResponse, error)
+	// MergeGenre re-points movie dari genreID ke targetID lalu menghapus genreID (admin)
+	MergeGenre(ctx context.Context, adminID, genreID, targetID string) (*response.GenreMergeResponse, error)
+
+	// Bulk katalog: import CSV dengan hasil per baris, export streaming ke w
+	ImportMovies(ctx context.Context, r io.Reader, dryRun bool) (*response.MovieImportResponse, error)
+	ExportMovies(ctx context.Context, format export.Format, w io.Writer) error
+
+	// Background job
+	SyncReleaseStatuses(ctx context.Context) (promoted int, archived int64, err error)
 }
 
 type movieService struct {
-	repo *repository.Repository
-	log  *zap.Logger
+	repo      *repository.Repository
+	watchlist WatchlistService
+	feed      *movieFeed
+	pricing   pricingRules
+	trending  *cache.TTL[int, []response.TrendingMovieResponse]
+	log       *zap.Logger
 }
 
 func NewMovieService(
 	repo *repository.Repository,
+	watchlist WatchlistService,
+	feed *movieFeed,
+	pricing utils.PricingConfig,
 	log *zap.Logger,
 ) MovieService {
 	return &movieService{
-		repo: repo,
-		log:  log.With(zap.String("service", "movie")),
+		repo:      repo,
+		watchlist: watchlist,
+		feed:      feed,
+		pricing:   newPricingRules(pricing),
+		trending:  cache.NewTTL[int, []response.TrendingMovieResponse](trendingCacheTTL),
+		log:       log.With(zap.String("service", "movie")),
 	}
 }
 
-func (s *movieService) GetMovies(ctx context.Context, req *request.PaginatedRequest, releaseStatus *string) (*response.PaginatedResponse[response.MovieResponse], error) {
+func (s *movieService) GetMovies(ctx context.Context, req *request.PaginatedRequest, releaseStatus *string, filter *request.MovieListFilter, viewerID string) (*response.PaginatedResponse[response.MovieResponse], error) {
+	var sort repository.MovieSort
+	if filter != nil {
+		if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+			return nil, errs
+		}
+		sort.Field = repository.MovieSortField(filter.Sort)
+		if filter.Order != "" {
+			ascending := filter.Order == "asc"
+			sort.Ascending = &ascending
+		}
+	}
+
 	limit := req.Limit()
 	offset := req.Offset()
 
 	// Get movies with pagination and filter
-	movies, err := s.repo.Movie.FindAll(ctx, limit, offset, releaseStatus)
+	movies, err := s.repo.Movie.FindAll(ctx, limit, offset, releaseStatus, req.IncludeDeleted, sort)
 	if err != nil {
-		s.log.Error("Failed to get movies",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get movies",
 			zap.Error(err),
 			zap.Int("page", req.Page),
 			zap.Int("per_page", req.PerPage),
@@ -55,64 +111,46 @@ func (s *movieService) GetMovies(ctx context.Context, req *request.PaginatedRequ
 	}
 
 	// Get total count for pagination metadata
-	total, err := s.repo.Movie.CountAll(ctx, releaseStatus)
+	total, err := s.repo.Movie.CountAll(ctx, releaseStatus, req.IncludeDeleted)
 	if err != nil {
-		s.log.Error("Failed to count movies",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count movies",
 			zap.Error(err),
 			zap.Stringp("release_status", releaseStatus),
 		)
 		return nil, fmt.Errorf("count movies: %w", err)
 	}
 
-	// Convert each movie to response with additional data
-	movieResponses := make([]response.MovieResponse, len(movies))
-	for i, movie := range movies {
-		// Get associated genres
-		genres, err := s.repo.Genre.FindByMovieID(ctx, movie.ID)
-		if err != nil {
-			s.log.Warn("Failed to get genres for movie",
-				zap.Error(err),
-				zap.String("movie_id", movie.ID.String()),
-			)
-		}
+	movieResponses := s.buildMovieResponses(ctx, movies, viewerID)
 
-		genreNames := make([]string, len(genres))
-		for j, genre := range genres {
-			genreNames[j] = genre.Name
-		}
-
-		// Get review statistics
-		avgRating, reviewCount, err := s.repo.Review.GetMovieReviewStats(ctx, movie.ID)
-		if err != nil {
-			// Log error but continue
-			s.log.Warn("Failed to get review stats for movie",
-				zap.Error(err),
-				zap.String("movie_id", movie.ID.String()),
-			)
-			// Use default values
-			avgRating = movie.Rating
-			reviewCount = 0
-		} else if avgRating > 0 { // Update movie rating if reviews exist
-			movie.Rating = avgRating
-		}
-
-		movieResponses[i] = response.MovieToResponse(movie, genreNames, int(reviewCount))
-	}
-
-	s.log.Info("Movies retrieved",
+	utils.LoggerFromContext(ctx, s.log).Info("Movies retrieved",
 		zap.Int("count", len(movies)),
 		zap.Int64("total", total),
 		zap.Int("page", req.Page),
 		zap.Int("per_page", req.PerPage),
 	)
 
-	return response.NewPaginatedResponse(movieResponses, req.Page, req.PerPage, total), nil
+	// Rating dan review count ikut menggeser movies.updated_at, jadi cukup dari baris movie
+	result := response.NewPaginatedResponse(movieResponses, req.Page, req.PerPage, total)
+	for _, movie := range movies {
+		if movie.UpdatedAt.After(result.LastModified) {
+			result.LastModified = movie.UpdatedAt
+		}
+	}
+
+	return result, nil
 }
 
-func (s *movieService) GetMovieByID(ctx context.Context, movieID string) (*response.MovieDetailResponse, error) {
+func (s *movieService) GetMovieByID(ctx context.Context, movieID, viewerID string, opts *request.MovieDetailRequest) (*response.MovieDetailResponse, error) {
+	if opts != nil {
+		if errs := utils.ValidateStruct(opts); len(errs) > 0 {
+			utils.LoggerFromContext(ctx, s.log).Warn("Movie detail options validation failed", zap.Any("errors", errs))
+			return nil, errs
+		}
+	}
+
 	id, err := uuid.Parse(movieID)
 	if err != nil {
-		s.log.Warn("Invalid movie ID format",
+		utils.LoggerFromContext(ctx, s.log).Warn("Invalid movie ID format",
 			zap.String("movie_id", movieID),
 			zap.Error(err),
 		)
@@ -121,7 +159,7 @@ func (s *movieService) GetMovieByID(ctx context.Context, movieID string) (*respo
 
 	movie, err := s.repo.Movie.FindByID(ctx, id)
 	if err != nil {
-		s.log.Error("Failed to get movie by ID",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get movie by ID",
 			zap.Error(err),
 			zap.String("movie_id", movieID),
 		)
@@ -134,7 +172,7 @@ func (s *movieService) GetMovieByID(ctx context.Context, movieID string) (*respo
 
 	genres, err := s.repo.Genre.FindByMovieID(ctx, movie.ID)
 	if err != nil {
-		s.log.Warn("Failed to get genres for movie",
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to get genres for movie",
 			zap.Error(err),
 			zap.String("movie_id", movieID),
 		)
@@ -145,20 +183,18 @@ func (s *movieService) GetMovieByID(ctx context.Context, movieID string) (*respo
 		genreNames[i] = genre.Name
 	}
 
+	// movie.Rating sudah weighted rating; rata-rata mentah hanya untuk log
 	avgRating, reviewCount, err := s.repo.Review.GetMovieReviewStats(ctx, movie.ID)
 	if err != nil {
-		s.log.Warn("Failed to get review stats for movie",
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to get review stats for movie",
 			zap.Error(err),
 			zap.String("movie_id", movieID),
 		)
 		// Use default values
 		reviewCount = 0
-	} else if avgRating > 0 {
-		// Update movie rating from reviews
-		movie.Rating = avgRating
 	}
 
-	s.log.Info("Movie retrieved",
+	utils.LoggerFromContext(ctx, s.log).Info("Movie retrieved",
 		zap.String("movie_id", movieID),
 		zap.String("title", movie.Title),
 		zap.Int64("review_count", reviewCount),
@@ -166,19 +202,107 @@ func (s *movieService) GetMovieByID(ctx context.Context, movieID string) (*respo
 	)
 
 	detailMovie := response.MovieToDetailResponse(movie, genreNames, int(reviewCount))
+	if viewerID != "" {
+		if watched := s.loadWatched(ctx, viewerID, []uuid.UUID{movie.ID}); watched != nil {
+			inWatchlist := watched[movie.ID]
+			detailMovie.InWatchlist = &inWatchlist
+		}
+	}
+
+	if opts.IncludesSchedules() {
+		showtimes, err := s.movieShowtimes(ctx, movie.ID, opts)
+		if err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to get showtimes for movie",
+				zap.Error(err),
+				zap.String("movie_id", movieID),
+			)
+			return nil, fmt.Errorf("get movie showtimes: %w", err)
+		}
+		detailMovie.Showtimes = showtimes
+	}
+
 	return &detailMovie, nil
 }
 
+// movieShowtimes groups jadwal upcoming movie per cinema. Urutan cinema mengikuti show paling awal,
+// jadwal di dalamnya tetap urut starts_at dari FindByMovieID.
+func (s *movieService) movieShowtimes(ctx context.Context, movieID uuid.UUID, opts *request.MovieDetailRequest) ([]response.CinemaShowtimes, error) {
+	schedules, err := s.repo.Schedule.FindByMovieID(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("find schedules: %w", err)
+	}
+
+	now := time.Now()
+
+	upcoming := make([]*entity.Schedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		if !schedule.StartsAt.After(now) {
+			continue
+		}
+		// show_date sudah tanggal lokal cinema, jadi bisa dibandingkan langsung dengan ?date=
+		if opts.Date != "" && schedule.ShowDate.Format("2006-01-02") != opts.Date {
+			continue
+		}
+		upcoming = append(upcoming, schedule)
+	}
+
+	scheduleResps, err := buildScheduleResponses(ctx, s.repo, s.pricing, upcoming)
+	if err != nil {
+		return nil, err
+	}
+
+	cinemas := make(map[string]*entity.Cinema)
+	groups := make(map[string]int)
+	showtimes := make([]response.CinemaShowtimes, 0)
+	for _, scheduleResp := range scheduleResps {
+		cinema, ok := cinemas[scheduleResp.CinemaID]
+		if !ok {
+			cinemaID, err := uuid.Parse(scheduleResp.CinemaID)
+			if err == nil {
+				cinema, err = s.repo.Cinema.FindByID(ctx, cinemaID)
+				if err != nil {
+					return nil, fmt.Errorf("find cinema: %w", err)
+				}
+			}
+			cinemas[scheduleResp.CinemaID] = cinema
+		}
+
+		// Hall atau cinema yang sudah dihapus tidak bisa dipesan, jadi tidak ditampilkan
+		if cinema == nil {
+			continue
+		}
+		if opts.City != nil && !strings.EqualFold(cinema.City, strings.TrimSpace(*opts.City)) {
+			continue
+		}
+
+		idx, ok := groups[scheduleResp.CinemaID]
+		if !ok {
+			idx = len(showtimes)
+			groups[scheduleResp.CinemaID] = idx
+			showtimes = append(showtimes, response.CinemaShowtimes{
+				CinemaID:   scheduleResp.CinemaID,
+				CinemaName: cinema.Name,
+				City:       cinema.City,
+				Location:   cinema.Location,
+				Timezone:   scheduleResp.Timezone,
+			})
+		}
+		showtimes[idx].Schedules = append(showtimes[idx].Schedules, scheduleResp)
+	}
+
+	return showtimes, nil
+}
+
 func (s *movieService) CreateMovie(ctx context.Context, req *request.MovieRequest) (*response.MovieResponse, error) {
 	// Validate request data
 	if errs := utils.ValidateStruct(req); len(errs) > 0 {
-		s.log.Warn("Create movie validation failed", zap.Any("errors", errs))
-		return nil, fmt.Errorf("validation failed: %s", utils.FormatValidationErrors(errs))
+		utils.LoggerFromContext(ctx, s.log).Warn("Create movie validation failed", zap.Any("errors", errs))
+		return nil, errs
 	}
 
 	releaseDate, err := time.Parse("2006-01-02", req.ReleaseDate)
 	if err != nil {
-		s.log.Warn("Invalid release date format",
+		utils.LoggerFromContext(ctx, s.log).Warn("Invalid release date format",
 			zap.String("release_date", req.ReleaseDate),
 			zap.Error(err),
 		)
@@ -186,14 +310,9 @@ func (s *movieService) CreateMovie(ctx context.Context, req *request.MovieReques
 	}
 
 	/// Validate release status enum
-	var releaseStatus entity.ReleaseStatus
-	switch req.ReleaseStatus {
-	case "now_playing":
-		releaseStatus = entity.ReleaseStatusNowPlaying
-	case "coming_soon":
-		releaseStatus = entity.ReleaseStatusComingSoon
-	default:
-		return nil, fmt.Errorf("invalid release status: %s", req.ReleaseStatus)
+	releaseStatus, err := parseReleaseStatus(req.ReleaseStatus)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate genres
@@ -206,7 +325,7 @@ func (s *movieService) CreateMovie(ctx context.Context, req *request.MovieReques
 
 		genre, err := s.repo.Genre.FindByID(ctx, genreID)
 		if err != nil {
-			s.log.Error("Failed to check genre existence",
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to check genre existence",
 				zap.Error(err),
 				zap.String("genre_id", genreIDStr),
 			)
@@ -234,42 +353,46 @@ func (s *movieService) CreateMovie(ctx context.Context, req *request.MovieReques
 		ReleaseDate:       releaseDate,
 		DurationInMinutes: req.DurationInMinutes,
 		ReleaseStatus:     releaseStatus,
+		AgeRating:         entity.AgeRatingSU,
+	}
+	if req.AgeRating != "" {
+		movie.AgeRating = entity.AgeRating(req.AgeRating)
 	}
 
-	// Save movie to database
-	if err := s.repo.Movie.Create(ctx, movie); err != nil {
-		s.log.Error("Failed to create movie",
-			zap.Error(err),
-			zap.String("title", req.Title),
-		)
-		return nil, fmt.Errorf("create movie: %w", err)
-	}
-
-	// Create movie-genre relationships in batch
-	if len(genreUUIDs) > 0 {
-		movieGenres := make([]*entity.MovieGenre, len(genreUUIDs))
-		for i, genreID := range genreUUIDs {
-			movieGenres[i] = &entity.MovieGenre{
-				BaseSimple: entity.BaseSimple{
-					ID:        uuid.New(),
-					CreatedAt: now,
-				},
-				MovieID: movie.ID,
-				GenreID: genreID,
-			}
+	movieGenres := make([]*entity.MovieGenre, len(genreUUIDs))
+	for i, genreID := range genreUUIDs {
+		movieGenres[i] = &entity.MovieGenre{
+			BaseSimple: entity.BaseSimple{
+				ID:        uuid.New(),
+				CreatedAt: now,
+			},
+			MovieID: movie.ID,
+			GenreID: genreID,
 		}
+	}
 
-		// Batch insert for performance
-		if err := s.repo.MovieGenre.CreateBatch(ctx, movieGenres); err != nil {
-			s.log.Error("Failed to create movie-genre relationships",
-				zap.Error(err),
-				zap.String("movie_id", movie.ID.String()),
-			)
-			// Rollback: delete movie if genre relationships fail
-			s.repo.Movie.Delete(ctx, movie.ID)
-			return nil, fmt.Errorf("create movie-genre relationships: %w", err)
+	// Movie dan genre relationships disimpan atomic
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.Movie.Create(ctx, movie); err != nil {
+			return fmt.Errorf("create movie: %w", err)
+		}
+
+		if len(movieGenres) > 0 {
+			if err := tx.MovieGenre.CreateBatch(ctx, movieGenres); err != nil {
+				return fmt.Errorf("create movie-genre relationships: %w", err)
+			}
 		}
+
+		return nil
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create movie",
+			zap.Error(err),
+			zap.String("title", req.Title),
+		)
+		return nil, err
 	}
+	s.feed.invalidate()
 
 	// Get genre names for response
 	genreNames := make([]string, len(genreUUIDs))
@@ -280,7 +403,7 @@ func (s *movieService) CreateMovie(ctx context.Context, req *request.MovieReques
 		}
 	}
 
-	s.log.Info("Movie created",
+	utils.LoggerFromContext(ctx, s.log).Info("Movie created",
 		zap.String("movie_id", movie.ID.String()),
 		zap.String("title", movie.Title),
 		zap.Int("genre_count", len(genreUUIDs)),
@@ -291,6 +414,11 @@ func (s *movieService) CreateMovie(ctx context.Context, req *request.MovieReques
 }
 
 func (s *movieService) UpdateMovie(ctx context.Context, movieID string, req *request.MovieUpdateRequest) (*response.MovieResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Update movie validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
 	id, err := uuid.Parse(movieID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid movie id: %w", err)
@@ -307,6 +435,7 @@ func (s *movieService) UpdateMovie(ctx context.Context, movieID string, req *req
 
 	// Apply partial updates only for provided fields
 	updated := false
+	wasComingSoon := movie.ReleaseStatus == entity.ReleaseStatusComingSoon
 
 	if req.Title != nil && *req.Title != movie.Title {
 		movie.Title = *req.Title
@@ -338,29 +467,41 @@ func (s *movieService) UpdateMovie(ctx context.Context, movieID string, req *req
 	}
 
 	if req.ReleaseStatus != nil {
-		var releaseStatus entity.ReleaseStatus
-		switch *req.ReleaseStatus {
-		case "now_playing":
-			releaseStatus = entity.ReleaseStatusNowPlaying
-		case "coming_soon":
-			releaseStatus = entity.ReleaseStatusComingSoon
-		default:
-			return nil, fmt.Errorf("invalid release status: %s", *req.ReleaseStatus)
+		releaseStatus, err := parseReleaseStatus(*req.ReleaseStatus)
+		if err != nil {
+			return nil, err
 		}
 		movie.ReleaseStatus = releaseStatus
 		updated = true
 	}
 
+	if req.AgeRating != nil && entity.AgeRating(*req.AgeRating) != movie.AgeRating {
+		movie.AgeRating = entity.AgeRating(*req.AgeRating)
+		updated = true
+	}
+
 	// Update timestamp and save only if changes were made
 	if updated {
 		movie.UpdatedAt = time.Now()
-		if err := s.repo.Movie.Update(ctx, movie); err != nil {
-			s.log.Error("Failed to update movie",
+		err := s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+			if err := tx.Movie.Update(ctx, movie); err != nil {
+				return err
+			}
+			return enqueueMovieUpdated(ctx, tx, movie)
+		})
+		if err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to update movie",
 				zap.Error(err),
 				zap.String("movie_id", movieID),
 			)
 			return nil, fmt.Errorf("update movie: %w", err)
 		}
+		s.feed.invalidate()
+	}
+
+	// Movie coming_soon yang mulai tayang dikabarkan ke user yang watchlist
+	if updated && wasComingSoon && movie.ReleaseStatus == entity.ReleaseStatusNowPlaying {
+		go s.notifyNowPlaying(movie)
 	}
 
 	genres, _ := s.repo.Genre.FindByMovieID(ctx, movie.ID)
@@ -369,7 +510,7 @@ func (s *movieService) UpdateMovie(ctx context.Context, movieID string, req *req
 		genreNames[i] = genre.Name
 	}
 
-	s.log.Info("Movie updated",
+	utils.LoggerFromContext(ctx, s.log).Info("Movie updated",
 		zap.String("movie_id", movieID),
 		zap.String("title", movie.Title),
 		zap.Bool("was_updated", updated),
@@ -394,25 +535,340 @@ func (s *movieService) DeleteMovie(ctx context.Context, movieID string) error {
 		return fmt.Errorf("movie not found")
 	}
 
-	if err := s.repo.MovieGenre.DeleteByMovieID(ctx, id); err != nil {
-		s.log.Warn("Failed to delete movie-genre relationships",
+	// Genre relationships are kept so the movie can be restored intact
+	if err := s.repo.Movie.Delete(ctx, id); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to delete movie",
 			zap.Error(err),
 			zap.String("movie_id", movieID),
 		)
+		return fmt.Errorf("delete movie: %w", err)
 	}
+	s.feed.invalidate()
 
-	if err := s.repo.Movie.Delete(ctx, id); err != nil {
-		s.log.Error("Failed to delete movie",
+	utils.LoggerFromContext(ctx, s.log).Info("Movie deleted",
+		zap.String("movie_id", movieID),
+		zap.String("title", movie.Title),
+	)
+
+	return nil
+}
+
+func (s *movieService) RestoreMovie(ctx context.Context, movieID string) error {
+	id, err := uuid.Parse(movieID)
+	if err != nil {
+		return fmt.Errorf("invalid movie id: %w", err)
+	}
+
+	if err := s.repo.Movie.Restore(ctx, id); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to restore movie",
 			zap.Error(err),
 			zap.String("movie_id", movieID),
 		)
-		return fmt.Errorf("delete movie: %w", err)
+		return fmt.Errorf("restore movie: %w", err)
+	}
+	s.feed.invalidate()
+
+	utils.LoggerFromContext(ctx, s.log).Info("Movie restored", zap.String("movie_id", movieID))
+	return nil
+}
+
+// SetReleaseStatus handles the admin override untuk release status
+func (s *movieService) SetReleaseStatus(ctx context.Context, movieID string, req *request.MovieReleaseStatusRequest) (*response.MovieResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	id, err := uuid.Parse(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie id: %w", err)
+	}
+
+	releaseStatus, err := parseReleaseStatus(req.ReleaseStatus)
+	if err != nil {
+		return nil, err
 	}
 
-	s.log.Info("Movie deleted",
+	movie, err := s.repo.Movie.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find movie: %w", err)
+	}
+	if movie == nil {
+		return nil, fmt.Errorf("movie not found")
+	}
+
+	locked := true
+	if req.Locked != nil {
+		locked = *req.Locked
+	}
+
+	wasComingSoon := movie.ReleaseStatus == entity.ReleaseStatusComingSoon
+	movie.ReleaseStatus = releaseStatus
+	movie.UpdatedAt = time.Now()
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.Movie.SetReleaseStatus(ctx, id, releaseStatus, locked); err != nil {
+			return err
+		}
+		return enqueueMovieUpdated(ctx, tx, movie)
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to override release status",
+			zap.Error(err),
+			zap.String("movie_id", movieID),
+		)
+		return nil, fmt.Errorf("set release status: %w", err)
+	}
+	s.feed.invalidate()
+
+	if wasComingSoon && releaseStatus == entity.ReleaseStatusNowPlaying {
+		go s.notifyNowPlaying(movie)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Movie release status overridden",
 		zap.String("movie_id", movieID),
-		zap.String("title", movie.Title),
+		zap.String("release_status", string(releaseStatus)),
+		zap.Bool("locked", locked),
 	)
 
-	return nil
+	genres, _ := s.repo.Genre.FindByMovieID(ctx, movie.ID)
+	genreNames := make([]string, len(genres))
+	for i, genre := range genres {
+		genreNames[i] = genre.Name
+	}
+
+	movieResp := response.MovieToResponse(movie, genreNames, 0)
+	return &movieResp, nil
+}
+
+func (s *movieService) GetTopRatedMovies(ctx context.Context, limit int, viewerID string) ([]response.MovieResponse, error) {
+	movies, err := s.repo.Movie.FindTopRated(ctx, limit)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get top rated movies", zap.Error(err))
+		return nil, fmt.Errorf("get top rated movies: %w", err)
+	}
+
+	return s.buildMovieResponses(ctx, movies, viewerID), nil
+}
+
+func (s *movieService) GetTrendingMovies(ctx context.Context, limit int) ([]response.TrendingMovieResponse, error) {
+	if cached, ok := s.trending.Get(limit); ok {
+		return cached, nil
+	}
+
+	trending, err := s.repo.Movie.FindTrending(ctx, time.Now().Add(-trendingWindow), limit)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get trending movies", zap.Error(err))
+		return nil, fmt.Errorf("get trending movies: %w", err)
+	}
+
+	movies := make([]*entity.Movie, len(trending))
+	for i, item := range trending {
+		movies[i] = item.Movie
+	}
+	movieResponses := s.buildMovieResponses(ctx, movies, "")
+
+	result := make([]response.TrendingMovieResponse, len(trending))
+	for i, item := range trending {
+		result[i] = response.TrendingMovieResponse{
+			MovieResponse: movieResponses[i],
+			TicketsSold:   item.TicketsSold,
+		}
+	}
+
+	s.trending.Set(limit, result)
+	return result, nil
+}
+
+// GetMovieSchedules returns upcoming schedules (today onwards) for a movie
+func (s *movieService) GetMovieSchedules(ctx context.Context, movieID string) ([]response.ScheduleResponse, error) {
+	id, err := uuid.Parse(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie id: %w", err)
+	}
+
+	movie, err := s.repo.Movie.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find movie: %w", err)
+	}
+	if movie == nil {
+		return nil, fmt.Errorf("movie not found")
+	}
+
+	schedules, err := s.repo.Schedule.FindByMovieID(ctx, id)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get movie schedules",
+			zap.Error(err),
+			zap.String("movie_id", movieID),
+		)
+		return nil, fmt.Errorf("get movie schedules: %w", err)
+	}
+
+	now := time.Now()
+
+	upcoming := make([]*entity.Schedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		if schedule.StartsAt.After(now) {
+			upcoming = append(upcoming, schedule)
+		}
+	}
+
+	return buildScheduleResponses(ctx, s.repo, s.pricing, upcoming)
+}
+
+// ==================== BACKGROUND JOBS ====================
+
+// SyncReleaseStatuses promotes coming_soon movies yang sudah rilis dan mengarsip movie
+// now_playing yang tidak punya jadwal lagi. Kondisinya berbasis tanggal, jadi aman dijalankan
+// berkali-kali dalam sehari; movie yang di-lock admin tidak disentuh.
+func (s *movieService) SyncReleaseStatuses(ctx context.Context) (int, int64, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	promoted, err := s.repo.Movie.PromoteReleased(ctx, today)
+	if err != nil {
+		return 0, 0, fmt.Errorf("promote released movies: %w", err)
+	}
+	if len(promoted) > 0 {
+		s.feed.invalidate()
+	}
+
+	for _, movie := range promoted {
+		if _, err := s.watchlist.NotifyNowPlaying(ctx, movie); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to notify watchlist users",
+				zap.Error(err),
+				zap.String("movie_id", movie.ID.String()),
+			)
+		}
+	}
+
+	// Movie yang baru rilis diberi waktu supaya admin sempat membuat jadwal
+	archived, err := s.repo.Movie.ArchiveEnded(ctx, today, today.AddDate(0, 0, -movieEndGraceDays))
+	if err != nil {
+		return len(promoted), 0, fmt.Errorf("archive ended movies: %w", err)
+	}
+
+	if archived > 0 {
+		s.feed.invalidate()
+	}
+
+	if len(promoted) > 0 || archived > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Movie release statuses synced",
+			zap.Int("promoted", len(promoted)),
+			zap.Int64("archived", archived),
+		)
+	}
+
+	return len(promoted), archived, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+// enqueueMovieUpdated untuk perubahan oleh admin; promote/archive otomatis dari SyncReleaseStatuses tidak ikut
+func enqueueMovieUpdated(ctx context.Context, tx *repository.Repository, movie *entity.Movie) error {
+	return enqueueEvent(ctx, tx, events.AggregateMovie, movie.ID, events.TypeMovieUpdated, events.MovieUpdated{
+		MovieID:           movie.ID.String(),
+		Title:             movie.Title,
+		ReleaseDate:       movie.ReleaseDate.Format("2006-01-02"),
+		ReleaseStatus:     string(movie.ReleaseStatus),
+		DurationInMinutes: movie.DurationInMinutes,
+		UpdatedAt:         movie.UpdatedAt,
+	})
+}
+
+// parseReleaseStatus maps input string ke enum release status
+func parseReleaseStatus(status string) (entity.ReleaseStatus, error) {
+	switch entity.ReleaseStatus(status) {
+	case entity.ReleaseStatusNowPlaying, entity.ReleaseStatusComingSoon, entity.ReleaseStatusEnded:
+		return entity.ReleaseStatus(status), nil
+	default:
+		return "", fmt.Errorf("invalid release status: %s", status)
+	}
+}
+
+// buildMovieResponses adds genres, review stats dan flag in_watchlist (kalau viewer login)
+func (s *movieService) buildMovieResponses(ctx context.Context, movies []*entity.Movie, viewerID string) []response.MovieResponse {
+	movieResponses := make([]response.MovieResponse, len(movies))
+
+	// Genre dan review stats per movie di-fetch paralel dengan batas worker
+	var g errgroup.Group
+	g.SetLimit(hydrationConcurrency)
+	for i, movie := range movies {
+		g.Go(func() error {
+			// Get associated genres
+			genres, err := s.repo.Genre.FindByMovieID(ctx, movie.ID)
+			if err != nil {
+				utils.LoggerFromContext(ctx, s.log).Warn("Failed to get genres for movie",
+					zap.Error(err),
+					zap.String("movie_id", movie.ID.String()),
+				)
+			}
+
+			genreNames := make([]string, len(genres))
+			for j, genre := range genres {
+				genreNames[j] = genre.Name
+			}
+
+			// Review count saja; movie.Rating yang tersimpan sudah weighted rating
+			_, reviewCount, err := s.repo.Review.GetMovieReviewStats(ctx, movie.ID)
+			if err != nil {
+				// Log error but continue
+				utils.LoggerFromContext(ctx, s.log).Warn("Failed to get review stats for movie",
+					zap.Error(err),
+					zap.String("movie_id", movie.ID.String()),
+				)
+				// Use default values
+				reviewCount = 0
+			}
+
+			movieResponses[i] = response.MovieToResponse(movie, genreNames, int(reviewCount))
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	// Flag in_watchlist hanya untuk user yang login
+	if viewerID != "" {
+		movieIDs := make([]uuid.UUID, len(movies))
+		for i, movie := range movies {
+			movieIDs[i] = movie.ID
+		}
+
+		watched := s.loadWatched(ctx, viewerID, movieIDs)
+		if watched != nil {
+			for i := range movieResponses {
+				inWatchlist := watched[movies[i].ID]
+				movieResponses[i].InWatchlist = &inWatchlist
+			}
+		}
+	}
+
+	return movieResponses
+}
+
+// loadWatched returns nil kalau viewer tidak valid atau lookup gagal, supaya response tetap jalan
+func (s *movieService) loadWatched(ctx context.Context, viewerID string, movieIDs []uuid.UUID) map[uuid.UUID]bool {
+	userID, err := uuid.Parse(viewerID)
+	if err != nil {
+		return nil
+	}
+
+	watched, err := s.repo.Watchlist.FindWatchedMovieIDs(ctx, userID, movieIDs)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to check watchlist", zap.Error(err), zap.String("user_id", viewerID))
+		return nil
+	}
+
+	return watched
+}
+
+func (s *movieService) notifyNowPlaying(movie *entity.Movie) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	if _, err := s.watchlist.NotifyNowPlaying(ctx, movie); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to notify watchlist users",
+			zap.Error(err),
+			zap.String("movie_id", movie.ID.String()),
+		)
+	}
 }
@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/notification"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// notificationChannels urutan channel yang ditampilkan ke user
+var notificationChannels = []entity.NotificationChannel{
+	entity.NotificationChannelEmail,
+	entity.NotificationChannelPush,
+	entity.NotificationChannelSMS,
+}
+
+type NotificationService interface {
+	GetSettings(ctx context.Context, userID string) (*response.NotificationSettingsResponse, error)
+	UpdateSettings(ctx context.Context, userID string, req *request.UpdateNotificationSettingsRequest) (*response.NotificationSettingsResponse, error)
+
+	// Device tokens for push notifications
+	RegisterDevice(ctx context.Context, userID string, req *request.RegisterDeviceRequest) (*response.DeviceResponse, error)
+	UnregisterDevice(ctx context.Context, userID, token string) error
+
+	// Notify dispatches a message to every channel the user has enabled for the category.
+	// Error kalau semua channel yang dicoba gagal, supaya job pengirim bisa di-retry; gagal
+	// sebagian tidak di-retry karena channel yang berhasil akan terkirim dobel.
+	Notify(ctx context.Context, userID uuid.UUID, category entity.NotificationCategory, compose MessageComposer) error
+}
+
+// MessageComposer builds the message dalam bahasa notifikasi user penerima
+type MessageComposer func(lang i18n.Lang) notification.Message
+
+type notificationService struct {
+	repo    *repository.Repository
+	senders map[entity.NotificationChannel]notification.Sender
+	log     *zap.Logger
+}
+
+func NewNotificationService(repo *repository.Repository, senders []notification.Sender, log *zap.Logger) NotificationService {
+	senderMap := make(map[entity.NotificationChannel]notification.Sender, len(senders))
+	for _, sender := range senders {
+		senderMap[entity.NotificationChannel(sender.Channel())] = sender
+	}
+
+	return &notificationService{
+		repo:    repo,
+		senders: senderMap,
+		log:     log.With(zap.String("service", "notification")),
+	}
+}
+
+func (s *notificationService) GetSettings(ctx context.Context, userID string) (*response.NotificationSettingsResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	settings, err := s.loadSettings(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildSettingsResponse(settings), nil
+}
+
+func (s *notificationService) UpdateSettings(ctx context.Context, userID string, req *request.UpdateNotificationSettingsRequest) (*response.NotificationSettingsResponse, error) {
+	// Validate request
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Update notification settings validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	settings, err := s.loadSettings(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, item := range req.Settings {
+		channel := entity.NotificationChannel(item.Channel)
+		setting := settings[channel]
+
+		if item.BookingConfirmation != nil {
+			setting.BookingConfirmation = *item.BookingConfirmation
+		}
+		if item.Reminders != nil {
+			setting.Reminders = *item.Reminders
+		}
+		if item.Promotions != nil {
+			setting.Promotions = *item.Promotions
+		}
+
+		if setting.ID == uuid.Nil {
+			setting.ID = uuid.New()
+			setting.CreatedAt = now
+		}
+		setting.UpdatedAt = now
+
+		if err := s.repo.NotificationSetting.Upsert(ctx, setting); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to save notification setting",
+				zap.Error(err),
+				zap.String("user_id", userID),
+				zap.String("channel", item.Channel),
+			)
+			return nil, fmt.Errorf("save notification setting %s: %w", item.Channel, err)
+		}
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Notification settings updated",
+		zap.String("user_id", userID),
+		zap.Int("channel_count", len(req.Settings)),
+	)
+
+	return s.buildSettingsResponse(settings), nil
+}
+
+func (s *notificationService) RegisterDevice(ctx context.Context, userID string, req *request.RegisterDeviceRequest) (*response.DeviceResponse, error) {
+	// Validate request
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Register device validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	now := time.Now()
+	device := &entity.UserDevice{
+		BaseNoDelete: entity.BaseNoDelete{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		UserID:   userUUID,
+		Token:    req.Token,
+		Platform: entity.DevicePlatform(req.Platform),
+	}
+
+	if err := s.repo.UserDevice.Upsert(ctx, device); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to register device",
+			zap.Error(err),
+			zap.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("register device: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Device registered",
+		zap.String("user_id", userID),
+		zap.String("platform", req.Platform),
+	)
+
+	deviceResp := response.DeviceToResponse(device)
+	return &deviceResp, nil
+}
+
+func (s *notificationService) UnregisterDevice(ctx context.Context, userID, token string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	if err := s.repo.UserDevice.DeleteByToken(ctx, userUUID, token); err != nil {
+		return fmt.Errorf("unregister device: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Device unregistered", zap.String("user_id", userID))
+	return nil
+}
+
+func (s *notificationService) Notify(ctx context.Context, userID uuid.UUID, category entity.NotificationCategory, compose MessageComposer) error {
+	user, err := s.repo.User.FindByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("find user %s for notification: %w", userID.String(), err)
+	}
+	if user == nil {
+		return fmt.Errorf("user %s not found", userID.String())
+	}
+
+	settings, err := s.loadSettings(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	lang, ok := i18n.Parse(user.Language)
+	if !ok {
+		lang = i18n.Default
+	}
+	msg := compose(lang)
+
+	recipient := notification.Recipient{
+		UserID: user.ID.String(),
+		Email:  user.Email,
+	}
+	if user.Phone != nil {
+		recipient.Phone = *user.Phone
+	}
+
+	devices, err := s.repo.UserDevice.FindByUserID(ctx, userID)
+	if err != nil {
+		// Push tetap di-skip, channel lain jalan terus
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to get user devices", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+	for _, device := range devices {
+		recipient.DeviceTokens = append(recipient.DeviceTokens, device.Token)
+	}
+
+	attempted, failed := 0, 0
+	var lastErr error
+	for _, channel := range notificationChannels {
+		// Respect user preference per channel
+		if !settings[channel].Allows(category) {
+			utils.LoggerFromContext(ctx, s.log).Debug("Notification skipped by user preference",
+				zap.String("user_id", userID.String()),
+				zap.String("channel", string(channel)),
+				zap.String("category", string(category)),
+			)
+			continue
+		}
+
+		sender, ok := s.senders[channel]
+		if !ok {
+			continue
+		}
+
+		attempted++
+		if err := sender.Send(ctx, recipient, msg); err != nil {
+			failed++
+			lastErr = err
+			// Satu channel gagal tidak menghentikan channel lain
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to send notification",
+				zap.Error(err),
+				zap.String("user_id", userID.String()),
+				zap.String("channel", string(channel)),
+				zap.String("category", string(category)),
+			)
+			continue
+		}
+	}
+
+	if attempted > 0 && failed == attempted {
+		return fmt.Errorf("notify user %s: all %d channels failed: %w", userID.String(), attempted, lastErr)
+	}
+	return nil
+}
+
+// ==================== HELPER METHODS ====================
+
+// loadSettings returns settings for every channel, falling back to defaults for channels never saved
+func (s *notificationService) loadSettings(ctx context.Context, userID uuid.UUID) (map[entity.NotificationChannel]*entity.UserNotificationSetting, error) {
+	stored, err := s.repo.NotificationSetting.FindByUserID(ctx, userID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get notification settings",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("get notification settings: %w", err)
+	}
+
+	settings := make(map[entity.NotificationChannel]*entity.UserNotificationSetting, len(notificationChannels))
+	for _, channel := range notificationChannels {
+		settings[channel] = entity.DefaultNotificationSetting(userID, channel)
+	}
+	for _, setting := range stored {
+		settings[setting.Channel] = setting
+	}
+
+	return settings, nil
+}
+
+func (s *notificationService) buildSettingsResponse(settings map[entity.NotificationChannel]*entity.UserNotificationSetting) *response.NotificationSettingsResponse {
+	items := make([]response.NotificationChannelSettingResponse, 0, len(notificationChannels))
+	for _, channel := range notificationChannels {
+		items = append(items, response.NotificationSettingToResponse(settings[channel]))
+	}
+
+	return &response.NotificationSettingsResponse{Settings: items}
+}
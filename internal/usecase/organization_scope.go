@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// adminOrganization returns organization admin chain yang sedang login (diset middleware Admin).
+// nil untuk admin platform, endpoint publik dan background job, yaitu tanpa batasan.
+func adminOrganization(ctx context.Context) *uuid.UUID {
+	if organizationID, ok := utils.GetOrganizationIDFromContext(ctx); ok {
+		return &organizationID
+	}
+	return nil
+}
+
+// requireCinemaScope menolak cinema milik chain lain dengan error not found yang sama seperti
+// cinema yang tidak ada, supaya admin chain tidak bisa menebak data chain lain
+func requireCinemaScope(ctx context.Context, repo *repository.Repository, cinemaID uuid.UUID) error {
+	organizationID := adminOrganization(ctx)
+	if organizationID == nil {
+		return nil
+	}
+
+	owned, err := repo.Organization.OwnsCinema(ctx, *organizationID, cinemaID)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return fmt.Errorf("cinema %s not found", cinemaID.String())
+	}
+	return nil
+}
+
+func requireHallScope(ctx context.Context, repo *repository.Repository, hallID uuid.UUID) error {
+	organizationID := adminOrganization(ctx)
+	if organizationID == nil {
+		return nil
+	}
+
+	owned, err := repo.Organization.OwnsHall(ctx, *organizationID, hallID)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return fmt.Errorf("hall %s not found", hallID.String())
+	}
+	return nil
+}
+
+func requireScheduleScope(ctx context.Context, repo *repository.Repository, scheduleID uuid.UUID) error {
+	owned, err := scheduleInScope(ctx, repo, scheduleID)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return fmt.Errorf("schedule %s not found", scheduleID.String())
+	}
+	return nil
+}
+
+// scheduleInScope dipakai operasi batch yang melaporkan schedule di luar scope per item, bukan gagal total
+func scheduleInScope(ctx context.Context, repo *repository.Repository, scheduleID uuid.UUID) (bool, error) {
+	organizationID := adminOrganization(ctx)
+	if organizationID == nil {
+		return true, nil
+	}
+
+	return repo.Organization.OwnsSchedule(ctx, *organizationID, scheduleID)
+}
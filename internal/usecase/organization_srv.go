@@ -0,0 +1,408 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var organizationSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// OrganizationService mengelola chain cinema beserta cinema dan admin-nya (khusus admin platform)
+type OrganizationService interface {
+	GetOrganizations(ctx context.Context) ([]response.OrganizationResponse, error)
+	GetOrganizationByID(ctx context.Context, organizationID string) (*response.OrganizationResponse, error)
+	CreateOrganization(ctx context.Context, req *request.OrganizationRequest) (*response.OrganizationResponse, error)
+	UpdateOrganization(ctx context.Context, organizationID string, req *request.OrganizationRequest) (*response.OrganizationResponse, error)
+	DeleteOrganization(ctx context.Context, organizationID string) error
+
+	AssignCinemas(ctx context.Context, organizationID string, req *request.OrganizationCinemasRequest) (*response.OrganizationResponse, error)
+	ReleaseCinema(ctx context.Context, organizationID, cinemaID string) error
+	AddAdmin(ctx context.Context, organizationID string, req *request.OrganizationAdminRequest) error
+	RemoveAdmin(ctx context.Context, organizationID, userID string) error
+}
+
+type organizationService struct {
+	repo *repository.Repository
+	log  *zap.Logger
+}
+
+func NewOrganizationService(repo *repository.Repository, log *zap.Logger) OrganizationService {
+	return &organizationService{
+		repo: repo,
+		log:  log.With(zap.String("service", "organization")),
+	}
+}
+
+func (s *organizationService) GetOrganizations(ctx context.Context) ([]response.OrganizationResponse, error) {
+	organizations, err := s.repo.Organization.FindAll(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get organizations", zap.Error(err))
+		return nil, fmt.Errorf("get organizations: %w", err)
+	}
+
+	result := make([]response.OrganizationResponse, 0, len(organizations))
+	for _, organization := range organizations {
+		resp, err := s.toResponse(ctx, organization)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *resp)
+	}
+
+	return result, nil
+}
+
+func (s *organizationService) GetOrganizationByID(ctx context.Context, organizationID string) (*response.OrganizationResponse, error) {
+	organization, err := s.findOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(ctx, organization)
+}
+
+func (s *organizationService) CreateOrganization(ctx context.Context, req *request.OrganizationRequest) (*response.OrganizationResponse, error) {
+	if err := s.validateRequest(ctx, req, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	organization := &entity.Organization{
+		Base: entity.Base{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Name:        strings.TrimSpace(req.Name),
+		Slug:        req.Slug,
+		OrderPrefix: normalizeOrderPrefix(req.OrderPrefix),
+	}
+
+	if err := s.repo.Organization.Create(ctx, organization); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create organization",
+			zap.Error(err),
+			zap.String("slug", req.Slug),
+		)
+		return nil, fmt.Errorf("create organization: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Organization created",
+		zap.String("organization_id", organization.ID.String()),
+		zap.String("slug", organization.Slug),
+	)
+
+	resp := response.OrganizationToResponse(organization, 0, 0)
+	return &resp, nil
+}
+
+func (s *organizationService) UpdateOrganization(ctx context.Context, organizationID string, req *request.OrganizationRequest) (*response.OrganizationResponse, error) {
+	organization, err := s.findOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRequest(ctx, req, organization.ID); err != nil {
+		return nil, err
+	}
+
+	organization.Name = strings.TrimSpace(req.Name)
+	organization.Slug = req.Slug
+	organization.OrderPrefix = normalizeOrderPrefix(req.OrderPrefix)
+	organization.UpdatedAt = time.Now()
+
+	if err := s.repo.Organization.Update(ctx, organization); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update organization",
+			zap.Error(err),
+			zap.String("organization_id", organizationID),
+		)
+		return nil, fmt.Errorf("update organization %s: %w", organizationID, err)
+	}
+
+	return s.toResponse(ctx, organization)
+}
+
+// DeleteOrganization ditolak selama masih ada cinema atau admin; admin chain yang organization-nya
+// hilang akan lolos sebagai admin platform
+func (s *organizationService) DeleteOrganization(ctx context.Context, organizationID string) error {
+	organization, err := s.findOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	cinemas, admins, err := s.counts(ctx, organization.ID)
+	if err != nil {
+		return err
+	}
+	if cinemas > 0 || admins > 0 {
+		return fmt.Errorf("cannot delete organization %s: still has %d cinemas and %d admins", organization.Slug, cinemas, admins)
+	}
+
+	if err := s.repo.Organization.Delete(ctx, organization.ID); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to delete organization",
+			zap.Error(err),
+			zap.String("organization_id", organizationID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *organizationService) AssignCinemas(ctx context.Context, organizationID string, req *request.OrganizationCinemasRequest) (*response.OrganizationResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	organization, err := s.findOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	cinemaIDs := make([]uuid.UUID, len(req.CinemaIDs))
+	for i, idStr := range req.CinemaIDs {
+		cinemaIDs[i] = uuid.MustParse(idStr) // sudah divalidasi tag uuid
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		for _, cinemaID := range cinemaIDs {
+			cinema, err := tx.Cinema.FindByID(ctx, cinemaID)
+			if err != nil {
+				return err
+			}
+			if cinema == nil {
+				return fmt.Errorf("cinema %s not found", cinemaID.String())
+			}
+		}
+
+		_, err := tx.Organization.AssignCinemas(ctx, &organization.ID, cinemaIDs)
+		return err
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to assign cinemas to organization",
+			zap.Error(err),
+			zap.String("organization_id", organizationID),
+		)
+		return nil, err
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Cinemas assigned to organization",
+		zap.String("organization_id", organizationID),
+		zap.Int("cinemas", len(cinemaIDs)),
+	)
+
+	return s.toResponse(ctx, organization)
+}
+
+// ReleaseCinema mengembalikan cinema ke pengelolaan platform
+func (s *organizationService) ReleaseCinema(ctx context.Context, organizationID, cinemaID string) error {
+	organization, err := s.findOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	cinemaUUID, err := uuid.Parse(cinemaID)
+	if err != nil {
+		return fmt.Errorf("invalid cinema ID format %s: %w", cinemaID, err)
+	}
+
+	owned, err := s.repo.Organization.OwnsCinema(ctx, organization.ID, cinemaUUID)
+	if err != nil {
+		return fmt.Errorf("check cinema organization: %w", err)
+	}
+	if !owned {
+		return fmt.Errorf("cinema %s not found in organization %s", cinemaID, organization.Slug)
+	}
+
+	if _, err := s.repo.Organization.AssignCinemas(ctx, nil, []uuid.UUID{cinemaUUID}); err != nil {
+		return err
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Cinema released from organization",
+		zap.String("organization_id", organizationID),
+		zap.String("cinema_id", cinemaID),
+	)
+	return nil
+}
+
+// AddAdmin menjadikan user admin chain; admin platform yang dipindah ke chain kehilangan akses global
+func (s *organizationService) AddAdmin(ctx context.Context, organizationID string, req *request.OrganizationAdminRequest) error {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return errs
+	}
+
+	organization, err := s.findOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.findUser(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+	if callerID, ok := utils.GetUserIDFromContext(ctx); ok && callerID == user.ID {
+		return fmt.Errorf("cannot move your own account into an organization")
+	}
+
+	user.Role = entity.RoleAdmin
+	user.OrganizationID = &organization.ID
+	user.UpdatedAt = time.Now()
+
+	if err := s.repo.User.Update(ctx, user); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to add organization admin",
+			zap.Error(err),
+			zap.String("organization_id", organizationID),
+			zap.String("user_id", req.UserID),
+		)
+		return fmt.Errorf("add organization admin: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Organization admin added",
+		zap.String("organization_id", organizationID),
+		zap.String("user_id", req.UserID),
+	)
+	return nil
+}
+
+// RemoveAdmin menurunkan admin chain jadi customer, bukan admin platform
+func (s *organizationService) RemoveAdmin(ctx context.Context, organizationID, userID string) error {
+	organization, err := s.findOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role != entity.RoleAdmin || user.OrganizationID == nil || *user.OrganizationID != organization.ID {
+		return fmt.Errorf("admin %s not found in organization %s", userID, organization.Slug)
+	}
+
+	user.Role = entity.RoleCustomer
+	user.OrganizationID = nil
+	user.UpdatedAt = time.Now()
+
+	if err := s.repo.User.Update(ctx, user); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to remove organization admin",
+			zap.Error(err),
+			zap.String("organization_id", organizationID),
+			zap.String("user_id", userID),
+		)
+		return fmt.Errorf("remove organization admin: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Organization admin removed",
+		zap.String("organization_id", organizationID),
+		zap.String("user_id", userID),
+	)
+	return nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func (s *organizationService) validateRequest(ctx context.Context, req *request.OrganizationRequest, selfID uuid.UUID) error {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Organization validation failed", zap.Any("errors", errs))
+		return errs
+	}
+	if !organizationSlugPattern.MatchString(req.Slug) {
+		return fmt.Errorf("invalid slug %q: use lowercase letters, digits and hyphens", req.Slug)
+	}
+	if prefix := normalizeOrderPrefix(req.OrderPrefix); prefix != nil && !utils.ValidOrderIDPrefix(*prefix) {
+		return fmt.Errorf("invalid order_prefix %q: use 2-8 letters or digits", *req.OrderPrefix)
+	}
+
+	existing, err := s.repo.Organization.FindBySlug(ctx, req.Slug)
+	if err != nil {
+		return fmt.Errorf("check organization slug: %w", err)
+	}
+	if existing != nil && existing.ID != selfID {
+		return fmt.Errorf("organization %s already exists", req.Slug)
+	}
+
+	return nil
+}
+
+// normalizeOrderPrefix uppercases prefix; string kosong berarti kembali ke prefix default
+func normalizeOrderPrefix(prefix *string) *string {
+	if prefix == nil {
+		return nil
+	}
+	normalized := strings.ToUpper(strings.TrimSpace(*prefix))
+	if normalized == "" {
+		return nil
+	}
+	return &normalized
+}
+
+func (s *organizationService) findOrganization(ctx context.Context, organizationID string) (*entity.Organization, error) {
+	id, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid organization ID format %s: %w", organizationID, err)
+	}
+
+	organization, err := s.repo.Organization.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find organization: %w", err)
+	}
+	if organization == nil {
+		return nil, fmt.Errorf("organization %s not found", organizationID)
+	}
+
+	return organization, nil
+}
+
+func (s *organizationService) findUser(ctx context.Context, userID string) (*entity.User, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	user, err := s.repo.User.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		return nil, fmt.Errorf("user %s not found", userID)
+	}
+
+	return user, nil
+}
+
+func (s *organizationService) counts(ctx context.Context, id uuid.UUID) (int64, int64, error) {
+	cinemas, err := s.repo.Organization.CountCinemas(ctx, id)
+	if err != nil {
+		return 0, 0, err
+	}
+	admins, err := s.repo.Organization.CountAdmins(ctx, id)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cinemas, admins, nil
+}
+
+func (s *organizationService) toResponse(ctx context.Context, organization *entity.Organization) (*response.OrganizationResponse, error) {
+	cinemas, admins, err := s.counts(ctx, organization.ID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count organization members",
+			zap.Error(err),
+			zap.String("organization_id", organization.ID.String()),
+		)
+		return nil, fmt.Errorf("count organization members: %w", err)
+	}
+
+	resp := response.OrganizationToResponse(organization, cinemas, admins)
+	return &resp, nil
+}
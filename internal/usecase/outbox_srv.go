@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/events"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type OutboxService interface {
+	// RelayPendingEvents publishes one batch of unpublished events, return jumlah yang terkirim
+	RelayPendingEvents(ctx context.Context) (int, error)
+}
+
+type outboxService struct {
+	repo        *repository.Repository
+	publisher   events.Publisher
+	topicPrefix string
+	batchSize   int
+	maxAttempts int
+	log         *zap.Logger
+}
+
+func NewOutboxService(
+	repo *repository.Repository,
+	publisher events.Publisher,
+	topicPrefix string,
+	batchSize, maxAttempts int,
+	log *zap.Logger,
+) OutboxService {
+	return &outboxService{
+		repo:        repo,
+		publisher:   publisher,
+		topicPrefix: topicPrefix,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		log:         log.With(zap.String("service", "outbox")),
+	}
+}
+
+func (s *outboxService) RelayPendingEvents(ctx context.Context) (int, error) {
+	published := 0
+
+	// Rows tetap di-lock sampai commit, jadi beberapa instance relay tidak kirim event yang sama
+	err := s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		pending, err := tx.Outbox.FindPendingForUpdate(ctx, s.maxAttempts, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range pending {
+			if err := s.publish(ctx, event); err != nil {
+				utils.LoggerFromContext(ctx, s.log).Warn("Failed to publish outbox event",
+					zap.Error(err),
+					zap.String("event_id", event.ID.String()),
+					zap.String("event_type", event.EventType),
+					zap.Int("attempts", event.Attempts+1),
+				)
+				if err := tx.Outbox.MarkFailed(ctx, event.ID, err.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := tx.Outbox.MarkPublished(ctx, event.ID, time.Now()); err != nil {
+				return err
+			}
+			published++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("relay outbox events: %w", err)
+	}
+
+	if published > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Outbox events published", zap.Int("count", published))
+	}
+
+	return published, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func (s *outboxService) publish(ctx context.Context, event *entity.OutboxEvent) error {
+	payload, err := marshalEnvelope(event)
+	if err != nil {
+		return err
+	}
+
+	return s.publisher.Publish(ctx, events.Message{
+		Topic:   s.topicPrefix + event.EventType,
+		Key:     event.AggregateID.String(),
+		Payload: payload,
+	})
+}
+
+// marshalEnvelope is the body yang dikirim ke broker maupun webhook partner
+func marshalEnvelope(event *entity.OutboxEvent) ([]byte, error) {
+	payload, err := json.Marshal(events.Envelope{
+		ID:            event.ID.String(),
+		Type:          event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID.String(),
+		OccurredAt:    event.CreatedAt,
+		Data:          event.Payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal envelope: %w", err)
+	}
+
+	return payload, nil
+}
+
+// enqueueEvent writes an event ke outbox; panggil dengan repo dari WithTx supaya atomic dengan perubahan data.
+// Delivery webhook untuk subscription yang cocok ikut dibuat di tx yang sama.
+func enqueueEvent(ctx context.Context, repo *repository.Repository, aggregateType string, aggregateID uuid.UUID, eventType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal %s event: %w", eventType, err)
+	}
+
+	event := &entity.OutboxEvent{
+		BaseSimple: entity.BaseSimple{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+		},
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       payload,
+	}
+	if err := repo.Outbox.Create(ctx, event); err != nil {
+		return err
+	}
+
+	return enqueueWebhookDeliveries(ctx, repo, event)
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PaymentMethodService admin CRUD termasuk method nonaktif; list untuk user tetap lewat BookingService
+type PaymentMethodService interface {
+	GetPaymentMethods(ctx context.Context) ([]response.PaymentMethodResponse, error)
+	GetPaymentMethodByID(ctx context.Context, paymentMethodID string) (*response.PaymentMethodResponse, error)
+	CreatePaymentMethod(ctx context.Context, req *request.PaymentMethodRequest) (*response.PaymentMethodResponse, error)
+	UpdatePaymentMethod(ctx context.Context, paymentMethodID string, req *request.PaymentMethodUpdateRequest) (*response.PaymentMethodResponse, error)
+	DeletePaymentMethod(ctx context.Context, paymentMethodID string) error
+}
+
+type paymentMethodService struct {
+	repo     *repository.Repository
+	currency utils.Currency // fee di request/response dalam default currency
+	log      *zap.Logger
+}
+
+func NewPaymentMethodService(repo *repository.Repository, pricing utils.PricingConfig, log *zap.Logger) PaymentMethodService {
+	return &paymentMethodService{
+		repo:     repo,
+		currency: utils.CurrencyOf(pricing.Currency),
+		log:      log.With(zap.String("service", "payment_method")),
+	}
+}
+
+func (s *paymentMethodService) GetPaymentMethods(ctx context.Context) ([]response.PaymentMethodResponse, error) {
+	paymentMethods, err := s.repo.PaymentMethod.FindAll(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get payment methods", zap.Error(err))
+		return nil, fmt.Errorf("get payment methods: %w", err)
+	}
+
+	result := make([]response.PaymentMethodResponse, len(paymentMethods))
+	for i, pm := range paymentMethods {
+		result[i] = response.PaymentMethodToResponse(pm, s.currency.Code)
+	}
+
+	return result, nil
+}
+
+func (s *paymentMethodService) GetPaymentMethodByID(ctx context.Context, paymentMethodID string) (*response.PaymentMethodResponse, error) {
+	paymentMethod, err := s.findPaymentMethod(ctx, paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response.PaymentMethodToResponse(paymentMethod, s.currency.Code)
+	return &resp, nil
+}
+
+func (s *paymentMethodService) CreatePaymentMethod(ctx context.Context, req *request.PaymentMethodRequest) (*response.PaymentMethodResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Create payment method validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	if err := s.checkNameAvailable(ctx, req.Name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	paymentMethod := &entity.PaymentMethod{
+		Base: entity.Base{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Name:           req.Name,
+		Type:           entity.PaymentMethodType(req.Type),
+		IsActive:       req.IsActive == nil || *req.IsActive,
+		LogoURL:        req.LogoURL,
+		Instructions:   req.Instructions,
+		ConvenienceFee: s.toMinor(req.ConvenienceFee),
+		FeePercent:     req.FeePercent,
+	}
+
+	if err := s.repo.PaymentMethod.Create(ctx, paymentMethod); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create payment method",
+			zap.Error(err),
+			zap.String("name", req.Name),
+		)
+		return nil, fmt.Errorf("create payment method: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Payment method created",
+		zap.String("payment_method_id", paymentMethod.ID.String()),
+		zap.String("name", paymentMethod.Name),
+		zap.String("type", string(paymentMethod.Type)),
+	)
+
+	resp := response.PaymentMethodToResponse(paymentMethod, s.currency.Code)
+	return &resp, nil
+}
+
+func (s *paymentMethodService) UpdatePaymentMethod(ctx context.Context, paymentMethodID string, req *request.PaymentMethodUpdateRequest) (*response.PaymentMethodResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Update payment method validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	paymentMethod, err := s.findPaymentMethod(ctx, paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && *req.Name != paymentMethod.Name {
+		if err := s.checkNameAvailable(ctx, *req.Name, paymentMethod.ID); err != nil {
+			return nil, err
+		}
+		paymentMethod.Name = *req.Name
+	}
+	if req.Type != nil {
+		paymentMethod.Type = entity.PaymentMethodType(*req.Type)
+	}
+	if req.IsActive != nil {
+		paymentMethod.IsActive = *req.IsActive
+	}
+	if req.LogoURL != nil {
+		paymentMethod.LogoURL = req.LogoURL
+	}
+	if req.Instructions != nil {
+		paymentMethod.Instructions = req.Instructions
+	}
+	if req.ConvenienceFee != nil {
+		paymentMethod.ConvenienceFee = s.toMinor(req.ConvenienceFee)
+	}
+	if req.FeePercent != nil {
+		paymentMethod.FeePercent = req.FeePercent
+	}
+
+	paymentMethod.UpdatedAt = time.Now()
+	if err := s.repo.PaymentMethod.Update(ctx, paymentMethod); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update payment method",
+			zap.Error(err),
+			zap.String("payment_method_id", paymentMethodID),
+		)
+		return nil, fmt.Errorf("update payment method %s: %w", paymentMethodID, err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Payment method updated",
+		zap.String("payment_method_id", paymentMethodID),
+		zap.String("name", paymentMethod.Name),
+	)
+
+	resp := response.PaymentMethodToResponse(paymentMethod, s.currency.Code)
+	return &resp, nil
+}
+
+func (s *paymentMethodService) DeletePaymentMethod(ctx context.Context, paymentMethodID string) error {
+	id, err := uuid.Parse(paymentMethodID)
+	if err != nil {
+		return fmt.Errorf("invalid payment method ID format %s: %w", paymentMethodID, err)
+	}
+
+	// Payment lama tetap menunjuk ke method ini; soft delete jadi histori tetap utuh
+	if err := s.repo.PaymentMethod.Delete(ctx, id); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to delete payment method",
+			zap.Error(err),
+			zap.String("payment_method_id", paymentMethodID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func (s *paymentMethodService) findPaymentMethod(ctx context.Context, paymentMethodID string) (*entity.PaymentMethod, error) {
+	id, err := uuid.Parse(paymentMethodID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment method ID format %s: %w", paymentMethodID, err)
+	}
+
+	paymentMethod, err := s.repo.PaymentMethod.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find payment method: %w", err)
+	}
+	if paymentMethod == nil {
+		return nil, fmt.Errorf("payment method %s not found", paymentMethodID)
+	}
+
+	return paymentMethod, nil
+}
+
+// checkNameAvailable rejects nama yang sudah dipakai method lain (case-insensitive)
+func (s *paymentMethodService) checkNameAvailable(ctx context.Context, name string, selfID uuid.UUID) error {
+	existing, err := s.repo.PaymentMethod.FindByName(ctx, name)
+	if err != nil {
+		return fmt.Errorf("check payment method name: %w", err)
+	}
+	if existing != nil && existing.ID != selfID {
+		return fmt.Errorf("payment method %s already exists", name)
+	}
+
+	return nil
+}
+
+func (s *paymentMethodService) toMinor(amount *float64) *int64 {
+	if amount == nil {
+		return nil
+	}
+
+	minor := s.currency.ToMinor(*amount)
+	return &minor
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// transitionPayment pasangan transitionBooking untuk payment. TransactionID dan PaidAt diset
+// pemanggil sebelumnya karena ikut ditulis bersama status. Panggil di dalam WithTx.
+func transitionPayment(ctx context.Context, tx *repository.Repository, payment *entity.Payment, to entity.PaymentStatus, reason string) error {
+	from := payment.Status
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("invalid payment status change: payment %s is %s, cannot move to %s", payment.ID, from, to)
+	}
+
+	if err := tx.Payment.UpdateStatus(ctx, payment, to); err != nil {
+		return err
+	}
+
+	change := &entity.PaymentStatusChange{
+		BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: time.Now()},
+		PaymentID:  payment.ID,
+		FromStatus: from,
+		ToStatus:   to,
+	}
+	if actorID, ok := utils.GetUserIDFromContext(ctx); ok {
+		change.ActorID = &actorID
+	}
+	if reason != "" {
+		change.Reason = &reason
+	}
+	if err := tx.PaymentHistory.Create(ctx, change); err != nil {
+		return err
+	}
+
+	payment.Status = to
+	payment.UpdatedAt = change.CreatedAt
+	return nil
+}
+
+func (s *bookingService) loadPaymentStatusHistory(ctx context.Context, paymentID uuid.UUID) ([]response.PaymentStatusChangeResponse, error) {
+	history, err := s.repo.PaymentHistory.FindByPaymentID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("find payment status history: %w", err)
+	}
+
+	result := make([]response.PaymentStatusChangeResponse, len(history))
+	for i, change := range history {
+		result[i] = response.PaymentStatusChangeToResponse(change)
+	}
+	return result, nil
+}
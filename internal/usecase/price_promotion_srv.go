@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PricePromotionService admin CRUD promo jam tertentu. Promo dipakai di listing schedule
+// dan saat booking lewat pricingRules.promotedPrice.
+type PricePromotionService interface {
+	GetPromotions(ctx context.Context) ([]response.PricePromotionResponse, error)
+	GetPromotionByID(ctx context.Context, promotionID string) (*response.PricePromotionResponse, error)
+	CreatePromotion(ctx context.Context, req *request.PricePromotionRequest) (*response.PricePromotionResponse, error)
+	UpdatePromotion(ctx context.Context, promotionID string, req *request.PricePromotionRequest) (*response.PricePromotionResponse, error)
+	DeletePromotion(ctx context.Context, promotionID string) error
+}
+
+type pricePromotionService struct {
+	repo *repository.Repository
+	log  *zap.Logger
+}
+
+func NewPricePromotionService(repo *repository.Repository, log *zap.Logger) PricePromotionService {
+	return &pricePromotionService{
+		repo: repo,
+		log:  log.With(zap.String("service", "price_promotion")),
+	}
+}
+
+func (s *pricePromotionService) GetPromotions(ctx context.Context) ([]response.PricePromotionResponse, error) {
+	promotions, err := s.repo.PricePromotion.FindAll(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get price promotions", zap.Error(err))
+		return nil, fmt.Errorf("get price promotions: %w", err)
+	}
+
+	result := make([]response.PricePromotionResponse, len(promotions))
+	for i, promotion := range promotions {
+		result[i] = response.PricePromotionToResponse(promotion)
+	}
+
+	return result, nil
+}
+
+func (s *pricePromotionService) GetPromotionByID(ctx context.Context, promotionID string) (*response.PricePromotionResponse, error) {
+	promotion, err := s.findPromotion(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response.PricePromotionToResponse(promotion)
+	return &resp, nil
+}
+
+func (s *pricePromotionService) CreatePromotion(ctx context.Context, req *request.PricePromotionRequest) (*response.PricePromotionResponse, error) {
+	now := time.Now()
+	promotion := &entity.PricePromotion{
+		Base: entity.Base{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	if err := s.applyRequest(ctx, promotion, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.PricePromotion.Create(ctx, promotion); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create price promotion",
+			zap.Error(err),
+			zap.String("name", req.Name),
+		)
+		return nil, fmt.Errorf("create price promotion: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Price promotion created",
+		zap.String("promotion_id", promotion.ID.String()),
+		zap.String("name", promotion.Name),
+		zap.Float64("discount_percent", promotion.DiscountPercent),
+	)
+
+	resp := response.PricePromotionToResponse(promotion)
+	return &resp, nil
+}
+
+func (s *pricePromotionService) UpdatePromotion(ctx context.Context, promotionID string, req *request.PricePromotionRequest) (*response.PricePromotionResponse, error) {
+	promotion, err := s.findPromotion(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyRequest(ctx, promotion, req); err != nil {
+		return nil, err
+	}
+
+	promotion.UpdatedAt = time.Now()
+	if err := s.repo.PricePromotion.Update(ctx, promotion); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update price promotion",
+			zap.Error(err),
+			zap.String("promotion_id", promotionID),
+		)
+		return nil, fmt.Errorf("update price promotion %s: %w", promotionID, err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Price promotion updated",
+		zap.String("promotion_id", promotionID),
+		zap.String("name", promotion.Name),
+	)
+
+	resp := response.PricePromotionToResponse(promotion)
+	return &resp, nil
+}
+
+func (s *pricePromotionService) DeletePromotion(ctx context.Context, promotionID string) error {
+	id, err := uuid.Parse(promotionID)
+	if err != nil {
+		return fmt.Errorf("invalid promotion ID format %s: %w", promotionID, err)
+	}
+
+	// Booking yang sudah dapat diskon menyimpan nominalnya sendiri, jadi promo aman dihapus
+	if err := s.repo.PricePromotion.Delete(ctx, id); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to delete price promotion",
+			zap.Error(err),
+			zap.String("promotion_id", promotionID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// ==================== HELPER METHODS ====================
+
+func (s *pricePromotionService) findPromotion(ctx context.Context, promotionID string) (*entity.PricePromotion, error) {
+	id, err := uuid.Parse(promotionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid promotion ID format %s: %w", promotionID, err)
+	}
+
+	promotion, err := s.repo.PricePromotion.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find price promotion: %w", err)
+	}
+	if promotion == nil {
+		return nil, fmt.Errorf("price promotion %s not found", promotionID)
+	}
+
+	return promotion, nil
+}
+
+// applyRequest validates req lalu menimpa semua field promo (PUT adalah replace penuh)
+func (s *pricePromotionService) applyRequest(ctx context.Context, promotion *entity.PricePromotion, req *request.PricePromotionRequest) error {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Price promotion validation failed", zap.Any("errors", errs))
+		return errs
+	}
+
+	startTime, _ := time.Parse("15:04", req.StartTime)
+	endTime, _ := time.Parse("15:04", req.EndTime)
+	if !endTime.After(startTime) {
+		return fmt.Errorf("invalid time window: end_time %s must be after start_time %s", req.EndTime, req.StartTime)
+	}
+
+	var validFrom, validUntil *time.Time
+	if req.ValidFrom != nil {
+		date, _ := time.Parse("2006-01-02", *req.ValidFrom)
+		validFrom = &date
+	}
+	if req.ValidUntil != nil {
+		date, _ := time.Parse("2006-01-02", *req.ValidUntil)
+		validUntil = &date
+	}
+	if validFrom != nil && validUntil != nil && validUntil.Before(*validFrom) {
+		return fmt.Errorf("invalid validity period: valid_until %s is before valid_from %s", *req.ValidUntil, *req.ValidFrom)
+	}
+
+	var cinemaID *uuid.UUID
+	if req.CinemaID != nil {
+		id, err := uuid.Parse(*req.CinemaID)
+		if err != nil {
+			return fmt.Errorf("invalid cinema ID format %s: %w", *req.CinemaID, err)
+		}
+		cinema, err := s.repo.Cinema.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("find cinema: %w", err)
+		}
+		if cinema == nil {
+			return fmt.Errorf("cinema %s not found", *req.CinemaID)
+		}
+		cinemaID = &id
+	}
+
+	weekdays := make([]int16, len(req.Weekdays))
+	for i, day := range req.Weekdays {
+		weekdays[i] = int16(day)
+	}
+
+	tiers := make([]entity.UserTier, len(req.Tiers))
+	for i, tier := range req.Tiers {
+		tiers[i] = entity.UserTier(tier)
+	}
+
+	promotion.Name = req.Name
+	promotion.DiscountPercent = req.DiscountPercent
+	promotion.Weekdays = weekdays
+	promotion.StartTime = startTime
+	promotion.EndTime = endTime
+	promotion.ValidFrom = validFrom
+	promotion.ValidUntil = validUntil
+	promotion.CinemaID = cinemaID
+	promotion.Tiers = tiers
+	promotion.IsActive = req.IsActive == nil || *req.IsActive
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"math"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+)
+
+// pricingRules computes the per-seat ticket price dari base price schedule,
+// plus fee dan pajak yang ditambahkan ke total booking. Semua nominal dalam minor unit.
+type pricingRules struct {
+	hallTypeMultipliers map[entity.HallType]float64
+	currency            utils.Currency
+	amountTolerance     int64
+	convenienceFee      int64
+	taxRate             float64
+	freeFeeTiers        map[entity.UserTier]bool
+}
+
+func newPricingRules(config utils.PricingConfig) pricingRules {
+	currency := utils.CurrencyOf(config.Currency)
+
+	freeFeeTiers := make(map[entity.UserTier]bool, len(config.FreeFeeTiers))
+	for _, tier := range config.FreeFeeTiers {
+		freeFeeTiers[entity.UserTier(tier)] = true
+	}
+
+	return pricingRules{
+		hallTypeMultipliers: map[entity.HallType]float64{
+			entity.HallType2D:   1,
+			entity.HallType3D:   config.Multiplier3D,
+			entity.HallTypeIMAX: config.MultiplierIMAX,
+			entity.HallType4DX:  config.Multiplier4DX,
+		},
+		currency:        currency,
+		amountTolerance: currency.ToMinor(config.AmountTolerance),
+		convenienceFee:  currency.ToMinor(config.ConvenienceFee),
+		taxRate:         config.TaxRate,
+		freeFeeTiers:    freeFeeTiers,
+	}
+}
+
+// seatPrice applies the hall format premium; hall nil atau multiplier <= 0 berarti harga dasar.
+// PriceOverride schedule menggantikan hasil multiplier sepenuhnya.
+func (r pricingRules) seatPrice(schedule *entity.Schedule, hall *entity.Hall) int64 {
+	if schedule.PriceOverride != nil {
+		return *schedule.PriceOverride
+	}
+
+	price := schedule.Price
+	if hall != nil {
+		if multiplier := r.hallTypeMultipliers[hall.HallType]; multiplier > 0 {
+			price = int64(math.Round(float64(price) * multiplier))
+		}
+	}
+
+	return price
+}
+
+// promotedPrice returns harga kursi setelah promo dengan diskon terbesar yang cocok, plus promo-nya (nil kalau tidak ada).
+// Schedule dengan PriceOverride tidak ikut promo karena harganya sudah ditentukan admin.
+// tier customer yang membeli; kosong kalau tidak diketahui sehingga promo khusus tier dilewati.
+func (r pricingRules) promotedPrice(schedule *entity.Schedule, hall *entity.Hall, promotions []*entity.PricePromotion, tier entity.UserTier) (int64, *entity.PricePromotion) {
+	price := r.seatPrice(schedule, hall)
+	if schedule.PriceOverride != nil || hall == nil {
+		return price, nil
+	}
+
+	var best *entity.PricePromotion
+	for _, promotion := range promotions {
+		if !promotion.Matches(schedule.ShowDate, schedule.ShowTime, hall.CinemaID, tier) {
+			continue
+		}
+		if best == nil || promotion.DiscountPercent > best.DiscountPercent {
+			best = promotion
+		}
+	}
+	if best == nil {
+		return price, nil
+	}
+
+	discount := int64(math.Round(float64(price) * best.DiscountPercent))
+	return max(price-discount, 0), best
+}
+
+// applyCharges mengisi fee, pajak dan total booking dari BasePrice dan DiscountAmount.
+// Fee per kursi bisa di-override payment method (plus fee persen opsional), tax rate bisa di-override cinema.
+// Tier di freeFeeTiers bebas fee per kursi; fee persen payment method tetap berlaku.
+func (r pricingRules) applyCharges(booking *entity.Booking, method *entity.PaymentMethod, cinema *entity.Cinema, tier entity.UserTier) {
+	fee := r.convenienceFee
+	if method != nil && method.ConvenienceFee != nil {
+		fee = *method.ConvenienceFee
+	}
+	if r.freeFeeTiers[tier] {
+		fee = 0
+	}
+
+	taxRate := r.taxRate
+	if cinema != nil && cinema.TaxRate != nil {
+		taxRate = *cinema.TaxRate
+	}
+
+	ticketPrice := max(booking.BasePrice-booking.DiscountAmount, 0)
+
+	booking.FeeAmount = fee * int64(booking.TotalSeats)
+	if method != nil && method.FeePercent != nil {
+		booking.FeeAmount += int64(math.Round(float64(ticketPrice) * *method.FeePercent))
+	}
+
+	// Pajak dihitung dari harga setelah diskon ditambah fee
+	taxable := ticketPrice + booking.FeeAmount
+	booking.TaxAmount = int64(math.Round(float64(taxable) * taxRate))
+	booking.TotalPrice = taxable + booking.TaxAmount
+}
+
+// applyGroupCharges is applyCharges tanpa convenience fee; group booking ditagih manual di luar payment gateway
+func (r pricingRules) applyGroupCharges(booking *entity.Booking, cinema *entity.Cinema) {
+	noFee := int64(0)
+	r.applyCharges(booking, &entity.PaymentMethod{ConvenienceFee: &noFee}, cinema, "")
+}
+
+// amountMatches checks amount (major unit) yang ditampilkan client masih sesuai total server
+func (r pricingRules) amountMatches(amount float64, total int64, currency string) bool {
+	diff := utils.CurrencyOf(currency).ToMinor(amount) - total
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff <= r.amountTolerance
+}
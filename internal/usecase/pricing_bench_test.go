@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+func benchPricingRules() pricingRules {
+	return newPricingRules(utils.PricingConfig{
+		Multiplier3D:    1.25,
+		MultiplierIMAX:  1.6,
+		Multiplier4DX:   1.8,
+		AmountTolerance: 1,
+		ConvenienceFee:  4000,
+		TaxRate:         0.11,
+		Currency:        "IDR",
+		FreeFeeTiers:    []string{"gold", "platinum"},
+	})
+}
+
+// benchPromotions sejumlah promo aktif yang sebagian besar tidak cocok, seperti daftar promo saat on-sale
+func benchPromotions(cinemaID uuid.UUID, n int) []*entity.PricePromotion {
+	start := time.Date(2000, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	promotions := make([]*entity.PricePromotion, 0, n)
+	for i := 0; i < n; i++ {
+		promotion := &entity.PricePromotion{
+			Base:            entity.Base{ID: uuid.New()},
+			DiscountPercent: float64(i%5+1) / 20,
+			Weekdays:        []int16{int16(i % 7)},
+			StartTime:       start,
+			EndTime:         end,
+			IsActive:        true,
+		}
+		if i%3 == 0 {
+			promotion.CinemaID = &cinemaID
+		}
+		if i%4 == 0 {
+			promotion.Tiers = []entity.UserTier{entity.TierGold, entity.TierPlatinum}
+		}
+		promotions = append(promotions, promotion)
+	}
+	return promotions
+}
+
+func BenchmarkPricingRules_PromotedPrice(b *testing.B) {
+	rules := benchPricingRules()
+	hall := &entity.Hall{Base: entity.Base{ID: uuid.New()}, CinemaID: uuid.New(), HallType: entity.HallTypeIMAX}
+	showAt := time.Date(2026, 10, 17, 19, 30, 0, 0, time.UTC)
+	schedule := &entity.Schedule{Price: 5000000, ShowDate: showAt, ShowTime: showAt, Currency: "IDR"}
+	promotions := benchPromotions(hall.CinemaID, 50)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		rules.promotedPrice(schedule, hall, promotions, entity.TierGold)
+	}
+}
+
+func BenchmarkPricingRules_ApplyCharges(b *testing.B) {
+	rules := benchPricingRules()
+	fee := int64(650000)
+	feePercent := 0.007
+	method := &entity.PaymentMethod{ConvenienceFee: &fee, FeePercent: &feePercent}
+	taxRate := 0.1
+	cinema := &entity.Cinema{TaxRate: &taxRate}
+
+	for _, tc := range []struct {
+		name string
+		tier entity.UserTier
+	}{
+		{name: "standard", tier: entity.TierStandard},
+		{name: "free fee tier", tier: entity.TierGold},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			booking := &entity.Booking{TotalSeats: 4, BasePrice: 20000000, DiscountAmount: 1500000, Currency: "IDR"}
+
+			b.ReportAllocs()
+			for b.Loop() {
+				rules.applyCharges(booking, method, cinema, tc.tier)
+			}
+		})
+	}
+}
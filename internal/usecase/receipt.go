@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/pdf"
+	"cinema-booking/pkg/utils"
+)
+
+// receiptContentType MIME type receipt, dipakai handler dan attachment email
+const receiptContentType = "application/pdf"
+
+// receiptData is everything printed on the invoice; diisi oleh bookingService.loadReceiptData
+type receiptData struct {
+	booking       *entity.Booking
+	payment       *entity.Payment
+	paymentMethod *entity.PaymentMethod
+	user          *entity.User // boleh nil kalau user sudah dihapus
+	schedule      response.ScheduleDetails
+	seatNumbers   []string
+}
+
+// Layout receipt dalam point, origin kiri atas
+const (
+	receiptMarginX   = 50.0
+	receiptAmountX   = pdf.PageWidth - 50.0
+	receiptLabelX    = 180.0
+	receiptLineGap   = 16.0
+	receiptBodySize  = 10.0
+	receiptTitleSize = 20.0
+)
+
+// receiptFilename nama file PDF untuk satu booking
+func receiptFilename(orderID string) string {
+	return fmt.Sprintf("receipt-%s.pdf", orderID)
+}
+
+// renderReceipt draws a single-page A4 invoice
+func renderReceipt(data receiptData) ([]byte, error) {
+	booking := data.booking
+	currency := utils.CurrencyOf(booking.Currency)
+
+	doc := pdf.New()
+	doc.AddPage()
+
+	y := 70.0
+	doc.Text(receiptMarginX, y, pdf.FontBold, receiptTitleSize, "Cinema Booking")
+	doc.TextRight(receiptAmountX, y, pdf.FontBold, 14, "RECEIPT / INVOICE")
+	y += 14
+	doc.Line(receiptMarginX, y, receiptAmountX, y, 1)
+	y += 24
+
+	field := func(label, value string) {
+		doc.Text(receiptMarginX, y, pdf.FontBold, receiptBodySize, label)
+		lines := wrapText(value, pdf.FontRegular, receiptBodySize, receiptAmountX-receiptLabelX)
+		for _, line := range lines {
+			doc.Text(receiptLabelX, y, pdf.FontRegular, receiptBodySize, line)
+			y += receiptLineGap
+		}
+	}
+	section := func(title string) {
+		y += 8
+		doc.Text(receiptMarginX, y, pdf.FontBold, 12, title)
+		y += 6
+		doc.Line(receiptMarginX, y, receiptAmountX, y, 0.5)
+		y += receiptLineGap
+	}
+
+	issuedAt := data.payment.CreatedAt
+	if data.payment.PaidAt != nil {
+		issuedAt = *data.payment.PaidAt
+	}
+
+	field("Order ID", booking.OrderID)
+	field("Issued", issuedAt.Format("02 Jan 2006 15:04 MST"))
+	field("Booking status", string(booking.Status))
+	if data.user != nil {
+		field("Billed to", fmt.Sprintf("%s <%s>", data.user.Username, data.user.Email))
+	}
+
+	section("Order details")
+	field("Movie", data.schedule.MovieTitle)
+	field("Cinema", data.schedule.CinemaName)
+	hall := fmt.Sprintf("Hall %d", data.schedule.HallNumber)
+	if data.schedule.HallType != "" {
+		hall += " (" + data.schedule.HallType + ")"
+	}
+	field("Hall", hall)
+	field("Showtime", data.schedule.ShowDate+" "+data.schedule.ShowTime)
+	field("Seats", strings.Join(data.seatNumbers, ", "))
+
+	section("Charges")
+	amount := func(label string, value int64, font pdf.Font) {
+		doc.Text(receiptMarginX, y, font, receiptBodySize, label)
+		doc.TextRight(receiptAmountX, y, font, receiptBodySize, currency.Format(value))
+		y += receiptLineGap
+	}
+
+	var seatPrice int64
+	if booking.TotalSeats > 0 {
+		seatPrice = booking.BasePrice / int64(booking.TotalSeats)
+	}
+	amount(fmt.Sprintf("Tickets (%d x %s)", booking.TotalSeats, currency.Format(seatPrice)), booking.BasePrice, pdf.FontRegular)
+	if booking.DiscountAmount > 0 {
+		amount("Discount", -booking.DiscountAmount, pdf.FontRegular)
+	}
+	amount("Convenience fee", booking.FeeAmount, pdf.FontRegular)
+	amount("Tax", booking.TaxAmount, pdf.FontRegular)
+	doc.Line(receiptLabelX, y-10, receiptAmountX, y-10, 0.5)
+	y += 4
+	amount("Total", booking.TotalPrice, pdf.FontBold)
+
+	section("Payment")
+	field("Method", data.paymentMethod.Name)
+	field("Status", string(data.payment.Status))
+	transactionID := "-"
+	if data.payment.TransactionID != nil && *data.payment.TransactionID != "" {
+		transactionID = *data.payment.TransactionID
+	}
+	field("Transaction ID", transactionID)
+	field("Amount paid", utils.CurrencyOf(data.payment.Currency).Format(data.payment.Amount))
+
+	y += 24
+	doc.Text(receiptMarginX, y, pdf.FontRegular, 8,
+		fmt.Sprintf("Generated %s. Please show your order ID at the cinema counter.", time.Now().Format("02 Jan 2006 15:04 MST")))
+
+	content, err := doc.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("render receipt %s: %w", booking.OrderID, err)
+	}
+
+	return content, nil
+}
+
+// wrapText memecah teks per kata supaya tidak melewati lebar kolom
+func wrapText(text string, font pdf.Font, size, maxWidth float64) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []string{"-"}
+	}
+
+	var lines []string
+	line := words[0]
+	for _, word := range words[1:] {
+		candidate := line + " " + word
+		if pdf.TextWidth(font, size, candidate) > maxWidth {
+			lines = append(lines, line)
+			line = word
+			continue
+		}
+		line = candidate
+	}
+
+	return append(lines, line)
+}
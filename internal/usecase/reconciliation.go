@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+)
+
+// Mismatch types yang dilaporkan reconciliation
+const (
+	mismatchMissingLocal        = "missing_local"        // ada di settlement, tidak ada payment lokal
+	mismatchMissingSettlement   = "missing_settlement"   // payment completed lokal tidak ada di settlement
+	mismatchDuplicateSettlement = "duplicate_settlement" // transaction_id muncul lebih dari sekali di file
+	mismatchDuplicateLocal      = "duplicate_local"      // lebih dari satu payment lokal dengan transaction_id sama
+	mismatchAmount              = "amount_mismatch"
+	mismatchStatus              = "status_mismatch" // gateway settle tapi payment lokal belum completed
+)
+
+// maxSettlementRows batas baris settlement file supaya matching tetap in-memory
+const maxSettlementRows = 50000
+
+// settlementRow satu baris settlement file gateway; amount sudah dalam minor unit
+type settlementRow struct {
+	line          int
+	transactionID string
+	amount        int64
+}
+
+// parseSettlementCSV reads a header-based CSV. Kolom wajib transaction_id dan amount (major unit),
+// kolom lain diabaikan supaya format export tiap gateway bisa dipakai langsung.
+func parseSettlementCSV(r io.Reader, currency utils.Currency) ([]settlementRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid settlement file: empty file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid settlement file: %w", err)
+	}
+
+	txCol, amountCol := -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))) {
+		case "transaction_id":
+			txCol = i
+		case "amount":
+			amountCol = i
+		}
+	}
+	if txCol < 0 || amountCol < 0 {
+		return nil, fmt.Errorf("invalid settlement file: header must contain transaction_id and amount")
+	}
+
+	var rows []settlementRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid settlement file: %w", err)
+		}
+		if len(record) <= txCol || len(record) <= amountCol {
+			return nil, fmt.Errorf("invalid settlement file: line %d has too few columns", line)
+		}
+
+		transactionID := strings.TrimSpace(record[txCol])
+		if transactionID == "" {
+			return nil, fmt.Errorf("invalid settlement file: line %d has empty transaction_id", line)
+		}
+		amount, err := strconv.ParseFloat(strings.TrimSpace(record[amountCol]), 64)
+		if err != nil || amount < 0 {
+			return nil, fmt.Errorf("invalid settlement file: line %d has invalid amount %q", line, record[amountCol])
+		}
+
+		rows = append(rows, settlementRow{
+			line:          line,
+			transactionID: transactionID,
+			amount:        currency.ToMinor(amount),
+		})
+		if len(rows) > maxSettlementRows {
+			return nil, fmt.Errorf("invalid settlement file: maximum %d rows", maxSettlementRows)
+		}
+	}
+
+	return rows, nil
+}
+
+// reconcilePayments compares settlement rows against local payments.
+// Payment di luar filter (periode / gateway) hanya dicocokkan, tidak dilaporkan missing_settlement.
+func reconcilePayments(rows []settlementRow, payments []*entity.PaymentReportRow, filter repository.PaymentReportFilter, currency utils.Currency) (int, []*response.ReconciliationMismatchResponse) {
+	localByTx := make(map[string][]*entity.PaymentReportRow)
+	for _, payment := range payments {
+		if payment.TransactionID != nil && *payment.TransactionID != "" {
+			localByTx[*payment.TransactionID] = append(localByTx[*payment.TransactionID], payment)
+		}
+	}
+
+	var mismatches []*response.ReconciliationMismatchResponse
+	add := func(m *response.ReconciliationMismatchResponse) {
+		mismatches = append(mismatches, m)
+	}
+
+	matched := 0
+	firstLine := make(map[string]int, len(rows))
+	for _, row := range rows {
+		settlementAmount := currency.ToMajor(row.amount)
+
+		if line, ok := firstLine[row.transactionID]; ok {
+			add(&response.ReconciliationMismatchResponse{
+				Type:             mismatchDuplicateSettlement,
+				Line:             row.line,
+				TransactionID:    row.transactionID,
+				SettlementAmount: &settlementAmount,
+				Detail:           fmt.Sprintf("transaction already listed on line %d", line),
+			})
+			continue
+		}
+		firstLine[row.transactionID] = row.line
+
+		locals := localByTx[row.transactionID]
+		if len(locals) == 0 {
+			add(&response.ReconciliationMismatchResponse{
+				Type:             mismatchMissingLocal,
+				Line:             row.line,
+				TransactionID:    row.transactionID,
+				SettlementAmount: &settlementAmount,
+				Detail:           "no local payment with this transaction_id",
+			})
+			continue
+		}
+
+		if len(locals) > 1 {
+			ids := make([]string, len(locals))
+			for i, local := range locals {
+				ids[i] = local.ID.String()
+			}
+			add(&response.ReconciliationMismatchResponse{
+				Type:             mismatchDuplicateLocal,
+				Line:             row.line,
+				TransactionID:    row.transactionID,
+				SettlementAmount: &settlementAmount,
+				Detail:           "transaction_id shared by payments " + strings.Join(ids, ", "),
+			})
+		}
+
+		local := pickSettledPayment(locals)
+		localAmount := utils.CurrencyOf(local.Currency).ToMajor(local.Amount)
+		clean := len(locals) == 1
+
+		if !local.Status.Settled() {
+			clean = false
+			add(&response.ReconciliationMismatchResponse{
+				Type:             mismatchStatus,
+				Line:             row.line,
+				TransactionID:    row.transactionID,
+				PaymentID:        local.ID.String(),
+				OrderID:          local.OrderID,
+				LocalStatus:      local.Status,
+				LocalAmount:      &localAmount,
+				SettlementAmount: &settlementAmount,
+				Detail:           fmt.Sprintf("settled by gateway but local payment is %s", local.Status),
+			})
+		}
+
+		if local.Currency != currency.Code || local.Amount != row.amount {
+			clean = false
+			add(&response.ReconciliationMismatchResponse{
+				Type:             mismatchAmount,
+				Line:             row.line,
+				TransactionID:    row.transactionID,
+				PaymentID:        local.ID.String(),
+				OrderID:          local.OrderID,
+				LocalStatus:      local.Status,
+				LocalAmount:      &localAmount,
+				SettlementAmount: &settlementAmount,
+				Detail: fmt.Sprintf("local %s, settlement %s",
+					utils.CurrencyOf(local.Currency).Format(local.Amount), currency.Format(row.amount)),
+			})
+		}
+
+		if clean {
+			matched++
+		}
+	}
+
+	// Payment completed dalam periode yang tidak ada di settlement
+	for _, payment := range payments {
+		if !payment.Status.Settled() || !inReconciliationScope(payment, filter) {
+			continue
+		}
+
+		detail := "completed payment not found in settlement file"
+		transactionID := ""
+		if payment.TransactionID == nil || *payment.TransactionID == "" {
+			detail = "completed payment has no transaction_id"
+		} else if _, ok := firstLine[*payment.TransactionID]; ok {
+			continue
+		} else {
+			transactionID = *payment.TransactionID
+		}
+
+		localAmount := utils.CurrencyOf(payment.Currency).ToMajor(payment.Amount)
+		add(&response.ReconciliationMismatchResponse{
+			Type:          mismatchMissingSettlement,
+			TransactionID: transactionID,
+			PaymentID:     payment.ID.String(),
+			OrderID:       payment.OrderID,
+			LocalStatus:   payment.Status,
+			LocalAmount:   &localAmount,
+			Detail:        detail,
+		})
+	}
+
+	return matched, mismatches
+}
+
+// pickSettledPayment prefers the settled payment kalau transaction_id dipakai lebih dari satu payment
+func pickSettledPayment(payments []*entity.PaymentReportRow) *entity.PaymentReportRow {
+	for _, payment := range payments {
+		if payment.Status.Settled() {
+			return payment
+		}
+	}
+	return payments[0]
+}
+
+// inReconciliationScope mirrors the date (created_at::date) dan gateway filter di query
+func inReconciliationScope(payment *entity.PaymentReportRow, filter repository.PaymentReportFilter) bool {
+	if filter.PaymentMethodID != nil && payment.PaymentMethodID != *filter.PaymentMethodID {
+		return false
+	}
+
+	date := time.Date(payment.CreatedAt.Year(), payment.CreatedAt.Month(), payment.CreatedAt.Day(), 0, 0, 0, 0, time.UTC)
+	return !date.Before(filter.StartDate) && !date.After(filter.EndDate)
+}
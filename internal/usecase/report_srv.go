@@ -0,0 +1,557 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/export"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxReportRangeDays batas range laporan supaya query agregat tidak terlalu berat
+	maxReportRangeDays = 366
+
+	// exportBatchSize jumlah row per query saat export, keeps memory flat for large ranges
+	exportBatchSize = 500
+
+	// maxReconcileRangeDays settlement gateway biasanya harian/bulanan; semua payment periode dimuat ke memory
+	maxReconcileRangeDays = 31
+)
+
+type ReportService interface {
+	GetSalesReport(ctx context.Context, req *request.SalesReportRequest) (*response.SalesReportResponse, error)
+	GetTodaySummary(ctx context.Context) (*response.SalesSummaryResponse, error)
+	GetOccupancyReport(ctx context.Context, req *request.OccupancyReportRequest) (*response.OccupancyReportResponse, error)
+	// GetFunnelReport conversion seat view -> hold -> booking -> payment, total dan per movie
+	GetFunnelReport(ctx context.Context, req *request.FunnelReportRequest) (*response.FunnelReportResponse, error)
+
+	// Exports stream rows to w; nothing is written to w when validation fails
+	ExportSalesReport(ctx context.Context, req *request.SalesReportRequest, format export.Format, w io.Writer) error
+	ExportBookings(ctx context.Context, req *request.ExportBookingsRequest, format export.Format, w io.Writer) error
+
+	// Payment reconciliation
+	GetPaymentReport(ctx context.Context, req *request.PaymentReportRequest) (*response.PaymentReportResponse, error)
+	ReconcilePayments(ctx context.Context, req *request.ReconcilePaymentsRequest, settlement io.Reader) (*response.PaymentReconciliationResponse, error)
+}
+
+// reportService menjumlahkan nominal apa adanya, jadi laporan diasumsikan satu currency (default)
+type reportService struct {
+	repo     *repository.Repository
+	currency utils.Currency
+	log      *zap.Logger
+}
+
+func NewReportService(repo *repository.Repository, pricing utils.PricingConfig, log *zap.Logger) ReportService {
+	return &reportService{
+		repo:     repo,
+		currency: utils.CurrencyOf(pricing.Currency),
+		log:      log.With(zap.String("service", "report")),
+	}
+}
+
+func (s *reportService) GetSalesReport(ctx context.Context, req *request.SalesReportRequest) (*response.SalesReportResponse, error) {
+	// 1. Validate request
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	// 2. Parse & check date range
+	startDate, endDate, err := s.parseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Aggregate
+	rows, err := s.repo.Report.GetSales(ctx, startDate, endDate, repository.ReportGroupBy(req.GroupBy), adminOrganization(ctx))
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get sales report",
+			zap.String("start_date", req.StartDate),
+			zap.String("end_date", req.EndDate),
+			zap.String("group_by", req.GroupBy),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get sales report")
+	}
+
+	// 4. Build response + totals
+	result := &response.SalesReportResponse{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		GroupBy:   req.GroupBy,
+		Currency:  s.currency.Code,
+		Rows:      make([]*response.SalesReportRowResponse, 0, len(rows)),
+		Totals:    response.SalesReportRowResponse{Key: "total", Label: "Total"},
+	}
+	var totalRevenue int64
+	for _, row := range rows {
+		result.Rows = append(result.Rows, response.SalesReportRowToResponse(row, s.currency))
+		totalRevenue += row.Revenue
+		result.Totals.TicketsSold += row.TicketsSold
+		result.Totals.Bookings += row.Bookings
+		result.Totals.Capacity += row.Capacity
+	}
+	result.Totals.Revenue = s.currency.ToMajor(totalRevenue)
+	result.Totals.OccupancyRate = response.OccupancyRate(result.Totals.TicketsSold, result.Totals.Capacity)
+
+	return result, nil
+}
+
+func (s *reportService) GetTodaySummary(ctx context.Context) (*response.SalesSummaryResponse, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	summary, err := s.repo.Report.GetDailySummary(ctx, today, adminOrganization(ctx))
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get today summary", zap.Error(err))
+		return nil, fmt.Errorf("failed to get sales summary")
+	}
+
+	return response.SalesSummaryToResponse(summary, s.currency), nil
+}
+
+func (s *reportService) GetOccupancyReport(ctx context.Context, req *request.OccupancyReportRequest) (*response.OccupancyReportResponse, error) {
+	// 1. Validate request
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	cinemaID, err := uuid.Parse(req.CinemaID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cinema ID format")
+	}
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format")
+	}
+
+	// 2. Make sure cinema exists
+	cinema, err := s.repo.Cinema.FindByID(ctx, cinemaID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to find cinema for occupancy report",
+			zap.String("cinema_id", req.CinemaID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get cinema")
+	}
+	if cinema == nil {
+		return nil, fmt.Errorf("cinema not found")
+	}
+	if err := requireCinemaScope(ctx, s.repo, cinemaID); err != nil {
+		return nil, err
+	}
+
+	// 3. Per-schedule occupancy
+	rows, err := s.repo.Report.GetScheduleOccupancy(ctx, cinemaID, date)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get occupancy report",
+			zap.String("cinema_id", req.CinemaID),
+			zap.String("date", req.Date),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get occupancy report")
+	}
+
+	result := &response.OccupancyReportResponse{
+		CinemaID:   cinema.ID.String(),
+		CinemaName: cinema.Name,
+		Date:       req.Date,
+		Schedules:  make([]*response.ScheduleOccupancyResponse, 0, len(rows)),
+	}
+	for _, row := range rows {
+		result.Schedules = append(result.Schedules, response.ScheduleOccupancyToResponse(row))
+		result.TotalCapacity += row.Capacity
+		result.TotalSold += row.SoldSeats
+	}
+	result.OccupancyRate = response.OccupancyRate(int64(result.TotalSold), int64(result.TotalCapacity))
+
+	return result, nil
+}
+
+func (s *reportService) GetFunnelReport(ctx context.Context, req *request.FunnelReportRequest) (*response.FunnelReportResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	from, err := time.Parse("2006-01-02", req.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from format")
+	}
+	to, err := time.Parse("2006-01-02", req.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to format")
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("invalid date range: to must not be before from")
+	}
+	if to.Sub(from) > maxReportRangeDays*24*time.Hour {
+		return nil, fmt.Errorf("invalid date range: maximum %d days", maxReportRangeDays)
+	}
+
+	rows, err := s.repo.Report.GetFunnel(ctx, from, to.AddDate(0, 0, 1), adminOrganization(ctx))
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get funnel report",
+			zap.String("from", req.From),
+			zap.String("to", req.To),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get funnel report")
+	}
+
+	// Rows urut per movie dari query, jadi urutan movie di response ikut urutan pertama kali muncul
+	totals := make(map[entity.FunnelStep]int64)
+	perMovie := make(map[uuid.UUID]map[entity.FunnelStep]int64)
+	var movieIDs []uuid.UUID
+	titles := make(map[uuid.UUID]string)
+	for _, row := range rows {
+		totals[row.Step] += row.Count
+		counts, ok := perMovie[row.MovieID]
+		if !ok {
+			counts = make(map[entity.FunnelStep]int64)
+			perMovie[row.MovieID] = counts
+			movieIDs = append(movieIDs, row.MovieID)
+			titles[row.MovieID] = row.MovieTitle
+		}
+		counts[row.Step] += row.Count
+	}
+
+	result := &response.FunnelReportResponse{
+		From:   req.From,
+		To:     req.To,
+		Steps:  response.FunnelStepsToResponse(totals),
+		Movies: make([]response.MovieFunnelResponse, 0, len(movieIDs)),
+	}
+	for _, movieID := range movieIDs {
+		result.Movies = append(result.Movies, response.MovieFunnelResponse{
+			MovieID: movieID.String(),
+			Title:   titles[movieID],
+			Steps:   response.FunnelStepsToResponse(perMovie[movieID]),
+		})
+	}
+
+	return result, nil
+}
+
+func (s *reportService) ExportSalesReport(ctx context.Context, req *request.SalesReportRequest, format export.Format, w io.Writer) error {
+	report, err := s.GetSalesReport(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	writer, err := export.NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.WriteRow([]string{req.GroupBy, "label", "revenue", "tickets_sold", "bookings", "capacity", "occupancy_rate"}); err != nil {
+		return fmt.Errorf("write sales export header: %w", err)
+	}
+	for _, row := range append(report.Rows, &report.Totals) {
+		err := writer.WriteRow([]string{
+			row.Key,
+			row.Label,
+			strconv.FormatFloat(row.Revenue, 'f', 2, 64),
+			strconv.FormatInt(row.TicketsSold, 10),
+			strconv.FormatInt(row.Bookings, 10),
+			strconv.FormatInt(row.Capacity, 10),
+			strconv.FormatFloat(row.OccupancyRate, 'f', 2, 64),
+		})
+		if err != nil {
+			return fmt.Errorf("write sales export row: %w", err)
+		}
+	}
+
+	return writer.Close()
+}
+
+func (s *reportService) ExportBookings(ctx context.Context, req *request.ExportBookingsRequest, format export.Format, w io.Writer) error {
+	// 1. Validate request
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return errs
+	}
+
+	startDate, endDate, err := s.parseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return err
+	}
+
+	filter := repository.BookingExportFilter{
+		StartDate:      startDate,
+		EndDate:        endDate,
+		OrganizationID: adminOrganization(ctx),
+	}
+	if req.Status != "" {
+		status := entity.BookingStatus(req.Status)
+		filter.Status = &status
+	}
+
+	// 2. Start streaming
+	writer, err := export.NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	header := []string{
+		"order_id", "username", "email", "movie", "cinema", "hall",
+		"show_date", "show_time", "seats", "total_seats", "total_price", "status", "created_at",
+	}
+	if err := writer.WriteRow(header); err != nil {
+		return fmt.Errorf("write booking export header: %w", err)
+	}
+
+	// 3. Iterate with keyset cursor, one batch in memory at a time
+	exported := 0
+	for {
+		rows, err := s.repo.Report.FindBookingsForExport(ctx, filter, exportBatchSize)
+		if err != nil {
+			utils.LoggerFromContext(ctx, s.log).Error("Failed to export bookings",
+				zap.Int("exported", exported),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to export bookings")
+		}
+
+		for _, row := range rows {
+			err := writer.WriteRow([]string{
+				row.OrderID,
+				row.Username,
+				row.Email,
+				row.MovieTitle,
+				row.CinemaName,
+				strconv.Itoa(row.HallNumber),
+				row.ShowDate.Format("2006-01-02"),
+				row.ShowTime.Format("15:04"),
+				row.SeatNumbers,
+				strconv.Itoa(row.TotalSeats),
+				strconv.FormatFloat(utils.CurrencyOf(row.Currency).ToMajor(row.TotalPrice), 'f', 2, 64),
+				string(row.Status),
+				row.CreatedAt.Format(time.RFC3339),
+			})
+			if err != nil {
+				return fmt.Errorf("write booking export row: %w", err)
+			}
+		}
+		exported += len(rows)
+
+		if len(rows) < exportBatchSize {
+			break
+		}
+		last := rows[len(rows)-1]
+		filter.AfterCreatedAt = &last.CreatedAt
+		filter.AfterID = &last.ID
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Bookings exported",
+		zap.String("format", string(format)),
+		zap.Int("rows", exported),
+	)
+
+	return writer.Close()
+}
+
+func (s *reportService) GetPaymentReport(ctx context.Context, req *request.PaymentReportRequest) (*response.PaymentReportResponse, error) {
+	// 1. Validate request
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	filter, err := s.paymentReportFilter(req.StartDate, req.EndDate, req.PaymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Status != "" {
+		status := entity.PaymentStatus(req.Status)
+		filter.Status = &status
+	}
+
+	// 2. Totals over the whole filter, list hanya satu halaman
+	totals, err := s.repo.Report.GetPaymentTotals(ctx, filter)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get payment totals", zap.Error(err))
+		return nil, fmt.Errorf("failed to get payment report")
+	}
+
+	paging := request.PaginatedRequest{Page: req.Page, PerPage: req.PerPage}
+	rows, err := s.repo.Report.FindPayments(ctx, filter, paging.Limit(), paging.Offset())
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get payment report", zap.Error(err))
+		return nil, fmt.Errorf("failed to get payment report")
+	}
+
+	total, err := s.repo.Report.CountPayments(ctx, filter)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count payment report", zap.Error(err))
+		return nil, fmt.Errorf("failed to get payment report")
+	}
+
+	// 3. Build response
+	payments := make([]*response.PaymentReportRowResponse, len(rows))
+	for i, row := range rows {
+		payments[i] = response.PaymentReportRowToResponse(row)
+	}
+
+	return &response.PaymentReportResponse{
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Currency:   s.currency.Code,
+		Totals:     s.buildPaymentTotals(totals),
+		Payments:   payments,
+		Pagination: response.NewPaginatedResponse(payments, paging.Page, paging.Limit(), total).Pagination,
+	}, nil
+}
+
+func (s *reportService) ReconcilePayments(ctx context.Context, req *request.ReconcilePaymentsRequest, settlement io.Reader) (*response.PaymentReconciliationResponse, error) {
+	// 1. Validate request
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	filter, err := s.paymentReportFilter(req.StartDate, req.EndDate, req.PaymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	if filter.EndDate.Sub(filter.StartDate) > maxReconcileRangeDays*24*time.Hour {
+		return nil, fmt.Errorf("invalid date range: maximum %d days for reconciliation", maxReconcileRangeDays)
+	}
+
+	// 2. Parse settlement file; amount diasumsikan dalam currency laporan
+	rows, err := parseSettlementCSV(settlement, s.currency)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionIDs := make([]string, len(rows))
+	for i, row := range rows {
+		transactionIDs[i] = row.transactionID
+	}
+
+	// 3. Load local payments dan cocokkan
+	payments, err := s.repo.Report.FindPaymentsForReconciliation(ctx, filter, transactionIDs)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to load payments for reconciliation", zap.Error(err))
+		return nil, fmt.Errorf("failed to reconcile payments")
+	}
+
+	matched, mismatches := reconcilePayments(rows, payments, filter, s.currency)
+
+	result := &response.PaymentReconciliationResponse{
+		StartDate:      req.StartDate,
+		EndDate:        req.EndDate,
+		Currency:       s.currency.Code,
+		SettlementRows: len(rows),
+		LocalPayments:  len(payments),
+		Matched:        matched,
+		Summary:        make(map[string]int),
+		Mismatches:     mismatches,
+	}
+	for _, mismatch := range mismatches {
+		result.Summary[mismatch.Type]++
+	}
+	if result.Mismatches == nil {
+		result.Mismatches = []*response.ReconciliationMismatchResponse{}
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Payments reconciled",
+		zap.String("start_date", req.StartDate),
+		zap.String("end_date", req.EndDate),
+		zap.Int("settlement_rows", len(rows)),
+		zap.Int("matched", matched),
+		zap.Int("mismatches", len(mismatches)),
+	)
+
+	return result, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+// paymentReportFilter parses the shared date range dan optional gateway filter
+func (s *reportService) paymentReportFilter(start, end, paymentMethodID string) (repository.PaymentReportFilter, error) {
+	startDate, endDate, err := s.parseDateRange(start, end)
+	if err != nil {
+		return repository.PaymentReportFilter{}, err
+	}
+
+	filter := repository.PaymentReportFilter{StartDate: startDate, EndDate: endDate}
+	if paymentMethodID != "" {
+		id, err := uuid.Parse(paymentMethodID)
+		if err != nil {
+			return repository.PaymentReportFilter{}, fmt.Errorf("invalid payment method ID format")
+		}
+		filter.PaymentMethodID = &id
+	}
+
+	return filter, nil
+}
+
+// buildPaymentTotals rolls (status, method) rows up into grand total, per status dan per method
+func (s *reportService) buildPaymentTotals(rows []*entity.PaymentTotalRow) response.PaymentReportTotalsResponse {
+	totals := response.PaymentReportTotalsResponse{
+		ByStatus: []*response.PaymentTotalBucketResponse{},
+		ByMethod: []*response.PaymentTotalBucketResponse{},
+	}
+	byStatus := make(map[entity.PaymentStatus]*response.PaymentTotalBucketResponse)
+	byMethod := make(map[uuid.UUID]*response.PaymentTotalBucketResponse)
+	statusAmount := make(map[entity.PaymentStatus]int64)
+	methodAmount := make(map[uuid.UUID]int64)
+
+	var totalAmount int64
+	for _, row := range rows {
+		totals.Payments += row.Payments
+		totalAmount += row.Amount
+
+		if _, ok := byStatus[row.Status]; !ok {
+			byStatus[row.Status] = &response.PaymentTotalBucketResponse{Key: string(row.Status), Label: string(row.Status)}
+			totals.ByStatus = append(totals.ByStatus, byStatus[row.Status])
+		}
+		byStatus[row.Status].Payments += row.Payments
+		statusAmount[row.Status] += row.Amount
+
+		if _, ok := byMethod[row.PaymentMethodID]; !ok {
+			byMethod[row.PaymentMethodID] = &response.PaymentTotalBucketResponse{Key: row.PaymentMethodID.String(), Label: row.PaymentMethodName}
+			totals.ByMethod = append(totals.ByMethod, byMethod[row.PaymentMethodID])
+		}
+		byMethod[row.PaymentMethodID].Payments += row.Payments
+		methodAmount[row.PaymentMethodID] += row.Amount
+	}
+
+	// Jumlahkan di minor unit dulu, baru convert sekali supaya tidak ada selisih pembulatan
+	totals.Amount = s.currency.ToMajor(totalAmount)
+	for status, bucket := range byStatus {
+		bucket.Amount = s.currency.ToMajor(statusAmount[status])
+	}
+	for methodID, bucket := range byMethod {
+		bucket.Amount = s.currency.ToMajor(methodAmount[methodID])
+	}
+
+	return totals
+}
+
+// parseDateRange parses YYYY-MM-DD bounds and enforces maxReportRangeDays
+func (s *reportService) parseDateRange(start, end string) (time.Time, time.Time, error) {
+	startDate, err := time.Parse("2006-01-02", start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start_date format")
+	}
+	endDate, err := time.Parse("2006-01-02", end)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end_date format")
+	}
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: end_date must not be before start_date")
+	}
+	if endDate.Sub(startDate) > maxReportRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: maximum %d days", maxReportRangeDays)
+	}
+
+	return startDate, endDate, nil
+}
@@ -2,48 +2,101 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"cinema-booking/internal/data/entity"
 	"cinema-booking/internal/data/repository"
 	"cinema-booking/internal/dto/request"
 	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/contentfilter"
+	"cinema-booking/pkg/i18n"
 	"cinema-booking/pkg/utils"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+// ReviewContentError is returned kalau komentar review ditolak content filter
+type ReviewContentError struct {
+	Violation contentfilter.Violation
+}
+
+func (e *ReviewContentError) Error() string {
+	return i18n.T(i18n.English, e.key())
+}
+
+func (e *ReviewContentError) Localize(lang i18n.Lang) string {
+	return i18n.T(lang, e.key())
+}
+
+func (e *ReviewContentError) key() string {
+	return "review.content_" + string(e.Violation)
+}
+
 type ReviewService interface {
 	// Public endpoints
 	CreateReview(ctx context.Context, userID string, req *request.CreateReviewRequest) (*response.ReviewResponse, error)
-	GetMovieReviews(ctx context.Context, movieID string, req *request.PaginatedRequest) (*response.PaginatedResponse[response.ReviewResponse], error)
-	GetUserReviews(ctx context.Context, userID string, req *request.PaginatedRequest) (*response.PaginatedResponse[response.ReviewResponse], error)
+	GetMovieReviews(ctx context.Context, movieID string, req *request.PaginatedRequest, filter *request.ReviewListFilter) (*response.PaginatedResponse[response.ReviewResponse], error)
+	GetUserReviews(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.ReviewListFilter) (*response.PaginatedResponse[response.ReviewResponse], error)
 	UpdateReview(ctx context.Context, reviewID, userID string, req *request.UpdateReviewRequest) (*response.ReviewResponse, error)
 	DeleteReview(ctx context.Context, reviewID, userID string) error
+	RestoreReview(ctx context.Context, reviewID string) error
+	// GetReviewRevisions isi review sebelum tiap edit, untuk admin
+	GetReviewRevisions(ctx context.Context, reviewID string) ([]response.ReviewRevisionResponse, error)
+
+	// Official reply (staff/admin), satu per review
+	ReplyToReview(ctx context.Context, reviewID, staffID string, req *request.ReviewReplyRequest) (*response.ReviewResponse, error)
+	UpdateReply(ctx context.Context, reviewID, staffID string, req *request.ReviewReplyRequest) (*response.ReviewResponse, error)
+	DeleteReply(ctx context.Context, reviewID string) error
+
+	// Moderation
+	ReportReview(ctx context.Context, reviewID, userID string, req *request.ReportReviewRequest) error
+	GetReportedReviews(ctx context.Context, req *request.PaginatedRequest) (*response.PaginatedResponse[response.ReportedReviewResponse], error)
+	ModerateReview(ctx context.Context, reviewID, adminID string, req *request.ModerateReviewRequest) error
 
 	// Stats
 	GetMovieReviewStats(ctx context.Context, movieID string) (*response.MovieReviewStats, error)
+	// RecalculateMovieRatings dipanggil job malam untuk mengoreksi rating yang drift
+	RecalculateMovieRatings(ctx context.Context) (int, error)
 }
 
 type reviewService struct {
-	repo *repository.Repository
-	log  *zap.Logger
+	repo   *repository.Repository
+	config utils.ReviewConfig
+	filter *contentfilter.Filter
+	log    *zap.Logger
 }
 
-func NewReviewService(repo *repository.Repository, log *zap.Logger) ReviewService {
+func NewReviewService(repo *repository.Repository, config utils.ReviewConfig, log *zap.Logger) ReviewService {
 	return &reviewService{
-		repo: repo,
-		log:  log.With(zap.String("service", "review")),
+		repo:   repo,
+		config: config,
+		filter: contentfilter.New(contentfilter.Config{
+			Mode: contentfilter.Mode(config.FilterMode),
+			BlockedWords: map[string][]string{
+				string(i18n.English):    config.BlockedWordsEN,
+				string(i18n.Indonesian): config.BlockedWordsID,
+			},
+			BlockURLs:        config.BlockURLs,
+			MaxRepeatedChars: config.MaxRepeatedChars,
+		}),
+		log: log.With(zap.String("service", "review")),
 	}
 }
 
 func (s *reviewService) CreateReview(ctx context.Context, userID string, req *request.CreateReviewRequest) (*response.ReviewResponse, error) {
 	// Validate request
 	if errs := utils.ValidateStruct(req); len(errs) > 0 {
-		s.log.Warn("Create review validation failed", zap.Any("errors", errs))
-		return nil, fmt.Errorf("validation failed: %s", utils.FormatValidationErrors(errs))
+		utils.LoggerFromContext(ctx, s.log).Warn("Create review validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	comment, err := s.filterComment(ctx, req.Comment, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Parse IDs
@@ -66,12 +119,12 @@ func (s *reviewService) CreateReview(ctx context.Context, userID string, req *re
 	// Check if user has already reviewed this movie
 	existingReview, err := s.repo.Review.FindByUserAndMovie(ctx, userUUID, movieID)
 	if err != nil {
-		s.log.Error("Failed to check existing review", zap.Error(err))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to check existing review", zap.Error(err))
 		return nil, fmt.Errorf("check existing review: %w", err)
 	}
 
 	if existingReview != nil {
-		return nil, fmt.Errorf("user already reviewed this movie")
+		return nil, repository.ErrAlreadyReviewed
 	}
 
 	// Create review entity
@@ -84,12 +137,15 @@ func (s *reviewService) CreateReview(ctx context.Context, userID string, req *re
 		UserID:  userUUID,
 		MovieID: movieID,
 		Rating:  req.Rating,
-		Comment: req.Comment,
+		Comment: comment,
 	}
 
-	// Save review
+	// Save review; cek di atas bisa kalah balapan dengan request paralel, unique index yang menentukan
 	if err := s.repo.Review.Create(ctx, review); err != nil {
-		s.log.Error("Failed to create review",
+		if errors.Is(err, repository.ErrAlreadyReviewed) {
+			return nil, err
+		}
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create review",
 			zap.Error(err),
 			zap.String("user_id", userID),
 			zap.String("movie_id", req.MovieID),
@@ -99,7 +155,7 @@ func (s *reviewService) CreateReview(ctx context.Context, userID string, req *re
 
 	// Update movie rating
 	if err := s.updateMovieRating(ctx, movieID); err != nil {
-		s.log.Warn("Failed to update movie rating",
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to update movie rating",
 			zap.Error(err),
 			zap.String("movie_id", req.MovieID),
 		)
@@ -113,7 +169,7 @@ func (s *reviewService) CreateReview(ctx context.Context, userID string, req *re
 		username = user.Username
 	}
 
-	s.log.Info("Review created",
+	utils.LoggerFromContext(ctx, s.log).Info("Review created",
 		zap.String("review_id", review.ID.String()),
 		zap.String("user_id", userID),
 		zap.String("movie_id", req.MovieID),
@@ -124,7 +180,11 @@ func (s *reviewService) CreateReview(ctx context.Context, userID string, req *re
 	return &reviewResp, nil
 }
 
-func (s *reviewService) GetMovieReviews(ctx context.Context, movieID string, req *request.PaginatedRequest) (*response.PaginatedResponse[response.ReviewResponse], error) {
+func (s *reviewService) GetMovieReviews(ctx context.Context, movieID string, req *request.PaginatedRequest, filter *request.ReviewListFilter) (*response.PaginatedResponse[response.ReviewResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
 	// Parse movie ID
 	movieUUID, err := uuid.Parse(movieID)
 	if err != nil {
@@ -135,9 +195,9 @@ func (s *reviewService) GetMovieReviews(ctx context.Context, movieID string, req
 	offset := req.Offset()
 
 	// Get reviews
-	reviews, err := s.repo.Review.FindByMovieID(ctx, movieUUID, limit, offset)
+	reviews, err := s.repo.Review.FindByMovieID(ctx, movieUUID, reviewSort(filter), limit, offset)
 	if err != nil {
-		s.log.Error("Failed to get movie reviews",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get movie reviews",
 			zap.Error(err),
 			zap.String("movie_id", movieID),
 			zap.Int("page", req.Page),
@@ -149,7 +209,7 @@ func (s *reviewService) GetMovieReviews(ctx context.Context, movieID string, req
 	// Get total count
 	total, err := s.repo.Review.CountByMovieID(ctx, movieUUID)
 	if err != nil {
-		s.log.Error("Failed to count movie reviews", zap.Error(err))
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count movie reviews", zap.Error(err))
 		return nil, fmt.Errorf("count movie reviews: %w", err)
 	}
 
@@ -173,7 +233,11 @@ func (s *reviewService) GetMovieReviews(ctx context.Context, movieID string, req
 		reviewResponses[i] = response.ReviewToResponse(review, username, movieTitle)
 	}
 
-	s.log.Info("Movie reviews retrieved",
+	if err := s.attachReplies(ctx, reviews, reviewResponses); err != nil {
+		return nil, err
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Movie reviews retrieved",
 		zap.String("movie_id", movieID),
 		zap.Int("count", len(reviews)),
 		zap.Int64("total", total),
@@ -184,7 +248,11 @@ func (s *reviewService) GetMovieReviews(ctx context.Context, movieID string, req
 	return response.NewPaginatedResponse(reviewResponses, req.Page, req.PerPage, total), nil
 }
 
-func (s *reviewService) GetUserReviews(ctx context.Context, userID string, req *request.PaginatedRequest) (*response.PaginatedResponse[response.ReviewResponse], error) {
+func (s *reviewService) GetUserReviews(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.ReviewListFilter) (*response.PaginatedResponse[response.ReviewResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
 	// Parse user ID
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
@@ -195,9 +263,9 @@ func (s *reviewService) GetUserReviews(ctx context.Context, userID string, req *
 	offset := req.Offset()
 
 	// Get reviews
-	reviews, err := s.repo.Review.FindByUserID(ctx, userUUID, limit, offset)
+	reviews, err := s.repo.Review.FindByUserID(ctx, userUUID, reviewSort(filter), limit, offset)
 	if err != nil {
-		s.log.Error("Failed to get user reviews",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get user reviews",
 			zap.Error(err),
 			zap.String("user_id", userID),
 			zap.Int("page", req.Page),
@@ -206,8 +274,12 @@ func (s *reviewService) GetUserReviews(ctx context.Context, userID string, req *
 		return nil, fmt.Errorf("get user reviews: %w", err)
 	}
 
-	// Get total count (simplified - bisa pakai CountByUserID kalau ada)
-	total := int64(len(reviews)) // Simplified
+	// Get total count
+	total, err := s.repo.Review.CountByUserID(ctx, userUUID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count user reviews", zap.Error(err))
+		return nil, fmt.Errorf("count user reviews: %w", err)
+	}
 
 	// Get user info
 	user, _ := s.repo.User.FindByID(ctx, userUUID)
@@ -229,9 +301,14 @@ func (s *reviewService) GetUserReviews(ctx context.Context, userID string, req *
 		reviewResponses[i] = response.ReviewToResponse(review, username, movieTitle)
 	}
 
-	s.log.Info("User reviews retrieved",
+	if err := s.attachReplies(ctx, reviews, reviewResponses); err != nil {
+		return nil, err
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("User reviews retrieved",
 		zap.String("user_id", userID),
 		zap.Int("count", len(reviews)),
+		zap.Int64("total", total),
 		zap.Int("page", req.Page),
 		zap.Int("per_page", req.PerPage),
 	)
@@ -240,6 +317,11 @@ func (s *reviewService) GetUserReviews(ctx context.Context, userID string, req *
 }
 
 func (s *reviewService) UpdateReview(ctx context.Context, reviewID, userID string, req *request.UpdateReviewRequest) (*response.ReviewResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Update review validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
 	// Parse IDs
 	reviewUUID, err := uuid.Parse(reviewID)
 	if err != nil {
@@ -262,6 +344,19 @@ func (s *reviewService) UpdateReview(ctx context.Context, reviewID, userID strin
 		return nil, fmt.Errorf("unauthorized to update this review")
 	}
 
+	if window := s.config.EditWindowDays; window > 0 && time.Since(review.CreatedAt) > time.Duration(window)*24*time.Hour {
+		return nil, i18n.Errorf("review.edit_window_closed", window)
+	}
+
+	// Isi lama disimpan sebagai revisi sebelum field-nya ditimpa
+	now := time.Now()
+	revision := &entity.ReviewRevision{
+		BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+		ReviewID:   review.ID,
+		Rating:     review.Rating,
+		Comment:    review.Comment,
+	}
+
 	// Update fields if provided
 	updated := false
 
@@ -271,7 +366,11 @@ func (s *reviewService) UpdateReview(ctx context.Context, reviewID, userID strin
 	}
 
 	if req.Comment != nil {
-		review.Comment = req.Comment
+		comment, err := s.filterComment(ctx, req.Comment, userID)
+		if err != nil {
+			return nil, err
+		}
+		review.Comment = comment
 		updated = true
 	}
 
@@ -281,8 +380,15 @@ func (s *reviewService) UpdateReview(ctx context.Context, reviewID, userID strin
 	}
 
 	// Save updated review
-	if err := s.repo.Review.Update(ctx, review); err != nil {
-		s.log.Error("Failed to update review",
+	review.EditedAt = &now
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.ReviewRevision.Create(ctx, revision); err != nil {
+			return err
+		}
+		return tx.Review.Update(ctx, review)
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update review",
 			zap.Error(err),
 			zap.String("review_id", reviewID),
 		)
@@ -291,14 +397,14 @@ func (s *reviewService) UpdateReview(ctx context.Context, reviewID, userID strin
 
 	// Update movie rating
 	if err := s.updateMovieRating(ctx, review.MovieID); err != nil {
-		s.log.Warn("Failed to update movie rating",
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to update movie rating",
 			zap.Error(err),
 			zap.String("movie_id", review.MovieID.String()),
 		)
 		// Continue anyway
 	}
 
-	s.log.Info("Review updated",
+	utils.LoggerFromContext(ctx, s.log).Info("Review updated",
 		zap.String("review_id", reviewID),
 		zap.String("user_id", userID),
 		zap.Bool("was_updated", updated),
@@ -332,7 +438,7 @@ func (s *reviewService) DeleteReview(ctx context.Context, reviewID, userID strin
 
 	// Delete review
 	if err := s.repo.Review.Delete(ctx, reviewUUID); err != nil {
-		s.log.Error("Failed to delete review",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to delete review",
 			zap.Error(err),
 			zap.String("review_id", reviewID),
 		)
@@ -341,14 +447,14 @@ func (s *reviewService) DeleteReview(ctx context.Context, reviewID, userID strin
 
 	// Update movie rating
 	if err := s.updateMovieRating(ctx, review.MovieID); err != nil {
-		s.log.Warn("Failed to update movie rating",
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to update movie rating",
 			zap.Error(err),
 			zap.String("movie_id", review.MovieID.String()),
 		)
 		// Continue anyway
 	}
 
-	s.log.Info("Review deleted",
+	utils.LoggerFromContext(ctx, s.log).Info("Review deleted",
 		zap.String("review_id", reviewID),
 		zap.String("user_id", userID),
 		zap.String("movie_id", review.MovieID.String()),
@@ -357,6 +463,355 @@ func (s *reviewService) DeleteReview(ctx context.Context, reviewID, userID strin
 	return nil
 }
 
+// RestoreReview undeletes a review (admin only) and recalculates the movie rating
+func (s *reviewService) RestoreReview(ctx context.Context, reviewID string) error {
+	reviewUUID, err := uuid.Parse(reviewID)
+	if err != nil {
+		return fmt.Errorf("invalid review ID format %s: %w", reviewID, err)
+	}
+
+	if err := s.repo.Review.Restore(ctx, reviewUUID); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to restore review",
+			zap.Error(err),
+			zap.String("review_id", reviewID),
+		)
+		return fmt.Errorf("restore review: %w", err)
+	}
+
+	review, err := s.repo.Review.FindByID(ctx, reviewUUID)
+	if err != nil || review == nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Restored review not readable, skipping rating update",
+			zap.Error(err),
+			zap.String("review_id", reviewID),
+		)
+		return nil
+	}
+
+	if err := s.updateMovieRating(ctx, review.MovieID); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to update movie rating",
+			zap.Error(err),
+			zap.String("movie_id", review.MovieID.String()),
+		)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Review restored",
+		zap.String("review_id", reviewID),
+		zap.String("movie_id", review.MovieID.String()),
+	)
+
+	return nil
+}
+
+func (s *reviewService) GetReviewRevisions(ctx context.Context, reviewID string) ([]response.ReviewRevisionResponse, error) {
+	reviewUUID, err := uuid.Parse(reviewID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid review ID format %s: %w", reviewID, err)
+	}
+
+	review, err := s.repo.Review.FindByID(ctx, reviewUUID)
+	if err != nil {
+		return nil, fmt.Errorf("find review: %w", err)
+	}
+	if review == nil {
+		return nil, fmt.Errorf("review %s not found", reviewID)
+	}
+
+	revisions, err := s.repo.ReviewRevision.FindByReviewID(ctx, reviewUUID)
+	if err != nil {
+		return nil, fmt.Errorf("find review revisions: %w", err)
+	}
+
+	result := make([]response.ReviewRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		result[i] = response.ReviewRevisionToResponse(revision)
+	}
+	return result, nil
+}
+
+// ReplyToReview posts the official reply; review yang sudah dibalas harus di-update, bukan dibalas ulang
+func (s *reviewService) ReplyToReview(ctx context.Context, reviewID, staffID string, req *request.ReviewReplyRequest) (*response.ReviewResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	review, staffUUID, err := s.replyTarget(ctx, reviewID, staffID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reply := &entity.ReviewReply{
+		BaseSimple: entity.BaseSimple{
+			ID:        uuid.New(),
+			CreatedAt: now,
+		},
+		ReviewID:  review.ID,
+		AuthorID:  staffUUID,
+		Message:   req.Message,
+		UpdatedAt: now,
+	}
+
+	if err := s.repo.ReviewReply.Create(ctx, reply); err != nil {
+		return nil, fmt.Errorf("reply to review: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Review reply posted",
+		zap.String("review_id", reviewID),
+		zap.String("staff_id", staffID),
+	)
+
+	return s.buildReviewResponse(ctx, review), nil
+}
+
+// UpdateReply replaces message reply; author ikut diganti ke staff terakhir yang mengedit
+func (s *reviewService) UpdateReply(ctx context.Context, reviewID, staffID string, req *request.ReviewReplyRequest) (*response.ReviewResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	review, staffUUID, err := s.replyTarget(ctx, reviewID, staffID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &entity.ReviewReply{
+		ReviewID:  review.ID,
+		AuthorID:  staffUUID,
+		Message:   req.Message,
+		UpdatedAt: time.Now(),
+	}
+
+	if err := s.repo.ReviewReply.Update(ctx, reply); err != nil {
+		return nil, fmt.Errorf("update review reply: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Review reply updated",
+		zap.String("review_id", reviewID),
+		zap.String("staff_id", staffID),
+	)
+
+	return s.buildReviewResponse(ctx, review), nil
+}
+
+func (s *reviewService) DeleteReply(ctx context.Context, reviewID string) error {
+	reviewUUID, err := uuid.Parse(reviewID)
+	if err != nil {
+		return fmt.Errorf("invalid review ID format %s: %w", reviewID, err)
+	}
+
+	if err := s.repo.ReviewReply.DeleteByReviewID(ctx, reviewUUID); err != nil {
+		return fmt.Errorf("delete review reply: %w", err)
+	}
+
+	return nil
+}
+
+// ReportReview flags a review. Review disembunyikan dari publik begitu report mencapai threshold
+// dan rating movie dihitung ulang tanpa review tersebut.
+func (s *reviewService) ReportReview(ctx context.Context, reviewID, userID string, req *request.ReportReviewRequest) error {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return errs
+	}
+
+	reviewUUID, err := uuid.Parse(reviewID)
+	if err != nil {
+		return fmt.Errorf("invalid review ID format %s: %w", reviewID, err)
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	review, err := s.repo.Review.FindByID(ctx, reviewUUID)
+	if err != nil {
+		return fmt.Errorf("find review: %w", err)
+	}
+	if review == nil {
+		return fmt.Errorf("review %s not found", reviewID)
+	}
+	if review.UserID == userUUID {
+		return fmt.Errorf("cannot report your own review")
+	}
+
+	report := &entity.ReviewReport{
+		BaseSimple: entity.BaseSimple{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+		},
+		ReviewID:   reviewUUID,
+		ReporterID: userUUID,
+		Reason:     entity.ReviewReportReason(req.Reason),
+		Details:    req.Details,
+	}
+
+	var reportCount int
+	var hidden bool
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.ReviewReport.Create(ctx, report); err != nil {
+			return err
+		}
+
+		reportCount, hidden, err = tx.Review.IncrementReportCount(ctx, reviewUUID, s.config.ReportHideThreshold)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("report review: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Review reported",
+		zap.String("review_id", reviewID),
+		zap.String("reporter_id", userID),
+		zap.String("reason", req.Reason),
+		zap.Int("report_count", reportCount),
+	)
+
+	if hidden && !review.IsHidden() {
+		utils.LoggerFromContext(ctx, s.log).Warn("Review auto-hidden pending moderation",
+			zap.String("review_id", reviewID),
+			zap.Int("report_count", reportCount),
+		)
+		if err := s.updateMovieRating(ctx, review.MovieID); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to update movie rating",
+				zap.Error(err),
+				zap.String("movie_id", review.MovieID.String()),
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetReportedReviews returns antrian moderasi admin, review yang sudah tersembunyi di depan
+func (s *reviewService) GetReportedReviews(ctx context.Context, req *request.PaginatedRequest) (*response.PaginatedResponse[response.ReportedReviewResponse], error) {
+	reviews, err := s.repo.Review.FindReported(ctx, req.Limit(), req.Offset())
+	if err != nil {
+		return nil, fmt.Errorf("get reported reviews: %w", err)
+	}
+
+	total, err := s.repo.Review.CountReported(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count reported reviews: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(reviews))
+	for i, review := range reviews {
+		ids[i] = review.ID
+	}
+
+	reasons, err := s.repo.ReviewReport.CountOpenReasons(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("count report reasons: %w", err)
+	}
+
+	items := make([]response.ReportedReviewResponse, len(reviews))
+	for i, review := range reviews {
+		itemReasons := reasons[review.ID]
+		if itemReasons == nil {
+			itemReasons = map[string]int{}
+		}
+
+		items[i] = response.ReportedReviewResponse{
+			ReviewResponse: *s.buildReviewResponse(ctx, review),
+			ReportCount:    review.ReportCount,
+			HiddenAt:       review.HiddenAt,
+			Reasons:        itemReasons,
+		}
+	}
+
+	return response.NewPaginatedResponse(items, req.Page, req.PerPage, total), nil
+}
+
+// ModerateReview resolves report sebuah review: approve menampilkannya lagi, remove menghapusnya (soft delete)
+func (s *reviewService) ModerateReview(ctx context.Context, reviewID, adminID string, req *request.ModerateReviewRequest) error {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return errs
+	}
+
+	reviewUUID, err := uuid.Parse(reviewID)
+	if err != nil {
+		return fmt.Errorf("invalid review ID format %s: %w", reviewID, err)
+	}
+
+	review, err := s.repo.Review.FindByID(ctx, reviewUUID)
+	if err != nil {
+		return fmt.Errorf("find review: %w", err)
+	}
+	if review == nil {
+		return fmt.Errorf("review %s not found", reviewID)
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		if err := tx.ReviewReport.ResolveByReviewID(ctx, reviewUUID, time.Now()); err != nil {
+			return err
+		}
+		if req.Action == "remove" {
+			return tx.Review.Delete(ctx, reviewUUID)
+		}
+		return tx.Review.ClearReports(ctx, reviewUUID)
+	})
+	if err != nil {
+		return fmt.Errorf("moderate review: %w", err)
+	}
+
+	if err := s.updateMovieRating(ctx, review.MovieID); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to update movie rating",
+			zap.Error(err),
+			zap.String("movie_id", review.MovieID.String()),
+		)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Review moderated",
+		zap.String("review_id", reviewID),
+		zap.String("admin_id", adminID),
+		zap.String("action", req.Action),
+		zap.Int("report_count", review.ReportCount),
+	)
+
+	return nil
+}
+
+// RecalculateMovieRatings recomputes rating semua movie dari review yang terlihat publik.
+// Returns jumlah movie yang rating-nya berubah.
+func (s *reviewService) RecalculateMovieRatings(ctx context.Context) (int, error) {
+	stats, err := s.repo.Review.GetAllMovieRatingStats(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("load movie rating stats: %w", err)
+	}
+
+	updated := 0
+	for _, st := range stats {
+		rating := s.weightedRating(st.AverageRating, st.ReviewCount)
+		if math.Abs(rating-st.CurrentRating) < 0.005 {
+			continue
+		}
+
+		// Movie yang baru dihapus di tengah job cukup dilewati
+		if err := s.repo.Movie.UpdateRating(ctx, st.MovieID, rating); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to recalculate movie rating",
+				zap.Error(err),
+				zap.String("movie_id", st.MovieID.String()),
+			)
+			continue
+		}
+
+		utils.LoggerFromContext(ctx, s.log).Info("Movie rating corrected",
+			zap.String("movie_id", st.MovieID.String()),
+			zap.Float64("from", st.CurrentRating),
+			zap.Float64("to", rating),
+			zap.Int64("review_count", st.ReviewCount),
+		)
+		updated++
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Movie ratings recalculated",
+		zap.Int("movies", len(stats)),
+		zap.Int("updated", updated),
+	)
+
+	return updated, nil
+}
+
 func (s *reviewService) GetMovieReviewStats(ctx context.Context, movieID string) (*response.MovieReviewStats, error) {
 	// Parse movie ID
 	movieUUID, err := uuid.Parse(movieID)
@@ -366,7 +821,7 @@ func (s *reviewService) GetMovieReviewStats(ctx context.Context, movieID string)
 
 	avgRating, reviewCount, err := s.repo.Review.GetMovieReviewStats(ctx, movieUUID)
 	if err != nil {
-		s.log.Error("Failed to get movie review stats",
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get movie review stats",
 			zap.Error(err),
 			zap.String("movie_id", movieID),
 		)
@@ -381,25 +836,44 @@ func (s *reviewService) GetMovieReviewStats(ctx context.Context, movieID string)
 
 // ==================== HELPER METHODS ====================
 
+// updateMovieRating is best-effort setelah review berubah; drift karena kegagalan dikoreksi
+// RecalculateMovieRatings tiap malam
 func (s *reviewService) updateMovieRating(ctx context.Context, movieID uuid.UUID) error {
-	avgRating, err := s.repo.Review.GetMovieAverageRating(ctx, movieID)
+	avgRating, count, err := s.repo.Review.GetMovieAverageRating(ctx, movieID)
 	if err != nil {
 		return fmt.Errorf("get average rating: %w", err)
 	}
 
+	rating := s.weightedRating(avgRating, count)
+
 	// Update movie rating in movies table
-	if err := s.repo.Movie.UpdateRating(ctx, movieID, avgRating); err != nil {
+	if err := s.repo.Movie.UpdateRating(ctx, movieID, rating); err != nil {
 		return fmt.Errorf("update movie rating: %w", err)
 	}
 
-	s.log.Debug("Movie rating updated",
+	utils.LoggerFromContext(ctx, s.log).Debug("Movie rating updated",
 		zap.String("movie_id", movieID.String()),
-		zap.Float64("new_rating", avgRating),
+		zap.Float64("average", avgRating),
+		zap.Int64("review_count", count),
+		zap.Float64("new_rating", rating),
 	)
 
 	return nil
 }
 
+// weightedRating is the Bayesian average: (C*m + n*avg) / (C + n), dibulatkan 2 desimal.
+// Movie tanpa review tetap 0 supaya tidak ikut muncul di top rated.
+func (s *reviewService) weightedRating(avg float64, count int64) float64 {
+	if count == 0 {
+		return 0
+	}
+
+	n := float64(count)
+	prior := s.config.RatingPriorWeight
+	rating := (prior*s.config.RatingPriorMean + n*avg) / (prior + n)
+	return math.Round(rating*100) / 100
+}
+
 func (s *reviewService) buildReviewResponse(ctx context.Context, review *entity.Review) *response.ReviewResponse {
 	// Get user info
 	user, _ := s.repo.User.FindByID(ctx, review.UserID)
@@ -416,5 +890,93 @@ func (s *reviewService) buildReviewResponse(ctx context.Context, review *entity.
 	}
 
 	reviewResp := response.ReviewToResponse(review, username, movieTitle)
+
+	reply, err := s.repo.ReviewReply.FindByReviewID(ctx, review.ID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to load review reply", zap.Error(err), zap.String("review_id", review.ID.String()))
+	}
+	reviewResp.Reply = response.ReviewReplyToResponse(reply)
+
 	return &reviewResp
 }
+
+// attachReplies loads replies satu halaman dengan satu query; responses sejajar dengan reviews
+func (s *reviewService) attachReplies(ctx context.Context, reviews []*entity.Review, responses []response.ReviewResponse) error {
+	ids := make([]uuid.UUID, len(reviews))
+	for i, review := range reviews {
+		ids[i] = review.ID
+	}
+
+	replies, err := s.repo.ReviewReply.FindByReviewIDs(ctx, ids)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to load review replies", zap.Error(err))
+		return fmt.Errorf("load review replies: %w", err)
+	}
+
+	for i, review := range reviews {
+		responses[i].Reply = response.ReviewReplyToResponse(replies[review.ID])
+	}
+	return nil
+}
+
+// replyTarget parses IDs dan memastikan review masih ada (belum dihapus)
+func (s *reviewService) replyTarget(ctx context.Context, reviewID, staffID string) (*entity.Review, uuid.UUID, error) {
+	reviewUUID, err := uuid.Parse(reviewID)
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("invalid review ID format %s: %w", reviewID, err)
+	}
+
+	staffUUID, err := uuid.Parse(staffID)
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("invalid user ID format %s: %w", staffID, err)
+	}
+
+	review, err := s.repo.Review.FindByID(ctx, reviewUUID)
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("find review: %w", err)
+	}
+	if review == nil {
+		return nil, uuid.Nil, fmt.Errorf("review %s not found", reviewID)
+	}
+
+	return review, staffUUID, nil
+}
+
+// filterComment runs content filter; returns komentar yang sudah di-mask, atau ReviewContentError kalau ditolak
+func (s *reviewService) filterComment(ctx context.Context, comment *string, userID string) (*string, error) {
+	if comment == nil {
+		return nil, nil
+	}
+
+	result := s.filter.Check(*comment)
+	if len(result.Violations) == 0 {
+		return comment, nil
+	}
+
+	violations := make([]string, len(result.Violations))
+	for i, v := range result.Violations {
+		violations[i] = string(v)
+	}
+
+	if result.Rejected {
+		utils.LoggerFromContext(ctx, s.log).Warn("Review comment rejected by content filter",
+			zap.String("user_id", userID),
+			zap.Strings("violations", violations),
+		)
+		return nil, &ReviewContentError{Violation: result.Violations[0]}
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Review comment masked by content filter",
+		zap.String("user_id", userID),
+		zap.Strings("violations", violations),
+	)
+	return &result.Text, nil
+}
+
+// reviewSort maps filter sort ke repository, default newest
+func reviewSort(filter *request.ReviewListFilter) repository.ReviewSort {
+	if filter == nil || filter.Sort == "" {
+		return repository.ReviewSortNewest
+	}
+	return repository.ReviewSort(filter.Sort)
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const scheduleWeek = 7 * 24 * time.Hour
+
+// CloneWeek copies grid jadwal minggu sumber ke minggu target sebagai draft, hari dan jam tayang sama.
+// Slot yang sudah lewat atau bentrok dengan schedule lain di hall yang sama (draft maupun published,
+// termasuk hasil clone sebelumnya di batch ini) dilewati dan dilaporkan di Skipped.
+func (s *scheduleService) CloneWeek(ctx context.Context, req *request.CloneScheduleWeekRequest) (*response.ScheduleCloneResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Clone schedule week validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	cinemaID, err := uuid.Parse(req.CinemaID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cinema ID format %s: %w", req.CinemaID, err)
+	}
+	sourceStart, err := parseWeekStart("source_week_start", req.SourceWeekStart)
+	if err != nil {
+		return nil, err
+	}
+	targetStart, err := parseWeekStart("target_week_start", req.TargetWeekStart)
+	if err != nil {
+		return nil, err
+	}
+	if sourceStart.Equal(targetStart) {
+		return nil, fmt.Errorf("invalid target_week_start: must differ from source_week_start")
+	}
+
+	cinema, err := s.repo.Cinema.FindByID(ctx, cinemaID)
+	if err != nil {
+		return nil, fmt.Errorf("find cinema: %w", err)
+	}
+	if cinema == nil {
+		return nil, fmt.Errorf("cinema %s not found", req.CinemaID)
+	}
+	if err := requireCinemaScope(ctx, s.repo, cinemaID); err != nil {
+		return nil, err
+	}
+
+	sources, err := s.repo.Schedule.FindByCinemaAndDateRange(ctx, cinemaID, sourceStart, sourceStart.Add(scheduleWeek))
+	if err != nil {
+		return nil, fmt.Errorf("load source week: %w", err)
+	}
+
+	result := &response.ScheduleCloneResponse{
+		DryRun:          req.DryRun,
+		SourceWeekStart: req.SourceWeekStart,
+		TargetWeekStart: req.TargetWeekStart,
+		Created:         []response.ScheduleResponse{},
+		Skipped:         []response.ScheduleCloneConflict{},
+	}
+	if len(sources) == 0 {
+		return result, nil
+	}
+
+	offsetDays := int(targetStart.Sub(sourceStart) / (24 * time.Hour))
+	loc := cinema.TimeLocation()
+	now := time.Now()
+
+	var clones []*entity.Schedule
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		// Lock hall tujuan berurutan, sama seperti publish, supaya clone paralel tidak lolos bentrok bersamaan
+		halls := make([]uuid.UUID, 0)
+		lockedHall := make(map[uuid.UUID]bool)
+		for _, source := range sources {
+			if !lockedHall[source.HallID] {
+				lockedHall[source.HallID] = true
+				halls = append(halls, source.HallID)
+			}
+		}
+		sort.Slice(halls, func(i, j int) bool { return halls[i].String() < halls[j].String() })
+		for _, hallID := range halls {
+			if err := tx.Hall.LockByID(ctx, hallID); err != nil {
+				return err
+			}
+		}
+
+		movies := make(map[uuid.UUID]*entity.Movie)
+		runtime := func(movieID uuid.UUID) (*entity.Movie, time.Duration, error) {
+			movie, ok := movies[movieID]
+			if !ok {
+				var err error
+				if movie, err = tx.Movie.FindByID(ctx, movieID); err != nil {
+					return nil, 0, fmt.Errorf("find movie %s: %w", movieID.String(), err)
+				}
+				movies[movieID] = movie
+			}
+			// Movie yang sudah dihapus tetap memblok slot minimal selama turnaround
+			if movie == nil {
+				return nil, scheduleTurnaround, nil
+			}
+			return movie, time.Duration(movie.DurationInMinutes)*time.Minute + scheduleTurnaround, nil
+		}
+
+		// Show larut malam di hari sebelum / sesudah minggu target juga bisa bentrok
+		existing, err := tx.Schedule.FindByCinemaAndDateRange(ctx, cinemaID,
+			targetStart.AddDate(0, 0, -1), targetStart.Add(scheduleWeek).AddDate(0, 0, 1))
+		if err != nil {
+			return fmt.Errorf("load target week: %w", err)
+		}
+		occupied := make(map[uuid.UUID][]showSlot)
+		for _, other := range existing {
+			_, length, err := runtime(other.MovieID)
+			if err != nil {
+				return err
+			}
+			occupied[other.HallID] = append(occupied[other.HallID], showSlot{
+				scheduleID: other.ID,
+				start:      other.StartsAt,
+				end:        other.StartsAt.Add(length),
+			})
+		}
+
+		for _, source := range sources {
+			showDate := source.ShowDate.AddDate(0, 0, offsetDays)
+			startsAt := time.Date(
+				showDate.Year(), showDate.Month(), showDate.Day(),
+				source.ShowTime.Hour(), source.ShowTime.Minute(), 0, 0, loc,
+			).UTC()
+
+			skip := func(reason string) {
+				result.Skipped = append(result.Skipped, response.ScheduleCloneConflict{
+					SourceScheduleID: source.ID.String(),
+					HallID:           source.HallID.String(),
+					ShowDate:         showDate.Format("2006-01-02"),
+					ShowTime:         source.ShowTime.Format("15:04"),
+					Reason:           reason,
+				})
+			}
+
+			if startsAt.Before(now) {
+				skip("show time has passed")
+				continue
+			}
+			movie, length, err := runtime(source.MovieID)
+			if err != nil {
+				return err
+			}
+			if movie == nil {
+				skip("movie not found")
+				continue
+			}
+
+			slot := showSlot{scheduleID: uuid.New(), start: startsAt, end: startsAt.Add(length)}
+			conflict := ""
+			for _, other := range occupied[source.HallID] {
+				if slot.start.Before(other.end) && other.start.Before(slot.end) {
+					conflict = fmt.Sprintf("conflicts with schedule %s at %s (movie runtime plus %d minutes turnaround)",
+						other.scheduleID, other.start.Format("2006-01-02 15:04"), int(scheduleTurnaround.Minutes()))
+					break
+				}
+			}
+			if conflict != "" {
+				skip(conflict)
+				continue
+			}
+			occupied[source.HallID] = append(occupied[source.HallID], slot)
+
+			clone := &entity.Schedule{
+				Base: entity.Base{
+					ID:        slot.scheduleID,
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				MovieID:       source.MovieID,
+				HallID:        source.HallID,
+				ShowDate:      showDate,
+				ShowTime:      source.ShowTime,
+				StartsAt:      startsAt,
+				Price:         source.Price,
+				Currency:      source.Currency,
+				PriceOverride: source.PriceOverride,
+				Status:        entity.ScheduleStatusDraft,
+			}
+			clones = append(clones, clone)
+		}
+
+		if req.DryRun {
+			return nil
+		}
+		for _, clone := range clones {
+			if err := tx.Schedule.Create(ctx, clone); err != nil {
+				return err
+			}
+			if clone.PriceOverride != nil {
+				if err := tx.Schedule.SetPriceOverride(ctx, clone.ID, clone.PriceOverride); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to clone schedule week", zap.Error(err), zap.String("cinema_id", req.CinemaID))
+		return nil, fmt.Errorf("clone schedule week: %w", err)
+	}
+
+	created, err := buildScheduleResponses(ctx, s.repo, s.pricing, clones)
+	if err != nil {
+		return nil, err
+	}
+	result.Created = append(result.Created, created...)
+
+	utils.LoggerFromContext(ctx, s.log).Info("Schedule week cloned",
+		zap.String("cinema_id", req.CinemaID),
+		zap.String("source_week_start", req.SourceWeekStart),
+		zap.String("target_week_start", req.TargetWeekStart),
+		zap.Bool("dry_run", req.DryRun),
+		zap.Int("created", len(clones)),
+		zap.Int("skipped", len(result.Skipped)),
+	)
+
+	return result, nil
+}
+
+// parseWeekStart parses tanggal awal minggu; harus hari Senin supaya grid Senin-Minggu tidak bergeser
+func parseWeekStart(field, value string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %s: %w", field, value, err)
+	}
+	if date.Weekday() != time.Monday {
+		return time.Time{}, fmt.Errorf("invalid %s %s: must be a Monday", field, value)
+	}
+	return date, nil
+}
@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/events"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// showSlot is the time a show occupies a hall, termasuk turnaround sesudahnya
+type showSlot struct {
+	scheduleID uuid.UUID
+	start      time.Time
+	end        time.Time
+}
+
+type hallDay struct {
+	hallID uuid.UUID
+	date   string
+}
+
+// publishChecker validates drafts sebelum publish. Hall, seat map, movie dan slot published
+// di-cache per batch supaya publish puluhan schedule tidak query ulang data yang sama.
+type publishChecker struct {
+	repo *repository.Repository
+	now  time.Time
+
+	halls       map[uuid.UUID]*entity.Hall
+	cinemas     map[uuid.UUID]*entity.Cinema
+	seatMapErrs map[uuid.UUID]string
+	movies      map[uuid.UUID]*entity.Movie
+	slots       map[hallDay][]showSlot
+}
+
+func newPublishChecker(repo *repository.Repository, now time.Time) *publishChecker {
+	return &publishChecker{
+		repo:        repo,
+		now:         now,
+		halls:       make(map[uuid.UUID]*entity.Hall),
+		cinemas:     make(map[uuid.UUID]*entity.Cinema),
+		seatMapErrs: make(map[uuid.UUID]string),
+		movies:      make(map[uuid.UUID]*entity.Movie),
+		slots:       make(map[hallDay][]showSlot),
+	}
+}
+
+// check returns alasan schedule belum boleh di-publish, "" kalau lolos.
+// Error hanya untuk kegagalan query.
+func (c *publishChecker) check(ctx context.Context, schedule *entity.Schedule) (string, error) {
+	if schedule.IsPublished() {
+		return "schedule is already published", nil
+	}
+	if schedule.Status == entity.ScheduleStatusCancelled {
+		return "schedule was cancelled", nil
+	}
+	if schedule.StartsAt.Before(c.now) {
+		return "show time has passed", nil
+	}
+
+	movie, err := c.movie(ctx, schedule.MovieID)
+	if err != nil {
+		return "", err
+	}
+	if movie == nil {
+		return "movie not found", nil
+	}
+	if movie.DurationInMinutes <= 0 {
+		return "movie has no duration", nil
+	}
+
+	hall, err := c.hall(ctx, schedule.HallID)
+	if err != nil {
+		return "", err
+	}
+	if hall == nil {
+		return "hall not found", nil
+	}
+
+	cinema, err := c.cinema(ctx, hall.CinemaID)
+	if err != nil {
+		return "", err
+	}
+	if cinema != nil && cinema.IsClosedOn(schedule.ShowDate) {
+		return "cinema is closed on this date", nil
+	}
+
+	if reason, err := c.seatMapReadiness(ctx, hall); err != nil || reason != "" {
+		return reason, err
+	}
+
+	slot := showSlot{
+		scheduleID: schedule.ID,
+		start:      schedule.StartsAt,
+		end:        schedule.StartsAt.Add(time.Duration(movie.DurationInMinutes)*time.Minute + scheduleTurnaround),
+	}
+
+	// Show larut malam bisa bentrok dengan jadwal hari sebelum / sesudahnya
+	for _, offset := range []int{-1, 0, 1} {
+		day := schedule.ShowDate.AddDate(0, 0, offset)
+		others, err := c.daySlots(ctx, schedule.HallID, day)
+		if err != nil {
+			return "", err
+		}
+		for _, other := range others {
+			if slot.start.Before(other.end) && other.start.Before(slot.end) {
+				return fmt.Sprintf("conflicts with schedule %s at %s (movie runtime plus %d minutes turnaround)",
+					other.scheduleID, other.start.Format("2006-01-02 15:04"), int(scheduleTurnaround.Minutes())), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// accept records a schedule yang baru di-publish supaya draft berikutnya di batch dicek terhadapnya
+func (c *publishChecker) accept(schedule *entity.Schedule) {
+	movie := c.movies[schedule.MovieID]
+	if movie == nil {
+		return
+	}
+
+	key := hallDay{hallID: schedule.HallID, date: schedule.ShowDate.Format("2006-01-02")}
+	c.slots[key] = append(c.slots[key], showSlot{
+		scheduleID: schedule.ID,
+		start:      schedule.StartsAt,
+		end:        schedule.StartsAt.Add(time.Duration(movie.DurationInMinutes)*time.Minute + scheduleTurnaround),
+	})
+}
+
+// seatMapReadiness: hall harus punya seat map lengkap sesuai kapasitas dan minimal satu kursi yang bisa dijual
+func (c *publishChecker) seatMapReadiness(ctx context.Context, hall *entity.Hall) (string, error) {
+	if reason, ok := c.seatMapErrs[hall.ID]; ok {
+		return reason, nil
+	}
+
+	seats, err := c.repo.Seat.FindByHallID(ctx, hall.ID)
+	if err != nil {
+		return "", fmt.Errorf("load seat map for hall %s: %w", hall.ID.String(), err)
+	}
+
+	sellable := 0
+	for _, seat := range seats {
+		if seat.IsAvailable {
+			sellable++
+		}
+	}
+
+	reason := ""
+	switch {
+	case len(seats) == 0:
+		reason = "hall has no seat map"
+	case len(seats) != hall.TotalSeats:
+		reason = fmt.Sprintf("seat map has %d seats but hall capacity is %d", len(seats), hall.TotalSeats)
+	case sellable == 0:
+		reason = "hall has no sellable seats"
+	}
+
+	c.seatMapErrs[hall.ID] = reason
+	return reason, nil
+}
+
+// enqueueScheduleCreated announces schedule yang baru di-publish; hall sudah ada di cache checker
+func enqueueScheduleCreated(ctx context.Context, tx *repository.Repository, c *publishChecker, schedule *entity.Schedule, publishedAt time.Time) error {
+	hall, err := c.hall(ctx, schedule.HallID)
+	if err != nil {
+		return err
+	}
+	if hall == nil {
+		return fmt.Errorf("hall %s not found", schedule.HallID.String())
+	}
+
+	return enqueueEvent(ctx, tx, events.AggregateSchedule, schedule.ID, events.TypeScheduleCreated, events.ScheduleCreated{
+		ScheduleID:  schedule.ID.String(),
+		MovieID:     schedule.MovieID.String(),
+		HallID:      schedule.HallID.String(),
+		CinemaID:    hall.CinemaID.String(),
+		ShowDate:    schedule.ShowDate.Format("2006-01-02"),
+		ShowTime:    schedule.ShowTime.Format("15:04"),
+		StartsAt:    schedule.StartsAt,
+		Price:       utils.CurrencyOf(schedule.Currency).ToMajor(schedule.Price),
+		Currency:    schedule.Currency,
+		PublishedAt: publishedAt,
+	})
+}
+
+func (c *publishChecker) daySlots(ctx context.Context, hallID uuid.UUID, day time.Time) ([]showSlot, error) {
+	key := hallDay{hallID: hallID, date: day.Format("2006-01-02")}
+	if slots, ok := c.slots[key]; ok {
+		return slots, nil
+	}
+
+	published, err := c.repo.Schedule.FindByDateAndHall(ctx, hallID, day)
+	if err != nil {
+		return nil, fmt.Errorf("load schedules for conflict check: %w", err)
+	}
+
+	slots := make([]showSlot, 0, len(published))
+	for _, other := range published {
+		movie, err := c.movie(ctx, other.MovieID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Movie yang sudah dihapus tetap memblok slot minimal selama turnaround
+		runtime := scheduleTurnaround
+		if movie != nil {
+			runtime += time.Duration(movie.DurationInMinutes) * time.Minute
+		}
+		slots = append(slots, showSlot{
+			scheduleID: other.ID,
+			start:      other.StartsAt,
+			end:        other.StartsAt.Add(runtime),
+		})
+	}
+
+	c.slots[key] = slots
+	return slots, nil
+}
+
+func (c *publishChecker) cinema(ctx context.Context, id uuid.UUID) (*entity.Cinema, error) {
+	if cinema, ok := c.cinemas[id]; ok {
+		return cinema, nil
+	}
+
+	cinema, err := c.repo.Cinema.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find cinema %s: %w", id.String(), err)
+	}
+
+	c.cinemas[id] = cinema
+	return cinema, nil
+}
+
+func (c *publishChecker) movie(ctx context.Context, id uuid.UUID) (*entity.Movie, error) {
+	if movie, ok := c.movies[id]; ok {
+		return movie, nil
+	}
+
+	movie, err := c.repo.Movie.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find movie %s: %w", id.String(), err)
+	}
+
+	c.movies[id] = movie
+	return movie, nil
+}
+
+func (c *publishChecker) hall(ctx context.Context, id uuid.UUID) (*entity.Hall, error) {
+	if hall, ok := c.halls[id]; ok {
+		return hall, nil
+	}
+
+	hall, err := c.repo.Hall.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find hall %s: %w", id.String(), err)
+	}
+
+	c.halls[id] = hall
+	return hall, nil
+}
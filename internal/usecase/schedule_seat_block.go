@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetSeatBlocks lists kursi yang diblokir untuk schedule
+func (s *scheduleService) GetSeatBlocks(ctx context.Context, scheduleID string) ([]response.SeatBlockResponse, error) {
+	schedule, err := s.findSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.repo.SeatBlock.FindBySchedule(ctx, schedule.ID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get seat blocks", zap.Error(err), zap.String("schedule_id", scheduleID))
+		return nil, fmt.Errorf("get seat blocks: %w", err)
+	}
+
+	return s.seatBlockResponses(ctx, schedule.HallID, blocks)
+}
+
+// BlockSeats blocks kursi untuk satu schedule. Kursi yang sudah dibooking atau di-hold waitlist
+// ditolak; booking-nya harus dibatalkan dulu supaya penonton tidak kehilangan kursi diam-diam.
+func (s *scheduleService) BlockSeats(ctx context.Context, adminID, scheduleID string, req *request.SeatBlockRequest) ([]response.SeatBlockResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin ID format %s: %w", adminID, err)
+	}
+
+	schedule, err := s.findSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	seatIDs, err := hallSeatIDs(ctx, s.repo, schedule.HallID, req.SeatIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	blocks := make([]*entity.SeatBlock, len(seatIDs))
+	for i, seatID := range seatIDs {
+		blocks[i] = &entity.SeatBlock{
+			BaseSimple: entity.BaseSimple{ID: uuid.New(), CreatedAt: now},
+			ScheduleID: schedule.ID,
+			SeatID:     seatID,
+			Reason:     entity.SeatBlockReason(req.Reason),
+			Note:       req.Note,
+			BlockedBy:  &adminUUID,
+		}
+	}
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		// Lock yang sama dengan CreateBooking supaya kursi tidak dibooking sambil diblokir
+		if err := tx.Schedule.LockByID(ctx, schedule.ID); err != nil {
+			return err
+		}
+
+		bookedSeats, err := tx.BookingSeat.FindBookedSeatsBySchedule(ctx, schedule.ID)
+		if err != nil {
+			return fmt.Errorf("check booked seats: %w", err)
+		}
+		holds, err := tx.SeatHold.FindActiveBySchedule(ctx, schedule.ID, now)
+		if err != nil {
+			return fmt.Errorf("check seat holds: %w", err)
+		}
+
+		occupied := make(map[uuid.UUID]bool, len(bookedSeats)+len(holds))
+		for _, id := range bookedSeats {
+			occupied[id] = true
+		}
+		for _, hold := range holds {
+			occupied[hold.SeatID] = true
+		}
+		for _, seatID := range seatIDs {
+			if occupied[seatID] {
+				return fmt.Errorf("cannot block seat %s: already booked or held", seatID.String())
+			}
+		}
+
+		return tx.SeatBlock.CreateBatch(ctx, blocks)
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to block seats",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID),
+			zap.Int("seat_count", len(seatIDs)),
+		)
+		return nil, fmt.Errorf("block seats: %w", err)
+	}
+	s.seats.invalidate(schedule.ID)
+
+	utils.LoggerFromContext(ctx, s.log).Info("Seats blocked",
+		zap.String("schedule_id", scheduleID),
+		zap.String("admin_id", adminID),
+		zap.String("reason", req.Reason),
+		zap.Int("seat_count", len(seatIDs)),
+	)
+
+	return s.GetSeatBlocks(ctx, scheduleID)
+}
+
+// UnblockSeats removes blokir dan menawarkan kursi yang lepas ke waitlist
+func (s *scheduleService) UnblockSeats(ctx context.Context, scheduleID string, req *request.SeatIDsRequest) ([]response.SeatBlockResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	schedule, err := s.findSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	seatIDs, err := hallSeatIDs(ctx, s.repo, schedule.HallID, req.SeatIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.repo.SeatBlock.DeleteBySeats(ctx, schedule.ID, seatIDs)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to unblock seats", zap.Error(err), zap.String("schedule_id", scheduleID))
+		return nil, fmt.Errorf("unblock seats: %w", err)
+	}
+
+	if removed > 0 {
+		s.seats.invalidate(schedule.ID)
+		if err := s.waitlist.OfferFreedSeats(ctx, schedule.ID); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to offer unblocked seats to waitlist", zap.Error(err), zap.String("schedule_id", scheduleID))
+		}
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Seats unblocked",
+		zap.String("schedule_id", scheduleID),
+		zap.Int64("removed", removed),
+	)
+
+	return s.GetSeatBlocks(ctx, scheduleID)
+}
+
+func (s *scheduleService) findSchedule(ctx context.Context, scheduleID string) (*entity.Schedule, error) {
+	id, err := uuid.Parse(scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule id: %w", err)
+	}
+
+	schedule, err := s.repo.Schedule.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find schedule: %w", err)
+	}
+	if schedule == nil {
+		return nil, fmt.Errorf("schedule %s not found", scheduleID)
+	}
+	if err := requireScheduleScope(ctx, s.repo, schedule.ID); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (s *scheduleService) seatBlockResponses(ctx context.Context, hallID uuid.UUID, blocks []*entity.SeatBlock) ([]response.SeatBlockResponse, error) {
+	result := make([]response.SeatBlockResponse, 0, len(blocks))
+	if len(blocks) == 0 {
+		return result, nil
+	}
+
+	seats, err := s.repo.Seat.FindByHallID(ctx, hallID)
+	if err != nil {
+		return nil, fmt.Errorf("load hall seats: %w", err)
+	}
+	seatNumbers := make(map[uuid.UUID]string, len(seats))
+	for _, seat := range seats {
+		seatNumbers[seat.ID] = seat.SeatNumber
+	}
+
+	for _, block := range blocks {
+		result = append(result, response.SeatBlockToResponse(block, seatNumbers[block.SeatID]))
+	}
+	return result, nil
+}
+
+// hallSeatIDs parses seat ID dan memastikan semuanya ada di hall; duplikat diabaikan
+func hallSeatIDs(ctx context.Context, repo *repository.Repository, hallID uuid.UUID, seatIDStrs []string) ([]uuid.UUID, error) {
+	seats, err := repo.Seat.FindByHallID(ctx, hallID)
+	if err != nil {
+		return nil, fmt.Errorf("load hall seats: %w", err)
+	}
+	inHall := make(map[uuid.UUID]bool, len(seats))
+	for _, seat := range seats {
+		inHall[seat.ID] = true
+	}
+
+	seen := make(map[uuid.UUID]bool, len(seatIDStrs))
+	seatIDs := make([]uuid.UUID, 0, len(seatIDStrs))
+	for _, seatIDStr := range seatIDStrs {
+		seatID, err := uuid.Parse(seatIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seat ID format %s: %w", seatIDStr, err)
+		}
+		if !inHall[seatID] {
+			return nil, fmt.Errorf("seat %s not found in hall %s", seatIDStr, hallID.String())
+		}
+		if !seen[seatID] {
+			seen[seatID] = true
+			seatIDs = append(seatIDs, seatID)
+		}
+	}
+
+	return seatIDs, nil
+}
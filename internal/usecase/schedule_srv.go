@@ -0,0 +1,655 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// scheduleTurnaround jeda minimal antar show di hall yang sama (bersih-bersih, keluar-masuk penonton)
+const scheduleTurnaround = 15 * time.Minute
+
+type ScheduleService interface {
+	GetSchedules(ctx context.Context, req *request.PaginatedRequest, filter *request.ScheduleListFilter) (*response.PaginatedResponse[response.ScheduleResponse], error)
+
+	// Admin endpoints; schedule baru selalu draft sampai di-publish
+	GetAdminSchedules(ctx context.Context, req *request.PaginatedRequest, filter *request.AdminScheduleListFilter) (*response.PaginatedResponse[response.ScheduleResponse], error)
+	CreateSchedule(ctx context.Context, req *request.ScheduleRequest) (*response.ScheduleResponse, error)
+	UpdateSchedule(ctx context.Context, scheduleID string, req *request.ScheduleUpdateRequest) (*response.ScheduleResponse, error)
+	DeleteSchedule(ctx context.Context, scheduleID string) error
+	PublishSchedules(ctx context.Context, req *request.PublishSchedulesRequest) (*response.PublishSchedulesResponse, error)
+	// CloneWeek menyalin program mingguan satu cinema ke minggu lain sebagai draft, dry_run hanya preview
+	CloneWeek(ctx context.Context, req *request.CloneScheduleWeekRequest) (*response.ScheduleCloneResponse, error)
+	// SetPriceOverride menetapkan atau menghapus (price null) harga khusus schedule; promo tidak berlaku di atasnya
+	SetPriceOverride(ctx context.Context, scheduleID string, req *request.SchedulePriceOverrideRequest) (*response.ScheduleResponse, error)
+
+	// Blokir kursi per schedule (jaga jarak dsb); blokir permanen per hall ada di CinemaService
+	GetSeatBlocks(ctx context.Context, scheduleID string) ([]response.SeatBlockResponse, error)
+	BlockSeats(ctx context.Context, adminID, scheduleID string, req *request.SeatBlockRequest) ([]response.SeatBlockResponse, error)
+	UnblockSeats(ctx context.Context, scheduleID string, req *request.SeatIDsRequest) ([]response.SeatBlockResponse, error)
+}
+
+type scheduleService struct {
+	repo     *repository.Repository
+	seats    *seatAvailability
+	waitlist WaitlistService
+	pricing  pricingRules
+	log      *zap.Logger
+}
+
+func NewScheduleService(repo *repository.Repository, seats *seatAvailability, waitlist WaitlistService, pricing utils.PricingConfig, log *zap.Logger) ScheduleService {
+	return &scheduleService{
+		repo:     repo,
+		seats:    seats,
+		waitlist: waitlist,
+		pricing:  newPricingRules(pricing),
+		log:      log.With(zap.String("service", "schedule")),
+	}
+}
+
+// GetSchedules lists upcoming published schedules (today onwards) dengan filter movie, cinema, tanggal dan format
+func (s *scheduleService) GetSchedules(ctx context.Context, req *request.PaginatedRequest, filter *request.ScheduleListFilter) (*response.PaginatedResponse[response.ScheduleResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
+	published := entity.ScheduleStatusPublished
+	return s.listSchedules(ctx, req, filter, &published)
+}
+
+// GetAdminSchedules sama dengan GetSchedules tapi termasuk draft, opsional difilter status
+func (s *scheduleService) GetAdminSchedules(ctx context.Context, req *request.PaginatedRequest, filter *request.AdminScheduleListFilter) (*response.PaginatedResponse[response.ScheduleResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
+	var status *entity.ScheduleStatus
+	if filter.Status != "" {
+		st := entity.ScheduleStatus(filter.Status)
+		status = &st
+	}
+
+	return s.listSchedules(ctx, req, &filter.ScheduleListFilter, status)
+}
+
+func (s *scheduleService) CreateSchedule(ctx context.Context, req *request.ScheduleRequest) (*response.ScheduleResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Create schedule validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	movieID, hallID, err := s.parseMovieAndHall(ctx, req.MovieID, req.HallID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.hallLocation(ctx, hallID)
+	if err != nil {
+		return nil, err
+	}
+
+	showDate, showTime, startsAt, err := parseShowtime(req.ShowDate, req.ShowTime, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, err := s.scheduleCurrency(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	schedule := &entity.Schedule{
+		Base: entity.Base{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		MovieID:  movieID,
+		HallID:   hallID,
+		ShowDate: showDate,
+		ShowTime: showTime,
+		StartsAt: startsAt,
+		Price:    currency.ToMinor(req.Price),
+		Currency: currency.Code,
+		Status:   entity.ScheduleStatusDraft,
+	}
+
+	if err := s.repo.Schedule.Create(ctx, schedule); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create schedule", zap.Error(err))
+		return nil, fmt.Errorf("create schedule: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Draft schedule created",
+		zap.String("schedule_id", schedule.ID.String()),
+		zap.String("movie_id", req.MovieID),
+		zap.String("hall_id", req.HallID),
+	)
+
+	return s.buildScheduleResponse(ctx, schedule)
+}
+
+func (s *scheduleService) UpdateSchedule(ctx context.Context, scheduleID string, req *request.ScheduleUpdateRequest) (*response.ScheduleResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Update schedule validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	schedule, err := s.findDraft(ctx, scheduleID, "update")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MovieID != nil || req.HallID != nil {
+		movieIDStr, hallIDStr := schedule.MovieID.String(), schedule.HallID.String()
+		if req.MovieID != nil {
+			movieIDStr = *req.MovieID
+		}
+		if req.HallID != nil {
+			hallIDStr = *req.HallID
+		}
+
+		schedule.MovieID, schedule.HallID, err = s.parseMovieAndHall(ctx, movieIDStr, hallIDStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Pindah hall bisa berarti pindah cinema dengan timezone lain, jadi starts_at ikut dihitung ulang
+	if req.HallID != nil || req.ShowDate != nil || req.ShowTime != nil {
+		loc, err := s.hallLocation(ctx, schedule.HallID)
+		if err != nil {
+			return nil, err
+		}
+
+		dateStr, timeStr := schedule.ShowDate.Format("2006-01-02"), schedule.ShowTime.Format("15:04")
+		if req.ShowDate != nil {
+			dateStr = *req.ShowDate
+		}
+		if req.ShowTime != nil {
+			timeStr = *req.ShowTime
+		}
+
+		schedule.ShowDate, schedule.ShowTime, schedule.StartsAt, err = parseShowtime(dateStr, timeStr, loc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Price disimpan ulang dalam minor unit currency (baru) supaya keduanya tetap konsisten
+	if req.Currency != nil || req.Price != nil {
+		code := schedule.Currency
+		if req.Currency != nil {
+			code = *req.Currency
+		}
+		currency, err := s.scheduleCurrency(code)
+		if err != nil {
+			return nil, err
+		}
+
+		price := utils.CurrencyOf(schedule.Currency).ToMajor(schedule.Price)
+		if req.Price != nil {
+			price = *req.Price
+		}
+		schedule.Price = currency.ToMinor(price)
+		schedule.Currency = currency.Code
+	}
+
+	schedule.UpdatedAt = time.Now()
+	if err := s.repo.Schedule.Update(ctx, schedule); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update schedule",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID),
+		)
+		return nil, fmt.Errorf("update schedule %s: %w", scheduleID, err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Draft schedule updated", zap.String("schedule_id", scheduleID))
+
+	return s.buildScheduleResponse(ctx, schedule)
+}
+
+// DeleteSchedule only removes drafts; schedule published bisa sudah punya booking
+func (s *scheduleService) DeleteSchedule(ctx context.Context, scheduleID string) error {
+	schedule, err := s.findDraft(ctx, scheduleID, "delete")
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Schedule.Delete(ctx, schedule.ID); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to delete schedule",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// PublishSchedules validates every draft lalu publish yang lolos dalam satu transaction.
+// Schedule yang gagal (bentrok jadwal, seat map belum siap, sudah lewat) dilaporkan dan tetap draft.
+func (s *scheduleService) PublishSchedules(ctx context.Context, req *request.PublishSchedulesRequest) (*response.PublishSchedulesResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Publish schedules validation failed", zap.Any("errors", errs))
+		return nil, errs
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.ScheduleIDs))
+	seen := make(map[uuid.UUID]bool, len(req.ScheduleIDs))
+	for _, idStr := range req.ScheduleIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule ID format %s: %w", idStr, err)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	result := &response.PublishSchedulesResponse{
+		Published: []string{},
+		Failed:    []response.SchedulePublishFailure{},
+	}
+
+	now := time.Now()
+	err := s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		checker := newPublishChecker(tx, now)
+
+		var candidates []*entity.Schedule
+		for _, id := range ids {
+			schedule, err := tx.Schedule.FindByID(ctx, id)
+			if err != nil {
+				return err
+			}
+			inScope, err := scheduleInScope(ctx, tx, id)
+			if err != nil {
+				return err
+			}
+			if schedule == nil || !inScope {
+				result.Failed = append(result.Failed, response.SchedulePublishFailure{ScheduleID: id.String(), Reason: "schedule not found"})
+				continue
+			}
+			candidates = append(candidates, schedule)
+		}
+
+		// Lock hall berurutan supaya dua publish paralel tidak deadlock maupun lolos bentrok bersamaan
+		halls := make([]uuid.UUID, 0, len(candidates))
+		lockedHall := make(map[uuid.UUID]bool)
+		for _, schedule := range candidates {
+			if !lockedHall[schedule.HallID] {
+				lockedHall[schedule.HallID] = true
+				halls = append(halls, schedule.HallID)
+			}
+		}
+		sort.Slice(halls, func(i, j int) bool { return halls[i].String() < halls[j].String() })
+		for _, hallID := range halls {
+			hall, err := checker.hall(ctx, hallID)
+			if err != nil {
+				return err
+			}
+			if hall == nil {
+				continue // dilaporkan per schedule oleh checker
+			}
+			if err := tx.Hall.LockByID(ctx, hallID); err != nil {
+				return err
+			}
+		}
+
+		// Urut waktu tayang supaya dua draft yang bentrok, yang lebih awal yang menang
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].StartsAt.Before(candidates[j].StartsAt)
+		})
+
+		for _, schedule := range candidates {
+			reason, err := checker.check(ctx, schedule)
+			if err != nil {
+				return err
+			}
+			if reason != "" {
+				result.Failed = append(result.Failed, response.SchedulePublishFailure{ScheduleID: schedule.ID.String(), Reason: reason})
+				continue
+			}
+
+			if err := tx.Schedule.Publish(ctx, schedule.ID, now); err != nil {
+				return err
+			}
+			if err := enqueueScheduleCreated(ctx, tx, checker, schedule, now); err != nil {
+				return err
+			}
+			checker.accept(schedule)
+			result.Published = append(result.Published, schedule.ID.String())
+		}
+
+		return nil
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to publish schedules", zap.Error(err), zap.Int("count", len(ids)))
+		return nil, fmt.Errorf("publish schedules: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Schedules published",
+		zap.Int("published", len(result.Published)),
+		zap.Int("failed", len(result.Failed)),
+	)
+
+	return result, nil
+}
+
+// SetPriceOverride sets harga khusus per kursi (major unit currency schedule), boleh untuk schedule published.
+// Booking yang sudah dibuat tetap memakai harga lama.
+func (s *scheduleService) SetPriceOverride(ctx context.Context, scheduleID string, req *request.SchedulePriceOverrideRequest) (*response.ScheduleResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	schedule, err := s.findSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.PriceOverride = nil
+	if req.Price != nil {
+		price := utils.CurrencyOf(schedule.Currency).ToMinor(*req.Price)
+		schedule.PriceOverride = &price
+	}
+
+	if err := s.repo.Schedule.SetPriceOverride(ctx, schedule.ID, schedule.PriceOverride); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to set schedule price override",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID),
+		)
+		return nil, err
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Schedule price override updated",
+		zap.String("schedule_id", scheduleID),
+		zap.Bool("cleared", schedule.PriceOverride == nil),
+	)
+
+	return s.buildScheduleResponse(ctx, schedule)
+}
+
+// ==================== HELPER METHODS ====================
+
+// listSchedules shared by public and admin listing; status nil berarti semua status
+func (s *scheduleService) listSchedules(ctx context.Context, req *request.PaginatedRequest, filter *request.ScheduleListFilter, status *entity.ScheduleStatus) (*response.PaginatedResponse[response.ScheduleResponse], error) {
+	repoFilter := repository.ScheduleFilter{
+		StartsFrom:     time.Now(),
+		Status:         status,
+		OrganizationID: adminOrganization(ctx),
+	}
+
+	if filter.MovieID != "" {
+		movieID, err := uuid.Parse(filter.MovieID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid movie id: %w", err)
+		}
+		repoFilter.MovieID = &movieID
+	}
+
+	if filter.CinemaID != "" {
+		cinemaID, err := uuid.Parse(filter.CinemaID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cinema ID format %s: %w", filter.CinemaID, err)
+		}
+		repoFilter.CinemaID = &cinemaID
+	}
+
+	if filter.Date != "" {
+		showDate, err := time.Parse("2006-01-02", filter.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date format %s: %w", filter.Date, err)
+		}
+		repoFilter.ShowDate = &showDate
+	}
+
+	if filter.Format != "" {
+		hallType := entity.HallType(filter.Format)
+		repoFilter.HallType = &hallType
+	}
+
+	schedules, err := s.repo.Schedule.FindAll(ctx, repoFilter, req.Limit(), req.Offset())
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get schedules",
+			zap.Error(err),
+			zap.Int("page", req.Page),
+			zap.Int("per_page", req.PerPage),
+		)
+		return nil, fmt.Errorf("get schedules: %w", err)
+	}
+
+	total, err := s.repo.Schedule.CountAll(ctx, repoFilter)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count schedules", zap.Error(err))
+		return nil, fmt.Errorf("count schedules: %w", err)
+	}
+
+	scheduleResponses, lastModified, err := scheduleResponsesWithLastModified(ctx, s.repo, s.pricing, schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Schedules retrieved",
+		zap.Int("count", len(schedules)),
+		zap.Int64("total", total),
+		zap.String("format", filter.Format),
+	)
+
+	result := response.NewPaginatedResponse(scheduleResponses, req.Page, req.PerPage, total)
+	result.LastModified = lastModified
+	return result, nil
+}
+
+// findDraft loads a schedule untuk diubah admin; schedule published tidak boleh diubah lagi
+func (s *scheduleService) findDraft(ctx context.Context, scheduleID, action string) (*entity.Schedule, error) {
+	id, err := uuid.Parse(scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule ID format %s: %w", scheduleID, err)
+	}
+
+	schedule, err := s.repo.Schedule.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find schedule: %w", err)
+	}
+	if schedule == nil {
+		return nil, fmt.Errorf("schedule %s not found", scheduleID)
+	}
+	if err := requireScheduleScope(ctx, s.repo, schedule.ID); err != nil {
+		return nil, err
+	}
+	if schedule.IsPublished() {
+		return nil, fmt.Errorf("cannot %s published schedule %s", action, scheduleID)
+	}
+
+	return schedule, nil
+}
+
+// parseMovieAndHall memastikan movie dan hall masih ada, dan hall milik chain admin yang login
+func (s *scheduleService) parseMovieAndHall(ctx context.Context, movieIDStr, hallIDStr string) (uuid.UUID, uuid.UUID, error) {
+	movieID, err := uuid.Parse(movieIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid movie ID format %s: %w", movieIDStr, err)
+	}
+	hallID, err := uuid.Parse(hallIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid hall ID format %s: %w", hallIDStr, err)
+	}
+
+	movie, err := s.repo.Movie.FindByID(ctx, movieID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("find movie: %w", err)
+	}
+	if movie == nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("movie %s not found", movieIDStr)
+	}
+
+	hall, err := s.repo.Hall.FindByID(ctx, hallID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("find hall: %w", err)
+	}
+	if hall == nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("hall %s not found", hallIDStr)
+	}
+	if err := requireHallScope(ctx, s.repo, hallID); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return movieID, hallID, nil
+}
+
+// hallLocation returns zona waktu cinema tempat hall berada
+func (s *scheduleService) hallLocation(ctx context.Context, hallID uuid.UUID) (*time.Location, error) {
+	hall, err := s.repo.Hall.FindByID(ctx, hallID)
+	if err != nil {
+		return nil, fmt.Errorf("find hall: %w", err)
+	}
+	if hall == nil {
+		return nil, fmt.Errorf("hall %s not found", hallID.String())
+	}
+
+	cinema, err := s.repo.Cinema.FindByID(ctx, hall.CinemaID)
+	if err != nil {
+		return nil, fmt.Errorf("find cinema: %w", err)
+	}
+	if cinema == nil {
+		return nil, fmt.Errorf("cinema %s not found", hall.CinemaID.String())
+	}
+
+	return cinema.TimeLocation(), nil
+}
+
+// parseShowtime parses show date (DATE) dan jam tayang (TIME) sebagai jam dinding di loc,
+// lalu mengembalikan juga waktu mulainya dalam UTC. Jadwal lampau ditolak.
+func parseShowtime(dateStr, timeStr string, loc *time.Location) (time.Time, time.Time, time.Time, error) {
+	showDate, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("invalid show_date %s: %w", dateStr, err)
+	}
+	showTime, err := time.Parse("15:04", timeStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("invalid show_time %s: %w", timeStr, err)
+	}
+
+	startsAt := time.Date(
+		showDate.Year(), showDate.Month(), showDate.Day(),
+		showTime.Hour(), showTime.Minute(), 0, 0, loc,
+	).UTC()
+	if startsAt.Before(time.Now()) {
+		return time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("invalid showtime: %s %s is in the past", dateStr, timeStr)
+	}
+
+	return showDate, showTime, startsAt, nil
+}
+
+// scheduleCurrency defaults ke currency pricing; kode lain harus currency yang didukung
+func (s *scheduleService) scheduleCurrency(code string) (utils.Currency, error) {
+	if code == "" {
+		return s.pricing.currency, nil
+	}
+
+	currency, ok := utils.LookupCurrency(code)
+	if !ok {
+		return utils.Currency{}, fmt.Errorf("invalid currency %s", code)
+	}
+
+	return currency, nil
+}
+
+func (s *scheduleService) buildScheduleResponse(ctx context.Context, schedule *entity.Schedule) (*response.ScheduleResponse, error) {
+	responses, err := buildScheduleResponses(ctx, s.repo, s.pricing, []*entity.Schedule{schedule})
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses[0], nil
+}
+
+// buildScheduleResponses hydrates hall & cinema (di-cache per call karena schedule sering di hall yang sama)
+// dan mengisi Price dengan harga efektif setelah pricing rules, override dan promo
+func buildScheduleResponses(ctx context.Context, repo *repository.Repository, pricing pricingRules, schedules []*entity.Schedule) ([]response.ScheduleResponse, error) {
+	result, _, err := scheduleResponsesWithLastModified(ctx, repo, pricing, schedules)
+	return result, err
+}
+
+// scheduleResponsesWithLastModified juga mengembalikan updated_at terbaru dari schedule, hall, cinema
+// dan promo aktif, karena perubahan salah satunya mengubah isi response
+func scheduleResponsesWithLastModified(ctx context.Context, repo *repository.Repository, pricing pricingRules, schedules []*entity.Schedule) ([]response.ScheduleResponse, time.Time, error) {
+	var lastModified time.Time
+	touch := func(t time.Time) {
+		if t.After(lastModified) {
+			lastModified = t
+		}
+	}
+
+	halls := make(map[uuid.UUID]*entity.Hall)
+	cinemas := make(map[uuid.UUID]*entity.Cinema)
+
+	var promotions []*entity.PricePromotion
+	if len(schedules) > 0 {
+		var err error
+		promotions, err = repo.PricePromotion.FindActive(ctx)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("find price promotions: %w", err)
+		}
+	}
+	for _, promotion := range promotions {
+		touch(promotion.UpdatedAt)
+	}
+
+	result := make([]response.ScheduleResponse, 0, len(schedules))
+	for _, schedule := range schedules {
+		hall, ok := halls[schedule.HallID]
+		if !ok {
+			var err error
+			hall, err = repo.Hall.FindByID(ctx, schedule.HallID)
+			if err != nil {
+				return nil, time.Time{}, fmt.Errorf("find hall: %w", err)
+			}
+			halls[schedule.HallID] = hall
+		}
+
+		var cinema *entity.Cinema
+		if hall != nil {
+			cinema, ok = cinemas[hall.CinemaID]
+			if !ok {
+				var err error
+				cinema, err = repo.Cinema.FindByID(ctx, hall.CinemaID)
+				if err != nil {
+					return nil, time.Time{}, fmt.Errorf("find cinema: %w", err)
+				}
+				cinemas[hall.CinemaID] = cinema
+			}
+		}
+
+		touch(schedule.UpdatedAt)
+		if hall != nil {
+			touch(hall.UpdatedAt)
+		}
+		if cinema != nil {
+			touch(cinema.UpdatedAt)
+		}
+
+		scheduleResp := response.ScheduleToResponse(schedule, hall, cinema)
+		// Listing sama untuk semua pengunjung, jadi promo khusus tier hanya berlaku saat booking
+		price, promotion := pricing.promotedPrice(schedule, hall, promotions, "")
+		response.SetSchedulePrice(&scheduleResp, price, schedule.Currency)
+		if promotion != nil {
+			response.SetSchedulePromotion(&scheduleResp, pricing.seatPrice(schedule, hall), promotion)
+		}
+		result = append(result, scheduleResp)
+	}
+
+	return result, lastModified, nil
+}
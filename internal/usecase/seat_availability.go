@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/cache"
+
+	"github.com/google/uuid"
+)
+
+// seatStatus why a seat tidak bisa dipilih untuk satu schedule
+type seatStatus string
+
+const (
+	seatStatusBooked  seatStatus = "booked"
+	seatStatusHeld    seatStatus = "held"
+	seatStatusBlocked seatStatus = "blocked"
+)
+
+// seatAvailability caches kursi yang sudah dibooking, di-hold waitlist atau diblokir admin per schedule untuk
+// tampilan seat map saat on-sale ramai. Hanya untuk listing: CreateBooking dan OfferFreedSeats
+// tetap membaca langsung di dalam transaction dengan schedule lock.
+type seatAvailability struct {
+	repo  *repository.Repository
+	cache *cache.TTL[uuid.UUID, map[uuid.UUID]seatStatus]
+
+	// generation naik setiap invalidate; hasil query yang mulai sebelum invalidate tidak disimpan
+	generation atomic.Uint64
+}
+
+func newSeatAvailability(repo *repository.Repository, ttl time.Duration) *seatAvailability {
+	return &seatAvailability{
+		repo:  repo,
+		cache: cache.NewTTL[uuid.UUID, map[uuid.UUID]seatStatus](ttl),
+	}
+}
+
+// taken returns seat yang tidak bisa dipilih untuk schedule beserta alasannya. Map hasil dipakai bersama, jangan diubah.
+func (a *seatAvailability) taken(ctx context.Context, scheduleID uuid.UUID) (map[uuid.UUID]seatStatus, error) {
+	if taken, ok := a.cache.Get(scheduleID); ok {
+		return taken, nil
+	}
+
+	generation := a.generation.Load()
+
+	bookedSeats, err := a.repo.BookingSeat.FindBookedSeatsBySchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("load booked seats: %w", err)
+	}
+
+	holds, err := a.repo.SeatHold.FindActiveBySchedule(ctx, scheduleID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("load seat holds: %w", err)
+	}
+
+	blocks, err := a.repo.SeatBlock.FindBySchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("load seat blocks: %w", err)
+	}
+
+	taken := make(map[uuid.UUID]seatStatus, len(bookedSeats)+len(holds)+len(blocks))
+	for _, block := range blocks {
+		taken[block.SeatID] = seatStatusBlocked
+	}
+	for _, hold := range holds {
+		taken[hold.SeatID] = seatStatusHeld
+	}
+	for _, id := range bookedSeats {
+		taken[id] = seatStatusBooked
+	}
+
+	if a.generation.Load() == generation {
+		a.cache.Set(scheduleID, taken)
+	}
+	return taken, nil
+}
+
+// invalidate dipanggil setelah booking, hold atau blokir untuk schedule berubah dan sudah commit
+func (a *seatAvailability) invalidate(scheduleID uuid.UUID) {
+	a.generation.Add(1)
+	a.cache.Delete(scheduleID)
+}
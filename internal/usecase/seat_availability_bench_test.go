@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/data/repository/mockrepo"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+// benchSeatAvailability cache di atas repository mock berisi satu hall penuh (booked, hold dan blokir)
+func benchSeatAvailability(b *testing.B, seats int) *seatAvailability {
+	ctrl := gomock.NewController(b)
+
+	booked := make([]uuid.UUID, 0, seats)
+	holds := make([]*entity.SeatHold, 0, seats/10)
+	blocks := make([]*entity.SeatBlock, 0, seats/20)
+	for i := 0; i < seats; i++ {
+		id := uuid.New()
+		switch {
+		case i%20 == 0:
+			blocks = append(blocks, &entity.SeatBlock{SeatID: id})
+		case i%10 == 0:
+			holds = append(holds, &entity.SeatHold{SeatID: id})
+		default:
+			booked = append(booked, id)
+		}
+	}
+
+	bookingSeats := mockrepo.NewMockBookingSeatRepository(ctrl)
+	bookingSeats.EXPECT().FindBookedSeatsBySchedule(gomock.Any(), gomock.Any()).Return(booked, nil).AnyTimes()
+	seatHolds := mockrepo.NewMockSeatHoldRepository(ctrl)
+	seatHolds.EXPECT().FindActiveBySchedule(gomock.Any(), gomock.Any(), gomock.Any()).Return(holds, nil).AnyTimes()
+	seatBlocks := mockrepo.NewMockSeatBlockRepository(ctrl)
+	seatBlocks.EXPECT().FindBySchedule(gomock.Any(), gomock.Any()).Return(blocks, nil).AnyTimes()
+
+	repo := &repository.Repository{BookingSeat: bookingSeats, SeatHold: seatHolds, SeatBlock: seatBlocks}
+	return newSeatAvailability(repo, time.Minute)
+}
+
+func BenchmarkSeatAvailability_Taken(b *testing.B) {
+	ctx := context.Background()
+	scheduleID := uuid.New()
+
+	b.Run("cache hit", func(b *testing.B) {
+		availability := benchSeatAvailability(b, 300)
+		if _, err := availability.taken(ctx, scheduleID); err != nil {
+			b.Fatal(err)
+		}
+
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := availability.taken(ctx, scheduleID); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+
+	// Setiap booking meng-invalidate cache, jadi on-sale ramai sebagian besar jatuh ke jalur ini
+	b.Run("miss after invalidate", func(b *testing.B) {
+		availability := benchSeatAvailability(b, 300)
+
+		b.ReportAllocs()
+		for b.Loop() {
+			availability.invalidate(scheduleID)
+			if _, err := availability.taken(ctx, scheduleID); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
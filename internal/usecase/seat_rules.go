@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"sort"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/pkg/i18n"
+
+	"github.com/google/uuid"
+)
+
+// SeatRule identifies which seat selection rule was violated
+type SeatRule string
+
+const (
+	SeatRuleMaxSeats      SeatRule = "max_seats"
+	SeatRuleDuplicateSeat SeatRule = "duplicate_seat"
+	SeatRuleSingleSeatGap SeatRule = "single_seat_gap"
+	SeatRuleUnavailable   SeatRule = "seat_unavailable"
+	SeatRuleBlocked       SeatRule = "seat_blocked"
+)
+
+// SeatSelectionError is returned when CreateBooking rejects the chosen seats
+type SeatSelectionError struct {
+	Rule    SeatRule
+	Message string
+
+	// key dan args untuk menerjemahkan Message sesuai bahasa request
+	key  string
+	args []any
+}
+
+func newSeatSelectionError(rule SeatRule, key string, args ...any) *SeatSelectionError {
+	return &SeatSelectionError{
+		Rule:    rule,
+		Message: i18n.T(i18n.English, key, args...),
+		key:     key,
+		args:    args,
+	}
+}
+
+func (e *SeatSelectionError) Error() string {
+	return "invalid seat selection: " + e.Message
+}
+
+func (e *SeatSelectionError) Localize(lang i18n.Lang) string {
+	return i18n.T(lang, "booking.seat_selection", i18n.T(lang, e.key, e.args...))
+}
+
+// seatRules holds the configurable booking rules; maxSeats <= 0 berarti tanpa batas
+type seatRules struct {
+	maxSeats        int
+	noSingleSeatGap bool
+}
+
+// checkSelection validates the request itself, sebelum menyentuh database
+func (r seatRules) checkSelection(seatIDs []uuid.UUID) error {
+	if r.maxSeats > 0 && len(seatIDs) > r.maxSeats {
+		return newSeatSelectionError(SeatRuleMaxSeats, "booking.seat_max", r.maxSeats, len(seatIDs))
+	}
+
+	seen := make(map[uuid.UUID]bool, len(seatIDs))
+	for _, id := range seatIDs {
+		if seen[id] {
+			return newSeatSelectionError(SeatRuleDuplicateSeat, "booking.seat_duplicate", id.String())
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// checkGaps rejects selections that leave a single empty seat next to a newly selected seat.
+// Gap yang sudah ada sebelumnya (bukan karena booking ini) tidak dihitung.
+func (r seatRules) checkGaps(hallSeats []*entity.Seat, booked map[uuid.UUID]bool, selectedIDs []uuid.UUID) error {
+	if !r.noSingleSeatGap {
+		return nil
+	}
+
+	selected := make(map[uuid.UUID]bool, len(selectedIDs))
+	for _, id := range selectedIDs {
+		selected[id] = true
+	}
+
+	rows := make(map[string][]*entity.Seat)
+	touched := make(map[string]bool)
+	for _, seat := range hallSeats {
+		rows[seat.SeatRow] = append(rows[seat.SeatRow], seat)
+		if selected[seat.ID] {
+			touched[seat.SeatRow] = true
+		}
+	}
+
+	occupied := func(seat *entity.Seat) bool {
+		return booked[seat.ID] || selected[seat.ID] || !seat.IsAvailable
+	}
+
+	for row := range touched {
+		seats := rows[row]
+		sort.Slice(seats, func(i, j int) bool { return seats[i].SeatColumn < seats[j].SeatColumn })
+
+		for i, seat := range seats {
+			if occupied(seat) {
+				continue
+			}
+
+			// Kolom yang tidak berurutan (aisle) dianggap ujung row
+			leftBlocked, leftSelected := true, false
+			if i > 0 && seats[i-1].SeatColumn == seat.SeatColumn-1 {
+				leftBlocked = occupied(seats[i-1])
+				leftSelected = selected[seats[i-1].ID]
+			}
+
+			rightBlocked, rightSelected := true, false
+			if i < len(seats)-1 && seats[i+1].SeatColumn == seat.SeatColumn+1 {
+				rightBlocked = occupied(seats[i+1])
+				rightSelected = selected[seats[i+1].ID]
+			}
+
+			if leftBlocked && rightBlocked && (leftSelected || rightSelected) {
+				return newSeatSelectionError(SeatRuleSingleSeatGap, "booking.seat_single_gap", seat.SeatNumber)
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,28 +1,173 @@
 package usecase
 
 import (
+	"time"
+
 	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/events"
+	"cinema-booking/pkg/notification"
+	"cinema-booking/pkg/resilience"
 	"cinema-booking/pkg/utils"
 
 	"go.uber.org/zap"
 )
 
 type Service struct {
-	Auth    AuthService
-	User    UserService
-	Movie   MovieService
-	Cinema  CinemaService
-	Booking BookingService
-	Review  ReviewService
+	Auth          AuthService
+	User          UserService
+	Movie         MovieService
+	Cinema        CinemaService
+	Schedule      ScheduleService
+	Booking       BookingService
+	Review        ReviewService
+	Notification  NotificationService
+	Report        ReportService
+	Outbox        OutboxService
+	Waitlist      WaitlistService
+	Watchlist     WatchlistService
+	Home          HomeService
+	PaymentMethod PaymentMethodService
+	Health        HealthService
+
+	PricePromotion PricePromotionService
+	Organization   OrganizationService
+	Webhook        WebhookService
+	Feed           FeedService
+	Banner         BannerService
+	GiftCard       GiftCardService
+	DataExport     DataExportService
+	Audit          AuditService
+	Ledger         LedgerService
+	Settings       SettingsService
+	FeatureFlag    FeatureFlagService
+	Job            JobService
+	Cleanup        CleanupService
+	CustomerTier   CustomerTierService
 }
 
 func NewService(repo *repository.Repository, config *utils.Config, log *zap.Logger) *Service {
+	notificationService := NewNotificationService(repo, newNotificationSenders(config, log), log)
+	watchlistService := NewWatchlistService(repo, notificationService, log)
+	seats := newSeatAvailability(repo, time.Duration(config.Booking.SeatCacheSeconds)*time.Second)
+	settings := newAppSettings(repo, config, log)
+	flags := newFeatureFlags(repo, config.App, log)
+	jobs := newJobQueue(config.Jobs, log)
+	waitlistService := NewWaitlistService(repo, notificationService, seats, settings, flags, log)
+
+	movieFeed := newMovieFeed(repo, config.App)
+	movieService := NewMovieService(repo, watchlistService, movieFeed, config.Pricing, log)
+	cleanupService := NewCleanupService(repo, config.Cleanup, log)
+	bookingService := NewBookingService(repo, notificationService, waitlistService, seats, settings, jobs, config.Booking, config.Pricing, config.Payment, log)
+
 	return &Service{
-		Auth:    NewAuthService(repo, config, log),
-		User:    NewUserService(repo.User, log),
-		Movie:   NewMovieService(repo, log),
-		Cinema:  NewCinemaService(repo, log),
-		Booking: NewBookingService(repo, log),
-		Review:  NewReviewService(repo, log),
+		Auth:          NewAuthService(repo, settings, jobs, config, log),
+		User:          NewUserService(repo.User, repo.Activity, repo.UserStats, log),
+		Movie:         movieService,
+		Cinema:        NewCinemaService(repo, seats, notificationService, config.Pricing, log),
+		Schedule:      NewScheduleService(repo, seats, waitlistService, config.Pricing, log),
+		Booking:       bookingService,
+		Review:        NewReviewService(repo, config.Review, log),
+		Notification:  notificationService,
+		Report:        NewReportService(repo, config.Pricing, log),
+		Waitlist:      waitlistService,
+		Watchlist:     watchlistService,
+		Home:          NewHomeService(movieService, bookingService, log),
+		PaymentMethod: NewPaymentMethodService(repo, config.Pricing, log),
+		Health:        NewHealthService(repo, cleanupService, log),
+
+		PricePromotion: NewPricePromotionService(repo, log),
+		Organization:   NewOrganizationService(repo, log),
+		Webhook:        NewWebhookService(repo, config.Webhook, log),
+		Feed:           NewFeedService(movieFeed, log),
+		Banner:         NewBannerService(repo, log),
+		GiftCard:       NewGiftCardService(repo, config.Pricing, log),
+		DataExport:     NewDataExportService(repo, jobs, config.DataExport, log),
+		Audit:          NewAuditService(repo, log),
+		Ledger:         NewLedgerService(repo, log),
+		Settings:       NewSettingsService(repo, settings, log),
+		FeatureFlag:    NewFeatureFlagService(repo, flags, log),
+		Job:            NewJobService(repo, jobs, config.Jobs, log),
+		Cleanup:        cleanupService,
+		CustomerTier:   NewCustomerTierService(repo, config.Tier, config.Pricing, log),
+		Outbox: NewOutboxService(repo, newEventPublisher(config, log),
+			config.Events.TopicPrefix, config.Events.RelayBatchSize, config.Events.MaxAttempts, log),
+	}
+}
+
+// newNotificationSenders builds channel senders, fallback ke log kalau SMTP/FCM belum dikonfigurasi
+func newNotificationSenders(config *utils.Config, log *zap.Logger) []notification.Sender {
+	var emailSender notification.Sender = notification.NewLogSender(notification.ChannelEmail, log)
+	if config.Email.Host != "" {
+		emailSender = notification.NewResilientSender(notification.NewEmailSender(config.Email),
+			externalPolicy("smtp", config.Resilience, true, log))
+	}
+
+	var pushSender notification.Sender = notification.NewLogSender(notification.ChannelPush, log)
+	if config.Notification.FCMCredentialsFile != "" {
+		fcmSender, err := notification.NewFCMSender(config.Notification.FCMProjectID, config.Notification.FCMCredentialsFile)
+		if err != nil {
+			log.Warn("Failed to init FCM sender, push notifications will only be logged", zap.Error(err))
+		} else {
+			// Tanpa retry: satu Send bisa sudah sampai ke sebagian device, diulang berarti notifikasi dobel
+			pushSender = notification.NewResilientSender(fcmSender, externalPolicy("fcm", config.Resilience, false, log))
+		}
 	}
+
+	return []notification.Sender{
+		emailSender,
+		pushSender,
+	}
+}
+
+// externalPolicy builds resiliency policy satu dependency eksternal dari config bersama.
+// retry false untuk call yang tidak aman diulang; timeout dan breaker tetap berlaku.
+func externalPolicy(name string, config utils.ResilienceConfig, retry bool, log *zap.Logger) *resilience.Policy {
+	attempts := config.MaxAttempts
+	if !retry {
+		attempts = 1
+	}
+
+	return resilience.NewPolicy(name, resilience.Options{
+		Timeout:          time.Duration(config.TimeoutSeconds) * time.Second,
+		MaxAttempts:      attempts,
+		BaseDelay:        500 * time.Millisecond,
+		MaxDelay:         5 * time.Second,
+		BreakerThreshold: config.BreakerThreshold,
+		BreakerOpenFor:   time.Duration(config.BreakerOpenSeconds) * time.Second,
+	}, log)
+}
+
+// newEventPublisher picks the broker for outbox relay, fallback ke log kalau belum dikonfigurasi
+func newEventPublisher(config *utils.Config, log *zap.Logger) events.Publisher {
+	switch config.Events.Broker {
+	case "nats":
+		publisher, err := events.NewNATSPublisher(config.Events.NATSURL)
+		if err == nil {
+			return publisher
+		}
+		log.Warn("Failed to init NATS publisher, events will only be logged", zap.Error(err))
+
+	case "kafka":
+		publisher, err := events.NewKafkaRESTPublisher(config.Events.KafkaRESTURL)
+		if err == nil {
+			return publisher
+		}
+		log.Warn("Failed to init Kafka publisher, events will only be logged", zap.Error(err))
+	}
+
+	return events.NewLogPublisher(log)
+}
+
+// decodeCursor parses an opaque list cursor; empty string means first page
+func decodeCursor(cursor string) (*repository.Cursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	createdAt, id, err := utils.DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.Cursor{CreatedAt: createdAt, ID: id}, nil
 }
@@ -0,0 +1,299 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/cache"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// settingsCacheTTL batas umur override di memory; instance lain ikut berubah paling lambat setelah ini
+const settingsCacheTTL = 30 * time.Second
+
+// settingsCacheKey satu entry berisi semua override, tabelnya kecil
+const settingsCacheKey = "all"
+
+// Key setting yang bisa diubah admin tanpa redeploy
+const (
+	settingSessionTTLHours     = "session_ttl_hours"
+	settingWaitlistHoldMinutes = "waitlist_hold_minutes"
+	settingMaxSeatsPerBooking  = "max_seats_per_booking"
+	settingSalesCutoffMinutes  = "sales_cutoff_minutes"
+)
+
+// settingDefinition satu setting integer; fallback berasal dari env config
+type settingDefinition struct {
+	key         string
+	description string
+	min         int
+	max         int
+	fallback    int
+}
+
+func settingDefinitions(config *utils.Config) []settingDefinition {
+	return []settingDefinition{
+		{
+			key:         settingSessionTTLHours,
+			description: "Lifetime of a new login session in hours",
+			min:         1,
+			max:         720,
+			fallback:    config.JWT.ExpiryHours,
+		},
+		{
+			key:         settingWaitlistHoldMinutes,
+			description: "Minutes freed seats stay held for a waitlisted user",
+			min:         1,
+			max:         1440,
+			fallback:    config.Booking.WaitlistHoldMinutes,
+		},
+		{
+			key:         settingMaxSeatsPerBooking,
+			description: "Maximum seats in one booking or waitlist request, 0 for no limit",
+			min:         0,
+			max:         50,
+			fallback:    config.Booking.MaxSeatsPerBooking,
+		},
+		{
+			key:         settingSalesCutoffMinutes,
+			description: "Minutes after show start when ticket sales close, negative closes sales before the show",
+			min:         -1440,
+			max:         240,
+			fallback:    config.Booking.SalesCutoffMinutes,
+		},
+	}
+}
+
+// appSettings typed accessor untuk setting runtime, dibagi antar service seperti seatAvailability.
+// Override dari tabel app_settings di-cache sebentar; kalau database gagal dibaca dipakai default config.
+type appSettings struct {
+	repo        *repository.Repository
+	definitions map[string]settingDefinition
+	overrides   *cache.TTL[string, map[string]*entity.AppSetting]
+	log         *zap.Logger
+}
+
+func newAppSettings(repo *repository.Repository, config *utils.Config, log *zap.Logger) *appSettings {
+	definitions := make(map[string]settingDefinition)
+	for _, definition := range settingDefinitions(config) {
+		definitions[definition.key] = definition
+	}
+
+	return &appSettings{
+		repo:        repo,
+		definitions: definitions,
+		overrides:   cache.NewTTL[string, map[string]*entity.AppSetting](settingsCacheTTL),
+		log:         log.With(zap.String("component", "settings")),
+	}
+}
+
+func (a *appSettings) sessionTTL(ctx context.Context) time.Duration {
+	return time.Duration(a.intValue(ctx, settingSessionTTLHours)) * time.Hour
+}
+
+func (a *appSettings) waitlistHold(ctx context.Context) time.Duration {
+	return time.Duration(a.intValue(ctx, settingWaitlistHoldMinutes)) * time.Minute
+}
+
+// maxSeatsPerBooking <= 0 berarti tanpa batas
+func (a *appSettings) maxSeatsPerBooking(ctx context.Context) int {
+	return a.intValue(ctx, settingMaxSeatsPerBooking)
+}
+
+func (a *appSettings) salesCutoff(ctx context.Context) time.Duration {
+	return time.Duration(a.intValue(ctx, settingSalesCutoffMinutes)) * time.Minute
+}
+
+// intValue returns override yang valid, atau default config
+func (a *appSettings) intValue(ctx context.Context, key string) int {
+	definition := a.definitions[key]
+	override, ok := a.load(ctx)[key]
+	if !ok {
+		return definition.fallback
+	}
+
+	value, err := definition.parse(override.Value)
+	if err != nil {
+		utils.LoggerFromContext(ctx, a.log).Warn("Ignoring invalid stored setting",
+			zap.String("key", key),
+			zap.String("value", override.Value),
+			zap.Error(err),
+		)
+		return definition.fallback
+	}
+	return value
+}
+
+func (a *appSettings) load(ctx context.Context) map[string]*entity.AppSetting {
+	if overrides, ok := a.overrides.Get(settingsCacheKey); ok {
+		return overrides
+	}
+
+	settings, err := a.repo.Setting.FindAll(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, a.log).Warn("Failed to load settings, using config defaults", zap.Error(err))
+		return nil
+	}
+
+	overrides := make(map[string]*entity.AppSetting, len(settings))
+	for _, setting := range settings {
+		overrides[setting.Key] = setting
+	}
+	a.overrides.Set(settingsCacheKey, overrides)
+	return overrides
+}
+
+func (a *appSettings) invalidate() {
+	a.overrides.Delete(settingsCacheKey)
+}
+
+func (d settingDefinition) parse(raw string) (int, error) {
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid setting %s: not an integer", d.key)
+	}
+	if err := d.check(value); err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+func (d settingDefinition) check(value int) error {
+	if value < d.min || value > d.max {
+		return fmt.Errorf("invalid setting %s: must be between %d and %d", d.key, d.min, d.max)
+	}
+	return nil
+}
+
+type SettingsService interface {
+	GetSettings(ctx context.Context) ([]response.SettingResponse, error)
+	// UpdateSettings menyimpan semua perubahan dalam satu tx; key yang tidak dikenal menolak seluruh request
+	UpdateSettings(ctx context.Context, adminID string, req *request.UpdateSettingsRequest) ([]response.SettingResponse, error)
+}
+
+type settingsService struct {
+	repo     *repository.Repository
+	settings *appSettings
+	log      *zap.Logger
+}
+
+func NewSettingsService(repo *repository.Repository, settings *appSettings, log *zap.Logger) SettingsService {
+	return &settingsService{
+		repo:     repo,
+		settings: settings,
+		log:      log.With(zap.String("service", "settings")),
+	}
+}
+
+func (s *settingsService) GetSettings(ctx context.Context) ([]response.SettingResponse, error) {
+	// Admin selalu melihat isi tabel terbaru, bukan cache
+	stored, err := s.repo.Setting.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get settings: %w", err)
+	}
+
+	overrides := make(map[string]*entity.AppSetting, len(stored))
+	for _, setting := range stored {
+		overrides[setting.Key] = setting
+	}
+
+	keys := make([]string, 0, len(s.settings.definitions))
+	for key := range s.settings.definitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]response.SettingResponse, len(keys))
+	for i, key := range keys {
+		definition := s.settings.definitions[key]
+		item := response.SettingResponse{
+			Key:         key,
+			Value:       definition.fallback,
+			Default:     definition.fallback,
+			Min:         definition.min,
+			Max:         definition.max,
+			Description: definition.description,
+		}
+		if override, ok := overrides[key]; ok {
+			if value, err := definition.parse(override.Value); err == nil {
+				item.Value = value
+				item.Overridden = true
+			}
+			if override.UpdatedBy != nil {
+				updatedBy := override.UpdatedBy.String()
+				item.UpdatedBy = &updatedBy
+			}
+			item.UpdatedAt = &override.UpdatedAt
+		}
+		result[i] = item
+	}
+
+	return result, nil
+}
+
+func (s *settingsService) UpdateSettings(ctx context.Context, adminID string, req *request.UpdateSettingsRequest) ([]response.SettingResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	actorID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin ID format %s: %w", adminID, err)
+	}
+
+	for key, value := range req.Settings {
+		definition, ok := s.settings.definitions[key]
+		if !ok {
+			return nil, fmt.Errorf("invalid setting: unknown key %s", key)
+		}
+		if value != nil {
+			if err := definition.check(*value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	now := time.Now()
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		for key, value := range req.Settings {
+			if value == nil {
+				if err := tx.Setting.Delete(ctx, key); err != nil {
+					return err
+				}
+				continue
+			}
+			setting := &entity.AppSetting{
+				Key:       key,
+				Value:     strconv.Itoa(*value),
+				UpdatedBy: &actorID,
+				UpdatedAt: now,
+			}
+			if err := tx.Setting.Upsert(ctx, setting); err != nil {
+				return err
+			}
+		}
+		return recordAudit(ctx, tx, actorID, entity.AuditActionSettingsUpdate, entity.AuditTargetSettings, nil,
+			map[string]any{"settings": req.Settings}, "")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update settings: %w", err)
+	}
+	s.settings.invalidate()
+
+	utils.LoggerFromContext(ctx, s.log).Info("Settings updated",
+		zap.String("admin_id", adminID),
+		zap.Any("settings", req.Settings),
+	)
+
+	return s.GetSettings(ctx)
+}
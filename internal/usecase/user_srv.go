@@ -3,10 +3,14 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"cinema-booking/internal/data/entity"
 	"cinema-booking/internal/data/repository"
 	"cinema-booking/internal/dto/request"
 	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/utils"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -14,19 +18,31 @@ import (
 
 type UserService interface {
 	GetProfile(ctx context.Context, userID string) (*response.UserResponse, error)
-	GetAllUsers(ctx context.Context, req *request.PaginatedRequest) (*response.PaginatedResponse[response.UserResponse], error)
+	// GetAllUsers list user untuk admin; filter nil berarti tanpa search
+	GetAllUsers(ctx context.Context, req *request.PaginatedRequest, filter *request.UserSearchFilter) (*response.PaginatedResponse[response.UserResponse], error)
+	// GetUserDetail profil user plus agregat booking, belanja, login terakhir dan genre favorit untuk admin
+	GetUserDetail(ctx context.Context, userID string) (*response.UserDetailResponse, error)
 	DeleteUser(ctx context.Context, userID string) error
+	RestoreUser(ctx context.Context, userID string) error
+	UpdateLanguage(ctx context.Context, userID string, req *request.UpdateLanguageRequest) (*response.UserResponse, error)
+	UpdateDateOfBirth(ctx context.Context, userID string, req *request.UpdateDateOfBirthRequest) (*response.UserResponse, error)
+	// GetActivity timeline booking, payment, review dan login; dipakai halaman akun dan admin support
+	GetActivity(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.ActivityFilter) (*response.PaginatedResponse[response.ActivityResponse], error)
 }
 
 type userService struct {
-	userRepo repository.UserRepository
-	log      *zap.Logger
+	userRepo      repository.UserRepository
+	activityRepo  repository.ActivityRepository
+	userStatsRepo repository.UserStatsRepository
+	log           *zap.Logger
 }
 
-func NewUserService(userRepo repository.UserRepository, log *zap.Logger) UserService {
+func NewUserService(userRepo repository.UserRepository, activityRepo repository.ActivityRepository, userStatsRepo repository.UserStatsRepository, log *zap.Logger) UserService {
 	return &userService{
-		userRepo: userRepo,
-		log:      log,
+		userRepo:      userRepo,
+		activityRepo:  activityRepo,
+		userStatsRepo: userStatsRepo,
+		log:           log,
 	}
 }
 
@@ -35,14 +51,14 @@ func (us *userService) GetProfile(ctx context.Context, userID string) (*response
 	// Parse string userID to UUID format
 	id, err := uuid.Parse(userID)
 	if err != nil {
-		us.log.Warn("Invalid user ID format", zap.String("user_id", userID), zap.Error(err))
+		utils.LoggerFromContext(ctx, us.log).Warn("Invalid user ID format", zap.String("user_id", userID), zap.Error(err))
 		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
 	}
 
 	// Find user
 	user, err := us.userRepo.FindByID(ctx, id)
 	if err != nil {
-		us.log.Error("Failed to find user", zap.Error(err), zap.String("user_id", userID))
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to find user", zap.Error(err), zap.String("user_id", userID))
 		return nil, fmt.Errorf("find user profile %s: %w", userID, err)
 	}
 	if user == nil {
@@ -54,15 +70,138 @@ func (us *userService) GetProfile(ctx context.Context, userID string) (*response
 	return &userResp, nil
 }
 
-func (us *userService) GetAllUsers(ctx context.Context, req *request.PaginatedRequest) (*response.PaginatedResponse[response.UserResponse], error) {
+// UpdateLanguage sets bahasa yang dipakai untuk email dan push notification
+func (us *userService) UpdateLanguage(ctx context.Context, userID string, req *request.UpdateLanguageRequest) (*response.UserResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	user, err := us.userRepo.FindByID(ctx, id)
+	if err != nil {
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to find user", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("find user %s: %w", userID, err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user %s not found", userID)
+	}
+
+	user.Language = req.Language
+	user.UpdatedAt = time.Now()
+	if err := us.userRepo.Update(ctx, user); err != nil {
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to update user language", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("update language %s: %w", userID, err)
+	}
+
+	userResp := response.UserToResponse(user)
+	return &userResp, nil
+}
+
+// UpdateDateOfBirth sets tanggal lahir; tanggal di masa depan ditolak
+func (us *userService) UpdateDateOfBirth(ctx context.Context, userID string, req *request.UpdateDateOfBirthRequest) (*response.UserResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	dob, err := time.Parse("2006-01-02", req.DateOfBirth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date of birth: %w", err)
+	}
+	if dob.After(time.Now()) {
+		return nil, fmt.Errorf("invalid date of birth: must not be in the future")
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	user, err := us.userRepo.FindByID(ctx, id)
+	if err != nil {
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to find user", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("find user %s: %w", userID, err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user %s not found", userID)
+	}
+
+	user.DateOfBirth = &dob
+	user.UpdatedAt = time.Now()
+	if err := us.userRepo.Update(ctx, user); err != nil {
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to update user date of birth", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("update date of birth %s: %w", userID, err)
+	}
+
+	userResp := response.UserToResponse(user)
+	return &userResp, nil
+}
+
+func (us *userService) GetActivity(ctx context.Context, userID string, req *request.PaginatedRequest, filter *request.ActivityFilter) (*response.PaginatedResponse[response.ActivityResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	// User yang sudah dihapus dianggap tidak ditemukan, sama seperti GetProfile
+	user, err := us.userRepo.FindByID(ctx, id)
+	if err != nil {
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to find user", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("find user %s: %w", userID, err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user %s not found", userID)
+	}
+
+	var activityType *entity.ActivityType
+	if filter.Type != "" {
+		t := entity.ActivityType(filter.Type)
+		activityType = &t
+	}
+
+	activities, err := us.activityRepo.FindByUser(ctx, id, activityType, req.Limit(), req.Offset())
+	if err != nil {
+		return nil, fmt.Errorf("get activity for user %s: %w", userID, err)
+	}
+	total, err := us.activityRepo.CountByUser(ctx, id, activityType)
+	if err != nil {
+		return nil, fmt.Errorf("count activity for user %s: %w", userID, err)
+	}
+
+	items := make([]response.ActivityResponse, len(activities))
+	for i, activity := range activities {
+		items[i] = response.ActivityToResponse(activity)
+	}
+
+	return response.NewPaginatedResponse(items, req.Page, req.PerPage, total), nil
+}
+
+func (us *userService) GetAllUsers(ctx context.Context, req *request.PaginatedRequest, filter *request.UserSearchFilter) (*response.PaginatedResponse[response.UserResponse], error) {
+	userFilter, err := parseUserSearchFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	userFilter.IncludeDeleted = req.IncludeDeleted
+
+	if req.UseCursor() {
+		return us.getAllUsersByCursor(ctx, req, userFilter)
+	}
+
 	// Calculate pagination parameters using helper methods
 	limit := req.Limit()   // Default: 10, Max: 100
 	offset := req.Offset() // (page-1) * per_page
 
 	// Get users with pagination
-	users, err := us.userRepo.FindAll(ctx, limit, offset)
+	users, err := us.userRepo.FindAll(ctx, userFilter, limit, offset)
 	if err != nil {
-		us.log.Error("Failed to get all users",
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to get all users",
 			zap.Error(err),
 			zap.Int("limit", limit),
 			zap.Int("offset", offset),
@@ -73,9 +212,9 @@ func (us *userService) GetAllUsers(ctx context.Context, req *request.PaginatedRe
 	}
 
 	// Get total count of users for pagination metadata
-	total, err := us.userRepo.CountAll(ctx)
+	total, err := us.userRepo.CountAll(ctx, userFilter)
 	if err != nil {
-		us.log.Error("Failed to count users", zap.Error(err))
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to count users", zap.Error(err))
 		return nil, fmt.Errorf("count all users: %w", err)
 	}
 
@@ -88,7 +227,7 @@ func (us *userService) GetAllUsers(ctx context.Context, req *request.PaginatedRe
 	// Create paginated response seperti movie_service
 	paginatedResp := response.NewPaginatedResponse(userResponses, req.Page, req.PerPage, total)
 
-	us.log.Info("Users retrieved",
+	utils.LoggerFromContext(ctx, us.log).Info("Users retrieved",
 		zap.Int("count", len(users)),
 		zap.Int64("total", total),
 		zap.Int("page", req.Page),
@@ -101,6 +240,121 @@ func (us *userService) GetAllUsers(ctx context.Context, req *request.PaginatedRe
 	return paginatedResp, nil
 }
 
+// getAllUsersByCursor is the keyset-pagination path of GetAllUsers
+func (us *userService) getAllUsersByCursor(ctx context.Context, req *request.PaginatedRequest, filter repository.UserFilter) (*response.PaginatedResponse[response.UserResponse], error) {
+	cursor, err := decodeCursor(*req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit()
+	users, err := us.userRepo.FindAllAfter(ctx, filter, cursor, limit+1)
+	if err != nil {
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to get users by cursor", zap.Error(err))
+		return nil, fmt.Errorf("get all users by cursor: %w", err)
+	}
+
+	nextCursor := ""
+	if len(users) > limit {
+		users = users[:limit]
+		last := users[len(users)-1]
+		nextCursor = utils.EncodeCursor(last.CreatedAt, last.ID)
+	}
+
+	userResponses := make([]response.UserResponse, len(users))
+	for i, user := range users {
+		userResponses[i] = response.UserToResponse(user)
+	}
+
+	return response.NewCursorPaginatedResponse(userResponses, limit, nextCursor), nil
+}
+
+func (us *userService) GetUserDetail(ctx context.Context, userID string) (*response.UserDetailResponse, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	user, err := us.userRepo.FindByID(ctx, id)
+	if err != nil {
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to find user", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("find user %s: %w", userID, err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user %s not found", userID)
+	}
+
+	bookings, err := us.userStatsRepo.GetBookingStats(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get user detail %s: %w", userID, err)
+	}
+	spend, err := us.userStatsRepo.GetSpend(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get user detail %s: %w", userID, err)
+	}
+	lastLogin, err := us.userStatsRepo.GetLastLogin(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get user detail %s: %w", userID, err)
+	}
+	genre, err := us.userStatsRepo.GetFavoriteGenre(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get user detail %s: %w", userID, err)
+	}
+
+	resp := response.UserDetailToResponse(user, bookings, spend, lastLogin, genre)
+	return &resp, nil
+}
+
+// parseUserSearchFilter converts query admin ke filter repository; created_to inklusif sampai akhir hari
+func parseUserSearchFilter(filter *request.UserSearchFilter) (repository.UserFilter, error) {
+	var result repository.UserFilter
+	if filter == nil {
+		return result, nil
+	}
+
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return result, errs
+	}
+
+	if email := strings.TrimSpace(filter.Email); email != "" {
+		result.Email = &email
+	}
+	if username := strings.TrimSpace(filter.Username); username != "" {
+		result.Username = &username
+	}
+	if phone := strings.TrimSpace(filter.Phone); phone != "" {
+		result.Phone = &phone
+	}
+	if filter.Role != "" {
+		role := entity.UserRole(filter.Role)
+		result.Role = &role
+	}
+	if filter.Verified != "" {
+		verified := filter.Verified == "true"
+		result.EmailVerified = &verified
+	}
+	if filter.Active != "" {
+		active := filter.Active == "true"
+		result.IsActive = &active
+	}
+
+	// Format tanggal sudah divalidasi tag datetime
+	if filter.CreatedFrom != "" {
+		from, _ := time.ParseInLocation("2006-01-02", filter.CreatedFrom, time.Local)
+		result.CreatedFrom = &from
+	}
+	if filter.CreatedTo != "" {
+		to, _ := time.ParseInLocation("2006-01-02", filter.CreatedTo, time.Local)
+		to = to.AddDate(0, 0, 1)
+		result.CreatedTo = &to
+	}
+	if result.CreatedFrom != nil && result.CreatedTo != nil && !result.CreatedFrom.Before(*result.CreatedTo) {
+		return result, fmt.Errorf("invalid created range: created_from must not be after created_to")
+	}
+
+	return result, nil
+}
+
 func (us *userService) DeleteUser(ctx context.Context, userID string) error {
 	id, err := uuid.Parse(userID)
 	if err != nil {
@@ -109,7 +363,7 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) error {
 
 	user, err := us.userRepo.FindByID(ctx, id)
 	if err != nil {
-		us.log.Error("Failed to get user for delete", zap.Error(err), zap.String("id", userID))
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to get user for delete", zap.Error(err), zap.String("id", userID))
 		return fmt.Errorf("find user for delete %s: %w", userID, err)
 	}
 
@@ -118,14 +372,29 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) error {
 	}
 
 	if err := us.userRepo.Delete(ctx, id); err != nil {
-		us.log.Error("Failed to delete user", zap.Error(err), zap.String("id", userID))
+		utils.LoggerFromContext(ctx, us.log).Error("Failed to delete user", zap.Error(err), zap.String("id", userID))
 		return fmt.Errorf("delete user %s: %w", userID, err)
 	}
 
-	us.log.Info("User deleted",
+	utils.LoggerFromContext(ctx, us.log).Info("User deleted",
 		zap.String("user_id", id.String()),
-		zap.String("email", user.Email),
+		utils.EmailField(user.Email),
 		zap.String("username", user.Username),
 	)
 	return nil
 }
+
+func (us *userService) RestoreUser(ctx context.Context, userID string) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	if err := us.userRepo.Restore(ctx, id); err != nil {
+		utils.LoggerFromContext(ctx, us.log).Warn("Failed to restore user", zap.Error(err), zap.String("id", userID))
+		return fmt.Errorf("restore user: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, us.log).Info("User restored", zap.String("user_id", id.String()))
+	return nil
+}
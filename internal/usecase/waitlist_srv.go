@@ -0,0 +1,451 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/notification"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// waitlistOfferBatch batas entry yang diproses per schedule dalam satu kali offer
+const waitlistOfferBatch = 50
+
+type WaitlistService interface {
+	JoinWaitlist(ctx context.Context, userID, scheduleID string, req *request.JoinWaitlistRequest) (*response.WaitlistEntryResponse, error)
+	LeaveWaitlist(ctx context.Context, userID, scheduleID string) error
+	GetUserWaitlist(ctx context.Context, userID string) ([]response.WaitlistEntryResponse, error)
+
+	// OfferFreedSeats holds free seats for the next waitlisted users, dipanggil setelah booking dibatalkan/expired
+	OfferFreedSeats(ctx context.Context, scheduleID uuid.UUID) error
+	// ExpireOffers releases holds yang tidak dipakai sampai batas waktu
+	ExpireOffers(ctx context.Context) (int, error)
+}
+
+type waitlistService struct {
+	repo     *repository.Repository
+	notifier NotificationService
+	seats    *seatAvailability
+	settings *appSettings // hold duration, batas kursi dan sales cutoff
+	flags    *featureFlags
+	log      *zap.Logger
+}
+
+func NewWaitlistService(repo *repository.Repository, notifier NotificationService, seats *seatAvailability, settings *appSettings, flags *featureFlags, log *zap.Logger) WaitlistService {
+	return &waitlistService{
+		repo:     repo,
+		notifier: notifier,
+		seats:    seats,
+		settings: settings,
+		flags:    flags,
+		log:      log.With(zap.String("service", "waitlist")),
+	}
+}
+
+// waitlistOffer is collected inside the transaction and notified after commit
+type waitlistOffer struct {
+	entry *entity.WaitlistEntry
+	seats []*entity.Seat
+}
+
+func (s *waitlistService) JoinWaitlist(ctx context.Context, userID, scheduleID string, req *request.JoinWaitlistRequest) (*response.WaitlistEntryResponse, error) {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	seatsRequested := req.SeatsRequested
+	if seatsRequested == 0 {
+		seatsRequested = 1
+	}
+	if maxSeats := s.settings.maxSeatsPerBooking(ctx); maxSeats > 0 && seatsRequested > maxSeats {
+		return nil, fmt.Errorf("invalid seats_requested: maximum %d seats per booking", maxSeats)
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+	if !s.flags.EnabledFor(ctx, flagWaitlist, userUUID) {
+		return nil, fmt.Errorf("cannot join waitlist: waitlist is not available")
+	}
+
+	scheduleUUID, err := uuid.Parse(scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule ID format %s: %w", scheduleID, err)
+	}
+
+	schedule, err := s.repo.Schedule.FindByID(ctx, scheduleUUID)
+	if err != nil {
+		return nil, fmt.Errorf("find schedule: %w", err)
+	}
+	if schedule == nil || !schedule.IsPublished() {
+		return nil, fmt.Errorf("schedule %s not found", scheduleID)
+	}
+	if salesClosed(schedule, s.settings.salesCutoff(ctx), time.Now()) {
+		return nil, ErrSalesClosed
+	}
+
+	existing, err := s.repo.Waitlist.FindActiveByScheduleAndUser(ctx, scheduleUUID, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("check waitlist entry: %w", err)
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("waitlist entry already exists for this schedule")
+	}
+
+	free, err := s.freeSeats(ctx, s.repo, schedule)
+	if err != nil {
+		return nil, err
+	}
+	if len(free) >= seatsRequested {
+		return nil, fmt.Errorf("cannot join waitlist: schedule still has %d available seats", len(free))
+	}
+
+	now := time.Now()
+	entry := &entity.WaitlistEntry{
+		BaseNoDelete: entity.BaseNoDelete{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ScheduleID:     scheduleUUID,
+		UserID:         userUUID,
+		SeatsRequested: seatsRequested,
+		Status:         entity.WaitlistStatusWaiting,
+	}
+
+	if err := s.repo.Waitlist.Create(ctx, entry); err != nil {
+		return nil, fmt.Errorf("join waitlist: %w", err)
+	}
+
+	ahead, err := s.repo.Waitlist.CountAhead(ctx, entry)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to compute waitlist position", zap.Error(err))
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("User joined waitlist",
+		zap.String("schedule_id", scheduleID),
+		zap.String("user_id", userID),
+		zap.Int("seats_requested", seatsRequested),
+	)
+
+	resp := response.WaitlistEntryToResponse(entry, ahead+1, nil)
+	return &resp, nil
+}
+
+func (s *waitlistService) LeaveWaitlist(ctx context.Context, userID, scheduleID string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	scheduleUUID, err := uuid.Parse(scheduleID)
+	if err != nil {
+		return fmt.Errorf("invalid schedule ID format %s: %w", scheduleID, err)
+	}
+
+	entry, err := s.repo.Waitlist.FindActiveByScheduleAndUser(ctx, scheduleUUID, userUUID)
+	if err != nil {
+		return fmt.Errorf("find waitlist entry: %w", err)
+	}
+	if entry == nil {
+		return fmt.Errorf("waitlist entry not found")
+	}
+
+	wasOffered := entry.Status == entity.WaitlistStatusOffered
+
+	err = s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		entry.Status = entity.WaitlistStatusCancelled
+		entry.UpdatedAt = time.Now()
+		if err := tx.Waitlist.Update(ctx, entry); err != nil {
+			return err
+		}
+		return tx.SeatHold.DeleteByWaitlistEntry(ctx, entry.ID)
+	})
+	if err != nil {
+		return fmt.Errorf("leave waitlist: %w", err)
+	}
+
+	// Seat yang tadinya di-hold langsung ditawarkan ke antrian berikutnya
+	if wasOffered {
+		if err := s.OfferFreedSeats(ctx, scheduleUUID); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to re-offer released seats", zap.Error(err), zap.String("schedule_id", scheduleID))
+		}
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("User left waitlist", zap.String("schedule_id", scheduleID), zap.String("user_id", userID))
+	return nil
+}
+
+func (s *waitlistService) GetUserWaitlist(ctx context.Context, userID string) ([]response.WaitlistEntryResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	entries, err := s.repo.Waitlist.FindActiveByUserID(ctx, userUUID)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get user waitlist", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("get user waitlist: %w", err)
+	}
+
+	result := make([]response.WaitlistEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		var position int64
+		var holds []*entity.SeatHold
+
+		switch entry.Status {
+		case entity.WaitlistStatusWaiting:
+			ahead, err := s.repo.Waitlist.CountAhead(ctx, entry)
+			if err != nil {
+				return nil, err
+			}
+			position = ahead + 1
+		case entity.WaitlistStatusOffered:
+			holds, err = s.repo.SeatHold.FindByWaitlistEntry(ctx, entry.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, response.WaitlistEntryToResponse(entry, position, holds))
+	}
+
+	return result, nil
+}
+
+func (s *waitlistService) OfferFreedSeats(ctx context.Context, scheduleID uuid.UUID) error {
+	// Semua jalur yang melepas kursi (cancel, expired, hold dilepas) lewat sini, jadi cache
+	// seat map di-invalidate juga kalau offer gagal
+	defer s.seats.invalidate(scheduleID)
+
+	var offers []waitlistOffer
+
+	err := s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		// Lock yang sama dengan CreateBooking, jadi seat tidak bisa dibooking sambil di-offer
+		if err := tx.Schedule.LockByID(ctx, scheduleID); err != nil {
+			return err
+		}
+
+		schedule, err := tx.Schedule.FindByID(ctx, scheduleID)
+		if err != nil {
+			return err
+		}
+		// Offer setelah penjualan ditutup tidak bisa dipakai untuk booking
+		if schedule == nil || salesClosed(schedule, s.settings.salesCutoff(ctx), time.Now()) {
+			return nil
+		}
+
+		now := time.Now()
+		if err := tx.SeatHold.DeleteExpiredBySchedule(ctx, scheduleID, now); err != nil {
+			return err
+		}
+
+		free, err := s.freeSeats(ctx, tx, schedule)
+		if err != nil || len(free) == 0 {
+			return err
+		}
+
+		waiting, err := tx.Waitlist.FindWaitingForUpdate(ctx, scheduleID, waitlistOfferBatch)
+		if err != nil {
+			return err
+		}
+
+		expiresAt := now.Add(s.settings.waitlistHold(ctx))
+		for _, entry := range waiting {
+			// User di luar rollout seat_holds tetap menunggu tanpa memakan kursi yang bisa di-offer
+			if !s.flags.EnabledFor(ctx, flagSeatHolds, entry.UserID) {
+				continue
+			}
+			// Strict FIFO: kalau entry terdepan belum cukup kursi, yang di belakang tetap menunggu
+			if entry.SeatsRequested > len(free) {
+				break
+			}
+
+			seats := free[:entry.SeatsRequested]
+			free = free[entry.SeatsRequested:]
+
+			holds := make([]*entity.SeatHold, len(seats))
+			for i, seat := range seats {
+				holds[i] = &entity.SeatHold{
+					BaseSimple: entity.BaseSimple{
+						ID:        uuid.New(),
+						CreatedAt: now,
+					},
+					ScheduleID:      scheduleID,
+					SeatID:          seat.ID,
+					UserID:          entry.UserID,
+					WaitlistEntryID: entry.ID,
+					ExpiresAt:       expiresAt,
+				}
+			}
+			if err := tx.SeatHold.CreateBatch(ctx, holds); err != nil {
+				return err
+			}
+
+			entry.Status = entity.WaitlistStatusOffered
+			entry.OfferedAt = &now
+			entry.HoldExpiresAt = &expiresAt
+			entry.UpdatedAt = now
+			if err := tx.Waitlist.Update(ctx, entry); err != nil {
+				return err
+			}
+
+			offers = append(offers, waitlistOffer{entry: entry, seats: seats})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("offer freed seats for schedule %s: %w", scheduleID.String(), err)
+	}
+
+	for _, offer := range offers {
+		s.notifyOffer(ctx, offer)
+	}
+
+	if len(offers) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Waitlist offers created",
+			zap.String("schedule_id", scheduleID.String()),
+			zap.Int("count", len(offers)),
+		)
+	}
+
+	return nil
+}
+
+func (s *waitlistService) ExpireOffers(ctx context.Context) (int, error) {
+	schedules := make(map[uuid.UUID]bool)
+	expired := 0
+
+	err := s.repo.WithTx(ctx, func(tx *repository.Repository) error {
+		entries, err := tx.Waitlist.FindExpiredOffersForUpdate(ctx, time.Now(), waitlistOfferBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			entry.Status = entity.WaitlistStatusExpired
+			entry.UpdatedAt = time.Now()
+			if err := tx.Waitlist.Update(ctx, entry); err != nil {
+				return err
+			}
+			if err := tx.SeatHold.DeleteByWaitlistEntry(ctx, entry.ID); err != nil {
+				return err
+			}
+			schedules[entry.ScheduleID] = true
+			expired++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("expire waitlist offers: %w", err)
+	}
+
+	// Seat yang dilepas langsung ditawarkan ke antrian berikutnya
+	for scheduleID := range schedules {
+		if err := s.OfferFreedSeats(ctx, scheduleID); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to re-offer expired holds", zap.Error(err), zap.String("schedule_id", scheduleID.String()))
+		}
+	}
+
+	if expired > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Waitlist offers expired", zap.Int("count", expired))
+	}
+
+	return expired, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+// freeSeats returns seats yang tidak dibooking, tidak di-hold dan tidak diblokir, urut per row lalu kolom
+func (s *waitlistService) freeSeats(ctx context.Context, repo *repository.Repository, schedule *entity.Schedule) ([]*entity.Seat, error) {
+	seats, err := repo.Seat.FindByHallID(ctx, schedule.HallID)
+	if err != nil {
+		return nil, fmt.Errorf("load hall seats: %w", err)
+	}
+
+	bookedSeats, err := repo.BookingSeat.FindBookedSeatsBySchedule(ctx, schedule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load booked seats: %w", err)
+	}
+
+	holds, err := repo.SeatHold.FindActiveBySchedule(ctx, schedule.ID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("load seat holds: %w", err)
+	}
+
+	blocks, err := repo.SeatBlock.FindBySchedule(ctx, schedule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load seat blocks: %w", err)
+	}
+
+	taken := make(map[uuid.UUID]bool, len(bookedSeats)+len(holds)+len(blocks))
+	for _, id := range bookedSeats {
+		taken[id] = true
+	}
+	for _, hold := range holds {
+		taken[hold.SeatID] = true
+	}
+	for _, block := range blocks {
+		taken[block.SeatID] = true
+	}
+
+	free := make([]*entity.Seat, 0, len(seats))
+	for _, seat := range seats {
+		if seat.IsAvailable && !taken[seat.ID] {
+			free = append(free, seat)
+		}
+	}
+
+	// Urutan row/kolom supaya seat yang di-hold cenderung bersebelahan
+	sort.Slice(free, func(i, j int) bool {
+		if free[i].SeatRow != free[j].SeatRow {
+			return free[i].SeatRow < free[j].SeatRow
+		}
+		return free[i].SeatColumn < free[j].SeatColumn
+	})
+
+	return free, nil
+}
+
+func (s *waitlistService) notifyOffer(ctx context.Context, offer waitlistOffer) {
+	seatNumbers := ""
+	for i, seat := range offer.seats {
+		if i > 0 {
+			seatNumbers += ", "
+		}
+		seatNumbers += seat.SeatNumber
+	}
+
+	compose := func(lang i18n.Lang) notification.Message {
+		return notification.Message{
+			Subject: i18n.T(lang, "email.waitlist_offer.subject"),
+			Body: i18n.T(lang, "email.waitlist_offer.body",
+				seatNumbers, offer.entry.HoldExpiresAt.Format("2006-01-02 15:04")),
+			Data: map[string]string{
+				"waitlist_id": offer.entry.ID.String(),
+				"schedule_id": offer.entry.ScheduleID.String(),
+			},
+		}
+	}
+
+	// Offer bersifat transactional, pakai preferensi booking confirmation
+	if err := s.notifier.Notify(ctx, offer.entry.UserID, entity.NotificationCategoryBookingConfirmation, compose); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to notify waitlist offer",
+			zap.Error(err),
+			zap.String("waitlist_id", offer.entry.ID.String()),
+		)
+	}
+}
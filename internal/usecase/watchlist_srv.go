@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/i18n"
+	"cinema-booking/pkg/notification"
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type WatchlistService interface {
+	AddToWatchlist(ctx context.Context, userID, movieID string) error
+	RemoveFromWatchlist(ctx context.Context, userID, movieID string) error
+	GetUserWatchlist(ctx context.Context, userID string) ([]response.MovieResponse, error)
+
+	// NotifyNowPlaying tells watchers that a coming_soon movie sudah mulai tayang
+	NotifyNowPlaying(ctx context.Context, movie *entity.Movie) (int, error)
+}
+
+type watchlistService struct {
+	repo     *repository.Repository
+	notifier NotificationService
+	log      *zap.Logger
+}
+
+func NewWatchlistService(repo *repository.Repository, notifier NotificationService, log *zap.Logger) WatchlistService {
+	return &watchlistService{
+		repo:     repo,
+		notifier: notifier,
+		log:      log.With(zap.String("service", "watchlist")),
+	}
+}
+
+func (s *watchlistService) AddToWatchlist(ctx context.Context, userID, movieID string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	movieUUID, err := uuid.Parse(movieID)
+	if err != nil {
+		return fmt.Errorf("invalid movie id: %w", err)
+	}
+
+	movie, err := s.repo.Movie.FindByID(ctx, movieUUID)
+	if err != nil {
+		return fmt.Errorf("find movie: %w", err)
+	}
+	if movie == nil {
+		return fmt.Errorf("movie not found")
+	}
+
+	item := &entity.WatchlistItem{
+		BaseSimple: entity.BaseSimple{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+		},
+		UserID:  userUUID,
+		MovieID: movieUUID,
+	}
+
+	if err := s.repo.Watchlist.Add(ctx, item); err != nil {
+		return fmt.Errorf("add to watchlist: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Movie added to watchlist",
+		zap.String("user_id", userID),
+		zap.String("movie_id", movieID),
+	)
+
+	return nil
+}
+
+func (s *watchlistService) RemoveFromWatchlist(ctx context.Context, userID, movieID string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	movieUUID, err := uuid.Parse(movieID)
+	if err != nil {
+		return fmt.Errorf("invalid movie id: %w", err)
+	}
+
+	if err := s.repo.Watchlist.Remove(ctx, userUUID, movieUUID); err != nil {
+		return err
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Movie removed from watchlist",
+		zap.String("user_id", userID),
+		zap.String("movie_id", movieID),
+	)
+
+	return nil
+}
+
+func (s *watchlistService) GetUserWatchlist(ctx context.Context, userID string) ([]response.MovieResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format %s: %w", userID, err)
+	}
+
+	movies, err := s.repo.Watchlist.FindMoviesByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("get user watchlist: %w", err)
+	}
+
+	inWatchlist := true
+	movieResponses := make([]response.MovieResponse, len(movies))
+	for i, movie := range movies {
+		genres, err := s.repo.Genre.FindByMovieID(ctx, movie.ID)
+		if err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to get genres for movie",
+				zap.Error(err),
+				zap.String("movie_id", movie.ID.String()),
+			)
+		}
+
+		genreNames := make([]string, len(genres))
+		for j, genre := range genres {
+			genreNames[j] = genre.Name
+		}
+
+		movieResponses[i] = response.MovieToResponse(movie, genreNames, 0)
+		movieResponses[i].InWatchlist = &inWatchlist
+	}
+
+	return movieResponses, nil
+}
+
+func (s *watchlistService) NotifyNowPlaying(ctx context.Context, movie *entity.Movie) (int, error) {
+	userIDs, err := s.repo.Watchlist.FindUserIDsByMovieID(ctx, movie.ID)
+	if err != nil {
+		return 0, fmt.Errorf("find watchers: %w", err)
+	}
+
+	compose := func(lang i18n.Lang) notification.Message {
+		return notification.Message{
+			Subject: i18n.T(lang, "email.now_playing.subject", movie.Title),
+			Body:    i18n.T(lang, "email.now_playing.body", movie.Title),
+			Data: map[string]string{
+				"movie_id": movie.ID.String(),
+			},
+		}
+	}
+
+	sent := 0
+	for _, userID := range userIDs {
+		// Satu user gagal tidak menghentikan yang lain
+		if err := s.notifier.Notify(ctx, userID, entity.NotificationCategoryReminder, compose); err != nil {
+			utils.LoggerFromContext(ctx, s.log).Warn("Failed to notify watcher",
+				zap.Error(err),
+				zap.String("user_id", userID.String()),
+				zap.String("movie_id", movie.ID.String()),
+			)
+			continue
+		}
+		sent++
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Watchlist now playing notifications sent",
+		zap.String("movie_id", movie.ID.String()),
+		zap.Int("watchers", len(userIDs)),
+		zap.Int("sent", sent),
+	)
+
+	return sent, nil
+}
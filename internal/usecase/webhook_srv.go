@@ -0,0 +1,412 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"cinema-booking/internal/data/entity"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/dto/request"
+	"cinema-booking/internal/dto/response"
+	"cinema-booking/pkg/events"
+	"cinema-booking/pkg/utils"
+	"cinema-booking/pkg/webhook"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Backoff retry delivery: 30 detik, 1 menit, 2 menit, ... maksimal 6 jam
+const (
+	webhookBackoffBase = 30 * time.Second
+	webhookBackoffMax  = 6 * time.Hour
+)
+
+// WebhookService mengelola subscription partner dan mengirim delivery yang dibuat enqueueEvent
+type WebhookService interface {
+	GetSubscriptions(ctx context.Context) ([]response.WebhookSubscriptionResponse, error)
+	GetSubscriptionByID(ctx context.Context, subscriptionID string) (*response.WebhookSubscriptionResponse, error)
+	CreateSubscription(ctx context.Context, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error)
+	UpdateSubscription(ctx context.Context, subscriptionID string, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error)
+	DeleteSubscription(ctx context.Context, subscriptionID string) error
+	RotateSecret(ctx context.Context, subscriptionID string) (*response.WebhookSubscriptionResponse, error)
+	GetDeliveries(ctx context.Context, subscriptionID string, req *request.PaginatedRequest, filter *request.WebhookDeliveryFilter) (*response.PaginatedResponse[response.WebhookDeliveryResponse], error)
+
+	// DeliverPending sends one batch delivery yang sudah jatuh tempo, return jumlah yang berhasil
+	DeliverPending(ctx context.Context) (int, error)
+}
+
+type webhookService struct {
+	repo        *repository.Repository
+	sender      *webhook.Sender
+	batchSize   int
+	maxAttempts int
+	lease       time.Duration
+	log         *zap.Logger
+}
+
+func NewWebhookService(repo *repository.Repository, config utils.WebhookConfig, log *zap.Logger) WebhookService {
+	timeout := time.Duration(config.TimeoutSeconds) * time.Second
+
+	return &webhookService{
+		repo:        repo,
+		sender:      webhook.NewSender(timeout),
+		batchSize:   config.BatchSize,
+		maxAttempts: config.MaxAttempts,
+		// Delivery dikirim berurutan, jadi satu batch paling lama batchSize kali timeout
+		lease: timeout*time.Duration(config.BatchSize) + time.Minute,
+		log:   log.With(zap.String("service", "webhook")),
+	}
+}
+
+func (s *webhookService) GetSubscriptions(ctx context.Context) ([]response.WebhookSubscriptionResponse, error) {
+	subscriptions, err := s.repo.Webhook.FindSubscriptions(ctx)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get webhook subscriptions", zap.Error(err))
+		return nil, fmt.Errorf("get webhook subscriptions: %w", err)
+	}
+
+	result := make([]response.WebhookSubscriptionResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		result[i] = response.WebhookSubscriptionToResponse(subscription)
+	}
+
+	return result, nil
+}
+
+func (s *webhookService) GetSubscriptionByID(ctx context.Context, subscriptionID string) (*response.WebhookSubscriptionResponse, error) {
+	subscription, err := s.findSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response.WebhookSubscriptionToResponse(subscription)
+	return &resp, nil
+}
+
+func (s *webhookService) CreateSubscription(ctx context.Context, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error) {
+	if err := s.validateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	subscription := &entity.WebhookSubscription{
+		Base: entity.Base{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Name:       strings.TrimSpace(req.Name),
+		URL:        req.URL,
+		Secret:     secret,
+		EventTypes: req.EventTypes,
+		IsActive:   req.IsActive == nil || *req.IsActive,
+	}
+
+	if err := s.repo.Webhook.CreateSubscription(ctx, subscription); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to create webhook subscription",
+			zap.Error(err),
+			zap.String("name", req.Name),
+		)
+		return nil, fmt.Errorf("create webhook subscription: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Webhook subscription created",
+		zap.String("subscription_id", subscription.ID.String()),
+		zap.String("name", subscription.Name),
+		zap.Strings("event_types", subscription.EventTypes),
+	)
+
+	resp := response.WebhookSubscriptionToResponse(subscription)
+	resp.Secret = &subscription.Secret
+	return &resp, nil
+}
+
+func (s *webhookService) UpdateSubscription(ctx context.Context, subscriptionID string, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error) {
+	if err := s.validateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.findSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Name = strings.TrimSpace(req.Name)
+	subscription.URL = req.URL
+	subscription.EventTypes = req.EventTypes
+	subscription.IsActive = req.IsActive == nil || *req.IsActive
+	subscription.UpdatedAt = time.Now()
+
+	if err := s.repo.Webhook.UpdateSubscription(ctx, subscription); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to update webhook subscription",
+			zap.Error(err),
+			zap.String("subscription_id", subscriptionID),
+		)
+		return nil, fmt.Errorf("update webhook subscription %s: %w", subscriptionID, err)
+	}
+
+	resp := response.WebhookSubscriptionToResponse(subscription)
+	return &resp, nil
+}
+
+// DeleteSubscription soft delete; delivery yang masih pending ditandai failed oleh worker
+func (s *webhookService) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	id, err := uuid.Parse(subscriptionID)
+	if err != nil {
+		return fmt.Errorf("invalid webhook subscription ID format %s: %w", subscriptionID, err)
+	}
+
+	if err := s.repo.Webhook.DeleteSubscription(ctx, id); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Warn("Failed to delete webhook subscription",
+			zap.Error(err),
+			zap.String("subscription_id", subscriptionID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// RotateSecret berlaku untuk delivery berikutnya, termasuk retry delivery lama
+func (s *webhookService) RotateSecret(ctx context.Context, subscriptionID string) (*response.WebhookSubscriptionResponse, error) {
+	subscription, err := s.findSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	subscription.Secret = secret
+	subscription.UpdatedAt = time.Now()
+
+	if err := s.repo.Webhook.UpdateSubscription(ctx, subscription); err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to rotate webhook secret",
+			zap.Error(err),
+			zap.String("subscription_id", subscriptionID),
+		)
+		return nil, fmt.Errorf("rotate webhook secret %s: %w", subscriptionID, err)
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Info("Webhook secret rotated", zap.String("subscription_id", subscriptionID))
+
+	resp := response.WebhookSubscriptionToResponse(subscription)
+	resp.Secret = &subscription.Secret
+	return &resp, nil
+}
+
+func (s *webhookService) GetDeliveries(ctx context.Context, subscriptionID string, req *request.PaginatedRequest, filter *request.WebhookDeliveryFilter) (*response.PaginatedResponse[response.WebhookDeliveryResponse], error) {
+	if errs := utils.ValidateStruct(filter); len(errs) > 0 {
+		return nil, errs
+	}
+
+	subscription, err := s.findSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var status *entity.WebhookDeliveryStatus
+	if filter.Status != "" {
+		st := entity.WebhookDeliveryStatus(filter.Status)
+		status = &st
+	}
+
+	deliveries, err := s.repo.Webhook.FindDeliveries(ctx, subscription.ID, status, req.Limit(), req.Offset())
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to get webhook deliveries",
+			zap.Error(err),
+			zap.String("subscription_id", subscriptionID),
+		)
+		return nil, fmt.Errorf("get webhook deliveries: %w", err)
+	}
+
+	total, err := s.repo.Webhook.CountDeliveries(ctx, subscription.ID, status)
+	if err != nil {
+		utils.LoggerFromContext(ctx, s.log).Error("Failed to count webhook deliveries",
+			zap.Error(err),
+			zap.String("subscription_id", subscriptionID),
+		)
+		return nil, fmt.Errorf("count webhook deliveries: %w", err)
+	}
+
+	result := make([]response.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		result[i] = response.WebhookDeliveryToResponse(delivery)
+	}
+
+	return response.NewPaginatedResponse(result, req.Page, req.PerPage, total), nil
+}
+
+func (s *webhookService) DeliverPending(ctx context.Context) (int, error) {
+	now := time.Now()
+	deliveries, err := s.repo.Webhook.ClaimDue(ctx, now, now.Add(s.lease), s.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+
+	subscriptions := make(map[uuid.UUID]*entity.WebhookSubscription)
+	delivered := 0
+	for _, delivery := range deliveries {
+		// Sisa batch tetap ter-lease dan dicoba lagi setelah lease habis
+		if ctx.Err() != nil {
+			break
+		}
+
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = s.repo.Webhook.FindSubscriptionByID(ctx, delivery.SubscriptionID)
+			if err != nil {
+				return delivered, err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		if subscription == nil || !subscription.IsActive {
+			if err := s.repo.Webhook.MarkAttemptFailed(ctx, delivery.ID, nil, "subscription is inactive or deleted", time.Now(), nil); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+
+		ok, err := s.deliver(ctx, subscription, delivery)
+		if err != nil {
+			return delivered, err
+		}
+		if ok {
+			delivered++
+		}
+	}
+
+	if delivered > 0 {
+		utils.LoggerFromContext(ctx, s.log).Info("Webhook deliveries sent", zap.Int("count", delivered))
+	}
+
+	return delivered, nil
+}
+
+// ==================== HELPER METHODS ====================
+
+// deliver sends satu delivery dan mencatat hasilnya; error hanya untuk kegagalan database
+func (s *webhookService) deliver(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (bool, error) {
+	statusCode, sendErr := s.sender.Send(ctx, webhook.Request{
+		URL:        subscription.URL,
+		Secret:     subscription.Secret,
+		DeliveryID: delivery.ID.String(),
+		EventType:  delivery.EventType,
+		Body:       delivery.Payload,
+	})
+	at := time.Now()
+
+	if sendErr == nil {
+		return true, s.repo.Webhook.MarkDelivered(ctx, delivery.ID, statusCode, at)
+	}
+
+	attempts := delivery.Attempts + 1
+	var nextAttemptAt *time.Time
+	if attempts < s.maxAttempts {
+		next := at.Add(webhookBackoff(attempts))
+		nextAttemptAt = &next
+	}
+
+	var code *int
+	if statusCode > 0 {
+		code = &statusCode
+	}
+
+	utils.LoggerFromContext(ctx, s.log).Warn("Webhook delivery failed",
+		zap.Error(sendErr),
+		zap.String("delivery_id", delivery.ID.String()),
+		zap.String("subscription_id", subscription.ID.String()),
+		zap.String("event_type", delivery.EventType),
+		zap.Int("attempts", attempts),
+		zap.Bool("will_retry", nextAttemptAt != nil),
+	)
+
+	return false, s.repo.Webhook.MarkAttemptFailed(ctx, delivery.ID, code, sendErr.Error(), at, nextAttemptAt)
+}
+
+// webhookBackoff returns jeda sebelum percobaan berikutnya setelah attempts kali gagal
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookBackoffBase
+	for i := 1; i < attempts && delay < webhookBackoffMax; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookBackoffMax)
+}
+
+func (s *webhookService) validateRequest(ctx context.Context, req *request.WebhookSubscriptionRequest) error {
+	if errs := utils.ValidateStruct(req); len(errs) > 0 {
+		utils.LoggerFromContext(ctx, s.log).Warn("Webhook subscription validation failed", zap.Any("errors", errs))
+		return errs
+	}
+
+	supported := events.Types()
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(supported, eventType) {
+			return fmt.Errorf("invalid event type %q, supported: %s", eventType, strings.Join(supported, ", "))
+		}
+	}
+
+	return nil
+}
+
+func (s *webhookService) findSubscription(ctx context.Context, subscriptionID string) (*entity.WebhookSubscription, error) {
+	id, err := uuid.Parse(subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook subscription ID format %s: %w", subscriptionID, err)
+	}
+
+	subscription, err := s.repo.Webhook.FindSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find webhook subscription: %w", err)
+	}
+	if subscription == nil {
+		return nil, fmt.Errorf("webhook subscription %s not found", subscriptionID)
+	}
+
+	return subscription, nil
+}
+
+// enqueueWebhookDeliveries membuat satu delivery per subscription aktif yang mendaftar event ini
+func enqueueWebhookDeliveries(ctx context.Context, repo *repository.Repository, event *entity.OutboxEvent) error {
+	subscriptions, err := repo.Webhook.FindActiveSubscriptions(ctx, event.EventType)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	payload, err := marshalEnvelope(event)
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]*entity.WebhookDelivery, len(subscriptions))
+	for i, subscription := range subscriptions {
+		deliveries[i] = &entity.WebhookDelivery{
+			BaseSimple: entity.BaseSimple{
+				ID:        uuid.New(),
+				CreatedAt: event.CreatedAt,
+			},
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.EventType,
+			Payload:        payload,
+			Status:         entity.WebhookDeliveryPending,
+			NextAttemptAt:  event.CreatedAt,
+		}
+	}
+
+	return repo.Webhook.CreateDeliveries(ctx, deliveries)
+}
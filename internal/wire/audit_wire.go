@@ -0,0 +1,26 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireAudit(
+	r chi.Router,
+	auditHandler *adaptor.AuditHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// GET /api/admin/audit-logs?actor_id=&target_id=&action= - Jejak aksi sensitif admin (impersonation, dsb)
+	r.With(
+		middleware.AuthSession(repo.Session, log),
+		middleware.PlatformAdmin(repo.User, log),
+	).Get("/api/admin/audit-logs", auditHandler.GetAuditLogs)
+}
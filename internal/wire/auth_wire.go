@@ -28,4 +28,14 @@ func wireAuth(
 	// ==================== PROTECTED ROUTES ====================
 	// Logout requires valid session (can't logout without being logged in)
 	r.With(middleware.AuthSession(repo.Session, log)).Post("/api/logout", authHandler.Logout)
+
+	// Akhiri session impersonation; dipanggil dengan token impersonation, bukan token admin
+	r.With(middleware.AuthSession(repo.Session, log)).Post("/api/impersonation/stop", authHandler.StopImpersonation)
+
+	// ==================== ADMIN ROUTES ====================
+	// Session pendek sebagai user untuk debugging support, tercatat di audit log
+	r.With(
+		middleware.AuthSession(repo.Session, log),
+		middleware.PlatformAdmin(repo.User, log),
+	).Post("/api/admin/users/{id}/impersonate", authHandler.Impersonate)
 }
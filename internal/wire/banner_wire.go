@@ -0,0 +1,35 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireBanner(
+	r chi.Router,
+	bannerHandler *adaptor.BannerHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PUBLIC ROUTES ====================
+	// GET /api/banners?city=Jakarta - Banner home screen yang sedang live
+	r.Get("/api/banners", bannerHandler.GetLiveBanners)
+
+	// ==================== ADMIN ROUTES ====================
+	r.Route("/api/admin/banners", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		r.Get("/", bannerHandler.GetBanners)          // List semua, dengan flag is_live
+		r.Get("/{id}", bannerHandler.GetBannerByID)   // Detail banner
+		r.Post("/", bannerHandler.CreateBanner)       // Jadwalkan banner {starts_at, ends_at, city}
+		r.Put("/{id}", bannerHandler.UpdateBanner)    // Replace penuh
+		r.Delete("/{id}", bannerHandler.DeleteBanner) // Soft delete
+	})
+}
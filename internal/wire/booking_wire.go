@@ -28,10 +28,28 @@ func wireBooking(
 		// GET /api/user/bookings - View booking history (user's own bookings)
 		r.Get("/api/user/bookings", bookingHandler.GetUserBookings)
 
+		// GET /api/user/bookings/by-order/{orderID} - Lookup own booking by receipt order number
+		r.Get("/api/user/bookings/by-order/{orderID}", bookingHandler.GetUserBookingByOrderID)
+
+		// GET /api/user/bookings/{id}/receipt - Download invoice PDF untuk booking yang sudah dibayar
+		r.Get("/api/user/bookings/{id}/receipt", bookingHandler.GetBookingReceipt)
+
 		// POST /api/pay - Process payment for booking
 		r.Post("/api/pay", bookingHandler.ProcessPayment)
+
+		// GET /api/payments/{id}/status - Poll VA / QRIS payment sampai webhook masuk
+		r.Get("/api/payments/{id}/status", bookingHandler.GetPaymentStatus)
 	})
 
+	// ==================== WEBHOOK ROUTES ====================
+	// POST /api/payments/webhook - Callback gateway, diautentikasi dengan HMAC signature
+	if config.Payment.WebhookSecret != "" {
+		r.With(middleware.WebhookSignature(config.Payment.WebhookSecret, log)).
+			Post("/api/payments/webhook", bookingHandler.PaymentWebhook)
+	} else {
+		log.Warn("PAYMENT_WEBHOOK_SECRET not set, payment webhook disabled")
+	}
+
 	// ==================== PUBLIC ROUTES ====================
 	// GET /api/payment-methods - List available payment methods (public)
 	r.Get("/api/payment-methods", bookingHandler.GetPaymentMethods)
@@ -43,10 +61,35 @@ func wireBooking(
 		r.Use(middleware.AuthSession(repo.Session, log))
 		r.Use(middleware.Admin(repo.User, log))
 
+		// GET /api/admin/bookings - List all bookings (page/per_page or cursor, ?flag=disputed|chargeback|vip)
+		r.Get("/", bookingHandler.GetAllBookings)
+
+		// POST /api/admin/bookings/group - Block booking kursi / satu hall untuk event
+		r.Post("/group", bookingHandler.CreateGroupBooking)
+
+		// GET /api/admin/bookings/by-order/{orderID} - Resolve booking from receipt order number
+		r.Get("/by-order/{orderID}", bookingHandler.GetBookingByOrderID)
+
 		// GET /api/admin/bookings/{id} - View any booking details (admin)
 		r.Get("/{id}", bookingHandler.GetBookingByID)
 
 		// PUT /api/admin/bookings/{id}/cancel - Cancel any booking (admin)
 		r.Put("/{id}/cancel", bookingHandler.CancelBooking)
+
+		// POST /api/admin/bookings/{id}/status - Check-in atau refund {"status": "checked_in|refunded", "reason": "..."}
+		r.Post("/{id}/status", bookingHandler.TransitionBooking)
+
+		// POST /api/admin/bookings/{id}/notes - Catatan internal support {"body": "..."}
+		r.Post("/{id}/notes", bookingHandler.AddBookingNote)
+
+		// PUT /api/admin/bookings/{id}/flags - Replace flag {"flags": ["disputed", "chargeback", "vip"]}
+		r.Put("/{id}/flags", bookingHandler.UpdateBookingFlags)
 	})
+
+	// ==================== STAFF ROUTES ====================
+	// GET /api/staff/bookings/{id}/notes - Catatan internal, tidak pernah tampil ke customer
+	r.With(
+		middleware.AuthSession(repo.Session, log),
+		middleware.Staff(repo.User, log),
+	).Get("/api/staff/bookings/{id}/notes", bookingHandler.GetBookingNotes)
 }
@@ -18,15 +18,23 @@ func wireCinema(
 	log *zap.Logger,
 ) {
 	// ==================== PUBLIC ROUTES ====================
-	// GET /api/cinemas - List all cinemas (public)
+	// GET /api/cinemas - List all cinemas (public), ?city=&facilities=imax,parking
 	r.Get("/api/cinemas", cinemaHandler.GetCinemas)
 
+	// GET /api/cities - Distinct cities with cinema counts (public)
+	r.Get("/api/cities", cinemaHandler.GetCities)
+
+	// GET /api/cinemas/nearby - Cinemas near a point, ordered by distance (public)
+	// Query params: ?lat=-6.2&lng=106.8&radius_km=10
+	r.Get("/api/cinemas/nearby", cinemaHandler.GetNearbyCinemas)
+
 	// GET /api/cinemas/{id} - Get specific cinema details (public)
 	r.Get("/api/cinemas/{id}", cinemaHandler.GetCinemaByID)
 
 	// GET /api/cinemas/{id}/seats - Check seat availability (public)
 	// Requires query params: ?date=2024-01-16&time=14:30
-	r.Get("/api/cinemas/{id}/seats", cinemaHandler.GetSeatAvailability)
+	// Token opsional, hanya supaya seat view di funnel bisa dihitung unik per user
+	r.With(middleware.OptionalAuth(repo.Session, log)).Get("/api/cinemas/{id}/seats", cinemaHandler.GetSeatAvailability)
 
 	// ==================== ADMIN ROUTES ====================
 	// Group admin routes under /api/admin/cinemas
@@ -36,8 +44,25 @@ func wireCinema(
 		r.Use(middleware.Admin(repo.User, log))
 
 		// Cinema CRUD operations (admin only)
-		r.Post("/", cinemaHandler.CreateCinema)       // Create new cinema
-		r.Put("/{id}", cinemaHandler.UpdateCinema)    // Update existing cinema
-		r.Delete("/{id}", cinemaHandler.DeleteCinema) // Delete cinema
+		r.Get("/", cinemaHandler.GetCinemasAdmin)            // List cinemas, ?include_deleted=true
+		r.Post("/", cinemaHandler.CreateCinema)              // Create new cinema
+		r.Put("/{id}", cinemaHandler.UpdateCinema)           // Update existing cinema
+		r.Delete("/{id}", cinemaHandler.DeleteCinema)        // Delete cinema
+		r.Post("/{id}/restore", cinemaHandler.RestoreCinema) // Restore soft-deleted cinema
+
+		// PUT /api/admin/cinemas/{id}/close - Tutup sementara / permanen, schedule & booking terdampak dibatalkan
+		r.Put("/{id}/close", cinemaHandler.CloseCinema)
+	})
+
+	// Kursi rusak / maintenance diblokir di level hall untuk semua schedule; body {seat_ids}
+	r.Route("/api/admin/halls", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.Admin(repo.User, log))
+
+		r.Post("/{id}/seats/block", cinemaHandler.BlockHallSeats)
+		r.Post("/{id}/seats/unblock", cinemaHandler.UnblockHallSeats)
+
+		// GET /api/admin/halls/capacity-check - Seat map vs total_seats dan counter kursi schedule (platform admin)
+		r.With(middleware.PlatformAdmin(repo.User, log)).Get("/capacity-check", cinemaHandler.CheckCapacity)
 	})
 }
@@ -0,0 +1,35 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireDataExport(
+	r chi.Router,
+	dataExportHandler *adaptor.DataExportHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PROTECTED ROUTES (require auth) ====================
+	// GET /api/users/me/export?format=json|zip - Minta export / cek status; download_url muncul setelah selesai
+	r.With(middleware.AuthSession(repo.Session, log)).
+		Get("/api/users/me/export", dataExportHandler.RequestExport)
+
+	// ==================== PUBLIC ROUTES ====================
+	// Link dari download_url; akses dijaga signature HMAC + expires, bukan session
+	r.Get("/api/exports/{id}/download", dataExportHandler.Download)
+
+	// ==================== ADMIN ROUTES ====================
+	// GET /api/admin/data-exports?user_id=&status= - Log permintaan export semua user
+	r.With(
+		middleware.AuthSession(repo.Session, log),
+		middleware.PlatformAdmin(repo.User, log),
+	).Get("/api/admin/data-exports", dataExportHandler.GetDataExports)
+}
@@ -0,0 +1,33 @@
+package wire
+
+import (
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+// wireDebug mounts net/http/pprof untuk profiling load test; hanya kalau PPROF_ENABLED dan khusus admin
+func wireDebug(
+	r chi.Router,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	if !config.App.PprofEnabled {
+		return
+	}
+
+	// ==================== ADMIN ROUTES ====================
+	r.Route("/api/admin/debug", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log)) // Must be authenticated
+		r.Use(middleware.PlatformAdmin(repo.User, log))  // Must be platform admin
+
+		r.Mount("/", chimiddleware.Profiler()) // GET /api/admin/debug/pprof/, /pprof/heap, /pprof/profile?seconds=30, /vars
+	})
+
+	log.Warn("pprof endpoints enabled at /api/admin/debug/pprof/")
+}
@@ -0,0 +1,35 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireFeatureFlag(
+	r chi.Router,
+	featureFlagHandler *adaptor.FeatureFlagHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// Flag berlaku untuk semua chain, hanya untuk admin platform
+	r.Route("/api/admin/feature-flags", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		// GET /api/admin/feature-flags - Semua flag, default dan environment instance ini
+		r.Get("/", featureFlagHandler.ListFlags)
+
+		// PUT /api/admin/feature-flags/{key} - Buat/ubah flag, rollout per environment dan persen user
+		r.Put("/{key}", featureFlagHandler.UpdateFlag)
+
+		// DELETE /api/admin/feature-flags/{key} - Hapus override, flag kembali ke default
+		r.Delete("/{key}", featureFlagHandler.DeleteFlag)
+	})
+}
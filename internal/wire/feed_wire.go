@@ -0,0 +1,30 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireFeed(
+	r chi.Router,
+	feedHandler *adaptor.FeedHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PUBLIC ROUTES ====================
+	// Untuk SEO website publik; isinya movie now playing dan coming soon dengan canonical URL PUBLIC_SITE_URL
+
+	// GET /sitemap.xml - Sitemap untuk crawler
+	r.Get("/sitemap.xml", feedHandler.GetSitemap)
+
+	// GET /api/feeds/movies.json - JSON Feed 1.1
+	r.Get("/api/feeds/movies.json", feedHandler.GetMoviesJSON)
+
+	// GET /api/feeds/movies.rss - RSS 2.0
+	r.Get("/api/feeds/movies.rss", feedHandler.GetMoviesRSS)
+}
@@ -0,0 +1,42 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireGiftCard(
+	r chi.Router,
+	giftCardHandler *adaptor.GiftCardHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PROTECTED ROUTES (require auth) ====================
+	// Saldo dipakai saat bayar lewat POST /api/pay dengan use_gift_balance=true
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+
+		// GET /api/user/giftcards - Total saldo yang bisa dipakai + kartu milik user
+		r.Get("/api/user/giftcards", giftCardHandler.GetGiftBalance)
+
+		// POST /api/user/giftcards/redeem - Klaim kode ke akun {code}
+		r.Post("/api/user/giftcards/redeem", giftCardHandler.RedeemGiftCard)
+	})
+
+	// ==================== ADMIN ROUTES ====================
+	r.Route("/api/admin/giftcards", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		r.Get("/", giftCardHandler.GetGiftCards)              // List paginated, kode lengkap
+		r.Get("/{id}", giftCardHandler.GetGiftCardByID)       // Detail + saldo tersisa
+		r.Post("/", giftCardHandler.IssueGiftCards)           // Terbitkan {amount, quantity, expires_on}
+		r.Delete("/{id}", giftCardHandler.DeactivateGiftCard) // Bekukan sisa saldo
+	})
+}
@@ -0,0 +1,24 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireHealth(
+	r chi.Router,
+	healthHandler *adaptor.HealthHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PUBLIC ROUTES ====================
+	// Dipanggil load balancer dan Prometheus dari dalam cluster, jadi tanpa auth
+	r.Get("/health", healthHandler.Health)    // GET /health (liveness)
+	r.Get("/readyz", healthHandler.Readiness) // GET /readyz (database + pool saturation)
+	r.Get("/metrics", healthHandler.Metrics)  // GET /metrics (Prometheus)
+}
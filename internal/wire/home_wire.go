@@ -0,0 +1,28 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireHome(
+	r chi.Router,
+	homeHandler *adaptor.HomeHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PUBLIC ROUTES ====================
+	// Token opsional: kalau login, response menyertakan upcoming_booking dan in_watchlist
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.OptionalAuth(repo.Session, log))
+
+		// GET /api/home - Now playing, coming soon, top rated dan booking terdekat dalam satu call
+		r.Get("/api/home", homeHandler.GetHome)
+	})
+}
@@ -0,0 +1,38 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireJob(
+	r chi.Router,
+	jobHandler *adaptor.JobHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// Antrian job dibagi semua chain, hanya untuk admin platform
+	r.Route("/api/admin/jobs", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		// GET /api/admin/jobs?type=&status= - Daftar job terbaru, status=dead untuk dead letter
+		r.Get("/", jobHandler.GetJobs)
+
+		// GET /api/admin/jobs/stats - Jumlah job per status
+		r.Get("/stats", jobHandler.GetJobStats)
+
+		// GET /api/admin/jobs/{id} - Detail job termasuk error terakhir
+		r.Get("/{id}", jobHandler.GetJob)
+
+		// POST /api/admin/jobs/{id}/retry - Kembalikan job dead ke antrian
+		r.Post("/{id}/retry", jobHandler.RetryJob)
+	})
+}
@@ -0,0 +1,32 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireLedger(
+	r chi.Router,
+	ledgerHandler *adaptor.LedgerHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// Ledger lintas organization, hanya untuk admin platform
+	r.Route("/api/admin/ledger", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		// GET /api/admin/ledger/balances - Total debit / credit per akun dan currency
+		r.Get("/balances", ledgerHandler.GetBalances)
+
+		// GET /api/admin/ledger/check - Jalankan pengecekan invariant sekarang
+		r.Get("/check", ledgerHandler.CheckInvariants)
+	})
+}
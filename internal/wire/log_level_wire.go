@@ -0,0 +1,28 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireLogLevel(
+	r chi.Router,
+	logLevelHandler *adaptor.LogLevelHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	r.Route("/api/admin/loglevel", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log)) // Must be authenticated
+		r.Use(middleware.PlatformAdmin(repo.User, log))  // Must be platform admin
+
+		r.Get("/", logLevelHandler.GetLogLevel)    // GET /api/admin/loglevel
+		r.Put("/", logLevelHandler.UpdateLogLevel) // PUT /api/admin/loglevel {"level": "debug"}
+	})
+}
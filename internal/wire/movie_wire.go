@@ -18,22 +18,48 @@ func wireMovie(
 	log *zap.Logger,
 ) {
 	// ==================== PUBLIC ROUTES ====================
-	// GET /api/movies - List movies (public, anyone can view)
-	r.Get("/api/movies", movieHandler.GetMovies)
+	// Token opsional: kalau login, response menyertakan in_watchlist
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.OptionalAuth(repo.Session, log))
 
-	// GET /api/movies/{id} - Movie details (public)
-	r.Get("/api/movies/{id}", movieHandler.GetMovieByID)
+		// GET /api/movies - List movies (public, anyone can view)
+		r.Get("/api/movies", movieHandler.GetMovies)
+
+		// GET /api/movies/{id} - Movie details (public)
+		r.Get("/api/movies/{id}", movieHandler.GetMovieByID)
+	})
+
+	// GET /api/movies/trending - Paling banyak dipesan 7 hari terakhir (public, cached)
+	r.Get("/api/movies/trending", movieHandler.GetTrendingMovies)
+
+	// GET /api/movies/{id}/schedules - Upcoming schedules (public)
+	r.Get("/api/movies/{id}/schedules", movieHandler.GetMovieSchedules)
 
 	// ==================== ADMIN ROUTES ====================
 	// Group admin routes with middleware chain
 	r.Route("/api/admin/movies", func(r chi.Router) {
 		// Apply middleware to all routes in this group
 		r.Use(middleware.AuthSession(repo.Session, log)) // Must be authenticated
-		r.Use(middleware.Admin(repo.User, log))          // Must be admin
+		r.Use(middleware.PlatformAdmin(repo.User, log))  // Must be platform admin
 
 		// Admin movie management endpoints
-		r.Post("/", movieHandler.CreateMovie)       // POST /api/admin/movies
-		r.Put("/{id}", movieHandler.UpdateMovie)    // PUT /api/admin/movies/{id}
-		r.Delete("/{id}", movieHandler.DeleteMovie) // DELETE /api/admin/movies/{id}
+		r.Get("/", movieHandler.GetMoviesAdmin)            // GET /api/admin/movies?include_deleted=true
+		r.Post("/", movieHandler.CreateMovie)              // POST /api/admin/movies
+		r.Put("/{id}", movieHandler.UpdateMovie)           // PUT /api/admin/movies/{id}
+		r.Delete("/{id}", movieHandler.DeleteMovie)        // DELETE /api/admin/movies/{id}
+		r.Post("/{id}/restore", movieHandler.RestoreMovie) // POST /api/admin/movies/{id}/restore
+
+		// Bulk katalog untuk onboarding: import CSV (multipart, ?dry_run) dan export ?format=csv|xlsx
+		r.Post("/import", movieHandler.ImportMovies)
+		r.Get("/export", movieHandler.ExportMovies)
+
+		// PUT /api/admin/movies/{id}/release-status - Override status, job otomatis skip kalau locked
+		r.Put("/{id}/release-status", movieHandler.SetReleaseStatus)
 	})
+
+	// POST /api/admin/genres/{id}/merge-into/{targetID} - Gabungkan genre duplikat (mis. "Sci-Fi" ke "Science Fiction")
+	r.With(
+		middleware.AuthSession(repo.Session, log),
+		middleware.PlatformAdmin(repo.User, log),
+	).Post("/api/admin/genres/{id}/merge-into/{targetID}", movieHandler.MergeGenre)
 }
@@ -0,0 +1,36 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireNotification(
+	r chi.Router,
+	notificationHandler *adaptor.NotificationHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PROTECTED ROUTES (require auth) ====================
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+
+		// GET /api/users/notification-settings - View notification preferences per channel
+		r.Get("/api/users/notification-settings", notificationHandler.GetSettings)
+
+		// PUT /api/users/notification-settings - Toggle notification categories per channel
+		r.Put("/api/users/notification-settings", notificationHandler.UpdateSettings)
+
+		// POST /api/users/devices - Register device token for push notifications
+		r.Post("/api/users/devices", notificationHandler.RegisterDevice)
+
+		// DELETE /api/users/devices/{token} - Remove device token (e.g. on app logout)
+		r.Delete("/api/users/devices/{token}", notificationHandler.UnregisterDevice)
+	})
+}
@@ -0,0 +1,37 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireOrganization(
+	r chi.Router,
+	organizationHandler *adaptor.OrganizationHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// Chain cinema; admin chain sendiri tidak bisa mengubah organization-nya
+	r.Route("/api/admin/organizations", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		r.Get("/", organizationHandler.GetOrganizations)          // List chain + jumlah cinema & admin
+		r.Get("/{id}", organizationHandler.GetOrganizationByID)   // Detail chain
+		r.Post("/", organizationHandler.CreateOrganization)       // Buat chain {name, slug}
+		r.Put("/{id}", organizationHandler.UpdateOrganization)    // Replace name & slug
+		r.Delete("/{id}", organizationHandler.DeleteOrganization) // Hanya kalau sudah tanpa cinema & admin
+
+		r.Put("/{id}/cinemas", organizationHandler.AssignCinemas)               // Pindahkan cinema ke chain {cinema_ids}
+		r.Delete("/{id}/cinemas/{cinemaID}", organizationHandler.ReleaseCinema) // Kembalikan cinema ke platform
+		r.Post("/{id}/admins", organizationHandler.AddAdmin)                    // Jadikan user admin chain {user_id}
+		r.Delete("/{id}/admins/{userID}", organizationHandler.RemoveAdmin)      // Turunkan admin chain jadi customer
+	})
+}
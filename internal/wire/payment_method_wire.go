@@ -0,0 +1,32 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wirePaymentMethod(
+	r chi.Router,
+	paymentMethodHandler *adaptor.PaymentMethodHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// List publik (hanya yang aktif) tetap di GET /api/payment-methods milik booking
+	r.Route("/api/admin/payment-methods", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		r.Get("/", paymentMethodHandler.GetPaymentMethods)          // List semua, termasuk nonaktif
+		r.Get("/{id}", paymentMethodHandler.GetPaymentMethodByID)   // Detail payment method
+		r.Post("/", paymentMethodHandler.CreatePaymentMethod)       // Tambah payment method
+		r.Put("/{id}", paymentMethodHandler.UpdatePaymentMethod)    // Update metadata, fee, status aktif
+		r.Delete("/{id}", paymentMethodHandler.DeletePaymentMethod) // Soft delete
+	})
+}
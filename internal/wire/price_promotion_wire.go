@@ -0,0 +1,32 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wirePricePromotion(
+	r chi.Router,
+	pricePromotionHandler *adaptor.PricePromotionHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// Harga setelah promo tampil di listing schedule publik; tidak ada endpoint publik terpisah
+	r.Route("/api/admin/promotions", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		r.Get("/", pricePromotionHandler.GetPromotions)          // List semua, termasuk nonaktif
+		r.Get("/{id}", pricePromotionHandler.GetPromotionByID)   // Detail promo
+		r.Post("/", pricePromotionHandler.CreatePromotion)       // Tambah promo, mis. weekday 10:00-13:00
+		r.Put("/{id}", pricePromotionHandler.UpdatePromotion)    // Replace penuh
+		r.Delete("/{id}", pricePromotionHandler.DeletePromotion) // Soft delete
+	})
+}
@@ -0,0 +1,42 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireReport(
+	r chi.Router,
+	reportHandler *adaptor.ReportHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	r.Route("/api/admin/reports", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log)) // Must be authenticated
+		r.Use(middleware.Admin(repo.User, log))          // Must be admin
+
+		r.Get("/sales", reportHandler.GetSalesReport)         // GET /api/admin/reports/sales?start_date=&end_date=&group_by=day|cinema|movie
+		r.Get("/summary", reportHandler.GetTodaySummary)      // GET /api/admin/reports/summary
+		r.Get("/occupancy", reportHandler.GetOccupancyReport) // GET /api/admin/reports/occupancy?cinema_id=&date=
+		r.Get("/funnel", reportHandler.GetFunnelReport)       // GET /api/admin/reports/funnel?from=&to=
+
+		// Downloads (format=csv|xlsx, default csv)
+		r.Get("/sales/export", reportHandler.ExportSalesReport) // GET /api/admin/reports/sales/export?start_date=&end_date=&group_by=&format=
+		r.Get("/bookings/export", reportHandler.ExportBookings) // GET /api/admin/reports/bookings/export?start_date=&end_date=&status=&format=
+
+		// Payment reconciliation; settlement gateway lintas chain, jadi hanya admin platform
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.PlatformAdmin(repo.User, log))
+
+			r.Get("/payments", reportHandler.GetPaymentReport)             // GET /api/admin/reports/payments?start_date=&end_date=&status=&payment_method_id=
+			r.Post("/payments/reconcile", reportHandler.ReconcilePayments) // POST multipart: file (settlement CSV), start_date, end_date, payment_method_id
+		})
+	})
+}
@@ -40,5 +40,36 @@ func wireReview(
 
 		// DELETE /api/reviews/{id} - Delete review (owner only)
 		r.Delete("/api/reviews/{id}", reviewHandler.DeleteReview)
+
+		// POST /api/reviews/{id}/report - Flag abusive review {"reason": "spam", "details": "..."}
+		r.Post("/api/reviews/{id}/report", reviewHandler.ReportReview)
+	})
+
+	// ==================== STAFF ROUTES ====================
+	r.Route("/api/reviews/{id}/reply", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.Staff(repo.User, log)) // Staff cinema atau admin
+
+		r.Post("/", reviewHandler.ReplyToReview) // POST /api/reviews/{id}/reply - Official reply, satu per review
+		r.Put("/", reviewHandler.UpdateReply)    // PUT /api/reviews/{id}/reply
+		r.Delete("/", reviewHandler.DeleteReply) // DELETE /api/reviews/{id}/reply
+	})
+
+	// ==================== ADMIN ROUTES ====================
+	r.Route("/api/admin/reviews", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		// POST /api/admin/reviews/{id}/restore - Undo a soft-deleted review
+		r.Post("/{id}/restore", reviewHandler.RestoreReview)
+
+		// GET /api/admin/reviews/{id}/revisions - Isi review sebelum tiap edit pemiliknya
+		r.Get("/{id}/revisions", reviewHandler.GetReviewRevisions)
+
+		// GET /api/admin/reviews/reported - Moderation queue, hidden reviews first
+		r.Get("/reported", reviewHandler.GetReportedReviews)
+
+		// POST /api/admin/reviews/{id}/moderate - Resolve reports {"action": "approve"|"remove"}
+		r.Post("/{id}/moderate", reviewHandler.ModerateReview)
 	})
 }
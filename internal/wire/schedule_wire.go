@@ -0,0 +1,46 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireSchedule(
+	r chi.Router,
+	scheduleHandler *adaptor.ScheduleHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PUBLIC ROUTES ====================
+	// GET /api/schedules - Upcoming schedules (public)
+	// Optional query params: ?movie_id=&cinema_id=&date=2024-01-16&format=IMAX
+	r.Get("/api/schedules", scheduleHandler.GetSchedules)
+
+	// ==================== ADMIN ROUTES ====================
+	// Schedule baru dibuat draft, baru muncul di endpoint publik setelah di-publish
+	r.Route("/api/admin/schedules", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.Admin(repo.User, log))
+
+		r.Get("/", scheduleHandler.GetAdminSchedules)        // List termasuk draft, ?status=draft|published
+		r.Post("/", scheduleHandler.CreateSchedule)          // Buat draft
+		r.Post("/publish", scheduleHandler.PublishSchedules) // Bulk publish dengan validasi bentrok & seat map
+		r.Post("/clone-week", scheduleHandler.CloneWeek)     // Salin program minggu sumber ke minggu target sebagai draft
+		r.Put("/{id}", scheduleHandler.UpdateSchedule)       // Edit draft
+		r.Delete("/{id}", scheduleHandler.DeleteSchedule)    // Hapus draft
+
+		// Harga khusus schedule (draft atau published); body {price}, null untuk kembali ke pricing rules
+		r.Put("/{id}/price-override", scheduleHandler.SetPriceOverride)
+
+		// Blokir kursi khusus schedule ini, mis. jaga jarak; body {seat_ids, reason, note}
+		r.Get("/{id}/seats/blocked", scheduleHandler.GetSeatBlocks)
+		r.Post("/{id}/seats/block", scheduleHandler.BlockSeats)
+		r.Post("/{id}/seats/unblock", scheduleHandler.UnblockSeats)
+	})
+}
@@ -0,0 +1,32 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireSettings(
+	r chi.Router,
+	settingsHandler *adaptor.SettingsHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// Setting runtime berlaku untuk semua chain, hanya untuk admin platform
+	r.Route("/api/admin/settings", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		// GET /api/admin/settings - Nilai efektif, default config dan batas tiap setting
+		r.Get("/", settingsHandler.GetSettings)
+
+		// PUT /api/admin/settings - Ubah beberapa setting sekaligus, null = kembali ke default
+		r.Put("/", settingsHandler.UpdateSettings)
+	})
+}
@@ -22,13 +22,25 @@ func wireUser(
 	// User profile - requires authentication
 	r.With(middleware.AuthSession(repo.Session, log)).Get("/api/user/profile", userHandler.GetProfile)
 
+	// Bahasa email / push notification
+	r.With(middleware.AuthSession(repo.Session, log)).Put("/api/user/profile/language", userHandler.UpdateLanguage)
+
+	// Timeline booking, payment, review dan login milik user
+	r.With(middleware.AuthSession(repo.Session, log)).Get("/api/users/activity", userHandler.GetActivity)
+
+	// Tanggal lahir, syarat booking film dengan klasifikasi usia
+	r.With(middleware.AuthSession(repo.Session, log)).Put("/api/user/profile/date-of-birth", userHandler.UpdateDateOfBirth)
+
 	// ==================== ADMIN ROUTES ====================
 	// Admin user management - requires both authentication AND admin role
 	r.With(
 		middleware.AuthSession(repo.Session, log), // Check valid session
-		middleware.Admin(repo.User, log),          // Check admin role
+		middleware.PlatformAdmin(repo.User, log),  // Check platform admin role
 	).Route("/api/admin/users", func(r chi.Router) {
-		r.Get("/", userHandler.GetAllUsers)       // GET /api/admin/users?page=1&per_page=10
-		r.Delete("/{id}", userHandler.DeleteUser) // DELETE /api/admin/users/{user-id}
+		r.Get("/", userHandler.GetAllUsers)                  // GET /api/admin/users?page=1&per_page=10&include_deleted=true&email=&username=&phone=&role=&verified=&active=&created_from=&created_to=
+		r.Get("/{id}", userHandler.GetUser)                  // GET /api/admin/users/{user-id}
+		r.Delete("/{id}", userHandler.DeleteUser)            // DELETE /api/admin/users/{user-id}
+		r.Post("/{id}/restore", userHandler.RestoreUser)     // POST /api/admin/users/{user-id}/restore
+		r.Get("/{id}/activity", userHandler.GetUserActivity) // GET /api/admin/users/{user-id}/activity?type=
 	})
 }
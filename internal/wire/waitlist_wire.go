@@ -0,0 +1,33 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireWaitlist(
+	r chi.Router,
+	waitlistHandler *adaptor.WaitlistHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PROTECTED ROUTES (require auth) ====================
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+
+		// POST /api/schedules/{id}/waitlist - Join waiting list for a sold-out show
+		r.Post("/api/schedules/{id}/waitlist", waitlistHandler.JoinWaitlist)
+
+		// DELETE /api/schedules/{id}/waitlist - Leave waiting list (releases any held seats)
+		r.Delete("/api/schedules/{id}/waitlist", waitlistHandler.LeaveWaitlist)
+
+		// GET /api/user/waitlist - Active waitlist entries with queue position and holds
+		r.Get("/api/user/waitlist", waitlistHandler.GetUserWaitlist)
+	})
+}
@@ -0,0 +1,33 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireWatchlist(
+	r chi.Router,
+	watchlistHandler *adaptor.WatchlistHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== PROTECTED ROUTES (require auth) ====================
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+
+		// GET /api/user/watchlist - Movies saved by the user
+		r.Get("/api/user/watchlist", watchlistHandler.GetUserWatchlist)
+
+		// POST /api/user/watchlist/{movieID} - Save movie (idempotent)
+		r.Post("/api/user/watchlist/{movieID}", watchlistHandler.AddToWatchlist)
+
+		// DELETE /api/user/watchlist/{movieID} - Remove movie from watchlist
+		r.Delete("/api/user/watchlist/{movieID}", watchlistHandler.RemoveFromWatchlist)
+	})
+}
@@ -0,0 +1,35 @@
+package wire
+
+import (
+	"cinema-booking/internal/adaptor"
+	"cinema-booking/internal/data/repository"
+	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func wireWebhook(
+	r chi.Router,
+	webhookHandler *adaptor.WebhookHandler,
+	repo *repository.Repository,
+	config *utils.Config,
+	log *zap.Logger,
+) {
+	// ==================== ADMIN ROUTES ====================
+	// Webhook partner berlaku lintas chain, jadi hanya admin platform
+	r.Route("/api/admin/webhooks", func(r chi.Router) {
+		r.Use(middleware.AuthSession(repo.Session, log))
+		r.Use(middleware.PlatformAdmin(repo.User, log))
+
+		r.Get("/", webhookHandler.GetSubscriptions)          // List subscription (tanpa secret)
+		r.Get("/{id}", webhookHandler.GetSubscriptionByID)   // Detail subscription
+		r.Post("/", webhookHandler.CreateSubscription)       // Daftar URL + event_types, secret dikembalikan sekali
+		r.Put("/{id}", webhookHandler.UpdateSubscription)    // Replace name, url, event_types, is_active
+		r.Delete("/{id}", webhookHandler.DeleteSubscription) // Soft delete, delivery pending dihentikan
+
+		r.Post("/{id}/rotate-secret", webhookHandler.RotateSecret) // Generate secret baru
+		r.Get("/{id}/deliveries", webhookHandler.GetDeliveries)    // Log delivery ?status=pending|delivered|failed
+	})
+}
@@ -4,18 +4,25 @@ package wire
 import (
 	"cinema-booking/internal/adaptor"
 	"cinema-booking/internal/data/repository"
+	"cinema-booking/internal/rpc"
 	"cinema-booking/internal/usecase"
+	"cinema-booking/internal/worker"
 	"cinema-booking/pkg/middleware"
+	"cinema-booking/pkg/reporting"
 	"cinema-booking/pkg/utils"
-	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 // App menyimpan semua dependencies
 type App struct {
-	Router *chi.Mux
+	Router  *chi.Mux
+	Workers []worker.Worker
+
+	// GRPC nil kalau GRPC_API_KEY belum di-set
+	GRPC *grpc.Server
 }
 
 // Wiring menginisialisasi semua dependencies
@@ -23,18 +30,42 @@ func Wiring(repo *repository.Repository, config *utils.Config, logger *zap.Logge
 	// Initialize services dan handlers
 	service := usecase.NewService(repo, config, logger)
 	handler := adaptor.NewHandler(service, logger)
+	reporter := newErrorReporter(config, logger)
 
 	// Setup router
-	router := setupRouter(handler, repo, config, logger)
+	router := setupRouter(handler, reporter, repo, config, logger)
+
+	app := &App{
+		Router:  router,
+		Workers: setupWorkers(service, config, logger),
+	}
 
-	return &App{
-		Router: router,
+	if config.GRPC.APIKey != "" {
+		app.GRPC = rpc.NewServer(service, reporter, config, logger)
+	} else {
+		logger.Warn("GRPC_API_KEY not set, gRPC server disabled")
 	}
+
+	return app
+}
+
+// newErrorReporter picks backend error reporting, fallback ke log kalau SENTRY_DSN belum di-set
+func newErrorReporter(config *utils.Config, logger *zap.Logger) reporting.ErrorReporter {
+	if config.Reporting.SentryDSN != "" {
+		reporter, err := reporting.NewSentryReporter(config.Reporting.SentryDSN, config.App.Environment, config.Reporting.Release)
+		if err == nil {
+			return reporter
+		}
+		logger.Warn("Failed to init Sentry reporter, panics will only be logged", zap.Error(err))
+	}
+
+	return reporting.NewLogReporter(logger)
 }
 
 // setupRouter konfigurasi Chi router
 func setupRouter(
 	handler *adaptor.Handler,
+	reporter reporting.ErrorReporter,
 	repo *repository.Repository,
 	config *utils.Config,
 	logger *zap.Logger,
@@ -42,23 +73,56 @@ func setupRouter(
 	r := chi.NewRouter()
 
 	// Apply global middleware
+	r.Use(middleware.RequestID())
+	r.Use(middleware.QueryCounter(config.Database.QueriesPerRequestWarn, logger))
 	r.Use(middleware.Logger(logger))
-	r.Use(middleware.Recover(logger))
-	r.Use(middleware.CORS())
+	// Sebelum Recover supaya response 500 dari panic ikut lewat writer yang sama
+	if config.App.CompressionEnabled {
+		r.Use(middleware.Compress(config.App.CompressionMinBytes))
+	}
+	r.Use(middleware.Recover(reporter, logger))
+	r.Use(middleware.SecurityHeaders())
+	r.Use(middleware.BodyLimit(config.App.MaxBodyBytes, config.App.MaxUploadBytes))
+	if config.PayloadLog.Active() {
+		logger.Warn("Payload logging enabled, request/response bodies are sampled into logs",
+			zap.Bool("all_routes", config.PayloadLog.Enabled),
+			zap.Strings("routes", config.PayloadLog.Routes),
+			zap.Float64("sample_rate", config.PayloadLog.SampleRate),
+		)
+		r.Use(middleware.PayloadLog(config.PayloadLog, logger))
+	}
+	r.Use(middleware.CORS(config.CORS))
+	r.Use(middleware.Locale())
 
 	// Apply routes
 	wireAuth(r, handler.Auth, repo, config, logger)
 	wireUser(r, handler.User, repo, config, logger)
 	wireMovie(r, handler.Movie, repo, config, logger)
 	wireCinema(r, handler.Cinema, repo, config, logger)
+	wireSchedule(r, handler.Schedule, repo, config, logger)
 	wireBooking(r, handler.Booking, repo, config, logger)
 	wireReview(r, handler.Review, repo, config, logger)
-
-	// Health check endpoint
-	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("OK"))
-	})
+	wireNotification(r, handler.Notification, repo, config, logger)
+	wireReport(r, handler.Report, repo, config, logger)
+	wireWaitlist(r, handler.Waitlist, repo, config, logger)
+	wireWatchlist(r, handler.Watchlist, repo, config, logger)
+	wireHome(r, handler.Home, repo, config, logger)
+	wirePaymentMethod(r, handler.PaymentMethod, repo, config, logger)
+	wirePricePromotion(r, handler.PricePromotion, repo, config, logger)
+	wireOrganization(r, handler.Organization, repo, config, logger)
+	wireWebhook(r, handler.Webhook, repo, config, logger)
+	wireFeed(r, handler.Feed, repo, config, logger)
+	wireBanner(r, handler.Banner, repo, config, logger)
+	wireGiftCard(r, handler.GiftCard, repo, config, logger)
+	wireDataExport(r, handler.DataExport, repo, config, logger)
+	wireAudit(r, handler.Audit, repo, config, logger)
+	wireLedger(r, handler.Ledger, repo, config, logger)
+	wireSettings(r, handler.Settings, repo, config, logger)
+	wireFeatureFlag(r, handler.FeatureFlag, repo, config, logger)
+	wireJob(r, handler.Job, repo, config, logger)
+	wireLogLevel(r, handler.LogLevel, repo, config, logger)
+	wireDebug(r, repo, config, logger)
+	wireHealth(r, handler.Health, repo, config, logger)
 
 	return r
 }
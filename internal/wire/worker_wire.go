@@ -0,0 +1,128 @@
+package wire
+
+import (
+	"context"
+	"time"
+
+	"cinema-booking/internal/usecase"
+	"cinema-booking/internal/worker"
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// setupWorkers registers background jobs
+func setupWorkers(service *usecase.Service, config *utils.Config, log *zap.Logger) []worker.Worker {
+	reminderLead := time.Duration(config.Notification.ReminderLeadMinutes) * time.Minute
+
+	var cleanupTasks []worker.Task
+	for _, task := range service.Cleanup.Tasks() {
+		cleanupTasks = append(cleanupTasks, worker.Task{
+			Name:     task.Name,
+			Interval: task.Interval,
+			Run: func(ctx context.Context) error {
+				_, err := task.Purge(ctx)
+				return err
+			},
+		})
+	}
+
+	return []worker.Worker{
+		// Show reminders for confirmed bookings starting soon
+		worker.NewPeriodic("show_reminder",
+			time.Duration(config.Notification.ReminderIntervalMinutes)*time.Minute,
+			func(ctx context.Context) error {
+				_, err := service.Booking.SendShowReminders(ctx, reminderLead)
+				return err
+			}, log),
+
+		// Relay booking lifecycle events dari outbox ke broker
+		worker.NewPeriodic("outbox_relay",
+			time.Duration(config.Events.RelayIntervalSeconds)*time.Second,
+			func(ctx context.Context) error {
+				_, err := service.Outbox.RelayPendingEvents(ctx)
+				return err
+			}, log),
+
+		// Lepas hold waitlist yang expired lalu tawarkan ke antrian berikutnya
+		worker.NewPeriodic("waitlist_offer_expiry", time.Minute,
+			func(ctx context.Context) error {
+				_, err := service.Waitlist.ExpireOffers(ctx)
+				return err
+			}, log),
+
+		// Kirim webhook partner yang sudah jatuh tempo, termasuk retry dengan backoff
+		worker.NewPeriodic("webhook_delivery",
+			time.Duration(config.Webhook.DeliveryIntervalSeconds)*time.Second,
+			func(ctx context.Context) error {
+				_, err := service.Webhook.DeliverPending(ctx)
+				return err
+			}, log),
+
+		// Expire payment VA / QRIS yang tidak dibayar dan lepas kursinya
+		worker.NewPeriodic("payment_expiry", time.Minute,
+			func(ctx context.Context) error {
+				_, err := service.Booking.ExpirePendingPayments(ctx)
+				return err
+			}, log),
+
+		// Promote / archive release status movie. Kondisinya per tanggal, jalan tiap jam
+		// supaya restart server tidak membuat satu hari terlewat
+		worker.NewPeriodic("movie_release_status", time.Hour,
+			func(ctx context.Context) error {
+				_, _, err := service.Movie.SyncReleaseStatuses(ctx)
+				return err
+			}, log),
+
+		// Hapus file export data pribadi yang sudah lewat masa berlaku; file-nya disusun job queue
+		worker.NewPeriodic("data_export_expiry",
+			time.Duration(config.DataExport.IntervalSeconds)*time.Second,
+			func(ctx context.Context) error {
+				_, err := service.DataExport.ExpireFiles(ctx)
+				return err
+			}, log),
+
+		// Hapus session, OTP dan job selesai yang sudah lewat retention, tiap task dengan interval sendiri;
+		// jumlah baris terhapus tercatat di /metrics
+		worker.NewScheduler("cleanup", cleanupTasks, log),
+
+		// Kerjakan job persistent yang jatuh tempo (email OTP / konfirmasi + receipt, export data)
+		worker.NewPeriodic("job_queue",
+			time.Duration(config.Jobs.PollSeconds)*time.Second,
+			func(ctx context.Context) error {
+				_, err := service.Job.ProcessDue(ctx)
+				return err
+			}, log),
+
+		// Hitung ulang tier customer dari belanja bersih di ledger; tier dipakai promo dan bebas convenience fee
+		worker.NewPeriodic("customer_tier",
+			time.Duration(config.Tier.IntervalMinutes)*time.Minute,
+			func(ctx context.Context) error {
+				_, err := service.CustomerTier.RecomputeTiers(ctx)
+				return err
+			}, log),
+
+		// Cek invariant ledger (transaksi balance, saldo gift card, payment yang belum terposting);
+		// pelanggaran di-log level error oleh service
+		worker.NewDaily("ledger_invariants", config.Ledger.CheckHour,
+			func(ctx context.Context) error {
+				_, err := service.Ledger.CheckInvariants(ctx)
+				return err
+			}, log),
+
+		// Cek seat map hall vs total_seats dan counter booked_seats schedule tiap jam; hasilnya hanya
+		// di-log, perbaikannya butuh keputusan admin
+		worker.NewPeriodic("hall_capacity_check", time.Hour,
+			func(ctx context.Context) error {
+				_, err := service.Cinema.CheckCapacity(ctx)
+				return err
+			}, log),
+
+		// Hitung ulang rating semua movie, koreksi update rating per review yang gagal
+		worker.NewDaily("movie_rating_recalc", config.Review.RatingRecalcHour,
+			func(ctx context.Context) error {
+				_, err := service.Review.RecalculateMovieRatings(ctx)
+				return err
+			}, log),
+	}
+}
@@ -0,0 +1,184 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Worker is a background job yang jalan bareng HTTP server
+type Worker interface {
+	Name() string
+	Run(ctx context.Context)
+}
+
+// Periodic runs a task every interval until the context is cancelled
+type Periodic struct {
+	name     string
+	interval time.Duration
+	task     func(ctx context.Context) error
+	log      *zap.Logger
+}
+
+func NewPeriodic(name string, interval time.Duration, task func(ctx context.Context) error, log *zap.Logger) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		task:     task,
+		log:      log.With(zap.String("worker", name)),
+	}
+}
+
+func (p *Periodic) Name() string {
+	return p.name
+}
+
+func (p *Periodic) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.log.Info("Worker started", zap.Duration("interval", p.interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.log.Info("Worker stopped")
+			return
+		case <-ticker.C:
+			if err := p.task(ctx); err != nil {
+				p.log.Error("Worker run failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Daily runs a task sekali sehari pada jam tertentu (waktu lokal server), untuk job malam
+// yang terlalu berat dijalankan tiap beberapa menit
+type Daily struct {
+	name string
+	hour int
+	task func(ctx context.Context) error
+	log  *zap.Logger
+}
+
+func NewDaily(name string, hour int, task func(ctx context.Context) error, log *zap.Logger) *Daily {
+	return &Daily{
+		name: name,
+		hour: hour,
+		task: task,
+		log:  log.With(zap.String("worker", name)),
+	}
+}
+
+func (d *Daily) Name() string {
+	return d.name
+}
+
+func (d *Daily) Run(ctx context.Context) {
+	d.log.Info("Worker started", zap.Int("hour", d.hour))
+
+	for {
+		next := nextDailyRun(time.Now(), d.hour)
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			d.log.Info("Worker stopped")
+			return
+		case <-timer.C:
+			if err := d.task(ctx); err != nil {
+				d.log.Error("Worker run failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// nextDailyRun returns jam hour:00 berikutnya setelah now
+func nextDailyRun(now time.Time, hour int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// Task satu pekerjaan di Scheduler dengan interval sendiri
+type Task struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs beberapa Task dengan interval berbeda dalam satu goroutine, seperti crontab kecil.
+// Task dijalankan berurutan, jadi task yang lama hanya menggeser jadwal task lain, tidak menumpuk;
+// cocok untuk pekerjaan ringan seperti cleanup.
+type Scheduler struct {
+	name  string
+	tasks []Task
+	log   *zap.Logger
+}
+
+func NewScheduler(name string, tasks []Task, log *zap.Logger) *Scheduler {
+	return &Scheduler{
+		name:  name,
+		tasks: tasks,
+		log:   log.With(zap.String("worker", name)),
+	}
+}
+
+func (s *Scheduler) Name() string {
+	return s.name
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	if len(s.tasks) == 0 {
+		return
+	}
+
+	now := time.Now()
+	next := make([]time.Time, len(s.tasks))
+	for i, task := range s.tasks {
+		next[i] = now.Add(task.Interval)
+	}
+
+	s.log.Info("Worker started", zap.Int("tasks", len(s.tasks)))
+
+	for {
+		due := next[0]
+		for _, at := range next[1:] {
+			if at.Before(due) {
+				due = at
+			}
+		}
+		timer := time.NewTimer(time.Until(due))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.log.Info("Worker stopped")
+			return
+		case <-timer.C:
+		}
+
+		for i, task := range s.tasks {
+			if time.Now().Before(next[i]) || ctx.Err() != nil {
+				continue
+			}
+			if err := task.Run(ctx); err != nil {
+				s.log.Error("Scheduled task failed", zap.Error(err), zap.String("task", task.Name))
+			}
+			next[i] = time.Now().Add(task.Interval)
+		}
+	}
+}
+
+// StartAll starts every worker in its own goroutine
+func StartAll(ctx context.Context, workers []Worker, log *zap.Logger) {
+	for _, w := range workers {
+		go w.Run(ctx)
+	}
+
+	log.Info("Background workers started", zap.Int("count", len(workers)))
+}
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"log"
+	// Timezone cinema tetap bisa di-load di container tanpa /usr/share/zoneinfo
+	_ "time/tzdata"
 
 	"cinema-booking/cmd"
 	"cinema-booking/internal/data/repository"
 	"cinema-booking/internal/wire"
+	"cinema-booking/internal/worker"
 	"cinema-booking/pkg/database"
 	"cinema-booking/pkg/utils"
 
@@ -34,7 +38,7 @@ func main() {
 	)
 
 	// Connect to database
-	db, err := database.InitDB(config.Database)
+	db, err := database.InitDB(config.Database, logger)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
@@ -48,8 +52,22 @@ func main() {
 	// Wire all dependencies
 	app := wire.Wiring(repos, config, logger)
 
+	// Start background workers
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	worker.StartAll(ctx, app.Workers, logger)
+
+	// Start gRPC server (internal services)
+	if app.GRPC != nil {
+		logger.Info("Starting gRPC server", zap.String("port", config.GRPC.Port))
+		go cmd.GRPCServer(app.GRPC, config.GRPC.Port)
+	}
+
 	// Start server
-	logger.Info("Starting HTTP server", zap.String("port", config.App.Port))
+	logger.Info("Starting HTTP server",
+		zap.String("port", config.App.Port),
+		zap.Bool("tls", config.App.TLSEnabled()),
+	)
 
-	cmd.APIServer(app.Router, config.App.Port)
+	cmd.APIServer(app.Router, config.App)
 }
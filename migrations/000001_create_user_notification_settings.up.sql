@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS user_notification_settings (
+    id                   UUID PRIMARY KEY,
+    user_id              UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel              VARCHAR(20) NOT NULL,
+    booking_confirmation BOOLEAN     NOT NULL DEFAULT TRUE,
+    reminders            BOOLEAN     NOT NULL DEFAULT TRUE,
+    promotions           BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at           TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMP   NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_user_notification_settings_user_channel UNIQUE (user_id, channel)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS reminder_sent_at;
+
+DROP TABLE IF EXISTS user_devices;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS uq_reviews_user_movie_active;
+
+ALTER TABLE schedules DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE reviews DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE payments DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE bookings DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,10 @@
+-- Bookings, payments, reviews, and schedules now soft-delete like the other entities
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+-- One active review per user per movie; deleted reviews no longer block a new one
+CREATE UNIQUE INDEX IF NOT EXISTS uq_reviews_user_movie_active
+    ON reviews (user_id, movie_id)
+    WHERE deleted_at IS NULL;
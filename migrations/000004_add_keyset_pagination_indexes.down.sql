@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_users_created_id;
+DROP INDEX IF EXISTS idx_bookings_created_id;
+DROP INDEX IF EXISTS idx_bookings_user_created_id;
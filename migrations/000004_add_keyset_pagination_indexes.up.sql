@@ -0,0 +1,4 @@
+-- Support (created_at DESC, id DESC) keyset pagination on large listings
+CREATE INDEX IF NOT EXISTS idx_bookings_user_created_id ON bookings (user_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_bookings_created_id ON bookings (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_users_created_id ON users (created_at DESC, id DESC);
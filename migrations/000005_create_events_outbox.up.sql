@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS events_outbox (
+    id             UUID PRIMARY KEY,
+    aggregate_type VARCHAR(50)  NOT NULL,
+    aggregate_id   UUID         NOT NULL,
+    event_type     VARCHAR(100) NOT NULL,
+    payload        JSONB        NOT NULL,
+    attempts       INT          NOT NULL DEFAULT 0,
+    last_error     TEXT,
+    published_at   TIMESTAMP,
+    created_at     TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+-- Relay hanya scan event yang belum terkirim, urut sesuai waktu dibuat
+CREATE INDEX IF NOT EXISTS idx_events_outbox_pending ON events_outbox(created_at) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_events_outbox_aggregate ON events_outbox(aggregate_type, aggregate_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS seat_holds;
+DROP TABLE IF EXISTS schedule_waitlist;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS schedule_waitlist (
+    id              UUID PRIMARY KEY,
+    schedule_id     UUID        NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    user_id         UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    seats_requested INT         NOT NULL DEFAULT 1 CHECK (seats_requested > 0),
+    status          VARCHAR(20) NOT NULL DEFAULT 'waiting',
+    offered_at      TIMESTAMP,
+    hold_expires_at TIMESTAMP,
+    created_at      TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+-- Satu entry aktif per user per schedule
+CREATE UNIQUE INDEX IF NOT EXISTS uq_schedule_waitlist_active
+    ON schedule_waitlist(schedule_id, user_id) WHERE status IN ('waiting', 'offered');
+CREATE INDEX IF NOT EXISTS idx_schedule_waitlist_queue
+    ON schedule_waitlist(schedule_id, created_at) WHERE status = 'waiting';
+CREATE INDEX IF NOT EXISTS idx_schedule_waitlist_offer_expiry
+    ON schedule_waitlist(hold_expires_at) WHERE status = 'offered';
+
+CREATE TABLE IF NOT EXISTS seat_holds (
+    id                UUID PRIMARY KEY,
+    schedule_id       UUID      NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    seat_id           UUID      NOT NULL REFERENCES seats(id) ON DELETE CASCADE,
+    user_id           UUID      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    waitlist_entry_id UUID      NOT NULL REFERENCES schedule_waitlist(id) ON DELETE CASCADE,
+    expires_at        TIMESTAMP NOT NULL,
+    created_at        TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_seat_holds_schedule_seat UNIQUE (schedule_id, seat_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_seat_holds_waitlist_entry ON seat_holds(waitlist_entry_id);
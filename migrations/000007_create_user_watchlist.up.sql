@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS user_watchlist (
+    id         UUID PRIMARY KEY,
+    user_id    UUID      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    movie_id   UUID      NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, movie_id)
+);
+
+-- Lookup watchers saat movie mulai tayang
+CREATE INDEX IF NOT EXISTS idx_user_watchlist_movie_id ON user_watchlist(movie_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_cinemas_coordinates;
+ALTER TABLE cinemas DROP CONSTRAINT IF EXISTS chk_cinemas_coordinates;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS longitude;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS latitude;
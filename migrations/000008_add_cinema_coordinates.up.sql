@@ -0,0 +1,12 @@
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+-- Koordinat diisi berpasangan dan dalam range yang valid
+ALTER TABLE cinemas ADD CONSTRAINT chk_cinemas_coordinates CHECK (
+    (latitude IS NULL AND longitude IS NULL)
+    OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cinemas_coordinates
+    ON cinemas(latitude, longitude)
+    WHERE deleted_at IS NULL AND latitude IS NOT NULL;
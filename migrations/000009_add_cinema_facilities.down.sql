@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_cinemas_facilities;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS opening_hours;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS facilities;
@@ -0,0 +1,5 @@
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS facilities JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS opening_hours JSONB;
+
+-- Filter facilities pakai containment (@>)
+CREATE INDEX IF NOT EXISTS idx_cinemas_facilities ON cinemas USING GIN (facilities);
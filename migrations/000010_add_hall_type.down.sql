@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_halls_hall_type;
+ALTER TABLE halls DROP CONSTRAINT IF EXISTS chk_halls_hall_type;
+ALTER TABLE halls DROP COLUMN IF EXISTS hall_type;
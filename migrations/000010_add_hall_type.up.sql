@@ -0,0 +1,6 @@
+ALTER TABLE halls ADD COLUMN IF NOT EXISTS hall_type VARCHAR(10) NOT NULL DEFAULT '2D';
+
+ALTER TABLE halls ADD CONSTRAINT chk_halls_hall_type
+    CHECK (hall_type IN ('2D', '3D', 'IMAX', '4DX'));
+
+CREATE INDEX IF NOT EXISTS idx_halls_hall_type ON halls(hall_type) WHERE deleted_at IS NULL;
@@ -0,0 +1,10 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS discount_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS tax_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS fee_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS base_price;
+
+ALTER TABLE cinemas DROP CONSTRAINT IF EXISTS chk_cinemas_tax_rate;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS tax_rate;
+
+ALTER TABLE payment_methods DROP CONSTRAINT IF EXISTS chk_payment_methods_convenience_fee;
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS convenience_fee;
@@ -0,0 +1,17 @@
+-- Override per payment method / cinema; NULL berarti pakai default dari config
+ALTER TABLE payment_methods ADD COLUMN IF NOT EXISTS convenience_fee NUMERIC(10,2);
+ALTER TABLE payment_methods ADD CONSTRAINT chk_payment_methods_convenience_fee
+    CHECK (convenience_fee IS NULL OR convenience_fee >= 0);
+
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS tax_rate NUMERIC(5,4);
+ALTER TABLE cinemas ADD CONSTRAINT chk_cinemas_tax_rate
+    CHECK (tax_rate IS NULL OR tax_rate BETWEEN 0 AND 1);
+
+-- Rincian harga disimpan di booking; total_price = base_price - discount_amount + fee_amount + tax_amount
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS base_price NUMERIC(10,2) NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS fee_amount NUMERIC(10,2) NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS tax_amount NUMERIC(10,2) NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS discount_amount NUMERIC(10,2) NOT NULL DEFAULT 0;
+
+-- Booking lama belum punya fee/tax, seluruh total dianggap harga tiket
+UPDATE bookings SET base_price = total_price WHERE base_price = 0;
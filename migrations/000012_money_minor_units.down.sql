@@ -0,0 +1,14 @@
+ALTER TABLE payment_methods ALTER COLUMN convenience_fee TYPE NUMERIC(10,2) USING convenience_fee / 100.0;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS currency;
+ALTER TABLE payments ALTER COLUMN amount TYPE NUMERIC(10,2) USING amount / 100.0;
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS currency;
+ALTER TABLE bookings ALTER COLUMN discount_amount TYPE NUMERIC(10,2) USING discount_amount / 100.0;
+ALTER TABLE bookings ALTER COLUMN tax_amount TYPE NUMERIC(10,2) USING tax_amount / 100.0;
+ALTER TABLE bookings ALTER COLUMN fee_amount TYPE NUMERIC(10,2) USING fee_amount / 100.0;
+ALTER TABLE bookings ALTER COLUMN base_price TYPE NUMERIC(10,2) USING base_price / 100.0;
+ALTER TABLE bookings ALTER COLUMN total_price TYPE NUMERIC(10,2) USING total_price / 100.0;
+
+ALTER TABLE schedules DROP COLUMN IF EXISTS currency;
+ALTER TABLE schedules ALTER COLUMN price TYPE NUMERIC(10,2) USING price / 100.0;
@@ -0,0 +1,16 @@
+-- Nominal uang disimpan sebagai integer minor unit (ISO 4217, IDR/USD = 2 digit)
+-- supaya tidak ada rounding error float. Data lama dianggap IDR.
+ALTER TABLE schedules ALTER COLUMN price TYPE BIGINT USING ROUND(price * 100)::BIGINT;
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE bookings ALTER COLUMN total_price TYPE BIGINT USING ROUND(total_price * 100)::BIGINT;
+ALTER TABLE bookings ALTER COLUMN base_price TYPE BIGINT USING ROUND(base_price * 100)::BIGINT;
+ALTER TABLE bookings ALTER COLUMN fee_amount TYPE BIGINT USING ROUND(fee_amount * 100)::BIGINT;
+ALTER TABLE bookings ALTER COLUMN tax_amount TYPE BIGINT USING ROUND(tax_amount * 100)::BIGINT;
+ALTER TABLE bookings ALTER COLUMN discount_amount TYPE BIGINT USING ROUND(discount_amount * 100)::BIGINT;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE payments ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 100)::BIGINT;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE payment_methods ALTER COLUMN convenience_fee TYPE BIGINT USING ROUND(convenience_fee * 100)::BIGINT;
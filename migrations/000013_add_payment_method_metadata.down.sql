@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_payment_methods_name_unique;
+
+ALTER TABLE payment_methods DROP CONSTRAINT IF EXISTS chk_payment_methods_fee_percent;
+ALTER TABLE payment_methods DROP CONSTRAINT IF EXISTS chk_payment_methods_payment_type;
+
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS fee_percent;
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS instructions;
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS logo_url;
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS payment_type;
@@ -0,0 +1,13 @@
+ALTER TABLE payment_methods ADD COLUMN IF NOT EXISTS payment_type VARCHAR(20) NOT NULL DEFAULT 'other';
+ALTER TABLE payment_methods ADD COLUMN IF NOT EXISTS logo_url TEXT;
+ALTER TABLE payment_methods ADD COLUMN IF NOT EXISTS instructions TEXT;
+-- Fee persentase dari harga tiket, ditambahkan ke convenience_fee per kursi
+ALTER TABLE payment_methods ADD COLUMN IF NOT EXISTS fee_percent NUMERIC(5,4);
+
+ALTER TABLE payment_methods ADD CONSTRAINT chk_payment_methods_payment_type
+    CHECK (payment_type IN ('e_wallet', 'virtual_account', 'card', 'qris', 'other'));
+ALTER TABLE payment_methods ADD CONSTRAINT chk_payment_methods_fee_percent
+    CHECK (fee_percent IS NULL OR fee_percent BETWEEN 0 AND 1);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_methods_name_unique
+    ON payment_methods (LOWER(name)) WHERE deleted_at IS NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS uq_payments_booking_pending;
+DROP INDEX IF EXISTS idx_payments_pending_expiry;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS paid_at;
+ALTER TABLE payments DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE payments DROP COLUMN IF EXISTS payment_code;
@@ -0,0 +1,14 @@
+-- VA / QRIS: payment dibuat pending dengan kode bayar, difinalisasi lewat webhook gateway
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS payment_code TEXT;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS paid_at TIMESTAMP;
+
+UPDATE payments SET paid_at = updated_at WHERE status = 'completed' AND paid_at IS NULL;
+
+-- Worker expiry hanya scan payment pending yang punya batas waktu
+CREATE INDEX IF NOT EXISTS idx_payments_pending_expiry
+    ON payments(expires_at) WHERE status = 'pending' AND deleted_at IS NULL;
+
+-- Maksimal satu payment pending per booking
+CREATE UNIQUE INDEX IF NOT EXISTS uq_payments_booking_pending
+    ON payments(booking_id) WHERE status = 'pending' AND deleted_at IS NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS uq_booking_seats_ticket_code;
+
+ALTER TABLE booking_seats DROP COLUMN IF EXISTS is_blocked;
+ALTER TABLE booking_seats DROP COLUMN IF EXISTS ticket_code;
+
+DROP TABLE IF EXISTS group_bookings;
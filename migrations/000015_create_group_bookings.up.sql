@@ -0,0 +1,16 @@
+-- Block booking untuk event (school screening, corporate rental); kursi dan harga tetap di bookings
+CREATE TABLE IF NOT EXISTS group_bookings (
+    booking_id      UUID PRIMARY KEY REFERENCES bookings(id) ON DELETE CASCADE,
+    group_name      VARCHAR(150) NOT NULL,
+    notes           TEXT,
+    created_by      UUID         NOT NULL REFERENCES users(id),
+    block_remaining BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+-- Ticket per kursi group booking; kursi is_blocked hanya menutup penjualan, tanpa ticket
+ALTER TABLE booking_seats ADD COLUMN IF NOT EXISTS ticket_code VARCHAR(50);
+ALTER TABLE booking_seats ADD COLUMN IF NOT EXISTS is_blocked BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_booking_seats_ticket_code
+    ON booking_seats(ticket_code) WHERE ticket_code IS NOT NULL;
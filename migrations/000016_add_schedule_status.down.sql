@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_schedules_hall_date_status;
+
+ALTER TABLE schedules DROP COLUMN IF EXISTS published_at;
+ALTER TABLE schedules DROP COLUMN IF EXISTS status;
@@ -0,0 +1,12 @@
+-- Schedule dibuat sebagai draft, baru terlihat publik setelah di-publish admin.
+-- Schedule yang sudah ada dianggap published supaya tidak hilang dari listing.
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'published'
+    CHECK (status IN ('draft', 'published'));
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS published_at TIMESTAMP;
+
+UPDATE schedules SET published_at = created_at WHERE status = 'published' AND published_at IS NULL;
+
+ALTER TABLE schedules ALTER COLUMN status SET DEFAULT 'draft';
+
+CREATE INDEX IF NOT EXISTS idx_schedules_hall_date_status
+    ON schedules(hall_id, show_date, status) WHERE deleted_at IS NULL;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_movies_release_automation;
+
+ALTER TABLE movies DROP COLUMN IF EXISTS release_status_locked;
+
+-- Value enum tidak bisa dihapus; movie ended dikembalikan ke now_playing
+UPDATE movies SET release_status = 'now_playing' WHERE release_status = 'ended';
+
+ALTER TABLE movies DROP CONSTRAINT IF EXISTS chk_movies_release_status;
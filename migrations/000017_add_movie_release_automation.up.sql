@@ -0,0 +1,34 @@
+-- Status 'ended' untuk movie yang sudah tidak punya jadwal ke depan.
+-- Kolom release_status bisa berupa enum atau varchar + CHECK tergantung umur database.
+DO $$
+DECLARE
+    status_type TEXT;
+    constraint_name TEXT;
+BEGIN
+    SELECT t.typname INTO status_type
+    FROM pg_attribute a
+    JOIN pg_type t ON t.oid = a.atttypid
+    WHERE a.attrelid = 'movies'::regclass AND a.attname = 'release_status' AND t.typtype = 'e';
+
+    IF status_type IS NOT NULL THEN
+        EXECUTE format('ALTER TYPE %I ADD VALUE IF NOT EXISTS %L', status_type, 'ended');
+    ELSE
+        FOR constraint_name IN
+            SELECT c.conname
+            FROM pg_constraint c
+            WHERE c.conrelid = 'movies'::regclass AND c.contype = 'c'
+              AND pg_get_constraintdef(c.oid) LIKE '%release_status%'
+        LOOP
+            EXECUTE format('ALTER TABLE movies DROP CONSTRAINT %I', constraint_name);
+        END LOOP;
+
+        ALTER TABLE movies ADD CONSTRAINT chk_movies_release_status
+            CHECK (release_status IN ('coming_soon', 'now_playing', 'ended'));
+    END IF;
+END $$;
+
+-- Movie yang status-nya di-override admin dilewati oleh job otomatis
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS release_status_locked BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS idx_movies_release_automation
+    ON movies(release_status, release_date) WHERE deleted_at IS NULL AND NOT release_status_locked;
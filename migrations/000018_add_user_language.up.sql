@@ -0,0 +1,4 @@
+-- Bahasa untuk email / push notification; request API tetap mengikuti Accept-Language
+ALTER TABLE users ADD COLUMN IF NOT EXISTS language VARCHAR(5) NOT NULL DEFAULT 'en';
+
+ALTER TABLE users ADD CONSTRAINT chk_users_language CHECK (language IN ('en', 'id'));
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_schedules_starts_at;
+
+ALTER TABLE schedules DROP COLUMN IF EXISTS starts_at;
+
+ALTER TABLE cinemas DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,20 @@
+-- Zona waktu IANA per cinema; show_date/show_time tetap jam dinding lokal cinema
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- starts_at adalah waktu mulai show dalam UTC, dipakai untuk semua perbandingan "sudah mulai atau belum"
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS starts_at TIMESTAMPTZ;
+
+UPDATE schedules s
+SET starts_at = (s.show_date + s.show_time) AT TIME ZONE c.timezone
+FROM halls h
+JOIN cinemas c ON c.id = h.cinema_id
+WHERE h.id = s.hall_id AND s.starts_at IS NULL;
+
+-- Schedule yatim (hall/cinema sudah tidak ada) dianggap memakai default Asia/Jakarta
+UPDATE schedules
+SET starts_at = (show_date + show_time) AT TIME ZONE 'Asia/Jakarta'
+WHERE starts_at IS NULL;
+
+ALTER TABLE schedules ALTER COLUMN starts_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_schedules_starts_at ON schedules (starts_at) WHERE deleted_at IS NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS review_replies;
+
+-- Value enum tidak bisa dihapus; akun staff dikembalikan ke customer
+UPDATE users SET role = 'customer' WHERE role = 'staff';
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_role;
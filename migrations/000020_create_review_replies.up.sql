@@ -0,0 +1,38 @@
+-- Role 'staff' untuk akun cinema yang boleh membalas review.
+-- Kolom role bisa berupa enum atau varchar + CHECK tergantung umur database.
+DO $$
+DECLARE
+    role_type TEXT;
+    constraint_name TEXT;
+BEGIN
+    SELECT t.typname INTO role_type
+    FROM pg_attribute a
+    JOIN pg_type t ON t.oid = a.atttypid
+    WHERE a.attrelid = 'users'::regclass AND a.attname = 'role' AND t.typtype = 'e';
+
+    IF role_type IS NOT NULL THEN
+        EXECUTE format('ALTER TYPE %I ADD VALUE IF NOT EXISTS %L', role_type, 'staff');
+    ELSE
+        FOR constraint_name IN
+            SELECT c.conname
+            FROM pg_constraint c
+            WHERE c.conrelid = 'users'::regclass AND c.contype = 'c'
+              AND pg_get_constraintdef(c.oid) LIKE '%role%'
+        LOOP
+            EXECUTE format('ALTER TABLE users DROP CONSTRAINT %I', constraint_name);
+        END LOOP;
+
+        ALTER TABLE users ADD CONSTRAINT chk_users_role
+            CHECK (role IN ('customer', 'admin', 'staff'));
+    END IF;
+END $$;
+
+-- Balasan resmi cinema, maksimal satu per review
+CREATE TABLE IF NOT EXISTS review_replies (
+    id         UUID PRIMARY KEY,
+    review_id  UUID      NOT NULL UNIQUE REFERENCES reviews(id) ON DELETE CASCADE,
+    author_id  UUID      NOT NULL REFERENCES users(id),
+    message    TEXT      NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_reviews_reported;
+
+DROP TABLE IF EXISTS review_reports;
+
+ALTER TABLE reviews DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE reviews DROP COLUMN IF EXISTS report_count;
@@ -0,0 +1,19 @@
+-- Report review oleh user; review disembunyikan otomatis setelah melewati threshold sampai dimoderasi admin
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS report_count INT NOT NULL DEFAULT 0;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS review_reports (
+    id          UUID PRIMARY KEY,
+    review_id   UUID        NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    reporter_id UUID        NOT NULL REFERENCES users(id),
+    reason      VARCHAR(20) NOT NULL
+        CHECK (reason IN ('spam', 'offensive', 'spoiler', 'harassment', 'off_topic', 'other')),
+    details     TEXT,
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    -- Di-set saat admin meninjau review; report lama tidak dihitung lagi
+    resolved_at TIMESTAMP,
+    UNIQUE (review_id, reporter_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_reported
+    ON reviews(report_count DESC, created_at DESC) WHERE report_count > 0 AND deleted_at IS NULL;
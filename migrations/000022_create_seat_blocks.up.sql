@@ -0,0 +1,12 @@
+-- Blokir kursi untuk satu schedule (mis. jaga jarak); blokir permanen per hall tetap lewat seats.is_available
+CREATE TABLE IF NOT EXISTS seat_blocks (
+    id          UUID PRIMARY KEY,
+    schedule_id UUID        NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    seat_id     UUID        NOT NULL REFERENCES seats(id) ON DELETE CASCADE,
+    reason      VARCHAR(20) NOT NULL
+        CHECK (reason IN ('maintenance', 'distancing', 'other')),
+    note        TEXT,
+    blocked_by  UUID        REFERENCES users(id) ON DELETE SET NULL,
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    UNIQUE (schedule_id, seat_id)
+);
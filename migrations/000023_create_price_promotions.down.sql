@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS price_promotions;
+ALTER TABLE schedules DROP COLUMN IF EXISTS price_override;
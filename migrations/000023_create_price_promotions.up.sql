@@ -0,0 +1,23 @@
+-- Harga khusus satu schedule, menggantikan harga setelah multiplier hall
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS price_override BIGINT CHECK (price_override > 0);
+
+-- Promo jam tertentu (mis. weekday pagi); weekdays 0 = Minggu, kosong berarti setiap hari
+CREATE TABLE IF NOT EXISTS price_promotions (
+    id               UUID PRIMARY KEY,
+    name             VARCHAR(100)  NOT NULL,
+    discount_percent NUMERIC(5, 4) NOT NULL CHECK (discount_percent > 0 AND discount_percent <= 1),
+    weekdays         SMALLINT[]    NOT NULL DEFAULT '{}',
+    start_time       TIME          NOT NULL,
+    end_time         TIME          NOT NULL,
+    valid_from       DATE,
+    valid_until      DATE,
+    cinema_id        UUID          REFERENCES cinemas(id) ON DELETE CASCADE,
+    is_active        BOOLEAN       NOT NULL DEFAULT TRUE,
+    created_at       TIMESTAMP     NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP     NOT NULL DEFAULT NOW(),
+    deleted_at       TIMESTAMP,
+    CHECK (end_time > start_time),
+    CHECK (valid_until IS NULL OR valid_from IS NULL OR valid_until >= valid_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_promotions_active ON price_promotions(is_active) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS organization_id;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,18 @@
+-- Chain bioskop yang memiliki beberapa cinema; admin dengan organization_id hanya mengelola cinema chain-nya
+CREATE TABLE IF NOT EXISTS organizations (
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(100) NOT NULL,
+    slug       VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organizations_slug ON organizations(slug) WHERE deleted_at IS NULL;
+
+-- NULL = dikelola langsung oleh platform
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_cinemas_organization_id ON cinemas(organization_id);
+
+-- Hanya berarti untuk role admin; admin tanpa organization_id adalah admin platform
+ALTER TABLE users ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE SET NULL;
@@ -0,0 +1,34 @@
+-- Partner mendaftarkan URL untuk event outbox tertentu; secret dipakai menandatangani setiap delivery
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id          UUID PRIMARY KEY,
+    name        VARCHAR(100) NOT NULL,
+    url         VARCHAR(500) NOT NULL,
+    secret      VARCHAR(100) NOT NULL,
+    event_types TEXT[]       NOT NULL,
+    is_active   BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMP
+);
+
+-- Satu baris per (subscription, event), dibuat di tx yang sama dengan event outbox-nya
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id               UUID PRIMARY KEY,
+    subscription_id  UUID         NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id         UUID         NOT NULL,
+    event_type       VARCHAR(100) NOT NULL,
+    payload          JSONB        NOT NULL,
+    status           VARCHAR(20)  NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts         INT          NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    last_attempt_at  TIMESTAMP,
+    last_status_code INT,
+    last_error       TEXT,
+    delivered_at     TIMESTAMP,
+    created_at       TIMESTAMP    NOT NULL DEFAULT NOW(),
+    UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, created_at DESC);
@@ -0,0 +1,18 @@
+-- Banner home screen app; tampil hanya selama [starts_at, ends_at) dan city cocok (city NULL = semua kota)
+CREATE TABLE IF NOT EXISTS banners (
+    id         UUID PRIMARY KEY,
+    title      VARCHAR(100)  NOT NULL,
+    image_url  VARCHAR(2048) NOT NULL,
+    link_url   VARCHAR(2048),
+    city       VARCHAR(100),
+    starts_at  TIMESTAMPTZ   NOT NULL,
+    ends_at    TIMESTAMPTZ,
+    sort_order INTEGER       NOT NULL DEFAULT 0,
+    is_active  BOOLEAN       NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP     NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP     NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP,
+    CHECK (ends_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_banners_live ON banners(starts_at, ends_at) WHERE is_active = TRUE AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE payments DROP COLUMN IF EXISTS gift_card_amount;
+DROP TABLE IF EXISTS gift_card_transactions;
+DROP TABLE IF EXISTS gift_cards;
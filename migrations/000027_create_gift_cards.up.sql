@@ -0,0 +1,32 @@
+-- Gift card / saldo tersimpan. Kode disimpan uppercase tanpa tanda hubung, nominal dalam minor unit.
+-- user_id terisi setelah kode di-redeem; sejak itu saldo hanya bisa dipakai pemilik akun.
+CREATE TABLE IF NOT EXISTS gift_cards (
+    id             UUID PRIMARY KEY,
+    code           VARCHAR(32) NOT NULL UNIQUE,
+    initial_amount BIGINT      NOT NULL CHECK (initial_amount > 0),
+    balance        BIGINT      NOT NULL CHECK (balance >= 0),
+    currency       CHAR(3)     NOT NULL,
+    user_id        UUID        REFERENCES users(id) ON DELETE SET NULL,
+    redeemed_at    TIMESTAMP,
+    expires_at     TIMESTAMP,
+    is_active      BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at     TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP   NOT NULL DEFAULT NOW(),
+    CHECK (balance <= initial_amount)
+);
+
+CREATE INDEX IF NOT EXISTS idx_gift_cards_user ON gift_cards(user_id) WHERE user_id IS NOT NULL;
+
+-- Ledger perubahan saldo: amount negatif dipakai bayar, positif dikembalikan (payment gagal / expired)
+CREATE TABLE IF NOT EXISTS gift_card_transactions (
+    id           UUID PRIMARY KEY,
+    gift_card_id UUID      NOT NULL REFERENCES gift_cards(id) ON DELETE CASCADE,
+    payment_id   UUID      REFERENCES payments(id) ON DELETE SET NULL,
+    amount       BIGINT    NOT NULL CHECK (amount <> 0),
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gift_card_transactions_payment ON gift_card_transactions(payment_id);
+
+-- Bagian total booking yang dibayar dari saldo gift card; payments.amount tinggal porsi payment method
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS gift_card_amount BIGINT NOT NULL DEFAULT 0 CHECK (gift_card_amount >= 0);
@@ -0,0 +1,2 @@
+ALTER TABLE users DROP COLUMN IF EXISTS date_of_birth;
+ALTER TABLE movies DROP COLUMN IF EXISTS age_rating;
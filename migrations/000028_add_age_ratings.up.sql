@@ -0,0 +1,6 @@
+-- Klasifikasi usia film (LSF): SU semua umur, 13+, 17+, R dewasa (21+)
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS age_rating VARCHAR(3) NOT NULL DEFAULT 'SU'
+    CHECK (age_rating IN ('SU', '13+', '17+', 'R'));
+
+-- Tanggal lahir pemilik akun, dipakai untuk cek klasifikasi usia saat booking
+ALTER TABLE users ADD COLUMN IF NOT EXISTS date_of_birth DATE;
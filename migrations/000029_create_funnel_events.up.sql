@@ -0,0 +1,12 @@
+-- Event funnel checkout: seat_view -> hold -> booking -> payment. Movie diambil lewat schedule saat laporan,
+-- jadi insert di jalur booking tidak perlu lookup tambahan. user_id kosong untuk pengunjung anonim.
+CREATE TABLE IF NOT EXISTS funnel_events (
+    id          UUID PRIMARY KEY,
+    step        VARCHAR(16) NOT NULL CHECK (step IN ('seat_view', 'hold', 'booking', 'payment')),
+    schedule_id UUID        NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    user_id     UUID        REFERENCES users(id) ON DELETE SET NULL,
+    booking_id  UUID        REFERENCES bookings(id) ON DELETE CASCADE,
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_funnel_events_created ON funnel_events(created_at);
@@ -0,0 +1,20 @@
+-- Permintaan export data pribadi user. File disimpan di disk server (DATA_EXPORT_DIR) sampai expires_at,
+-- baris tetap disimpan sebagai log untuk admin walaupun file-nya sudah dihapus.
+CREATE TABLE IF NOT EXISTS data_exports (
+    id            UUID PRIMARY KEY,
+    user_id       UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    format        VARCHAR(8)  NOT NULL CHECK (format IN ('json', 'zip')),
+    status        VARCHAR(16) NOT NULL DEFAULT 'pending'
+                  CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'expired')),
+    file_path     TEXT,
+    size_bytes    BIGINT,
+    error         TEXT,
+    completed_at  TIMESTAMP,
+    expires_at    TIMESTAMP,
+    downloaded_at TIMESTAMP,
+    created_at    TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_data_exports_user ON data_exports(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_data_exports_pending ON data_exports(created_at) WHERE status IN ('pending', 'processing');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS audit_logs;
+ALTER TABLE sessions DROP COLUMN IF EXISTS impersonator_id;
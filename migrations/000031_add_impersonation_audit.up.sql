@@ -0,0 +1,18 @@
+-- Session impersonation: dibuat admin untuk debugging support, user_id = user yang ditiru
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS impersonator_id UUID REFERENCES users(id);
+
+-- Audit log aksi sensitif admin; append-only, tidak pernah di-update atau dihapus aplikasi
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id          UUID PRIMARY KEY,
+    actor_id    UUID        NOT NULL REFERENCES users(id),
+    action      VARCHAR(64) NOT NULL,
+    target_type VARCHAR(32) NOT NULL,
+    target_id   UUID,
+    metadata    JSONB       NOT NULL DEFAULT '{}',
+    ip_address  VARCHAR(64),
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created ON audit_logs(created_at DESC, id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor ON audit_logs(actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target ON audit_logs(target_type, target_id);
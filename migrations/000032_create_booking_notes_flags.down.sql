@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS booking_flags;
+DROP TABLE IF EXISTS booking_notes;
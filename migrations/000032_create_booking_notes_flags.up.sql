@@ -0,0 +1,22 @@
+-- Catatan internal support per booking; tidak pernah tampil ke pemilik booking
+CREATE TABLE IF NOT EXISTS booking_notes (
+    id         UUID PRIMARY KEY,
+    booking_id UUID      NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    author_id  UUID      NOT NULL REFERENCES users(id),
+    body       TEXT      NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_notes_booking ON booking_notes(booking_id, created_at);
+
+-- Penanda booking untuk support (sengketa, chargeback, pelanggan VIP); satu baris per flag
+CREATE TABLE IF NOT EXISTS booking_flags (
+    booking_id UUID        NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    flag       VARCHAR(16) NOT NULL CHECK (flag IN ('disputed', 'chargeback', 'vip')),
+    created_by UUID        NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP   NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (booking_id, flag)
+);
+
+-- Filter list admin ?flag=
+CREATE INDEX IF NOT EXISTS idx_booking_flags_flag ON booking_flags(flag, booking_id);
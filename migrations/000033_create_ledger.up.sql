@@ -0,0 +1,124 @@
+-- Double-entry ledger: satu transaksi per kejadian keuangan (payment, refund, gift card), tiap transaksi
+-- punya minimal dua entry dengan total debit = total credit. Append-only; koreksi dicatat sebagai transaksi baru.
+-- (kind, reference_id) unik supaya retry webhook / worker tidak memposting kejadian yang sama dua kali.
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+    id           UUID PRIMARY KEY,
+    kind         VARCHAR(32) NOT NULL,
+    reference_id UUID        NOT NULL,
+    booking_id   UUID        REFERENCES bookings(id) ON DELETE SET NULL,
+    currency     CHAR(3)     NOT NULL,
+    description  TEXT,
+    occurred_at  TIMESTAMP   NOT NULL,
+    created_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, reference_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_transactions_occurred ON ledger_transactions(occurred_at);
+CREATE INDEX IF NOT EXISTS idx_ledger_transactions_booking ON ledger_transactions(booking_id) WHERE booking_id IS NOT NULL;
+
+-- Nominal minor unit currency transaksi; satu entry hanya debit atau credit
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id             UUID PRIMARY KEY,
+    transaction_id UUID        NOT NULL REFERENCES ledger_transactions(id),
+    account        VARCHAR(32) NOT NULL,
+    debit          BIGINT      NOT NULL DEFAULT 0 CHECK (debit >= 0),
+    credit         BIGINT      NOT NULL DEFAULT 0 CHECK (credit >= 0),
+    CHECK ((debit > 0) <> (credit > 0))
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_transaction ON ledger_entries(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account ON ledger_entries(account);
+
+-- ==================== BACKFILL ====================
+-- Data lama diposting ulang dengan aturan yang sama seperti aplikasi, supaya report dan pengecekan
+-- invariant langsung berlaku untuk histori. Payment failed / expired tidak diposting: potongan
+-- saldo gift card-nya sudah dikembalikan, jadi net nol.
+
+INSERT INTO ledger_transactions (id, kind, reference_id, booking_id, currency, occurred_at)
+SELECT gen_random_uuid(), 'gift_card_issue', g.id, NULL, g.currency, g.created_at
+FROM gift_cards g
+ON CONFLICT (kind, reference_id) DO NOTHING;
+
+INSERT INTO ledger_transactions (id, kind, reference_id, booking_id, currency, occurred_at)
+SELECT gen_random_uuid(), 'payment_gift_hold', p.id, p.booking_id, p.currency, p.created_at
+FROM payments p
+WHERE p.gift_card_amount > 0 AND p.status IN ('pending', 'completed')
+ON CONFLICT (kind, reference_id) DO NOTHING;
+
+INSERT INTO ledger_transactions (id, kind, reference_id, booking_id, currency, occurred_at)
+SELECT gen_random_uuid(), 'payment_completed', p.id, p.booking_id, p.currency, COALESCE(p.paid_at, p.updated_at)
+FROM payments p
+WHERE p.status = 'completed'
+ON CONFLICT (kind, reference_id) DO NOTHING;
+
+INSERT INTO ledger_transactions (id, kind, reference_id, booking_id, currency, occurred_at)
+SELECT gen_random_uuid(), 'booking_refund', b.id, b.id, b.currency, b.updated_at
+FROM bookings b
+WHERE b.status = 'cancelled'
+  AND EXISTS (SELECT 1 FROM payments p WHERE p.booking_id = b.id AND p.status = 'completed')
+ON CONFLICT (kind, reference_id) DO NOTHING;
+
+INSERT INTO ledger_entries (id, transaction_id, account, debit, credit)
+SELECT gen_random_uuid(), t.id, e.account, e.debit, e.credit
+FROM ledger_transactions t
+INNER JOIN gift_cards g ON g.id = t.reference_id
+CROSS JOIN LATERAL (VALUES
+    ('cash', g.initial_amount, 0::BIGINT),
+    ('gift_card_liability', 0::BIGINT, g.initial_amount)
+) AS e(account, debit, credit)
+WHERE t.kind = 'gift_card_issue';
+
+INSERT INTO ledger_entries (id, transaction_id, account, debit, credit)
+SELECT gen_random_uuid(), t.id, e.account, e.debit, e.credit
+FROM ledger_transactions t
+INNER JOIN payments p ON p.id = t.reference_id
+CROSS JOIN LATERAL (VALUES
+    ('gift_card_liability', p.gift_card_amount, 0::BIGINT),
+    ('payment_clearing', 0::BIGINT, p.gift_card_amount)
+) AS e(account, debit, credit)
+WHERE t.kind = 'payment_gift_hold';
+
+-- Seperti reconcilePaidCharges, fee mengikuti nominal yang benar-benar dibayar: selisih payment dengan
+-- rincian booking (mis. fee payment method yang berbeda dari saat booking dibuat) masuk ke fee_revenue.
+-- Fee negatif dicatat sebagai debit, jadi total debit dan credit selalu sama.
+INSERT INTO ledger_entries (id, transaction_id, account, debit, credit)
+SELECT gen_random_uuid(), t.id, e.account, e.debit, e.credit
+FROM ledger_transactions t
+INNER JOIN payments p ON p.id = t.reference_id
+INNER JOIN bookings b ON b.id = p.booking_id
+CROSS JOIN LATERAL (
+    SELECT p.amount + p.gift_card_amount - b.base_price + b.discount_amount - b.tax_amount AS fee
+) AS c
+CROSS JOIN LATERAL (VALUES
+    ('cash', p.amount, 0::BIGINT),
+    ('payment_clearing', p.gift_card_amount, 0::BIGINT),
+    ('discounts', b.discount_amount, 0::BIGINT),
+    ('ticket_revenue', 0::BIGINT, b.base_price),
+    ('fee_revenue', GREATEST(-c.fee, 0), GREATEST(c.fee, 0)),
+    ('tax_payable', 0::BIGINT, b.tax_amount)
+) AS e(account, debit, credit)
+WHERE t.kind = 'payment_completed' AND e.debit + e.credit > 0;
+
+-- Refund membalik entry payment_completed di atas: yang dikembalikan adalah yang dibayar, bukan total_price
+INSERT INTO ledger_entries (id, transaction_id, account, debit, credit)
+SELECT gen_random_uuid(), t.id, e.account, e.debit, e.credit
+FROM ledger_transactions t
+INNER JOIN bookings b ON b.id = t.reference_id
+CROSS JOIN LATERAL (
+    SELECT p.amount + p.gift_card_amount AS paid
+    FROM payments p
+    WHERE p.booking_id = b.id AND p.status = 'completed'
+    ORDER BY p.paid_at DESC NULLS LAST
+    LIMIT 1
+) AS pay
+CROSS JOIN LATERAL (
+    SELECT pay.paid - b.base_price + b.discount_amount - b.tax_amount AS fee
+) AS c
+CROSS JOIN LATERAL (VALUES
+    ('ticket_revenue', b.base_price, 0::BIGINT),
+    ('fee_revenue', GREATEST(c.fee, 0), GREATEST(-c.fee, 0)),
+    ('tax_payable', b.tax_amount, 0::BIGINT),
+    ('discounts', 0::BIGINT, b.discount_amount),
+    ('refunds_payable', 0::BIGINT, pay.paid)
+) AS e(account, debit, credit)
+WHERE t.kind = 'booking_refund' AND e.debit + e.credit > 0;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_bookings_order_id_unique;
+
+ALTER TABLE organizations DROP CONSTRAINT IF EXISTS chk_organizations_order_prefix;
+ALTER TABLE organizations DROP COLUMN IF EXISTS order_prefix;
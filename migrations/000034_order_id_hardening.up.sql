@@ -0,0 +1,17 @@
+-- Prefix order ID per chain (mis. "XXI"); NULL = pakai BOOKING_ORDER_ID_PREFIX
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS order_prefix VARCHAR(8);
+ALTER TABLE organizations ADD CONSTRAINT chk_organizations_order_prefix
+    CHECK (order_prefix IS NULL OR order_prefix ~ '^[A-Z0-9]{2,8}$');
+
+-- Generator lama hanya 4 digit acak per detik, jadi duplikat mungkin sudah ada. Booking paling awal
+-- mempertahankan nomornya, sisanya diberi suffix dari id supaya unique index bisa dibuat.
+UPDATE bookings b
+SET order_id = b.order_id || '-' || UPPER(SUBSTRING(REPLACE(b.id::text, '-', '') FROM 1 FOR 6))
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY order_id ORDER BY created_at, id) AS rn
+    FROM bookings
+) dup
+WHERE dup.id = b.id AND dup.rn > 1;
+
+-- Non-partial (termasuk booking soft delete) supaya bisa jadi target ON CONFLICT (order_id)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookings_order_id_unique ON bookings(order_id);
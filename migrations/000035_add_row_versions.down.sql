@@ -0,0 +1,2 @@
+ALTER TABLE payments DROP COLUMN IF EXISTS version;
+ALTER TABLE bookings DROP COLUMN IF EXISTS version;
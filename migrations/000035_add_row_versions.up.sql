@@ -0,0 +1,3 @@
+-- Optimistic locking: setiap UPDATE lewat repository menaikkan version dan mensyaratkan version lama
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
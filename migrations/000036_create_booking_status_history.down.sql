@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS booking_status_history;
+
+-- Value enum tidak bisa dihapus; status baru dipetakan ke status lama yang paling dekat
+UPDATE bookings SET status = 'confirmed' WHERE status = 'checked_in';
+UPDATE bookings SET status = 'cancelled' WHERE status = 'refunded';
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS chk_bookings_status;
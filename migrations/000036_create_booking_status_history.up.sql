@@ -0,0 +1,42 @@
+-- Status baru: checked_in (tiket discan di pintu studio) dan refunded (dana dikembalikan admin).
+-- Kolom status bisa enum atau varchar + CHECK tergantung umur database, sama seperti movies.release_status.
+DO $$
+DECLARE
+    status_type TEXT;
+    constraint_name TEXT;
+BEGIN
+    SELECT t.typname INTO status_type
+    FROM pg_attribute a
+    JOIN pg_type t ON t.oid = a.atttypid
+    WHERE a.attrelid = 'bookings'::regclass AND a.attname = 'status' AND t.typtype = 'e';
+
+    IF status_type IS NOT NULL THEN
+        EXECUTE format('ALTER TYPE %I ADD VALUE IF NOT EXISTS %L', status_type, 'checked_in');
+        EXECUTE format('ALTER TYPE %I ADD VALUE IF NOT EXISTS %L', status_type, 'refunded');
+    ELSE
+        FOR constraint_name IN
+            SELECT c.conname
+            FROM pg_constraint c
+            WHERE c.conrelid = 'bookings'::regclass AND c.contype = 'c'
+              AND pg_get_constraintdef(c.oid) LIKE '%status%'
+        LOOP
+            EXECUTE format('ALTER TABLE bookings DROP CONSTRAINT %I', constraint_name);
+        END LOOP;
+
+        ALTER TABLE bookings ADD CONSTRAINT chk_bookings_status
+            CHECK (status IN ('pending', 'confirmed', 'cancelled', 'expired', 'checked_in', 'refunded'));
+    END IF;
+END $$;
+
+-- Riwayat perpindahan status; satu baris per transisi, ditulis di tx yang sama dengan update status
+CREATE TABLE IF NOT EXISTS booking_status_history (
+    id          UUID PRIMARY KEY,
+    booking_id  UUID        NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    from_status VARCHAR(16) NOT NULL,
+    to_status   VARCHAR(16) NOT NULL,
+    actor_id    UUID        REFERENCES users(id) ON DELETE SET NULL,
+    reason      TEXT,
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_status_history_booking ON booking_status_history(booking_id, created_at);
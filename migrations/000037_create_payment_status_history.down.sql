@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS payment_status_history;
+
+-- Value enum tidak bisa dihapus; payment yang sedang diproses kembali pending, yang di-refund tetap tercatat completed
+UPDATE payments SET status = 'pending' WHERE status = 'processing';
+UPDATE payments SET status = 'completed' WHERE status IN ('refunded', 'partially_refunded');
+
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS chk_payments_status;
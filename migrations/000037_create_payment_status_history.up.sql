@@ -0,0 +1,42 @@
+-- Status baru: processing (gateway sedang memproses), refunded dan partially_refunded.
+-- Sama seperti bookings.status, kolomnya bisa enum atau varchar + CHECK.
+DO $$
+DECLARE
+    status_type TEXT;
+    constraint_name TEXT;
+BEGIN
+    SELECT t.typname INTO status_type
+    FROM pg_attribute a
+    JOIN pg_type t ON t.oid = a.atttypid
+    WHERE a.attrelid = 'payments'::regclass AND a.attname = 'status' AND t.typtype = 'e';
+
+    IF status_type IS NOT NULL THEN
+        EXECUTE format('ALTER TYPE %I ADD VALUE IF NOT EXISTS %L', status_type, 'processing');
+        EXECUTE format('ALTER TYPE %I ADD VALUE IF NOT EXISTS %L', status_type, 'refunded');
+        EXECUTE format('ALTER TYPE %I ADD VALUE IF NOT EXISTS %L', status_type, 'partially_refunded');
+    ELSE
+        FOR constraint_name IN
+            SELECT c.conname
+            FROM pg_constraint c
+            WHERE c.conrelid = 'payments'::regclass AND c.contype = 'c'
+              AND pg_get_constraintdef(c.oid) LIKE '%status%'
+        LOOP
+            EXECUTE format('ALTER TABLE payments DROP CONSTRAINT %I', constraint_name);
+        END LOOP;
+
+        ALTER TABLE payments ADD CONSTRAINT chk_payments_status
+            CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'expired', 'refunded', 'partially_refunded'));
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS payment_status_history (
+    id          UUID PRIMARY KEY,
+    payment_id  UUID        NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    from_status VARCHAR(24) NOT NULL,
+    to_status   VARCHAR(24) NOT NULL,
+    actor_id    UUID        REFERENCES users(id) ON DELETE SET NULL,
+    reason      TEXT,
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_status_history_payment ON payment_status_history(payment_id, created_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS uq_booking_seats_schedule_seat_active;
+
+ALTER TABLE booking_seats DROP COLUMN IF EXISTS released_at;
+ALTER TABLE booking_seats DROP COLUMN IF EXISTS schedule_id;
@@ -0,0 +1,27 @@
+-- Kursi aktif per jadwal dijaga di database, bukan cuma lewat scan di service.
+-- schedule_id didenormalisasi dari bookings supaya bisa masuk unique index; released_at
+-- diisi saat booking berhenti memegang kursi (cancelled, expired, refunded, soft delete).
+ALTER TABLE booking_seats ADD COLUMN IF NOT EXISTS schedule_id UUID REFERENCES schedules(id);
+ALTER TABLE booking_seats ADD COLUMN IF NOT EXISTS released_at TIMESTAMP;
+
+UPDATE booking_seats bs
+SET schedule_id = b.schedule_id
+FROM bookings b
+WHERE b.id = bs.booking_id AND bs.schedule_id IS NULL;
+
+UPDATE booking_seats bs
+SET released_at = COALESCE(b.deleted_at, b.updated_at)
+FROM bookings b
+WHERE b.id = bs.booking_id
+  AND bs.released_at IS NULL
+  AND (b.deleted_at IS NOT NULL OR b.status::text NOT IN ('pending', 'confirmed', 'checked_in'));
+
+ALTER TABLE booking_seats ALTER COLUMN schedule_id SET NOT NULL;
+
+-- Gagal di sini berarti data lama sudah punya kursi dobel. Cari dengan:
+--   SELECT schedule_id, seat_id, COUNT(*) FROM booking_seats WHERE released_at IS NULL
+--   GROUP BY schedule_id, seat_id HAVING COUNT(*) > 1;
+-- lalu batalkan salah satu booking sebelum menjalankan ulang migration ini.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_booking_seats_schedule_seat_active
+    ON booking_seats(schedule_id, seat_id)
+    WHERE released_at IS NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS review_revisions;
+
+ALTER TABLE reviews DROP COLUMN IF EXISTS edited_at;
@@ -0,0 +1,12 @@
+-- Riwayat edit review: setiap edit menyimpan isi sebelumnya, reviews.edited_at menandai edit terakhir
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS review_revisions (
+    id         UUID PRIMARY KEY,
+    review_id  UUID      NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    rating     INT       NOT NULL,
+    comment    TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_revisions_review ON review_revisions(review_id, created_at);
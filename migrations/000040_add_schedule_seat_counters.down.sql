@@ -0,0 +1,4 @@
+ALTER TABLE schedules DROP CONSTRAINT IF EXISTS chk_schedules_booked_seats;
+
+ALTER TABLE schedules DROP COLUMN IF EXISTS booked_seats;
+ALTER TABLE schedules DROP COLUMN IF EXISTS capacity;
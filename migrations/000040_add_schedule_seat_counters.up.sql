@@ -0,0 +1,21 @@
+-- Counter kursi per schedule supaya listing tidak perlu menghitung booking_seats setiap request.
+-- capacity mengikuti halls.total_seats; booked_seats = booking_seats yang belum dilepas (released_at IS NULL).
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS capacity INT NOT NULL DEFAULT 0;
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS booked_seats INT NOT NULL DEFAULT 0;
+
+UPDATE schedules s
+SET capacity = h.total_seats
+FROM halls h
+WHERE h.id = s.hall_id;
+
+UPDATE schedules s
+SET booked_seats = active.seats
+FROM (
+    SELECT schedule_id, COUNT(*) AS seats
+    FROM booking_seats
+    WHERE released_at IS NULL
+    GROUP BY schedule_id
+) active
+WHERE active.schedule_id = s.id;
+
+ALTER TABLE schedules ADD CONSTRAINT chk_schedules_booked_seats CHECK (booked_seats >= 0);
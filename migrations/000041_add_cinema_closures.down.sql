@@ -0,0 +1,10 @@
+UPDATE schedules SET status = 'draft' WHERE status = 'cancelled';
+
+ALTER TABLE schedules DROP CONSTRAINT IF EXISTS schedules_status_check;
+ALTER TABLE schedules ADD CONSTRAINT schedules_status_check
+    CHECK (status IN ('draft', 'published'));
+
+ALTER TABLE cinemas DROP COLUMN IF EXISTS closure_reason;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS closed_until;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS closed_from;
+ALTER TABLE cinemas DROP COLUMN IF EXISTS closure_type;
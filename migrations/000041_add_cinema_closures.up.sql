@@ -0,0 +1,11 @@
+-- Penutupan cinema: temporary punya closed_until (tanggal buka lagi, eksklusif), permanent tidak.
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS closure_type VARCHAR(20)
+    CHECK (closure_type IN ('temporary', 'permanent'));
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS closed_from DATE;
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS closed_until DATE;
+ALTER TABLE cinemas ADD COLUMN IF NOT EXISTS closure_reason TEXT;
+
+-- Schedule di dalam periode tutup dibatalkan; cancelled tidak pernah terlihat publik
+ALTER TABLE schedules DROP CONSTRAINT IF EXISTS schedules_status_check;
+ALTER TABLE schedules ADD CONSTRAINT schedules_status_check
+    CHECK (status IN ('draft', 'published', 'cancelled'));
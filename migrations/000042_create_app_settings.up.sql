@@ -0,0 +1,7 @@
+-- Override runtime untuk nilai yang sebelumnya hanya dari env; key yang tidak ada memakai default config
+CREATE TABLE IF NOT EXISTS app_settings (
+    key        VARCHAR(64) PRIMARY KEY,
+    value      TEXT        NOT NULL,
+    updated_by UUID        REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMP   NOT NULL DEFAULT NOW()
+);
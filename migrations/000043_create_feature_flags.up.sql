@@ -0,0 +1,12 @@
+-- Feature flag untuk rollout fitur berisiko; flag yang tidak ada di tabel memakai default di kode.
+-- environments kosong berarti semua environment, rollout_percent dihitung dari hash user ID
+CREATE TABLE IF NOT EXISTS feature_flags (
+    key             VARCHAR(64)  PRIMARY KEY,
+    description     VARCHAR(255) NOT NULL DEFAULT '',
+    enabled         BOOLEAN      NOT NULL DEFAULT FALSE,
+    rollout_percent INT          NOT NULL DEFAULT 100 CHECK (rollout_percent BETWEEN 0 AND 100),
+    environments    TEXT[]       NOT NULL DEFAULT '{}',
+    updated_by      UUID         REFERENCES users(id) ON DELETE SET NULL,
+    created_at      TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMP    NOT NULL DEFAULT NOW()
+);
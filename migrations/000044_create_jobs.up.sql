@@ -0,0 +1,29 @@
+-- Antrian job persistent (email, receipt PDF, export data) supaya pekerjaan async tidak hilang saat restart.
+-- attempts naik saat job diambil worker; job yang gagal max_attempts kali jadi dead dan menunggu retry admin.
+-- locked_until lease worker, job running yang lease-nya lewat dianggap ditinggal instance yang mati.
+CREATE TABLE IF NOT EXISTS jobs (
+    id           UUID         PRIMARY KEY,
+    type         VARCHAR(64)  NOT NULL,
+    payload      JSONB        NOT NULL DEFAULT '{}',
+    status       VARCHAR(20)  NOT NULL DEFAULT 'pending'
+                 CHECK (status IN ('pending', 'running', 'completed', 'dead')),
+    attempts     INT          NOT NULL DEFAULT 0,
+    max_attempts INT          NOT NULL CHECK (max_attempts > 0),
+    run_at       TIMESTAMP    NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP,
+    last_error   TEXT,
+    completed_at TIMESTAMP,
+    created_at   TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_due ON jobs (run_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_jobs_running ON jobs (locked_until) WHERE status = 'running';
+CREATE INDEX IF NOT EXISTS idx_jobs_completed ON jobs (completed_at) WHERE status = 'completed';
+CREATE INDEX IF NOT EXISTS idx_jobs_status_created ON jobs (status, created_at DESC);
+
+-- Export yang masih antri sebelum job queue ada dipindahkan ke antrian baru
+INSERT INTO jobs (id, type, payload, max_attempts)
+SELECT gen_random_uuid(), 'data_export.build', jsonb_build_object('export_id', id), 5
+FROM data_exports
+WHERE status IN ('pending', 'processing');
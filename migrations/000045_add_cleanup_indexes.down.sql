@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_otps_expires_at;
+DROP INDEX IF EXISTS idx_sessions_revoked_at;
+DROP INDEX IF EXISTS idx_sessions_expires_at;
@@ -0,0 +1,4 @@
+-- Cleanup terjadwal menghapus session dan OTP lama per batch berdasarkan waktu expired
+CREATE INDEX IF NOT EXISTS idx_sessions_expires_at ON sessions (expires_at);
+CREATE INDEX IF NOT EXISTS idx_sessions_revoked_at ON sessions (revoked_at) WHERE revoked_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_otps_expires_at ON otps (expires_at);
@@ -0,0 +1,9 @@
+-- Search user admin: partial match (ILIKE '%...%') email, username, phone butuh index trigram
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_users_email_trgm ON users USING GIN (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING GIN (username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_phone_trgm ON users USING GIN (phone gin_trgm_ops);
+
+-- Urutan list user (terbaru dulu) dan filter rentang tanggal daftar
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at DESC, id DESC);
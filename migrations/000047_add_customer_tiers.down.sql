@@ -0,0 +1,2 @@
+ALTER TABLE price_promotions DROP COLUMN IF EXISTS tiers;
+ALTER TABLE users DROP COLUMN IF EXISTS tier;
@@ -0,0 +1,6 @@
+-- Tier loyalty customer, dihitung ulang berkala dari belanja bersih di ledger
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tier VARCHAR(20) NOT NULL DEFAULT 'standard'
+    CHECK (tier IN ('standard', 'silver', 'gold', 'platinum'));
+
+-- Promo bisa dibatasi ke tier tertentu; kosong berarti untuk semua customer
+ALTER TABLE price_promotions ADD COLUMN IF NOT EXISTS tiers TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,9 @@
+-- Tier customer dikunci saat booking dibuat, supaya fee dan promo waktu bayar sama dengan yang ditampilkan
+-- walaupun job tier sempat mengubah tier user di antaranya. Booking lama memakai tier user saat ini.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS customer_tier VARCHAR(20) NOT NULL DEFAULT 'standard'
+    CHECK (customer_tier IN ('standard', 'silver', 'gold', 'platinum'));
+
+UPDATE bookings b
+SET customer_tier = u.tier
+FROM users u
+WHERE u.id = b.user_id AND b.customer_tier <> u.tier;
@@ -0,0 +1,81 @@
+// Package cache menyediakan in-memory cache dengan TTL per entry untuk data yang boleh sedikit basi
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTL is a concurrency-safe map yang entry-nya kadaluarsa setelah ttl.
+// Entry expired dibersihkan saat Set, paling sering sekali per ttl, jadi tidak perlu goroutine janitor.
+type TTL[K comparable, V any] struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	entries   map[K]entry[V]
+	lastSweep time.Time
+}
+
+// NewTTL creates a cache; ttl <= 0 berarti cache nonaktif (Get selalu miss)
+func NewTTL[K comparable, V any](ttl time.Duration) *TTL[K, V] {
+	return &TTL[K, V]{
+		ttl:     ttl,
+		entries: make(map[K]entry[V]),
+	}
+}
+
+// Enabled reports whether cache menyimpan apa pun
+func (c *TTL[K, V]) Enabled() bool {
+	return c.ttl > 0
+}
+
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.SetFor(key, value, c.ttl)
+}
+
+// SetFor stores value dengan umur sendiri, mis. fallback yang harus dicoba ulang lebih cepat dari ttl
+func (c *TTL[K, V]) SetFor(key K, value V, ttl time.Duration) {
+	if !c.Enabled() || ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, e := range c.entries {
+			if now.After(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[key] = entry[V]{value: value, expiresAt: now.Add(ttl)}
+}
+
+// Delete invalidates key; aman dipanggil untuk key yang tidak ada
+func (c *TTL[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
@@ -0,0 +1,144 @@
+// Package contentfilter menyaring teks buatan user (komentar review) dari kata kasar dan spam
+package contentfilter
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Violation jenis pelanggaran yang ditemukan di teks
+type Violation string
+
+const (
+	ViolationProfanity     Violation = "profanity"
+	ViolationURL           Violation = "url"
+	ViolationRepeatedChars Violation = "repeated_chars"
+)
+
+// Mode menentukan perlakuan untuk kata kasar. Spam (URL, karakter berulang) selalu ditolak
+// kecuali mode off, karena spam yang di-mask tetap tidak berguna untuk pembaca.
+type Mode string
+
+const (
+	ModeOff    Mode = "off"
+	ModeMask   Mode = "mask"
+	ModeReject Mode = "reject"
+)
+
+// Config filter; BlockedWords per kode bahasa ("en", "id") ditambahkan ke daftar bawaan
+type Config struct {
+	Mode             Mode
+	BlockedWords     map[string][]string
+	BlockURLs        bool
+	MaxRepeatedChars int // 0 = tidak dicek
+}
+
+// defaultBlockedWords daftar minimal per bahasa. Kata yang juga punya arti biasa
+// (mis. "anjing", "babi") sengaja tidak dimasukkan supaya review film tentang hewan tidak ikut kena.
+var defaultBlockedWords = map[string][]string{
+	"en": {"fuck", "fucking", "fucker", "motherfucker", "shit", "bullshit", "bitch", "bastard", "asshole", "cunt", "dickhead"},
+	"id": {"bangsat", "bajingan", "kontol", "memek", "ngentot", "goblok", "tolol", "kampret", "brengsek", "jancok"},
+}
+
+var urlPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9-]+\.(?:com|net|org|id|co|io|xyz|info|biz|ly|me|site|online|link)\b(?:/\S*)?`)
+
+// Result hasil Check. Text sudah di-mask kalau mode mask; Rejected true kalau teks harus ditolak.
+type Result struct {
+	Text       string
+	Violations []Violation
+	Rejected   bool
+}
+
+// Filter aman dipakai concurrent; semua pattern dikompilasi sekali di New
+type Filter struct {
+	mode             Mode
+	profanity        *regexp.Regexp
+	blockURLs        bool
+	maxRepeatedChars int
+}
+
+// New compiles daftar kata semua bahasa jadi satu pattern. Semua bahasa dicek sekaligus karena
+// bahasa komentar tidak selalu sama dengan bahasa UI user.
+func New(config Config) *Filter {
+	f := &Filter{
+		mode:             config.Mode,
+		blockURLs:        config.BlockURLs,
+		maxRepeatedChars: config.MaxRepeatedChars,
+	}
+	if f.mode == "" {
+		f.mode = ModeMask
+	}
+
+	seen := make(map[string]bool)
+	var words []string
+	for _, lists := range []map[string][]string{defaultBlockedWords, config.BlockedWords} {
+		for _, list := range lists {
+			for _, word := range list {
+				word = strings.ToLower(strings.TrimSpace(word))
+				if word != "" && !seen[word] {
+					seen[word] = true
+					words = append(words, regexp.QuoteMeta(word))
+				}
+			}
+		}
+	}
+	if len(words) > 0 {
+		f.profanity = regexp.MustCompile(`(?i)\b(?:` + strings.Join(words, "|") + `)\b`)
+	}
+
+	return f
+}
+
+// Check scans text. Violations berisi semua jenis pelanggaran yang ditemukan, urutan spam dulu.
+func (f *Filter) Check(text string) Result {
+	result := Result{Text: text}
+	if f == nil || f.mode == ModeOff || text == "" {
+		return result
+	}
+
+	if f.blockURLs && urlPattern.MatchString(text) {
+		result.Violations = append(result.Violations, ViolationURL)
+		result.Rejected = true
+	}
+	if f.maxRepeatedChars > 0 && longestRun(text) > f.maxRepeatedChars {
+		result.Violations = append(result.Violations, ViolationRepeatedChars)
+		result.Rejected = true
+	}
+
+	if f.profanity != nil && f.profanity.MatchString(text) {
+		result.Violations = append(result.Violations, ViolationProfanity)
+		if f.mode == ModeReject {
+			result.Rejected = true
+		} else {
+			result.Text = f.profanity.ReplaceAllStringFunc(text, func(word string) string {
+				return strings.Repeat("*", utf8.RuneCountInString(word))
+			})
+		}
+	}
+
+	return result
+}
+
+// longestRun returns panjang deret huruf/simbol identik terpanjang (case-insensitive); spasi diabaikan
+func longestRun(text string) int {
+	longest, run := 0, 0
+	var prev rune = -1
+	for _, r := range text {
+		r = unicode.ToLower(r)
+		if unicode.IsSpace(r) {
+			prev, run = -1, 0
+			continue
+		}
+		if r == prev {
+			run++
+		} else {
+			prev, run = r, 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
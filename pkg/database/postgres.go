@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
 )
 
 // PgxIface interface untuk abstraction database
@@ -19,11 +20,16 @@ type PgxIface interface {
 	Begin(ctx context.Context) (pgx.Tx, error)
 	Ping(ctx context.Context) error
 	Close()
+
+	// Reader returns koneksi untuk query read-only yang boleh sedikit tertinggal (listing, search).
+	// Tanpa replica, atau saat replica down, hasilnya primary itu sendiri.
+	Reader() PgxIface
 }
 
 // DB wrapper struct
 type DB struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	replica *replica
 }
 
 // Query implements PgxIface
@@ -53,14 +59,58 @@ func (db *DB) Ping(ctx context.Context) error {
 
 // Close implements PgxIface
 func (db *DB) Close() {
+	if db.replica != nil {
+		db.replica.close()
+	}
 	db.pool.Close()
 }
 
+// Reader implements PgxIface
+func (db *DB) Reader() PgxIface {
+	if db.replica == nil || !db.replica.healthy.Load() {
+		return db
+	}
+	return &readDB{primary: db, replica: db.replica}
+}
+
 // InitDB membuat koneksi database pool
-func InitDB(config utils.DatabaseConfig) (PgxIface, error) {
+func InitDB(config utils.DatabaseConfig, log *zap.Logger) (PgxIface, error) {
+	log = log.With(zap.String("component", "database"))
+
+	pool, err := newPool(config, config.Host, config.Port, log)
+	if err != nil {
+		return nil, err
+	}
+
+	// Test connection
+	pingCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := pool.Ping(pingCtx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ping database failed: %w", err)
+	}
+
+	db := &DB{pool: pool}
+
+	// Replica opsional; kalau belum bisa dihubungi saat start, app tetap jalan di primary
+	if config.ReplicaHost != "" {
+		replicaPool, err := newPool(config, config.ReplicaHost, config.ReplicaPort, log.With(zap.String("pool", "replica")))
+		if err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("replica: %w", err)
+		}
+		db.replica = newReplica(replicaPool, log)
+	}
+
+	return db, nil
+}
+
+// newPool creates pool ke host:port dengan kredensial dan setting yang sama
+func newPool(config utils.DatabaseConfig, host, port string, log *zap.Logger) (*pgxpool.Pool, error) {
 	// Build connection string
-	connStr := fmt.Sprintf("user=%s password=%s dbname=%s sslmode=disable host=%s",
-		config.User, config.Password, config.Name, config.Host)
+	connStr := fmt.Sprintf("user=%s password=%s dbname=%s sslmode=disable host=%s port=%s",
+		config.User, config.Password, config.Name, host, port)
 
 	// Parse config
 	poolConfig, err := pgxpool.ParseConfig(connStr)
@@ -75,6 +125,11 @@ func InitDB(config utils.DatabaseConfig) (PgxIface, error) {
 	poolConfig.MaxConnIdleTime = 5 * time.Minute
 	poolConfig.HealthCheckPeriod = 1 * time.Minute
 	poolConfig.ConnConfig.ConnectTimeout = 5 * time.Second
+	poolConfig.ConnConfig.Tracer = &queryTracer{
+		log:           log,
+		slowThreshold: time.Duration(config.SlowQueryMillis) * time.Millisecond,
+		logAll:        config.LogQueries,
+	}
 
 	// Create connection pool
 	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
@@ -82,14 +137,5 @@ func InitDB(config utils.DatabaseConfig) (PgxIface, error) {
 		return nil, fmt.Errorf("create connection pool: %w", err)
 	}
 
-	// Test connection
-	pingCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-
-	if err := pool.Ping(pingCtx); err != nil {
-		pool.Close()
-		return nil, fmt.Errorf("ping database failed: %w", err)
-	}
-
-	return &DB{pool: pool}, nil
+	return pool, nil
 }
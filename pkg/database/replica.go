@@ -0,0 +1,176 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const (
+	// replicaCheckInterval seberapa sering replica yang down dicoba lagi
+	replicaCheckInterval = 10 * time.Second
+	replicaPingTimeout   = 3 * time.Second
+)
+
+// replica is the read pool beserta status sehatnya. Status dimatikan begitu query gagal karena
+// koneksi, dan dinyalakan lagi oleh health loop setelah ping berhasil.
+type replica struct {
+	pool    *pgxpool.Pool
+	healthy atomic.Bool
+	log     *zap.Logger
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func newReplica(pool *pgxpool.Pool, log *zap.Logger) *replica {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &replica{
+		pool:   pool,
+		log:    log,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	if err := r.ping(ctx); err != nil {
+		log.Warn("Read replica unreachable, reads use primary until it recovers", zap.Error(err))
+	} else {
+		r.healthy.Store(true)
+		log.Info("Read replica connected")
+	}
+
+	go r.healthLoop(ctx)
+	return r
+}
+
+func (r *replica) ping(ctx context.Context) error {
+	pingCtx, cancel := context.WithTimeout(ctx, replicaPingTimeout)
+	defer cancel()
+	return r.pool.Ping(pingCtx)
+}
+
+func (r *replica) healthLoop(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := r.ping(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				if !r.healthy.Swap(true) {
+					r.log.Info("Read replica recovered, routing reads back to replica")
+				}
+			} else {
+				r.markDown(err)
+			}
+		}
+	}
+}
+
+// markDown switches reads ke primary; log hanya saat status berubah supaya tidak banjir
+func (r *replica) markDown(err error) {
+	if r.healthy.Swap(false) {
+		r.log.Warn("Read replica down, falling back to primary", zap.Error(err))
+	}
+}
+
+func (r *replica) close() {
+	r.cancel()
+	<-r.done
+	r.pool.Close()
+}
+
+// isConnError reports whether query gagal karena replica tidak bisa dihubungi, bukan karena
+// SQL-nya; hanya error seperti ini yang layak diulang di primary.
+func isConnError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return true
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// admin_shutdown, crash_shutdown, cannot_connect_now
+		return pgErr.Code == "57P01" || pgErr.Code == "57P02" || pgErr.Code == "57P03"
+	}
+	return pgconn.SafeToRetry(err)
+}
+
+// readDB routes query ke replica dan mengulang di primary kalau koneksi replica gagal.
+// Exec dan Begin selalu ke primary karena replica read-only.
+type readDB struct {
+	primary *DB
+	replica *replica
+}
+
+// Query implements PgxIface
+func (db *readDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	rows, err := db.replica.pool.Query(ctx, sql, args...)
+	if isConnError(err) {
+		db.replica.markDown(err)
+		return db.primary.Query(ctx, sql, args...)
+	}
+	return rows, err
+}
+
+// QueryRow implements PgxIface. Error pgx.Row baru muncul saat Scan, jadi fallback dilakukan di sana.
+func (db *readDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return &fallbackRow{
+		row: db.replica.pool.QueryRow(ctx, sql, args...),
+		fallback: func(err error) pgx.Row {
+			db.replica.markDown(err)
+			return db.primary.QueryRow(ctx, sql, args...)
+		},
+	}
+}
+
+// Exec implements PgxIface
+func (db *readDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return db.primary.Exec(ctx, sql, args...)
+}
+
+// Begin implements PgxIface
+func (db *readDB) Begin(ctx context.Context) (pgx.Tx, error) {
+	return db.primary.Begin(ctx)
+}
+
+// Ping implements PgxIface
+func (db *readDB) Ping(ctx context.Context) error {
+	return db.replica.ping(ctx)
+}
+
+// Close implements PgxIface - no-op, pool ditutup lewat DB.Close
+func (db *readDB) Close() {}
+
+// Reader implements PgxIface
+func (db *readDB) Reader() PgxIface {
+	return db
+}
+
+type fallbackRow struct {
+	row      pgx.Row
+	fallback func(err error) pgx.Row
+}
+
+func (r *fallbackRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if isConnError(err) {
+		return r.fallback(err).Scan(dest...)
+	}
+	return err
+}
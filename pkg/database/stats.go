@@ -0,0 +1,66 @@
+package database
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PoolStats is a snapshot statistik satu connection pool (primary atau replica)
+type PoolStats struct {
+	Pool    string
+	Healthy bool
+
+	MaxConns      int32
+	TotalConns    int32
+	AcquiredConns int32
+	IdleConns     int32
+
+	// Counter kumulatif sejak start. EmptyAcquire = acquire yang harus menunggu karena pool kosong.
+	AcquireCount         int64
+	EmptyAcquireCount    int64
+	CanceledAcquireCount int64
+	AcquireDuration      time.Duration
+	EmptyAcquireWaitTime time.Duration
+}
+
+// Saturation returns porsi MaxConns yang sedang dipakai, 0..1
+func (s PoolStats) Saturation() float64 {
+	if s.MaxConns <= 0 {
+		return 0
+	}
+	return float64(s.AcquiredConns) / float64(s.MaxConns)
+}
+
+// StatsReporter is implemented by DB; TxDB tidak karena pool-nya milik DB induk
+type StatsReporter interface {
+	PoolStats() []PoolStats
+}
+
+// PoolStats implements StatsReporter
+func (db *DB) PoolStats() []PoolStats {
+	stats := []PoolStats{poolStats("primary", db.pool, true)}
+	if db.replica != nil {
+		stats = append(stats, poolStats("replica", db.replica.pool, db.replica.healthy.Load()))
+	}
+	return stats
+}
+
+func poolStats(name string, pool *pgxpool.Pool, healthy bool) PoolStats {
+	stat := pool.Stat()
+	return PoolStats{
+		Pool:    name,
+		Healthy: healthy,
+
+		MaxConns:      stat.MaxConns(),
+		TotalConns:    stat.TotalConns(),
+		AcquiredConns: stat.AcquiredConns(),
+		IdleConns:     stat.IdleConns(),
+
+		AcquireCount:         stat.AcquireCount(),
+		EmptyAcquireCount:    stat.EmptyAcquireCount(),
+		CanceledAcquireCount: stat.CanceledAcquireCount(),
+		AcquireDuration:      stat.AcquireDuration(),
+		EmptyAcquireWaitTime: stat.EmptyAcquireWaitTime(),
+	}
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// maxLoggedSQLLength membatasi panjang SQL di log; query report bisa ratusan baris
+const maxLoggedSQLLength = 1000
+
+type queryCounterKey struct{}
+type queryStartKey struct{}
+
+type queryStart struct {
+	sql   string
+	start time.Time
+}
+
+// WithQueryCounter attaches counter query baru ke context (satu per HTTP request)
+func WithQueryCounter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryCounterKey{}, new(atomic.Int64))
+}
+
+// QueryCount returns jumlah query yang sudah dijalankan dengan context ini, 0 kalau tanpa counter
+func QueryCount(ctx context.Context) int64 {
+	if counter, ok := ctx.Value(queryCounterKey{}).(*atomic.Int64); ok {
+		return counter.Load()
+	}
+	return 0
+}
+
+// queryTracer implements pgx.QueryTracer: menghitung query per request dan log query yang lambat.
+// Argumen query tidak pernah di-log karena bisa berisi email, token atau password hash.
+type queryTracer struct {
+	log           *zap.Logger
+	slowThreshold time.Duration
+	logAll        bool
+}
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if counter, ok := ctx.Value(queryCounterKey{}).(*atomic.Int64); ok {
+		counter.Add(1)
+	}
+	return context.WithValue(ctx, queryStartKey{}, queryStart{sql: data.SQL, start: time.Now()})
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	started, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+
+	duration := time.Since(started.start)
+	slow := t.slowThreshold > 0 && duration >= t.slowThreshold
+	if !slow && !t.logAll {
+		return
+	}
+
+	fields := []zap.Field{
+		zap.String("sql", normalizeSQL(started.sql)),
+		zap.Duration("duration", duration),
+		zap.Int64("rows", data.CommandTag.RowsAffected()),
+	}
+	if data.Err != nil {
+		fields = append(fields, zap.Error(data.Err))
+	}
+
+	if slow {
+		t.log.Warn("Slow query", fields...)
+		return
+	}
+	t.log.Debug("Query", fields...)
+}
+
+// normalizeSQL collapses whitespace supaya query multi-baris jadi satu baris di log
+func normalizeSQL(sql string) string {
+	normalized := strings.Join(strings.Fields(sql), " ")
+	if len(normalized) > maxLoggedSQLLength {
+		return normalized[:maxLoggedSQLLength] + "..."
+	}
+	return normalized
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Event types untuk booking lifecycle
+const (
+	TypeBookingCreated   = "booking.created"
+	TypePaymentCompleted = "payment.completed"
+	TypeBookingCancelled = "booking.cancelled"
+	TypePaymentExpired   = "payment.expired"
+	TypeBookingConfirmed = "booking.confirmed"
+)
+
+// Event types untuk katalog (schedule & movie)
+const (
+	TypeScheduleCreated = "schedule.created"
+	TypeMovieUpdated    = "movie.updated"
+)
+
+// Aggregate types, disimpan di events_outbox.aggregate_type
+const (
+	AggregateBooking  = "booking"
+	AggregatePayment  = "payment"
+	AggregateSchedule = "schedule"
+	AggregateMovie    = "movie"
+)
+
+// Types returns every event type yang di-enqueue ke outbox, urut untuk validasi subscription
+func Types() []string {
+	return []string{
+		TypeBookingCreated, TypeBookingConfirmed, TypeBookingCancelled,
+		TypePaymentCompleted, TypePaymentExpired,
+		TypeScheduleCreated, TypeMovieUpdated,
+	}
+}
+
+type BookingCreated struct {
+	BookingID  string    `json:"booking_id"`
+	OrderID    string    `json:"order_id"`
+	UserID     string    `json:"user_id"`
+	ScheduleID string    `json:"schedule_id"`
+	SeatIDs    []string  `json:"seat_ids"`
+	TotalSeats int       `json:"total_seats"`
+	TotalPrice float64   `json:"total_price"` // major unit Currency
+	Currency   string    `json:"currency"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type PaymentCompleted struct {
+	PaymentID       string    `json:"payment_id"`
+	BookingID       string    `json:"booking_id"`
+	OrderID         string    `json:"order_id"`
+	UserID          string    `json:"user_id"`
+	PaymentMethodID string    `json:"payment_method_id"`
+	Amount          float64   `json:"amount"` // major unit Currency
+	Currency        string    `json:"currency"`
+	TransactionID   *string   `json:"transaction_id,omitempty"`
+	PaidAt          time.Time `json:"paid_at"`
+	// GiftCardAmount porsi yang dibayar dari saldo gift card, di luar Amount
+	GiftCardAmount float64 `json:"gift_card_amount,omitempty"`
+}
+
+type BookingCancelled struct {
+	BookingID      string    `json:"booking_id"`
+	OrderID        string    `json:"order_id"`
+	UserID         string    `json:"user_id"`
+	PreviousStatus string    `json:"previous_status"`
+	CancelledAt    time.Time `json:"cancelled_at"`
+}
+
+// BookingConfirmed dikirim saat booking jadi confirmed: payment lunas atau group booking dibuat admin
+type BookingConfirmed struct {
+	BookingID   string    `json:"booking_id"`
+	OrderID     string    `json:"order_id"`
+	UserID      string    `json:"user_id"`
+	ScheduleID  string    `json:"schedule_id"`
+	TotalSeats  int       `json:"total_seats"`
+	TotalPrice  float64   `json:"total_price"` // major unit Currency
+	Currency    string    `json:"currency"`
+	ConfirmedAt time.Time `json:"confirmed_at"`
+}
+
+// ScheduleCreated dikirim saat schedule di-publish; draft belum terlihat publik jadi tidak ikut
+type ScheduleCreated struct {
+	ScheduleID  string    `json:"schedule_id"`
+	MovieID     string    `json:"movie_id"`
+	HallID      string    `json:"hall_id"`
+	CinemaID    string    `json:"cinema_id"`
+	ShowDate    string    `json:"show_date"` // YYYY-MM-DD waktu lokal cinema
+	ShowTime    string    `json:"show_time"` // HH:MM
+	StartsAt    time.Time `json:"starts_at"`
+	Price       float64   `json:"price"` // harga dasar, major unit Currency; sebelum multiplier hall dan promo
+	Currency    string    `json:"currency"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// MovieUpdated membawa snapshot movie setelah update, bukan diff
+type MovieUpdated struct {
+	MovieID           string    `json:"movie_id"`
+	Title             string    `json:"title"`
+	ReleaseDate       string    `json:"release_date"` // YYYY-MM-DD
+	ReleaseStatus     string    `json:"release_status"`
+	DurationInMinutes int       `json:"duration_in_minutes"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// PaymentExpired dikirim saat payment async lewat batas bayar; BookingReleased true kalau kursi ikut dilepas
+type PaymentExpired struct {
+	PaymentID       string    `json:"payment_id"`
+	BookingID       string    `json:"booking_id"`
+	OrderID         string    `json:"order_id"`
+	UserID          string    `json:"user_id"`
+	PaymentMethodID string    `json:"payment_method_id"`
+	Amount          float64   `json:"amount"` // major unit Currency
+	Currency        string    `json:"currency"`
+	BookingReleased bool      `json:"booking_released"`
+	ExpiredAt       time.Time `json:"expired_at"`
+}
+
+// Envelope is the wire format; ID bisa dipakai consumer untuk dedup
+type Envelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// Message is a broker-agnostic record
+type Message struct {
+	Topic   string
+	Key     string
+	Payload []byte
+}
+
+// Publisher sends messages to a broker (NATS, Kafka, ...)
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
+
+// LogPublisher only logs messages, dipakai saat broker belum dikonfigurasi
+type LogPublisher struct {
+	log *zap.Logger
+}
+
+func NewLogPublisher(log *zap.Logger) *LogPublisher {
+	return &LogPublisher{log: log.With(zap.String("publisher", "log"))}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, msg Message) error {
+	p.log.Info("Event (log only)",
+		zap.String("topic", msg.Topic),
+		zap.String("key", msg.Key),
+		zap.Int("size", len(msg.Payload)),
+	)
+	return nil
+}
+
+func (p *LogPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// KafkaRESTPublisher produces records via Kafka REST Proxy (Confluent v2 API)
+type KafkaRESTPublisher struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewKafkaRESTPublisher(baseURL string) (*KafkaRESTPublisher, error) {
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return nil, fmt.Errorf("invalid kafka rest url %q: %w", baseURL, err)
+	}
+
+	return &KafkaRESTPublisher{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key,omitempty"`
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (p *KafkaRESTPublisher) Publish(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(map[string][]kafkaRecord{
+		"records": {{Key: msg.Key, Value: msg.Payload}},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal kafka record: %w", err)
+	}
+
+	endpoint := p.baseURL + "/topics/" + url.PathEscape(msg.Topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build kafka request: %w", err)
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("kafka produce %s: %w", msg.Topic, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("kafka produce %s: status %d: %s", msg.Topic, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	// REST proxy bisa return 200 tapi record tetap gagal per-partition
+	var produced kafkaProduceResponse
+	if err := json.Unmarshal(respBody, &produced); err == nil {
+		for _, offset := range produced.Offsets {
+			if offset.ErrorCode != nil {
+				return fmt.Errorf("kafka produce %s: %s (code %d)", msg.Topic, offset.Error, *offset.ErrorCode)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *KafkaRESTPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,163 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const natsDialTimeout = 5 * time.Second
+
+// NATSPublisher publishes with the NATS core text protocol.
+// Setiap PUB diikuti PING/PONG supaya error dari server tidak hilang.
+type NATSPublisher struct {
+	url  *url.URL
+	mu   sync.Mutex
+	conn net.Conn
+	rd   *bufio.Reader
+}
+
+// NewNATSPublisher accepts nats://[user:pass@]host:port, koneksi dibuka saat publish pertama
+func NewNATSPublisher(rawURL string) (*NATSPublisher, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse nats url: %w", err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid nats url %q", rawURL)
+	}
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	return &NATSPublisher{url: u}, nil
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.connect(ctx); err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		p.conn.SetDeadline(deadline)
+	} else {
+		p.conn.SetDeadline(time.Now().Add(natsDialTimeout))
+	}
+
+	frame := fmt.Sprintf("PUB %s %d\r\n%s\r\nPING\r\n", msg.Topic, len(msg.Payload), msg.Payload)
+	if _, err := p.conn.Write([]byte(frame)); err != nil {
+		p.reset()
+		return fmt.Errorf("nats publish %s: %w", msg.Topic, err)
+	}
+
+	if err := p.awaitPong(); err != nil {
+		p.reset()
+		return fmt.Errorf("nats publish %s: %w", msg.Topic, err)
+	}
+
+	return nil
+}
+
+func (p *NATSPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn = nil
+	return err
+}
+
+// ==================== HELPER METHODS ====================
+
+func (p *NATSPublisher) connect(ctx context.Context) error {
+	if p.conn != nil {
+		return nil
+	}
+
+	dialer := net.Dialer{Timeout: natsDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", p.url.Host)
+	if err != nil {
+		return fmt.Errorf("dial nats %s: %w", p.url.Host, err)
+	}
+	conn.SetDeadline(time.Now().Add(natsDialTimeout))
+
+	p.conn = conn
+	p.rd = bufio.NewReader(conn)
+
+	// Server selalu kirim INFO duluan
+	line, err := p.rd.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "INFO") {
+		p.reset()
+		return fmt.Errorf("nats handshake: unexpected greeting %q: %v", strings.TrimSpace(line), err)
+	}
+
+	opts := map[string]any{
+		"verbose":  false,
+		"pedantic": false,
+		"name":     "cinema-booking",
+		"lang":     "go",
+	}
+	if user := p.url.User; user != nil {
+		if pass, ok := user.Password(); ok {
+			opts["user"] = user.Username()
+			opts["pass"] = pass
+		} else {
+			opts["auth_token"] = user.Username()
+		}
+	}
+
+	connectOpts, _ := json.Marshal(opts)
+	if _, err := fmt.Fprintf(conn, "CONNECT %s\r\nPING\r\n", connectOpts); err != nil {
+		p.reset()
+		return fmt.Errorf("nats connect: %w", err)
+	}
+
+	if err := p.awaitPong(); err != nil {
+		p.reset()
+		return fmt.Errorf("nats connect: %w", err)
+	}
+
+	return nil
+}
+
+// awaitPong reads until PONG, server PING dibalas dan -ERR jadi error
+func (p *NATSPublisher) awaitPong() error {
+	for {
+		line, err := p.rd.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := p.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (p *NATSPublisher) reset() {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	p.conn = nil
+	p.rd = nil
+}
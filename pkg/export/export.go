@@ -0,0 +1,71 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// Writer writes tabular rows to an underlying stream one row at a time
+type Writer interface {
+	WriteRow(values []string) error
+	Close() error
+}
+
+// ParseFormat normalizes export query value, empty berarti csv
+func ParseFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(value))) {
+	case "", FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", fmt.Errorf("invalid export format %q, must be csv or xlsx", value)
+	}
+}
+
+// ContentType returns the MIME type for the format
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Filename appends the format extension to a base name
+func (f Format) Filename(base string) string {
+	return base + "." + string(f)
+}
+
+// NewWriter creates a Writer for the format on top of w
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, fmt.Errorf("invalid export format %q", format)
+	}
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteRow(values []string) error {
+	return c.w.Write(values)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
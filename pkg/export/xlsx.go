@@ -0,0 +1,117 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Minimal SpreadsheetML parts for a single-sheet workbook
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	xlsxSheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetFooter = `</sheetData></worksheet>`
+)
+
+// xlsxWriter streams rows into the sheet entry of a zip archive, jadi tidak perlu buffer seluruh file
+type xlsxWriter struct {
+	zw    *zip.Writer
+	sheet io.Writer
+	row   int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	zw := zip.NewWriter(w)
+
+	staticParts := []struct{ name, body string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+	for _, part := range staticParts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("create xlsx part %s: %w", part.name, err)
+		}
+		if _, err := io.WriteString(f, part.body); err != nil {
+			return nil, fmt.Errorf("write xlsx part %s: %w", part.name, err)
+		}
+	}
+
+	// Sheet must be the last entry since it stays open while rows are written
+	sheet, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("create xlsx sheet: %w", err)
+	}
+	if _, err := io.WriteString(sheet, xlsxSheetHeader); err != nil {
+		return nil, fmt.Errorf("write xlsx sheet header: %w", err)
+	}
+
+	return &xlsxWriter{zw: zw, sheet: sheet}, nil
+}
+
+func (x *xlsxWriter) WriteRow(values []string) error {
+	x.row++
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, x.row)
+	for i, value := range values {
+		ref := columnName(i) + strconv.Itoa(x.row)
+		// Header row always text; numeric values stored as numbers so they can be summed in Excel
+		if x.row > 1 && isNumeric(value) {
+			fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, value)
+			continue
+		}
+		fmt.Fprintf(&b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+		if err := xml.EscapeText(&b, []byte(value)); err != nil {
+			return fmt.Errorf("escape xlsx cell %s: %w", ref, err)
+		}
+		b.WriteString(`</t></is></c>`)
+	}
+	b.WriteString(`</row>`)
+
+	_, err := io.WriteString(x.sheet, b.String())
+	return err
+}
+
+func (x *xlsxWriter) Close() error {
+	if _, err := io.WriteString(x.sheet, xlsxSheetFooter); err != nil {
+		return fmt.Errorf("write xlsx sheet footer: %w", err)
+	}
+	return x.zw.Close()
+}
+
+// isNumeric reports whether value can be stored as a number without losing its text form (e.g. leading zeros)
+func isNumeric(value string) bool {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return false
+	}
+	if len(value) > 1 && value[0] == '0' && value[1] != '.' {
+		return false
+	}
+	return true
+}
+
+// columnName converts zero-based column index to A, B, ..., Z, AA, AB, ...
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
@@ -0,0 +1,261 @@
+// Package feed renders sitemap XML, RSS 2.0 dan JSON Feed 1.1 untuk website publik
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"path"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatRSS  Format = "rss"
+)
+
+// ContentType returns the MIME type for the format
+func (f Format) ContentType() string {
+	if f == FormatRSS {
+		return "application/rss+xml; charset=utf-8"
+	}
+	return "application/feed+json; charset=utf-8"
+}
+
+// SitemapContentType MIME type untuk Sitemap
+const SitemapContentType = "application/xml; charset=utf-8"
+
+// Item satu entry feed; URL harus absolut karena feed reader tidak tahu base URL
+type Item struct {
+	ID        string
+	Title     string
+	URL       string
+	Summary   string
+	ImageURL  string
+	Tags      []string
+	Published time.Time
+	Updated   time.Time
+}
+
+type Feed struct {
+	Title       string
+	Description string
+	HomeURL     string
+	FeedURL     string
+	Items       []Item
+}
+
+// Render encodes feed sesuai format
+func Render(f Feed, format Format) ([]byte, error) {
+	switch format {
+	case FormatRSS:
+		return RSS(f)
+	case FormatJSON:
+		return JSON(f)
+	default:
+		return nil, fmt.Errorf("invalid feed format %q", format)
+	}
+}
+
+// ==================== RSS 2.0 ====================
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      rssLink   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+// rssLink self reference yang direkomendasikan validator RSS
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	GUID        rssGUID       `xml:"guid"`
+	Description string        `xml:"description,omitempty"`
+	Categories  []string      `xml:"category"`
+	PubDate     string        `xml:"pubDate"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+// rssEnclosure wajib punya length; 0 diterima kalau ukuran file tidak diketahui
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int    `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+func RSS(f Feed) ([]byte, error) {
+	channel := rssChannel{
+		Title:       f.Title,
+		Link:        f.HomeURL,
+		Description: f.Description,
+		AtomLink:    rssLink{Href: f.FeedURL, Rel: "self", Type: "application/rss+xml"},
+		Items:       make([]rssItem, len(f.Items)),
+	}
+
+	var lastBuild time.Time
+	for i, item := range f.Items {
+		entry := rssItem{
+			Title:       item.Title,
+			Link:        item.URL,
+			GUID:        rssGUID{Value: item.URL, IsPermaLink: true},
+			Description: item.Summary,
+			Categories:  item.Tags,
+			PubDate:     item.Published.Format(time.RFC1123Z),
+		}
+		if item.ImageURL != "" {
+			entry.Enclosure = &rssEnclosure{URL: item.ImageURL, Type: imageType(item.ImageURL)}
+		}
+		channel.Items[i] = entry
+
+		if item.Updated.After(lastBuild) {
+			lastBuild = item.Updated
+		}
+	}
+	if !lastBuild.IsZero() {
+		channel.LastBuildDate = lastBuild.Format(time.RFC1123Z)
+	}
+
+	return marshalXML(rssDocument{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Channel: channel,
+	})
+}
+
+// ==================== JSON FEED 1.1 ====================
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	FeedURL     string         `json:"feed_url"`
+	Description string         `json:"description,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	Title         string   `json:"title"`
+	ContentText   string   `json:"content_text"`
+	Image         string   `json:"image,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	DatePublished string   `json:"date_published"`
+	DateModified  string   `json:"date_modified,omitempty"`
+}
+
+func JSON(f Feed) ([]byte, error) {
+	doc := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.HomeURL,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Items:       make([]jsonFeedItem, len(f.Items)),
+	}
+
+	for i, item := range f.Items {
+		entry := jsonFeedItem{
+			ID:            item.ID,
+			URL:           item.URL,
+			Title:         item.Title,
+			ContentText:   item.Summary,
+			Image:         item.ImageURL,
+			Tags:          item.Tags,
+			DatePublished: item.Published.Format(time.RFC3339),
+		}
+		if !item.Updated.IsZero() {
+			entry.DateModified = item.Updated.Format(time.RFC3339)
+		}
+		doc.Items[i] = entry
+	}
+
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("encode json feed: %w", err)
+	}
+	return body, nil
+}
+
+// ==================== SITEMAP ====================
+
+// SitemapURL satu <url> di sitemap; LastMod zero dan Priority 0 tidak ditulis
+type SitemapURL struct {
+	Loc        string
+	LastMod    time.Time
+	ChangeFreq string
+	Priority   float64
+}
+
+type sitemapDocument struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod,omitempty"`
+	ChangeFreq string `xml:"changefreq,omitempty"`
+	Priority   string `xml:"priority,omitempty"`
+}
+
+func Sitemap(urls []SitemapURL) ([]byte, error) {
+	doc := sitemapDocument{
+		Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		URLs:  make([]sitemapURL, len(urls)),
+	}
+
+	for i, u := range urls {
+		entry := sitemapURL{Loc: u.Loc, ChangeFreq: u.ChangeFreq}
+		if !u.LastMod.IsZero() {
+			entry.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+		if u.Priority > 0 {
+			entry.Priority = fmt.Sprintf("%.1f", u.Priority)
+		}
+		doc.URLs[i] = entry
+	}
+
+	return marshalXML(doc)
+}
+
+// imageType menebak MIME dari ekstensi poster, default jpeg untuk URL CDN tanpa ekstensi
+func imageType(url string) string {
+	if t := mime.TypeByExtension(path.Ext(url)); t != "" {
+		return t
+	}
+	return "image/jpeg"
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode xml: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
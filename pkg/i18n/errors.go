@@ -0,0 +1,41 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+)
+
+// Localizer is implemented by errors yang bisa menampilkan pesan dalam bahasa user
+type Localizer interface {
+	Localize(lang Lang) string
+}
+
+// Error is a user-facing error dengan message key. Error() selalu English supaya log dan
+// pencocokan string di handler tetap sama apa pun bahasa request-nya.
+type Error struct {
+	Key  string
+	Args []any
+}
+
+// Errorf creates a localizable error dari key di bundle
+func Errorf(key string, args ...any) error {
+	return &Error{Key: key, Args: args}
+}
+
+func (e *Error) Error() string {
+	return T(English, e.Key, e.Args...)
+}
+
+func (e *Error) Localize(lang Lang) string {
+	return T(lang, e.Key, e.Args...)
+}
+
+// Message returns the message untuk client: terjemahan kalau err (atau error yang di-wrap-nya)
+// bisa dilokalisasi, selain itu err.Error() apa adanya.
+func Message(ctx context.Context, err error) string {
+	var localizer Localizer
+	if errors.As(err, &localizer) {
+		return localizer.Localize(FromContext(ctx))
+	}
+	return err.Error()
+}
@@ -0,0 +1,121 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Lang is a supported message language
+type Lang string
+
+const (
+	English    Lang = "en"
+	Indonesian Lang = "id"
+
+	// Default dipakai kalau Accept-Language kosong atau tidak ada yang didukung
+	Default = English
+)
+
+var bundles = map[Lang]map[string]string{
+	English:    english,
+	Indonesian: indonesian,
+}
+
+// Supported returns every language yang punya bundle
+func Supported() []Lang {
+	return []Lang{English, Indonesian}
+}
+
+// Parse maps a language tag seperti "id", "id-ID" atau "en_US" ke Lang yang didukung.
+// "in" adalah kode lama untuk Bahasa Indonesia dan masih dikirim beberapa Android versi lama.
+func Parse(tag string) (Lang, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+
+	switch tag {
+	case "en":
+		return English, true
+	case "id", "in":
+		return Indonesian, true
+	default:
+		return "", false
+	}
+}
+
+// FromAcceptLanguage picks the best supported language dari header Accept-Language
+// berdasarkan q-value; urutan header dipakai kalau q sama.
+func FromAcceptLanguage(header string) Lang {
+	type candidate struct {
+		lang  Lang
+		q     float64
+		order int
+	}
+
+	var candidates []candidate
+	for i, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		lang, ok := Parse(fields[0])
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{lang: lang, q: q, order: i})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return Default
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].lang
+}
+
+type contextKey struct{}
+
+// WithLang stores the request language di context
+func WithLang(ctx context.Context, lang Lang) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// FromContext returns bahasa request, Default kalau tidak di-set (mis. background job)
+func FromContext(ctx context.Context) Lang {
+	if lang, ok := ctx.Value(contextKey{}).(Lang); ok {
+		return lang
+	}
+	return Default
+}
+
+// T renders message key dalam bahasa lang. Key yang belum diterjemahkan jatuh ke English,
+// dan key yang tidak dikenal dikembalikan apa adanya supaya kelihatan saat development.
+func T(lang Lang, key string, args ...any) string {
+	format, ok := bundles[lang][key]
+	if !ok {
+		format, ok = bundles[Default][key]
+	}
+	if !ok {
+		return key
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
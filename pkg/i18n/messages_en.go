@@ -0,0 +1,96 @@
+package i18n
+
+// english is the default bundle; semua key wajib ada di sini
+var english = map[string]string{
+	// Validation
+	"validation.failed":         "Validation failed",
+	"validation.required":       "This field is required",
+	"validation.required_with":  "This field is required when %s is present",
+	"validation.email":          "Invalid email format",
+	"validation.url":            "Must be a valid URL",
+	"validation.uuid":           "Must be a valid UUID",
+	"validation.datetime":       "Must match the format %s",
+	"validation.datetime.date":  "Must be a date in YYYY-MM-DD format",
+	"validation.datetime.time":  "Must be a time in HH:MM format",
+	"validation.datetime.month": "Must be a month in YYYY-MM format",
+	"validation.min.string":     "Must be at least %s characters long",
+	"validation.min.items":      "Must contain at least %s items",
+	"validation.min.number":     "Must be at least %s",
+	"validation.max.string":     "Must be at most %s characters long",
+	"validation.max.items":      "Must contain at most %s items",
+	"validation.max.number":     "Must be at most %s",
+	"validation.len.string":     "Must be exactly %s characters long",
+	"validation.len.items":      "Must contain exactly %s items",
+	"validation.len.number":     "Must be exactly %s",
+	"validation.gt":             "Must be greater than %s",
+	"validation.gte":            "Must be greater than or equal to %s",
+	"validation.lt":             "Must be less than %s",
+	"validation.lte":            "Must be less than or equal to %s",
+	"validation.oneof":          "Must be one of: %s",
+	"validation.unique":         "Must not contain duplicate values",
+	"validation.numeric":        "Must be numeric",
+	"validation.timezone":       "Must be an IANA time zone name, e.g. Asia/Jakarta",
+	"validation.invalid":        "Invalid %s field",
+
+	// Booking
+	"booking.not_found":                "booking %s not found",
+	"booking.order_not_found":          "booking with order %s not found",
+	"booking.order_id_empty":           "invalid order ID: empty",
+	"booking.schedule_not_found":       "schedule %s not found",
+	"booking.sales_closed":             "ticket sales for this schedule are closed",
+	"booking.schedule_unpublished":     "cannot book unpublished schedule %s",
+	"booking.hall_not_found":           "hall not found for schedule",
+	"booking.cinema_not_found":         "cinema not found for schedule",
+	"booking.user_not_found":           "user %s not found",
+	"booking.seat_not_found":           "seat %s not found",
+	"booking.seat_wrong_hall":          "seat %s not in schedule hall",
+	"booking.seat_already_booked":      "seat %s is already booked",
+	"booking.seat_selection":           "invalid seat selection: %s",
+	"booking.seat_max":                 "maximum %d seats per booking, got %d",
+	"booking.seat_duplicate":           "seat %s is selected more than once",
+	"booking.seat_single_gap":          "selection leaves seat %s as a single empty seat",
+	"booking.seat_unavailable":         "seat %s is not available",
+	"booking.seat_blocked":             "seat %s is blocked and cannot be booked",
+	"booking.group_selection":          "invalid group booking: provide either seat_ids or whole_hall",
+	"booking.whole_hall_empty":         "cannot book whole hall: hall has no available seats",
+	"booking.whole_hall_conflict":      "cannot book whole hall: %d seat(s) already booked or held",
+	"booking.cancel_status":            "booking status is %s, cannot cancel",
+	"booking.transition_invalid":       "invalid status change: booking is %s, cannot move to %s",
+	"booking.payment_unauthorized":     "unauthorized to process payment for this booking",
+	"booking.payment_status":           "booking status is %s, cannot process payment",
+	"booking.payment_pending":          "booking %s has pending payment %s, cannot process another payment",
+	"booking.payment_amount":           "invalid amount: %.2f does not match booking total %s",
+	"booking.payment_not_found":        "payment %s not found",
+	"booking.payment_method_not_found": "payment method %s not found",
+	"booking.payment_method_inactive":  "payment method %s is not active",
+	"booking.gift_balance_empty":       "cannot pay with gift balance: no usable gift card balance",
+	"booking.age_restricted":           "cannot book %s: rated %s, minimum age is %d",
+	"booking.age_unverified":           "cannot book %s: rated %s (minimum age %d), set your date of birth first",
+	"booking.age_warning":              "%s is rated %s; viewers under %d may be refused entry",
+	"booking.age_warning_unverified":   "%s is rated %s (minimum age %d); add your date of birth to your profile",
+	"booking.receipt_unpaid":           "cannot generate receipt: booking %s has no completed payment",
+	"booking.date_range":               "invalid date range: end_date is before start_date",
+	"booking.concurrent_update":        "this booking was changed by another request, reload it and try again",
+	"booking.capacity_exceeded":        "cannot book %d seat(s): the show is already at full capacity",
+
+	// Review
+	"review.content_profanity":      "comment contains inappropriate language",
+	"review.content_url":            "comment must not contain links",
+	"review.content_repeated_chars": "comment looks like spam: too many repeated characters",
+	"review.edit_window_closed":     "reviews can only be edited within %d days of posting",
+
+	// Email / push
+	"email.reminder.subject":          "%s starts soon",
+	"email.reminder.body":             "Your show %s at %s hall %d starts at %s %s. Order: %s",
+	"email.booking_confirmed.subject": "Booking %s confirmed",
+	"email.booking_confirmed.body":    "Your booking %s for %d seat(s) has been confirmed. Total paid: %s",
+	"email.payment_expired.subject":   "Payment for booking %s expired",
+	"email.payment_expired.body":      "We did not receive payment for booking %s before the deadline, so its %d seat(s) have been released. Please make a new booking if you still want to attend.",
+	"email.waitlist_offer.subject":    "Seats are available for your waitlisted show",
+	"email.waitlist_offer.body":       "Seat(s) %s are being held for you until %s. Complete your booking before the hold expires.",
+	"email.now_playing.subject":       "%s is now playing",
+	"email.now_playing.body":          "%s from your watchlist is now showing in cinemas. Book your seats before they sell out!",
+	"email.cinema_closed.subject":     "Your show %s at %s has been cancelled",
+	"email.cinema_closed.body":        "%s is closed from %s, so your booking %s has been cancelled. We are sorry for the inconvenience.",
+	"email.cinema_closed.refund":      "%s is closed from %s, so your booking %s has been cancelled and the %s you paid will be refunded. We are sorry for the inconvenience.",
+}
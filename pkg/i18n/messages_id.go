@@ -0,0 +1,96 @@
+package i18n
+
+// indonesian bundle; key yang belum ada otomatis pakai English
+var indonesian = map[string]string{
+	// Validation
+	"validation.failed":         "Validasi gagal",
+	"validation.required":       "Wajib diisi",
+	"validation.required_with":  "Wajib diisi jika %s diisi",
+	"validation.email":          "Format email tidak valid",
+	"validation.url":            "Harus berupa URL yang valid",
+	"validation.uuid":           "Harus berupa UUID yang valid",
+	"validation.datetime":       "Harus sesuai format %s",
+	"validation.datetime.date":  "Harus berupa tanggal dengan format YYYY-MM-DD",
+	"validation.datetime.time":  "Harus berupa jam dengan format HH:MM",
+	"validation.datetime.month": "Harus berupa bulan dengan format YYYY-MM",
+	"validation.min.string":     "Minimal %s karakter",
+	"validation.min.items":      "Minimal berisi %s item",
+	"validation.min.number":     "Minimal %s",
+	"validation.max.string":     "Maksimal %s karakter",
+	"validation.max.items":      "Maksimal berisi %s item",
+	"validation.max.number":     "Maksimal %s",
+	"validation.len.string":     "Harus tepat %s karakter",
+	"validation.len.items":      "Harus berisi tepat %s item",
+	"validation.len.number":     "Harus tepat %s",
+	"validation.gt":             "Harus lebih besar dari %s",
+	"validation.gte":            "Harus lebih besar dari atau sama dengan %s",
+	"validation.lt":             "Harus lebih kecil dari %s",
+	"validation.lte":            "Harus lebih kecil dari atau sama dengan %s",
+	"validation.oneof":          "Harus salah satu dari: %s",
+	"validation.unique":         "Tidak boleh berisi nilai yang sama",
+	"validation.numeric":        "Harus berupa angka",
+	"validation.timezone":       "Harus nama zona waktu IANA, mis. Asia/Jakarta",
+	"validation.invalid":        "Field %s tidak valid",
+
+	// Booking
+	"booking.not_found":                "pesanan %s tidak ditemukan",
+	"booking.order_not_found":          "pesanan dengan order %s tidak ditemukan",
+	"booking.order_id_empty":           "order ID tidak valid: kosong",
+	"booking.schedule_not_found":       "jadwal %s tidak ditemukan",
+	"booking.sales_closed":             "penjualan tiket untuk jadwal ini sudah ditutup",
+	"booking.schedule_unpublished":     "jadwal %s belum dipublikasikan dan tidak bisa dipesan",
+	"booking.hall_not_found":           "studio untuk jadwal ini tidak ditemukan",
+	"booking.cinema_not_found":         "bioskop untuk jadwal ini tidak ditemukan",
+	"booking.user_not_found":           "user %s tidak ditemukan",
+	"booking.seat_not_found":           "kursi %s tidak ditemukan",
+	"booking.seat_wrong_hall":          "kursi %s tidak berada di studio jadwal ini",
+	"booking.seat_already_booked":      "kursi %s sudah dipesan",
+	"booking.seat_selection":           "pilihan kursi tidak valid: %s",
+	"booking.seat_max":                 "maksimal %d kursi per pesanan, dipilih %d",
+	"booking.seat_duplicate":           "kursi %s dipilih lebih dari sekali",
+	"booking.seat_single_gap":          "pilihan ini menyisakan kursi %s kosong sendirian",
+	"booking.seat_unavailable":         "kursi %s tidak tersedia",
+	"booking.seat_blocked":             "kursi %s sedang diblokir dan tidak bisa dipesan",
+	"booking.group_selection":          "group booking tidak valid: isi seat_ids atau whole_hall",
+	"booking.whole_hall_empty":         "tidak bisa memesan satu studio: tidak ada kursi yang tersedia",
+	"booking.whole_hall_conflict":      "tidak bisa memesan satu studio: %d kursi sudah dipesan atau ditahan",
+	"booking.cancel_status":            "status pesanan %s, tidak bisa dibatalkan",
+	"booking.transition_invalid":       "perubahan status tidak valid: pesanan berstatus %s, tidak bisa menjadi %s",
+	"booking.payment_unauthorized":     "tidak berhak memproses pembayaran untuk pesanan ini",
+	"booking.payment_status":           "status pesanan %s, pembayaran tidak bisa diproses",
+	"booking.payment_pending":          "pesanan %s masih punya pembayaran %s yang menunggu, tidak bisa membuat pembayaran lain",
+	"booking.payment_amount":           "nominal tidak valid: %.2f tidak sama dengan total pesanan %s",
+	"booking.payment_not_found":        "pembayaran %s tidak ditemukan",
+	"booking.payment_method_not_found": "metode pembayaran %s tidak ditemukan",
+	"booking.payment_method_inactive":  "metode pembayaran %s sedang tidak aktif",
+	"booking.gift_balance_empty":       "saldo gift card tidak tersedia, tidak bisa membayar dengan saldo gift card",
+	"booking.age_restricted":           "tidak bisa booking %s: klasifikasi %s, usia minimal %d tahun",
+	"booking.age_unverified":           "tidak bisa booking %s: klasifikasi %s (usia minimal %d tahun), isi tanggal lahir terlebih dahulu",
+	"booking.age_warning":              "%s berklasifikasi %s; penonton di bawah %d tahun bisa ditolak masuk",
+	"booking.age_warning_unverified":   "%s berklasifikasi %s (usia minimal %d tahun); lengkapi tanggal lahir di profil",
+	"booking.receipt_unpaid":           "tidak bisa membuat struk: pesanan %s belum memiliki pembayaran yang selesai",
+	"booking.date_range":               "rentang tanggal tidak valid: end_date sebelum start_date",
+	"booking.concurrent_update":        "pesanan ini baru saja diubah oleh proses lain, muat ulang lalu coba lagi",
+	"booking.capacity_exceeded":        "tidak bisa memesan %d kursi: kapasitas pertunjukan sudah penuh",
+
+	// Review
+	"review.content_profanity":      "komentar mengandung kata yang tidak pantas",
+	"review.content_url":            "komentar tidak boleh berisi link",
+	"review.content_repeated_chars": "komentar terdeteksi spam: terlalu banyak karakter berulang",
+	"review.edit_window_closed":     "review hanya bisa diedit dalam %d hari sejak dibuat",
+
+	// Email / push
+	"email.reminder.subject":          "%s segera dimulai",
+	"email.reminder.body":             "Film %s di %s studio %d dimulai pada %s %s. Order: %s",
+	"email.booking_confirmed.subject": "Pesanan %s terkonfirmasi",
+	"email.booking_confirmed.body":    "Pesanan %s untuk %d kursi sudah terkonfirmasi. Total dibayar: %s",
+	"email.payment_expired.subject":   "Pembayaran pesanan %s kedaluwarsa",
+	"email.payment_expired.body":      "Kami belum menerima pembayaran untuk pesanan %s sampai batas waktu, sehingga %d kursinya sudah dilepas. Silakan buat pesanan baru jika masih ingin menonton.",
+	"email.waitlist_offer.subject":    "Kursi tersedia untuk jadwal di waitlist Anda",
+	"email.waitlist_offer.body":       "Kursi %s ditahan untuk Anda sampai %s. Selesaikan pesanan sebelum waktu tahan habis.",
+	"email.now_playing.subject":       "%s sudah tayang",
+	"email.now_playing.body":          "%s dari watchlist Anda sudah tayang di bioskop. Pesan kursi sebelum kehabisan!",
+	"email.cinema_closed.subject":     "Pertunjukan %s di %s dibatalkan",
+	"email.cinema_closed.body":        "%s tutup mulai %s, sehingga pesanan %s dibatalkan. Mohon maaf atas ketidaknyamanannya.",
+	"email.cinema_closed.refund":      "%s tutup mulai %s, sehingga pesanan %s dibatalkan dan pembayaran %s akan dikembalikan. Mohon maaf atas ketidaknyamanannya.",
+}
@@ -1,9 +1,11 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
+	"cinema-booking/internal/data/entity"
 	"cinema-booking/internal/data/repository"
 	"cinema-booking/pkg/utils"
 
@@ -39,27 +41,79 @@ func AuthSession(sessionRepo repository.SessionRepository, logger *zap.Logger) f
 			session, err := sessionRepo.FindValidSession(r.Context(), token)
 			if err != nil {
 				logger.Error("Failed to validate session",
-					zap.String("token", token),
+					utils.TokenField(token),
 					zap.Error(err))
 				utils.ResponseInternalError(w, "Internal server error")
 				return
 			}
 
 			if session == nil {
-				logger.Warn("Invalid or expired session", zap.String("token", token))
+				logger.Warn("Invalid or expired session", utils.TokenField(token))
 				utils.ResponseUnauthorized(w, "Invalid or expired session")
 				return
 			}
 
 			// Set context dengan user info DAN token
-			ctx := utils.SetUserContext(r.Context(), session.UserID, "customer")
-			ctx = utils.SetTokenContext(ctx, token) // SET TOKEN!
+			ctx := sessionContext(w, r, session, token)
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// OptionalAuth sets user context kalau token valid, tapi request tanpa/invalid token tetap lanjut sebagai anonymous
+func OptionalAuth(sessionRepo repository.SessionRepository, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session, err := sessionRepo.FindValidSession(r.Context(), token)
+			if err != nil {
+				logger.Warn("Optional auth: failed to validate session", zap.Error(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if session == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := sessionContext(w, r, session, token)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// ImpersonatedByHeader response header di setiap request dari session impersonation, berisi ID admin
+const ImpersonatedByHeader = "X-Impersonated-By"
+
+// sessionContext sets user ID dan token session ke context. Session impersonation juga ditandai
+// di context, response header dan access log supaya tidak tertukar dengan aksi user sendiri.
+func sessionContext(w http.ResponseWriter, r *http.Request, session *entity.Session, token string) context.Context {
+	ctx := utils.SetUserContext(r.Context(), session.UserID, "customer")
+	ctx = utils.SetTokenContext(ctx, token)
+	// Middleware luar (access log, Recover) tidak melihat context ini, jadi user ID juga dititipkan di sini
+	utils.AddRequestLogFields(ctx, zap.String("user_id", session.UserID.String()))
+
+	if session.ImpersonatorID != nil {
+		ctx = utils.SetImpersonatorContext(ctx, *session.ImpersonatorID)
+		w.Header().Set(ImpersonatedByHeader, session.ImpersonatorID.String())
+		utils.AddRequestLogFields(ctx,
+			zap.Bool("impersonated", true),
+			zap.String("impersonator_id", session.ImpersonatorID.String()),
+			zap.String("impersonated_user_id", session.UserID.String()),
+		)
+	}
+
+	return ctx
+}
+
 // Admin - middleware cek role admin
 func Admin(userRepo repository.UserRepository, logger *zap.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -89,12 +143,87 @@ func Admin(userRepo repository.UserRepository, logger *zap.Logger) func(http.Han
 				return
 			}
 
-			// 4. Lanjut ke handler
+			// 4. Admin chain dibatasi ke cinema organization-nya; service membaca scope dari context
+			ctx := r.Context()
+			if user.OrganizationID != nil {
+				ctx = utils.SetOrganizationContext(ctx, *user.OrganizationID)
+			}
+
+			// 5. Lanjut ke handler
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// PlatformAdmin - middleware untuk data global (movie, user, payment method, dsb);
+// admin chain ditolak. Dipasang sesudah AuthSession.
+func PlatformAdmin(userRepo repository.UserRepository, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, ok := utils.GetUserIDFromContext(r.Context())
+			if !ok {
+				utils.ResponseUnauthorized(w, "Authentication required")
+				return
+			}
+
+			user, err := userRepo.FindByID(r.Context(), userID)
+			if err != nil {
+				logger.Error("Platform admin check: failed to get user",
+					zap.Error(err), zap.String("user_id", userID.String()))
+				utils.ResponseInternalError(w, "Internal server error")
+				return
+			}
+
+			if user == nil || !user.IsPlatformAdmin() {
+				logger.Warn("Platform admin check: access denied",
+					zap.String("user_id", userID.String()),
+					zap.String("path", r.URL.Path))
+				utils.ResponseForbidden(w, "Platform admin access required")
+				return
+			}
+
 			next.ServeHTTP(w, r)
 		})
 	}
 }
 
+// Staff - middleware cek role staff cinema; admin juga lolos
+func Staff(userRepo repository.UserRepository, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, ok := utils.GetUserIDFromContext(r.Context())
+			if !ok {
+				utils.ResponseUnauthorized(w, "Authentication required")
+				return
+			}
+
+			user, err := userRepo.FindByID(r.Context(), userID)
+			if err != nil {
+				logger.Error("Staff check: failed to get user",
+					zap.Error(err), zap.String("user_id", userID.String()))
+				utils.ResponseInternalError(w, "Internal server error")
+				return
+			}
+
+			if user == nil || !user.IsStaff() {
+				logger.Warn("Staff check: non-staff access attempt",
+					zap.String("user_id", userID.String()),
+					zap.String("path", r.URL.Path))
+				utils.ResponseForbidden(w, "Staff access required")
+				return
+			}
+
+			// Staff chain hanya melihat data organization-nya, sama seperti admin chain
+			ctx := r.Context()
+			if user.OrganizationID != nil {
+				ctx = utils.SetOrganizationContext(ctx, *user.OrganizationID)
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
 // // Helper untuk cek public routes (TERIMA METHOD PARAMETER!)
 // func isPublicRoute(path, method string) bool {
 // 	publicRoutes := map[string][]string{
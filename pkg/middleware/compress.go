@@ -0,0 +1,255 @@
+package middleware
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+// brotliLevel 4 kira-kira secepat gzip default tapi hasilnya lebih kecil, cocok untuk response dinamis
+const brotliLevel = 4
+
+// compressibleTypes media type yang layak dikompres; PDF, xlsx dan gambar sudah terkompres
+var compressibleTypes = map[string]bool{
+	"application/json":         true,
+	"application/xml":          true,
+	"application/rss+xml":      true,
+	"application/feed+json":    true,
+	"application/problem+json": true,
+	"text/plain":               true,
+	"text/csv":                 true,
+	"text/html":                true,
+	"text/xml":                 true,
+	"image/svg+xml":            true,
+}
+
+var (
+	gzipPool = sync.Pool{New: func() any {
+		w, _ := gzip.NewWriterLevel(io.Discard, gzip.DefaultCompression)
+		return w
+	}}
+	brotliPool = sync.Pool{New: func() any {
+		return brotli.NewWriterLevel(io.Discard, brotliLevel)
+	}}
+)
+
+// Compress encodes response dengan br atau gzip sesuai Accept-Encoding. Body di-buffer sampai minBytes
+// supaya response kecil (error, 304, payload pendek) tidak kena overhead kompresi; response yang
+// di-flush handler (export streaming) langsung dikompres tanpa menunggu batas.
+func Compress(minBytes int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Accept-Encoding")
+
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if encoding == "" || r.Method == http.MethodHead || r.Header.Get("Range") != "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &compressWriter{
+				ResponseWriter: w,
+				encoding:       encoding,
+				minBytes:       minBytes,
+				statusCode:     http.StatusOK,
+			}
+			defer cw.Close()
+
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// negotiateEncoding memilih br lalu gzip, mengabaikan encoding dengan q=0
+func negotiateEncoding(header string) string {
+	if header == "" {
+		return ""
+	}
+
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+		accepted[name] = q > 0
+	}
+
+	switch {
+	case accepted["br"]:
+		return "br"
+	case accepted["gzip"], accepted["*"]:
+		return "gzip"
+	default:
+		return ""
+	}
+}
+
+// compressWriter menunda WriteHeader sampai tahu apakah body akan dikompres
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	minBytes int
+
+	statusCode  int
+	wroteHeader bool // handler sudah memanggil WriteHeader
+	decided     bool // header sudah diteruskan ke client
+	buf         []byte
+	encoder     io.WriteCloser
+}
+
+func (cw *compressWriter) WriteHeader(code int) {
+	if cw.wroteHeader || cw.decided {
+		return
+	}
+	cw.wroteHeader = true
+	cw.statusCode = code
+
+	// Informational dan response tanpa body tidak perlu ditunda
+	if code < http.StatusOK || code == http.StatusNoContent || code == http.StatusNotModified {
+		cw.decided = true
+		cw.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if !cw.wroteHeader {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if cw.decided {
+		if cw.encoder != nil {
+			return cw.encoder.Write(p)
+		}
+		return cw.ResponseWriter.Write(p)
+	}
+
+	cw.buf = append(cw.buf, p...)
+	if len(cw.buf) >= cw.minBytes {
+		if err := cw.start(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush meneruskan data yang sudah ada ke client; dipakai export CSV yang streaming
+func (cw *compressWriter) Flush() {
+	if !cw.decided {
+		if !cw.wroteHeader {
+			cw.WriteHeader(http.StatusOK)
+		}
+		if err := cw.start(true); err != nil {
+			return
+		}
+	}
+
+	if f, ok := cw.encoder.(interface{ Flush() error }); ok {
+		f.Flush()
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack dibutuhkan kalau ada handler yang upgrade koneksi (mis. websocket)
+func (cw *compressWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := cw.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, http.ErrNotSupported
+}
+
+// Unwrap supaya http.ResponseController menemukan writer asli
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// Close menyelesaikan response: body di bawah minBytes dikirim apa adanya
+func (cw *compressWriter) Close() error {
+	if !cw.decided {
+		if !cw.wroteHeader {
+			// Handler tidak menulis apa pun; biarkan net/http mengirim 200 kosong
+			return nil
+		}
+		if err := cw.start(false); err != nil {
+			return err
+		}
+	}
+
+	if cw.encoder == nil {
+		return nil
+	}
+
+	err := cw.encoder.Close()
+	switch enc := cw.encoder.(type) {
+	case *gzip.Writer:
+		enc.Reset(io.Discard)
+		gzipPool.Put(enc)
+	case *brotli.Writer:
+		enc.Reset(io.Discard)
+		brotliPool.Put(enc)
+	}
+	cw.encoder = nil
+	return err
+}
+
+// start sends header yang tertunda lalu buffer, lewat encoder kalau compress dan tipe konten mendukung
+func (cw *compressWriter) start(compress bool) error {
+	cw.decided = true
+	h := cw.Header()
+
+	if h.Get("Content-Type") == "" && len(cw.buf) > 0 {
+		h.Set("Content-Type", http.DetectContentType(cw.buf))
+	}
+
+	if compress && h.Get("Content-Encoding") == "" && compressible(h.Get("Content-Type")) {
+		h.Set("Content-Encoding", cw.encoding)
+		h.Del("Content-Length")
+
+		switch cw.encoding {
+		case "br":
+			enc := brotliPool.Get().(*brotli.Writer)
+			enc.Reset(cw.ResponseWriter)
+			cw.encoder = enc
+		default:
+			enc := gzipPool.Get().(*gzip.Writer)
+			enc.Reset(cw.ResponseWriter)
+			cw.encoder = enc
+		}
+	}
+
+	cw.ResponseWriter.WriteHeader(cw.statusCode)
+
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if cw.encoder != nil {
+		_, err := cw.encoder.Write(buf)
+		return err
+	}
+	_, err := cw.ResponseWriter.Write(buf)
+	return err
+}
+
+func compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return compressibleTypes[mediaType]
+}
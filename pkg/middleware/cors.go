@@ -1,20 +1,50 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cinema-booking/pkg/utils"
+)
+
+// CORS applies the configured policy. Origin yang tidak diizinkan tidak mendapat header CORS
+// sama sekali, jadi browser yang memblok; request non-browser tetap jalan seperti biasa.
+func CORS(config utils.CORSConfig) func(http.Handler) http.Handler {
+	allowAll := slices.Contains(config.AllowedOrigins, "*")
+	methods := strings.Join(config.AllowedMethods, ", ")
+	headers := strings.Join(config.AllowedHeaders, ", ")
 
-func CORS() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
-			w.Header().Set("Access-Control-Allow-Credentials", "true")
+			origin := r.Header.Get("Origin")
+
+			switch {
+			case allowAll:
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			case origin != "" && slices.Contains(config.AllowedOrigins, origin):
+				// Origin dipantulkan, jadi cache harus membedakan response per origin
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Add("Vary", "Origin")
+				if config.AllowCredentials {
+					w.Header().Set("Access-Control-Allow-Credentials", "true")
+				}
+			}
 
-			if r.Method == "OPTIONS" {
+			if r.Method == http.MethodOptions {
+				w.Header().Set("Access-Control-Allow-Methods", methods)
+				w.Header().Set("Access-Control-Allow-Headers", headers)
+				if config.MaxAgeSeconds > 0 {
+					w.Header().Set("Access-Control-Max-Age", strconv.Itoa(config.MaxAgeSeconds))
+				}
 				w.WriteHeader(http.StatusOK)
 				return
 			}
 
+			// Supaya frontend bisa membaca ETag listing dan mengirimnya lagi sebagai If-None-Match,
+			// dan menampilkan banner saat session adalah impersonation admin
+			w.Header().Set("Access-Control-Expose-Headers", "ETag, "+ImpersonatedByHeader+", "+RequestIDHeader)
 			next.ServeHTTP(w, r)
 		})
 	}
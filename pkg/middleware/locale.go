@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"net/http"
+
+	"cinema-booking/pkg/i18n"
+)
+
+// Locale resolves bahasa response dari header Accept-Language dan menyimpannya di context
+func Locale() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lang := i18n.FromAcceptLanguage(r.Header.Get("Accept-Language"))
+
+			w.Header().Set("Content-Language", string(lang))
+			w.Header().Add("Vary", "Accept-Language")
+
+			next.ServeHTTP(w, r.WithContext(i18n.WithLang(r.Context(), lang)))
+		})
+	}
+}
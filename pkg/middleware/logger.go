@@ -2,11 +2,22 @@ package middleware
 
 import (
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
+	"cinema-booking/pkg/database"
+	"cinema-booking/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 )
 
+// sensitiveQueryParams nilainya tidak boleh masuk log access apa adanya
+var sensitiveQueryParams = map[string]bool{
+	"token": true, "otp": true, "code": true, "password": true, "email": true, "phone": true, "signature": true,
+}
+
 // Custom response writer to capture status code and response size
 type responseWriter struct {
 	http.ResponseWriter
@@ -42,22 +53,68 @@ func Logger(logger *zap.Logger) func(http.Handler) http.Handler {
 			}
 
 			// Process request through next handler
-			next.ServeHTTP(rw, r)
+			ctx := utils.WithRequestLogFields(r.Context())
+			next.ServeHTTP(rw, r.WithContext(ctx))
 
 			// Calculate request duration
 			duration := time.Since(start)
 
 			// Log request details
-			logger.Info("HTTP request",
+			fields := []zap.Field{
 				zap.String("method", r.Method),
 				zap.String("path", r.URL.Path),
-				zap.String("query", r.URL.RawQuery),
+				zap.String("route", routePattern(r)),
+				zap.String("query", redactQuery(r.URL.RawQuery)),
 				zap.Int("status", rw.statusCode),
 				zap.Int("bytes", rw.bytesWritten),
 				zap.Duration("duration", duration),
+				zap.Int64("db_queries", database.QueryCount(r.Context())),
 				zap.String("ip", r.RemoteAddr),
 				zap.String("user_agent", r.UserAgent()),
-			)
+			}
+			if requestID, ok := utils.GetRequestIDFromContext(ctx); ok {
+				fields = append(fields, zap.String("request_id", requestID))
+			}
+			logger.Info("HTTP request", append(fields, utils.RequestLogFields(ctx)...)...)
 		})
 	}
 }
+
+// routePattern returns pattern chi yang cocok (mis. /api/bookings/{id}), kosong kalau tidak ada route
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		return rctx.RoutePattern()
+	}
+	return ""
+}
+
+// redactQuery masks parameter sensitif di query string sebelum di-log
+func redactQuery(raw string) string {
+	if raw == "" {
+		return raw
+	}
+
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return "[unparseable]"
+	}
+
+	for key, vals := range values {
+		name := strings.ToLower(key)
+		if !sensitiveQueryParams[name] {
+			continue
+		}
+		for i, v := range vals {
+			switch name {
+			case "email":
+				vals[i] = utils.MaskEmail(v)
+			case "phone":
+				vals[i] = utils.MaskPhone(v)
+			default:
+				vals[i] = "[redacted]"
+			}
+		}
+	}
+
+	return values.Encode()
+}
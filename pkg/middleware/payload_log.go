@@ -0,0 +1,187 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// sensitiveBodyFields key JSON yang nilainya tidak boleh masuk log payload. Key yang mengandung
+// password, token atau secret juga di-redact, jadi new_password dan refresh_token ikut tertutup.
+var sensitiveBodyFields = map[string]bool{
+	"otp": true, "code": true, "pin": true, "cvv": true, "card_number": true,
+	"signature": true, "api_key": true, "authorization": true,
+}
+
+// sensitiveBodyPattern fallback untuk body yang bukan JSON valid (justru yang sering perlu dilihat)
+// atau terpotong di MaxBytes: semua pasangan "key": "value" dengan key sensitif ditutup
+var sensitiveBodyPattern = regexp.MustCompile(
+	`(?i)("[a-z_]*(?:password|token|secret|otp|code|pin|cvv|card_number|signature|api_key|authorization|email|phone)[a-z_]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+type payloadBody struct {
+	io.Reader
+	io.Closer
+}
+
+// payloadRecorder menyimpan status dan awal body response, body tetap dikirim utuh ke client
+type payloadRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+	maxBytes   int
+	truncated  bool
+}
+
+func (rw *payloadRecorder) WriteHeader(code int) {
+	rw.statusCode = code
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *payloadRecorder) Write(b []byte) (int, error) {
+	room := max(rw.maxBytes-rw.body.Len(), 0)
+	if len(b) > room {
+		rw.truncated = true
+	}
+	rw.body.Write(b[:min(room, len(b))])
+	return rw.ResponseWriter.Write(b)
+}
+
+// Unwrap supaya http.ResponseController menemukan writer asli
+func (rw *payloadRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// PayloadLog logs body request dan response dari sebagian request untuk mereproduksi masalah client
+// (mis. payload booking yang salah bentuk). Body dipotong di MaxBytes dan field sensitif di-redact;
+// upload multipart dan response biner hanya dicatat content type-nya.
+// Dipasang setelah BodyLimit supaya body yang dibaca sudah dibatasi.
+func PayloadLog(config utils.PayloadLogConfig, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !payloadLogRoute(config, r.URL.Path) || rand.Float64() >= config.SampleRate {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			requestContentType := r.Header.Get("Content-Type")
+			var requestBody []byte
+			requestTruncated := false
+			if r.Body != nil && r.Body != http.NoBody && loggableContentType(requestContentType) {
+				head, _ := io.ReadAll(io.LimitReader(r.Body, int64(config.MaxBytes)+1))
+				// Handler tetap membaca body utuh: bagian yang sudah dibaca disambung lagi di depan
+				r.Body = payloadBody{Reader: io.MultiReader(bytes.NewReader(head), r.Body), Closer: r.Body}
+				requestTruncated = len(head) > config.MaxBytes
+				requestBody = head[:min(len(head), config.MaxBytes)]
+			}
+
+			rw := &payloadRecorder{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+				maxBytes:       config.MaxBytes,
+			}
+			next.ServeHTTP(rw, r)
+
+			fields := []zap.Field{
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.String("route", routePattern(r)),
+				zap.Int("status", rw.statusCode),
+				zap.String("request_content_type", requestContentType),
+				zap.String("request_body", payloadText(requestContentType, requestBody)),
+				zap.Bool("request_truncated", requestTruncated),
+				zap.String("response_content_type", rw.Header().Get("Content-Type")),
+				zap.String("response_body", payloadText(rw.Header().Get("Content-Type"), rw.body.Bytes())),
+				zap.Bool("response_truncated", rw.truncated),
+			}
+			if requestID, ok := utils.GetRequestIDFromContext(r.Context()); ok {
+				fields = append(fields, zap.String("request_id", requestID))
+			}
+			logger.Info("HTTP payload", fields...)
+		})
+	}
+}
+
+// payloadLogRoute true kalau payload log aktif global atau path berada di bawah salah satu prefix
+func payloadLogRoute(config utils.PayloadLogConfig, path string) bool {
+	if config.Enabled {
+		return true
+	}
+	for _, prefix := range config.Routes {
+		prefix = strings.TrimRight(prefix, "/")
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// loggableContentType hanya body teks; content type kosong dianggap JSON karena client sering lupa header.
+// Form urlencoded tidak dicatat karena pola redaction hanya mengenali bentuk JSON.
+func loggableContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	contentType = strings.ToLower(contentType)
+	return strings.Contains(contentType, "json") ||
+		strings.HasPrefix(contentType, "text/") ||
+		strings.Contains(contentType, "xml")
+}
+
+// payloadText returns body yang sudah di-redact, atau penanda kalau body tidak dicatat
+func payloadText(contentType string, body []byte) string {
+	if !loggableContentType(contentType) {
+		return "[" + contentType + " omitted]"
+	}
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err == nil {
+		if redacted, err := json.Marshal(redactPayload(value)); err == nil {
+			return string(redacted)
+		}
+	}
+	return sensitiveBodyPattern.ReplaceAllString(string(body), `${1}"[redacted]"`)
+}
+
+// redactPayload walks JSON yang sudah di-decode dan menutup nilai field sensitif
+func redactPayload(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			name := strings.ToLower(key)
+			text, isString := field.(string)
+			switch {
+			case name == "email" && isString:
+				v[key] = utils.MaskEmail(text)
+			case name == "phone" && isString:
+				v[key] = utils.MaskPhone(text)
+			case sensitiveBodyField(name):
+				v[key] = "[redacted]"
+			default:
+				v[key] = redactPayload(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactPayload(item)
+		}
+	}
+	return value
+}
+
+func sensitiveBodyField(name string) bool {
+	return sensitiveBodyFields[name] || sensitiveQueryParams[name] ||
+		strings.Contains(name, "password") ||
+		strings.Contains(name, "token") ||
+		strings.Contains(name, "secret")
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"cinema-booking/pkg/database"
+
+	"go.uber.org/zap"
+)
+
+// QueryCounter attaches counter query database ke context request. Request yang menjalankan
+// lebih dari warnAt query di-log supaya pola N+1 kelihatan di production (warnAt 0 = tanpa warning).
+// Dipasang sebelum Logger supaya Logger bisa ikut mencatat jumlahnya.
+func QueryCounter(warnAt int, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r = r.WithContext(database.WithQueryCounter(r.Context()))
+
+			next.ServeHTTP(w, r)
+
+			if count := database.QueryCount(r.Context()); warnAt > 0 && count > int64(warnAt) {
+				logger.Warn("Too many queries for one request",
+					zap.String("method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.Int64("db_queries", count),
+					zap.Int("threshold", warnAt),
+				)
+			}
+		})
+	}
+}
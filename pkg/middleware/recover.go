@@ -1,23 +1,51 @@
 package middleware
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"runtime/debug"
+	"time"
+
+	"cinema-booking/pkg/reporting"
+	"cinema-booking/pkg/utils"
 
 	"go.uber.org/zap"
 )
 
-// Recover middleware
-func Recover(logger *zap.Logger) func(http.Handler) http.Handler {
+// reportTimeout batas kirim satu event ke backend error reporting
+const reportTimeout = 10 * time.Second
+
+// Recover middleware: panic di-log beserta stack trace lalu diteruskan ke error reporter
+// (Sentry atau fallback log) bersama request ID dan user ID
+func Recover(reporter reporting.ErrorReporter, logger *zap.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			defer func() {
 				if err := recover(); err != nil {
-					logger.Error("PANIC recovered",
+					stack := string(debug.Stack())
+					fields := []zap.Field{
 						zap.Any("error", err),
 						zap.String("path", r.URL.Path),
 						zap.String("method", r.Method),
-						zap.Stack("stack"),
-					)
+						zap.String("stack", stack),
+					}
+					requestID, _ := utils.GetRequestIDFromContext(r.Context())
+					if requestID != "" {
+						fields = append(fields, zap.String("request_id", requestID))
+					}
+					logger.Error("PANIC recovered", append(fields, utils.RequestLogFields(r.Context())...)...)
+
+					ReportPanic(reporter, logger, reporting.Event{
+						Message:   fmt.Sprintf("panic: %v", err),
+						Stack:     stack,
+						RequestID: requestID,
+						UserID:    utils.RequestLogString(r.Context(), "user_id"),
+						Method:    r.Method,
+						Path:      r.URL.Path,
+						Route:     routePattern(r),
+						Tags:      map[string]string{"transport": "http"},
+					})
 
 					// Return internal server error
 					w.WriteHeader(http.StatusInternalServerError)
@@ -29,3 +57,19 @@ func Recover(logger *zap.Logger) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// ReportPanic mengirim event di goroutine terpisah supaya response 500 tidak menunggu backend;
+// gagal kirim hanya di-log
+func ReportPanic(reporter reporting.ErrorReporter, logger *zap.Logger, event reporting.Event) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), reportTimeout)
+		defer cancel()
+
+		if err := reporter.Report(ctx, event); err != nil {
+			logger.Warn("Failed to report panic",
+				zap.Error(err),
+				zap.String("request_id", event.RequestID),
+			)
+		}
+	}()
+}
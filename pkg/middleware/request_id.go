@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	"cinema-booking/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader header untuk meneruskan ID request dari proxy/client dan mengembalikannya di response
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDPattern membatasi ID dari luar supaya tidak bisa menyisipkan isi aneh ke log
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestID memakai X-Request-ID dari upstream kalau valid, selain itu membuat UUID baru
+func RequestID() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if !requestIDPattern.MatchString(requestID) {
+				requestID = uuid.NewString()
+			}
+
+			w.Header().Set(RequestIDHeader, requestID)
+			next.ServeHTTP(w, r.WithContext(utils.SetRequestIDContext(r.Context(), requestID)))
+		})
+	}
+}
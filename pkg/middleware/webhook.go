@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"cinema-booking/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// WebhookSignatureHeader berisi hex HMAC-SHA256 dari raw body callback
+const WebhookSignatureHeader = "X-Webhook-Signature"
+
+// maxWebhookBody batas body callback yang dibaca untuk verifikasi
+const maxWebhookBody = 64 << 10
+
+// WebhookSignature middleware verifies callback gateway sebelum body di-decode handler
+func WebhookSignature(secret string, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+			if err != nil {
+				utils.ResponseBadRequest(w, "Invalid request body", nil)
+				return
+			}
+
+			signature, err := hex.DecodeString(r.Header.Get(WebhookSignatureHeader))
+			if err != nil || len(signature) == 0 {
+				logger.Warn("Webhook rejected - missing signature", zap.String("path", r.URL.Path))
+				utils.ResponseUnauthorized(w, "Invalid webhook signature")
+				return
+			}
+
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write(body)
+			if !hmac.Equal(signature, mac.Sum(nil)) {
+				logger.Warn("Webhook rejected - signature mismatch", zap.String("path", r.URL.Path))
+				utils.ResponseUnauthorized(w, "Invalid webhook signature")
+				return
+			}
+
+			// Body sudah dibaca, pasang ulang untuk handler
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
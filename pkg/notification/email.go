@@ -0,0 +1,164 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+
+	"cinema-booking/pkg/resilience"
+	"cinema-booking/pkg/utils"
+)
+
+// EmailSender sends notifications through SMTP
+type EmailSender struct {
+	config utils.EmailConfig
+}
+
+func NewEmailSender(config utils.EmailConfig) *EmailSender {
+	return &EmailSender{config: config}
+}
+
+func (s *EmailSender) Channel() Channel {
+	return ChannelEmail
+}
+
+func (s *EmailSender) Send(ctx context.Context, to Recipient, msg Message) error {
+	if to.Email == "" {
+		return resilience.Permanent(fmt.Errorf("recipient %s has no email address", to.UserID))
+	}
+
+	var auth smtp.Auth
+	if s.config.User != "" {
+		auth = smtp.PlainAuth("", s.config.User, s.config.Password, s.config.Host)
+	}
+
+	var body bytes.Buffer
+	body.WriteString("From: " + s.config.From + "\r\n")
+	body.WriteString("To: " + to.Email + "\r\n")
+	body.WriteString("Subject: " + msg.Subject + "\r\n")
+	body.WriteString("MIME-Version: 1.0\r\n")
+
+	if len(msg.Attachments) == 0 {
+		body.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n\r\n")
+		body.WriteString(msg.Body)
+	} else if err := writeMultipart(&body, msg); err != nil {
+		return err
+	}
+
+	if err := s.sendMail(ctx, auth, to.Email, body.Bytes()); err != nil {
+		return fmt.Errorf("send email to %s: %w", utils.MaskEmail(to.Email), err)
+	}
+
+	return nil
+}
+
+// sendMail sama seperti smtp.SendMail (STARTTLS kalau didukung, lalu AUTH), tapi koneksinya
+// mengikuti deadline ctx supaya server SMTP yang lambat tidak menggantung pengirim
+func (s *EmailSender) sendMail(ctx context.Context, auth smtp.Auth, to string, msg []byte) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port)))
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); ok {
+			if err := client.Auth(auth); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := client.Mail(s.config.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// writeMultipart writes a multipart/mixed body: teks dulu, lalu tiap attachment dalam base64
+func writeMultipart(body *bytes.Buffer, msg Message) error {
+	mw := multipart.NewWriter(body)
+	body.WriteString("Content-Type: multipart/mixed; boundary=\"" + mw.Boundary() + "\"\r\n\r\n")
+
+	textPart, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {`text/plain; charset="utf-8"`},
+	})
+	if err != nil {
+		return fmt.Errorf("create email text part: %w", err)
+	}
+	if _, err := textPart.Write([]byte(msg.Body)); err != nil {
+		return fmt.Errorf("write email text part: %w", err)
+	}
+
+	for _, attachment := range msg.Attachments {
+		contentType := attachment.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mime.FormatMediaType(contentType, map[string]string{"name": attachment.Filename})},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return fmt.Errorf("create email attachment %s: %w", attachment.Filename, err)
+		}
+
+		// RFC 2045: baris base64 maksimal 76 karakter
+		encoded := base64.StdEncoding.EncodeToString(attachment.Content)
+		for len(encoded) > 76 {
+			if _, err := part.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+				return fmt.Errorf("write email attachment %s: %w", attachment.Filename, err)
+			}
+			encoded = encoded[76:]
+		}
+		if _, err := part.Write([]byte(encoded + "\r\n")); err != nil {
+			return fmt.Errorf("write email attachment %s: %w", attachment.Filename, err)
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return fmt.Errorf("close email multipart: %w", err)
+	}
+
+	return nil
+}
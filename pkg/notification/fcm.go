@@ -0,0 +1,227 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fcmScope    = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmEndpoint = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+)
+
+// serviceAccount is the subset of a Google service account key file yang dibutuhkan FCM
+type serviceAccount struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMSender sends push notifications through the Firebase Cloud Messaging HTTP v1 API
+type FCMSender struct {
+	projectID string
+	account   serviceAccount
+	key       *rsa.PrivateKey
+	client    *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMSender loads service account credentials from credentialsFile
+func NewFCMSender(projectID, credentialsFile string) (*FCMSender, error) {
+	raw, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("read FCM credentials: %w", err)
+	}
+
+	var account serviceAccount
+	if err := json.Unmarshal(raw, &account); err != nil {
+		return nil, fmt.Errorf("parse FCM credentials: %w", err)
+	}
+
+	block, _ := pem.Decode([]byte(account.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("invalid FCM private key")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse FCM private key: %w", err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("FCM private key is not RSA")
+	}
+
+	if projectID == "" {
+		projectID = account.ProjectID
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+
+	return &FCMSender{
+		projectID: projectID,
+		account:   account,
+		key:       key,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (s *FCMSender) Channel() Channel {
+	return ChannelPush
+}
+
+func (s *FCMSender) Send(ctx context.Context, to Recipient, msg Message) error {
+	if len(to.DeviceTokens) == 0 {
+		return nil
+	}
+
+	token, err := s.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, deviceToken := range to.DeviceTokens {
+		if err := s.sendToDevice(ctx, token, deviceToken, msg); err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("send push to %d of %d devices failed: %s",
+			len(failed), len(to.DeviceTokens), strings.Join(failed, "; "))
+	}
+
+	return nil
+}
+
+func (s *FCMSender) sendToDevice(ctx context.Context, accessToken, deviceToken string, msg Message) error {
+	payload := map[string]any{
+		"message": map[string]any{
+			"token": deviceToken,
+			"notification": map[string]string{
+				"title": msg.Subject,
+				"body":  msg.Body,
+			},
+			"data": msg.Data,
+		},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal FCM payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf(fcmEndpoint, s.projectID), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build FCM request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send FCM request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("FCM returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+// token returns a cached OAuth2 access token, refresh kalau hampir expired
+func (s *FCMSender) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && time.Until(s.expiresAt) > time.Minute {
+		return s.accessToken, nil
+	}
+
+	assertion, err := s.signJWT()
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request FCM access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("decode token response: %w", err)
+	}
+
+	s.accessToken = tokenResp.AccessToken
+	s.expiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+
+	return s.accessToken, nil
+}
+
+func (s *FCMSender) signJWT() (string, error) {
+	now := time.Now()
+
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]any{
+		"iss":   s.account.ClientEmail,
+		"scope": fcmScope,
+		"aud":   s.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(nil, s.key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", fmt.Errorf("sign FCM JWT: %w", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
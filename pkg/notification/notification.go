@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"context"
+
+	"cinema-booking/pkg/resilience"
+
+	"go.uber.org/zap"
+)
+
+// Channel identifies the delivery medium of a notification
+type Channel string
+
+const (
+	ChannelEmail Channel = "email"
+	ChannelPush  Channel = "push"
+	ChannelSMS   Channel = "sms"
+)
+
+// Recipient berisi alamat tujuan untuk setiap channel
+type Recipient struct {
+	UserID       string
+	Email        string
+	Phone        string
+	DeviceTokens []string
+}
+
+// Message is a channel-agnostic notification payload
+type Message struct {
+	Subject string
+	Body    string
+	Data    map[string]string
+
+	// Attachments hanya dikirim lewat email; channel lain mengabaikannya
+	Attachments []Attachment
+}
+
+// Attachment is a file sent along with an email notification
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// Sender delivers a message over a single channel
+type Sender interface {
+	Channel() Channel
+	Send(ctx context.Context, to Recipient, msg Message) error
+}
+
+// LogSender only writes notifications to the log, dipakai saat channel belum dikonfigurasi
+type LogSender struct {
+	channel Channel
+	log     *zap.Logger
+}
+
+func NewLogSender(channel Channel, log *zap.Logger) *LogSender {
+	return &LogSender{
+		channel: channel,
+		log:     log.With(zap.String("sender", string(channel))),
+	}
+}
+
+func (s *LogSender) Channel() Channel {
+	return s.channel
+}
+
+func (s *LogSender) Send(ctx context.Context, to Recipient, msg Message) error {
+	s.log.Info("Notification (log only)",
+		zap.String("user_id", to.UserID),
+		zap.String("subject", msg.Subject),
+		zap.Int("attachments", len(msg.Attachments)),
+	)
+	return nil
+}
+
+// ResilientSender membungkus sender eksternal dengan timeout, retry dan circuit breaker
+type ResilientSender struct {
+	sender Sender
+	policy *resilience.Policy
+}
+
+func NewResilientSender(sender Sender, policy *resilience.Policy) *ResilientSender {
+	return &ResilientSender{sender: sender, policy: policy}
+}
+
+func (s *ResilientSender) Channel() Channel {
+	return s.sender.Channel()
+}
+
+func (s *ResilientSender) Send(ctx context.Context, to Recipient, msg Message) error {
+	return s.policy.Do(ctx, func(ctx context.Context) error {
+		return s.sender.Send(ctx, to, msg)
+	})
+}
@@ -0,0 +1,1481 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: cinema/v1/cinema.proto
+
+package cinemav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Movie struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PosterUrl         string                 `protobuf:"bytes,4,opt,name=poster_url,json=posterUrl,proto3" json:"poster_url,omitempty"`
+	Rating            float64                `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReviewCount       int32                  `protobuf:"varint,6,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	ReleaseDate       string                 `protobuf:"bytes,7,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	DurationInMinutes int32                  `protobuf:"varint,8,opt,name=duration_in_minutes,json=durationInMinutes,proto3" json:"duration_in_minutes,omitempty"`
+	Genres            []string               `protobuf:"bytes,9,rep,name=genres,proto3" json:"genres,omitempty"`
+	ReleaseStatus     string                 `protobuf:"bytes,10,opt,name=release_status,json=releaseStatus,proto3" json:"release_status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Movie) Reset() {
+	*x = Movie{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Movie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Movie) ProtoMessage() {}
+
+func (x *Movie) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Movie.ProtoReflect.Descriptor instead.
+func (*Movie) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Movie) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Movie) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Movie) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Movie) GetPosterUrl() string {
+	if x != nil {
+		return x.PosterUrl
+	}
+	return ""
+}
+
+func (x *Movie) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Movie) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *Movie) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *Movie) GetDurationInMinutes() int32 {
+	if x != nil {
+		return x.DurationInMinutes
+	}
+	return 0
+}
+
+func (x *Movie) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Movie) GetReleaseStatus() string {
+	if x != nil {
+		return x.ReleaseStatus
+	}
+	return ""
+}
+
+type ListMoviesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Page    int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// now | coming_soon | ended, kosong = semua
+	ReleaseStatus string `protobuf:"bytes,3,opt,name=release_status,json=releaseStatus,proto3" json:"release_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMoviesRequest) Reset() {
+	*x = ListMoviesRequest{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMoviesRequest) ProtoMessage() {}
+
+func (x *ListMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMoviesRequest.ProtoReflect.Descriptor instead.
+func (*ListMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMoviesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMoviesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListMoviesRequest) GetReleaseStatus() string {
+	if x != nil {
+		return x.ReleaseStatus
+	}
+	return ""
+}
+
+type ListMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMoviesResponse) Reset() {
+	*x = ListMoviesResponse{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMoviesResponse) ProtoMessage() {}
+
+func (x *ListMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMoviesResponse.ProtoReflect.Descriptor instead.
+func (*ListMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMoviesResponse) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+func (x *ListMoviesResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieRequest) Reset() {
+	*x = GetMovieRequest{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieRequest) ProtoMessage() {}
+
+func (x *GetMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieRequest.ProtoReflect.Descriptor instead.
+func (*GetMovieRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMovieRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieResponse) Reset() {
+	*x = GetMovieResponse{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieResponse) ProtoMessage() {}
+
+func (x *GetMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieResponse.ProtoReflect.Descriptor instead.
+func (*GetMovieResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+type Schedule struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieId    string                 `protobuf:"bytes,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	HallId     string                 `protobuf:"bytes,3,opt,name=hall_id,json=hallId,proto3" json:"hall_id,omitempty"`
+	HallNumber int32                  `protobuf:"varint,4,opt,name=hall_number,json=hallNumber,proto3" json:"hall_number,omitempty"`
+	CinemaId   string                 `protobuf:"bytes,5,opt,name=cinema_id,json=cinemaId,proto3" json:"cinema_id,omitempty"`
+	CinemaName string                 `protobuf:"bytes,6,opt,name=cinema_name,json=cinemaName,proto3" json:"cinema_name,omitempty"`
+	ShowDate   string                 `protobuf:"bytes,7,opt,name=show_date,json=showDate,proto3" json:"show_date,omitempty"`
+	ShowTime   string                 `protobuf:"bytes,8,opt,name=show_time,json=showTime,proto3" json:"show_time,omitempty"`
+	Price      float64                `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	// 2D, 3D, IMAX or 4DX; price already includes the format premium
+	HallType string `protobuf:"bytes,10,opt,name=hall_type,json=hallType,proto3" json:"hall_type,omitempty"`
+	// ISO 4217 code; price is in major units of this currency
+	Currency string `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	// RFC 3339 start time with the cinema's UTC offset; show_date/show_time are cinema wall-clock
+	StartsAt string `protobuf:"bytes,12,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// IANA time zone of the cinema, e.g. Asia/Jakarta
+	Timezone      string `protobuf:"bytes,13,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Schedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Schedule) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *Schedule) GetHallId() string {
+	if x != nil {
+		return x.HallId
+	}
+	return ""
+}
+
+func (x *Schedule) GetHallNumber() int32 {
+	if x != nil {
+		return x.HallNumber
+	}
+	return 0
+}
+
+func (x *Schedule) GetCinemaId() string {
+	if x != nil {
+		return x.CinemaId
+	}
+	return ""
+}
+
+func (x *Schedule) GetCinemaName() string {
+	if x != nil {
+		return x.CinemaName
+	}
+	return ""
+}
+
+func (x *Schedule) GetShowDate() string {
+	if x != nil {
+		return x.ShowDate
+	}
+	return ""
+}
+
+func (x *Schedule) GetShowTime() string {
+	if x != nil {
+		return x.ShowTime
+	}
+	return ""
+}
+
+func (x *Schedule) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Schedule) GetHallType() string {
+	if x != nil {
+		return x.HallType
+	}
+	return ""
+}
+
+func (x *Schedule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Schedule) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Schedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieId       string                 `protobuf:"bytes,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSchedulesRequest) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalRecords  int64                  `protobuf:"varint,4,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Pagination) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+type GetSeatAvailabilityRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CinemaId string                 `protobuf:"bytes,1,opt,name=cinema_id,json=cinemaId,proto3" json:"cinema_id,omitempty"`
+	// YYYY-MM-DD
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// HH:MM
+	Time          string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatAvailabilityRequest) Reset() {
+	*x = GetSeatAvailabilityRequest{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetSeatAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSeatAvailabilityRequest) GetCinemaId() string {
+	if x != nil {
+		return x.CinemaId
+	}
+	return ""
+}
+
+func (x *GetSeatAvailabilityRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetSeatAvailabilityRequest) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type Seat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	SeatRow       string                 `protobuf:"bytes,3,opt,name=seat_row,json=seatRow,proto3" json:"seat_row,omitempty"`
+	SeatColumn    int32                  `protobuf:"varint,4,opt,name=seat_column,json=seatColumn,proto3" json:"seat_column,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,5,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Seat) Reset() {
+	*x = Seat{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Seat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seat) ProtoMessage() {}
+
+func (x *Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Seat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Seat) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *Seat) GetSeatRow() string {
+	if x != nil {
+		return x.SeatRow
+	}
+	return ""
+}
+
+func (x *Seat) GetSeatColumn() int32 {
+	if x != nil {
+		return x.SeatColumn
+	}
+	return 0
+}
+
+func (x *Seat) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+type HallSeats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HallId        string                 `protobuf:"bytes,1,opt,name=hall_id,json=hallId,proto3" json:"hall_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Time          string                 `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Seats         []*Seat                `protobuf:"bytes,4,rep,name=seats,proto3" json:"seats,omitempty"`
+	HallType      string                 `protobuf:"bytes,5,opt,name=hall_type,json=hallType,proto3" json:"hall_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HallSeats) Reset() {
+	*x = HallSeats{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HallSeats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HallSeats) ProtoMessage() {}
+
+func (x *HallSeats) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HallSeats.ProtoReflect.Descriptor instead.
+func (*HallSeats) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HallSeats) GetHallId() string {
+	if x != nil {
+		return x.HallId
+	}
+	return ""
+}
+
+func (x *HallSeats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *HallSeats) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *HallSeats) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *HallSeats) GetHallType() string {
+	if x != nil {
+		return x.HallType
+	}
+	return ""
+}
+
+type GetSeatAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Halls         []*HallSeats           `protobuf:"bytes,1,rep,name=halls,proto3" json:"halls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatAvailabilityResponse) Reset() {
+	*x = GetSeatAvailabilityResponse{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetSeatAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSeatAvailabilityResponse) GetHalls() []*HallSeats {
+	if x != nil {
+		return x.Halls
+	}
+	return nil
+}
+
+type CreateBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Booking dibuat atas nama user ini (kiosk tidak punya session)
+	UserId          string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId      string   `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	SeatIds         []string `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	PaymentMethodId string   `protobuf:"bytes,4,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateBookingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+type CreateBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type Booking struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MovieTitle     string                 `protobuf:"bytes,5,opt,name=movie_title,json=movieTitle,proto3" json:"movie_title,omitempty"`
+	CinemaName     string                 `protobuf:"bytes,6,opt,name=cinema_name,json=cinemaName,proto3" json:"cinema_name,omitempty"`
+	HallNumber     int32                  `protobuf:"varint,7,opt,name=hall_number,json=hallNumber,proto3" json:"hall_number,omitempty"`
+	ShowDate       string                 `protobuf:"bytes,8,opt,name=show_date,json=showDate,proto3" json:"show_date,omitempty"`
+	ShowTime       string                 `protobuf:"bytes,9,opt,name=show_time,json=showTime,proto3" json:"show_time,omitempty"`
+	TotalSeats     int32                  `protobuf:"varint,10,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	TotalPrice     float64                `protobuf:"fixed64,11,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Status         string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	SeatNumbers    []string               `protobuf:"bytes,13,rep,name=seat_numbers,json=seatNumbers,proto3" json:"seat_numbers,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PriceBreakdown *PriceBreakdown        `protobuf:"bytes,15,opt,name=price_breakdown,json=priceBreakdown,proto3" json:"price_breakdown,omitempty"`
+	// ISO 4217 code for total_price and price_breakdown
+	Currency      string `protobuf:"bytes,16,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Booking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Booking) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Booking) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Booking) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *Booking) GetMovieTitle() string {
+	if x != nil {
+		return x.MovieTitle
+	}
+	return ""
+}
+
+func (x *Booking) GetCinemaName() string {
+	if x != nil {
+		return x.CinemaName
+	}
+	return ""
+}
+
+func (x *Booking) GetHallNumber() int32 {
+	if x != nil {
+		return x.HallNumber
+	}
+	return 0
+}
+
+func (x *Booking) GetShowDate() string {
+	if x != nil {
+		return x.ShowDate
+	}
+	return ""
+}
+
+func (x *Booking) GetShowTime() string {
+	if x != nil {
+		return x.ShowTime
+	}
+	return ""
+}
+
+func (x *Booking) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *Booking) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Booking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Booking) GetSeatNumbers() []string {
+	if x != nil {
+		return x.SeatNumbers
+	}
+	return nil
+}
+
+func (x *Booking) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Booking) GetPriceBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.PriceBreakdown
+	}
+	return nil
+}
+
+func (x *Booking) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// total = base - discount + fees + tax
+type PriceBreakdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatPrice     float64                `protobuf:"fixed64,1,opt,name=seat_price,json=seatPrice,proto3" json:"seat_price,omitempty"`
+	Seats         int32                  `protobuf:"varint,2,opt,name=seats,proto3" json:"seats,omitempty"`
+	Base          float64                `protobuf:"fixed64,3,opt,name=base,proto3" json:"base,omitempty"`
+	Discount      float64                `protobuf:"fixed64,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	Fees          float64                `protobuf:"fixed64,5,opt,name=fees,proto3" json:"fees,omitempty"`
+	Tax           float64                `protobuf:"fixed64,6,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total         float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_cinema_v1_cinema_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_v1_cinema_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_cinema_v1_cinema_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PriceBreakdown) GetSeatPrice() float64 {
+	if x != nil {
+		return x.SeatPrice
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetBase() float64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetFees() float64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_cinema_v1_cinema_proto protoreflect.FileDescriptor
+
+const file_cinema_v1_cinema_proto_rawDesc = "" +
+	"\n" +
+	"\x16cinema/v1/cinema.proto\x12\tcinema.v1\"\xbb\x02\n" +
+	"\x05Movie\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"poster_url\x18\x04 \x01(\tR\tposterUrl\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x01R\x06rating\x12!\n" +
+	"\freview_count\x18\x06 \x01(\x05R\vreviewCount\x12!\n" +
+	"\frelease_date\x18\a \x01(\tR\vreleaseDate\x12.\n" +
+	"\x13duration_in_minutes\x18\b \x01(\x05R\x11durationInMinutes\x12\x16\n" +
+	"\x06genres\x18\t \x03(\tR\x06genres\x12%\n" +
+	"\x0erelease_status\x18\n" +
+	" \x01(\tR\rreleaseStatus\"i\n" +
+	"\x11ListMoviesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12%\n" +
+	"\x0erelease_status\x18\x03 \x01(\tR\rreleaseStatus\"u\n" +
+	"\x12ListMoviesResponse\x12(\n" +
+	"\x06movies\x18\x01 \x03(\v2\x10.cinema.v1.MovieR\x06movies\x125\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x15.cinema.v1.PaginationR\n" +
+	"pagination\"!\n" +
+	"\x0fGetMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x10GetMovieResponse\x12&\n" +
+	"\x05movie\x18\x01 \x01(\v2\x10.cinema.v1.MovieR\x05movie\"\xef\x02\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmovie_id\x18\x02 \x01(\tR\amovieId\x12\x17\n" +
+	"\ahall_id\x18\x03 \x01(\tR\x06hallId\x12\x1f\n" +
+	"\vhall_number\x18\x04 \x01(\x05R\n" +
+	"hallNumber\x12\x1b\n" +
+	"\tcinema_id\x18\x05 \x01(\tR\bcinemaId\x12\x1f\n" +
+	"\vcinema_name\x18\x06 \x01(\tR\n" +
+	"cinemaName\x12\x1b\n" +
+	"\tshow_date\x18\a \x01(\tR\bshowDate\x12\x1b\n" +
+	"\tshow_time\x18\b \x01(\tR\bshowTime\x12\x14\n" +
+	"\x05price\x18\t \x01(\x01R\x05price\x12\x1b\n" +
+	"\thall_type\x18\n" +
+	" \x01(\tR\bhallType\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tstarts_at\x18\f \x01(\tR\bstartsAt\x12\x1a\n" +
+	"\btimezone\x18\r \x01(\tR\btimezone\"1\n" +
+	"\x14ListSchedulesRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\tR\amovieId\"J\n" +
+	"\x15ListSchedulesResponse\x121\n" +
+	"\tschedules\x18\x01 \x03(\v2\x13.cinema.v1.ScheduleR\tschedules\"\x8b\x01\n" +
+	"\n" +
+	"Pagination\x12!\n" +
+	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
+	"totalPages\x12#\n" +
+	"\rtotal_records\x18\x04 \x01(\x03R\ftotalRecords\"a\n" +
+	"\x1aGetSeatAvailabilityRequest\x12\x1b\n" +
+	"\tcinema_id\x18\x01 \x01(\tR\bcinemaId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\"\x96\x01\n" +
+	"\x04Seat\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vseat_number\x18\x02 \x01(\tR\n" +
+	"seatNumber\x12\x19\n" +
+	"\bseat_row\x18\x03 \x01(\tR\aseatRow\x12\x1f\n" +
+	"\vseat_column\x18\x04 \x01(\x05R\n" +
+	"seatColumn\x12!\n" +
+	"\fis_available\x18\x05 \x01(\bR\visAvailable\"\x90\x01\n" +
+	"\tHallSeats\x12\x17\n" +
+	"\ahall_id\x18\x01 \x01(\tR\x06hallId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\x12%\n" +
+	"\x05seats\x18\x04 \x03(\v2\x0f.cinema.v1.SeatR\x05seats\x12\x1b\n" +
+	"\thall_type\x18\x05 \x01(\tR\bhallType\"I\n" +
+	"\x1bGetSeatAvailabilityResponse\x12*\n" +
+	"\x05halls\x18\x01 \x03(\v2\x14.cinema.v1.HallSeatsR\x05halls\"\x97\x01\n" +
+	"\x14CreateBookingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12\x19\n" +
+	"\bseat_ids\x18\x03 \x03(\tR\aseatIds\x12*\n" +
+	"\x11payment_method_id\x18\x04 \x01(\tR\x0fpaymentMethodId\"E\n" +
+	"\x15CreateBookingResponse\x12,\n" +
+	"\abooking\x18\x01 \x01(\v2\x12.cinema.v1.BookingR\abooking\"\x87\x04\n" +
+	"\aBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x04 \x01(\tR\n" +
+	"scheduleId\x12\x1f\n" +
+	"\vmovie_title\x18\x05 \x01(\tR\n" +
+	"movieTitle\x12\x1f\n" +
+	"\vcinema_name\x18\x06 \x01(\tR\n" +
+	"cinemaName\x12\x1f\n" +
+	"\vhall_number\x18\a \x01(\x05R\n" +
+	"hallNumber\x12\x1b\n" +
+	"\tshow_date\x18\b \x01(\tR\bshowDate\x12\x1b\n" +
+	"\tshow_time\x18\t \x01(\tR\bshowTime\x12\x1f\n" +
+	"\vtotal_seats\x18\n" +
+	" \x01(\x05R\n" +
+	"totalSeats\x12\x1f\n" +
+	"\vtotal_price\x18\v \x01(\x01R\n" +
+	"totalPrice\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12!\n" +
+	"\fseat_numbers\x18\r \x03(\tR\vseatNumbers\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12B\n" +
+	"\x0fprice_breakdown\x18\x0f \x01(\v2\x19.cinema.v1.PriceBreakdownR\x0epriceBreakdown\x12\x1a\n" +
+	"\bcurrency\x18\x10 \x01(\tR\bcurrency\"\xb1\x01\n" +
+	"\x0ePriceBreakdown\x12\x1d\n" +
+	"\n" +
+	"seat_price\x18\x01 \x01(\x01R\tseatPrice\x12\x14\n" +
+	"\x05seats\x18\x02 \x01(\x05R\x05seats\x12\x12\n" +
+	"\x04base\x18\x03 \x01(\x01R\x04base\x12\x1a\n" +
+	"\bdiscount\x18\x04 \x01(\x01R\bdiscount\x12\x12\n" +
+	"\x04fees\x18\x05 \x01(\x01R\x04fees\x12\x10\n" +
+	"\x03tax\x18\x06 \x01(\x01R\x03tax\x12\x14\n" +
+	"\x05total\x18\a \x01(\x01R\x05total2\xf2\x01\n" +
+	"\fMovieService\x12I\n" +
+	"\n" +
+	"ListMovies\x12\x1c.cinema.v1.ListMoviesRequest\x1a\x1d.cinema.v1.ListMoviesResponse\x12C\n" +
+	"\bGetMovie\x12\x1a.cinema.v1.GetMovieRequest\x1a\x1b.cinema.v1.GetMovieResponse\x12R\n" +
+	"\rListSchedules\x12\x1f.cinema.v1.ListSchedulesRequest\x1a .cinema.v1.ListSchedulesResponse2u\n" +
+	"\rCinemaService\x12d\n" +
+	"\x13GetSeatAvailability\x12%.cinema.v1.GetSeatAvailabilityRequest\x1a&.cinema.v1.GetSeatAvailabilityResponse2d\n" +
+	"\x0eBookingService\x12R\n" +
+	"\rCreateBooking\x12\x1f.cinema.v1.CreateBookingRequest\x1a .cinema.v1.CreateBookingResponseB*Z(cinema-booking/pkg/pb/cinema/v1;cinemav1b\x06proto3"
+
+var (
+	file_cinema_v1_cinema_proto_rawDescOnce sync.Once
+	file_cinema_v1_cinema_proto_rawDescData []byte
+)
+
+func file_cinema_v1_cinema_proto_rawDescGZIP() []byte {
+	file_cinema_v1_cinema_proto_rawDescOnce.Do(func() {
+		file_cinema_v1_cinema_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cinema_v1_cinema_proto_rawDesc), len(file_cinema_v1_cinema_proto_rawDesc)))
+	})
+	return file_cinema_v1_cinema_proto_rawDescData
+}
+
+var file_cinema_v1_cinema_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_cinema_v1_cinema_proto_goTypes = []any{
+	(*Movie)(nil),                       // 0: cinema.v1.Movie
+	(*ListMoviesRequest)(nil),           // 1: cinema.v1.ListMoviesRequest
+	(*ListMoviesResponse)(nil),          // 2: cinema.v1.ListMoviesResponse
+	(*GetMovieRequest)(nil),             // 3: cinema.v1.GetMovieRequest
+	(*GetMovieResponse)(nil),            // 4: cinema.v1.GetMovieResponse
+	(*Schedule)(nil),                    // 5: cinema.v1.Schedule
+	(*ListSchedulesRequest)(nil),        // 6: cinema.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),       // 7: cinema.v1.ListSchedulesResponse
+	(*Pagination)(nil),                  // 8: cinema.v1.Pagination
+	(*GetSeatAvailabilityRequest)(nil),  // 9: cinema.v1.GetSeatAvailabilityRequest
+	(*Seat)(nil),                        // 10: cinema.v1.Seat
+	(*HallSeats)(nil),                   // 11: cinema.v1.HallSeats
+	(*GetSeatAvailabilityResponse)(nil), // 12: cinema.v1.GetSeatAvailabilityResponse
+	(*CreateBookingRequest)(nil),        // 13: cinema.v1.CreateBookingRequest
+	(*CreateBookingResponse)(nil),       // 14: cinema.v1.CreateBookingResponse
+	(*Booking)(nil),                     // 15: cinema.v1.Booking
+	(*PriceBreakdown)(nil),              // 16: cinema.v1.PriceBreakdown
+}
+var file_cinema_v1_cinema_proto_depIdxs = []int32{
+	0,  // 0: cinema.v1.ListMoviesResponse.movies:type_name -> cinema.v1.Movie
+	8,  // 1: cinema.v1.ListMoviesResponse.pagination:type_name -> cinema.v1.Pagination
+	0,  // 2: cinema.v1.GetMovieResponse.movie:type_name -> cinema.v1.Movie
+	5,  // 3: cinema.v1.ListSchedulesResponse.schedules:type_name -> cinema.v1.Schedule
+	10, // 4: cinema.v1.HallSeats.seats:type_name -> cinema.v1.Seat
+	11, // 5: cinema.v1.GetSeatAvailabilityResponse.halls:type_name -> cinema.v1.HallSeats
+	15, // 6: cinema.v1.CreateBookingResponse.booking:type_name -> cinema.v1.Booking
+	16, // 7: cinema.v1.Booking.price_breakdown:type_name -> cinema.v1.PriceBreakdown
+	1,  // 8: cinema.v1.MovieService.ListMovies:input_type -> cinema.v1.ListMoviesRequest
+	3,  // 9: cinema.v1.MovieService.GetMovie:input_type -> cinema.v1.GetMovieRequest
+	6,  // 10: cinema.v1.MovieService.ListSchedules:input_type -> cinema.v1.ListSchedulesRequest
+	9,  // 11: cinema.v1.CinemaService.GetSeatAvailability:input_type -> cinema.v1.GetSeatAvailabilityRequest
+	13, // 12: cinema.v1.BookingService.CreateBooking:input_type -> cinema.v1.CreateBookingRequest
+	2,  // 13: cinema.v1.MovieService.ListMovies:output_type -> cinema.v1.ListMoviesResponse
+	4,  // 14: cinema.v1.MovieService.GetMovie:output_type -> cinema.v1.GetMovieResponse
+	7,  // 15: cinema.v1.MovieService.ListSchedules:output_type -> cinema.v1.ListSchedulesResponse
+	12, // 16: cinema.v1.CinemaService.GetSeatAvailability:output_type -> cinema.v1.GetSeatAvailabilityResponse
+	14, // 17: cinema.v1.BookingService.CreateBooking:output_type -> cinema.v1.CreateBookingResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_cinema_v1_cinema_proto_init() }
+func file_cinema_v1_cinema_proto_init() {
+	if File_cinema_v1_cinema_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cinema_v1_cinema_proto_rawDesc), len(file_cinema_v1_cinema_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_cinema_v1_cinema_proto_goTypes,
+		DependencyIndexes: file_cinema_v1_cinema_proto_depIdxs,
+		MessageInfos:      file_cinema_v1_cinema_proto_msgTypes,
+	}.Build()
+	File_cinema_v1_cinema_proto = out.File
+	file_cinema_v1_cinema_proto_goTypes = nil
+	file_cinema_v1_cinema_proto_depIdxs = nil
+}
@@ -0,0 +1,235 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A4 dalam point (1/72 inch)
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font is one of the standard Type1 fonts; tidak perlu embed karena semua viewer menyediakannya
+type Font int
+
+const (
+	FontRegular Font = iota
+	FontBold
+)
+
+func (f Font) resource() string {
+	if f == FontBold {
+		return "F2"
+	}
+	return "F1"
+}
+
+// Document builds a text-and-lines PDF in memory. Koordinat memakai origin kiri atas
+// (y bertambah ke bawah) supaya layout lebih mudah dibaca; konversi ke origin PDF dilakukan di sini.
+type Document struct {
+	pages []*bytes.Buffer
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+// AddPage starts a new A4 page; Text dan Line selalu menggambar di page terakhir
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+func (d *Document) current() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// Text draws text with its baseline at (x, y)
+func (d *Document) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(d.current(), "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+		font.resource(), num(size), num(x), num(PageHeight-y), escape(encode(text)))
+}
+
+// TextRight draws text so that it ends at x, dipakai untuk kolom nominal
+func (d *Document) TextRight(x, y float64, font Font, size float64, text string) {
+	d.Text(x-TextWidth(font, size, text), y, font, size, text)
+}
+
+// Line draws a straight line dengan ketebalan width point
+func (d *Document) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(d.current(), "%s w %s %s m %s %s l S\n",
+		num(width), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// TextWidth returns the rendered width of text in points
+func TextWidth(font Font, size float64, text string) float64 {
+	widths := &helveticaWidths
+	if font == FontBold {
+		widths = &helveticaBoldWidths
+	}
+
+	total := 0
+	for _, b := range encode(text) {
+		if b >= 32 && b <= 126 {
+			total += widths[b-32]
+		} else {
+			total += defaultGlyphWidth
+		}
+	}
+
+	return float64(total) * size / 1000
+}
+
+// WriteTo serializes the document: catalog, pages tree, dua font, lalu page + content stream per halaman
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	var buf bytes.Buffer
+	offsets := []int{0} // object 0 selalu free entry di xref
+
+	startObj := func() int {
+		offsets = append(offsets, buf.Len())
+		id := len(offsets) - 1
+		fmt.Fprintf(&buf, "%d 0 obj\n", id)
+		return id
+	}
+	endObj := func() {
+		buf.WriteString("endobj\n")
+	}
+
+	// Object id tetap: 1 catalog, 2 pages, 3-4 font, lalu pasangan page/content mulai dari 5
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+i*2)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	startObj()
+	buf.WriteString("<< /Type /Catalog /Pages 2 0 R >>\n")
+	endObj()
+
+	startObj()
+	fmt.Fprintf(&buf, "<< /Type /Pages /Kids [%s] /Count %d >>\n", strings.Join(kids, " "), len(d.pages))
+	endObj()
+
+	for _, name := range []string{"Helvetica", "Helvetica-Bold"} {
+		startObj()
+		fmt.Fprintf(&buf, "<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>\n", name)
+		endObj()
+	}
+
+	for _, content := range d.pages {
+		pageID := startObj()
+		fmt.Fprintf(&buf, "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>\n",
+			num(PageWidth), num(PageHeight), pageID+1)
+		endObj()
+
+		startObj()
+		fmt.Fprintf(&buf, "<< /Length %d >>\nstream\n", content.Len())
+		buf.Write(content.Bytes())
+		buf.WriteString("endstream\n")
+		endObj()
+	}
+
+	xrefOffset := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n", len(offsets))
+	buf.WriteString("0000000000 65535 f \n")
+	for _, offset := range offsets[1:] {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets), xrefOffset)
+
+	n, err := w.Write(buf.Bytes())
+	if err != nil {
+		return int64(n), fmt.Errorf("write pdf: %w", err)
+	}
+
+	return int64(n), nil
+}
+
+// Bytes renders the whole document
+func (d *Document) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := d.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// num formats a coordinate tanpa trailing zero supaya content stream tetap ringkas
+func num(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// escape applies PDF literal string escaping
+func escape(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		switch c {
+		case '\\', '(', ')':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case '\r', '\n', '\t':
+			sb.WriteByte(' ')
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// winAnsiExtra rune di luar Latin-1 yang tetap ada di WinAnsiEncoding
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '•': 0x95,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// encode converts UTF-8 to WinAnsi; karakter yang tidak terwakili diganti '?'
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r < 0x80 || (r >= 0xa0 && r <= 0xff):
+			out = append(out, byte(r))
+		default:
+			if b, ok := winAnsiExtra[r]; ok {
+				out = append(out, b)
+			} else {
+				out = append(out, '?')
+			}
+		}
+	}
+	return out
+}
+
+// defaultGlyphWidth dipakai untuk karakter di luar ASCII printable
+const defaultGlyphWidth = 556
+
+// Glyph widths (1/1000 em) untuk karakter 32..126 dari AFM standar Adobe
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,45 @@
+package reporting
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// Event satu error (biasanya panic) yang diteruskan ke backend error reporting
+type Event struct {
+	Message string
+	Stack   string
+
+	RequestID string
+	UserID    string
+	Method    string
+	Path      string
+	Route     string
+
+	// Tags field tambahan yang bisa difilter di backend (mis. transport=grpc)
+	Tags map[string]string
+}
+
+// ErrorReporter meneruskan error ke backend seperti Sentry. Report dipanggil di luar jalur
+// response, jadi implementasi boleh melakukan request jaringan.
+type ErrorReporter interface {
+	Report(ctx context.Context, event Event) error
+}
+
+// LogReporter fallback kalau backend belum dikonfigurasi; panic tetap sudah di-log oleh pemanggil
+type LogReporter struct {
+	log *zap.Logger
+}
+
+func NewLogReporter(log *zap.Logger) *LogReporter {
+	return &LogReporter{log: log.With(zap.String("component", "error_reporter"))}
+}
+
+func (r *LogReporter) Report(ctx context.Context, event Event) error {
+	r.log.Debug("Error report not forwarded, no backend configured",
+		zap.String("message", event.Message),
+		zap.String("request_id", event.RequestID),
+	)
+	return nil
+}
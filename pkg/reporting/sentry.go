@@ -0,0 +1,143 @@
+package reporting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// sentryClientName dikirim di header auth supaya event bisa dibedakan per client
+const sentryClientName = "cinema-booking/1.0"
+
+// SentryReporter mengirim event ke store API Sentry (atau backend kompatibel seperti GlitchTip)
+// langsung lewat HTTP, tanpa SDK
+type SentryReporter struct {
+	endpoint    string
+	publicKey   string
+	environment string
+	release     string
+	serverName  string
+	client      *http.Client
+}
+
+// NewSentryReporter parses DSN format https://<public_key>@<host>/<project_id>
+func NewSentryReporter(dsn, environment, release string) (*SentryReporter, error) {
+	u, err := url.Parse(dsn)
+	if err != nil || u.User == nil || u.User.Username() == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid sentry dsn")
+	}
+
+	path := strings.Trim(u.Path, "/")
+	slash := strings.LastIndex(path, "/")
+	projectID := path[slash+1:]
+	if projectID == "" {
+		return nil, fmt.Errorf("invalid sentry dsn: missing project id")
+	}
+	prefix := ""
+	if slash >= 0 {
+		prefix = "/" + path[:slash]
+	}
+
+	serverName, _ := os.Hostname()
+
+	return &SentryReporter{
+		endpoint:    fmt.Sprintf("%s://%s%s/api/%s/store/", u.Scheme, u.Host, prefix, projectID),
+		publicKey:   u.User.Username(),
+		environment: environment,
+		release:     release,
+		serverName:  serverName,
+		client:      &http.Client{Timeout: 5 * time.Second},
+	}, nil
+}
+
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Logger      string            `json:"logger"`
+	Message     string            `json:"message"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	User        *sentryUser       `json:"user,omitempty"`
+	Request     *sentryRequest    `json:"request,omitempty"`
+	Extra       map[string]string `json:"extra,omitempty"`
+}
+
+type sentryUser struct {
+	ID string `json:"id"`
+}
+
+type sentryRequest struct {
+	Method string `json:"method,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+func (r *SentryReporter) Report(ctx context.Context, event Event) error {
+	tags := map[string]string{}
+	for key, value := range event.Tags {
+		tags[key] = value
+	}
+	if event.RequestID != "" {
+		tags["request_id"] = event.RequestID
+	}
+	if event.Route != "" {
+		tags["route"] = event.Route
+	}
+
+	payload := sentryEvent{
+		EventID:     strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Level:       "fatal",
+		Platform:    "go",
+		Logger:      "panic",
+		Message:     event.Message,
+		Environment: r.environment,
+		Release:     r.release,
+		ServerName:  r.serverName,
+		Tags:        tags,
+		Extra:       map[string]string{"stacktrace": event.Stack},
+	}
+	if event.UserID != "" {
+		payload.User = &sentryUser{ID: event.UserID}
+	}
+	if event.Method != "" || event.Path != "" {
+		payload.Request = &sentryRequest{Method: event.Method, URL: event.Path}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal sentry event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build sentry request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_client=%s, sentry_key=%s",
+		sentryClientName, r.publicKey))
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send sentry event: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("sentry returned status %d", resp.StatusCode)
+	}
+	return nil
+}
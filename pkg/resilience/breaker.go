@@ -0,0 +1,106 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+// breakerState posisi circuit breaker
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// Breaker membuka sirkuit setelah threshold kegagalan berturut-turut. Selama open semua call
+// langsung ditolak; setelah openFor lewat satu call percobaan (half-open) menentukan sirkuit
+// tertutup lagi atau kembali open.
+type Breaker struct {
+	threshold int
+	openFor   time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewBreaker threshold <= 0 berarti breaker tidak pernah open
+func NewBreaker(threshold int, openFor time.Duration) *Breaker {
+	return &Breaker{threshold: threshold, openFor: openFor}
+}
+
+// allow reports whether call boleh jalan; di half-open hanya satu call percobaan sekaligus
+func (b *Breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if now.Sub(b.openedAt) < b.openFor {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *Breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+// failure returns true kalau kegagalan ini membuat sirkuit open
+func (b *Breaker) failure(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if b.state == breakerHalfOpen {
+		b.state = breakerOpen
+		b.openedAt = now
+		return true
+	}
+
+	b.failures++
+	if b.threshold > 0 && b.state == breakerClosed && b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = now
+		return true
+	}
+	return false
+}
+
+// State returns "closed", "open" atau "half_open", untuk health check dan log
+func (b *Breaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state.String()
+}
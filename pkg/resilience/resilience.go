@@ -0,0 +1,177 @@
+// Package resilience membungkus call ke dependency eksternal (SMTP, push, payment gateway, TMDB)
+// dengan timeout per percobaan, retry dengan backoff + jitter dan circuit breaker, supaya satu
+// third party yang lambat tidak menahan goroutine request terlalu lama saat traffic puncak.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrCircuitOpen dikembalikan tanpa memanggil dependency selama breaker open
+var ErrCircuitOpen = errors.New("circuit open")
+
+// permanentError kegagalan yang tidak akan berhasil kalau diulang (mis. alamat tujuan tidak valid)
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent menandai err supaya tidak di-retry dan tidak dihitung sebagai kegagalan dependency
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Options konfigurasi satu Policy; nilai nol memakai default yang aman
+type Options struct {
+	// Timeout batas satu percobaan; 0 = mengikuti context pemanggil saja
+	Timeout time.Duration
+	// MaxAttempts jumlah percobaan total termasuk yang pertama; 1 = tanpa retry
+	MaxAttempts int
+	// BaseDelay jeda sebelum retry pertama, berlipat dua tiap percobaan sampai MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// BreakerThreshold kegagalan berturut-turut sebelum sirkuit open; 0 = tanpa breaker
+	BreakerThreshold int
+	BreakerOpenFor   time.Duration
+}
+
+// Policy resiliency untuk satu dependency. Satu instance dibagi semua pemanggil dependency itu
+// supaya breaker melihat kegagalan secara keseluruhan.
+type Policy struct {
+	name    string
+	options Options
+	breaker *Breaker
+	log     *zap.Logger
+}
+
+func NewPolicy(name string, options Options, log *zap.Logger) *Policy {
+	if options.MaxAttempts < 1 {
+		options.MaxAttempts = 1
+	}
+	if options.BaseDelay <= 0 {
+		options.BaseDelay = 200 * time.Millisecond
+	}
+	if options.MaxDelay < options.BaseDelay {
+		options.MaxDelay = options.BaseDelay
+	}
+	if options.BreakerOpenFor <= 0 {
+		options.BreakerOpenFor = 30 * time.Second
+	}
+
+	return &Policy{
+		name:    name,
+		options: options,
+		breaker: NewBreaker(options.BreakerThreshold, options.BreakerOpenFor),
+		log:     log.With(zap.String("dependency", name)),
+	}
+}
+
+// Name returns nama dependency, dipakai di pesan error dan log
+func (p *Policy) Name() string {
+	return p.name
+}
+
+// BreakerState returns status breaker dependency ini
+func (p *Policy) BreakerState() string {
+	return p.breaker.State()
+}
+
+// Do menjalankan fn dengan timeout per percobaan, retry dan breaker. fn harus menghormati ctx
+// supaya timeout benar-benar memutus call yang lambat.
+func (p *Policy) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	var lastErr error
+
+	for attempt := 1; attempt <= p.options.MaxAttempts; attempt++ {
+		if !p.breaker.allow(time.Now()) {
+			if lastErr != nil {
+				return fmt.Errorf("%s: %w after: %w", p.name, ErrCircuitOpen, lastErr)
+			}
+			return fmt.Errorf("%s: %w", p.name, ErrCircuitOpen)
+		}
+
+		err := p.attempt(ctx, fn)
+		if err == nil {
+			p.breaker.success()
+			return nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			// Dependency-nya sehat, masalahnya di input; jangan sampai membuka sirkuit
+			p.breaker.success()
+			return permanent.err
+		}
+
+		lastErr = err
+		if p.breaker.failure(time.Now()) {
+			p.log.Warn("Circuit breaker opened",
+				zap.Error(err),
+				zap.Duration("open_for", p.options.BreakerOpenFor),
+			)
+		}
+
+		// Context pemanggil habis: retry tidak ada gunanya
+		if ctx.Err() != nil || attempt == p.options.MaxAttempts {
+			break
+		}
+
+		delay := p.backoff(attempt)
+		p.log.Debug("Retrying external call",
+			zap.Error(err),
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%s: %w", p.name, lastErr)
+		case <-timer.C:
+		}
+	}
+
+	return fmt.Errorf("%s: %w", p.name, lastErr)
+}
+
+// DoWithFallback seperti Do, tapi kalau semua percobaan gagal (termasuk breaker open) hasil
+// fallback yang dipakai, mis. data cache atau antrian kirim ulang
+func (p *Policy) DoWithFallback(ctx context.Context, fn func(ctx context.Context) error, fallback func(ctx context.Context, err error) error) error {
+	err := p.Do(ctx, fn)
+	if err == nil || fallback == nil {
+		return err
+	}
+	return fallback(ctx, err)
+}
+
+func (p *Policy) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	if p.options.Timeout <= 0 {
+		return fn(ctx)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, p.options.Timeout)
+	defer cancel()
+	return fn(attemptCtx)
+}
+
+// backoff eksponensial dengan full jitter, supaya retry dari banyak request tidak datang serempak
+func (p *Policy) backoff(attempt int) time.Duration {
+	delay := p.options.BaseDelay
+	for i := 1; i < attempt && delay < p.options.MaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.options.MaxDelay)
+	return delay/2 + rand.N(delay/2+1)
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// WeakETag builds validator lemah dari waktu perubahan terakhir dan nilai lain yang ikut menentukan isi
+// response (mis. total record dan halaman). ETag sama berarti isi setara, bukan identik byte-per-byte.
+func WeakETag(lastModified time.Time, parts ...any) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d", lastModified.UnixNano())
+	for _, part := range parts {
+		fmt.Fprintf(h, "|%v", part)
+	}
+	return fmt.Sprintf(`W/"%x"`, h.Sum64())
+}
+
+// CacheControlPublic header untuk response yang sama bagi semua user, boleh disimpan CDN selama maxAge
+func CacheControlPublic(maxAge time.Duration) string {
+	return fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+}
+
+// CheckNotModified sets ETag dan Cache-Control, lalu membalas 304 kalau If-None-Match cocok.
+// True berarti response sudah ditulis dan handler tidak boleh menulis body.
+func CheckNotModified(w http.ResponseWriter, r *http.Request, etag, cacheControl string) bool {
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+
+	if !etagMatches(r.Header.Get("If-None-Match"), etag) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// etagMatches memakai weak comparison (RFC 9110): prefix W/ diabaikan di kedua sisi
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+
+	target := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == target {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,14 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
 	"github.com/spf13/viper"
 )
 
@@ -10,13 +18,62 @@ type Config struct {
 	JWT      JWTConfig
 	Email    EmailConfig
 	OTP      OTPConfig
+
+	Notification NotificationConfig
+	GRPC         GRPCConfig
+	Events       EventsConfig
+	Webhook      WebhookConfig
+	Booking      BookingConfig
+	Pricing      PricingConfig
+	Payment      PaymentConfig
+	CORS         CORSConfig
+	Review       ReviewConfig
+	DataExport   DataExportConfig
+	Ledger       LedgerConfig
+	PayloadLog   PayloadLogConfig
+	Reporting    ReportingConfig
+	Resilience   ResilienceConfig
+	Jobs         JobConfig
+	Cleanup      CleanupConfig
+	Tier         TierConfig
 }
 
 type AppConfig struct {
-	Name    string
-	Port    string
-	Debug   bool
-	LogPath string
+	Name string
+	// Environment nama environment deployment (development, staging, production) untuk feature flag
+	Environment string
+	Port        string
+	Debug       bool
+	LogPath     string
+	// PprofEnabled membuka /api/admin/debug/pprof untuk profiling (tetap butuh login admin)
+	PprofEnabled bool
+
+	// Batas body request dalam byte; upload multipart memakai MaxUploadBytes. 0 = tanpa batas
+	MaxBodyBytes   int64
+	MaxUploadBytes int64
+
+	// Kompresi br/gzip untuk response JSON, XML dan CSV; body di bawah CompressionMinBytes dikirim apa adanya
+	CompressionEnabled  bool
+	CompressionMinBytes int
+
+	// TLS opsional untuk deployment tanpa reverse proxy: pasangan cert/key file, atau
+	// TLSAutocertDomains untuk sertifikat Let's Encrypt otomatis (butuh port 80 untuk challenge)
+	TLSCertFile         string
+	TLSKeyFile          string
+	TLSAutocertDomains  []string
+	TLSAutocertCacheDir string
+	TLSAutocertEmail    string
+
+	// PublicSiteURL base URL website publik (tanpa trailing slash) untuk canonical URL di sitemap dan feed.
+	// FeedCacheSeconds batas umur sitemap/feed yang sudah di-render; perubahan movie di instance ini langsung
+	// membuangnya, TTL menjaga instance lain tidak basi terlalu lama.
+	PublicSiteURL    string
+	FeedCacheSeconds int
+}
+
+// TLSEnabled reports whether server melayani HTTPS
+func (c AppConfig) TLSEnabled() bool {
+	return c.TLSCertFile != "" || len(c.TLSAutocertDomains) > 0
 }
 
 type DatabaseConfig struct {
@@ -26,11 +83,27 @@ type DatabaseConfig struct {
 	User     string
 	Password string
 	MaxConns int32
+
+	// SlowQueryMillis query selama ini atau lebih di-log sebagai warning (0 = nonaktif);
+	// LogQueries mencatat semua query di level debug
+	SlowQueryMillis int
+	LogQueries      bool
+	// QueriesPerRequestWarn batas jumlah query per HTTP request sebelum dicurigai N+1 (0 = nonaktif)
+	QueriesPerRequestWarn int
+
+	// ReplicaHost read replica untuk listing movie, cinema, schedule dan review (kosong = tanpa replica).
+	// Kredensial dan nama database sama dengan primary.
+	ReplicaHost string
+	ReplicaPort string
 }
 
 type JWTConfig struct {
-	Secret      string
+	Secret string
+	// ExpiryHours default umur session; bisa di-override admin lewat /api/admin/settings
 	ExpiryHours int
+
+	// ImpersonationMinutes umur session impersonation admin, sengaja pendek dan tidak bisa diperpanjang
+	ImpersonationMinutes int
 }
 
 type EmailConfig struct {
@@ -44,25 +117,303 @@ type EmailConfig struct {
 type OTPConfig struct {
 	ExpiryMinutes int
 	Length        int
+	// PrintToConsole mencetak kode OTP ke stdout, hanya untuk development tanpa SMTP
+	PrintToConsole bool
+}
+
+type NotificationConfig struct {
+	FCMProjectID            string
+	FCMCredentialsFile      string
+	ReminderLeadMinutes     int
+	ReminderIntervalMinutes int
+}
+
+// GRPCConfig internal gRPC listener; server tidak dijalankan kalau APIKey kosong
+type GRPCConfig struct {
+	Port   string
+	APIKey string
+}
+
+// EventsConfig broker untuk outbox relay; Broker kosong = log only
+type EventsConfig struct {
+	Broker               string // "", "nats", "kafka"
+	NATSURL              string
+	KafkaRESTURL         string
+	TopicPrefix          string
+	RelayIntervalSeconds int
+	RelayBatchSize       int
+	MaxAttempts          int
+}
+
+// WebhookConfig delivery worker untuk webhook partner. Retry memakai backoff eksponensial
+// sampai MaxAttempts, setelah itu delivery ditandai failed dan hanya terlihat di delivery log.
+type WebhookConfig struct {
+	DeliveryIntervalSeconds int
+	BatchSize               int
+	MaxAttempts             int
+	TimeoutSeconds          int
+}
+
+// BookingConfig seat selection rules untuk CreateBooking.
+// SalesCutoffMinutes relatif ke jam mulai show: 10 = penjualan ditutup 10 menit setelah mulai,
+// negatif = ditutup sebelum show mulai.
+// SeatCacheSeconds TTL cache seat availability untuk listing publik, 0 = tanpa cache.
+// AgeRatingEnforcement cek klasifikasi usia film: off, warn (booking jalan dengan peringatan)
+// atau reject (user di bawah umur / tanpa tanggal lahir ditolak).
+// OrderIDPrefix awalan order ID untuk cinema tanpa chain atau chain yang tidak mengatur prefix sendiri.
+// MaxSeatsPerBooking, WaitlistHoldMinutes dan SalesCutoffMinutes hanya default; admin bisa
+// mengubahnya saat runtime lewat /api/admin/settings.
+type BookingConfig struct {
+	MaxSeatsPerBooking  int
+	NoSingleSeatGap     bool
+	WaitlistHoldMinutes int
+	SalesCutoffMinutes  int
+	SeatCacheSeconds    int
+
+	AgeRatingEnforcement string
+	OrderIDPrefix        string
+}
+
+// PricingConfig multiplier harga tiket per hall type, relatif ke schedule.Price (2D = 1.0).
+// AmountTolerance selisih maksimal amount dari client terhadap total server saat bayar.
+// ConvenienceFee (per kursi) dan TaxRate adalah default kalau payment method / cinema tidak override.
+// Nominal di config dalam major unit Currency (default currency untuk schedule/booking baru).
+// FreeFeeTiers customer tier yang tidak dikenai convenience fee per kursi.
+type PricingConfig struct {
+	Multiplier3D    float64
+	MultiplierIMAX  float64
+	Multiplier4DX   float64
+	AmountTolerance float64
+	ConvenienceFee  float64
+	TaxRate         float64
+	Currency        string
+	FreeFeeTiers    []string
+}
+
+// PaymentConfig untuk method async (VA / QRIS); webhook tidak di-wire kalau WebhookSecret kosong.
+// Batas bayar per jenis method: QRIS dinamis pendek, VA biasanya berlaku sampai 24 jam.
+type PaymentConfig struct {
+	QRISExpiryMinutes int
+	VAExpiryMinutes   int
+	WebhookSecret     string // HMAC-SHA256 key untuk verifikasi signature callback gateway
+}
+
+// CORSConfig kebijakan CORS untuk frontend. AllowedOrigins berisi origin persis
+// (mis. https://app.example.com) atau "*" untuk semua origin.
+type CORSConfig struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	AllowCredentials bool
+	MaxAgeSeconds    int
+}
+
+// ReviewConfig moderasi review. Review otomatis disembunyikan dari publik begitu jumlah report
+// mencapai ReportHideThreshold, sampai admin meninjaunya.
+// FilterMode untuk kata kasar di komentar: off, mask (diganti ***) atau reject; BlockedWords
+// per bahasa menambah daftar bawaan pkg/contentfilter.
+// Rating movie memakai Bayesian average: RatingPriorWeight "review bayangan" bernilai RatingPriorMean
+// ikut dirata-rata, jadi satu review bintang 5 tidak langsung membuat rating 5.0 (weight 0 = rata-rata biasa).
+// EditWindowDays batas hari sejak review dibuat selama pemiliknya masih boleh mengedit (0 = tanpa batas).
+type ReviewConfig struct {
+	ReportHideThreshold int
+	EditWindowDays      int
+
+	RatingPriorMean   float64
+	RatingPriorWeight float64
+	// RatingRecalcHour jam (waktu lokal server) job malam menghitung ulang semua rating
+	RatingRecalcHour int
+
+	FilterMode       string
+	BlockedWordsEN   []string
+	BlockedWordsID   []string
+	BlockURLs        bool
+	MaxRepeatedChars int
+}
+
+// DataExportConfig export data pribadi user. File disusun lewat job queue, disimpan di Dir dan dihapus setelah
+// TTLHours oleh worker yang jalan tiap IntervalSeconds; link download ditandatangani HMAC dengan SigningKey. Kalau SigningKey kosong dipakai key acak per proses,
+// jadi link lama tidak berlaku lagi setelah restart dan tidak bisa dibagi antar instance.
+type DataExportConfig struct {
+	Dir             string
+	TTLHours        int
+	SigningKey      string
+	IntervalSeconds int
+}
+
+// LedgerConfig double-entry ledger. CheckHour jam (waktu lokal server) job malam mengecek invariant ledger
+type LedgerConfig struct {
+	CheckHour int
+}
+
+// PayloadLogConfig log body request/response untuk debugging client. Enabled menyalakan untuk semua
+// route, Routes (prefix path, mis. /api/bookings) hanya untuk route tertentu. SampleRate 0-1 porsi
+// request yang dicatat; body dipotong di MaxBytes dan field sensitif selalu di-redact.
+type PayloadLogConfig struct {
+	Enabled    bool
+	Routes     []string
+	SampleRate float64
+	MaxBytes   int
+}
+
+// Active reports whether payload log perlu dipasang sama sekali
+func (c PayloadLogConfig) Active() bool {
+	return (c.Enabled || len(c.Routes) > 0) && c.SampleRate > 0
+}
+
+// ReportingConfig error reporting untuk panic. SentryDSN kosong berarti panic hanya di-log lokal;
+// Release dikirim bersama event supaya error bisa dikaitkan dengan versi deploy.
+type ReportingConfig struct {
+	SentryDSN string
+	Release   string
+}
+
+// ResilienceConfig policy bersama untuk call ke dependency eksternal (SMTP, push, gateway).
+// TimeoutSeconds batas satu percobaan, MaxAttempts total percobaan termasuk yang pertama.
+// Circuit breaker open setelah BreakerThreshold kegagalan berturut-turut (0 = tanpa breaker)
+// dan menolak call selama BreakerOpenSeconds sebelum mencoba lagi.
+type ResilienceConfig struct {
+	TimeoutSeconds     int
+	MaxAttempts        int
+	BreakerThreshold   int
+	BreakerOpenSeconds int
 }
 
-// LoadConfig loads configuration from .env file
+// JobConfig antrian job persistent (email, receipt, export data). Worker mengambil job yang jatuh tempo
+// tiap PollSeconds, maksimal BatchSize per tick. Job yang gagal MaxAttempts kali pindah ke dead letter
+// dan hanya jalan lagi kalau di-retry admin.
+type JobConfig struct {
+	PollSeconds int
+	BatchSize   int
+	MaxAttempts int
+}
+
+// CleanupConfig jadwal penghapusan data kedaluwarsa. Tiap task punya interval sendiri (menit) dan
+// menghapus per BatchSize baris supaya tidak mengunci tabel lama. Retention menentukan berapa lama
+// data yang sudah expired masih disimpan, mis. session tetap terlihat di riwayat login beberapa hari.
+type CleanupConfig struct {
+	BatchSize int
+
+	SessionIntervalMinutes int
+	SessionRetentionDays   int
+	OTPIntervalMinutes     int
+	OTPRetentionHours      int
+	JobIntervalMinutes     int
+	JobRetentionDays       int
+}
+
+// TierConfig customer tiering (silver / gold / platinum) dari belanja bersih di ledger selama WindowDays
+// terakhir, dihitung ulang tiap IntervalMinutes. Batas spend dalam major unit default currency; belanja
+// dalam currency lain tidak dihitung. Benefit per tier diatur di PricingConfig dan promo.
+type TierConfig struct {
+	IntervalMinutes int
+	WindowDays      int
+	SilverSpend     float64
+	GoldSpend       float64
+	PlatinumSpend   float64
+}
+
+// minSecretLength panjang minimum secret (HMAC key, API key) supaya tidak mudah ditebak
+const minSecretLength = 32
+
+// LoadConfig loads configuration dari .env (kalau ada) dan environment variable, lalu memvalidasinya
 func LoadConfig() (*Config, error) {
 	viper.SetConfigFile(".env")
 	viper.SetConfigType("env")
 
 	// Default values for optional configs
+	viper.SetDefault("APP_ENV", "development")
 	viper.SetDefault("PORT", "8080")
 	viper.SetDefault("DEBUG", false)
+	viper.SetDefault("DB_PORT", "5432")
 	viper.SetDefault("DB_MAX_CONNS", 10)
+	viper.SetDefault("DB_SLOW_QUERY_MS", 200)
+	viper.SetDefault("DB_LOG_QUERIES", false)
+	viper.SetDefault("DB_QUERIES_PER_REQUEST_WARN", 50)
 	viper.SetDefault("JWT_EXPIRY_HOURS", 24)
+	viper.SetDefault("IMPERSONATION_EXPIRY_MINUTES", 30)
 	viper.SetDefault("OTP_EXPIRY_MINUTES", 10)
 	viper.SetDefault("OTP_LENGTH", 6)
+	viper.SetDefault("OTP_PRINT_CONSOLE", false)
 	viper.SetDefault("LOG_PATH", "logs/")
+	viper.SetDefault("PPROF_ENABLED", false)
+	viper.SetDefault("MAX_REQUEST_BODY_BYTES", 1<<20)
+	viper.SetDefault("MAX_UPLOAD_BODY_BYTES", 10<<20)
+	viper.SetDefault("COMPRESSION_ENABLED", true)
+	viper.SetDefault("COMPRESSION_MIN_BYTES", 1024)
+	viper.SetDefault("TLS_AUTOCERT_CACHE_DIR", "certs/")
+	viper.SetDefault("PUBLIC_SITE_URL", "http://localhost:3000")
+	viper.SetDefault("FEED_CACHE_SECONDS", 600)
+	viper.SetDefault("CORS_ALLOWED_ORIGINS", "*")
+	viper.SetDefault("CORS_ALLOWED_METHODS", "GET, POST, PUT, DELETE, OPTIONS")
+	viper.SetDefault("CORS_ALLOWED_HEADERS", "Content-Type, Authorization, X-Requested-With, Accept-Language, If-None-Match, X-Request-ID")
+	viper.SetDefault("CORS_ALLOW_CREDENTIALS", false)
+	viper.SetDefault("CORS_MAX_AGE_SECONDS", 600)
+	viper.SetDefault("REMINDER_LEAD_MINUTES", 120)
+	viper.SetDefault("REMINDER_INTERVAL_MINUTES", 5)
+	viper.SetDefault("GRPC_PORT", "9090")
+	viper.SetDefault("EVENTS_TOPIC_PREFIX", "cinema.")
+	viper.SetDefault("EVENTS_RELAY_INTERVAL_SECONDS", 5)
+	viper.SetDefault("EVENTS_RELAY_BATCH_SIZE", 100)
+	viper.SetDefault("EVENTS_MAX_ATTEMPTS", 10)
+	viper.SetDefault("WEBHOOK_DELIVERY_INTERVAL_SECONDS", 5)
+	viper.SetDefault("WEBHOOK_BATCH_SIZE", 20)
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	viper.SetDefault("WEBHOOK_TIMEOUT_SECONDS", 10)
+	viper.SetDefault("BOOKING_MAX_SEATS", 6)
+	viper.SetDefault("BOOKING_NO_SINGLE_SEAT_GAP", false)
+	viper.SetDefault("WAITLIST_HOLD_MINUTES", 15)
+	viper.SetDefault("BOOKING_SALES_CUTOFF_MINUTES", 0)
+	viper.SetDefault("BOOKING_SEAT_CACHE_SECONDS", 3)
+	viper.SetDefault("AGE_RATING_ENFORCEMENT", "warn")
+	viper.SetDefault("BOOKING_ORDER_ID_PREFIX", DefaultOrderIDPrefix)
+	viper.SetDefault("PRICE_MULTIPLIER_3D", 1.25)
+	viper.SetDefault("PRICE_MULTIPLIER_IMAX", 1.5)
+	viper.SetDefault("PRICE_MULTIPLIER_4DX", 1.75)
+	viper.SetDefault("PAYMENT_AMOUNT_TOLERANCE", 0)
+	viper.SetDefault("BOOKING_CONVENIENCE_FEE", 0)
+	viper.SetDefault("BOOKING_TAX_RATE", 0)
+	viper.SetDefault("DEFAULT_CURRENCY", "IDR")
+	viper.SetDefault("PAYMENT_EXPIRY_QRIS_MINUTES", 15)
+	viper.SetDefault("PAYMENT_EXPIRY_VA_MINUTES", 1440)
+	viper.SetDefault("REVIEW_REPORT_HIDE_THRESHOLD", 3)
+	viper.SetDefault("REVIEW_FILTER_MODE", "mask")
+	viper.SetDefault("REVIEW_BLOCK_URLS", true)
+	viper.SetDefault("REVIEW_MAX_REPEATED_CHARS", 8)
+	viper.SetDefault("REVIEW_RATING_PRIOR_MEAN", 3.0)
+	viper.SetDefault("REVIEW_RATING_PRIOR_WEIGHT", 5)
+	viper.SetDefault("REVIEW_RATING_RECALC_HOUR", 3)
+	viper.SetDefault("REVIEW_EDIT_WINDOW_DAYS", 7)
+	viper.SetDefault("DATA_EXPORT_DIR", "exports/")
+	viper.SetDefault("DATA_EXPORT_TTL_HOURS", 24)
+	viper.SetDefault("DATA_EXPORT_INTERVAL_SECONDS", 30)
+	viper.SetDefault("LEDGER_CHECK_HOUR", 2)
+	viper.SetDefault("PAYLOAD_LOG_SAMPLE_RATE", 0.1)
+	viper.SetDefault("EXTERNAL_TIMEOUT_SECONDS", 10)
+	viper.SetDefault("EXTERNAL_MAX_ATTEMPTS", 3)
+	viper.SetDefault("EXTERNAL_BREAKER_THRESHOLD", 5)
+	viper.SetDefault("EXTERNAL_BREAKER_OPEN_SECONDS", 30)
+	viper.SetDefault("PAYLOAD_LOG_MAX_BYTES", 4096)
+	viper.SetDefault("JOB_POLL_SECONDS", 5)
+	viper.SetDefault("JOB_BATCH_SIZE", 20)
+	viper.SetDefault("JOB_MAX_ATTEMPTS", 5)
+	viper.SetDefault("CLEANUP_BATCH_SIZE", 1000)
+	viper.SetDefault("CLEANUP_SESSION_INTERVAL_MINUTES", 60)
+	viper.SetDefault("CLEANUP_SESSION_RETENTION_DAYS", 30)
+	viper.SetDefault("CLEANUP_OTP_INTERVAL_MINUTES", 15)
+	viper.SetDefault("CLEANUP_OTP_RETENTION_HOURS", 24)
+	viper.SetDefault("CLEANUP_JOB_INTERVAL_MINUTES", 60)
+	viper.SetDefault("CLEANUP_JOB_RETENTION_DAYS", 7)
+	viper.SetDefault("TIER_INTERVAL_MINUTES", 60)
+	viper.SetDefault("TIER_WINDOW_DAYS", 365)
+	viper.SetDefault("TIER_SILVER_SPEND", 500000)
+	viper.SetDefault("TIER_GOLD_SPEND", 2000000)
+	viper.SetDefault("TIER_PLATINUM_SPEND", 5000000)
+	viper.SetDefault("TIER_FREE_FEE_TIERS", "gold,platinum")
 
-	// Read config file
-	if err := viper.ReadInConfig(); err != nil {
-		return nil, err
+	// .env opsional: di container config biasanya murni dari environment variable
+	if err := viper.ReadInConfig(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("read .env: %w", err)
 	}
 
 	// Enable reading from environment variables
@@ -71,10 +422,27 @@ func LoadConfig() (*Config, error) {
 	// Create config struct
 	config := &Config{
 		App: AppConfig{
-			Name:    viper.GetString("APP_NAME"),
-			Port:    viper.GetString("PORT"),
-			Debug:   viper.GetBool("DEBUG"),
-			LogPath: viper.GetString("LOG_PATH"),
+			Name:        viper.GetString("APP_NAME"),
+			Environment: viper.GetString("APP_ENV"),
+			Port:        viper.GetString("PORT"),
+			Debug:       viper.GetBool("DEBUG"),
+			LogPath:     viper.GetString("LOG_PATH"),
+
+			PprofEnabled:   viper.GetBool("PPROF_ENABLED"),
+			MaxBodyBytes:   viper.GetInt64("MAX_REQUEST_BODY_BYTES"),
+			MaxUploadBytes: viper.GetInt64("MAX_UPLOAD_BODY_BYTES"),
+
+			CompressionEnabled:  viper.GetBool("COMPRESSION_ENABLED"),
+			CompressionMinBytes: viper.GetInt("COMPRESSION_MIN_BYTES"),
+
+			TLSCertFile:         viper.GetString("TLS_CERT_FILE"),
+			TLSKeyFile:          viper.GetString("TLS_KEY_FILE"),
+			TLSAutocertDomains:  splitList(viper.GetString("TLS_AUTOCERT_DOMAINS")),
+			TLSAutocertCacheDir: viper.GetString("TLS_AUTOCERT_CACHE_DIR"),
+			TLSAutocertEmail:    viper.GetString("TLS_AUTOCERT_EMAIL"),
+
+			PublicSiteURL:    strings.TrimRight(viper.GetString("PUBLIC_SITE_URL"), "/"),
+			FeedCacheSeconds: viper.GetInt("FEED_CACHE_SECONDS"),
 		},
 		Database: DatabaseConfig{
 			Host:     viper.GetString("DB_HOST"),
@@ -83,10 +451,19 @@ func LoadConfig() (*Config, error) {
 			User:     viper.GetString("DB_USER"),
 			Password: viper.GetString("DB_PASS"),
 			MaxConns: viper.GetInt32("DB_MAX_CONNS"),
+
+			SlowQueryMillis:       viper.GetInt("DB_SLOW_QUERY_MS"),
+			LogQueries:            viper.GetBool("DB_LOG_QUERIES"),
+			QueriesPerRequestWarn: viper.GetInt("DB_QUERIES_PER_REQUEST_WARN"),
+
+			ReplicaHost: viper.GetString("DB_REPLICA_HOST"),
+			ReplicaPort: viper.GetString("DB_REPLICA_PORT"),
 		},
 		JWT: JWTConfig{
 			Secret:      viper.GetString("JWT_SECRET"),
 			ExpiryHours: viper.GetInt("JWT_EXPIRY_HOURS"),
+
+			ImpersonationMinutes: viper.GetInt("IMPERSONATION_EXPIRY_MINUTES"),
 		},
 		Email: EmailConfig{
 			Host:     viper.GetString("SMTP_HOST"),
@@ -98,8 +475,306 @@ func LoadConfig() (*Config, error) {
 		OTP: OTPConfig{
 			ExpiryMinutes: viper.GetInt("OTP_EXPIRY_MINUTES"),
 			Length:        viper.GetInt("OTP_LENGTH"),
+
+			PrintToConsole: viper.GetBool("OTP_PRINT_CONSOLE"),
+		},
+		Notification: NotificationConfig{
+			FCMProjectID:            viper.GetString("FCM_PROJECT_ID"),
+			FCMCredentialsFile:      viper.GetString("FCM_CREDENTIALS_FILE"),
+			ReminderLeadMinutes:     viper.GetInt("REMINDER_LEAD_MINUTES"),
+			ReminderIntervalMinutes: viper.GetInt("REMINDER_INTERVAL_MINUTES"),
+		},
+		GRPC: GRPCConfig{
+			Port:   viper.GetString("GRPC_PORT"),
+			APIKey: viper.GetString("GRPC_API_KEY"),
+		},
+		Events: EventsConfig{
+			Broker:               viper.GetString("EVENTS_BROKER"),
+			NATSURL:              viper.GetString("NATS_URL"),
+			KafkaRESTURL:         viper.GetString("KAFKA_REST_URL"),
+			TopicPrefix:          viper.GetString("EVENTS_TOPIC_PREFIX"),
+			RelayIntervalSeconds: viper.GetInt("EVENTS_RELAY_INTERVAL_SECONDS"),
+			RelayBatchSize:       viper.GetInt("EVENTS_RELAY_BATCH_SIZE"),
+			MaxAttempts:          viper.GetInt("EVENTS_MAX_ATTEMPTS"),
+		},
+		Webhook: WebhookConfig{
+			DeliveryIntervalSeconds: viper.GetInt("WEBHOOK_DELIVERY_INTERVAL_SECONDS"),
+			BatchSize:               viper.GetInt("WEBHOOK_BATCH_SIZE"),
+			MaxAttempts:             viper.GetInt("WEBHOOK_MAX_ATTEMPTS"),
+			TimeoutSeconds:          viper.GetInt("WEBHOOK_TIMEOUT_SECONDS"),
+		},
+		Booking: BookingConfig{
+			MaxSeatsPerBooking:  viper.GetInt("BOOKING_MAX_SEATS"),
+			NoSingleSeatGap:     viper.GetBool("BOOKING_NO_SINGLE_SEAT_GAP"),
+			WaitlistHoldMinutes: viper.GetInt("WAITLIST_HOLD_MINUTES"),
+			SalesCutoffMinutes:  viper.GetInt("BOOKING_SALES_CUTOFF_MINUTES"),
+			SeatCacheSeconds:    viper.GetInt("BOOKING_SEAT_CACHE_SECONDS"),
+
+			AgeRatingEnforcement: strings.ToLower(viper.GetString("AGE_RATING_ENFORCEMENT")),
+			OrderIDPrefix:        strings.ToUpper(viper.GetString("BOOKING_ORDER_ID_PREFIX")),
+		},
+		Pricing: PricingConfig{
+			Multiplier3D:    viper.GetFloat64("PRICE_MULTIPLIER_3D"),
+			MultiplierIMAX:  viper.GetFloat64("PRICE_MULTIPLIER_IMAX"),
+			Multiplier4DX:   viper.GetFloat64("PRICE_MULTIPLIER_4DX"),
+			AmountTolerance: viper.GetFloat64("PAYMENT_AMOUNT_TOLERANCE"),
+			ConvenienceFee:  viper.GetFloat64("BOOKING_CONVENIENCE_FEE"),
+			TaxRate:         viper.GetFloat64("BOOKING_TAX_RATE"),
+			Currency:        strings.ToUpper(viper.GetString("DEFAULT_CURRENCY")),
+			FreeFeeTiers:    splitList(strings.ToLower(viper.GetString("TIER_FREE_FEE_TIERS"))),
+		},
+		Payment: PaymentConfig{
+			QRISExpiryMinutes: viper.GetInt("PAYMENT_EXPIRY_QRIS_MINUTES"),
+			VAExpiryMinutes:   viper.GetInt("PAYMENT_EXPIRY_VA_MINUTES"),
+			WebhookSecret:     viper.GetString("PAYMENT_WEBHOOK_SECRET"),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins:   splitList(viper.GetString("CORS_ALLOWED_ORIGINS")),
+			AllowedMethods:   splitList(viper.GetString("CORS_ALLOWED_METHODS")),
+			AllowedHeaders:   splitList(viper.GetString("CORS_ALLOWED_HEADERS")),
+			AllowCredentials: viper.GetBool("CORS_ALLOW_CREDENTIALS"),
+			MaxAgeSeconds:    viper.GetInt("CORS_MAX_AGE_SECONDS"),
+		},
+		Review: ReviewConfig{
+			ReportHideThreshold: viper.GetInt("REVIEW_REPORT_HIDE_THRESHOLD"),
+			EditWindowDays:      viper.GetInt("REVIEW_EDIT_WINDOW_DAYS"),
+
+			RatingPriorMean:   viper.GetFloat64("REVIEW_RATING_PRIOR_MEAN"),
+			RatingPriorWeight: viper.GetFloat64("REVIEW_RATING_PRIOR_WEIGHT"),
+			RatingRecalcHour:  viper.GetInt("REVIEW_RATING_RECALC_HOUR"),
+
+			FilterMode:       strings.ToLower(viper.GetString("REVIEW_FILTER_MODE")),
+			BlockedWordsEN:   splitList(viper.GetString("REVIEW_BLOCKED_WORDS_EN")),
+			BlockedWordsID:   splitList(viper.GetString("REVIEW_BLOCKED_WORDS_ID")),
+			BlockURLs:        viper.GetBool("REVIEW_BLOCK_URLS"),
+			MaxRepeatedChars: viper.GetInt("REVIEW_MAX_REPEATED_CHARS"),
 		},
+		DataExport: DataExportConfig{
+			Dir:             viper.GetString("DATA_EXPORT_DIR"),
+			TTLHours:        viper.GetInt("DATA_EXPORT_TTL_HOURS"),
+			SigningKey:      viper.GetString("DATA_EXPORT_SIGNING_KEY"),
+			IntervalSeconds: viper.GetInt("DATA_EXPORT_INTERVAL_SECONDS"),
+		},
+		Ledger: LedgerConfig{
+			CheckHour: viper.GetInt("LEDGER_CHECK_HOUR"),
+		},
+		Resilience: ResilienceConfig{
+			TimeoutSeconds:     viper.GetInt("EXTERNAL_TIMEOUT_SECONDS"),
+			MaxAttempts:        viper.GetInt("EXTERNAL_MAX_ATTEMPTS"),
+			BreakerThreshold:   viper.GetInt("EXTERNAL_BREAKER_THRESHOLD"),
+			BreakerOpenSeconds: viper.GetInt("EXTERNAL_BREAKER_OPEN_SECONDS"),
+		},
+		Jobs: JobConfig{
+			PollSeconds: viper.GetInt("JOB_POLL_SECONDS"),
+			BatchSize:   viper.GetInt("JOB_BATCH_SIZE"),
+			MaxAttempts: viper.GetInt("JOB_MAX_ATTEMPTS"),
+		},
+		Cleanup: CleanupConfig{
+			BatchSize:              viper.GetInt("CLEANUP_BATCH_SIZE"),
+			SessionIntervalMinutes: viper.GetInt("CLEANUP_SESSION_INTERVAL_MINUTES"),
+			SessionRetentionDays:   viper.GetInt("CLEANUP_SESSION_RETENTION_DAYS"),
+			OTPIntervalMinutes:     viper.GetInt("CLEANUP_OTP_INTERVAL_MINUTES"),
+			OTPRetentionHours:      viper.GetInt("CLEANUP_OTP_RETENTION_HOURS"),
+			JobIntervalMinutes:     viper.GetInt("CLEANUP_JOB_INTERVAL_MINUTES"),
+			JobRetentionDays:       viper.GetInt("CLEANUP_JOB_RETENTION_DAYS"),
+		},
+		Tier: TierConfig{
+			IntervalMinutes: viper.GetInt("TIER_INTERVAL_MINUTES"),
+			WindowDays:      viper.GetInt("TIER_WINDOW_DAYS"),
+			SilverSpend:     viper.GetFloat64("TIER_SILVER_SPEND"),
+			GoldSpend:       viper.GetFloat64("TIER_GOLD_SPEND"),
+			PlatinumSpend:   viper.GetFloat64("TIER_PLATINUM_SPEND"),
+		},
+		Reporting: ReportingConfig{
+			SentryDSN: viper.GetString("SENTRY_DSN"),
+			Release:   viper.GetString("APP_RELEASE"),
+		},
+		PayloadLog: PayloadLogConfig{
+			Enabled:    viper.GetBool("PAYLOAD_LOG_ENABLED"),
+			Routes:     splitList(viper.GetString("PAYLOAD_LOG_ROUTES")),
+			SampleRate: viper.GetFloat64("PAYLOAD_LOG_SAMPLE_RATE"),
+			MaxBytes:   viper.GetInt("PAYLOAD_LOG_MAX_BYTES"),
+		},
+	}
+
+	// Replica biasanya di port yang sama dengan primary
+	if config.Database.ReplicaPort == "" {
+		config.Database.ReplicaPort = config.Database.Port
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
 	}
 
 	return config, nil
 }
+
+// Validate checks field wajib, range port dan angka, serta panjang secret.
+// Semua masalah dikumpulkan jadi satu error supaya startup cukup gagal sekali.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	// App & server
+	check(validPort(c.App.Port), "PORT must be a number between 1 and 65535, got %q", c.App.Port)
+	check(c.App.MaxBodyBytes >= 0, "MAX_REQUEST_BODY_BYTES must not be negative")
+	check(c.App.MaxUploadBytes >= 0, "MAX_UPLOAD_BODY_BYTES must not be negative")
+	check(c.App.CompressionMinBytes >= 0, "COMPRESSION_MIN_BYTES must not be negative")
+	check((c.App.TLSCertFile == "") == (c.App.TLSKeyFile == ""), "TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	check(c.App.TLSCertFile == "" || len(c.App.TLSAutocertDomains) == 0,
+		"use either TLS_CERT_FILE/TLS_KEY_FILE or TLS_AUTOCERT_DOMAINS, not both")
+	check(validSiteURL(c.App.PublicSiteURL), "PUBLIC_SITE_URL must be an absolute http(s) URL, got %q", c.App.PublicSiteURL)
+	check(c.App.FeedCacheSeconds >= 0, "FEED_CACHE_SECONDS must not be negative")
+
+	// Database
+	check(c.Database.Host != "", "DB_HOST is required")
+	check(validPort(c.Database.Port), "DB_PORT must be a number between 1 and 65535, got %q", c.Database.Port)
+	check(c.Database.Name != "", "DB_NAME is required")
+	check(c.Database.User != "", "DB_USER is required")
+	check(c.Database.Password != "", "DB_PASS is required")
+	check(c.Database.MaxConns > 0, "DB_MAX_CONNS must be greater than 0")
+	check(c.Database.SlowQueryMillis >= 0, "DB_SLOW_QUERY_MS must not be negative")
+	check(c.Database.QueriesPerRequestWarn >= 0, "DB_QUERIES_PER_REQUEST_WARN must not be negative")
+	if c.Database.ReplicaHost != "" {
+		check(validPort(c.Database.ReplicaPort), "DB_REPLICA_PORT must be a number between 1 and 65535, got %q", c.Database.ReplicaPort)
+	}
+
+	// Secrets: opsional, tapi kalau di-set harus cukup panjang
+	check(c.JWT.Secret == "" || len(c.JWT.Secret) >= minSecretLength,
+		"JWT_SECRET must be at least %d characters", minSecretLength)
+	check(c.GRPC.APIKey == "" || len(c.GRPC.APIKey) >= minSecretLength,
+		"GRPC_API_KEY must be at least %d characters", minSecretLength)
+	check(c.DataExport.SigningKey == "" || len(c.DataExport.SigningKey) >= minSecretLength,
+		"DATA_EXPORT_SIGNING_KEY must be at least %d characters", minSecretLength)
+	check(c.Payment.WebhookSecret == "" || len(c.Payment.WebhookSecret) >= minSecretLength,
+		"PAYMENT_WEBHOOK_SECRET must be at least %d characters", minSecretLength)
+	if c.GRPC.APIKey != "" {
+		check(validPort(c.GRPC.Port), "GRPC_PORT must be a number between 1 and 65535, got %q", c.GRPC.Port)
+	}
+
+	// Email: SMTP hanya dipakai kalau host di-set
+	if c.Email.Host != "" {
+		check(c.Email.Port > 0 && c.Email.Port <= 65535, "SMTP_PORT must be between 1 and 65535, got %d", c.Email.Port)
+		check(c.Email.From != "", "EMAIL_FROM is required when SMTP_HOST is set")
+	}
+
+	check(c.JWT.ImpersonationMinutes > 0 && c.JWT.ImpersonationMinutes <= 240,
+		"IMPERSONATION_EXPIRY_MINUTES must be between 1 and 240, got %d", c.JWT.ImpersonationMinutes)
+	check(c.OTP.Length >= 4 && c.OTP.Length <= 10, "OTP_LENGTH must be between 4 and 10, got %d", c.OTP.Length)
+	check(c.OTP.ExpiryMinutes > 0, "OTP_EXPIRY_MINUTES must be greater than 0")
+	check(!c.OTP.PrintToConsole || c.App.Debug, "OTP_PRINT_CONSOLE is only allowed with DEBUG=true")
+	check(c.Notification.ReminderIntervalMinutes > 0, "REMINDER_INTERVAL_MINUTES must be greater than 0")
+
+	switch c.Events.Broker {
+	case "":
+	case "nats":
+		check(c.Events.NATSURL != "", "NATS_URL is required when EVENTS_BROKER=nats")
+	case "kafka":
+		check(c.Events.KafkaRESTURL != "", "KAFKA_REST_URL is required when EVENTS_BROKER=kafka")
+	default:
+		check(false, "EVENTS_BROKER must be empty, nats or kafka, got %q", c.Events.Broker)
+	}
+	check(c.Events.RelayIntervalSeconds > 0, "EVENTS_RELAY_INTERVAL_SECONDS must be greater than 0")
+	check(c.Events.RelayBatchSize > 0, "EVENTS_RELAY_BATCH_SIZE must be greater than 0")
+	check(c.Webhook.DeliveryIntervalSeconds > 0, "WEBHOOK_DELIVERY_INTERVAL_SECONDS must be greater than 0")
+	check(c.Webhook.BatchSize > 0, "WEBHOOK_BATCH_SIZE must be greater than 0")
+	check(c.Webhook.MaxAttempts > 0, "WEBHOOK_MAX_ATTEMPTS must be greater than 0")
+	check(c.Webhook.TimeoutSeconds > 0, "WEBHOOK_TIMEOUT_SECONDS must be greater than 0")
+
+	// Booking & pricing
+	check(c.Booking.MaxSeatsPerBooking > 0, "BOOKING_MAX_SEATS must be greater than 0")
+	check(c.Booking.WaitlistHoldMinutes > 0, "WAITLIST_HOLD_MINUTES must be greater than 0")
+	check(c.Booking.SeatCacheSeconds >= 0, "BOOKING_SEAT_CACHE_SECONDS must not be negative")
+	check(slices.Contains([]string{"off", "warn", "reject"}, c.Booking.AgeRatingEnforcement),
+		"AGE_RATING_ENFORCEMENT must be off, warn or reject, got %q", c.Booking.AgeRatingEnforcement)
+	check(ValidOrderIDPrefix(c.Booking.OrderIDPrefix),
+		"BOOKING_ORDER_ID_PREFIX must be 2-8 letters or digits, got %q", c.Booking.OrderIDPrefix)
+	check(c.Pricing.TaxRate >= 0 && c.Pricing.TaxRate <= 1, "BOOKING_TAX_RATE must be between 0 and 1")
+	check(c.Pricing.ConvenienceFee >= 0, "BOOKING_CONVENIENCE_FEE must not be negative")
+	_, currencyOK := LookupCurrency(c.Pricing.Currency)
+	check(currencyOK, "unsupported DEFAULT_CURRENCY %q", c.Pricing.Currency)
+	check(c.Payment.QRISExpiryMinutes > 0, "PAYMENT_EXPIRY_QRIS_MINUTES must be greater than 0")
+	check(c.Payment.VAExpiryMinutes > 0, "PAYMENT_EXPIRY_VA_MINUTES must be greater than 0")
+
+	check(c.Review.ReportHideThreshold > 0, "REVIEW_REPORT_HIDE_THRESHOLD must be greater than 0")
+	check(slices.Contains([]string{"off", "mask", "reject"}, c.Review.FilterMode),
+		"REVIEW_FILTER_MODE must be off, mask or reject, got %q", c.Review.FilterMode)
+	check(c.Review.EditWindowDays >= 0, "REVIEW_EDIT_WINDOW_DAYS must not be negative")
+	check(c.Review.MaxRepeatedChars >= 0, "REVIEW_MAX_REPEATED_CHARS must not be negative")
+	check(c.Review.RatingPriorMean >= 1 && c.Review.RatingPriorMean <= 5, "REVIEW_RATING_PRIOR_MEAN must be between 1 and 5")
+	check(c.Review.RatingPriorWeight >= 0, "REVIEW_RATING_PRIOR_WEIGHT must not be negative")
+	check(c.Review.RatingRecalcHour >= 0 && c.Review.RatingRecalcHour <= 23, "REVIEW_RATING_RECALC_HOUR must be between 0 and 23")
+
+	check(c.DataExport.Dir != "", "DATA_EXPORT_DIR is required")
+	check(c.DataExport.TTLHours > 0, "DATA_EXPORT_TTL_HOURS must be greater than 0")
+	check(c.DataExport.IntervalSeconds > 0, "DATA_EXPORT_INTERVAL_SECONDS must be greater than 0")
+	check(c.Ledger.CheckHour >= 0 && c.Ledger.CheckHour <= 23, "LEDGER_CHECK_HOUR must be between 0 and 23")
+	check(c.PayloadLog.SampleRate >= 0 && c.PayloadLog.SampleRate <= 1, "PAYLOAD_LOG_SAMPLE_RATE must be between 0 and 1")
+	check(c.Resilience.TimeoutSeconds > 0, "EXTERNAL_TIMEOUT_SECONDS must be greater than 0")
+	check(c.Resilience.MaxAttempts > 0, "EXTERNAL_MAX_ATTEMPTS must be greater than 0")
+	check(c.Resilience.BreakerThreshold >= 0, "EXTERNAL_BREAKER_THRESHOLD must not be negative")
+	check(c.Resilience.BreakerOpenSeconds > 0, "EXTERNAL_BREAKER_OPEN_SECONDS must be greater than 0")
+	check(c.Reporting.SentryDSN == "" || validSentryDSN(c.Reporting.SentryDSN),
+		"SENTRY_DSN must look like https://<key>@<host>/<project_id>")
+	check(c.PayloadLog.MaxBytes > 0, "PAYLOAD_LOG_MAX_BYTES must be greater than 0")
+	check(c.Jobs.PollSeconds > 0, "JOB_POLL_SECONDS must be greater than 0")
+	check(c.Jobs.BatchSize > 0, "JOB_BATCH_SIZE must be greater than 0")
+	check(c.Jobs.MaxAttempts > 0, "JOB_MAX_ATTEMPTS must be greater than 0")
+	check(c.Cleanup.BatchSize > 0, "CLEANUP_BATCH_SIZE must be greater than 0")
+	check(c.Cleanup.SessionIntervalMinutes > 0, "CLEANUP_SESSION_INTERVAL_MINUTES must be greater than 0")
+	check(c.Cleanup.SessionRetentionDays >= 0, "CLEANUP_SESSION_RETENTION_DAYS must not be negative")
+	check(c.Cleanup.OTPIntervalMinutes > 0, "CLEANUP_OTP_INTERVAL_MINUTES must be greater than 0")
+	check(c.Cleanup.OTPRetentionHours >= 0, "CLEANUP_OTP_RETENTION_HOURS must not be negative")
+	check(c.Cleanup.JobIntervalMinutes > 0, "CLEANUP_JOB_INTERVAL_MINUTES must be greater than 0")
+	check(c.Cleanup.JobRetentionDays >= 0, "CLEANUP_JOB_RETENTION_DAYS must not be negative")
+	check(c.Tier.IntervalMinutes > 0, "TIER_INTERVAL_MINUTES must be greater than 0")
+	check(c.Tier.WindowDays > 0, "TIER_WINDOW_DAYS must be greater than 0")
+	check(c.Tier.SilverSpend > 0 && c.Tier.SilverSpend < c.Tier.GoldSpend && c.Tier.GoldSpend < c.Tier.PlatinumSpend,
+		"TIER_SILVER_SPEND, TIER_GOLD_SPEND and TIER_PLATINUM_SPEND must be positive and increasing")
+	for _, tier := range c.Pricing.FreeFeeTiers {
+		check(slices.Contains([]string{"standard", "silver", "gold", "platinum"}, tier),
+			"unknown tier %q in TIER_FREE_FEE_TIERS", tier)
+	}
+
+	// Browser menolak credentials dengan origin "*", dan memantulkan origin apa pun sama saja membuka cookie ke semua situs
+	check(!c.CORS.AllowCredentials || !slices.Contains(c.CORS.AllowedOrigins, "*"),
+		"CORS_ALLOW_CREDENTIALS requires explicit CORS_ALLOWED_ORIGINS, not *")
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func validPort(port string) bool {
+	n, err := strconv.Atoi(port)
+	return err == nil && n > 0 && n <= 65535
+}
+
+// validSentryDSN checks bentuk DSN: URL http(s) dengan public key sebagai username dan project ID di path
+func validSentryDSN(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" &&
+		u.User != nil && u.User.Username() != "" && strings.Trim(u.Path, "/") != ""
+}
+
+// validSiteURL accepts URL absolut http/https, dipakai sebagai prefix canonical URL
+func validSiteURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// splitList parses nilai env dipisah koma ("a, b,c") dan membuang entry kosong
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
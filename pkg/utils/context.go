@@ -12,6 +12,11 @@ const (
 	UserIDKey contextKey = "user_id"
 	RoleKey   contextKey = "role"
 	TokenKey  contextKey = "token"
+
+	OrganizationIDKey contextKey = "organization_id"
+
+	RequestIDKey contextKey = "request_id"
+	RouteKey     contextKey = "route"
 )
 
 // GetUserIDFromContext extracts user ID from context
@@ -68,3 +73,43 @@ func SetTokenContext(ctx context.Context, token string) context.Context {
 	ctx = context.WithValue(ctx, TokenKey, token)
 	return ctx
 }
+
+// SetOrganizationContext menandai request dari admin chain; hanya di-set middleware Admin
+func SetOrganizationContext(ctx context.Context, organizationID uuid.UUID) context.Context {
+	return context.WithValue(ctx, OrganizationIDKey, organizationID)
+}
+
+// GetOrganizationIDFromContext returns organization admin chain; false untuk admin platform dan user biasa
+func GetOrganizationIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	organizationID, ok := ctx.Value(OrganizationIDKey).(uuid.UUID)
+	return organizationID, ok
+}
+
+type impersonatorKey struct{}
+
+// SetImpersonatorContext menandai request dari session impersonation; user ID di context tetap user yang ditiru
+func SetImpersonatorContext(ctx context.Context, adminID uuid.UUID) context.Context {
+	return context.WithValue(ctx, impersonatorKey{}, adminID)
+}
+
+// GetImpersonatorFromContext returns admin di balik session impersonation; false untuk session biasa
+func GetImpersonatorFromContext(ctx context.Context) (uuid.UUID, bool) {
+	adminID, ok := ctx.Value(impersonatorKey{}).(uuid.UUID)
+	return adminID, ok
+}
+
+// SetRequestIDContext menyimpan ID request untuk log; di-set middleware RequestID dan interceptor gRPC
+func SetRequestIDContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, RequestIDKey, requestID)
+}
+
+// GetRequestIDFromContext returns ID request yang sedang diproses
+func GetRequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(RequestIDKey).(string)
+	return requestID, ok && requestID != ""
+}
+
+// SetRouteContext menyimpan nama route untuk transport tanpa chi (gRPC full method)
+func SetRouteContext(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, RouteKey, route)
+}
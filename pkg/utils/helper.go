@@ -25,6 +25,20 @@ func ParseInt(value string, defaultValue int) int {
 	return result
 }
 
+// ParseBool converts query string ke bool, fallback ke defaultValue kalau kosong/invalid
+func ParseBool(value string, defaultValue bool) bool {
+	if value == "" {
+		return defaultValue
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return defaultValue
+	}
+
+	return result
+}
+
 // GenerateOTP creates a numeric OTP of specified length
 func GenerateOTP(length int) string {
 	if length <= 0 {
@@ -40,16 +54,3 @@ func GenerateOTP(length int) string {
 
 	return otp
 }
-
-// GenerateOrderID creates a unique order ID with timestamp
-func GenerateOrderID() string {
-	rand.New(rand.NewSource(time.Now().UnixNano()))
-	now := time.Now()
-
-	// Format: BOOK-YYYYMMDD-HHMMSS-RANDOM
-	datePart := now.Format("20060102")
-	timePart := now.Format("150405")
-	randomPart := fmt.Sprintf("%04d", rand.Intn(10000))
-
-	return fmt.Sprintf("BOOK-%s-%s-%s", datePart, timePart, randomPart)
-}
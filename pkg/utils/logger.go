@@ -1,14 +1,25 @@
 package utils
 
 import (
+	"context"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
+// logLevel dipakai semua core logger dan bisa diubah saat runtime lewat LogLevel()
+var logLevel = zap.NewAtomicLevel()
+
+// LogLevel returns level logger aktif; SetLevel langsung berlaku tanpa restart
+func LogLevel() zap.AtomicLevel {
+	return logLevel
+}
+
 func InitLogger(path string, debug bool) (*zap.Logger, error) {
 	// Create log directory if not exists
 	if path != "" {
@@ -33,10 +44,10 @@ func InitLogger(path string, debug bool) (*zap.Logger, error) {
 		encoder = zapcore.NewConsoleEncoder(encoderConfig)
 	}
 
-	// Set log level
-	logLevel := zap.InfoLevel
+	// Set initial log level
+	logLevel.SetLevel(zap.InfoLevel)
 	if debug {
-		logLevel = zap.DebugLevel
+		logLevel.SetLevel(zap.DebugLevel)
 	}
 
 	// File sink with log rotation
@@ -62,3 +73,80 @@ func InitLogger(path string, debug bool) (*zap.Logger, error) {
 	logger := zap.New(core, zap.AddCaller())
 	return logger, nil
 }
+
+type requestLogFieldsKey struct{}
+
+type requestLogFields struct {
+	mu     sync.Mutex
+	fields []zap.Field
+}
+
+// WithRequestLogFields attaches wadah field log per request. Middleware Logger memasangnya paling luar,
+// jadi field yang ditambahkan middleware di dalamnya (mis. AuthSession) tetap ikut di access log.
+func WithRequestLogFields(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestLogFieldsKey{}, &requestLogFields{})
+}
+
+// AddRequestLogFields appends field ke log request ini; no-op kalau context tanpa wadah
+func AddRequestLogFields(ctx context.Context, fields ...zap.Field) {
+	holder, ok := ctx.Value(requestLogFieldsKey{}).(*requestLogFields)
+	if !ok {
+		return
+	}
+	holder.mu.Lock()
+	holder.fields = append(holder.fields, fields...)
+	holder.mu.Unlock()
+}
+
+// RequestLogFields returns field tambahan untuk request ini
+func RequestLogFields(ctx context.Context) []zap.Field {
+	holder, ok := ctx.Value(requestLogFieldsKey{}).(*requestLogFields)
+	if !ok {
+		return nil
+	}
+	holder.mu.Lock()
+	defer holder.mu.Unlock()
+	return append([]zap.Field(nil), holder.fields...)
+}
+
+// RequestLogString returns nilai string field log request dengan key tersebut, kosong kalau tidak ada
+func RequestLogString(ctx context.Context, key string) string {
+	for _, field := range RequestLogFields(ctx) {
+		if field.Key == key && field.Type == zapcore.StringType {
+			return field.String
+		}
+	}
+	return ""
+}
+
+// LoggerFromContext returns log yang diperkaya request_id, user_id dan route dari context, jadi setiap
+// log bisnis bisa dilacak ke request dan user-nya. Context tanpa metadata (job, goroutine background)
+// mendapat log apa adanya.
+func LoggerFromContext(ctx context.Context, log *zap.Logger) *zap.Logger {
+	fields := make([]zap.Field, 0, 3)
+	if requestID, ok := GetRequestIDFromContext(ctx); ok {
+		fields = append(fields, zap.String("request_id", requestID))
+	}
+	if userID, ok := GetUserIDFromContext(ctx); ok {
+		fields = append(fields, zap.String("user_id", userID.String()))
+	}
+	if route := routeFromContext(ctx); route != "" {
+		fields = append(fields, zap.String("route", route))
+	}
+
+	if len(fields) == 0 {
+		return log
+	}
+	return log.With(fields...)
+}
+
+// routeFromContext prefers route eksplisit (gRPC), lalu pattern chi yang sudah cocok sejauh ini
+func routeFromContext(ctx context.Context) string {
+	if route, ok := ctx.Value(RouteKey).(string); ok {
+		return route
+	}
+	if rctx := chi.RouteContext(ctx); rctx != nil {
+		return rctx.RoutePattern()
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Currency describes how an amount disimpan (minor unit ISO 4217) dan ditampilkan
+type Currency struct {
+	Code         string
+	Symbol       string
+	Exponent     int // digit minor unit, IDR/USD = 2
+	Decimals     int // digit desimal saat ditampilkan
+	ThousandsSep string
+	DecimalSep   string
+}
+
+var currencies = map[string]Currency{
+	"IDR": {Code: "IDR", Symbol: "Rp ", Exponent: 2, Decimals: 0, ThousandsSep: ".", DecimalSep: ","},
+	"USD": {Code: "USD", Symbol: "$", Exponent: 2, Decimals: 2, ThousandsSep: ",", DecimalSep: "."},
+	"SGD": {Code: "SGD", Symbol: "S$", Exponent: 2, Decimals: 2, ThousandsSep: ",", DecimalSep: "."},
+	"MYR": {Code: "MYR", Symbol: "RM", Exponent: 2, Decimals: 2, ThousandsSep: ",", DecimalSep: "."},
+}
+
+// LookupCurrency returns a supported currency by ISO code
+func LookupCurrency(code string) (Currency, bool) {
+	currency, ok := currencies[strings.ToUpper(code)]
+	return currency, ok
+}
+
+// CurrencyOf selalu mengembalikan currency; kode yang tidak dikenal diformat generik 2 desimal
+func CurrencyOf(code string) Currency {
+	if currency, ok := LookupCurrency(code); ok {
+		return currency
+	}
+
+	return Currency{Code: code, Symbol: code + " ", Exponent: 2, Decimals: 2, ThousandsSep: ",", DecimalSep: "."}
+}
+
+// ToMinor converts a major amount (mis. 50000.00) ke minor unit, dibulatkan ke unit terdekat
+func (c Currency) ToMinor(amount float64) int64 {
+	return int64(math.Round(amount * math.Pow10(c.Exponent)))
+}
+
+// ToMajor converts minor unit kembali ke major amount untuk response JSON
+func (c Currency) ToMajor(minor int64) float64 {
+	return float64(minor) / math.Pow10(c.Exponent)
+}
+
+// Format renders minor unit sebagai string siap tampil, mis. "Rp 50.000" atau "$12.50"
+func (c Currency) Format(minor int64) string {
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+
+	// Bulatkan ke jumlah desimal tampilan
+	scale := int64(math.Pow10(c.Exponent - c.Decimals))
+	display := (minor + scale/2) / scale
+
+	unit := int64(math.Pow10(c.Decimals))
+	whole := strconv.FormatInt(display/unit, 10)
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteString(c.ThousandsSep)
+		}
+		grouped.WriteRune(digit)
+	}
+
+	formatted := sign + c.Symbol + grouped.String()
+	if c.Decimals > 0 {
+		fraction := strconv.FormatInt(display%unit, 10)
+		formatted += c.DecimalSep + strings.Repeat("0", c.Decimals-len(fraction)) + fraction
+	}
+
+	return formatted
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"crypto/rand"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultOrderIDPrefix dipakai kalau chain tidak punya prefix sendiri dan BOOKING_ORDER_ID_PREFIX kosong
+const DefaultOrderIDPrefix = "BOOK"
+
+// orderIDAlphabet Crockford base32: tanpa I, L, O dan U supaya nomor order yang dibacakan ke CS
+// atau diketik ulang dari struk tidak tertukar. 32 karakter, jadi byte acak mod 32 tetap uniform.
+const orderIDAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// orderIDRandomLength 10 karakter = 50 bit acak per prefix per hari
+const orderIDRandomLength = 10
+
+var orderIDPrefixPattern = regexp.MustCompile(`^[A-Z0-9]{2,8}$`)
+
+// ValidOrderIDPrefix reports whether prefix boleh dipakai: 2-8 huruf besar / angka
+func ValidOrderIDPrefix(prefix string) bool {
+	return orderIDPrefixPattern.MatchString(prefix)
+}
+
+// GenerateOrderID creates order ID berformat PREFIX-YYYYMMDD-XXXXXXXXXX (tanggal UTC).
+// Keunikan tetap dijaga unique index bookings.order_id; BookingRepository.Create mengacak ulang kalau bentrok.
+func GenerateOrderID(prefix string, now time.Time) string {
+	if prefix == "" {
+		prefix = DefaultOrderIDPrefix
+	}
+	return prefix + "-" + now.UTC().Format("20060102") + "-" + randomOrderIDPart()
+}
+
+// RerollOrderID keeps prefix dan tanggal order ID, hanya bagian acaknya yang diganti
+func RerollOrderID(orderID string) string {
+	i := strings.LastIndex(orderID, "-")
+	if i < 0 {
+		return GenerateOrderID("", time.Now())
+	}
+	return orderID[:i+1] + randomOrderIDPart()
+}
+
+// NormalizeOrderID untuk lookup: order ID yang diketik customer sering huruf kecil atau berspasi
+func NormalizeOrderID(orderID string) string {
+	return strings.ToUpper(strings.TrimSpace(orderID))
+}
+
+func randomOrderIDPart() string {
+	buf := make([]byte, orderIDRandomLength)
+	// crypto/rand.Read tidak pernah gagal di platform yang didukung Go
+	_, _ = rand.Read(buf)
+	for i, b := range buf {
+		buf[i] = orderIDAlphabet[int(b)%len(orderIDAlphabet)]
+	}
+	return string(buf)
+}
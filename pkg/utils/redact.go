@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// MaskEmail keeps huruf pertama local part dan domain: "john.doe@mail.com" jadi "j***@mail.com".
+// Cukup untuk membedakan user di log tanpa menyimpan alamat lengkapnya.
+func MaskEmail(email string) string {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" {
+		return "***"
+	}
+	return local[:1] + "***@" + domain
+}
+
+// MaskPhone keeps 3 digit terakhir saja
+func MaskPhone(phone string) string {
+	if len(phone) <= 3 {
+		return "***"
+	}
+	return strings.Repeat("*", len(phone)-3) + phone[len(phone)-3:]
+}
+
+// HashToken returns 12 karakter awal SHA-256 token. Stabil, jadi baris log untuk session yang sama
+// tetap bisa dikorelasikan, tapi token aslinya tidak bisa dipakai dari log.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// EmailField is zap field dengan email yang sudah di-mask
+func EmailField(email string) zap.Field {
+	return zap.String("email", MaskEmail(email))
+}
+
+// PhoneField is zap field dengan nomor telepon yang sudah di-mask
+func PhoneField(phone string) zap.Field {
+	return zap.String("phone", MaskPhone(phone))
+}
+
+// TokenField logs session/device token sebagai hash, tidak pernah plaintext
+func TokenField(token string) zap.Field {
+	return zap.String("token_hash", HashToken(token))
+}
@@ -2,7 +2,11 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+
+	"cinema-booking/pkg/i18n"
 )
 
 type Response struct {
@@ -12,6 +16,25 @@ type Response struct {
 	Errors  any    `json:"errors,omitempty"`
 }
 
+// Pagination is the metadata block of paginated list responses
+type Pagination struct {
+	CurrentPage  int   `json:"current_page"`
+	Limit        int   `json:"limit"`
+	TotalPages   int   `json:"total_pages"`
+	TotalRecords int64 `json:"total_records"`
+
+	// Keyset pagination only (cursor mode): page/total fields are zero
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    *bool  `json:"has_more,omitempty"`
+}
+
+type PaginatedResponse struct {
+	Status     bool       `json:"status"`
+	Message    string     `json:"message"`
+	Data       any        `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
 // ResponseJSON writes JSON response with custom status code
 func ResponseJSON(w http.ResponseWriter, code int, status bool, message string, data, errors any) {
 	response := Response{
@@ -38,6 +61,20 @@ func ResponseCreated(w http.ResponseWriter, message string, data any) {
 	ResponseJSON(w, http.StatusCreated, true, message, data, nil)
 }
 
+// returns 200 OK with data and pagination side by side
+func ResponsePaginated(w http.ResponseWriter, message string, data any, pagination Pagination) {
+	response := PaginatedResponse{
+		Status:     true,
+		Message:    message,
+		Data:       data,
+		Pagination: pagination,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
 // ------------- Error responses -------------
 
 // returns 400 Bad Request
@@ -45,6 +82,24 @@ func ResponseBadRequest(w http.ResponseWriter, message string, errors any) {
 	ResponseJSON(w, http.StatusBadRequest, false, message, nil, errors)
 }
 
+// returns 400 Bad Request untuk error "validation failed" dari service. Kalau error membawa
+// ValidationErrors, field error dikirim terstruktur di "errors" dalam bahasa request.
+func ResponseValidationError(w http.ResponseWriter, r *http.Request, err error) {
+	lang := i18n.FromContext(r.Context())
+
+	var validationErrors ValidationErrors
+	if errors.As(err, &validationErrors) {
+		ResponseBadRequest(w, i18n.T(lang, "validation.failed"), validationErrors.Localize(lang))
+		return
+	}
+	ResponseBadRequest(w, i18n.Message(r.Context(), err), nil)
+}
+
+// returns 413 Request Entity Too Large
+func ResponseRequestTooLarge(w http.ResponseWriter, limit int64) {
+	ResponseJSON(w, http.StatusRequestEntityTooLarge, false, fmt.Sprintf("Request body exceeds %d bytes", limit), nil, nil)
+}
+
 // returns 401 Unauthorized
 func ResponseUnauthorized(w http.ResponseWriter, message string) {
 	ResponseJSON(w, http.StatusUnauthorized, false, message, nil, nil)
@@ -60,6 +115,11 @@ func ResponseNotFound(w http.ResponseWriter, message string) {
 	ResponseJSON(w, http.StatusNotFound, false, message, nil, nil)
 }
 
+// returns 409 Conflict
+func ResponseConflict(w http.ResponseWriter, message string) {
+	ResponseJSON(w, http.StatusConflict, false, message, nil, nil)
+}
+
 // returns 500 Internal Server Error
 func ResponseInternalError(w http.ResponseWriter, message string) {
 	ResponseJSON(w, http.StatusInternalServerError, false, message, nil, nil)
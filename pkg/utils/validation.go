@@ -1,58 +1,182 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"unicode"
+
+	"cinema-booking/pkg/i18n"
 
 	"github.com/go-playground/validator/v10"
 )
 
-var validate = validator.New()
+// embeddedSegment menandai embedded struct di namespace validator supaya tidak muncul di field path
+const embeddedSegment = "~"
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+
+	// Field path memakai nama yang dilihat client: json tag, atau snake_case untuk filter query string
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		if fld.Anonymous {
+			return embeddedSegment
+		}
+		if name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]; name != "" && name != "-" {
+			return name
+		}
+		return toSnakeCase(fld.Name)
+	})
+
+	return v
+}
+
+// FieldError is one failed rule, dikirim ke client di response "errors"
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
 
-func ValidateStruct(data interface{}) map[string]string {
+	// Disimpan supaya Message bisa dirender ulang dalam bahasa lain
+	key  string
+	args []any
+}
+
+// ValidationErrors is returned by ValidateStruct dan bisa langsung dikembalikan sebagai error dari service.
+// Handler mengambilnya kembali dengan errors.As untuk response terstruktur.
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fmt.Sprintf("%s: %s", fe.Field, fe.Message)
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+// ValidateStruct returns nil kalau data valid. Urutan error mengikuti urutan field di struct.
+func ValidateStruct(data interface{}) ValidationErrors {
 	err := validate.Struct(data)
 	if err == nil {
 		return nil
 	}
 
-	errors := make(map[string]string)
-	if validationErrors, ok := err.(validator.ValidationErrors); ok {
-		for _, err := range validationErrors {
-			errors[err.Field()] = getErrorMessage(err)
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return ValidationErrors{{Rule: "invalid", Message: err.Error()}}
+	}
+
+	result := make(ValidationErrors, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		key, args := messageKey(fe.Tag(), fe.Param(), fe.Field(), fe.Kind())
+		result = append(result, FieldError{
+			Field:   fieldPath(fe.Namespace()),
+			Rule:    fe.Tag(),
+			Message: i18n.T(i18n.English, key, args...),
+			key:     key,
+			args:    args,
+		})
+	}
+
+	return result
+}
+
+// Localize returns a copy dengan message dalam bahasa lang
+func (e ValidationErrors) Localize(lang i18n.Lang) ValidationErrors {
+	localized := make(ValidationErrors, len(e))
+	for i, fe := range e {
+		localized[i] = fe
+		if fe.key != "" {
+			localized[i].Message = i18n.T(lang, fe.key, fe.args...)
+		}
+	}
+	return localized
+}
+
+// fieldPath strips the root struct name dan segment embedded struct dari namespace,
+// mis. "GroupBookingRequest.seat_ids[1]" jadi "seat_ids[1]"
+func fieldPath(namespace string) string {
+	segments := strings.Split(namespace, ".")
+	if len(segments) > 1 {
+		segments = segments[1:]
+	}
+
+	path := segments[:0]
+	for _, segment := range segments {
+		if segment != embeddedSegment {
+			path = append(path, segment)
 		}
 	}
 
-	return errors
+	return strings.Join(path, ".")
 }
 
-// converts validator errors to human-readable messages
-func getErrorMessage(err validator.FieldError) string {
-	switch err.Tag() {
-	case "required":
-		return "This field is required"
-	case "email":
-		return "Invalid email format"
-	case "min":
-		return fmt.Sprintf("Minimum length is %s", err.Param())
-	case "max":
-		return fmt.Sprintf("Maximum length is %s", err.Param())
-	case "len":
-		return fmt.Sprintf("Must be exactly %s characters", err.Param())
+// messageKey maps a failed rule ke key i18n beserta argumennya
+func messageKey(rule, param, field string, kind reflect.Kind) (string, []any) {
+	switch rule {
+	case "required", "email", "url", "unique", "numeric", "timezone", "gt", "gte", "lt", "lte":
+		if param == "" {
+			return "validation." + rule, nil
+		}
+		return "validation." + rule, []any{param}
+	case "uuid", "uuid4":
+		return "validation.uuid", nil
+	case "required_with":
+		return "validation.required_with", []any{paramFields(param)}
+	case "datetime":
+		switch param {
+		case "2006-01-02":
+			return "validation.datetime.date", nil
+		case "15:04":
+			return "validation.datetime.time", nil
+		case "2006-01":
+			return "validation.datetime.month", nil
+		}
+		return "validation.datetime", []any{param}
+	case "min", "max", "len":
+		// Panjang untuk string, jumlah item untuk slice/map, dan nilai untuk angka
+		switch kind {
+		case reflect.String:
+			return "validation." + rule + ".string", []any{param}
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return "validation." + rule + ".items", []any{param}
+		default:
+			return "validation." + rule + ".number", []any{param}
+		}
 	case "oneof":
-		options := strings.ReplaceAll(err.Param(), " ", ", ")
-		return fmt.Sprintf("Must be one of: %s", options)
-	case "uuid":
-		return "Must be a valid UUID"
+		return "validation.oneof", []any{strings.ReplaceAll(param, " ", ", ")}
 	default:
-		return fmt.Sprintf("Invalid %s field", err.Field())
+		return "validation.invalid", []any{field}
 	}
 }
 
-// formats validation errors map into single string
-func FormatValidationErrors(errors map[string]string) string {
-	var msgs []string
-	for field, msg := range errors {
-		msgs = append(msgs, fmt.Sprintf("%s: %s", field, msg))
+// paramFields converts Go field names di param rule (mis. required_with) ke nama field client
+func paramFields(param string) string {
+	fields := strings.Fields(param)
+	for i, field := range fields {
+		fields[i] = toSnakeCase(field)
 	}
-	return strings.Join(msgs, "; ")
+	return strings.Join(fields, ", ")
+}
+
+// toSnakeCase converts Go field names seperti "MovieID" atau "RadiusKm" ke "movie_id" / "radius_km"
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
 }
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Header yang dikirim ke partner. Signature = hex HMAC-SHA256 dari "<timestamp>.<body>" dengan secret subscription;
+// partner sebaiknya menolak timestamp yang terlalu lama untuk mencegah replay.
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+const userAgent = "cinema-booking-webhooks/1.0"
+
+// maxErrorBody batas response body partner yang disimpan di pesan error
+const maxErrorBody = 512
+
+// Request is one signed delivery
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	EventType  string
+	Body       []byte
+}
+
+// Sign returns signature untuk body pada timestamp (unix detik)
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret generates a random signing secret untuk subscription baru
+func NewSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// Sender posts deliveries ke URL partner
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{client: &http.Client{Timeout: timeout}}
+}
+
+// Send returns status code response (0 kalau tidak ada response). Selain 2xx dianggap gagal.
+func (s *Sender) Send(ctx context.Context, req Request) (int, error) {
+	timestamp := time.Now().Unix()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("build webhook request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(EventHeader, req.EventType)
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryID)
+	httpReq.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, timestamp, req.Body))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	return resp.StatusCode, nil
+}
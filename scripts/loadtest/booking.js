@@ -0,0 +1,112 @@
+// k6 load test untuk hot path booking: cek seat availability lalu POST /api/booking.
+// Semua VU berebut kursi di schedule yang sama supaya lock schedule dan cek double-booking ikut teruji.
+//
+//   k6 run \
+//     -e BASE_URL=http://localhost:8080 \
+//     -e USERS=loadtest1:secret123,loadtest2:secret123 \
+//     -e CINEMA_ID=... -e SCHEDULE_ID=... -e SHOW_DATE=2026-10-20 -e SHOW_TIME=19:00 \
+//     -e PAYMENT_METHOD_ID=... \
+//     scripts/loadtest/booking.js
+//
+// Profil server selama test: GET /api/admin/debug/pprof/ (PPROF_ENABLED=true, login admin).
+import http from 'k6/http';
+import { check } from 'k6';
+
+const BASE_URL = __ENV.BASE_URL || 'http://localhost:8080';
+const SEATS_PER_BOOKING = parseInt(__ENV.SEATS_PER_BOOKING || '2', 10);
+
+export const options = {
+  scenarios: {
+    availability: {
+      executor: 'constant-arrival-rate',
+      exec: 'availability',
+      rate: parseInt(__ENV.AVAILABILITY_RPS || '50', 10),
+      timeUnit: '1s',
+      duration: __ENV.DURATION || '1m',
+      preAllocatedVUs: 20,
+    },
+    booking: {
+      executor: 'ramping-vus',
+      exec: 'booking',
+      stages: [
+        { duration: '15s', target: parseInt(__ENV.BOOKING_VUS || '20', 10) },
+        { duration: __ENV.DURATION || '1m', target: parseInt(__ENV.BOOKING_VUS || '20', 10) },
+        { duration: '10s', target: 0 },
+      ],
+    },
+  },
+  thresholds: {
+    'http_req_duration{endpoint:availability}': ['p(95)<300'],
+    'http_req_duration{endpoint:booking}': ['p(95)<800'],
+    // 400 "already booked" adalah hasil rebutan kursi yang wajar, bukan error server
+    'http_req_failed{endpoint:booking}': ['rate<0.9'],
+  },
+};
+
+// setup login sekali per user dan mengembalikan token untuk dibagi ke VU
+export function setup() {
+  const users = (__ENV.USERS || '').split(',').filter(Boolean);
+  if (users.length === 0) {
+    throw new Error('USERS is required (username:password,...)');
+  }
+
+  const tokens = users.map((pair) => {
+    const [username, password] = pair.split(':');
+    const res = http.post(`${BASE_URL}/api/login`, JSON.stringify({ username, password }), {
+      headers: { 'Content-Type': 'application/json' },
+    });
+    check(res, { 'login ok': (r) => r.status === 200 });
+    return res.json('data.token');
+  });
+
+  return { tokens };
+}
+
+function seatsURL() {
+  return `${BASE_URL}/api/cinemas/${__ENV.CINEMA_ID}/seats?date=${__ENV.SHOW_DATE}&time=${__ENV.SHOW_TIME}`;
+}
+
+function availableSeatIDs(res) {
+  const halls = res.json('data') || [];
+  const ids = [];
+  halls.forEach((hall) => {
+    (hall.seats || []).forEach((seat) => {
+      if (seat.is_available) {
+        ids.push(seat.id);
+      }
+    });
+  });
+  return ids;
+}
+
+export function availability() {
+  const res = http.get(seatsURL(), { tags: { endpoint: 'availability' } });
+  check(res, { 'availability 200': (r) => r.status === 200 });
+}
+
+export function booking(data) {
+  const token = data.tokens[__VU % data.tokens.length];
+
+  const seatsRes = http.get(seatsURL(), { tags: { endpoint: 'availability' } });
+  const free = availableSeatIDs(seatsRes);
+  if (free.length < SEATS_PER_BOOKING) {
+    return;
+  }
+
+  // Ambil kursi berdampingan secara acak supaya beberapa VU sering memilih kursi yang sama
+  const start = Math.floor(Math.random() * (free.length - SEATS_PER_BOOKING + 1));
+  const payload = {
+    schedule_id: __ENV.SCHEDULE_ID,
+    seat_ids: free.slice(start, start + SEATS_PER_BOOKING),
+    payment_method_id: __ENV.PAYMENT_METHOD_ID,
+  };
+
+  const res = http.post(`${BASE_URL}/api/booking`, JSON.stringify(payload), {
+    headers: { 'Content-Type': 'application/json', Authorization: `Bearer ${token}` },
+    tags: { endpoint: 'booking' },
+  });
+  check(res, {
+    'booking created or seat taken': (r) => r.status === 201 || r.status === 400,
+    'no server error': (r) => r.status < 500,
+  });
+}